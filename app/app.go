@@ -155,7 +155,8 @@ var (
 			ibcclientclient.UpgradeProposalHandler,
 			lscosmosclient.MinDepositAndFeeChangeProposalHandler,
 			lscosmosclient.PstakeFeeAddressChangeProposalHandler,
-			lscosmosclient.AllowListValidatorSetChangeProposalHandler},
+			lscosmosclient.AllowListValidatorSetChangeProposalHandler,
+			lscosmosclient.FeeSplitChangeProposalHandler},
 		),
 		params.AppModuleBasic{},
 		crisis.AppModuleBasic{},
@@ -415,16 +416,16 @@ func NewpStakeApp(
 		appCodec, homePath, app.BaseApp,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String())
 
+	app.LSPersistenceKeeper = lspersistencekeeper.NewKeeper(appCodec, keys[lspersistencetypes.StoreKey],
+		app.GetSubspace(lspersistencetypes.ModuleName), app.AccountKeeper, app.BankKeeper,
+		&stakingKeeper, app.DistrKeeper, app.SlashingKeeper, authtypes.NewModuleAddress(govtypes.ModuleName).String())
+
 	// register the staking hooks
 	// NOTE: stakingKeeper above is passed by reference, so that it will contain these hooks
 	app.StakingKeeper = *stakingKeeper.SetHooks(
-		stakingtypes.NewMultiStakingHooks(app.DistrKeeper.Hooks(), app.SlashingKeeper.Hooks()),
+		stakingtypes.NewMultiStakingHooks(app.DistrKeeper.Hooks(), app.SlashingKeeper.Hooks(), app.LSPersistenceKeeper.Hooks()),
 	)
 
-	app.LSPersistenceKeeper = lspersistencekeeper.NewKeeper(appCodec, keys[lspersistencetypes.StoreKey],
-		app.GetSubspace(lspersistencetypes.ModuleName), app.AccountKeeper, app.BankKeeper,
-		app.StakingKeeper, app.DistrKeeper, app.SlashingKeeper)
-
 	app.IBCKeeper = ibckeeper.NewKeeper(
 		appCodec,
 		keys[ibchost.StoreKey],
@@ -450,7 +451,7 @@ func NewpStakeApp(
 		app.IBCKeeper.ChannelKeeper,
 		&app.IBCKeeper.PortKeeper,
 		app.AccountKeeper,
-		app.BankKeeper,
+		lspersistencekeeper.NewBankKeeper(app.BankKeeper, app.LSPersistenceKeeper),
 		scopedTransferKeeper,
 	)
 	//transferModule := transfer.NewAppModule(app.TransferKeeper)
@@ -486,6 +487,7 @@ func NewpStakeApp(
 		app.GetSubspace(lscosmostypes.ModuleName),
 		app.BankKeeper,
 		app.AccountKeeper,
+		app.DistrKeeper,
 		epochsKeeper,
 		app.IBCKeeper.ChannelKeeper,
 		app.IBCKeeper.ChannelKeeper,
@@ -546,7 +548,7 @@ func NewpStakeApp(
 	// See: https://github.com/cosmos/cosmos-sdk/blob/release/v0.46.x/x/gov/spec/01_concepts.md#proposal-messages
 	govRouter := govv1beta1.NewRouter()
 	govRouter.AddRoute(govtypes.RouterKey, govv1beta1.ProposalHandler).
-		AddRoute(paramproposal.RouterKey, params.NewParamChangeProposalHandler(app.ParamsKeeper)).
+		AddRoute(paramproposal.RouterKey, lspersistence.NewParamChangeProposalHandler(app.LSPersistenceKeeper, params.NewParamChangeProposalHandler(app.ParamsKeeper))).
 		AddRoute(distrtypes.RouterKey, distr.NewCommunityPoolSpendProposalHandler(app.DistrKeeper)).
 		AddRoute(upgradetypes.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(app.UpgradeKeeper)).
 		AddRoute(lscosmostypes.RouterKey, lscosmos.NewLSCosmosProposalHandler(app.LSCosmosKeeper))
@@ -557,7 +559,8 @@ func NewpStakeApp(
 	*/
 	govKeeper := govkeeper.NewKeeper(
 		appCodec, keys[govtypes.StoreKey], app.GetSubspace(govtypes.ModuleName), app.AccountKeeper, app.BankKeeper,
-		&stakingKeeper, govRouter, app.MsgServiceRouter(), govConfig,
+		lspersistencekeeper.NewGovStakingKeeper(&stakingKeeper, app.LSPersistenceKeeper), govRouter,
+		app.MsgServiceRouter(), govConfig,
 	)
 
 	app.GovKeeper = *govKeeper.SetHooks(
@@ -593,7 +596,7 @@ func NewpStakeApp(
 		),
 		auth.NewAppModule(appCodec, app.AccountKeeper, nil),
 		vesting.NewAppModule(app.AccountKeeper, app.BankKeeper),
-		bank.NewAppModule(appCodec, app.BankKeeper, app.AccountKeeper),
+		lspersistence.NewBankAppModule(appCodec, app.BankKeeper, app.LSPersistenceKeeper, app.AccountKeeper),
 		capability.NewAppModule(appCodec, *app.CapabilityKeeper),
 		crisis.NewAppModule(&app.CrisisKeeper, skipGenesisInvariants),
 		gov.NewAppModule(appCodec, app.GovKeeper, app.AccountKeeper, app.BankKeeper),
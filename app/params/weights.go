@@ -19,8 +19,19 @@ const (
 
 	DefaultWeightMsgDummy int = 100
 
-	DefaultWeightMsgLiquidStake   int = 80
-	DefaultWeightMsgLiquidUnstake int = 30
+	DefaultWeightMsgLiquidStake          int = 80
+	DefaultWeightMsgLiquidUnstake        int = 30
+	DefaultWeightMsgInstantLiquidUnstake int = 20
+
+	DefaultWeightMsgLSCosmosLiquidStake   int = 80
+	DefaultWeightMsgLSCosmosLiquidUnstake int = 30
+	DefaultWeightMsgLSCosmosRedeem        int = 20
+	DefaultWeightMsgLSCosmosClaim         int = 20
+
+	DefaultWeightMinDepositAndFeeChangeProposal        int = 5
+	DefaultWeightPstakeFeeAddressChangeProposal        int = 5
+	DefaultWeightAllowListedValidatorSetChangeProposal int = 5
+	DefaultWeightFeeSplitChangeProposal                int = 5
 
 	DefaultWeightAddWhitelistValidatorsProposal    int = 50
 	DefaultWeightUpdateWhitelistValidatorsProposal int = 5
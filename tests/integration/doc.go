@@ -0,0 +1,14 @@
+// Package integration runs lscosmos against an in-process host chain.
+//
+// A pstake chain and an ibc-go simapp host chain are started with the ibc-go
+// testing package and connected over a transfer channel. The Harness relays
+// packets and interchain queries between them in memory, so the interchain
+// account and interchain query flows of lscosmos can be tested with a plain
+// go test, without the Docker network of tests/e2e.
+//
+// Packets can be acknowledged with an error or timed out instead of being
+// relayed, to exercise the failure paths of the host chain interactions.
+//
+// The harness swaps ibctesting.DefaultTestingAppInit while creating the
+// chains, so tests using it must not run in parallel.
+package integration
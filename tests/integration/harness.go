@@ -0,0 +1,363 @@
+package integration
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
+	ibctesting "github.com/cosmos/ibc-go/v6/testing"
+	"github.com/cosmos/ibc-go/v6/testing/simapp"
+	epochstypes "github.com/persistenceOne/persistence-sdk/v2/x/epochs/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/persistenceOne/pstake-native/v2/app"
+	"github.com/persistenceOne/pstake-native/v2/app/helpers"
+	lscosmostypes "github.com/persistenceOne/pstake-native/v2/x/lscosmos/types"
+)
+
+const (
+	// PstakeChainID is the chain id of the controller chain running the pstake app
+	PstakeChainID = "pstake-1"
+	// HostChainID is the chain id of the host chain running the ibc-go simapp
+	HostChainID = "cosmoshub-4"
+	// HostBaseDenom is the host chain bond denom liquid staked on the pstake chain
+	HostBaseDenom = sdk.DefaultBondDenom
+	// HostUnbondingTime is the unbonding time of the host chain, it is kept short so undelegations mature
+	// within a few epochs.
+	HostUnbondingTime = 3 * 24 * time.Hour
+	// HostTrustingPeriod is the trusting period of the host chain light client on the pstake chain, it must be
+	// below the host unbonding time and above the epoch duration.
+	HostTrustingPeriod = 2 * 24 * time.Hour
+)
+
+// recordingApp records the events of BeginBlock and EndBlock. ibctesting drops them, but lscosmos sends
+// interchain account transactions from its BeginBlocker and epoch hooks.
+type recordingApp struct {
+	ibctesting.TestingApp
+
+	events sdk.Events
+}
+
+// BeginBlock runs the wrapped app BeginBlock and records its events
+func (a *recordingApp) BeginBlock(req abci.RequestBeginBlock) abci.ResponseBeginBlock {
+	res := a.TestingApp.BeginBlock(req)
+	a.record(res.Events)
+	return res
+}
+
+// EndBlock runs the wrapped app EndBlock and records its events
+func (a *recordingApp) EndBlock(req abci.RequestEndBlock) abci.ResponseEndBlock {
+	res := a.TestingApp.EndBlock(req)
+	a.record(res.Events)
+	return res
+}
+
+func (a *recordingApp) record(events []abci.Event) {
+	for _, event := range events {
+		a.events = append(a.events, sdk.Event(event))
+	}
+}
+
+// popEvents returns the recorded events and clears them
+func (a *recordingApp) popEvents() sdk.Events {
+	events := a.events
+	a.events = nil
+	return events
+}
+
+// Harness runs a pstake chain and an ibc-go simapp host chain in process, it relays packets and interchain
+// queries between them in memory.
+type Harness struct {
+	t *testing.T
+
+	Coordinator  *ibctesting.Coordinator
+	PstakeChain  *ibctesting.TestChain
+	HostChain    *ibctesting.TestChain
+	TransferPath *ibctesting.Path
+
+	pstakeApp *recordingApp
+	hostApp   *recordingApp
+	icaPaths  []*ibctesting.Path
+
+	pending []pendingPacket
+	queued  map[string]bool
+}
+
+// NewHarness starts the pstake and host chains and opens a transfer channel between them
+func NewHarness(t *testing.T) *Harness {
+	t.Helper()
+
+	h := &Harness{
+		t:           t,
+		Coordinator: ibctesting.NewCoordinator(t, 0),
+		queued:      make(map[string]bool),
+	}
+
+	h.HostChain, h.hostApp = h.newChain(HostChainID, ibctesting.SetupTestingApp)
+	h.PstakeChain, h.pstakeApp = h.newChain(PstakeChainID, h.setupPstakeApp)
+
+	h.Exec(h.HostChain, func(ctx sdk.Context) error {
+		params := h.HostApp().StakingKeeper.GetParams(ctx)
+		params.UnbondingTime = HostUnbondingTime
+		h.HostApp().StakingKeeper.SetParams(ctx, params)
+		return nil
+	})
+
+	h.TransferPath = ibctesting.NewPath(h.PstakeChain, h.HostChain)
+	hostClientConfig, ok := h.TransferPath.EndpointA.ClientConfig.(*ibctesting.TendermintConfig)
+	require.True(t, ok)
+	hostClientConfig.UnbondingPeriod = HostUnbondingTime
+	hostClientConfig.TrustingPeriod = HostTrustingPeriod
+	h.TransferPath.EndpointA.ChannelConfig.PortID = ibctesting.TransferPort
+	h.TransferPath.EndpointA.ChannelConfig.Version = ibctransfertypes.Version
+	h.TransferPath.EndpointB.ChannelConfig.PortID = ibctesting.TransferPort
+	h.TransferPath.EndpointB.ChannelConfig.Version = ibctransfertypes.Version
+	h.Coordinator.Setup(h.TransferPath)
+	h.collectBlockEvents()
+
+	return h
+}
+
+// newChain creates a test chain for the app returned by setup, wrapped to record its block events
+func (h *Harness) newChain(
+	chainID string, setup func() (ibctesting.TestingApp, map[string]json.RawMessage),
+) (*ibctesting.TestChain, *recordingApp) {
+	defaultTestingAppInit := ibctesting.DefaultTestingAppInit
+	defer func() { ibctesting.DefaultTestingAppInit = defaultTestingAppInit }()
+
+	recorder := &recordingApp{}
+	ibctesting.DefaultTestingAppInit = func() (ibctesting.TestingApp, map[string]json.RawMessage) {
+		testingApp, genesis := setup()
+		recorder.TestingApp = testingApp
+		return recorder, genesis
+	}
+
+	chain := ibctesting.NewTestChain(h.t, h.Coordinator, chainID)
+	h.Coordinator.Chains[chainID] = chain
+
+	return chain, recorder
+}
+
+// setupPstakeApp returns a pstake app whose epochs start at the coordinator time. The genesis block of the test
+// chains has no time, the epochs would otherwise start at the zero time and end on every block.
+func (h *Harness) setupPstakeApp() (ibctesting.TestingApp, map[string]json.RawMessage) {
+	testingApp, genesis := helpers.SetupTestingApp()
+
+	cdc := testingApp.AppCodec()
+	var epochsGenesis epochstypes.GenesisState
+	cdc.MustUnmarshalJSON(genesis[epochstypes.ModuleName], &epochsGenesis)
+	for i := range epochsGenesis.Epochs {
+		epochsGenesis.Epochs[i].StartTime = h.Coordinator.CurrentTime
+	}
+	genesis[epochstypes.ModuleName] = cdc.MustMarshalJSON(&epochsGenesis)
+
+	return testingApp, genesis
+}
+
+// PstakeApp returns the app of the pstake chain
+func (h *Harness) PstakeApp() *app.PstakeApp {
+	pstakeApp, ok := h.pstakeApp.TestingApp.(*app.PstakeApp)
+	require.True(h.t, ok, "not pstake app")
+	return pstakeApp
+}
+
+// HostApp returns the app of the host chain
+func (h *Harness) HostApp() *simapp.SimApp {
+	hostApp, ok := h.hostApp.TestingApp.(*simapp.SimApp)
+	require.True(h.t, ok, "not simapp")
+	return hostApp
+}
+
+// PstakeSender returns the account signing the pstake chain transactions
+func (h *Harness) PstakeSender() sdk.AccAddress {
+	return h.PstakeChain.SenderAccount.GetAddress()
+}
+
+// HostSender returns the account signing the host chain transactions
+func (h *Harness) HostSender() sdk.AccAddress {
+	return h.HostChain.SenderAccount.GetAddress()
+}
+
+// Exec runs fn against the latest state of chain and commits a block, the packets sent by fn are queued for
+// relaying.
+func (h *Harness) Exec(chain *ibctesting.TestChain, fn func(ctx sdk.Context) error) {
+	h.t.Helper()
+
+	ctx := chain.GetContext()
+	require.NoError(h.t, fn(ctx))
+	h.Coordinator.CommitBlock(chain)
+	h.collect(chain, ctx.EventManager().Events())
+}
+
+// Deliver signs msgs with the sender account of chain and delivers them in a block, the packets sent by the
+// transaction are queued for relaying. ibctesting fails the test if the transaction fails.
+func (h *Harness) Deliver(chain *ibctesting.TestChain, msgs ...sdk.Msg) *sdk.Result {
+	h.t.Helper()
+
+	res, err := chain.SendMsgs(msgs...)
+	require.NoError(h.t, err)
+	h.collect(chain, res.GetEvents())
+
+	return res
+}
+
+// DefaultJumpStartMsg returns a jump start message for the host chain which allow lists all the host chain
+// validators. The pstake chain sender signs it and receives the fees, which are all zero.
+func (h *Harness) DefaultJumpStartMsg() *lscosmostypes.MsgJumpStart {
+	validators := h.HostApp().StakingKeeper.GetAllValidators(h.HostChain.GetContext())
+	require.NotEmpty(h.t, validators)
+
+	allowListedValidators := make([]lscosmostypes.AllowListedValidator, len(validators))
+	remaining := sdk.OneDec()
+	for i, validator := range validators {
+		valAddress, err := lscosmostypes.Bech32FromValAddress(validator.GetOperator(), lscosmostypes.CosmosValOperPrefix)
+		require.NoError(h.t, err)
+
+		weight := remaining
+		if i < len(validators)-1 {
+			weight = sdk.OneDec().QuoInt64(int64(len(validators)))
+		}
+		remaining = remaining.Sub(weight)
+
+		allowListedValidators[i] = lscosmostypes.AllowListedValidator{
+			ValidatorAddress: valAddress,
+			TargetWeight:     weight,
+		}
+	}
+
+	return lscosmostypes.NewMsgJumpStart(
+		h.PstakeSender(),
+		HostChainID,
+		h.TransferPath.EndpointA.ConnectionID,
+		h.TransferPath.EndpointA.ChannelID,
+		ibctransfertypes.PortID,
+		HostBaseDenom,
+		lscosmostypes.ConvertBaseDenomToMintDenom(HostBaseDenom),
+		math.OneInt(),
+		lscosmostypes.AllowListedValidators{AllowListedValidators: allowListedValidators},
+		lscosmostypes.PstakeParams{
+			PstakeDepositFee:    sdk.ZeroDec(),
+			PstakeRestakeFee:    sdk.ZeroDec(),
+			PstakeUnstakeFee:    sdk.ZeroDec(),
+			PstakeRedemptionFee: sdk.ZeroDec(),
+			PstakeFeeAddress:    h.PstakeSender().String(),
+		},
+		lscosmostypes.DefaultGenesis().HostAccounts,
+	)
+}
+
+// JumpStart makes the signer of msg a module admin and delivers msg. It then opens the delegator and rewards
+// interchain accounts and relays the withdraw address change, which enables the module.
+func (h *Harness) JumpStart(msg *lscosmostypes.MsgJumpStart) {
+	h.t.Helper()
+
+	keeper := h.PstakeApp().LSCosmosKeeper
+	h.Exec(h.PstakeChain, func(ctx sdk.Context) error {
+		roles := keeper.GetRoles(ctx)
+		roles.Admins = []string{msg.PstakeAddress}
+		keeper.SetRoles(ctx, roles)
+		return nil
+	})
+
+	h.Deliver(h.PstakeChain, msg)
+
+	// Both apps share the process wide bech32 config, so the host chain decodes validator addresses with the
+	// pstake prefix. Jump start validates the allow listed validators with the cosmos prefix, they are stored
+	// with the host chain encoding afterwards.
+	h.Exec(h.PstakeChain, func(ctx sdk.Context) error {
+		allowListedValidators := keeper.GetAllowListedValidators(ctx)
+		for i, validator := range allowListedValidators.AllowListedValidators {
+			valAddress, err := lscosmostypes.ValAddressFromBech32(validator.ValidatorAddress, lscosmostypes.CosmosValOperPrefix)
+			if err != nil {
+				return err
+			}
+			allowListedValidators.AllowListedValidators[i].ValidatorAddress = valAddress.String()
+		}
+		keeper.SetAllowListedValidators(ctx, allowListedValidators)
+		return nil
+	})
+
+	h.OpenICAChannels()
+	h.RelayAll()
+
+	require.True(h.t, keeper.GetModuleState(h.PstakeChain.GetContext()), "module not enabled by jump start")
+}
+
+// TransferToPstake sends amount of the host base denom from the host chain sender to the pstake chain sender
+// and relays it, it returns the ibc coin received on the pstake chain.
+func (h *Harness) TransferToPstake(amount math.Int) sdk.Coin {
+	h.t.Helper()
+
+	h.Deliver(h.HostChain, ibctransfertypes.NewMsgTransfer(
+		h.TransferPath.EndpointB.ChannelConfig.PortID,
+		h.TransferPath.EndpointB.ChannelID,
+		sdk.NewCoin(HostBaseDenom, amount),
+		h.HostSender().String(),
+		h.PstakeSender().String(),
+		h.PstakeChain.GetTimeoutHeight(),
+		0,
+		"",
+	))
+	h.RelayAll()
+
+	denomTrace := ibctransfertypes.ParseDenomTrace(ibctransfertypes.GetPrefixedDenom(
+		h.TransferPath.EndpointA.ChannelConfig.PortID, h.TransferPath.EndpointA.ChannelID, HostBaseDenom,
+	))
+	return sdk.NewCoin(denomTrace.IBCDenom(), amount)
+}
+
+// AllocateHostRewards distributes amount of the host base denom as rewards to every bonded host chain
+// validator and its delegators. ibctesting blocks carry no votes, so the host distribution module never
+// allocates rewards by itself.
+func (h *Harness) AllocateHostRewards(amount math.Int) {
+	h.t.Helper()
+
+	h.Exec(h.HostChain, func(ctx sdk.Context) error {
+		app := h.HostApp()
+		validators := app.StakingKeeper.GetBondedValidatorsByPower(ctx)
+
+		rewards := sdk.NewCoins(sdk.NewCoin(HostBaseDenom, amount.MulRaw(int64(len(validators)))))
+		if err := app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, rewards); err != nil {
+			return err
+		}
+		if err := app.BankKeeper.SendCoinsFromModuleToModule(ctx, minttypes.ModuleName, distrtypes.ModuleName, rewards); err != nil {
+			return err
+		}
+		for _, validator := range validators {
+			app.DistrKeeper.AllocateTokensToValidator(ctx, validator, sdk.NewDecCoins(sdk.NewDecCoin(HostBaseDenom, amount)))
+		}
+		return nil
+	})
+}
+
+// CurrentEpoch returns the current lscosmos epoch number of the pstake chain
+func (h *Harness) CurrentEpoch() int64 {
+	ctx := h.PstakeChain.GetContext()
+	return h.PstakeApp().EpochsKeeper.GetEpochInfo(ctx, lscosmostypes.DelegationEpochIdentifier).CurrentEpoch
+}
+
+// AdvanceEpoch moves both chains past the end of the current lscosmos epoch, the lscosmos epoch hooks run in
+// the next pstake BeginBlocker. Packets left pending across an epoch time out on their destination chain.
+func (h *Harness) AdvanceEpoch() {
+	h.t.Helper()
+
+	ctx := h.PstakeChain.GetContext()
+	epochInfo := h.PstakeApp().EpochsKeeper.GetEpochInfo(ctx, lscosmostypes.DelegationEpochIdentifier)
+	epochEndTime := epochInfo.CurrentEpochStartTime.Add(epochInfo.Duration)
+	if !h.Coordinator.CurrentTime.After(epochEndTime) {
+		h.Coordinator.IncrementTimeBy(epochEndTime.Sub(h.Coordinator.CurrentTime) + ibctesting.TimeIncrement)
+	}
+
+	h.Coordinator.CommitBlock(h.PstakeChain, h.HostChain)
+	require.NoError(h.t, h.TransferPath.EndpointA.UpdateClient())
+	require.NoError(h.t, h.TransferPath.EndpointB.UpdateClient())
+	h.collectBlockEvents()
+
+	require.Greater(h.t, h.CurrentEpoch(), epochInfo.CurrentEpoch, "epoch did not end")
+}
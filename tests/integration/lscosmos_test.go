@@ -0,0 +1,190 @@
+package integration
+
+import (
+	"errors"
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	icatypes "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+
+	lscosmoskeeper "github.com/persistenceOne/pstake-native/v2/x/lscosmos/keeper"
+	"github.com/persistenceOne/pstake-native/v2/x/lscosmos/types"
+)
+
+var (
+	depositAmount = math.NewInt(1_000_000)
+	hostRewards   = math.NewInt(100_000_000)
+)
+
+// hostDelegations returns the tokens the host delegation account delegates on the host chain
+func hostDelegations(h *Harness) math.Int {
+	ctx := h.HostChain.GetContext()
+	delegator := sdk.MustAccAddressFromBech32(
+		h.PstakeApp().LSCosmosKeeper.GetDelegationState(h.PstakeChain.GetContext()).HostChainDelegationAddress,
+	)
+
+	total := math.ZeroInt()
+	for _, delegation := range h.HostApp().StakingKeeper.GetAllDelegatorDelegations(ctx, delegator) {
+		validator, found := h.HostApp().StakingKeeper.GetValidator(ctx, delegation.GetValidatorAddr())
+		require.True(h.t, found)
+		total = total.Add(validator.TokensFromShares(delegation.GetShares()).TruncateInt())
+	}
+	return total
+}
+
+// liquidStake transfers amount to the pstake chain and liquid stakes it
+func liquidStake(h *Harness, amount math.Int) {
+	deposit := h.TransferToPstake(amount)
+	h.Deliver(h.PstakeChain, types.NewMsgLiquidStake(deposit, h.PstakeSender()))
+}
+
+// requireInvariants asserts the lscosmos invariants hold on the pstake chain
+func requireInvariants(h *Harness) {
+	msg, broken := lscosmoskeeper.AllInvariants(h.PstakeApp().LSCosmosKeeper)(h.PstakeChain.GetContext())
+	require.False(h.t, broken, msg)
+}
+
+func TestJumpStart(t *testing.T) {
+	h := NewHarness(t)
+	h.JumpStart(h.DefaultJumpStartMsg())
+
+	ctx := h.PstakeChain.GetContext()
+	keeper := h.PstakeApp().LSCosmosKeeper
+	delegationAddress := keeper.GetDelegationState(ctx).HostChainDelegationAddress
+	rewardAddress := keeper.GetHostChainRewardAddress(ctx).Address
+	require.NotEmpty(t, delegationAddress)
+	require.NotEmpty(t, rewardAddress)
+
+	// rewards of the delegation account are withdrawn to the rewards account
+	withdrawAddress := h.HostApp().DistrKeeper.GetDelegatorWithdrawAddr(
+		h.HostChain.GetContext(), sdk.MustAccAddressFromBech32(delegationAddress),
+	)
+	require.Equal(t, rewardAddress, withdrawAddress.String())
+}
+
+func TestLiquidStakingCycle(t *testing.T) {
+	h := NewHarness(t)
+	h.JumpStart(h.DefaultJumpStartMsg())
+	keeper := h.PstakeApp().LSCosmosKeeper
+	hostChainParams := keeper.GetHostChainParams(h.PstakeChain.GetContext())
+
+	// deposit
+	liquidStake(h, depositAmount)
+	stkBalance := h.PstakeApp().BankKeeper.GetBalance(h.PstakeChain.GetContext(), h.PstakeSender(), hostChainParams.MintDenom)
+	require.Equal(t, depositAmount, stkBalance.Amount)
+
+	// the delegation epoch transfers the deposits to the host chain, where they are delegated over ICA
+	h.AdvanceEpoch()
+	h.RelayAll()
+	require.Equal(t, depositAmount, hostDelegations(h))
+	delegationState := keeper.GetDelegationState(h.PstakeChain.GetContext())
+	require.Equal(t, depositAmount, delegationState.TotalDelegations(hostChainParams.BaseDenom).Amount)
+
+	// the reward epoch withdraws the rewards, queries the rewards account balance over ICQ and restakes it
+	h.AllocateHostRewards(hostRewards)
+	h.AdvanceEpoch()
+	h.RelayAll()
+	require.True(t, hostDelegations(h).GT(depositAmount))
+	require.True(t, keeper.GetCValue(h.PstakeChain.GetContext()).LT(sdk.OneDec()))
+
+	// undelegate on the next undelegation epoch
+	unstake := sdk.NewCoin(hostChainParams.MintDenom, stkBalance.Amount.QuoRaw(2))
+	h.Deliver(h.PstakeChain, types.NewMsgLiquidUnstake(h.PstakeSender(), unstake))
+	unbondingEpoch := types.CurrentUnbondingEpoch(h.CurrentEpoch())
+	for h.CurrentEpoch() <= unbondingEpoch {
+		h.AdvanceEpoch()
+		h.RelayAll()
+	}
+
+	unbondingEpochCValue := keeper.GetUnbondingEpochCValue(h.PstakeChain.GetContext(), unbondingEpoch)
+	require.False(t, unbondingEpochCValue.IsFailed)
+	require.Equal(t, unstake, unbondingEpochCValue.STKBurn)
+	require.True(t, unbondingEpochCValue.AmountUnbonded.IsPositive())
+	require.True(t, h.PstakeApp().BankKeeper.GetSupply(h.PstakeChain.GetContext(), hostChainParams.MintDenom).Amount.Equal(
+		stkBalance.Amount.Sub(unstake.Amount),
+	))
+
+	// the unbonded tokens are transferred back once the host chain undelegation matures
+	for i := 0; !keeper.GetUnbondingEpochCValue(h.PstakeChain.GetContext(), unbondingEpoch).IsMatured; i++ {
+		require.Less(t, i, 10, "undelegation did not mature")
+		h.AdvanceEpoch()
+		h.RelayAll()
+	}
+
+	// claim
+	ibcDenom := keeper.GetIBCDenom(h.PstakeChain.GetContext())
+	balanceBefore := h.PstakeApp().BankKeeper.GetBalance(h.PstakeChain.GetContext(), h.PstakeSender(), ibcDenom)
+	h.Deliver(h.PstakeChain, types.NewMsgClaim(h.PstakeSender()))
+	balanceAfter := h.PstakeApp().BankKeeper.GetBalance(h.PstakeChain.GetContext(), h.PstakeSender(), ibcDenom)
+	require.Equal(t, unbondingEpochCValue.AmountUnbonded.Amount, balanceAfter.Amount.Sub(balanceBefore.Amount))
+
+	requireInvariants(h)
+}
+
+// delegateUntilICA liquid stakes a deposit and relays the transfer of the delegation epoch, it returns once the
+// ICA delegation is pending.
+func delegateUntilICA(h *Harness) channeltypes.Packet {
+	liquidStake(h, depositAmount)
+	h.AdvanceEpoch()
+
+	packets := h.PendingPackets()
+	require.Len(h.t, packets, 1)
+	require.Equal(h.t, ibctransfertypes.PortID, packets[0].SourcePort)
+	h.RelayNext()
+
+	packets = h.PendingPackets()
+	require.Len(h.t, packets, 1)
+	require.Equal(h.t, icatypes.HostPortID, packets[0].DestinationPort)
+	return packets[0]
+}
+
+func TestDelegationErrorAck(t *testing.T) {
+	h := NewHarness(t)
+	h.JumpStart(h.DefaultJumpStartMsg())
+	keeper := h.PstakeApp().LSCosmosKeeper
+
+	delegateUntilICA(h)
+	h.RelayNextWithErrorAck(errors.New("delegation failed on host chain"))
+	require.True(t, hostDelegations(h).IsZero())
+	require.Empty(t, keeper.GetDelegationState(h.PstakeChain.GetContext()).HostAccountDelegations)
+
+	// the failed delegation goes back to the delegation state and is retried on the next block
+	retry := h.PendingPackets()
+	require.Len(t, retry, 1)
+	require.Equal(t, icatypes.HostPortID, retry[0].DestinationPort)
+	h.RelayAll()
+	require.Equal(t, depositAmount, hostDelegations(h))
+
+	requireInvariants(h)
+}
+
+func TestDelegationTimeout(t *testing.T) {
+	h := NewHarness(t)
+	h.JumpStart(h.DefaultJumpStartMsg())
+	keeper := h.PstakeApp().LSCosmosKeeper
+	hostChainParams := keeper.GetHostChainParams(h.PstakeChain.GetContext())
+
+	packet := delegateUntilICA(h)
+	h.TimeoutNext()
+
+	// the timeout closes the delegator ICA channel, the delegation waits in the delegation state
+	ctx := h.PstakeChain.GetContext()
+	channel, found := h.PstakeApp().IBCKeeper.ChannelKeeper.GetChannel(ctx, packet.SourcePort, packet.SourceChannel)
+	require.True(t, found)
+	require.Equal(t, channeltypes.CLOSED, channel.State)
+	require.Equal(t, depositAmount, keeper.GetDelegationState(ctx).HostDelegationAccountBalance.AmountOf(hostChainParams.BaseDenom))
+	require.Empty(t, h.PendingPackets())
+	require.True(t, hostDelegations(h).IsZero())
+
+	// the delegation is sent once the ICA channel is recreated
+	h.Deliver(h.PstakeChain, types.NewMsgRecreateICA(h.PstakeSender()))
+	h.OpenICAChannels()
+	h.RelayAll()
+	require.Equal(t, depositAmount, hostDelegations(h))
+
+	requireInvariants(h)
+}
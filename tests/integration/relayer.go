@@ -0,0 +1,336 @@
+package integration
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	icatypes "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v6/modules/core/24-host"
+	ibctesting "github.com/cosmos/ibc-go/v6/testing"
+	icqtypes "github.com/persistenceOne/persistence-sdk/v2/x/interchainquery/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+// maxRelayRounds bounds the packets and queries relayed by a single RelayAll
+const maxRelayRounds = 100
+
+// pendingPacket is a packet sent by either chain and not relayed yet. Channel identifiers are only unique per
+// chain, so the packet is kept with the chain which sent it.
+type pendingPacket struct {
+	channeltypes.Packet
+	source *ibctesting.TestChain
+}
+
+// key returns the unique identifier of the packet
+func (p pendingPacket) key() string {
+	return fmt.Sprintf("%s/%s/%s/%d", p.source.ChainID, p.SourcePort, p.SourceChannel, p.Sequence)
+}
+
+// sameChannel returns true if both packets are sent over the same channel of the same chain
+func (p pendingPacket) sameChannel(other pendingPacket) bool {
+	return p.source == other.source && p.SourcePort == other.SourcePort && p.SourceChannel == other.SourceChannel
+}
+
+// collect queues the packets sent in the events emitted by chain, and in the recorded block events of both
+// chains
+func (h *Harness) collect(chain *ibctesting.TestChain, events sdk.Events) {
+	h.collectEvents(chain, events)
+	h.collectBlockEvents()
+}
+
+// collectBlockEvents queues the packets sent in the recorded block events of both chains
+func (h *Harness) collectBlockEvents() {
+	h.collectEvents(h.PstakeChain, h.pstakeApp.popEvents())
+	h.collectEvents(h.HostChain, h.hostApp.popEvents())
+}
+
+// collectEvents queues the packets sent in the events emitted by chain
+func (h *Harness) collectEvents(chain *ibctesting.TestChain, events sdk.Events) {
+	for _, event := range events {
+		if event.Type != channeltypes.EventTypeSendPacket {
+			continue
+		}
+		packet, err := ibctesting.ParsePacketFromEvents(sdk.Events{event})
+		require.NoError(h.t, err)
+
+		pending := pendingPacket{Packet: packet, source: chain}
+		if h.queued[pending.key()] {
+			continue
+		}
+		h.queued[pending.key()] = true
+		h.pending = append(h.pending, pending)
+	}
+}
+
+// PendingPackets returns the packets sent by either chain which are not relayed yet
+func (h *Harness) PendingPackets() []channeltypes.Packet {
+	packets := make([]channeltypes.Packet, len(h.pending))
+	for i, pending := range h.pending {
+		packets[i] = pending.Packet
+	}
+	return packets
+}
+
+// popPacket removes and returns the oldest pending packet, packets of a channel are returned in sequence order
+func (h *Harness) popPacket() pendingPacket {
+	require.NotEmpty(h.t, h.pending, "no pending packets")
+
+	next := 0
+	for i, pending := range h.pending {
+		if pending.sameChannel(h.pending[next]) && pending.Sequence < h.pending[next].Sequence {
+			next = i
+		}
+	}
+
+	packet := h.pending[next]
+	h.pending = append(h.pending[:next], h.pending[next+1:]...)
+	return packet
+}
+
+// endpoints returns the source and destination endpoints of packet
+func (h *Harness) endpoints(packet pendingPacket) (src, dst *ibctesting.Endpoint) {
+	for _, path := range append([]*ibctesting.Path{h.TransferPath}, h.icaPaths...) {
+		for _, endpoint := range []*ibctesting.Endpoint{path.EndpointA, path.EndpointB} {
+			if endpoint.Chain == packet.source && endpoint.ChannelConfig.PortID == packet.SourcePort &&
+				endpoint.ChannelID == packet.SourceChannel {
+				return endpoint, endpoint.Counterparty
+			}
+		}
+	}
+	h.t.Fatalf("no path for packet sent over %s/%s of %s", packet.SourcePort, packet.SourceChannel, packet.source.ChainID)
+	return nil, nil
+}
+
+// RelayAll relays the pending packets and answers the interchain queries until there are none left, including
+// the ones sent while relaying.
+func (h *Harness) RelayAll() {
+	h.t.Helper()
+
+	for round := 0; ; round++ {
+		require.Less(h.t, round, maxRelayRounds, "relaying did not settle")
+
+		if len(h.pending) != 0 {
+			h.RelayNext()
+			continue
+		}
+		if h.RelayQueries() == 0 {
+			return
+		}
+	}
+}
+
+// RelayNext receives the oldest pending packet on its destination chain and relays the acknowledgement back to
+// the source chain.
+func (h *Harness) RelayNext() {
+	h.t.Helper()
+
+	packet := h.popPacket()
+	src, dst := h.endpoints(packet)
+
+	// packets sent in BeginBlock belong to the open block of the source chain, it is committed here and the
+	// update commits the next one, whose header proves the commitment
+	h.Coordinator.CommitBlock(src.Chain)
+	h.collectBlockEvents()
+	require.NoError(h.t, dst.UpdateClient())
+	res, err := dst.RecvPacketWithResult(packet.Packet)
+	require.NoError(h.t, err)
+	h.collect(dst.Chain, res.GetEvents())
+
+	ack, err := ibctesting.ParseAckFromEvents(res.GetEvents())
+	require.NoError(h.t, err)
+	h.acknowledge(src, packet, ack)
+}
+
+// RelayNextWithErrorAck acknowledges the oldest pending packet with an error acknowledgement, as if its
+// execution failed on the destination chain. Only the packet receipt and the acknowledgement are written on the
+// destination chain.
+func (h *Harness) RelayNextWithErrorAck(err error) {
+	h.t.Helper()
+
+	packet := h.popPacket()
+	src, dst := h.endpoints(packet)
+	ack := channeltypes.NewErrorAcknowledgement(err)
+
+	h.Exec(dst.Chain, func(ctx sdk.Context) error {
+		channelKeeper := dst.Chain.App.GetIBCKeeper().ChannelKeeper
+		if dst.ChannelConfig.Order == channeltypes.ORDERED {
+			channelKeeper.SetNextSequenceRecv(ctx, packet.DestinationPort, packet.DestinationChannel, packet.Sequence+1)
+		} else {
+			channelKeeper.SetPacketReceipt(ctx, packet.DestinationPort, packet.DestinationChannel, packet.Sequence)
+		}
+		channelKeeper.SetPacketAcknowledgement(ctx, packet.DestinationPort, packet.DestinationChannel, packet.Sequence,
+			channeltypes.CommitAcknowledgement(ack.Acknowledgement()))
+		return nil
+	})
+
+	require.NoError(h.t, src.UpdateClient())
+	h.acknowledge(src, packet, ack.Acknowledgement())
+}
+
+// TimeoutNext lets the oldest pending packet time out on its destination chain and relays the timeout to the
+// source chain. A timeout closes ordered channels, like the interchain account ones, on both chains.
+func (h *Harness) TimeoutNext() {
+	h.t.Helper()
+
+	packet := h.popPacket()
+	src, dst := h.endpoints(packet)
+
+	if packet.TimeoutTimestamp != 0 {
+		timeout := time.Unix(0, int64(packet.TimeoutTimestamp)).UTC()
+		if !h.Coordinator.CurrentTime.After(timeout) {
+			h.Coordinator.IncrementTimeBy(timeout.Sub(h.Coordinator.CurrentTime) + ibctesting.TimeIncrement)
+		}
+	}
+	h.Coordinator.CommitBlock(dst.Chain)
+	for !packet.TimeoutHeight.IsZero() && uint64(dst.Chain.App.LastBlockHeight()) < packet.TimeoutHeight.RevisionHeight {
+		h.Coordinator.CommitBlock(dst.Chain)
+	}
+	require.NoError(h.t, src.UpdateClient())
+	h.collectBlockEvents()
+
+	var proofKey []byte
+	if dst.ChannelConfig.Order == channeltypes.ORDERED {
+		proofKey = host.NextSequenceRecvKey(packet.DestinationPort, packet.DestinationChannel)
+	} else {
+		proofKey = host.PacketReceiptKey(packet.DestinationPort, packet.DestinationChannel, packet.Sequence)
+	}
+	proof, proofHeight := dst.QueryProof(proofKey)
+
+	nextSequenceRecv, found := dst.Chain.App.GetIBCKeeper().ChannelKeeper.GetNextSequenceRecv(
+		dst.Chain.GetContext(), packet.DestinationPort, packet.DestinationChannel,
+	)
+	require.True(h.t, found)
+
+	h.Deliver(src.Chain, channeltypes.NewMsgTimeout(
+		packet.Packet, nextSequenceRecv, proof, proofHeight, src.Chain.SenderAccount.GetAddress().String(),
+	))
+
+	if dst.ChannelConfig.Order == channeltypes.ORDERED {
+		h.closeConfirm(dst)
+	}
+}
+
+// closeConfirm closes the channel of endpoint after its counterparty channel was closed, like a relayer would
+func (h *Harness) closeConfirm(endpoint *ibctesting.Endpoint) {
+	require.NoError(h.t, endpoint.UpdateClient())
+	proof, proofHeight := endpoint.Counterparty.QueryProof(
+		host.ChannelKey(endpoint.Counterparty.ChannelConfig.PortID, endpoint.Counterparty.ChannelID),
+	)
+
+	h.Deliver(endpoint.Chain, channeltypes.NewMsgChannelCloseConfirm(
+		endpoint.ChannelConfig.PortID, endpoint.ChannelID, proof, proofHeight,
+		endpoint.Chain.SenderAccount.GetAddress().String(),
+	))
+}
+
+// acknowledge relays the acknowledgement of packet written on the destination chain to the source chain
+func (h *Harness) acknowledge(src *ibctesting.Endpoint, packet pendingPacket, ack []byte) {
+	proof, proofHeight := src.Counterparty.QueryProof(
+		host.PacketAcknowledgementKey(packet.DestinationPort, packet.DestinationChannel, packet.Sequence),
+	)
+
+	h.Deliver(src.Chain, channeltypes.NewMsgAcknowledgement(
+		packet.Packet, ack, proof, proofHeight, src.Chain.SenderAccount.GetAddress().String(),
+	))
+}
+
+// RelayQueries answers the one-shot interchain queries the pstake chain made to the host chain with the latest
+// host chain state, it returns the number of answered queries. No proofs are attached, the interchainquery
+// module only verifies them for raw store key queries, which lscosmos does not make.
+func (h *Harness) RelayQueries() int {
+	h.t.Helper()
+
+	queries := h.PstakeApp().InterchainQueryKeeper.AllQueries(h.PstakeChain.GetContext())
+
+	answered := 0
+	for _, query := range queries {
+		if query.ChainId != HostChainID || !query.Period.IsNegative() {
+			continue
+		}
+
+		res := h.HostChain.App.Query(abci.RequestQuery{
+			Path: "/" + query.QueryType,
+			Data: query.Request,
+		})
+		require.True(h.t, res.IsOK(), "query %s failed: %s", query.QueryType, res.Log)
+
+		h.Deliver(h.PstakeChain, &icqtypes.MsgSubmitQueryResponse{
+			ChainId:     query.ChainId,
+			QueryId:     query.Id,
+			Result:      res.Value,
+			Height:      res.Height,
+			FromAddress: h.PstakeSender().String(),
+		})
+		answered++
+	}
+	return answered
+}
+
+// OpenICAChannels completes the handshake of every interchain account channel initialised on the pstake chain,
+// including the ones initialised while completing an earlier handshake.
+func (h *Harness) OpenICAChannels() {
+	h.t.Helper()
+
+	for {
+		channel, found := h.initICAChannel()
+		if !found {
+			return
+		}
+		h.openICAChannel(channel)
+	}
+}
+
+// initICAChannel returns an interchain account channel of the pstake chain waiting for the host chain
+func (h *Harness) initICAChannel() (channeltypes.IdentifiedChannel, bool) {
+	channels := h.PstakeChain.App.GetIBCKeeper().ChannelKeeper.GetAllChannels(h.PstakeChain.GetContext())
+	for _, channel := range channels {
+		if channel.State == channeltypes.INIT && strings.HasPrefix(channel.PortId, icatypes.ControllerPortPrefix) {
+			return channel, true
+		}
+	}
+	return channeltypes.IdentifiedChannel{}, false
+}
+
+// openICAChannel completes the handshake of an initialised interchain account channel over the connection of
+// the transfer channel.
+func (h *Harness) openICAChannel(channel channeltypes.IdentifiedChannel) {
+	path := ibctesting.NewPath(h.PstakeChain, h.HostChain)
+	path.EndpointA.ClientID = h.TransferPath.EndpointA.ClientID
+	path.EndpointA.ConnectionID = h.TransferPath.EndpointA.ConnectionID
+	path.EndpointA.ChannelID = channel.ChannelId
+	path.EndpointA.ChannelConfig = &ibctesting.ChannelConfig{
+		PortID:  channel.PortId,
+		Version: channel.Version,
+		Order:   channel.Ordering,
+	}
+	path.EndpointB.ClientID = h.TransferPath.EndpointB.ClientID
+	path.EndpointB.ConnectionID = h.TransferPath.EndpointB.ConnectionID
+	path.EndpointB.ChannelConfig = &ibctesting.ChannelConfig{
+		PortID:  icatypes.HostPortID,
+		Version: channel.Version,
+		Order:   channel.Ordering,
+	}
+
+	require.NoError(h.t, path.EndpointB.ChanOpenTry())
+
+	// the ack is delivered here rather than with ibctesting to queue the packets sent by the lscosmos callback
+	require.NoError(h.t, path.EndpointA.UpdateClient())
+	proof, proofHeight := path.EndpointB.QueryProof(
+		host.ChannelKey(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID),
+	)
+	h.Deliver(h.PstakeChain, channeltypes.NewMsgChannelOpenAck(
+		path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID,
+		path.EndpointB.ChannelID, path.EndpointB.ChannelConfig.Version,
+		proof, proofHeight, h.PstakeSender().String(),
+	))
+	path.EndpointA.ChannelConfig.Version = path.EndpointA.GetChannel().Version
+
+	require.NoError(h.t, path.EndpointB.ChanOpenConfirm())
+	h.collectBlockEvents()
+
+	h.icaPaths = append(h.icaPaths, path)
+}
@@ -41,6 +41,21 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 		CmdQueryHostAccounts(),
 		CmdQueryDepositModuleAccount(),
 		CmdDelegatorUnbondingEpochEntries(),
+		CmdQueryRemainingCapacity(),
+		CmdQueryFeeSplit(),
+		CmdQueryCollectedFees(),
+		CmdQueryRoles(),
+		CmdQueryModuleStatus(),
+		CmdQueryUnbondingEpochs(),
+		CmdQueryDelegatorUnbondingEpochEntriesInRange(),
+		CmdQueryHostAccountDelegations(),
+		CmdQueryHostAccountDelegation(),
+		CmdQueryUnbondingEpochDelegatorEntries(),
+		CmdQueryValidatorWeights(),
+		CmdQueryRewardEpochRecords(),
+		CmdQueryHostProposals(),
+		CmdQueryHostProposal(),
+		CmdQueryHostProposalVotes(),
 	)
 
 	return cmd
@@ -82,7 +97,12 @@ func CmdQueryDelegationState() *cobra.Command {
 
 			queryClient := types.NewQueryClient(clientCtx)
 
-			res, err := queryClient.DelegationState(context.Background(), &types.QueryDelegationStateRequest{})
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.DelegationState(context.Background(), &types.QueryDelegationStateRequest{Pagination: pageReq})
 			if err != nil {
 				return err
 			}
@@ -92,6 +112,7 @@ func CmdQueryDelegationState() *cobra.Command {
 	}
 
 	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "delegation-state")
 
 	return cmd
 }
@@ -107,7 +128,12 @@ func CmdQueryAllowListedValidators() *cobra.Command {
 
 			queryClient := types.NewQueryClient(clientCtx)
 
-			res, err := queryClient.AllowListedValidators(context.Background(), &types.QueryAllowListedValidatorsRequest{})
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.AllowListedValidators(context.Background(), &types.QueryAllowListedValidatorsRequest{Pagination: pageReq})
 			if err != nil {
 				return err
 			}
@@ -117,6 +143,7 @@ func CmdQueryAllowListedValidators() *cobra.Command {
 	}
 
 	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "allow-listed-validators")
 
 	return cmd
 }
@@ -212,7 +239,12 @@ func CmdQueryUnclaimed() *cobra.Command {
 				return err
 			}
 
-			res, err := queryClient.Unclaimed(context.Background(), &types.QueryUnclaimedRequest{DelegatorAddress: delegatorAddress.String()})
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.Unclaimed(context.Background(), &types.QueryUnclaimedRequest{DelegatorAddress: delegatorAddress.String(), Pagination: pageReq})
 			if err != nil {
 				return err
 			}
@@ -222,6 +254,7 @@ func CmdQueryUnclaimed() *cobra.Command {
 	}
 
 	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "unclaimed")
 
 	return cmd
 }
@@ -242,7 +275,12 @@ func CmdQueryFailedUnbondings() *cobra.Command {
 				return err
 			}
 
-			res, err := queryClient.FailedUnbondings(context.Background(), &types.QueryFailedUnbondingsRequest{DelegatorAddress: args[0]})
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.FailedUnbondings(context.Background(), &types.QueryFailedUnbondingsRequest{DelegatorAddress: args[0], Pagination: pageReq})
 			if err != nil {
 				return err
 			}
@@ -252,6 +290,7 @@ func CmdQueryFailedUnbondings() *cobra.Command {
 	}
 
 	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "failed-unbondings")
 
 	return cmd
 }
@@ -272,7 +311,12 @@ func CmdQueryPendingUnbondings() *cobra.Command {
 				return err
 			}
 
-			res, err := queryClient.PendingUnbondings(context.Background(), &types.QueryPendingUnbondingsRequest{DelegatorAddress: args[0]})
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.PendingUnbondings(context.Background(), &types.QueryPendingUnbondingsRequest{DelegatorAddress: args[0], Pagination: pageReq})
 			if err != nil {
 				return err
 			}
@@ -282,6 +326,7 @@ func CmdQueryPendingUnbondings() *cobra.Command {
 	}
 
 	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "pending-unbondings")
 
 	return cmd
 }
@@ -447,7 +492,484 @@ func CmdDelegatorUnbondingEpochEntries() *cobra.Command {
 				return err
 			}
 
-			res, err := queryClient.DelegatorUnbondingEpochEntries(context.Background(), &types.QueryAllDelegatorUnbondingEpochEntriesRequest{DelegatorAddress: delegatorAddress.String()})
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.DelegatorUnbondingEpochEntries(context.Background(), &types.QueryAllDelegatorUnbondingEpochEntriesRequest{DelegatorAddress: delegatorAddress.String(), Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "delegator-unbonding-epoch-entries")
+
+	return cmd
+}
+
+// CmdQueryRemainingCapacity implements the remaining deposit capacity query command
+func CmdQueryRemainingCapacity() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remaining-capacity [delegator-address]",
+		Args:  cobra.RangeArgs(0, 1),
+		Short: "Shows the amount that can still be deposited before hitting the deposit caps, optionally for a delegator",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			request := &types.QueryRemainingCapacityRequest{}
+			if len(args) == 1 {
+				delegatorAddress, err := sdk.AccAddressFromBech32(args[0])
+				if err != nil {
+					return err
+				}
+				request.DelegatorAddress = delegatorAddress.String()
+			}
+
+			res, err := queryClient.RemainingCapacity(context.Background(), request)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdQueryFeeSplit implements the protocol fee split query command
+func CmdQueryFeeSplit() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fee-split",
+		Args:  cobra.NoArgs,
+		Short: "Shows the weighted recipients of every protocol fee type",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.FeeSplit(context.Background(), &types.QueryFeeSplitRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdQueryCollectedFees implements the cumulative protocol fees query command
+func CmdQueryCollectedFees() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "collected-fees [fee-type]",
+		Args:  cobra.RangeArgs(0, 1),
+		Short: "Shows the cumulative protocol fees sent to every recipient, optionally for a fee type (deposit, restake, unstake or redemption)",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			request := &types.QueryCollectedFeesRequest{}
+			if len(args) == 1 {
+				request.FeeType = args[0]
+			}
+
+			res, err := queryClient.CollectedFees(context.Background(), request)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdQueryRoles implements the roles query command
+func CmdQueryRoles() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "roles",
+		Args:  cobra.NoArgs,
+		Short: "Shows the admins, pausers, slashing reporters and the pending admin change",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Roles(context.Background(), &types.QueryRolesRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdQueryModuleStatus implements the module status query command
+func CmdQueryModuleStatus() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "module-status",
+		Args:  cobra.NoArgs,
+		Short: "Shows the module state and all the pause switches",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ModuleStatus(context.Background(), &types.QueryModuleStatusRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdQueryUnbondingEpochs implements the unbonding epochs in range query command
+func CmdQueryUnbondingEpochs() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unbonding-epochs [start-epoch] [end-epoch]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Shows unbonding epoch details for the epochs between start and end epoch, both included",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			startEpoch, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			endEpoch, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.UnbondingEpochCValues(context.Background(), &types.QueryUnbondingEpochCValuesRequest{StartEpoch: startEpoch, EndEpoch: endEpoch})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdQueryDelegatorUnbondingEpochEntriesInRange implements the delegator unbonding epoch entries in range query command
+func CmdQueryDelegatorUnbondingEpochEntriesInRange() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delegator-unbonding-epoch-entries-in-range [delegator-address] [start-epoch] [end-epoch]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Shows the delegator unbonding epoch entries for the epochs between start and end epoch, both included",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			delegatorAddress, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			startEpoch, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			endEpoch, err := strconv.ParseInt(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.DelegatorUnbondingEpochEntriesInRange(context.Background(), &types.QueryDelegatorUnbondingEpochEntriesInRangeRequest{
+				DelegatorAddress: delegatorAddress.String(),
+				StartEpoch:       startEpoch,
+				EndEpoch:         endEpoch,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdQueryHostAccountDelegations implements the paginated host account delegations query command
+func CmdQueryHostAccountDelegations() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "host-account-delegations",
+		Args:  cobra.NoArgs,
+		Short: "Shows the host account delegations of all validators",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.HostAccountDelegations(context.Background(), &types.QueryHostAccountDelegationsRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "host-account-delegations")
+
+	return cmd
+}
+
+// CmdQueryHostAccountDelegation implements the host account delegation query command
+func CmdQueryHostAccountDelegation() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "host-account-delegation [validator-address]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Shows the host account delegation of the given host chain validator",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.HostAccountDelegation(context.Background(), &types.QueryHostAccountDelegationRequest{ValidatorAddress: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdQueryUnbondingEpochDelegatorEntries implements the unbonding epoch delegator entries query command
+func CmdQueryUnbondingEpochDelegatorEntries() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unbonding-epoch-delegator-entries [epoch-number]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Shows the unbonding epoch entries of all delegators for the given epoch number",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			epochNumber, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.UnbondingEpochDelegatorEntries(context.Background(), &types.QueryUnbondingEpochDelegatorEntriesRequest{
+				EpochNumber: epochNumber,
+				Pagination:  pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "unbonding-epoch-delegator-entries")
+
+	return cmd
+}
+
+// CmdQueryValidatorWeights implements the validator weights query command
+func CmdQueryValidatorWeights() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validator-weights",
+		Short: "shows base and effective weights of the allow listed validators with their host chain metrics",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ValidatorWeights(context.Background(), &types.QueryValidatorWeightsRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "validator-weights")
+
+	return cmd
+}
+
+// CmdQueryRewardEpochRecords implements the reward epoch records query command
+func CmdQueryRewardEpochRecords() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reward-epoch-records",
+		Short: "shows accrued, restaked and carried over rewards per reward epoch",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.RewardEpochRecords(context.Background(), &types.QueryRewardEpochRecordsRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "reward-epoch-records")
+
+	return cmd
+}
+
+// CmdQueryHostProposals implements the host proposals query command
+func CmdQueryHostProposals() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "host-proposals",
+		Short: "shows the host chain proposals registered for vote signals",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.HostProposals(context.Background(), &types.QueryHostProposalsRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "host-proposals")
+
+	return cmd
+}
+
+// CmdQueryHostProposal implements the host proposal query command
+func CmdQueryHostProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "host-proposal [proposal-id]",
+		Short: "shows a host chain proposal registered for vote signals with its tally",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.HostProposal(context.Background(), &types.QueryHostProposalRequest{ProposalId: proposalID})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdQueryHostProposalVotes implements the host proposal votes query command
+func CmdQueryHostProposalVotes() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "host-proposal-votes [proposal-id]",
+		Short: "shows the vote signals on a host chain proposal",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.HostProposalVotes(context.Background(), &types.QueryHostProposalVotesRequest{ProposalId: proposalID, Pagination: pageReq})
 			if err != nil {
 				return err
 			}
@@ -457,6 +979,7 @@ func CmdDelegatorUnbondingEpochEntries() *cobra.Command {
 	}
 
 	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "host-proposal-votes")
 
 	return cmd
 }
@@ -4,12 +4,14 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
+	govutils "github.com/cosmos/cosmos-sdk/x/gov/client/utils"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 	"github.com/spf13/cobra"
 
@@ -37,6 +39,13 @@ func GetTxCmd() *cobra.Command {
 		NewRecreateICACmd(),
 		NewChangeModuleStateCmd(),
 		NewReportSlashingCmd(),
+		NewTransferUnbondingEntryCmd(),
+		NewClaimForCmd(),
+		NewSetAutoClaimCmd(),
+		NewUpdateRolesCmd(),
+		NewSetPauseSwitchesCmd(),
+		NewRegisterHostProposalCmd(),
+		NewVoteHostProposalCmd(),
 	)
 
 	return cmd
@@ -293,6 +302,73 @@ $ %s tx gov submit-proposal pstake-lscosmos-change-allow-listed-validator-set <p
 	}
 }
 
+func NewFeeSplitChangeProposalCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "pstake-lscosmos-change-fee-split [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a protocol fee split change proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a protocol fee split change proposal along with an initial deposit
+The proposal details must be supplied via a JSON file. Fee types without recipients
+are sent to the pstake fee address, weights of a fee type must add up to 1.
+
+Example Proposal :
+{
+	"title": "change fee split",
+	"description": "this proposal splits the deposit fee between treasury and insurance fund",
+	"fee_split": {
+		"deposit_fee_recipients": [
+			{
+				"address": "persistence1pss7nxeh3f9md2vuxku8q99femnwdjtcpe9ky9",
+				"weight": "0.7"
+			},
+			{
+				"address": "persistence1826wkxx8wv7mfnank8l6xu9rxm7kg8rvvk4e0a",
+				"weight": "0.3"
+			}
+		]
+	},
+	"deposit": "100stake"
+}
+
+Example:
+$ %s tx gov submit-proposal pstake-lscosmos-change-fee-split <path/to/proposal.json> --from <key_or_address> --fees <1000stake> --gas <200000>
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			proposal, err := utils.ParseFeeSplitChangeProposalJSON(clientCtx.LegacyAmino, args[0])
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+
+			content := types.NewFeeSplitChangeProposal(
+				proposal.Title,
+				proposal.Description,
+				proposal.FeeSplit,
+			)
+			deposit, err := sdk.ParseCoinsNormalized(proposal.Deposit)
+			if err != nil {
+				return err
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+}
+
 func NewLiquidUnstakeCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "liquid-unstake [amount(stk/Atom)]",
@@ -515,3 +591,273 @@ func NewReportSlashingCmd() *cobra.Command {
 
 	return cmd
 }
+
+func NewTransferUnbondingEntryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer-unbonding-entry [recipient-address] [epoch-number] [amount(stkDenom)]",
+		Short: "Transfer the whole or a part of an unbonding epoch entry to another address",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			clientctx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			recipientAddress, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			epochNumber, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+			amount, err := sdk.ParseCoinNormalized(args[2])
+			if err != nil {
+				return err
+			}
+
+			delegatorAddress := clientctx.GetFromAddress()
+			msg := types.NewMsgTransferUnbondingEntry(delegatorAddress, recipientAddress, epochNumber, amount)
+
+			return tx.GenerateOrBroadcastTxCLI(clientctx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewClaimForCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "claim-for [delegator-address]",
+		Short: "Claim matured tokens on behalf of a delegator, tokens are sent to the delegator",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			clientctx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			delegatorAddress, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			claimerAddress := clientctx.GetFromAddress()
+			msg := types.NewMsgClaimFor(claimerAddress, delegatorAddress)
+
+			return tx.GenerateOrBroadcastTxCLI(clientctx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewSetAutoClaimCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-auto-claim [enabled]",
+		Short: "Opt in or out of automatic claims of matured tokens",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			clientctx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			enabled, err := strconv.ParseBool(args[0])
+			if err != nil {
+				return err
+			}
+
+			delegatorAddress := clientctx.GetFromAddress()
+			msg := types.NewMsgSetAutoClaim(delegatorAddress, enabled)
+
+			return tx.GenerateOrBroadcastTxCLI(clientctx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewUpdateRolesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-roles [admins] [pausers] [slashing-reporters]",
+		Short: "Update the admins, pausers and slashing reporters, admin changes take effect after the admin timelock",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Update the module roles, every role takes a comma separated list of addresses.
+Pausers and slashing reporters fall back to the admins if left empty.
+
+Example:
+$ %s tx lscosmos update-roles persistence1admin1,persistence1admin2 persistence1pauser "" --from <admin>
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			clientctx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			roles := types.Roles{
+				Admins:            splitList(args[0]),
+				Pausers:           splitList(args[1]),
+				SlashingReporters: splitList(args[2]),
+			}
+
+			adminAddress := clientctx.GetFromAddress()
+			msg := types.NewMsgUpdateRoles(adminAddress, roles)
+
+			return tx.GenerateOrBroadcastTxCLI(clientctx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewSetPauseSwitchesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-pause-switches [paused-switches]",
+		Short: "Pause the listed operations and resume all the others",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Pause the comma separated list of operations and resume all the others, an empty list resumes everything.
+Available switches: %s
+
+Example:
+$ %s tx lscosmos set-pause-switches deposits,unstakes --from <pauser>
+`,
+				strings.Join(types.PauseSwitchNames, ", "), version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			clientctx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pauseSwitches, err := types.NewPauseSwitches(splitList(args[0]))
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetPauseSwitches(clientctx.GetFromAddress(), pauseSwitches)
+
+			return tx.GenerateOrBroadcastTxCLI(clientctx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewRegisterHostProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register-host-proposal [proposal-id] [voting-end-time]",
+		Short: "Register a host chain proposal for stk holders to signal their votes on",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Register a host chain proposal with the end of its voting period in RFC3339 format.
+
+Example:
+$ %s tx lscosmos register-host-proposal 42 2023-06-01T12:00:00Z --from <admin>
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			clientctx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			votingEndTime, err := time.Parse(time.RFC3339, args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRegisterHostProposal(clientctx.GetFromAddress(), proposalID, votingEndTime)
+
+			return tx.GenerateOrBroadcastTxCLI(clientctx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewVoteHostProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vote-host-proposal [proposal-id] [weighted-options]",
+		Short: "Signal a weighted vote on a host chain proposal with the stk balance",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Signal a weighted vote on a registered host chain proposal, the signal is weighted by the stk
+balance of the voter when the vote is sent to the host chain.
+
+Example:
+$ %s tx lscosmos vote-host-proposal 42 yes=0.6,no=0.3,abstain=0.1 --from <delegator>
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			clientctx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			options, err := govtypes.WeightedVoteOptionsFromString(govutils.NormalizeWeightedVoteOptions(args[1]))
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgVoteHostProposal(clientctx.GetFromAddress(), proposalID, options)
+
+			return tx.GenerateOrBroadcastTxCLI(clientctx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// splitList splits a comma separated list, an empty string returns no items
+func splitList(list string) []string {
+	if strings.TrimSpace(list) == "" {
+		return nil
+	}
+	split := strings.Split(list, ",")
+	for i := range split {
+		split[i] = strings.TrimSpace(split[i])
+	}
+	return split
+}
@@ -10,4 +10,5 @@ var (
 	MinDepositAndFeeChangeProposalHandler      = govclient.NewProposalHandler(cli.NewMinDepositAndFeeChangeCmd)
 	PstakeFeeAddressChangeProposalHandler      = govclient.NewProposalHandler(cli.NewPstakeFeeAddressChangeCmd)
 	AllowListValidatorSetChangeProposalHandler = govclient.NewProposalHandler(cli.NewAllowListedValidatorSetChangeProposalCmd)
+	FeeSplitChangeProposalHandler              = govclient.NewProposalHandler(cli.NewFeeSplitChangeProposalCmd)
 )
@@ -134,6 +134,41 @@ func ParseAllowListedValidatorSetChangeProposalJSON(cdc *codec.LegacyAmino, prop
 	return proposal, nil
 }
 
+// FeeSplitChangeProposalJSON defines a FeeSplitChangeProposal JSON input to be parsed
+// from a JSON file. Deposit is used by gov module to change status of proposal.
+type FeeSplitChangeProposalJSON struct {
+	Title       string         `json:"title" yaml:"title"`
+	Description string         `json:"description" yaml:"description"`
+	FeeSplit    types.FeeSplit `json:"fee_split" yaml:"fee_split"`
+	Deposit     string         `json:"deposit" yaml:"deposit"`
+}
+
+// NewFeeSplitChangeProposalJSON returns FeeSplitChangeProposalJSON struct with input values
+func NewFeeSplitChangeProposalJSON(title, description, deposit string, feeSplit types.FeeSplit) FeeSplitChangeProposalJSON {
+	return FeeSplitChangeProposalJSON{
+		Title:       title,
+		Description: description,
+		FeeSplit:    feeSplit,
+		Deposit:     deposit,
+	}
+}
+
+// ParseFeeSplitChangeProposalJSON reads and parses a FeeSplitChangeProposalJSON from
+// file.
+func ParseFeeSplitChangeProposalJSON(cdc *codec.LegacyAmino, proposalFile string) (FeeSplitChangeProposalJSON, error) {
+	proposal := FeeSplitChangeProposalJSON{}
+
+	contents, err := os.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+	if err := cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
+
 // JumpstartTxnJSON defines a Jump start JSON input to be parsed
 // from a JSON file.
 type JumpstartTxnJSON struct {
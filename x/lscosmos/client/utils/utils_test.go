@@ -61,3 +61,18 @@ func TestNewAllowListedValidatorSetChangeProposalJSON(t *testing.T) {
 	require.Equal(t, "1000stake", propJSON.Deposit)
 
 }
+
+func TestNewFeeSplitChangeProposalJSON(t *testing.T) {
+	feeSplit := types.FeeSplit{
+		DepositFeeRecipients: []types.FeeRecipient{{
+			Address: "persistence1pss7nxeh3f9md2vuxku8q99femnwdjtcpe9ky9",
+			Weight:  sdk.OneDec(),
+		}},
+	}
+	propJSON := NewFeeSplitChangeProposalJSON("title", "description", "1000stake", feeSplit)
+
+	require.Equal(t, "title", propJSON.Title)
+	require.Equal(t, "description", propJSON.Description)
+	require.Equal(t, feeSplit, propJSON.FeeSplit)
+	require.Equal(t, "1000stake", propJSON.Deposit)
+}
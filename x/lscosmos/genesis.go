@@ -32,6 +32,51 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 		k.SetDelegatorUnbondingEpochEntry(ctx, delegatorUnbondingEntry)
 	}
 	k.SetHostAccounts(ctx, genState.HostAccounts)
+	for _, delegator := range genState.AutoClaimDelegators {
+		k.SetAutoClaimState(ctx, sdk.MustAccAddressFromBech32(delegator), true)
+	}
+	for _, pendingEpoch := range genState.PendingAutoClaimEpochs {
+		k.SetPendingAutoClaimEpoch(ctx, pendingEpoch.EpochNumber)
+		if pendingEpoch.LastDelegatorAddress != "" {
+			k.SetAutoClaimCursor(ctx, pendingEpoch.EpochNumber, sdk.MustAccAddressFromBech32(pendingEpoch.LastDelegatorAddress))
+		}
+	}
+	for _, addressDeposits := range genState.AddressDeposits {
+		k.SetAddressDeposits(ctx, sdk.MustAccAddressFromBech32(addressDeposits.Address), addressDeposits.Amount)
+	}
+	if !genState.EpochDeposits.Amount.IsNil() {
+		k.SetEpochDeposits(ctx, genState.EpochDeposits)
+	}
+	k.SetFeeSplit(ctx, genState.FeeSplit)
+	for _, collectedFee := range genState.CollectedFees {
+		k.SetCollectedFee(ctx, collectedFee)
+	}
+	k.SetRoles(ctx, genState.Roles)
+	if genState.PendingAdminChange != nil {
+		k.SetPendingAdminChange(ctx, *genState.PendingAdminChange)
+	}
+	k.SetPauseSwitches(ctx, genState.PauseSwitches)
+	if len(genState.EffectiveAllowListedValidators.AllowListedValidators) != 0 {
+		k.SetEffectiveAllowListedValidators(ctx, genState.EffectiveAllowListedValidators)
+	}
+	for _, metrics := range genState.ValidatorMetrics {
+		k.SetValidatorMetrics(ctx, metrics)
+	}
+	if genState.CarriedOverRewards.Denom != "" {
+		k.SetCarriedOverRewards(ctx, genState.CarriedOverRewards)
+	}
+	for _, record := range genState.RewardEpochRecords {
+		k.SetRewardEpochRecord(ctx, record)
+	}
+	if genState.PendingRewardEpochRecord != nil {
+		k.SetPendingRewardEpochRecord(ctx, *genState.PendingRewardEpochRecord)
+	}
+	for _, hostProposal := range genState.HostProposals {
+		k.SetHostProposal(ctx, hostProposal)
+	}
+	for _, vote := range genState.HostProposalVotes {
+		k.SetHostProposalVote(ctx, vote)
+	}
 
 	k.GetDepositModuleAccount(ctx)
 	k.GetDelegationModuleAccount(ctx)
@@ -54,6 +99,24 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	genesis.UnbondingEpochCValues = k.IterateAllUnbondingEpochCValues(ctx)
 	genesis.DelegatorUnbondingEpochEntries = k.IterateAllDelegatorUnbondingEpochEntry(ctx)
 	genesis.HostAccounts = k.GetHostAccounts(ctx)
+	genesis.AutoClaimDelegators = k.IterateAllAutoClaimDelegators(ctx)
+	genesis.PendingAutoClaimEpochs = k.IterateAllPendingAutoClaimEpochs(ctx)
+	genesis.AddressDeposits = k.IterateAllAddressDeposits(ctx)
+	genesis.EpochDeposits = k.GetEpochDeposits(ctx)
+	genesis.FeeSplit = k.GetFeeSplit(ctx)
+	genesis.CollectedFees = k.IterateCollectedFees(ctx, "")
+	genesis.Roles = k.GetRoles(ctx)
+	genesis.PendingAdminChange = k.GetPendingAdminChange(ctx)
+	genesis.PauseSwitches = k.GetPauseSwitches(ctx)
+	if effective, found := k.GetComputedEffectiveAllowListedValidators(ctx); found {
+		genesis.EffectiveAllowListedValidators = effective
+	}
+	genesis.ValidatorMetrics = k.GetAllValidatorMetrics(ctx)
+	genesis.CarriedOverRewards = k.GetCarriedOverRewards(ctx)
+	genesis.RewardEpochRecords = k.GetAllRewardEpochRecords(ctx)
+	genesis.PendingRewardEpochRecord = k.GetPendingRewardEpochRecord(ctx)
+	genesis.HostProposals = k.GetAllHostProposals(ctx)
+	genesis.HostProposalVotes = k.GetAllHostProposalVotes(ctx)
 
 	// this line is used by starport scaffolding # genesis/module/export
 
@@ -43,6 +43,27 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 		case *types.MsgReportSlashing:
 			res, err := msgServer.ReportSlashing(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgTransferUnbondingEntry:
+			res, err := msgServer.TransferUnbondingEntry(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgClaimFor:
+			res, err := msgServer.ClaimFor(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgSetAutoClaim:
+			res, err := msgServer.SetAutoClaim(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgUpdateRoles:
+			res, err := msgServer.UpdateRoles(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgSetPauseSwitches:
+			res, err := msgServer.SetPauseSwitches(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgRegisterHostProposal:
+			res, err := msgServer.RegisterHostProposal(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgVoteHostProposal:
+			res, err := msgServer.VoteHostProposal(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
 		default:
 			errMsg := fmt.Sprintf("unrecognized %s message type: %T", types.ModuleName, msg)
 			return nil, errorsmod.Wrap(sdkerrors.ErrUnknownRequest, errMsg)
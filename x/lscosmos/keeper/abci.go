@@ -15,26 +15,44 @@ import (
 // BeginBlock will use utils.ApplyFuncIfNoError to apply the changes made by the functions
 // passed as parameters
 func (k Keeper) BeginBlock(ctx sdk.Context) {
+	// admin changes are applied even if the module is disabled
+	k.ProcessPendingAdminChange(ctx)
+
 	if !k.GetModuleState(ctx) {
 		return
 	}
 
-	err := utils.ApplyFuncIfNoError(ctx, k.DoDelegate)
-	if err != nil {
-		k.Logger(ctx).Error("Unable to Delegate tokens with ", "err: ", err)
+	if !k.IsPaused(ctx, lscosmostypes.PauseSwitchDelegationEpoch) {
+		err := utils.ApplyFuncIfNoError(ctx, k.DoDelegate)
+		if err != nil {
+			k.Logger(ctx).Error("Unable to Delegate tokens with ", "err: ", err)
+		}
 	}
-	err = utils.ApplyFuncIfNoError(ctx, k.ProcessMaturedUndelegation)
-	if err != nil {
-		k.Logger(ctx).Error("Unable to process matured undelegations with ", "err: ", err)
+	if !k.IsPaused(ctx, lscosmostypes.PauseSwitchUndelegationEpoch) {
+		err := utils.ApplyFuncIfNoError(ctx, k.ProcessMaturedUndelegation)
+		if err != nil {
+			k.Logger(ctx).Error("Unable to process matured undelegations with ", "err: ", err)
+		}
+	}
+	if !k.IsPaused(ctx, lscosmostypes.PauseSwitchClaims) {
+		err := utils.ApplyFuncIfNoError(ctx, k.ProcessAutoClaims)
+		if err != nil {
+			k.Logger(ctx).Error("Unable to process auto claims with ", "err: ", err)
+		}
+	}
+	if !k.IsPaused(ctx, lscosmostypes.PauseSwitchHostVotes) {
+		err := utils.ApplyFuncIfNoError(ctx, k.ProcessHostProposals)
+		if err != nil {
+			k.Logger(ctx).Error("Unable to process host proposals with ", "err: ", err)
+		}
 	}
-
 }
 
 // DoDelegate generates and executes ICA transactions based on the generated delegation state
 // from DelegateMsgs
 func (k Keeper) DoDelegate(ctx sdk.Context) error {
 	hostChainParams := k.GetHostChainParams(ctx)
-	delegationState := k.GetDelegationState(ctx)
+	delegationState := k.getDelegationAccountState(ctx)
 	delegatableAmount := delegationState.HostDelegationAccountBalance.AmountOf(hostChainParams.BaseDenom)
 
 	allowListedValidators := k.GetAllowListedValidators(ctx)
@@ -75,7 +93,7 @@ func (k Keeper) ProcessMaturedUndelegation(ctx sdk.Context) error {
 	}
 
 	hostChainParams := k.GetHostChainParams(ctx)
-	delegationState := k.GetDelegationState(ctx)
+	delegationState := k.getDelegationAccountState(ctx)
 	hostAccounts := k.GetHostAccounts(ctx)
 
 	for _, maturedUndelegation := range maturedUndelegations {
@@ -0,0 +1,152 @@
+package keeper
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+
+	"github.com/persistenceOne/pstake-native/v2/x/lscosmos/types"
+)
+
+// SetAutoClaimState opts the delegator address in or out of auto claims
+func (k Keeper) SetAutoClaimState(ctx sdk.Context, delegatorAddress sdk.AccAddress, enabled bool) {
+	store := ctx.KVStore(k.storeKey)
+	if enabled {
+		store.Set(types.GetAutoClaimKey(delegatorAddress), []byte{0x01})
+		return
+	}
+	store.Delete(types.GetAutoClaimKey(delegatorAddress))
+}
+
+// GetAutoClaimState returns true if the delegator address has opted in to auto claims
+func (k Keeper) GetAutoClaimState(ctx sdk.Context, delegatorAddress sdk.AccAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GetAutoClaimKey(delegatorAddress))
+}
+
+// IterateAllAutoClaimDelegators returns a list of all the delegator addresses opted in to auto claims
+func (k Keeper) IterateAllAutoClaimDelegators(ctx sdk.Context) []string {
+	store := ctx.KVStore(k.storeKey)
+	var delegators []string
+	iterator := sdk.KVStorePrefixIterator(store, types.AutoClaimKey)
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		// strip the key prefix and the address length prefix
+		delegators = append(delegators, sdk.AccAddress(iterator.Key()[len(types.AutoClaimKey)+1:]).String())
+	}
+
+	return delegators
+}
+
+// SetPendingAutoClaimEpoch queues the epoch number for auto claims, processing starts from the
+// first delegator entry of the epoch
+func (k Keeper) SetPendingAutoClaimEpoch(ctx sdk.Context, epochNumber int64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetPendingAutoClaimEpochKey(epochNumber), []byte{})
+}
+
+// SetAutoClaimCursor stores the last delegator processed for the epoch queued for auto claims, the next
+// batch resumes after it
+func (k Keeper) SetAutoClaimCursor(ctx sdk.Context, epochNumber int64, lastDelegatorAddress sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetPendingAutoClaimEpochKey(epochNumber), address.MustLengthPrefix(lastDelegatorAddress))
+}
+
+// RemovePendingAutoClaimEpoch removes the epoch number from the auto claim queue
+func (k Keeper) RemovePendingAutoClaimEpoch(ctx sdk.Context, epochNumber int64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetPendingAutoClaimEpochKey(epochNumber))
+}
+
+// IterateAllPendingAutoClaimEpochs returns a list of all the epochs queued for auto claims with their cursors
+func (k Keeper) IterateAllPendingAutoClaimEpochs(ctx sdk.Context) []types.PendingAutoClaimEpoch {
+	store := ctx.KVStore(k.storeKey)
+	var pendingEpochs []types.PendingAutoClaimEpoch
+	iterator := sdk.KVStorePrefixIterator(store, types.PendingAutoClaimEpochKey)
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		pendingEpoch := types.PendingAutoClaimEpoch{
+			EpochNumber: types.ParseEpochNumberBytes(iterator.Key()[len(types.PendingAutoClaimEpochKey):]),
+		}
+		if cursor := iterator.Value(); len(cursor) > 0 {
+			// strip the address length prefix
+			pendingEpoch.LastDelegatorAddress = sdk.AccAddress(cursor[1:]).String()
+		}
+		pendingEpochs = append(pendingEpochs, pendingEpoch)
+	}
+
+	return pendingEpochs
+}
+
+// ProcessAutoClaims pays out the unbonding entries of delegators opted in to auto claims for the
+// first epoch in the auto claim queue. The delegators with an entry for the epoch are iterated, at most
+// types.AutoClaimBatchSize of them per call, the last processed delegator is stored against the epoch so
+// the next call resumes from there. The epoch is removed from the queue once all its entries have been processed.
+func (k Keeper) ProcessAutoClaims(ctx sdk.Context) error {
+	store := ctx.KVStore(k.storeKey)
+
+	pendingIterator := sdk.KVStorePrefixIterator(store, types.PendingAutoClaimEpochKey)
+	if !pendingIterator.Valid() {
+		pendingIterator.Close()
+		return nil
+	}
+	epochNumber := types.ParseEpochNumberBytes(pendingIterator.Key()[len(types.PendingAutoClaimEpochKey):])
+	cursor := pendingIterator.Value()
+	pendingIterator.Close()
+
+	// collect one delegator more than the batch size to know if the epoch needs another pass
+	var start []byte
+	if len(cursor) > 0 {
+		start = append(append([]byte{}, cursor...), 0x00)
+	}
+	var delegatorKeys [][]byte
+	epochDelegatorIterator := prefix.NewStore(store, types.GetUnbondingEpochDelegatorsKey(epochNumber)).Iterator(start, nil)
+	for ; epochDelegatorIterator.Valid() && len(delegatorKeys) <= types.AutoClaimBatchSize; epochDelegatorIterator.Next() {
+		delegatorKeys = append(delegatorKeys, epochDelegatorIterator.Key())
+	}
+	epochDelegatorIterator.Close()
+
+	if len(delegatorKeys) <= types.AutoClaimBatchSize {
+		k.RemovePendingAutoClaimEpoch(ctx, epochNumber)
+	} else {
+		delegatorKeys = delegatorKeys[:types.AutoClaimBatchSize]
+		store.Set(types.GetPendingAutoClaimEpochKey(epochNumber), delegatorKeys[len(delegatorKeys)-1])
+	}
+
+	for _, delegatorKey := range delegatorKeys {
+		// strip the address length prefix
+		delegatorAddress := sdk.AccAddress(delegatorKey[1:])
+		if !k.GetAutoClaimState(ctx, delegatorAddress) {
+			continue
+		}
+		unbondingEntry := k.GetDelegatorUnbondingEpochEntry(ctx, delegatorAddress, epochNumber)
+		if unbondingEntry.DelegatorAddress == "" {
+			continue
+		}
+
+		// a failing claim should not block the auto claims of other delegators, the delegator can still claim manually
+		cacheCtx, write := ctx.CacheContext()
+		if err := k.ClaimDelegatorUnbondingEpochEntry(cacheCtx, delegatorAddress, unbondingEntry); err != nil {
+			k.Logger(ctx).Error(fmt.Sprintf("failed to auto claim epoch %d for %s", epochNumber, delegatorAddress.String()), "err: ", err)
+			continue
+		}
+		write()
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeAutoClaim,
+				sdk.NewAttribute(types.AttributeDelegatorAddress, delegatorAddress.String()),
+				sdk.NewAttribute(types.AttributeEpochNumber, strconv.FormatInt(epochNumber, 10)),
+			),
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,101 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
+
+	"github.com/persistenceOne/pstake-native/v2/x/lscosmos/types"
+)
+
+func (suite *IntegrationTestSuite) TestProcessAutoClaims() {
+	app, ctx := suite.app, suite.ctx
+	keeper := app.LSCosmosKeeper
+
+	addr1 := sdk.AccAddress("addr1_______________")
+	addr2 := sdk.AccAddress("addr2_______________")
+	addr3 := sdk.AccAddress("addr3_______________")
+
+	ibcDenom := keeper.GetIBCDenom(ctx)
+	keeper.GetUndelegationModuleAccount(ctx)
+	suite.Require().NoError(testutil.FundModuleAccount(app.BankKeeper, ctx, types.UndelegationModuleAccount, sdk.NewCoins(sdk.NewInt64Coin(ibcDenom, 3000))))
+
+	for _, addr := range []sdk.AccAddress{addr1, addr2, addr3} {
+		keeper.AddDelegatorUnbondingEpochEntry(ctx, addr, 4, sdk.NewInt64Coin(MintDenom, 1000))
+	}
+	keeper.SetAutoClaimState(ctx, addr1, true)
+	keeper.SetAutoClaimState(ctx, addr2, true)
+	keeper.SetAutoClaimState(ctx, addr3, true)
+	keeper.SetAutoClaimState(ctx, addr3, false)
+	suite.Equal(2, len(keeper.IterateAllAutoClaimDelegators(ctx)))
+	suite.False(keeper.GetAutoClaimState(ctx, addr3))
+
+	keeper.SetUnbondingEpochCValue(ctx, types.UnbondingEpochCValue{
+		EpochNumber:    4,
+		STKBurn:        sdk.NewInt64Coin(MintDenom, 3000),
+		AmountUnbonded: sdk.NewInt64Coin(BaseDenom, 3000),
+	})
+
+	// nothing happens before the epoch matures
+	suite.NoError(keeper.ProcessAutoClaims(ctx))
+	suite.Equal(sdk.NewInt64Coin(MintDenom, 1000), keeper.GetDelegatorUnbondingEpochEntry(ctx, addr1, 4).Amount)
+
+	keeper.MatureUnbondingEpochCValue(ctx, 4)
+	suite.Equal([]types.PendingAutoClaimEpoch{{EpochNumber: 4}}, keeper.IterateAllPendingAutoClaimEpochs(ctx))
+
+	suite.NoError(keeper.ProcessAutoClaims(ctx))
+	suite.Equal(0, len(keeper.IterateAllPendingAutoClaimEpochs(ctx)))
+
+	// opted in delegators are paid out, the others keep their entry
+	suite.Equal(sdk.NewInt64Coin(ibcDenom, 1000), app.BankKeeper.GetBalance(ctx, addr1, ibcDenom))
+	suite.Equal(sdk.NewInt64Coin(ibcDenom, 1000), app.BankKeeper.GetBalance(ctx, addr2, ibcDenom))
+	suite.Equal(0, len(keeper.IterateDelegatorUnbondingEpochEntry(ctx, addr1)))
+	suite.Equal(0, len(keeper.IterateDelegatorUnbondingEpochEntry(ctx, addr2)))
+	suite.Equal(sdk.NewInt64Coin(MintDenom, 1000), keeper.GetDelegatorUnbondingEpochEntry(ctx, addr3, 4).Amount)
+
+	// anyone can claim on behalf of the remaining delegator
+	suite.NoError(keeper.ClaimDelegatorUnbondingEpochEntries(ctx, addr3))
+	suite.Equal(sdk.NewInt64Coin(ibcDenom, 1000), app.BankKeeper.GetBalance(ctx, addr3, ibcDenom))
+}
+
+func (suite *IntegrationTestSuite) TestProcessAutoClaimsBatches() {
+	app, ctx := suite.app, suite.ctx
+	keeper := app.LSCosmosKeeper
+
+	ibcDenom := keeper.GetIBCDenom(ctx)
+	keeper.GetUndelegationModuleAccount(ctx)
+
+	delegatorCount := types.AutoClaimBatchSize + 5
+	suite.Require().NoError(testutil.FundModuleAccount(app.BankKeeper, ctx, types.UndelegationModuleAccount, sdk.NewCoins(sdk.NewInt64Coin(ibcDenom, int64(delegatorCount)*10))))
+	for i := 0; i < delegatorCount; i++ {
+		addr := sdk.AccAddress(append([]byte{byte(i)}, make([]byte, 19)...))
+		keeper.AddDelegatorUnbondingEpochEntry(ctx, addr, 4, sdk.NewInt64Coin(MintDenom, 10))
+		keeper.SetAutoClaimState(ctx, addr, true)
+	}
+	// only the delegators with an entry for the epoch are iterated
+	otherAddr := sdk.AccAddress("other_______________")
+	keeper.AddDelegatorUnbondingEpochEntry(ctx, otherAddr, 8, sdk.NewInt64Coin(MintDenom, 10))
+	keeper.SetAutoClaimState(ctx, otherAddr, true)
+	keeper.SetUnbondingEpochCValue(ctx, types.UnbondingEpochCValue{
+		EpochNumber:    4,
+		STKBurn:        sdk.NewInt64Coin(MintDenom, int64(delegatorCount)*10),
+		AmountUnbonded: sdk.NewInt64Coin(BaseDenom, int64(delegatorCount)*10),
+	})
+	keeper.MatureUnbondingEpochCValue(ctx, 4)
+
+	// first pass only processes a batch and keeps the epoch queued with the last processed delegator
+	suite.NoError(keeper.ProcessAutoClaims(ctx))
+	lastAddr := sdk.AccAddress(append([]byte{byte(types.AutoClaimBatchSize - 1)}, make([]byte, 19)...))
+	pendingEpochs := keeper.IterateAllPendingAutoClaimEpochs(ctx)
+	suite.Equal([]types.PendingAutoClaimEpoch{{EpochNumber: 4, LastDelegatorAddress: lastAddr.String()}}, pendingEpochs)
+	suite.Equal(6, len(keeper.IterateAllDelegatorUnbondingEpochEntry(ctx)))
+
+	// the cursor survives a genesis export and import
+	keeper.RemovePendingAutoClaimEpoch(ctx, 4)
+	keeper.SetPendingAutoClaimEpoch(ctx, pendingEpochs[0].EpochNumber)
+	keeper.SetAutoClaimCursor(ctx, pendingEpochs[0].EpochNumber, sdk.MustAccAddressFromBech32(pendingEpochs[0].LastDelegatorAddress))
+
+	// second pass resumes from the cursor and clears the queue
+	suite.NoError(keeper.ProcessAutoClaims(ctx))
+	suite.Equal(0, len(keeper.IterateAllPendingAutoClaimEpochs(ctx)))
+	suite.Equal([]types.DelegatorUnbondingEpochEntry{keeper.GetDelegatorUnbondingEpochEntry(ctx, otherAddr, 8)}, keeper.IterateAllDelegatorUnbondingEpochEntry(ctx))
+}
@@ -47,7 +47,7 @@ func (k Keeper) GetDelegationTransientAmount(ctx sdk.Context) math.Int {
 // GetStakedAmount returns the total staked amount stored in delegation state
 func (k Keeper) GetStakedAmount(ctx sdk.Context) math.Int {
 	sum := sdk.ZeroInt()
-	for _, delegation := range k.GetDelegationState(ctx).HostAccountDelegations {
+	for _, delegation := range k.GetAllHostAccountDelegations(ctx) {
 		sum = sum.Add(delegation.Amount.Amount)
 	}
 	return sum
@@ -55,17 +55,23 @@ func (k Keeper) GetStakedAmount(ctx sdk.Context) math.Int {
 
 // GetHostDelegationAccountAmount returns the host account delegation account amount of IBC denom
 func (k Keeper) GetHostDelegationAccountAmount(ctx sdk.Context) math.Int {
-	return k.GetDelegationState(ctx).HostDelegationAccountBalance.AmountOf(k.GetHostChainParams(ctx).BaseDenom)
+	return k.getDelegationAccountState(ctx).HostDelegationAccountBalance.AmountOf(k.GetHostChainParams(ctx).BaseDenom)
 }
 
-// GetCValue gets the C value after recalculating everytime when the
-// function is called. Returns 1 if stakedAmount or mintedAmount is zero.
-func (k Keeper) GetCValue(ctx sdk.Context) sdk.Dec {
-	stakedAmount := k.GetDepositAccountAmount(ctx).
+// GetTotalValueLocked returns the total amount of tokens backing the minted stk tokens, this includes
+// deposited, in transit, delegated and not yet delegated tokens.
+func (k Keeper) GetTotalValueLocked(ctx sdk.Context) math.Int {
+	return k.GetDepositAccountAmount(ctx).
 		Add(k.GetIBCTransferTransientAmount(ctx)).
 		Add(k.GetDelegationTransientAmount(ctx)).
 		Add(k.GetStakedAmount(ctx)).
 		Add(k.GetHostDelegationAccountAmount(ctx))
+}
+
+// GetCValue gets the C value after recalculating everytime when the
+// function is called. Returns 1 if stakedAmount or mintedAmount is zero.
+func (k Keeper) GetCValue(ctx sdk.Context) sdk.Dec {
+	stakedAmount := k.GetTotalValueLocked(ctx)
 
 	mintedAmount := k.GetMintedAmount(ctx)
 	if stakedAmount.IsZero() || mintedAmount.IsZero() {
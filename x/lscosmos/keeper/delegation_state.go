@@ -3,20 +3,55 @@ package keeper
 import (
 	"time"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	icatypes "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/types"
 
 	"github.com/persistenceOne/pstake-native/v2/x/lscosmos/types"
 )
 
-// SetDelegationState sets the delegation state in store
+// SetDelegationState replaces the delegation state in store. The host account delegations and undelegations
+// of the input are stored per validator and per epoch, the existing ones are removed.
 func (k Keeper) SetDelegationState(ctx sdk.Context, delegationState types.DelegationState) {
+	for _, delegation := range k.GetAllHostAccountDelegations(ctx) {
+		k.RemoveHostAccountDelegation(ctx, delegation.ValidatorAddress)
+	}
+	for _, undelegation := range k.GetAllHostAccountUndelegations(ctx) {
+		ctx.KVStore(k.storeKey).Delete(types.GetHostAccountUndelegationKey(undelegation.EpochNumber))
+	}
+
+	for _, delegation := range delegationState.HostAccountDelegations {
+		k.SetHostAccountDelegation(ctx, delegation)
+	}
+	for _, undelegation := range delegationState.HostAccountUndelegations {
+		k.SetHostAccountUndelegation(ctx, undelegation)
+	}
+	k.setDelegationAccountState(ctx, delegationState)
+}
+
+// GetDelegationState gets the delegation state in store, including all the host account delegations and
+// undelegations. Use GetHostChainDelegationAddress, GetHostAccountDelegation or GetHostAccountUndelegationForEpoch
+// where a single field is needed.
+func (k Keeper) GetDelegationState(ctx sdk.Context) types.DelegationState {
+	delegationState := k.getDelegationAccountState(ctx)
+	delegationState.HostAccountDelegations = k.GetAllHostAccountDelegations(ctx)
+	delegationState.HostAccountUndelegations = k.GetAllHostAccountUndelegations(ctx)
+
+	return delegationState
+}
+
+// setDelegationAccountState sets the host delegation account balance and address of the delegation state in
+// store, the host account delegations and undelegations are not part of it
+func (k Keeper) setDelegationAccountState(ctx sdk.Context, delegationState types.DelegationState) {
 	store := ctx.KVStore(k.storeKey)
+	delegationState.HostAccountDelegations = nil
+	delegationState.HostAccountUndelegations = nil
 	store.Set(types.DelegationStateKey, k.cdc.MustMarshal(&delegationState))
 }
 
-// GetDelegationState gets the delegation state in store
-func (k Keeper) GetDelegationState(ctx sdk.Context) types.DelegationState {
+// getDelegationAccountState gets the host delegation account balance and address of the delegation state in
+// store, the host account delegations and undelegations are left empty
+func (k Keeper) getDelegationAccountState(ctx sdk.Context) types.DelegationState {
 	store := ctx.KVStore(k.storeKey)
 
 	var delegationState types.DelegationState
@@ -25,196 +60,209 @@ func (k Keeper) GetDelegationState(ctx sdk.Context) types.DelegationState {
 	return delegationState
 }
 
+// GetHostChainDelegationAddress gets the host chain delegator address of types.DelegationState
+func (k Keeper) GetHostChainDelegationAddress(ctx sdk.Context) string {
+	return k.getDelegationAccountState(ctx).HostChainDelegationAddress
+}
+
 // AddBalanceToDelegationState adds balance in the HostDelegationAccountBalance of types.DelegationState
 func (k Keeper) AddBalanceToDelegationState(ctx sdk.Context, coin sdk.Coin) {
-	delegationState := k.GetDelegationState(ctx)
+	delegationState := k.getDelegationAccountState(ctx)
 	delegationState.HostDelegationAccountBalance = delegationState.HostDelegationAccountBalance.Add(coin)
-	k.SetDelegationState(ctx, delegationState)
+	k.setDelegationAccountState(ctx, delegationState)
 }
 
 // RemoveBalanceFromDelegationState subtracts balance in the HostDelegationAccountBalance
 // of types.DelegationState
 func (k Keeper) RemoveBalanceFromDelegationState(ctx sdk.Context, coins sdk.Coins) {
-	delegationState := k.GetDelegationState(ctx)
+	delegationState := k.getDelegationAccountState(ctx)
 	delegationState.HostDelegationAccountBalance = delegationState.HostDelegationAccountBalance.Sub(coins...)
-	k.SetDelegationState(ctx, delegationState)
+	k.setDelegationAccountState(ctx, delegationState)
 }
 
 // SetHostChainDelegationAddress sets the host chain delegator address in types.DelegationState
 func (k Keeper) SetHostChainDelegationAddress(ctx sdk.Context, addr string) error {
-	delegationState := k.GetDelegationState(ctx)
+	delegationState := k.getDelegationAccountState(ctx)
 	if delegationState.HostChainDelegationAddress != "" {
 		return icatypes.ErrInterchainAccountAlreadySet
 	}
 	delegationState.HostChainDelegationAddress = addr
-	k.SetDelegationState(ctx, delegationState)
+	k.setDelegationAccountState(ctx, delegationState)
 	return nil
 }
 
+// SetHostAccountDelegation sets the host account delegation of a validator
+func (k Keeper) SetHostAccountDelegation(ctx sdk.Context, delegation types.HostAccountDelegation) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetHostAccountDelegationKey(delegation.ValidatorAddress), k.cdc.MustMarshal(&delegation))
+}
+
 // GetHostAccountDelegation gets the delegation for a particular validator
 func (k Keeper) GetHostAccountDelegation(ctx sdk.Context, validatorAddress string) types.HostAccountDelegation {
-	delegationState := k.GetDelegationState(ctx)
-	for _, existingDelegation := range delegationState.HostAccountDelegations {
-		if existingDelegation.ValidatorAddress == validatorAddress {
-			return existingDelegation
-		}
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetHostAccountDelegationKey(validatorAddress))
+	if bz == nil {
+		return types.HostAccountDelegation{}
+	}
+
+	var delegation types.HostAccountDelegation
+	k.cdc.MustUnmarshal(bz, &delegation)
+
+	return delegation
+}
+
+// RemoveHostAccountDelegation removes the host account delegation of a validator
+func (k Keeper) RemoveHostAccountDelegation(ctx sdk.Context, validatorAddress string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetHostAccountDelegationKey(validatorAddress))
+}
+
+// GetAllHostAccountDelegations returns the host account delegations of all the validators, ordered by
+// validator address
+func (k Keeper) GetAllHostAccountDelegations(ctx sdk.Context) []types.HostAccountDelegation {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.HostAccountDelegationKey)
+	var delegations []types.HostAccountDelegation
+	iterator := store.Iterator(nil, nil)
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var delegation types.HostAccountDelegation
+		k.cdc.MustUnmarshal(iterator.Value(), &delegation)
+
+		delegations = append(delegations, delegation)
 	}
-	return types.HostAccountDelegation{}
+
+	return delegations
 }
 
-// AddHostAccountDelegation append the host account delegations in types.DelegationState provided
-// in the input
+// AddHostAccountDelegation adds the input delegation amount to the host account delegation of
+// its validator
 func (k Keeper) AddHostAccountDelegation(ctx sdk.Context, delegation types.HostAccountDelegation) {
-	delegationState := k.GetDelegationState(ctx)
-	delegationState = appendHostAccountDelegation(delegationState, delegation)
-	k.SetDelegationState(ctx, delegationState)
+	existingDelegation := k.GetHostAccountDelegation(ctx, delegation.ValidatorAddress)
+	if existingDelegation.ValidatorAddress != "" {
+		delegation.Amount = existingDelegation.Amount.Add(delegation.Amount)
+	}
+	k.SetHostAccountDelegation(ctx, delegation)
 }
 
-// SubtractHostAccountDelegation calls the removeHostAccountDelegation function to
-// subtract host account delegations in types.DelegationState
+// SubtractHostAccountDelegation subtracts the input delegation amount from the host account delegation
+// of its validator
 func (k Keeper) SubtractHostAccountDelegation(ctx sdk.Context, delegation types.HostAccountDelegation) error {
-	delegationState := k.GetDelegationState(ctx)
-	delegationState, err := removeHostAccountDelegation(delegationState, delegation)
-	if err != nil {
-		return err
+	existingDelegation := k.GetHostAccountDelegation(ctx, delegation.ValidatorAddress)
+	if existingDelegation.ValidatorAddress == "" {
+		return types.ErrCannotRemoveNonExistentDelegation
 	}
-	k.SetDelegationState(ctx, delegationState)
+	existingDelegation.Amount = existingDelegation.Amount.Sub(delegation.Amount) //This will panic if coin goes negative
+	k.SetHostAccountDelegation(ctx, existingDelegation)
 	return nil
 }
 
 // ForceUpdateHostAccountDelegation updates the delegation-state for a validator.
 func (k Keeper) ForceUpdateHostAccountDelegation(ctx sdk.Context, delegation types.HostAccountDelegation) {
-	delegationState := k.GetDelegationState(ctx)
-	for i, existingDelegation := range delegationState.HostAccountDelegations {
-		if existingDelegation.ValidatorAddress == delegation.ValidatorAddress {
-			delegationState.HostAccountDelegations[i].Amount = delegation.Amount
-			break
-		}
+	if k.GetHostAccountDelegation(ctx, delegation.ValidatorAddress).ValidatorAddress == "" {
+		return
 	}
-	k.SetDelegationState(ctx, delegationState)
+	k.SetHostAccountDelegation(ctx, delegation)
 }
 
-// appendHostAccountDelegation is a helper function to append the input delegation to the
-// input delegationState
-func appendHostAccountDelegation(delegationState types.DelegationState, delegation types.HostAccountDelegation) types.DelegationState {
-	// optimise this // do we want to have it sorted?
-	for i, existingDelegation := range delegationState.HostAccountDelegations {
-		if existingDelegation.ValidatorAddress == delegation.ValidatorAddress {
-			delegationState.HostAccountDelegations[i].Amount = existingDelegation.Amount.Add(delegation.Amount)
-			return delegationState
-		}
-	}
-
-	delegationState.HostAccountDelegations = append(delegationState.HostAccountDelegations, delegation)
-	return delegationState
+// SetHostAccountUndelegation sets the host account undelegation of an epoch
+func (k Keeper) SetHostAccountUndelegation(ctx sdk.Context, undelegation types.HostAccountUndelegation) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetHostAccountUndelegationKey(undelegation.EpochNumber), k.cdc.MustMarshal(&undelegation))
 }
 
-// removeHostAccountDelegation is a helper function to remove the input delegation from the input
-// delegationState
-func removeHostAccountDelegation(delegationState types.DelegationState, delegation types.HostAccountDelegation) (types.DelegationState, error) {
-	// optimise this // do we want to have it sorted?
-	for i, existingDelegation := range delegationState.HostAccountDelegations {
-		if existingDelegation.ValidatorAddress == delegation.ValidatorAddress {
-			delegationState.HostAccountDelegations[i].Amount = existingDelegation.Amount.Sub(delegation.Amount) //This will panic if coin goes negative
-			return delegationState, nil
-		}
+// GetAllHostAccountUndelegations returns the host account undelegations of all the epochs, in epoch order
+func (k Keeper) GetAllHostAccountUndelegations(ctx sdk.Context) []types.HostAccountUndelegation {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.HostAccountUndelegationKey)
+	var undelegations []types.HostAccountUndelegation
+	iterator := store.Iterator(nil, nil)
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var undelegation types.HostAccountUndelegation
+		k.cdc.MustUnmarshal(iterator.Value(), &undelegation)
+
+		undelegations = append(undelegations, undelegation)
 	}
-	return types.DelegationState{}, types.ErrCannotRemoveNonExistentDelegation
+
+	return undelegations
 }
 
-// AddHostAccountUndelegation appends the input undelegationEntry in types.DelegationState
+// AddHostAccountUndelegation sets the input undelegationEntry for its epoch
 func (k Keeper) AddHostAccountUndelegation(ctx sdk.Context, undelegationEntry types.HostAccountUndelegation) {
-	delegationState := k.GetDelegationState(ctx)
-	delegationState.HostAccountUndelegations = append(delegationState.HostAccountUndelegations, undelegationEntry)
-	k.SetDelegationState(ctx, delegationState)
+	k.SetHostAccountUndelegation(ctx, undelegationEntry)
 }
 
 // AddTotalUndelegationForEpoch adds the total undelegations corresponding to the input epoch number in
 // types.DelegationState
 func (k Keeper) AddTotalUndelegationForEpoch(ctx sdk.Context, epochNumber int64, amount sdk.Coin) {
-	delegationState := k.GetDelegationState(ctx)
-	found := false
-	for i, undelegation := range delegationState.HostAccountUndelegations {
-		if undelegation.EpochNumber == epochNumber && !found {
-			delegationState.HostAccountUndelegations[i].TotalUndelegationAmount =
-				delegationState.HostAccountUndelegations[i].TotalUndelegationAmount.Add(amount)
-			found = true
+	undelegation, err := k.GetHostAccountUndelegationForEpoch(ctx, epochNumber)
+	if err != nil {
+		undelegation = types.HostAccountUndelegation{
+			EpochNumber:             epochNumber,
+			TotalUndelegationAmount: amount,
+			CompletionTime:          time.Time{},
+			UndelegationEntries:     []types.UndelegationEntry{},
 		}
+	} else {
+		undelegation.TotalUndelegationAmount = undelegation.TotalUndelegationAmount.Add(amount)
 	}
-	if !found {
-		delegationState.HostAccountUndelegations = append(
-			delegationState.HostAccountUndelegations,
-			types.HostAccountUndelegation{
-				EpochNumber:             epochNumber,
-				TotalUndelegationAmount: amount,
-				CompletionTime:          time.Time{},
-				UndelegationEntries:     []types.UndelegationEntry{},
-			})
-	}
-	k.SetDelegationState(ctx, delegationState)
+	k.SetHostAccountUndelegation(ctx, undelegation)
 }
 
 // AddEntriesForUndelegationEpoch adds the input entries corresponding to the input epochNumber
 // in types.DelegationState
 func (k Keeper) AddEntriesForUndelegationEpoch(ctx sdk.Context, epochNumber int64, entries []types.UndelegationEntry) {
-	delegationState := k.GetDelegationState(ctx)
-	found := false
-	for i, undelegation := range delegationState.HostAccountUndelegations {
-		if undelegation.EpochNumber == epochNumber && !found {
-			delegationState.HostAccountUndelegations[i].UndelegationEntries =
-				append(delegationState.HostAccountUndelegations[i].UndelegationEntries, entries...)
-			found = true
-		}
-	}
-	if !found {
+	undelegation, err := k.GetHostAccountUndelegationForEpoch(ctx, epochNumber)
+	if err != nil {
 		panic("Adding Unbonding entries for non existing epoch")
 	}
-	k.SetDelegationState(ctx, delegationState)
+	undelegation.UndelegationEntries = append(undelegation.UndelegationEntries, entries...)
+	k.SetHostAccountUndelegation(ctx, undelegation)
 }
 
 // UpdateCompletionTimeForUndelegationEpoch updates the completion time for undelegation epoch
 // corresponding to the input epoch number in types.DelegationState
 func (k Keeper) UpdateCompletionTimeForUndelegationEpoch(ctx sdk.Context, epochNumber int64, completionTime time.Time) {
-	delegationState := k.GetDelegationState(ctx)
-	found := false
-	for i, undelegation := range delegationState.HostAccountUndelegations {
-		if undelegation.EpochNumber == epochNumber && !found {
-			delegationState.HostAccountUndelegations[i].CompletionTime = completionTime
-			found = true
-		}
+	undelegation, err := k.GetHostAccountUndelegationForEpoch(ctx, epochNumber)
+	if err != nil {
+		return
 	}
-
-	k.SetDelegationState(ctx, delegationState)
+	undelegation.CompletionTime = completionTime
+	k.SetHostAccountUndelegation(ctx, undelegation)
 }
 
-// RemoveHostAccountUndelegation removes the completion time for undelegation epoch
-// corresponding to the input epoch number in types.DelegationState
+// RemoveHostAccountUndelegation removes the undelegation epoch corresponding to the input epoch number
+// in types.DelegationState
 func (k Keeper) RemoveHostAccountUndelegation(ctx sdk.Context, epochNumber int64) error {
-	delegationState := k.GetDelegationState(ctx)
-	for i, undelegation := range delegationState.HostAccountUndelegations {
-		if undelegation.EpochNumber == epochNumber {
-			delegationState.HostAccountUndelegations = append(delegationState.HostAccountUndelegations[:i], delegationState.HostAccountUndelegations[i+1:]...)
-			k.SetDelegationState(ctx, delegationState)
-			return nil
-		}
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetHostAccountUndelegationKey(epochNumber)
+	if !store.Has(key) {
+		return types.ErrCannotRemoveNonExistentUndelegation
 	}
-	return types.ErrCannotRemoveNonExistentUndelegation
+	store.Delete(key)
+	return nil
 }
 
 // GetHostAccountUndelegationForEpoch returns the host account undelegation the input epoch number
 func (k Keeper) GetHostAccountUndelegationForEpoch(ctx sdk.Context, epochNumber int64) (types.HostAccountUndelegation, error) {
-	delegationState := k.GetDelegationState(ctx)
-	for _, undelegation := range delegationState.HostAccountUndelegations {
-		if undelegation.EpochNumber == epochNumber {
-			return undelegation, nil
-		}
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetHostAccountUndelegationKey(epochNumber))
+	if bz == nil {
+		return types.HostAccountUndelegation{}, types.ErrUndelegationEpochNotFound
 	}
-	return types.HostAccountUndelegation{}, types.ErrUndelegationEpochNotFound
+
+	var undelegation types.HostAccountUndelegation
+	k.cdc.MustUnmarshal(bz, &undelegation)
+
+	return undelegation, nil
 }
 
 // GetHostAccountMaturedUndelegations returns the host account matured undelegations
 func (k Keeper) GetHostAccountMaturedUndelegations(ctx sdk.Context) []types.HostAccountUndelegation {
-	undelegations := k.GetDelegationState(ctx).HostAccountUndelegations
+	undelegations := k.GetAllHostAccountUndelegations(ctx)
 	var maturedUndelegations []types.HostAccountUndelegation
 	for _, undelegation := range undelegations {
 		if !ctx.BlockTime().Before(undelegation.CompletionTime) && !undelegation.CompletionTime.Equal(time.Time{}) {
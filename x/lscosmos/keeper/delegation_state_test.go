@@ -64,3 +64,31 @@ func (suite *IntegrationTestSuite) TestDelegationState() {
 	suite.Error(err)
 
 }
+
+func (suite *IntegrationTestSuite) TestSetDelegationStateReplacesEntries() {
+	app, ctx := suite.app, suite.ctx
+	keeper := app.LSCosmosKeeper
+
+	baseDenom := keeper.GetHostChainParams(ctx).BaseDenom
+	keeper.AddHostAccountDelegation(ctx, types.NewHostAccountDelegation("validator1______________", sdk.NewInt64Coin(baseDenom, 10)))
+	keeper.AddHostAccountDelegation(ctx, types.NewHostAccountDelegation("validator2______________", sdk.NewInt64Coin(baseDenom, 20)))
+	keeper.AddTotalUndelegationForEpoch(ctx, 4, sdk.NewInt64Coin(keeper.GetHostChainParams(ctx).MintDenom, 5))
+
+	// the delegation account fields are updated without touching the entries
+	keeper.AddBalanceToDelegationState(ctx, sdk.NewInt64Coin(baseDenom, 100))
+	suite.Len(keeper.GetAllHostAccountDelegations(ctx), 2)
+	suite.Equal(int64(30), keeper.GetStakedAmount(ctx).Int64())
+
+	keeper.SetDelegationState(ctx, types.DelegationState{
+		HostAccountDelegations: []types.HostAccountDelegation{
+			types.NewHostAccountDelegation("validator3______________", sdk.NewInt64Coin(baseDenom, 30)),
+		},
+	})
+
+	delegationState := keeper.GetDelegationState(ctx)
+	suite.Len(delegationState.HostAccountDelegations, 1)
+	suite.Equal("validator3______________", delegationState.HostAccountDelegations[0].ValidatorAddress)
+	suite.Empty(delegationState.HostAccountUndelegations)
+	suite.True(delegationState.HostDelegationAccountBalance.IsZero())
+	suite.Equal(types.HostAccountDelegation{}, keeper.GetHostAccountDelegation(ctx, "validator1______________"))
+}
@@ -257,17 +257,17 @@ func DivideUndelegateAmountIntoValidatorSet(sortedValDiff types.WeightedAddressA
 // delegation state. It is done to keep the old validators in the loop while calculating weighted amounts
 // for delegation and undelegation
 func (k Keeper) GetAllValidatorsState(ctx sdk.Context, denom string) (types.AllowListedVals, types.HostAccountDelegations) {
-	// Get current active val set and make a map of it
-	currentAllowListedValSet := k.GetAllowListedValidators(ctx)
+	// Get current active val set with the weights in use and make a map of it
+	currentAllowListedValSet := k.GetEffectiveAllowListedValidators(ctx)
 	currentAllowListedValSetMap := make(map[string]sdk.Dec)
 	for _, val := range currentAllowListedValSet.AllowListedValidators {
 		currentAllowListedValSetMap[val.ValidatorAddress] = val.TargetWeight
 	}
 
 	// get delegation state and make a map with address as
-	currentDelegationState := k.GetDelegationState(ctx)
+	currentDelegations := k.GetAllHostAccountDelegations(ctx)
 	currentDelegationStateMap := make(map[string]sdk.Coin)
-	for _, delegation := range currentDelegationState.HostAccountDelegations {
+	for _, delegation := range currentDelegations {
 		currentDelegationStateMap[delegation.ValidatorAddress] = delegation.Amount
 	}
 
@@ -278,8 +278,8 @@ func (k Keeper) GetAllValidatorsState(ctx sdk.Context, denom string) (types.Allo
 	}
 
 	// get validator list from current delegation state
-	valList := make([]string, len(currentDelegationState.HostAccountDelegations))
-	for i, val := range currentDelegationState.HostAccountDelegations {
+	valList := make([]string, len(currentDelegations))
+	for i, val := range currentDelegations {
 		valList[i] = val.ValidatorAddress
 	}
 
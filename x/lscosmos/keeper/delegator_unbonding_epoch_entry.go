@@ -1,11 +1,15 @@
 package keeper
 
 import (
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"github.com/persistenceOne/pstake-native/v2/x/lscosmos/types"
 )
 
-// SetDelegatorUnbondingEpochEntry sets delegator entry for unbondign stkatom for an unbonding epoch
+// SetDelegatorUnbondingEpochEntry sets delegator entry for unbondign stkatom for an unbonding epoch and indexes
+// the delegator against the epoch
 func (k Keeper) SetDelegatorUnbondingEpochEntry(ctx sdk.Context, unbondingEpochEntry types.DelegatorUnbondingEpochEntry) {
 	store := ctx.KVStore(k.storeKey)
 	delAddr, err := sdk.AccAddressFromBech32(unbondingEpochEntry.DelegatorAddress)
@@ -14,6 +18,7 @@ func (k Keeper) SetDelegatorUnbondingEpochEntry(ctx sdk.Context, unbondingEpochE
 	}
 	bz := k.cdc.MustMarshal(&unbondingEpochEntry)
 	store.Set(types.GetDelegatorUnbondingEpochEntryKey(delAddr, unbondingEpochEntry.EpochNumber), bz)
+	store.Set(types.GetUnbondingEpochDelegatorKey(unbondingEpochEntry.EpochNumber, delAddr), []byte{})
 }
 
 // GetDelegatorUnbondingEpochEntry gets delegator entry for unbondign stkatom for an unbonding epoch
@@ -34,6 +39,7 @@ func (k Keeper) GetDelegatorUnbondingEpochEntry(ctx sdk.Context, delegatorAddres
 func (k Keeper) RemoveDelegatorUnbondingEpochEntry(ctx sdk.Context, delegatorAddress sdk.AccAddress, epochNumber int64) {
 	store := ctx.KVStore(k.storeKey)
 	store.Delete(types.GetDelegatorUnbondingEpochEntryKey(delegatorAddress, epochNumber))
+	store.Delete(types.GetUnbondingEpochDelegatorKey(epochNumber, delegatorAddress))
 }
 
 // IterateDelegatorUnbondingEpochEntry returns a list of types.DelegatorUnbondingEpochEntry by using
@@ -56,6 +62,26 @@ func (k Keeper) IterateDelegatorUnbondingEpochEntry(ctx sdk.Context, delegatorAd
 	return delegatorUnbondingEntries
 }
 
+// IterateDelegatorUnbondingEpochEntriesInRange returns the unbonding epoch entries of the input delegator address
+// for the epochs from startEpoch to endEpoch, both included, in epoch order
+func (k Keeper) IterateDelegatorUnbondingEpochEntriesInRange(ctx sdk.Context, delegatorAddress sdk.AccAddress, startEpoch, endEpoch int64) []types.DelegatorUnbondingEpochEntry {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetPartialDelegatorUnbondingEpochEntryKey(delegatorAddress))
+	var delegatorUnbondingEntries []types.DelegatorUnbondingEpochEntry
+	iterator := store.Iterator(types.GetEpochNumberBytes(startEpoch), types.GetEpochNumberBytes(endEpoch+1))
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var unbondingEntry types.DelegatorUnbondingEpochEntry
+
+		k.cdc.MustUnmarshal(iterator.Value(), &unbondingEntry)
+
+		delegatorUnbondingEntries = append(delegatorUnbondingEntries, unbondingEntry)
+	}
+
+	return delegatorUnbondingEntries
+}
+
 // IterateAllDelegatorUnbondingEpochEntry returns a list of all epoch entries ever created in the KV store
 // by using the prefix iterator
 func (k Keeper) IterateAllDelegatorUnbondingEpochEntry(ctx sdk.Context) []types.DelegatorUnbondingEpochEntry {
@@ -86,3 +112,86 @@ func (k Keeper) AddDelegatorUnbondingEpochEntry(ctx sdk.Context, delegatorAddres
 	}
 	k.SetDelegatorUnbondingEpochEntry(ctx, unbondingEntry)
 }
+
+// TransferDelegatorUnbondingEpochEntry moves amount from the delegator entry for an unbonding epoch to the
+// recipient entry for the same epoch, the delegator entry is removed if it is transferred as a whole
+func (k Keeper) TransferDelegatorUnbondingEpochEntry(ctx sdk.Context, delegatorAddress, recipientAddress sdk.AccAddress, epochNumber int64, amount sdk.Coin) error {
+	unbondingEntry := k.GetDelegatorUnbondingEpochEntry(ctx, delegatorAddress, epochNumber)
+	if unbondingEntry.Equal(types.DelegatorUnbondingEpochEntry{}) {
+		return errorsmod.Wrapf(types.ErrUnbondingEntryNotFound, "delegator: %s, epoch: %d", delegatorAddress.String(), epochNumber)
+	}
+	if unbondingEntry.Amount.Denom != amount.Denom {
+		return errorsmod.Wrapf(types.ErrInvalidDenom, "expected %s, got %s", unbondingEntry.Amount.Denom, amount.Denom)
+	}
+	if unbondingEntry.Amount.IsLT(amount) {
+		return errorsmod.Wrapf(types.ErrInsufficientUnbondingEntryAmount, "entry amount: %s, transfer amount: %s", unbondingEntry.Amount, amount)
+	}
+
+	unbondingEntry.Amount = unbondingEntry.Amount.Sub(amount)
+	if unbondingEntry.Amount.IsZero() {
+		k.RemoveDelegatorUnbondingEpochEntry(ctx, delegatorAddress, epochNumber)
+	} else {
+		k.SetDelegatorUnbondingEpochEntry(ctx, unbondingEntry)
+	}
+	k.AddDelegatorUnbondingEpochEntry(ctx, recipientAddress, epochNumber, amount)
+
+	return nil
+}
+
+// ClaimDelegatorUnbondingEpochEntry pays out a single delegator unbonding epoch entry from the undelegation
+// module account. Matured entries are paid in the ibc denom using the unbonding epoch c value, failed entries
+// are refunded in stk tokens. Entries which are neither matured nor failed are left untouched.
+func (k Keeper) ClaimDelegatorUnbondingEpochEntry(ctx sdk.Context, delegatorAddress sdk.AccAddress, unbondingEntry types.DelegatorUnbondingEpochEntry) error {
+	unbondingEpochCValue := k.GetUnbondingEpochCValue(ctx, unbondingEntry.EpochNumber)
+	if unbondingEpochCValue.IsMatured {
+		// get c value from the UnbondingEpochCValue struct
+		// calculate claimable amount from un inverse c value
+		claimableAmount := sdk.NewDecFromInt(unbondingEntry.Amount.Amount).Quo(unbondingEpochCValue.GetUnbondingEpochCValue())
+
+		// calculate claimable coin and community coin to be sent to delegator account and community pool respectively
+		claimableCoin, _ := sdk.NewDecCoinFromDec(k.GetIBCDenom(ctx), claimableAmount).TruncateDecimal()
+
+		// send coin to delegator address from undelegation module account
+		err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.UndelegationModuleAccount, delegatorAddress, sdk.NewCoins(claimableCoin))
+		if err != nil {
+			return err
+		}
+
+		ctx.EventManager().EmitEvents(sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeClaim,
+				sdk.NewAttribute(types.AttributeDelegatorAddress, delegatorAddress.String()),
+				sdk.NewAttribute(types.AttributeAmount, unbondingEntry.Amount.String()),
+				sdk.NewAttribute(types.AttributeClaimedAmount, claimableAmount.String()),
+			)},
+		)
+
+		// remove entry from unbonding epoch entry
+		k.RemoveDelegatorUnbondingEpochEntry(ctx, delegatorAddress, unbondingEntry.EpochNumber)
+	}
+	if unbondingEpochCValue.IsFailed {
+		err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.UndelegationModuleAccount, delegatorAddress, sdk.NewCoins(unbondingEntry.Amount))
+		if err != nil {
+			return err
+		}
+
+		// remove entry from unbonding epoch entry
+		k.RemoveDelegatorUnbondingEpochEntry(ctx, delegatorAddress, unbondingEntry.EpochNumber)
+	}
+	return nil
+}
+
+// ClaimDelegatorUnbondingEpochEntries pays out all the matured or failed unbonding epoch entries of the
+// input delegator address using ClaimDelegatorUnbondingEpochEntry
+func (k Keeper) ClaimDelegatorUnbondingEpochEntries(ctx sdk.Context, delegatorAddress sdk.AccAddress) error {
+	// get all the entries corresponding to the delegator address
+	delegatorUnbondingEntries := k.IterateDelegatorUnbondingEpochEntry(ctx, delegatorAddress)
+
+	// loop through all the epoch and send tokens if an entry has matured.
+	for _, unbondingEntry := range delegatorUnbondingEntries {
+		if err := k.ClaimDelegatorUnbondingEpochEntry(ctx, delegatorAddress, unbondingEntry); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -95,3 +95,41 @@ func (suite *IntegrationTestSuite) TestKVStore() {
 		suite.Equal(int64(0), entry.EpochNumber)
 	}
 }
+
+func (suite *IntegrationTestSuite) TestTransferDelegatorUnbondingEpochEntry() {
+	app, ctx := suite.app, suite.ctx
+	keeper := app.LSCosmosKeeper
+
+	addr1, err := sdk.AccAddressFromBech32("persistence1826wkxx8wv7mfnank8l6xu9rxm7kg8rvvk4e0a")
+	suite.NoError(err)
+
+	addr2, err := sdk.AccAddressFromBech32("persistence1pss7nxeh3f9md2vuxku8q99femnwdjtcpe9ky9")
+	suite.NoError(err)
+
+	keeper.AddDelegatorUnbondingEpochEntry(ctx, addr1, 4, sdk.NewInt64Coin("stkAtom", 10000))
+
+	// no entry for the epoch
+	err = keeper.TransferDelegatorUnbondingEpochEntry(ctx, addr1, addr2, 8, sdk.NewInt64Coin("stkAtom", 1000))
+	suite.ErrorIs(err, types.ErrUnbondingEntryNotFound)
+
+	// wrong denom
+	err = keeper.TransferDelegatorUnbondingEpochEntry(ctx, addr1, addr2, 4, sdk.NewInt64Coin("uatom", 1000))
+	suite.ErrorIs(err, types.ErrInvalidDenom)
+
+	// amount greater than entry
+	err = keeper.TransferDelegatorUnbondingEpochEntry(ctx, addr1, addr2, 4, sdk.NewInt64Coin("stkAtom", 10001))
+	suite.ErrorIs(err, types.ErrInsufficientUnbondingEntryAmount)
+
+	// partial transfer
+	err = keeper.TransferDelegatorUnbondingEpochEntry(ctx, addr1, addr2, 4, sdk.NewInt64Coin("stkAtom", 4000))
+	suite.NoError(err)
+	suite.Equal(sdk.NewInt64Coin("stkAtom", 6000), keeper.GetDelegatorUnbondingEpochEntry(ctx, addr1, 4).Amount)
+	suite.Equal(sdk.NewInt64Coin("stkAtom", 4000), keeper.GetDelegatorUnbondingEpochEntry(ctx, addr2, 4).Amount)
+	suite.Equal(addr2.String(), keeper.GetDelegatorUnbondingEpochEntry(ctx, addr2, 4).DelegatorAddress)
+
+	// whole transfer removes the entry
+	err = keeper.TransferDelegatorUnbondingEpochEntry(ctx, addr1, addr2, 4, sdk.NewInt64Coin("stkAtom", 6000))
+	suite.NoError(err)
+	suite.Equal(0, len(keeper.IterateDelegatorUnbondingEpochEntry(ctx, addr1)))
+	suite.Equal(sdk.NewInt64Coin("stkAtom", 10000), keeper.GetDelegatorUnbondingEpochEntry(ctx, addr2, 4).Amount)
+}
@@ -0,0 +1,155 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/persistenceOne/pstake-native/v2/x/lscosmos/types"
+)
+
+// SetAddressDeposits sets the amount currently deposited by the delegator address, the entry is removed
+// once nothing is left deposited
+func (k Keeper) SetAddressDeposits(ctx sdk.Context, delegatorAddress sdk.AccAddress, amount math.Int) {
+	store := ctx.KVStore(k.storeKey)
+	if !amount.IsPositive() {
+		store.Delete(types.GetAddressDepositsKey(delegatorAddress))
+		return
+	}
+	bz, err := amount.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	store.Set(types.GetAddressDepositsKey(delegatorAddress), bz)
+}
+
+// GetAddressDeposits gets the amount currently deposited by the delegator address
+func (k Keeper) GetAddressDeposits(ctx sdk.Context, delegatorAddress sdk.AccAddress) math.Int {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetAddressDepositsKey(delegatorAddress))
+	if bz == nil {
+		return sdk.ZeroInt()
+	}
+	var amount math.Int
+	if err := amount.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+	return amount
+}
+
+// IterateAllAddressDeposits returns a list of the current deposits of all the addresses
+func (k Keeper) IterateAllAddressDeposits(ctx sdk.Context) []types.AddressDeposits {
+	store := ctx.KVStore(k.storeKey)
+	var addressDeposits []types.AddressDeposits
+	iterator := sdk.KVStorePrefixIterator(store, types.AddressDepositsKey)
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var amount math.Int
+		if err := amount.Unmarshal(iterator.Value()); err != nil {
+			panic(err)
+		}
+		// strip the key prefix and the address length prefix
+		addressDeposits = append(addressDeposits, types.AddressDeposits{
+			Address: sdk.AccAddress(iterator.Key()[len(types.AddressDepositsKey)+1:]).String(),
+			Amount:  amount,
+		})
+	}
+
+	return addressDeposits
+}
+
+// SetEpochDeposits sets the deposits of the current delegation epoch
+func (k Keeper) SetEpochDeposits(ctx sdk.Context, epochDeposits types.EpochDeposits) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.EpochDepositsKey, k.cdc.MustMarshal(&epochDeposits))
+}
+
+// GetEpochDeposits gets the deposits of the current delegation epoch, deposits recorded for
+// a previous epoch are not returned
+func (k Keeper) GetEpochDeposits(ctx sdk.Context) types.EpochDeposits {
+	currentEpoch := k.epochKeeper.GetEpochInfo(ctx, types.DelegationEpochIdentifier).CurrentEpoch
+
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.EpochDepositsKey)
+	if bz == nil {
+		return types.EpochDeposits{EpochNumber: currentEpoch, Amount: sdk.ZeroInt()}
+	}
+	var epochDeposits types.EpochDeposits
+	k.cdc.MustUnmarshal(bz, &epochDeposits)
+	if epochDeposits.EpochNumber != currentEpoch {
+		return types.EpochDeposits{EpochNumber: currentEpoch, Amount: sdk.ZeroInt()}
+	}
+	return epochDeposits
+}
+
+// AddDeposit records the deposit against the delegator address and the current delegation epoch
+func (k Keeper) AddDeposit(ctx sdk.Context, delegatorAddress sdk.AccAddress, amount math.Int) {
+	k.SetAddressDeposits(ctx, delegatorAddress, k.GetAddressDeposits(ctx, delegatorAddress).Add(amount))
+
+	epochDeposits := k.GetEpochDeposits(ctx)
+	epochDeposits.Amount = epochDeposits.Amount.Add(amount)
+	k.SetEpochDeposits(ctx, epochDeposits)
+}
+
+// RemoveDeposit reduces the deposits of the delegator address by the token value it unstaked or redeemed, so the
+// per address deposit cap limits the current exposure of an address rather than its lifetime deposits. The
+// deposits never go below zero, stk tokens received from other addresses can be unstaked as well.
+func (k Keeper) RemoveDeposit(ctx sdk.Context, delegatorAddress sdk.AccAddress, amount math.Int) {
+	addressDeposits := k.GetAddressDeposits(ctx, delegatorAddress)
+	k.SetAddressDeposits(ctx, delegatorAddress, sdk.MaxInt(addressDeposits.Sub(amount), sdk.ZeroInt()))
+}
+
+// CheckDepositLimits returns an error if depositing the amount would exceed the tvl cap, the per address
+// deposit cap or the per epoch deposit limit. Caps set to zero are not enforced.
+func (k Keeper) CheckDepositLimits(ctx sdk.Context, delegatorAddress sdk.AccAddress, amount math.Int) error {
+	params := k.GetParams(ctx)
+
+	if params.TvlCap.IsPositive() {
+		tvl := k.GetTotalValueLocked(ctx)
+		if tvl.Add(amount).GT(params.TvlCap) {
+			return errorsmod.Wrapf(types.ErrTVLCapExceeded, "cap %s, current %s, got %s", params.TvlCap, tvl, amount)
+		}
+	}
+	if params.PerAddressDepositCap.IsPositive() {
+		addressDeposits := k.GetAddressDeposits(ctx, delegatorAddress)
+		if addressDeposits.Add(amount).GT(params.PerAddressDepositCap) {
+			return errorsmod.Wrapf(types.ErrAddressDepositCapExceeded, "cap %s, deposited %s, got %s", params.PerAddressDepositCap, addressDeposits, amount)
+		}
+	}
+	if params.PerEpochDepositLimit.IsPositive() {
+		epochDeposits := k.GetEpochDeposits(ctx)
+		if epochDeposits.Amount.Add(amount).GT(params.PerEpochDepositLimit) {
+			return errorsmod.Wrapf(types.ErrEpochDepositLimitExceeded, "limit %s, deposited %s, got %s", params.PerEpochDepositLimit, epochDeposits.Amount, amount)
+		}
+	}
+	return nil
+}
+
+// GetRemainingDepositCapacity returns the amount that can still be deposited by the delegator address before
+// any of the deposit caps is hit, limited is false when no cap is set. The per address cap is skipped for an
+// empty delegator address.
+func (k Keeper) GetRemainingDepositCapacity(ctx sdk.Context, delegatorAddress sdk.AccAddress) (remaining math.Int, limited bool) {
+	params := k.GetParams(ctx)
+	remaining = sdk.ZeroInt()
+
+	capRemaining := func(capAmount, used math.Int) {
+		left := sdk.MaxInt(capAmount.Sub(used), sdk.ZeroInt())
+		if !limited || left.LT(remaining) {
+			remaining = left
+		}
+		limited = true
+	}
+
+	if params.TvlCap.IsPositive() {
+		capRemaining(params.TvlCap, k.GetTotalValueLocked(ctx))
+	}
+	if params.PerAddressDepositCap.IsPositive() && !delegatorAddress.Empty() {
+		capRemaining(params.PerAddressDepositCap, k.GetAddressDeposits(ctx, delegatorAddress))
+	}
+	if params.PerEpochDepositLimit.IsPositive() {
+		capRemaining(params.PerEpochDepositLimit, k.GetEpochDeposits(ctx).Amount)
+	}
+	return remaining, limited
+}
@@ -0,0 +1,75 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
+
+	"github.com/persistenceOne/pstake-native/v2/x/lscosmos/types"
+)
+
+func (suite *IntegrationTestSuite) TestCheckDepositLimits() {
+	app, ctx := suite.app, suite.ctx
+	keeper := app.LSCosmosKeeper
+
+	addr1 := sdk.AccAddress("addr1_______________")
+	addr2 := sdk.AccAddress("addr2_______________")
+
+	// no caps by default
+	suite.NoError(keeper.CheckDepositLimits(ctx, addr1, sdk.NewInt(1000000000)))
+	_, limited := keeper.GetRemainingDepositCapacity(ctx, addr1)
+	suite.False(limited)
+
+	keeper.SetParams(ctx, types.NewParams(sdk.NewInt(5000), sdk.NewInt(1000), sdk.NewInt(1500), types.DefaultAdminTimelock, types.DefaultValidatorWeighting(),
+		types.DefaultRestakeCapPerDay, types.EXCESS_REWARD_POLICY_CARRY_FORWARD, "", types.DefaultHostVoteBuffer))
+
+	// per address cap
+	suite.ErrorIs(keeper.CheckDepositLimits(ctx, addr1, sdk.NewInt(1001)), types.ErrAddressDepositCapExceeded)
+	suite.NoError(keeper.CheckDepositLimits(ctx, addr1, sdk.NewInt(1000)))
+	keeper.AddDeposit(ctx, addr1, sdk.NewInt(1000))
+	suite.Equal(sdk.NewInt(1000), keeper.GetAddressDeposits(ctx, addr1))
+	suite.ErrorIs(keeper.CheckDepositLimits(ctx, addr1, sdk.NewInt(1)), types.ErrAddressDepositCapExceeded)
+
+	// unstaking frees the per address cap, the deposits never go below zero
+	keeper.RemoveDeposit(ctx, addr1, sdk.NewInt(400))
+	suite.Equal(sdk.NewInt(600), keeper.GetAddressDeposits(ctx, addr1))
+	suite.NoError(keeper.CheckDepositLimits(ctx, addr1, sdk.NewInt(400)))
+	keeper.RemoveDeposit(ctx, addr1, sdk.NewInt(1000))
+	suite.Equal(sdk.ZeroInt(), keeper.GetAddressDeposits(ctx, addr1))
+	suite.Empty(keeper.IterateAllAddressDeposits(ctx))
+	keeper.SetAddressDeposits(ctx, addr1, sdk.NewInt(1000))
+
+	// per epoch limit
+	suite.ErrorIs(keeper.CheckDepositLimits(ctx, addr2, sdk.NewInt(600)), types.ErrEpochDepositLimitExceeded)
+	remaining, limited := keeper.GetRemainingDepositCapacity(ctx, addr2)
+	suite.True(limited)
+	suite.Equal(sdk.NewInt(500), remaining)
+
+	// tvl cap
+	ibcDenom := keeper.GetIBCDenom(ctx)
+	keeper.GetDepositModuleAccount(ctx)
+	suite.Require().NoError(testutil.FundModuleAccount(app.BankKeeper, ctx, types.DepositModuleAccount, sdk.NewCoins(sdk.NewInt64Coin(ibcDenom, 4800))))
+	suite.ErrorIs(keeper.CheckDepositLimits(ctx, addr2, sdk.NewInt(300)), types.ErrTVLCapExceeded)
+	remaining, _ = keeper.GetRemainingDepositCapacity(ctx, addr2)
+	suite.Equal(sdk.NewInt(200), remaining)
+
+	// the address cap is skipped without an address
+	remaining, _ = keeper.GetRemainingDepositCapacity(ctx, nil)
+	suite.Equal(sdk.NewInt(200), remaining)
+	remaining, _ = keeper.GetRemainingDepositCapacity(ctx, addr1)
+	suite.Equal(sdk.ZeroInt(), remaining)
+}
+
+func (suite *IntegrationTestSuite) TestEpochDepositsReset() {
+	app, ctx := suite.app, suite.ctx
+	keeper := app.LSCosmosKeeper
+
+	addr1 := sdk.AccAddress("addr1_______________")
+	currentEpoch := app.EpochsKeeper.GetEpochInfo(ctx, types.DelegationEpochIdentifier).CurrentEpoch
+
+	keeper.AddDeposit(ctx, addr1, sdk.NewInt(1000))
+	suite.Equal(types.EpochDeposits{EpochNumber: currentEpoch, Amount: sdk.NewInt(1000)}, keeper.GetEpochDeposits(ctx))
+
+	// deposits of a previous epoch are not counted
+	keeper.SetEpochDeposits(ctx, types.EpochDeposits{EpochNumber: currentEpoch - 1, Amount: sdk.NewInt(1000)})
+	suite.Equal(sdk.ZeroInt(), keeper.GetEpochDeposits(ctx).Amount)
+}
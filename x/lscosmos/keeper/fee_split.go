@@ -0,0 +1,162 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+
+	"github.com/persistenceOne/pstake-native/v2/x/lscosmos/types"
+)
+
+// SetFeeSplit sets the protocol fee split in the store
+func (k Keeper) SetFeeSplit(ctx sdk.Context, feeSplit types.FeeSplit) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.FeeSplitKey, k.cdc.MustMarshal(&feeSplit))
+}
+
+// GetFeeSplit gets the protocol fee split from the store
+func (k Keeper) GetFeeSplit(ctx sdk.Context) types.FeeSplit {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.FeeSplitKey)
+	if bz == nil {
+		return types.FeeSplit{}
+	}
+	var feeSplit types.FeeSplit
+	k.cdc.MustUnmarshal(bz, &feeSplit)
+	return feeSplit
+}
+
+// SetCollectedFee sets the cumulative amount of a fee type sent to a recipient
+func (k Keeper) SetCollectedFee(ctx sdk.Context, collectedFee types.CollectedFee) {
+	store := ctx.KVStore(k.storeKey)
+	recipient, err := sdk.AccAddressFromBech32(collectedFee.Recipient)
+	if err != nil {
+		panic(err)
+	}
+	store.Set(types.GetCollectedFeesKey(collectedFee.FeeType, recipient), k.cdc.MustMarshal(&collectedFee))
+}
+
+// GetCollectedFee gets the cumulative amount of a fee type sent to a recipient
+func (k Keeper) GetCollectedFee(ctx sdk.Context, feeType string, recipient sdk.AccAddress) types.CollectedFee {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetCollectedFeesKey(feeType, recipient))
+	if bz == nil {
+		return types.CollectedFee{FeeType: feeType, Recipient: recipient.String(), Amount: sdk.NewCoins()}
+	}
+	var collectedFee types.CollectedFee
+	k.cdc.MustUnmarshal(bz, &collectedFee)
+	return collectedFee
+}
+
+// AddCollectedFee adds the amount to the cumulative amount of a fee type sent to a recipient
+func (k Keeper) AddCollectedFee(ctx sdk.Context, feeType string, recipient sdk.AccAddress, amount sdk.Coins) {
+	collectedFee := k.GetCollectedFee(ctx, feeType, recipient)
+	collectedFee.Amount = collectedFee.Amount.Add(amount...)
+	k.SetCollectedFee(ctx, collectedFee)
+}
+
+// IterateCollectedFees returns a list of the cumulative amounts of a fee type sent to every recipient,
+// all fee types are returned for an empty fee type
+func (k Keeper) IterateCollectedFees(ctx sdk.Context, feeType string) []types.CollectedFee {
+	store := ctx.KVStore(k.storeKey)
+	var collectedFees []types.CollectedFee
+
+	keyPrefix := types.CollectedFeesKey
+	if feeType != "" {
+		keyPrefix = types.GetPartialCollectedFeesKey(feeType)
+	}
+	iterator := sdk.KVStorePrefixIterator(store, keyPrefix)
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var collectedFee types.CollectedFee
+
+		k.cdc.MustUnmarshal(iterator.Value(), &collectedFee)
+
+		collectedFees = append(collectedFees, collectedFee)
+	}
+
+	return collectedFees
+}
+
+// ValidateFeeSplitRecipients checks that the fee split is valid and that every recipient can receive the fees,
+// recipients blocked by the bank module or holding a module account are rejected. The distribution module
+// account is the community pool and is funded through the distribution module instead.
+func (k Keeper) ValidateFeeSplitRecipients(ctx sdk.Context, feeSplit types.FeeSplit) error {
+	if err := feeSplit.Validate(); err != nil {
+		return err
+	}
+	communityPoolAddress := k.accountKeeper.GetModuleAddress(distrtypes.ModuleName)
+	for _, feeType := range types.FeeTypes {
+		for _, recipient := range feeSplit.GetRecipients(feeType) {
+			addr := sdk.MustAccAddressFromBech32(recipient.Address)
+			if addr.Equals(communityPoolAddress) {
+				continue
+			}
+			if k.bankKeeper.BlockedAddr(addr) {
+				return errorsmod.Wrapf(types.ErrInvalidFeeSplit, "%s fee recipient %s is not allowed to receive funds", feeType, recipient.Address)
+			}
+			if _, ok := k.accountKeeper.GetAccount(ctx, addr).(authtypes.ModuleAccountI); ok {
+				return errorsmod.Wrapf(types.ErrInvalidFeeSplit, "%s fee recipient %s is a module account", feeType, recipient.Address)
+			}
+		}
+	}
+	return nil
+}
+
+// SendProtocolFee splits the protocol fee between the recipients of the fee type and records the amount
+// sent to each of them. Fees of a type without recipients are sent to the pstake fee address. Shares are
+// truncated, the last recipient receives the remainder. The share of the distribution module account is
+// added to the community pool.
+func (k Keeper) SendProtocolFee(ctx sdk.Context, protocolFee sdk.Coins, moduleAccount, feeType string) error {
+	recipients := k.GetFeeSplit(ctx).GetRecipients(feeType)
+	if len(recipients) == 0 {
+		recipients = []types.FeeRecipient{{
+			Address: k.GetHostChainParams(ctx).PstakeParams.PstakeFeeAddress,
+			Weight:  sdk.OneDec(),
+		}}
+	}
+
+	communityPoolAddress := k.accountKeeper.GetModuleAddress(distrtypes.ModuleName)
+	remaining := protocolFee
+	for i, recipient := range recipients {
+		addr, err := sdk.AccAddressFromBech32(recipient.Address)
+		if err != nil {
+			return err
+		}
+
+		share := remaining
+		if i != len(recipients)-1 {
+			share = sdk.NewCoins()
+			for _, coin := range protocolFee {
+				share = share.Add(sdk.NewCoin(coin.Denom, recipient.Weight.MulInt(coin.Amount).TruncateInt()))
+			}
+		}
+		if share.IsZero() {
+			continue
+		}
+
+		if addr.Equals(communityPoolAddress) {
+			err = k.distrKeeper.FundCommunityPool(ctx, share, authtypes.NewModuleAddress(moduleAccount))
+		} else {
+			err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, moduleAccount, addr, share)
+		}
+		if err != nil {
+			return err
+		}
+		k.AddCollectedFee(ctx, feeType, addr, share)
+		remaining = remaining.Sub(share...)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeProtocolFee,
+				sdk.NewAttribute(types.AttributeFeeType, feeType),
+				sdk.NewAttribute(types.AttributeRecipientAddress, recipient.Address),
+				sdk.NewAttribute(types.AttributeAmount, share.String()),
+			),
+		)
+	}
+	return nil
+}
@@ -0,0 +1,80 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	lscosmoskeeper "github.com/persistenceOne/pstake-native/v2/x/lscosmos/keeper"
+	"github.com/persistenceOne/pstake-native/v2/x/lscosmos/types"
+)
+
+func (suite *IntegrationTestSuite) TestSendProtocolFee() {
+	app, ctx := suite.app, suite.ctx
+	keeper := app.LSCosmosKeeper
+
+	treasury := sdk.AccAddress("treasury____________")
+	insurance := sdk.AccAddress("insurance___________")
+	pstakeFeeAddress := sdk.MustAccAddressFromBech32(PstakeFeeAddress)
+
+	fee := sdk.NewCoins(sdk.NewInt64Coin(MintDenom, 1001))
+	suite.Require().NoError(app.BankKeeper.MintCoins(ctx, types.ModuleName, fee.Add(fee...)))
+
+	// fee types without recipients go to the pstake fee address
+	suite.NoError(keeper.SendProtocolFee(ctx, fee, types.ModuleName, types.FeeTypeDeposit))
+	suite.Equal(fee, app.BankKeeper.GetAllBalances(ctx, pstakeFeeAddress))
+
+	feeSplit := types.FeeSplit{
+		RestakeFeeRecipients: []types.FeeRecipient{
+			{Address: treasury.String(), Weight: sdk.NewDecWithPrec(7, 1)},
+			{Address: insurance.String(), Weight: sdk.NewDecWithPrec(3, 1)},
+		},
+	}
+	suite.NoError(lscosmoskeeper.HandleFeeSplitChangeProposal(ctx, keeper, types.FeeSplitChangeProposal{FeeSplit: feeSplit}))
+	suite.Equal(feeSplit, keeper.GetFeeSplit(ctx))
+
+	// the last recipient gets the remainder
+	suite.NoError(keeper.SendProtocolFee(ctx, fee, types.ModuleName, types.FeeTypeRestake))
+	suite.Equal(sdk.NewCoins(sdk.NewInt64Coin(MintDenom, 700)), app.BankKeeper.GetAllBalances(ctx, treasury))
+	suite.Equal(sdk.NewCoins(sdk.NewInt64Coin(MintDenom, 301)), app.BankKeeper.GetAllBalances(ctx, insurance))
+
+	suite.Equal(1, len(keeper.IterateCollectedFees(ctx, types.FeeTypeDeposit)))
+	suite.Equal(2, len(keeper.IterateCollectedFees(ctx, types.FeeTypeRestake)))
+	suite.Equal(3, len(keeper.IterateCollectedFees(ctx, "")))
+	suite.Equal(sdk.NewCoins(sdk.NewInt64Coin(MintDenom, 700)), keeper.GetCollectedFee(ctx, types.FeeTypeRestake, treasury).Amount)
+	suite.Equal(fee, keeper.GetCollectedFee(ctx, types.FeeTypeDeposit, pstakeFeeAddress).Amount)
+
+	// invalid splits are rejected
+	feeSplit.RestakeFeeRecipients[0].Weight = sdk.NewDecWithPrec(8, 1)
+	suite.ErrorIs(lscosmoskeeper.HandleFeeSplitChangeProposal(ctx, keeper, types.FeeSplitChangeProposal{FeeSplit: feeSplit}), types.ErrInvalidFeeSplit)
+}
+
+func (suite *IntegrationTestSuite) TestSendProtocolFeeToCommunityPool() {
+	app, ctx := suite.app, suite.ctx
+	keeper := app.LSCosmosKeeper
+
+	treasury := sdk.AccAddress("treasury____________")
+	communityPool := app.AccountKeeper.GetModuleAddress(distrtypes.ModuleName)
+	fee := sdk.NewCoins(sdk.NewInt64Coin(MintDenom, 1000))
+	suite.Require().NoError(app.BankKeeper.MintCoins(ctx, types.ModuleName, fee))
+
+	// the community pool is the only module account allowed to receive fees
+	splitTo := func(recipient sdk.AccAddress) types.FeeSplitChangeProposal {
+		return types.FeeSplitChangeProposal{FeeSplit: types.FeeSplit{RedemptionFeeRecipients: []types.FeeRecipient{
+			{Address: treasury.String(), Weight: sdk.NewDecWithPrec(6, 1)},
+			{Address: recipient.String(), Weight: sdk.NewDecWithPrec(4, 1)},
+		}}}
+	}
+	suite.ErrorIs(lscosmoskeeper.HandleFeeSplitChangeProposal(ctx, keeper, splitTo(app.AccountKeeper.GetModuleAddress(stakingtypes.BondedPoolName))), types.ErrInvalidFeeSplit)
+	otherModuleAccount := authtypes.NewEmptyModuleAccount("other")
+	app.AccountKeeper.SetModuleAccount(ctx, otherModuleAccount)
+	suite.ErrorIs(lscosmoskeeper.HandleFeeSplitChangeProposal(ctx, keeper, splitTo(otherModuleAccount.GetAddress())), types.ErrInvalidFeeSplit)
+	suite.NoError(lscosmoskeeper.HandleFeeSplitChangeProposal(ctx, keeper, splitTo(communityPool)))
+
+	communityPoolBefore := app.DistrKeeper.GetFeePoolCommunityCoins(ctx)
+	suite.NoError(keeper.SendProtocolFee(ctx, fee, types.ModuleName, types.FeeTypeRedemption))
+	suite.Equal(sdk.NewCoins(sdk.NewInt64Coin(MintDenom, 600)), app.BankKeeper.GetAllBalances(ctx, treasury))
+	suite.Equal(communityPoolBefore.Add(sdk.NewDecCoinsFromCoins(sdk.NewInt64Coin(MintDenom, 400))...), app.DistrKeeper.GetFeePoolCommunityCoins(ctx))
+	suite.Equal(sdk.NewCoins(sdk.NewInt64Coin(MintDenom, 400)), keeper.GetCollectedFee(ctx, types.FeeTypeRedemption, communityPool).Amount)
+}
@@ -30,7 +30,8 @@ func HandleMinDepositAndFeeChangeProposal(ctx sdk.Context, k Keeper, content typ
 	return nil
 }
 
-// HandlePstakeFeeAddressChangeProposal changes fee collector address
+// HandlePstakeFeeAddressChangeProposal changes fee collector address, the first fee address of a chain without
+// admins is seeded as the admin. Later changes of the fee address leave the admins untouched.
 func HandlePstakeFeeAddressChangeProposal(ctx sdk.Context, k Keeper, content types.PstakeFeeAddressChangeProposal) error {
 	//Do not check ModuleEnabled state or host chain params here because non-critical proposal and will help not hardcode address inside default genesis
 
@@ -40,6 +41,7 @@ func HandlePstakeFeeAddressChangeProposal(ctx sdk.Context, k Keeper, content typ
 	hostChainParams.PstakeParams.PstakeFeeAddress = content.PstakeFeeAddress
 
 	k.SetHostChainParams(ctx, hostChainParams)
+	k.SeedAdmins(ctx, content.PstakeFeeAddress)
 
 	return nil
 }
@@ -60,5 +62,16 @@ func HandleAllowListedValidatorSetChangeProposal(ctx sdk.Context, k Keeper, cont
 	}
 
 	k.SetAllowListedValidators(ctx, content.AllowListedValidators)
+	k.ResetValidatorWeighting(ctx)
+	return nil
+}
+
+// HandleFeeSplitChangeProposal changes the weighted recipients of the protocol fees
+func HandleFeeSplitChangeProposal(ctx sdk.Context, k Keeper, content types.FeeSplitChangeProposal) error {
+	if err := k.ValidateFeeSplitRecipients(ctx, content.FeeSplit); err != nil {
+		return err
+	}
+
+	k.SetFeeSplit(ctx, content.FeeSplit)
 	return nil
 }
@@ -3,7 +3,9 @@ package keeper
 import (
 	"context"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -23,24 +25,178 @@ func (k Keeper) HostChainParams(c context.Context, in *types.QueryHostChainParam
 	}, nil
 }
 
-// DelegationState queries the current delegation state
+// DelegationState queries the current delegation state, the host account delegations are paginated
 func (k Keeper) DelegationState(c context.Context, request *types.QueryDelegationStateRequest) (*types.QueryDelegationStateResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
 	ctx := sdk.UnwrapSDKContext(c)
-	delegationState := k.GetDelegationState(ctx)
+	delegationState := k.getDelegationAccountState(ctx)
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.HostAccountDelegationKey)
+	pageRes, err := query.Paginate(store, request.Pagination, func(_, value []byte) error {
+		var delegation types.HostAccountDelegation
+		if err := k.cdc.Unmarshal(value, &delegation); err != nil {
+			return err
+		}
+		delegationState.HostAccountDelegations = append(delegationState.HostAccountDelegations, delegation)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	delegationState.HostAccountUndelegations = k.GetAllHostAccountUndelegations(ctx)
 
 	return &types.QueryDelegationStateResponse{
 		DelegationState: delegationState,
+		Pagination:      pageRes,
 	}, nil
-
 }
 
-// AllowListedValidators queries the current allow listed validators set
+// AllowListedValidators queries the current allow listed validators set, the allow listed validators are
+// paginated by offset
 func (k Keeper) AllowListedValidators(c context.Context, request *types.QueryAllowListedValidatorsRequest) (*types.QueryAllowListedValidatorsResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
 	ctx := sdk.UnwrapSDKContext(c)
 	allowListedValidators := k.GetAllowListedValidators(ctx)
 
+	start, end, pageRes, err := paginateSlice(len(allowListedValidators.AllowListedValidators), request.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	allowListedValidators.AllowListedValidators = allowListedValidators.AllowListedValidators[start:end]
+
 	return &types.QueryAllowListedValidatorsResponse{
 		AllowListedValidators: allowListedValidators,
+		Pagination:            pageRes,
+	}, nil
+}
+
+// ValidatorWeights returns the base and effective weights of the allow listed validators with their host chain metrics
+func (k Keeper) ValidatorWeights(c context.Context, request *types.QueryValidatorWeightsRequest) (*types.QueryValidatorWeightsResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	validatorWeights := k.GetValidatorWeights(ctx)
+
+	start, end, pageRes, err := paginateSlice(len(validatorWeights), request.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryValidatorWeightsResponse{
+		ValidatorWeights: validatorWeights[start:end],
+		Pagination:       pageRes,
+	}, nil
+}
+
+// RewardEpochRecords queries the accrued, restaked and carried over rewards of the reward epochs in epoch order,
+// with the rewards currently carried over
+func (k Keeper) RewardEpochRecords(c context.Context, request *types.QueryRewardEpochRecordsRequest) (*types.QueryRewardEpochRecordsResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RewardEpochRecordKey)
+	var records []types.RewardEpochRecord
+	pageRes, err := query.Paginate(store, request.Pagination, func(_, value []byte) error {
+		var record types.RewardEpochRecord
+		if err := k.cdc.Unmarshal(value, &record); err != nil {
+			return err
+		}
+		records = append(records, record)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryRewardEpochRecordsResponse{
+		RewardEpochRecords: records,
+		CarriedOverRewards: k.GetCarriedOverRewards(ctx),
+		Pagination:         pageRes,
+	}, nil
+}
+
+// HostProposals queries the host chain proposals registered for vote signals in proposal id order
+func (k Keeper) HostProposals(c context.Context, request *types.QueryHostProposalsRequest) (*types.QueryHostProposalsResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.HostProposalKey)
+	var hostProposals []types.HostProposal
+	pageRes, err := query.Paginate(store, request.Pagination, func(_, value []byte) error {
+		var hostProposal types.HostProposal
+		if err := k.cdc.Unmarshal(value, &hostProposal); err != nil {
+			return err
+		}
+		hostProposals = append(hostProposals, hostProposal)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryHostProposalsResponse{
+		HostProposals: hostProposals,
+		Pagination:    pageRes,
+	}, nil
+}
+
+// HostProposal queries a host chain proposal registered for vote signals
+func (k Keeper) HostProposal(c context.Context, request *types.QueryHostProposalRequest) (*types.QueryHostProposalResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	hostProposal, found := k.GetHostProposal(ctx, request.ProposalId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "host proposal %d not found", request.ProposalId)
+	}
+
+	return &types.QueryHostProposalResponse{
+		HostProposal: hostProposal,
+	}, nil
+}
+
+// HostProposalVotes queries the vote signals on a host chain proposal
+func (k Keeper) HostProposalVotes(c context.Context, request *types.QueryHostProposalVotesRequest) (*types.QueryHostProposalVotesResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetHostProposalVotesKey(request.ProposalId))
+	var votes []types.HostProposalVote
+	pageRes, err := query.Paginate(store, request.Pagination, func(_, value []byte) error {
+		var vote types.HostProposalVote
+		if err := k.cdc.Unmarshal(value, &vote); err != nil {
+			return err
+		}
+		votes = append(votes, vote)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryHostProposalVotesResponse{
+		Votes:      votes,
+		Pagination: pageRes,
 	}, nil
 }
 
@@ -74,7 +230,8 @@ func (k Keeper) IBCTransientStore(c context.Context, request *types.QueryIBCTran
 	}, nil
 }
 
-// Unclaimed queries the unclaimed entries corresponding to the input delegator address in types.QueryUnclaimedRequest
+// Unclaimed queries the unclaimed entries corresponding to the input delegator address in
+// types.QueryUnclaimedRequest
 func (k Keeper) Unclaimed(c context.Context, request *types.QueryUnclaimedRequest) (*types.QueryUnclaimedResponse, error) {
 	if request == nil {
 		return nil, status.Error(codes.InvalidArgument, "empty request")
@@ -91,24 +248,21 @@ func (k Keeper) Unclaimed(c context.Context, request *types.QueryUnclaimedReques
 		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", err.Error())
 	}
 
-	var queryResponse types.QueryUnclaimedResponse
-
-	delegatorUnbondingEpochEntries := k.IterateDelegatorUnbondingEpochEntry(ctx, delegatorAddress)
-	for _, entry := range delegatorUnbondingEpochEntries {
-		unbondingEpochCValue := k.GetUnbondingEpochCValue(ctx, entry.EpochNumber)
-
-		// sort for all the cases
-		if unbondingEpochCValue.IsMatured && unbondingEpochCValue.EpochNumber > 0 {
-			// append to ready to claim entries
-			queryResponse.Unclaimed = append(queryResponse.Unclaimed, unbondingEpochCValue)
-		}
+	unclaimed, pageRes, err := k.paginateDelegatorUnbondings(ctx, delegatorAddress, request.Pagination,
+		func(unbondingEpochCValue types.UnbondingEpochCValue) bool {
+			// ready to claim entries
+			return unbondingEpochCValue.IsMatured
+		},
+	)
+	if err != nil {
+		return nil, err
 	}
 
-	return &queryResponse, nil
+	return &types.QueryUnclaimedResponse{Unclaimed: unclaimed, Pagination: pageRes}, nil
 }
 
 // FailedUnbondings queries the failed unbonding entries corresponding to the input delegator address in
-// types.QueryUnclaimedRequest
+// types.QueryFailedUnbondingsRequest
 func (k Keeper) FailedUnbondings(c context.Context, request *types.QueryFailedUnbondingsRequest) (*types.QueryFailedUnbondingsResponse, error) {
 	if request == nil {
 		return nil, status.Error(codes.InvalidArgument, "empty request")
@@ -125,22 +279,21 @@ func (k Keeper) FailedUnbondings(c context.Context, request *types.QueryFailedUn
 		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", err.Error())
 	}
 
-	var queryResponse types.QueryFailedUnbondingsResponse
-
-	delegatorUnbondingEpochEntries := k.IterateDelegatorUnbondingEpochEntry(ctx, delegatorAddress)
-	for _, entry := range delegatorUnbondingEpochEntries {
-		unbondingEpochCValue := k.GetUnbondingEpochCValue(ctx, entry.EpochNumber)
-		if unbondingEpochCValue.IsFailed && unbondingEpochCValue.EpochNumber > 0 {
-			// append to failed entries for which stkAtom should be claimed again
-			queryResponse.FailedUnbondings = append(queryResponse.FailedUnbondings, unbondingEpochCValue)
-		}
+	failedUnbondings, pageRes, err := k.paginateDelegatorUnbondings(ctx, delegatorAddress, request.Pagination,
+		func(unbondingEpochCValue types.UnbondingEpochCValue) bool {
+			// failed entries for which stkAtom should be claimed again
+			return unbondingEpochCValue.IsFailed
+		},
+	)
+	if err != nil {
+		return nil, err
 	}
 
-	return &queryResponse, nil
+	return &types.QueryFailedUnbondingsResponse{FailedUnbondings: failedUnbondings, Pagination: pageRes}, nil
 }
 
 // PendingUnbondings queries the pending unbonding entries corresponding to the input delegator address in
-// types.QueryUnclaimedRequest
+// types.QueryPendingUnbondingsRequest
 func (k Keeper) PendingUnbondings(c context.Context, request *types.QueryPendingUnbondingsRequest) (*types.QueryPendingUnbondingsResponse, error) {
 	if request == nil {
 		return nil, status.Error(codes.InvalidArgument, "empty request")
@@ -157,18 +310,17 @@ func (k Keeper) PendingUnbondings(c context.Context, request *types.QueryPending
 		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", err.Error())
 	}
 
-	var queryResponse types.QueryPendingUnbondingsResponse
-
-	delegatorUnbondingEpochEntries := k.IterateDelegatorUnbondingEpochEntry(ctx, delegatorAddress)
-	for _, entry := range delegatorUnbondingEpochEntries {
-		unbondingEpochCValue := k.GetUnbondingEpochCValue(ctx, entry.EpochNumber)
-		if !unbondingEpochCValue.IsFailed && !unbondingEpochCValue.IsMatured && unbondingEpochCValue.EpochNumber > 0 {
-			// append to in progress entries
-			queryResponse.PendingUnbondings = append(queryResponse.PendingUnbondings, unbondingEpochCValue)
-		}
+	pendingUnbondings, pageRes, err := k.paginateDelegatorUnbondings(ctx, delegatorAddress, request.Pagination,
+		func(unbondingEpochCValue types.UnbondingEpochCValue) bool {
+			// in progress entries
+			return !unbondingEpochCValue.IsFailed && !unbondingEpochCValue.IsMatured
+		},
+	)
+	if err != nil {
+		return nil, err
 	}
 
-	return &queryResponse, nil
+	return &types.QueryPendingUnbondingsResponse{PendingUnbondings: pendingUnbondings, Pagination: pageRes}, nil
 }
 
 // UnbondingEpochCValue queries the unbonding epoch c value details corresponding to the input epoch number
@@ -268,7 +420,292 @@ func (k Keeper) DelegatorUnbondingEpochEntries(c context.Context, request *types
 		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", err.Error())
 	}
 
-	list := k.IterateDelegatorUnbondingEpochEntry(ctx, delegatorAddress)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetPartialDelegatorUnbondingEpochEntryKey(delegatorAddress))
+	var list []types.DelegatorUnbondingEpochEntry
+	pageRes, err := query.Paginate(store, request.Pagination, func(_, value []byte) error {
+		var entry types.DelegatorUnbondingEpochEntry
+		if err := k.cdc.Unmarshal(value, &entry); err != nil {
+			return err
+		}
+		list = append(list, entry)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllDelegatorUnbondingEpochEntriesResponse{DelegatorUnbondingEpochEntries: list, Pagination: pageRes}, nil
+}
+
+// UnbondingEpochCValues queries the unbonding epoch c values of the epochs between the start and end epochs in
+// types.QueryUnbondingEpochCValuesRequest, both included
+func (k Keeper) UnbondingEpochCValues(c context.Context, request *types.QueryUnbondingEpochCValuesRequest) (*types.QueryUnbondingEpochCValuesResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if err := validateEpochRange(request.StartEpoch, request.EndEpoch); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	list := k.IterateUnbondingEpochCValuesInRange(ctx, request.StartEpoch, request.EndEpoch)
+
+	return &types.QueryUnbondingEpochCValuesResponse{UnbondingEpochCValues: list}, nil
+}
+
+// DelegatorUnbondingEpochEntriesInRange queries the unbonding epoch entries of the delegator address for the
+// epochs between the start and end epochs in types.QueryDelegatorUnbondingEpochEntriesInRangeRequest, both included
+func (k Keeper) DelegatorUnbondingEpochEntriesInRange(c context.Context, request *types.QueryDelegatorUnbondingEpochEntriesInRangeRequest) (*types.QueryDelegatorUnbondingEpochEntriesInRangeResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if request.DelegatorAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "address cannot be empty")
+	}
+	if err := validateEpochRange(request.StartEpoch, request.EndEpoch); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	delegatorAddress, err := sdk.AccAddressFromBech32(request.DelegatorAddress)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", err.Error())
+	}
+
+	list := k.IterateDelegatorUnbondingEpochEntriesInRange(ctx, delegatorAddress, request.StartEpoch, request.EndEpoch)
+
+	return &types.QueryDelegatorUnbondingEpochEntriesInRangeResponse{DelegatorUnbondingEpochEntries: list}, nil
+}
+
+// validateEpochRange returns an InvalidArgument error if the epoch range of a query is not valid
+func validateEpochRange(startEpoch, endEpoch int64) error {
+	if startEpoch <= 0 {
+		return status.Error(codes.InvalidArgument, "start epoch less than equal to 0")
+	}
+	if endEpoch < startEpoch {
+		return status.Error(codes.InvalidArgument, "end epoch less than start epoch")
+	}
+	return nil
+}
+
+// HostAccountDelegations queries the host account delegations of all the validators, ordered by validator address
+func (k Keeper) HostAccountDelegations(c context.Context, request *types.QueryHostAccountDelegationsRequest) (*types.QueryHostAccountDelegationsResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.HostAccountDelegationKey)
+	var delegations []types.HostAccountDelegation
+	pageRes, err := query.Paginate(store, request.Pagination, func(_, value []byte) error {
+		var delegation types.HostAccountDelegation
+		if err := k.cdc.Unmarshal(value, &delegation); err != nil {
+			return err
+		}
+		delegations = append(delegations, delegation)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryHostAccountDelegationsResponse{HostAccountDelegations: delegations, Pagination: pageRes}, nil
+}
+
+// HostAccountDelegation queries the host account delegation of the validator address in
+// types.QueryHostAccountDelegationRequest
+func (k Keeper) HostAccountDelegation(c context.Context, request *types.QueryHostAccountDelegationRequest) (*types.QueryHostAccountDelegationResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if request.ValidatorAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "validator address cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	delegation := k.GetHostAccountDelegation(ctx, request.ValidatorAddress)
+	if delegation.ValidatorAddress == "" {
+		return nil, status.Errorf(codes.NotFound, "no delegation found for validator %s", request.ValidatorAddress)
+	}
+
+	return &types.QueryHostAccountDelegationResponse{HostAccountDelegation: delegation}, nil
+}
+
+// UnbondingEpochDelegatorEntries queries the unbonding epoch entries of all the delegators for the epoch number in
+// types.QueryUnbondingEpochDelegatorEntriesRequest, paginating over the delegators indexed for the epoch.
+func (k Keeper) UnbondingEpochDelegatorEntries(c context.Context, request *types.QueryUnbondingEpochDelegatorEntriesRequest) (*types.QueryUnbondingEpochDelegatorEntriesResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if request.EpochNumber <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "epoch number less than equal to 0")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetUnbondingEpochDelegatorsKey(request.EpochNumber))
+	var list []types.DelegatorUnbondingEpochEntry
+	pageRes, err := query.Paginate(store, request.Pagination, func(key, _ []byte) error {
+		// strip the address length prefix
+		list = append(list, k.GetDelegatorUnbondingEpochEntry(ctx, sdk.AccAddress(key[1:]), request.EpochNumber))
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryUnbondingEpochDelegatorEntriesResponse{DelegatorUnbondingEpochEntries: list, Pagination: pageRes}, nil
+}
+
+// RemainingCapacity queries the amount that can still be deposited before any of the deposit caps is hit,
+// the per address deposit cap is only considered if a delegator address is set in the request
+func (k Keeper) RemainingCapacity(c context.Context, request *types.QueryRemainingCapacityRequest) (*types.QueryRemainingCapacityResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var delegatorAddress sdk.AccAddress
+	addressDeposits := sdk.ZeroInt()
+	if request.DelegatorAddress != "" {
+		var err error
+		delegatorAddress, err = sdk.AccAddressFromBech32(request.DelegatorAddress)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", err.Error())
+		}
+		addressDeposits = k.GetAddressDeposits(ctx, delegatorAddress)
+	}
+
+	remaining, limited := k.GetRemainingDepositCapacity(ctx, delegatorAddress)
+
+	return &types.QueryRemainingCapacityResponse{
+		Remaining:       remaining,
+		Limited:         limited,
+		Tvl:             k.GetTotalValueLocked(ctx),
+		EpochDeposits:   k.GetEpochDeposits(ctx).Amount,
+		AddressDeposits: addressDeposits,
+	}, nil
+}
+
+// FeeSplit queries the protocol fee split
+func (k Keeper) FeeSplit(c context.Context, request *types.QueryFeeSplitRequest) (*types.QueryFeeSplitResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryFeeSplitResponse{FeeSplit: k.GetFeeSplit(ctx)}, nil
+}
+
+// CollectedFees queries the cumulative protocol fees sent to every recipient for the fee type in
+// types.QueryCollectedFeesRequest, all fee types are returned if it is empty
+func (k Keeper) CollectedFees(c context.Context, request *types.QueryCollectedFeesRequest) (*types.QueryCollectedFeesResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryCollectedFeesResponse{CollectedFees: k.IterateCollectedFees(ctx, request.FeeType)}, nil
+}
+
+// Roles queries the module roles and the admin change waiting for the admin timelock
+func (k Keeper) Roles(c context.Context, request *types.QueryRolesRequest) (*types.QueryRolesResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
 
-	return &types.QueryAllDelegatorUnbondingEpochEntriesResponse{DelegatorUnbondingEpochEntries: list}, nil
+	return &types.QueryRolesResponse{
+		Roles: types.Roles{
+			Admins:            k.GetAdmins(ctx),
+			Pausers:           k.GetPausers(ctx),
+			SlashingReporters: k.GetSlashingReporters(ctx),
+		},
+		PendingAdminChange: k.GetPendingAdminChange(ctx),
+	}, nil
+}
+
+// ModuleStatus queries the module state and all the pause switches
+func (k Keeper) ModuleStatus(c context.Context, request *types.QueryModuleStatusRequest) (*types.QueryModuleStatusResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryModuleStatusResponse{
+		ModuleEnabled: k.GetModuleState(ctx),
+		PauseSwitches: k.GetPauseSwitches(ctx),
+	}, nil
+}
+
+// paginateDelegatorUnbondings returns the page of the unbonding epoch c values of the epochs the delegator address has
+// unbonding epoch entries for, only the unbonding epoch c values matching filter are counted
+func (k Keeper) paginateDelegatorUnbondings(
+	ctx sdk.Context, delegatorAddress sdk.AccAddress, pageRequest *query.PageRequest,
+	filter func(types.UnbondingEpochCValue) bool,
+) ([]types.UnbondingEpochCValue, *query.PageResponse, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetPartialDelegatorUnbondingEpochEntryKey(delegatorAddress))
+
+	var unbondingEpochCValues []types.UnbondingEpochCValue
+	pageRes, err := query.FilteredPaginate(store, pageRequest, func(key, _ []byte, accumulate bool) (bool, error) {
+		unbondingEpochCValue := k.GetUnbondingEpochCValue(ctx, types.ParseEpochNumberBytes(key))
+		if unbondingEpochCValue.EpochNumber <= 0 || !filter(unbondingEpochCValue) {
+			return false, nil
+		}
+		if accumulate {
+			unbondingEpochCValues = append(unbondingEpochCValues, unbondingEpochCValue)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return unbondingEpochCValues, pageRes, nil
+}
+
+// paginateSlice returns the bounds of the page of a list of length items for the page request, only offset based
+// pagination is supported
+func paginateSlice(length int, pageRequest *query.PageRequest) (start, end int, pageResponse *query.PageResponse, err error) {
+	if pageRequest == nil {
+		pageRequest = &query.PageRequest{}
+	}
+	if len(pageRequest.Key) != 0 {
+		return 0, 0, nil, status.Error(codes.InvalidArgument, "key based pagination is not supported")
+	}
+	if pageRequest.Reverse {
+		return 0, 0, nil, status.Error(codes.InvalidArgument, "reverse pagination is not supported")
+	}
+
+	limit := pageRequest.Limit
+	countTotal := pageRequest.CountTotal
+	if limit == 0 {
+		limit = query.DefaultLimit
+		countTotal = true
+	}
+
+	total := uint64(length)
+	offset := pageRequest.Offset
+	if offset > total {
+		offset = total
+	}
+	last := total
+	if limit < total-offset {
+		last = offset + limit
+	}
+
+	pageResponse = &query.PageResponse{}
+	if countTotal {
+		pageResponse.Total = total
+	}
+	return int(offset), int(last), pageResponse, nil
 }
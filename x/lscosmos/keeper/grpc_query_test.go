@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/persistenceOne/pstake-native/v2/x/lscosmos/types"
 )
@@ -27,11 +28,11 @@ func (suite *IntegrationTestSuite) TestQueryDelegationState() {
 		HostChainDelegationAddress:   "address________________",
 		HostAccountDelegations: []types.HostAccountDelegation{
 			{
-				ValidatorAddress: "address________________",
+				ValidatorAddress: "validator1______________",
 				Amount:           sdk.NewInt64Coin(baseDenom, 25),
 			},
 			{
-				ValidatorAddress: "address________________",
+				ValidatorAddress: "validator2______________",
 				Amount:           sdk.NewInt64Coin(baseDenom, 75),
 			},
 		},
@@ -44,7 +45,45 @@ func (suite *IntegrationTestSuite) TestQueryDelegationState() {
 
 	res, err := qrysrv.DelegationState(c, &types.QueryDelegationStateRequest{})
 	suite.NoError(err)
-	suite.Equal(&types.QueryDelegationStateResponse{DelegationState: delegationState}, res)
+	suite.Equal(delegationState, res.DelegationState)
+
+	res, err = qrysrv.DelegationState(c, &types.QueryDelegationStateRequest{Pagination: &query.PageRequest{Limit: 1}})
+	suite.NoError(err)
+	suite.Equal(delegationState.HostAccountDelegations[:1], res.DelegationState.HostAccountDelegations)
+	suite.Equal(delegationState.HostDelegationAccountBalance, res.DelegationState.HostDelegationAccountBalance)
+	suite.NotNil(res.Pagination.NextKey)
+}
+
+func (suite *IntegrationTestSuite) TestQueryHostAccountDelegations() {
+	app, ctx := suite.app, suite.ctx
+
+	baseDenom := app.LSCosmosKeeper.GetHostChainParams(ctx).BaseDenom
+	validators := []string{"validator1______________", "validator2______________", "validator3______________"}
+	for i, validator := range validators {
+		app.LSCosmosKeeper.AddHostAccountDelegation(ctx, types.NewHostAccountDelegation(validator, sdk.NewInt64Coin(baseDenom, int64(i+1))))
+	}
+
+	c := sdk.WrapSDKContext(ctx)
+
+	qrysrv := types.QueryServer(app.LSCosmosKeeper)
+
+	res, err := qrysrv.HostAccountDelegations(c, &types.QueryHostAccountDelegationsRequest{Pagination: &query.PageRequest{Limit: 2, CountTotal: true}})
+	suite.NoError(err)
+	suite.Len(res.HostAccountDelegations, 2)
+	suite.Equal(validators[0], res.HostAccountDelegations[0].ValidatorAddress)
+	suite.Equal(uint64(3), res.Pagination.Total)
+
+	res, err = qrysrv.HostAccountDelegations(c, &types.QueryHostAccountDelegationsRequest{Pagination: &query.PageRequest{Key: res.Pagination.NextKey}})
+	suite.NoError(err)
+	suite.Len(res.HostAccountDelegations, 1)
+	suite.Equal(validators[2], res.HostAccountDelegations[0].ValidatorAddress)
+
+	delegationRes, err := qrysrv.HostAccountDelegation(c, &types.QueryHostAccountDelegationRequest{ValidatorAddress: validators[1]})
+	suite.NoError(err)
+	suite.Equal(sdk.NewInt64Coin(baseDenom, 2), delegationRes.HostAccountDelegation.Amount)
+
+	_, err = qrysrv.HostAccountDelegation(c, &types.QueryHostAccountDelegationRequest{ValidatorAddress: "validator4______________"})
+	suite.Error(err)
 }
 
 func (suite *IntegrationTestSuite) TestQueryAllowListedValidators() {
@@ -56,7 +95,19 @@ func (suite *IntegrationTestSuite) TestQueryAllowListedValidators() {
 
 	res, err := qrysrv.AllowListedValidators(c, &types.QueryAllowListedValidatorsRequest{})
 	suite.NoError(err)
-	suite.Equal(&types.QueryAllowListedValidatorsResponse{AllowListedValidators: allowListedValidators}, res)
+	suite.Equal(allowListedValidators, res.AllowListedValidators)
+	suite.Equal(uint64(3), res.Pagination.Total)
+
+	res, err = qrysrv.AllowListedValidators(c, &types.QueryAllowListedValidatorsRequest{Pagination: &query.PageRequest{Offset: 1, Limit: 1}})
+	suite.NoError(err)
+	suite.Equal(allowListedValidators.AllowListedValidators[1:2], res.AllowListedValidators.AllowListedValidators)
+
+	res, err = qrysrv.AllowListedValidators(c, &types.QueryAllowListedValidatorsRequest{Pagination: &query.PageRequest{Offset: 5}})
+	suite.NoError(err)
+	suite.Empty(res.AllowListedValidators.AllowListedValidators)
+
+	_, err = qrysrv.AllowListedValidators(c, &types.QueryAllowListedValidatorsRequest{Pagination: &query.PageRequest{Key: []byte{0x01}}})
+	suite.Error(err)
 }
 
 func (suite *IntegrationTestSuite) TestQueryCValue() {
@@ -88,3 +139,140 @@ func (suite *IntegrationTestSuite) TestQueryModuleState() {
 	suite.NoError(err)
 	suite.Equal(&types.QueryModuleStateResponse{ModuleState: true}, res)
 }
+
+func (suite *IntegrationTestSuite) TestQueryEpochRanges() {
+	app, ctx := suite.app, suite.ctx
+
+	c := sdk.WrapSDKContext(ctx)
+
+	qrysrv := types.QueryServer(app.LSCosmosKeeper)
+
+	delegator, err := sdk.AccAddressFromBech32("persistence1826wkxx8wv7mfnank8l6xu9rxm7kg8rvvk4e0a")
+	suite.NoError(err)
+
+	// epochs 4, 8 and 12 sort lexicographically as "12" < "4" < "8" with decimal keys
+	for _, epochNumber := range []int64{12, 4, 8} {
+		app.LSCosmosKeeper.SetUnbondingEpochCValue(ctx, types.UnbondingEpochCValue{
+			EpochNumber:    epochNumber,
+			STKBurn:        sdk.NewInt64Coin("stkAtom", epochNumber),
+			AmountUnbonded: sdk.NewInt64Coin("uatom", epochNumber),
+		})
+		app.LSCosmosKeeper.AddDelegatorUnbondingEpochEntry(ctx, delegator, epochNumber, sdk.NewInt64Coin("stkAtom", epochNumber))
+	}
+
+	res, err := qrysrv.UnbondingEpochCValues(c, &types.QueryUnbondingEpochCValuesRequest{StartEpoch: 4, EndEpoch: 12})
+	suite.NoError(err)
+	suite.Len(res.UnbondingEpochCValues, 3)
+	for i, epochNumber := range []int64{4, 8, 12} {
+		suite.Equal(epochNumber, res.UnbondingEpochCValues[i].EpochNumber)
+	}
+
+	res, err = qrysrv.UnbondingEpochCValues(c, &types.QueryUnbondingEpochCValuesRequest{StartEpoch: 5, EndEpoch: 11})
+	suite.NoError(err)
+	suite.Len(res.UnbondingEpochCValues, 1)
+	suite.Equal(int64(8), res.UnbondingEpochCValues[0].EpochNumber)
+
+	entriesRes, err := qrysrv.DelegatorUnbondingEpochEntriesInRange(c, &types.QueryDelegatorUnbondingEpochEntriesInRangeRequest{
+		DelegatorAddress: delegator.String(),
+		StartEpoch:       8,
+		EndEpoch:         100,
+	})
+	suite.NoError(err)
+	suite.Len(entriesRes.DelegatorUnbondingEpochEntries, 2)
+	suite.Equal(int64(8), entriesRes.DelegatorUnbondingEpochEntries[0].EpochNumber)
+	suite.Equal(int64(12), entriesRes.DelegatorUnbondingEpochEntries[1].EpochNumber)
+
+	_, err = qrysrv.UnbondingEpochCValues(c, &types.QueryUnbondingEpochCValuesRequest{StartEpoch: 0, EndEpoch: 12})
+	suite.Error(err)
+	_, err = qrysrv.DelegatorUnbondingEpochEntriesInRange(c, &types.QueryDelegatorUnbondingEpochEntriesInRangeRequest{
+		DelegatorAddress: delegator.String(),
+		StartEpoch:       12,
+		EndEpoch:         8,
+	})
+	suite.Error(err)
+}
+
+func (suite *IntegrationTestSuite) TestQueryDelegatorUnbondingsPagination() {
+	app, ctx := suite.app, suite.ctx
+
+	c := sdk.WrapSDKContext(ctx)
+
+	qrysrv := types.QueryServer(app.LSCosmosKeeper)
+
+	delegator, err := sdk.AccAddressFromBech32("persistence1826wkxx8wv7mfnank8l6xu9rxm7kg8rvvk4e0a")
+	suite.NoError(err)
+	otherDelegator := sdk.AccAddress("other_delegator_____")
+
+	// epochs 4, 8 and 12 are matured, 16 failed and 20 in progress
+	for _, epochNumber := range []int64{4, 8, 12, 16, 20} {
+		app.LSCosmosKeeper.SetUnbondingEpochCValue(ctx, types.UnbondingEpochCValue{
+			EpochNumber:    epochNumber,
+			STKBurn:        sdk.NewInt64Coin("stkAtom", epochNumber),
+			AmountUnbonded: sdk.NewInt64Coin("uatom", epochNumber),
+			IsMatured:      epochNumber <= 12,
+			IsFailed:       epochNumber == 16,
+		})
+		app.LSCosmosKeeper.AddDelegatorUnbondingEpochEntry(ctx, delegator, epochNumber, sdk.NewInt64Coin("stkAtom", epochNumber))
+	}
+	app.LSCosmosKeeper.AddDelegatorUnbondingEpochEntry(ctx, otherDelegator, 8, sdk.NewInt64Coin("stkAtom", 1))
+
+	unclaimedRes, err := qrysrv.Unclaimed(c, &types.QueryUnclaimedRequest{
+		DelegatorAddress: delegator.String(),
+		Pagination:       &query.PageRequest{Limit: 2, CountTotal: true},
+	})
+	suite.NoError(err)
+	suite.Len(unclaimedRes.Unclaimed, 2)
+	suite.Equal(int64(4), unclaimedRes.Unclaimed[0].EpochNumber)
+	suite.Equal(uint64(3), unclaimedRes.Pagination.Total)
+
+	unclaimedRes, err = qrysrv.Unclaimed(c, &types.QueryUnclaimedRequest{
+		DelegatorAddress: delegator.String(),
+		Pagination:       &query.PageRequest{Key: unclaimedRes.Pagination.NextKey},
+	})
+	suite.NoError(err)
+	suite.Len(unclaimedRes.Unclaimed, 1)
+	suite.Equal(int64(12), unclaimedRes.Unclaimed[0].EpochNumber)
+
+	failedRes, err := qrysrv.FailedUnbondings(c, &types.QueryFailedUnbondingsRequest{DelegatorAddress: delegator.String()})
+	suite.NoError(err)
+	suite.Len(failedRes.FailedUnbondings, 1)
+	suite.Equal(int64(16), failedRes.FailedUnbondings[0].EpochNumber)
+
+	pendingRes, err := qrysrv.PendingUnbondings(c, &types.QueryPendingUnbondingsRequest{DelegatorAddress: delegator.String()})
+	suite.NoError(err)
+	suite.Len(pendingRes.PendingUnbondings, 1)
+	suite.Equal(int64(20), pendingRes.PendingUnbondings[0].EpochNumber)
+
+	entriesRes, err := qrysrv.DelegatorUnbondingEpochEntries(c, &types.QueryAllDelegatorUnbondingEpochEntriesRequest{
+		DelegatorAddress: delegator.String(),
+		Pagination:       &query.PageRequest{Offset: 3, CountTotal: true},
+	})
+	suite.NoError(err)
+	suite.Len(entriesRes.DelegatorUnbondingEpochEntries, 2)
+	suite.Equal(int64(16), entriesRes.DelegatorUnbondingEpochEntries[0].EpochNumber)
+	suite.Equal(uint64(5), entriesRes.Pagination.Total)
+
+	epochRes, err := qrysrv.UnbondingEpochDelegatorEntries(c, &types.QueryUnbondingEpochDelegatorEntriesRequest{
+		EpochNumber: 8,
+		Pagination:  &query.PageRequest{Limit: 1},
+	})
+	suite.NoError(err)
+	suite.Len(epochRes.DelegatorUnbondingEpochEntries, 1)
+	suite.NotNil(epochRes.Pagination.NextKey)
+	epochEntries := epochRes.DelegatorUnbondingEpochEntries
+
+	epochRes, err = qrysrv.UnbondingEpochDelegatorEntries(c, &types.QueryUnbondingEpochDelegatorEntriesRequest{
+		EpochNumber: 8,
+		Pagination:  &query.PageRequest{Key: epochRes.Pagination.NextKey},
+	})
+	suite.NoError(err)
+	suite.Nil(epochRes.Pagination.NextKey)
+	epochEntries = append(epochEntries, epochRes.DelegatorUnbondingEpochEntries...)
+	suite.ElementsMatch([]types.DelegatorUnbondingEpochEntry{
+		types.NewDelegatorUnbondingEpochEntry(delegator.String(), 8, sdk.NewInt64Coin("stkAtom", 8)),
+		types.NewDelegatorUnbondingEpochEntry(otherDelegator.String(), 8, sdk.NewInt64Coin("stkAtom", 1)),
+	}, epochEntries)
+
+	_, err = qrysrv.UnbondingEpochDelegatorEntries(c, &types.QueryUnbondingEpochDelegatorEntriesRequest{EpochNumber: 0})
+	suite.Error(err)
+}
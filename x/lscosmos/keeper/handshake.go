@@ -9,6 +9,7 @@ import (
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
 	distributiontypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	icatypes "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/types"
 	ibctransfertypes "github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
@@ -107,7 +108,7 @@ func (k Keeper) OnChanOpenAck(
 		}
 		if portID == hostAccounts.RewardsAccountPortID() {
 			rewardAddress, rewardAddrFound := k.icaControllerKeeper.GetInterchainAccountAddress(ctx, hostChainParams.ConnectionID, hostAccounts.RewardsAccountPortID())
-			delegationAddress := k.GetDelegationState(ctx).HostChainDelegationAddress
+			delegationAddress := k.GetHostChainDelegationAddress(ctx)
 			if rewardAddrFound {
 				_ = k.SetHostChainRewardAddressIfEmpty(ctx, types.NewHostChainRewardAddress(rewardAddress))
 				setWithdrawAddrMsg := &distributiontypes.MsgSetWithdrawAddress{
@@ -335,6 +336,10 @@ func (k Keeper) handleSuccessfulAck(ctx sdk.Context, ack channeltypes.Acknowledg
 					return err
 				}
 				k.UpdateCompletionTimeForUndelegationEpoch(ctx, previousEpochNumber, msgUndelegateResponse.CompletionTime.Add(types.UndelegationCompletionTimeBuffer))
+			case sdk.MsgTypeURL(&banktypes.MsgSend{}):
+				if k.isRewardsAccountSend(ctx, msgs[0]) {
+					k.RecordPendingRewards(ctx, true)
+				}
 			default:
 
 			}
@@ -391,7 +396,7 @@ func (k Keeper) handleAckMsgData(ctx sdk.Context, data []byte, msg sdk.Msg, host
 		}
 		//is from rewardaddr to delegationaddr?
 		rewardAddress := k.GetHostChainRewardAddress(ctx)
-		delegationState := k.GetDelegationState(ctx)
+		delegationState := k.getDelegationAccountState(ctx)
 		if rewardAddress.Address == parsedMsg.FromAddress && delegationState.HostChainDelegationAddress == parsedMsg.ToAddress {
 			amountOfBaseDenom := parsedMsg.Amount.AmountOf(hostChainParams.BaseDenom)
 			if amountOfBaseDenom.GT(sdk.ZeroInt()) {
@@ -409,7 +414,7 @@ func (k Keeper) handleAckMsgData(ctx sdk.Context, data []byte, msg sdk.Msg, host
 				}
 
 				//Send protocol fee to protocol pool
-				err = k.SendProtocolFee(ctx, sdk.NewCoins(protocolFee), types.ModuleName, hostChainParams.PstakeParams.PstakeFeeAddress)
+				err = k.SendProtocolFee(ctx, sdk.NewCoins(protocolFee), types.ModuleName, types.FeeTypeRestake)
 				if err != nil {
 					return "", types.ErrFailedDeposit
 				}
@@ -441,6 +446,17 @@ func (k Keeper) handleAckMsgData(ctx sdk.Context, data []byte, msg sdk.Msg, host
 		k.Logger(ctx).Info(fmt.Sprintf("Initiated IBC transfer from %s to %s with msg: %s", hostChainParams.ChainID, ctx.ChainID(), msg))
 		// handle rest in ibc hooks.
 		return msgResponse.String(), nil
+	case sdk.MsgTypeURL(&govtypes.MsgVoteWeighted{}):
+		parsedMsg, ok := msg.(*govtypes.MsgVoteWeighted)
+		if !ok {
+			return "", errorsmod.Wrapf(sdkerrors.ErrInvalidType, "unable to unmarshal msg of type %s", sdk.MsgTypeURL(msg))
+		}
+		var msgResponse govtypes.MsgVoteWeightedResponse
+		if err := k.cdc.Unmarshal(data, &msgResponse); err != nil {
+			return "", errorsmod.Wrapf(sdkerrors.ErrJSONUnmarshal, "cannot unmarshal vote weighted response message: %s", err.Error())
+		}
+		k.handleHostVoteResult(ctx, parsedMsg.ProposalId, types.HOST_PROPOSAL_STATUS_VOTED)
+		return msgResponse.String(), nil
 
 	default:
 		return "", nil
@@ -476,6 +492,10 @@ func (k Keeper) resetToPreICATx(ctx sdk.Context, icaPacket icatypes.InterchainAc
 			k.FailUnbondingEpochCValue(ctx, previousEpochNumber, sdk.NewCoin(hostChainParams.MintDenom, sdk.ZeroInt()))
 			k.Logger(ctx).Info(fmt.Sprintf("Failed unbonding msgs: %s, for undelegationEpoch: %v", msgs, previousEpochNumber))
 		}
+		if len(msgs) == msgsCount && expectedMsgType == sdk.MsgTypeURL(&banktypes.MsgSend{}) && k.isRewardsAccountSend(ctx, msg) {
+			// the rewards are still in the rewards account
+			k.RecordPendingRewards(ctx, false)
+		}
 
 		k.Logger(ctx).Info("ICA msg timed out, ", "msg", msg)
 	}
@@ -487,6 +507,12 @@ func (k Keeper) resetToPreICATx(ctx sdk.Context, icaPacket icatypes.InterchainAc
 	return nil
 }
 
+// isRewardsAccountSend returns true if the msg is a send from the host chain rewards account
+func (k Keeper) isRewardsAccountSend(ctx sdk.Context, msg sdk.Msg) bool {
+	msgSend, ok := msg.(*banktypes.MsgSend)
+	return ok && msgSend.FromAddress == k.GetHostChainRewardAddress(ctx).Address
+}
+
 // handleResetMsgs is a helper function for handling reset messages in resetToPreICATx
 func (k Keeper) handleResetMsgs(ctx sdk.Context, msg sdk.Msg, _ types.HostChainParams) error {
 	switch sdk.MsgTypeURL(msg) {
@@ -515,6 +541,14 @@ func (k Keeper) handleResetMsgs(ctx sdk.Context, msg sdk.Msg, _ types.HostChainP
 			UndelegationEntries:     nil,
 		})
 
+		return nil
+	case sdk.MsgTypeURL(&govtypes.MsgVoteWeighted{}):
+		parsedMsg, ok := msg.(*govtypes.MsgVoteWeighted)
+		if !ok {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidType, "unable to unmarshal msg of type %s", sdk.MsgTypeURL(msg))
+		}
+		// the vote cannot be resent as the voting period ends within the host vote buffer
+		k.handleHostVoteResult(ctx, parsedMsg.ProposalId, types.HOST_PROPOSAL_STATUS_FAILED)
 		return nil
 	default:
 		return nil
@@ -3,6 +3,8 @@ package keeper
 import (
 	"fmt"
 
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	distributiontypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
@@ -35,7 +37,7 @@ func (k Keeper) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumb
 	}
 	hostChainParams := k.GetHostChainParams(ctx)
 	k.Logger(ctx).Info(fmt.Sprintf("Starting AfterEndEpoch for epochIdentifier %s, epochNumber %v", epochIdentifier, epochNumber))
-	if epochIdentifier == lscosmostypes.DelegationEpochIdentifier {
+	if epochIdentifier == lscosmostypes.DelegationEpochIdentifier && !k.IsPaused(ctx, lscosmostypes.PauseSwitchDelegationEpoch) {
 		wrapperFn := func(ctx sdk.Context) error {
 			return k.DelegationEpochWorkFlow(ctx, hostChainParams)
 		}
@@ -44,7 +46,7 @@ func (k Keeper) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumb
 			k.Logger(ctx).Error("Failed DelegationEpochIdentifier Function with:", "err: ", err)
 		}
 	}
-	if epochIdentifier == lscosmostypes.RewardEpochIdentifier {
+	if epochIdentifier == lscosmostypes.RewardEpochIdentifier && !k.IsPaused(ctx, lscosmostypes.PauseSwitchRewardEpoch) {
 		wrapperFn := func(ctx sdk.Context) error {
 			return k.RewardEpochEpochWorkFlow(ctx, hostChainParams)
 		}
@@ -53,7 +55,28 @@ func (k Keeper) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumb
 			k.Logger(ctx).Error("Failed RewardEpochIdentifier Function with:", "err: ", err)
 		}
 	}
-	if epochIdentifier == lscosmostypes.UndelegationEpochIdentifier && epochNumber%lscosmostypes.UndelegationEpochNumberFactor == 0 {
+	if epochIdentifier == lscosmostypes.RewardEpochIdentifier {
+		wrapperFn := func(ctx sdk.Context) error {
+			return k.ValidatorWeightingWorkFlow(ctx, hostChainParams, epochNumber)
+		}
+		err := utils.ApplyFuncIfNoError(ctx, wrapperFn)
+		if err != nil {
+			k.Logger(ctx).Error("Failed ValidatorWeighting Function with:", "err: ", err)
+		}
+	}
+	if epochIdentifier == lscosmostypes.UndelegationEpochIdentifier && epochNumber%lscosmostypes.UndelegationEpochNumberFactor == 0 &&
+		k.IsPaused(ctx, lscosmostypes.PauseSwitchUndelegationEpoch) {
+		// a paused undelegation epoch is skipped, its undelegations are carried over to the next one
+		wrapperFn := func(ctx sdk.Context) error {
+			return k.CarryOverUndelegationEpoch(ctx, epochNumber)
+		}
+		err := utils.ApplyFuncIfNoError(ctx, wrapperFn)
+		if err != nil {
+			k.Logger(ctx).Error("Failed carrying over UndelegationEpochIdentifier with:", "err: ", err)
+		}
+	}
+	if epochIdentifier == lscosmostypes.UndelegationEpochIdentifier && epochNumber%lscosmostypes.UndelegationEpochNumberFactor == 0 &&
+		!k.IsPaused(ctx, lscosmostypes.PauseSwitchUndelegationEpoch) {
 		wrapperFn := func(ctx sdk.Context) error {
 			return k.UndelegationEpochWorkFlow(ctx, hostChainParams, epochNumber)
 		}
@@ -126,7 +149,7 @@ func (k Keeper) DelegationEpochWorkFlow(ctx sdk.Context, hostChainParams lscosmo
 	allDelegationBalances := k.bankKeeper.GetAllBalances(ctx, authtypes.NewModuleAddress(lscosmostypes.DelegationModuleAccount))
 	delegationBalance := sdk.NewCoin(ibcDenom, allDelegationBalances.AmountOf(ibcDenom))
 	if delegationBalance.IsPositive() && depositBalance.IsPositive() && delegationBalance.IsGTE(depositBalance) {
-		delegationState := k.GetDelegationState(ctx)
+		delegationState := k.getDelegationAccountState(ctx)
 		_, clientState, err := k.channelKeeper.GetChannelClientState(ctx, hostChainParams.TransferPort, hostChainParams.TransferChannel)
 		if err != nil {
 			k.Logger(ctx).Error(fmt.Sprintf("Error getting client state %s", err))
@@ -173,16 +196,17 @@ func (k Keeper) DelegationEpochWorkFlow(ctx sdk.Context, hostChainParams lscosmo
 // ICA transaction for claiming rewards
 func (k Keeper) RewardEpochEpochWorkFlow(ctx sdk.Context, hostChainParams lscosmostypes.HostChainParams) error {
 	// send withdraw rewards from delegators.
-	delegationState := k.GetDelegationState(ctx)
+	hostAccountDelegations := k.GetAllHostAccountDelegations(ctx)
 	hostAccounts := k.GetHostAccounts(ctx)
-	if len(delegationState.HostAccountDelegations) == 0 {
+	if len(hostAccountDelegations) == 0 {
 		//return early
 		return nil
 	}
-	withdrawRewardMsgs := make([]proto.Message, len(delegationState.HostAccountDelegations))
-	for i, delegation := range delegationState.HostAccountDelegations {
+	delegationAddress := k.GetHostChainDelegationAddress(ctx)
+	withdrawRewardMsgs := make([]proto.Message, len(hostAccountDelegations))
+	for i, delegation := range hostAccountDelegations {
 		withdrawRewardMsgs[i] = &distributiontypes.MsgWithdrawDelegatorReward{
-			DelegatorAddress: delegationState.HostChainDelegationAddress,
+			DelegatorAddress: delegationAddress,
 			ValidatorAddress: delegation.ValidatorAddress,
 		}
 	}
@@ -193,6 +217,42 @@ func (k Keeper) RewardEpochEpochWorkFlow(ctx sdk.Context, hostChainParams lscosm
 	// on Ack delegate txn
 }
 
+// CarryOverUndelegationEpoch moves the undelegations of an unbonding epoch and the unbonding entries of its
+// delegators to the next unbonding epoch, so they are undelegated once the undelegation epoch is resumed.
+func (k Keeper) CarryOverUndelegationEpoch(ctx sdk.Context, epochNumber int64) error {
+	hostAccountUndelegation, err := k.GetHostAccountUndelegationForEpoch(ctx, epochNumber)
+	if err != nil {
+		// nothing was unstaked during the epoch
+		return nil
+	}
+	if len(hostAccountUndelegation.UndelegationEntries) != 0 {
+		return errorsmod.Wrapf(lscosmostypes.ErrInvalidArgs, "undelegation epoch %d is already undelegated", epochNumber)
+	}
+	nextEpochNumber := epochNumber + lscosmostypes.UndelegationEpochNumberFactor
+
+	// collect the delegators first, the store can not be written while iterating
+	var delegatorAddresses []sdk.AccAddress
+	iterator := prefix.NewStore(ctx.KVStore(k.storeKey), lscosmostypes.GetUnbondingEpochDelegatorsKey(epochNumber)).Iterator(nil, nil)
+	for ; iterator.Valid(); iterator.Next() {
+		// strip the address length prefix
+		delegatorAddresses = append(delegatorAddresses, sdk.AccAddress(iterator.Key()[1:]))
+	}
+	iterator.Close()
+
+	for _, delegatorAddress := range delegatorAddresses {
+		unbondingEntry := k.GetDelegatorUnbondingEpochEntry(ctx, delegatorAddress, epochNumber)
+		k.RemoveDelegatorUnbondingEpochEntry(ctx, delegatorAddress, epochNumber)
+		k.AddDelegatorUnbondingEpochEntry(ctx, delegatorAddress, nextEpochNumber, unbondingEntry.Amount)
+	}
+	if err = k.RemoveHostAccountUndelegation(ctx, epochNumber); err != nil {
+		return err
+	}
+	k.AddTotalUndelegationForEpoch(ctx, nextEpochNumber, hostAccountUndelegation.TotalUndelegationAmount)
+
+	k.Logger(ctx).Info(fmt.Sprintf("Carried over undelegationEpoch: %v to undelegationEpoch: %v", epochNumber, nextEpochNumber))
+	return nil
+}
+
 // UndelegationEpochWorkFlow handles the undelegation epoch work flow :
 // 1. Fetches host account undelegations using in GetHostAccountUndelegationForEpoch
 // 2. Convert stk coin to token using ConvertStkToToken based on the current c value
@@ -220,7 +280,7 @@ func (k Keeper) UndelegationEpochWorkFlow(ctx sdk.Context, hostChainParams lscos
 	if len(allowListedValidators.AllowListedValidators) == 0 {
 		return lscosmostypes.ErrInValidAllowListedValidators
 	}
-	delegationState := k.GetDelegationState(ctx)
+	delegationState := k.getDelegationAccountState(ctx)
 	undelegateMsgs, undelegationEntries, err := k.UndelegateMsgs(ctx, amountToUnstake.Amount, hostChainParams.BaseDenom, delegationState)
 	if err != nil {
 		return err
@@ -260,7 +320,7 @@ func (k Keeper) OnRecvIBCTransferPacket(ctx sdk.Context, packet channeltypes.Pac
 		return err
 	}
 	hostChainParams := k.GetHostChainParams(ctx)
-	delegationState := k.GetDelegationState(ctx)
+	delegationState := k.getDelegationAccountState(ctx)
 	//Checks
 	channel, found := k.channelKeeper.GetChannel(ctx, hostChainParams.TransferPort, hostChainParams.TransferChannel)
 	if !found {
@@ -315,7 +375,7 @@ func (k Keeper) OnAcknowledgementIBCTransferPacket(ctx sdk.Context, packet chann
 	}
 	// check for tokens moved from delegationModuleAccount to it's ica counterpart.
 	hostChainParams := k.GetHostChainParams(ctx)
-	delegationState := k.GetDelegationState(ctx)
+	delegationState := k.getDelegationAccountState(ctx)
 	if packet.GetSourceChannel() != hostChainParams.TransferChannel ||
 		packet.GetSourcePort() != hostChainParams.TransferPort {
 		// no need to return err, since most likely code is expected to enter this condition
@@ -355,7 +415,7 @@ func (k Keeper) OnTimeoutIBCTransferPacket(ctx sdk.Context, packet channeltypes.
 	}
 	// check for tokens moved from delegationModuleAccount to it's ica counterpart.
 	hostChainParams := k.GetHostChainParams(ctx)
-	delegationState := k.GetDelegationState(ctx)
+	delegationState := k.getDelegationAccountState(ctx)
 	if packet.GetSourceChannel() != hostChainParams.TransferChannel ||
 		packet.GetSourcePort() != hostChainParams.TransferPort {
 		// no need to return err, since most likely code is expected to enter this condition
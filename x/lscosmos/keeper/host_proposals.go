@@ -0,0 +1,212 @@
+package keeper
+
+import (
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/persistenceOne/pstake-native/v2/x/lscosmos/types"
+)
+
+// SetHostProposal sets the host chain proposal in the store, signaling proposals are indexed by voting end time
+func (k Keeper) SetHostProposal(ctx sdk.Context, hostProposal types.HostProposal) {
+	store := ctx.KVStore(k.storeKey)
+	if previous, found := k.GetHostProposal(ctx, hostProposal.ProposalId); found {
+		store.Delete(types.GetSignalingHostProposalKey(previous.VotingEndTime, previous.ProposalId))
+	}
+	store.Set(types.GetHostProposalKey(hostProposal.ProposalId), k.cdc.MustMarshal(&hostProposal))
+	if hostProposal.Status == types.HOST_PROPOSAL_STATUS_SIGNALING {
+		store.Set(types.GetSignalingHostProposalKey(hostProposal.VotingEndTime, hostProposal.ProposalId), []byte{})
+	}
+}
+
+// GetHostProposal gets the host chain proposal, false is returned if it is not registered
+func (k Keeper) GetHostProposal(ctx sdk.Context, proposalID uint64) (types.HostProposal, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetHostProposalKey(proposalID))
+	if bz == nil {
+		return types.HostProposal{}, false
+	}
+
+	var hostProposal types.HostProposal
+	k.cdc.MustUnmarshal(bz, &hostProposal)
+	return hostProposal, true
+}
+
+// GetAllHostProposals returns all the host chain proposals ordered by proposal id
+func (k Keeper) GetAllHostProposals(ctx sdk.Context) []types.HostProposal {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.HostProposalKey)
+	defer iterator.Close()
+
+	var hostProposals []types.HostProposal
+	for ; iterator.Valid(); iterator.Next() {
+		var hostProposal types.HostProposal
+		k.cdc.MustUnmarshal(iterator.Value(), &hostProposal)
+		hostProposals = append(hostProposals, hostProposal)
+	}
+	return hostProposals
+}
+
+// SetHostProposalVote sets the vote signal of the voter on the host chain proposal, replacing a previous one
+func (k Keeper) SetHostProposalVote(ctx sdk.Context, vote types.HostProposalVote) {
+	store := ctx.KVStore(k.storeKey)
+	voter := sdk.MustAccAddressFromBech32(vote.Voter)
+	store.Set(types.GetHostProposalVoteKey(vote.ProposalId, voter), k.cdc.MustMarshal(&vote))
+}
+
+// GetHostProposalVote gets the vote signal of the voter on the host chain proposal
+func (k Keeper) GetHostProposalVote(ctx sdk.Context, proposalID uint64, voter sdk.AccAddress) (types.HostProposalVote, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetHostProposalVoteKey(proposalID, voter))
+	if bz == nil {
+		return types.HostProposalVote{}, false
+	}
+
+	var vote types.HostProposalVote
+	k.cdc.MustUnmarshal(bz, &vote)
+	return vote, true
+}
+
+// GetHostProposalVotes returns the vote signals on the host chain proposal
+func (k Keeper) GetHostProposalVotes(ctx sdk.Context, proposalID uint64) []types.HostProposalVote {
+	return k.getHostProposalVotesWithPrefix(ctx, types.GetHostProposalVotesKey(proposalID))
+}
+
+// GetAllHostProposalVotes returns the vote signals on all the host chain proposals
+func (k Keeper) GetAllHostProposalVotes(ctx sdk.Context) []types.HostProposalVote {
+	return k.getHostProposalVotesWithPrefix(ctx, types.HostProposalVoteKey)
+}
+
+func (k Keeper) getHostProposalVotesWithPrefix(ctx sdk.Context, prefix []byte) []types.HostProposalVote {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	var votes []types.HostProposalVote
+	for ; iterator.Valid(); iterator.Next() {
+		var vote types.HostProposalVote
+		k.cdc.MustUnmarshal(iterator.Value(), &vote)
+		votes = append(votes, vote)
+	}
+	return votes
+}
+
+// TallyHostProposal adds all the vote signals on the host chain proposal to its tally, with the stk balances of the
+// voters at the current height, so every stk is counted once. Voters without stk balance left are not counted. It
+// returns the number of tallied signals.
+func (k Keeper) TallyHostProposal(ctx sdk.Context, hostProposal *types.HostProposal) int {
+	mintDenom := k.GetHostChainParams(ctx).MintDenom
+
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.GetHostProposalVotesKey(hostProposal.ProposalId))
+	defer iterator.Close()
+
+	tallied := 0
+	for ; iterator.Valid(); iterator.Next() {
+		var vote types.HostProposalVote
+		k.cdc.MustUnmarshal(iterator.Value(), &vote)
+
+		balance := k.bankKeeper.GetBalance(ctx, sdk.MustAccAddressFromBech32(vote.Voter), mintDenom)
+		hostProposal.Tally.Add(vote.Options, balance.Amount)
+		tallied++
+	}
+	return tallied
+}
+
+// ProcessHostProposals sends the weighted votes of the host chain proposals whose voting period ends within the
+// host vote buffer, only the signaling proposals due in the voting end time index are read. Each proposal is tallied
+// in full in a single block, the snapshot height, and no more proposals are tallied in a block once
+// MaxHostProposalTallySignalsPerBlock signals were tallied. Proposals without any stk backed signal are not voted on
+// and proposals that could not be voted on before the end of their voting period are failed.
+func (k Keeper) ProcessHostProposals(ctx sdk.Context) error {
+	hostVoteBuffer := k.GetParams(ctx).HostVoteBuffer
+
+	// collect the due proposals first, the store can not be written while iterating
+	var proposalIDs []uint64
+	dueEnd := sdk.PrefixEndBytes(sdk.FormatTimeBytes(ctx.BlockTime().Add(hostVoteBuffer)))
+	iterator := prefix.NewStore(ctx.KVStore(k.storeKey), types.SignalingHostProposalKey).Iterator(nil, dueEnd)
+	for ; iterator.Valid(); iterator.Next() {
+		proposalIDs = append(proposalIDs, types.ParseSignalingHostProposalKey(iterator.Key()))
+	}
+	iterator.Close()
+
+	tallyBudget := types.MaxHostProposalTallySignalsPerBlock
+	for _, proposalID := range proposalIDs {
+		hostProposal, found := k.GetHostProposal(ctx, proposalID)
+		if !found || hostProposal.Status != types.HOST_PROPOSAL_STATUS_SIGNALING {
+			continue
+		}
+		if !ctx.BlockTime().Before(hostProposal.VotingEndTime) {
+			k.setHostProposalStatus(ctx, hostProposal, types.HOST_PROPOSAL_STATUS_FAILED)
+			continue
+		}
+		if tallyBudget <= 0 {
+			// the tally waits for the next block
+			continue
+		}
+
+		hostProposal.SnapshotHeight = ctx.BlockHeight()
+		tallyBudget -= k.TallyHostProposal(ctx, &hostProposal)
+
+		hostProposal.Options = hostProposal.Tally.WeightedVoteOptions()
+		if len(hostProposal.Options) == 0 {
+			k.setHostProposalStatus(ctx, hostProposal, types.HOST_PROPOSAL_STATUS_NO_SIGNAL)
+			continue
+		}
+
+		if err := k.sendHostVote(ctx, hostProposal); err != nil {
+			k.Logger(ctx).Error("Unable to send host chain vote", "proposal-id", hostProposal.ProposalId, "err", err)
+			k.setHostProposalStatus(ctx, hostProposal, types.HOST_PROPOSAL_STATUS_FAILED)
+			continue
+		}
+		k.setHostProposalStatus(ctx, hostProposal, types.HOST_PROPOSAL_STATUS_VOTE_SENT)
+	}
+	return nil
+}
+
+// sendHostVote sends the weighted vote of the host chain proposal through the delegator ICA, the ICA tx is
+// reverted on failure
+func (k Keeper) sendHostVote(ctx sdk.Context, hostProposal types.HostProposal) error {
+	cacheCtx, write := ctx.CacheContext()
+
+	hostChainParams := k.GetHostChainParams(cacheCtx)
+	hostAccounts := k.GetHostAccounts(cacheCtx)
+	msg := &govtypes.MsgVoteWeighted{
+		ProposalId: hostProposal.ProposalId,
+		Voter:      k.GetHostChainDelegationAddress(cacheCtx),
+		Options:    hostProposal.Options,
+	}
+	err := k.GenerateAndExecuteICATx(cacheCtx, hostChainParams.ConnectionID, hostAccounts.DelegatorAccountOwnerID, []proto.Message{msg})
+	if err != nil {
+		return err
+	}
+
+	write()
+	return nil
+}
+
+// setHostProposalStatus updates the status of the host chain proposal and emits it
+func (k Keeper) setHostProposalStatus(ctx sdk.Context, hostProposal types.HostProposal, status types.HostProposalStatus) {
+	hostProposal.Status = status
+	k.SetHostProposal(ctx, hostProposal)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeHostVote,
+		sdk.NewAttribute(types.AttributeProposalID, strconv.FormatUint(hostProposal.ProposalId, 10)),
+		sdk.NewAttribute(types.AttributeHostProposalStatus, status.String()),
+		sdk.NewAttribute(types.AttributeVoteOptions, govtypes.WeightedVoteOptions(hostProposal.Options).String()),
+	))
+}
+
+// handleHostVoteResult sets the final status of the host chain proposal once its weighted vote is acknowledged
+// or failed on the host chain
+func (k Keeper) handleHostVoteResult(ctx sdk.Context, proposalID uint64, status types.HostProposalStatus) {
+	hostProposal, found := k.GetHostProposal(ctx, proposalID)
+	if !found || hostProposal.Status != types.HOST_PROPOSAL_STATUS_VOTE_SENT {
+		return
+	}
+	k.setHostProposalStatus(ctx, hostProposal, status)
+}
@@ -0,0 +1,232 @@
+package keeper_test
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+
+	lscosmoskeeper "github.com/persistenceOne/pstake-native/v2/x/lscosmos/keeper"
+	"github.com/persistenceOne/pstake-native/v2/x/lscosmos/types"
+)
+
+func (suite *IntegrationTestSuite) TestRegisterAndVoteHostProposal() {
+	app, ctx := suite.app, suite.ctx.WithBlockHeight(1)
+	k := app.LSCosmosKeeper
+	msgServer := lscosmoskeeper.NewMsgServerImpl(k)
+
+	admin := sdk.MustAccAddressFromBech32(PstakeFeeAddress)
+	voter := sdk.AccAddress("voter_______________")
+	votingEndTime := ctx.BlockTime().Add(7 * 24 * time.Hour)
+
+	_, err := msgServer.RegisterHostProposal(sdk.WrapSDKContext(ctx), types.NewMsgRegisterHostProposal(voter, 1, votingEndTime))
+	suite.ErrorIs(err, sdkerrors.ErrUnauthorized)
+	_, err = msgServer.RegisterHostProposal(sdk.WrapSDKContext(ctx), types.NewMsgRegisterHostProposal(admin, 1, ctx.BlockTime()))
+	suite.ErrorIs(err, sdkerrors.ErrInvalidRequest)
+	_, err = msgServer.RegisterHostProposal(sdk.WrapSDKContext(ctx), types.NewMsgRegisterHostProposal(admin, 1, votingEndTime))
+	suite.NoError(err)
+	_, err = msgServer.RegisterHostProposal(sdk.WrapSDKContext(ctx), types.NewMsgRegisterHostProposal(admin, 1, votingEndTime))
+	suite.ErrorIs(err, types.ErrHostProposalExists)
+
+	hostProposal, found := k.GetHostProposal(ctx, 1)
+	suite.True(found)
+	suite.Equal(types.HOST_PROPOSAL_STATUS_SIGNALING, hostProposal.Status)
+
+	// voters need stk to signal their vote
+	yes := govtypes.NewNonSplitVoteOption(govtypes.OptionYes)
+	_, err = msgServer.VoteHostProposal(sdk.WrapSDKContext(ctx), types.NewMsgVoteHostProposal(voter, 1, yes))
+	suite.ErrorIs(err, sdkerrors.ErrInsufficientFunds)
+	suite.NoError(testutil.FundAccount(app.BankKeeper, ctx, voter, sdk.NewCoins(sdk.NewInt64Coin(MintDenom, 100))))
+	_, err = msgServer.VoteHostProposal(sdk.WrapSDKContext(ctx), types.NewMsgVoteHostProposal(voter, 2, yes))
+	suite.ErrorIs(err, types.ErrHostProposalNotFound)
+	_, err = msgServer.VoteHostProposal(sdk.WrapSDKContext(ctx), types.NewMsgVoteHostProposal(voter, 1, yes))
+	suite.NoError(err)
+
+	// a new signal replaces the previous one
+	no := govtypes.NewNonSplitVoteOption(govtypes.OptionNo)
+	_, err = msgServer.VoteHostProposal(sdk.WrapSDKContext(ctx), types.NewMsgVoteHostProposal(voter, 1, no))
+	suite.NoError(err)
+	vote, found := k.GetHostProposalVote(ctx, 1, voter)
+	suite.True(found)
+	suite.Equal([]govtypes.WeightedVoteOption(no), vote.Options)
+	suite.Len(k.GetHostProposalVotes(ctx, 1), 1)
+
+	suite.Equal(1, k.TallyHostProposal(ctx, &hostProposal))
+	suite.Equal(sdk.NewInt(100), hostProposal.Tally.No)
+	suite.Equal(sdk.NewInt(100), hostProposal.Tally.Total())
+
+	// signals are closed once the proposal was tallied
+	hostProposal.SnapshotHeight = ctx.BlockHeight()
+	k.SetHostProposal(ctx, hostProposal)
+	_, err = msgServer.VoteHostProposal(sdk.WrapSDKContext(ctx), types.NewMsgVoteHostProposal(voter, 1, yes))
+	suite.ErrorIs(err, types.ErrHostProposalNotSignaling)
+
+	hostProposal.Status = types.HOST_PROPOSAL_STATUS_VOTE_SENT
+	k.SetHostProposal(ctx, hostProposal)
+	_, err = msgServer.VoteHostProposal(sdk.WrapSDKContext(ctx), types.NewMsgVoteHostProposal(voter, 1, yes))
+	suite.ErrorIs(err, types.ErrHostProposalNotSignaling)
+}
+
+func (suite *IntegrationTestSuite) TestProcessHostProposals() {
+	app, ctx := suite.app, suite.ctx
+	k := app.LSCosmosKeeper
+
+	voter := sdk.AccAddress("voter_______________")
+	suite.NoError(testutil.FundAccount(app.BankKeeper, ctx, voter, sdk.NewCoins(sdk.NewInt64Coin(MintDenom, 100))))
+	hostVoteBuffer := k.GetParams(ctx).HostVoteBuffer
+
+	newHostProposal := func(proposalID uint64, votingEndTime time.Time) types.HostProposal {
+		return types.HostProposal{
+			ProposalId:    proposalID,
+			VotingEndTime: votingEndTime,
+			Status:        types.HOST_PROPOSAL_STATUS_SIGNALING,
+			Tally:         types.NewHostProposalTally(),
+		}
+	}
+	// outside of the host vote buffer
+	k.SetHostProposal(ctx, newHostProposal(1, ctx.BlockTime().Add(hostVoteBuffer+time.Hour)))
+	// within the host vote buffer without signals
+	k.SetHostProposal(ctx, newHostProposal(2, ctx.BlockTime().Add(time.Hour)))
+	// within the host vote buffer with signals
+	k.SetHostProposal(ctx, newHostProposal(3, ctx.BlockTime().Add(time.Hour)))
+	k.SetHostProposalVote(ctx, types.HostProposalVote{ProposalId: 3, Voter: voter.String(), Options: govtypes.NewNonSplitVoteOption(govtypes.OptionYes)})
+	// voting period already ended
+	k.SetHostProposal(ctx, newHostProposal(4, ctx.BlockTime()))
+
+	suite.NoError(k.ProcessHostProposals(ctx))
+
+	hostProposal, _ := k.GetHostProposal(ctx, 1)
+	suite.Equal(types.HOST_PROPOSAL_STATUS_SIGNALING, hostProposal.Status)
+
+	hostProposal, _ = k.GetHostProposal(ctx, 2)
+	suite.Equal(types.HOST_PROPOSAL_STATUS_NO_SIGNAL, hostProposal.Status)
+	suite.Equal(ctx.BlockHeight(), hostProposal.SnapshotHeight)
+	suite.Empty(hostProposal.Options)
+
+	// the tally is kept even if the vote cannot be sent through the delegator ICA
+	hostProposal, _ = k.GetHostProposal(ctx, 3)
+	suite.Equal(types.HOST_PROPOSAL_STATUS_FAILED, hostProposal.Status)
+	suite.Equal(sdk.NewInt(100), hostProposal.Tally.Yes)
+	suite.Equal([]govtypes.WeightedVoteOption(govtypes.NewNonSplitVoteOption(govtypes.OptionYes)), hostProposal.Options)
+
+	hostProposal, _ = k.GetHostProposal(ctx, 4)
+	suite.Equal(types.HOST_PROPOSAL_STATUS_FAILED, hostProposal.Status)
+}
+
+func (suite *IntegrationTestSuite) TestProcessHostProposalsSnapshot() {
+	app, ctx := suite.app, suite.ctx.WithBlockHeight(1)
+	k := app.LSCosmosKeeper
+	k.SetModuleState(ctx, true)
+
+	votingEndTime := ctx.BlockTime().Add(time.Hour)
+	for _, proposalID := range []uint64{1, 2} {
+		k.SetHostProposal(ctx, types.HostProposal{
+			ProposalId:    proposalID,
+			VotingEndTime: votingEndTime,
+			Status:        types.HOST_PROPOSAL_STATUS_SIGNALING,
+			Tally:         types.NewHostProposalTally(),
+		})
+	}
+	voters := make([]sdk.AccAddress, types.MaxHostProposalTallySignalsPerBlock+10)
+	for i := range voters {
+		voters[i] = sdk.AccAddress(fmt.Sprintf("voter%015d", i))
+		suite.NoError(testutil.FundAccount(app.BankKeeper, ctx, voters[i], sdk.NewCoins(sdk.NewInt64Coin(MintDenom, 1))))
+		for _, proposalID := range []uint64{1, 2} {
+			k.SetHostProposalVote(ctx, types.HostProposalVote{ProposalId: proposalID, Voter: voters[i].String(), Options: govtypes.NewNonSplitVoteOption(govtypes.OptionYes)})
+		}
+	}
+	// the first voter in the key order holds more stk, moved to the last voter after the first tally
+	first, last := voters[0], voters[len(voters)-1]
+	suite.NoError(testutil.FundAccount(app.BankKeeper, ctx, first, sdk.NewCoins(sdk.NewInt64Coin(MintDenom, 100))))
+	totalStk := sdk.NewInt(int64(len(voters) + 100))
+
+	// the paused host votes are not processed
+	k.SetPauseSwitches(ctx, types.PauseSwitches{HostVotes: true})
+	k.BeginBlock(ctx)
+	hostProposal, _ := k.GetHostProposal(ctx, 1)
+	suite.Zero(hostProposal.SnapshotHeight)
+	k.SetPauseSwitches(ctx, types.PauseSwitches{})
+
+	// the first proposal is tallied in full in a single block, the vote can not be sent without the delegator ICA,
+	// the second one waits for the next block as the signals per block are used up
+	suite.NoError(k.ProcessHostProposals(ctx))
+	hostProposal, _ = k.GetHostProposal(ctx, 1)
+	suite.Equal(types.HOST_PROPOSAL_STATUS_FAILED, hostProposal.Status)
+	suite.Equal(ctx.BlockHeight(), hostProposal.SnapshotHeight)
+	suite.Equal(totalStk, hostProposal.Tally.Yes)
+	hostProposal, _ = k.GetHostProposal(ctx, 2)
+	suite.Equal(types.HOST_PROPOSAL_STATUS_SIGNALING, hostProposal.Status)
+	suite.Zero(hostProposal.SnapshotHeight)
+
+	// the stk moved from the first to the last voter after the tally is not counted twice
+	suite.NoError(app.BankKeeper.SendCoins(ctx, first, last, sdk.NewCoins(sdk.NewInt64Coin(MintDenom, 101))))
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	suite.NoError(k.ProcessHostProposals(ctx))
+	hostProposal, _ = k.GetHostProposal(ctx, 1)
+	suite.Equal(totalStk, hostProposal.Tally.Yes)
+	hostProposal, _ = k.GetHostProposal(ctx, 2)
+	suite.Equal(types.HOST_PROPOSAL_STATUS_FAILED, hostProposal.Status)
+	suite.Equal(ctx.BlockHeight(), hostProposal.SnapshotHeight)
+	suite.Equal(totalStk, hostProposal.Tally.Yes)
+
+	// the proposals are no longer indexed once they left the signaling status
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(app.GetKey(types.StoreKey)), types.SignalingHostProposalKey)
+	suite.False(iterator.Valid())
+	iterator.Close()
+}
+
+func (suite *IntegrationTestSuite) TestQueryHostProposals() {
+	app, ctx := suite.app, suite.ctx
+	qrysrv := types.QueryServer(app.LSCosmosKeeper)
+	c := sdk.WrapSDKContext(ctx)
+
+	_, err := qrysrv.HostProposals(c, nil)
+	suite.Error(err)
+	_, err = qrysrv.HostProposal(c, nil)
+	suite.Error(err)
+	_, err = qrysrv.HostProposalVotes(c, nil)
+	suite.Error(err)
+
+	for _, proposalID := range []uint64{300, 2, 10} {
+		app.LSCosmosKeeper.SetHostProposal(ctx, types.HostProposal{
+			ProposalId:    proposalID,
+			VotingEndTime: ctx.BlockTime().Add(time.Hour).UTC(),
+			Tally:         types.NewHostProposalTally(),
+		})
+	}
+	for _, voter := range []sdk.AccAddress{sdk.AccAddress("voter1______________"), sdk.AccAddress("voter2______________")} {
+		app.LSCosmosKeeper.SetHostProposalVote(ctx, types.HostProposalVote{
+			ProposalId: 10,
+			Voter:      voter.String(),
+			Options:    govtypes.NewNonSplitVoteOption(govtypes.OptionAbstain),
+		})
+	}
+
+	res, err := qrysrv.HostProposals(c, &types.QueryHostProposalsRequest{})
+	suite.NoError(err)
+	suite.Len(res.HostProposals, 3)
+	suite.Equal(uint64(2), res.HostProposals[0].ProposalId)
+	suite.Equal(uint64(300), res.HostProposals[2].ProposalId)
+
+	res, err = qrysrv.HostProposals(c, &types.QueryHostProposalsRequest{Pagination: &query.PageRequest{Offset: 1, Limit: 1}})
+	suite.NoError(err)
+	suite.Len(res.HostProposals, 1)
+	suite.Equal(uint64(10), res.HostProposals[0].ProposalId)
+
+	proposalRes, err := qrysrv.HostProposal(c, &types.QueryHostProposalRequest{ProposalId: 10})
+	suite.NoError(err)
+	suite.Equal(uint64(10), proposalRes.HostProposal.ProposalId)
+	_, err = qrysrv.HostProposal(c, &types.QueryHostProposalRequest{ProposalId: 11})
+	suite.Error(err)
+
+	votesRes, err := qrysrv.HostProposalVotes(c, &types.QueryHostProposalVotesRequest{ProposalId: 10})
+	suite.NoError(err)
+	suite.Len(votesRes.Votes, 2)
+	votesRes, err = qrysrv.HostProposalVotes(c, &types.QueryHostProposalVotesRequest{ProposalId: 2})
+	suite.NoError(err)
+	suite.Empty(votesRes.Votes)
+}
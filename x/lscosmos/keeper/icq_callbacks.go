@@ -2,6 +2,7 @@ package keeper
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/gogo/protobuf/proto"
@@ -13,6 +14,9 @@ import (
 const (
 	RewardsAccountBalance = "reward_account_balance"
 	Delegation            = "delegation"
+	ValidatorMetrics      = "validator_metrics"
+	ValidatorSigningInfo  = "validator_signing_info"
+	ValidatorSelfBond     = "validator_self_bond"
 )
 
 // CallbackFn wrapper struct for interchainstaking keeper
@@ -40,7 +44,10 @@ func (c Callbacks) AddCallback(id string, fn interface{}) icqtypes.QueryCallback
 func (c Callbacks) RegisterCallbacks() icqtypes.QueryCallbacks {
 	a := c.
 		AddCallback(RewardsAccountBalance, CallbackFn(RewardsAccountBalanceCallback)).
-		AddCallback(Delegation, CallbackFn(DelegationCallback))
+		AddCallback(Delegation, CallbackFn(DelegationCallback)).
+		AddCallback(ValidatorMetrics, CallbackFn(ValidatorMetricsCallback)).
+		AddCallback(ValidatorSigningInfo, CallbackFn(ValidatorSigningInfoCallback)).
+		AddCallback(ValidatorSelfBond, CallbackFn(ValidatorSelfBondCallback))
 
 	return a.(Callbacks)
 }
@@ -66,6 +73,21 @@ func DelegationCallback(k Keeper, ctx sdk.Context, response []byte, query icqtyp
 	return k.HandleDelegationCallback(ctx, response, query)
 }
 
+// ValidatorMetricsCallback returns response of HandleValidatorMetricsCallback
+func ValidatorMetricsCallback(k Keeper, ctx sdk.Context, response []byte, query icqtypes.Query) error {
+	return k.HandleValidatorMetricsCallback(ctx, response, query)
+}
+
+// ValidatorSigningInfoCallback returns response of HandleValidatorSigningInfoCallback
+func ValidatorSigningInfoCallback(k Keeper, ctx sdk.Context, response []byte, query icqtypes.Query) error {
+	return k.HandleValidatorSigningInfoCallback(ctx, response, query)
+}
+
+// ValidatorSelfBondCallback returns response of HandleValidatorSelfBondCallback
+func ValidatorSelfBondCallback(k Keeper, ctx sdk.Context, response []byte, query icqtypes.Query) error {
+	return k.HandleValidatorSelfBondCallback(ctx, response, query)
+}
+
 // HandleRewardsAccountBalanceCallback generates and executes rewards account balance query
 func (k Keeper) HandleRewardsAccountBalanceCallback(ctx sdk.Context, response []byte, _ icqtypes.Query) error {
 	resp := banktypes.QueryBalanceResponse{}
@@ -79,30 +101,94 @@ func (k Keeper) HandleRewardsAccountBalanceCallback(ctx sdk.Context, response []
 		k.Logger(ctx).Info("No amount in rewards account to restake - noop.")
 		return nil
 	}
+	if k.GetPendingRewardEpochRecord(ctx) != nil {
+		k.Logger(ctx).Info("Rewards sent from the rewards account are not acknowledged yet - noop.")
+		return nil
+	}
 
 	hostChainParams := k.GetHostChainParams(ctx)
-	delegationState := k.GetDelegationState(ctx)
+	delegationState := k.getDelegationAccountState(ctx)
 	rewardsAddress := k.GetHostChainRewardAddress(ctx)
 	hostAccounts := k.GetHostAccounts(ctx)
 
 	// Cap the re-staking amount so exchange rate doesn't change drastically.
+	params := k.GetParams(ctx)
 	cValue := k.GetCValue(ctx)
 	stkAssetSupply := k.bankKeeper.GetSupply(ctx, hostChainParams.MintDenom)
 
 	atomTVU := sdk.NewDecFromInt(stkAssetSupply.Amount).Quo(cValue)
-	atomTVUCap := atomTVU.Mul(types.RestakeCapPerDay).TruncateInt()
+	atomTVUCap := atomTVU.Mul(params.RestakeCapPerDay).TruncateInt()
 	sendCoinAmt := resp.Balance.Amount
+	excessAmt := sdk.ZeroInt()
 	if resp.Balance.Amount.GT(atomTVUCap) {
 		sendCoinAmt = atomTVUCap
+		excessAmt = resp.Balance.Amount.Sub(atomTVUCap)
+	}
+
+	// handle the rewards above the cap according to the excess reward policy, carried forward rewards stay in the
+	// rewards account. Without a host chain insurance fund address the excess rewards are restaked.
+	policy := params.ExcessRewardPolicy
+	insuranceFundAddress := k.GetHostInsuranceFundAddress(ctx, params.InsuranceFundAddress, rewardsAddress.Address)
+	if policy == types.EXCESS_REWARD_POLICY_INSURANCE_FUND && insuranceFundAddress == "" {
+		k.Logger(ctx).Info("No host chain insurance fund address, restaking the excess rewards.", "address", params.InsuranceFundAddress)
+		policy = types.EXCESS_REWARD_POLICY_RESTAKE
+	}
+	insuranceFundAmt := sdk.ZeroInt()
+	switch policy {
+	case types.EXCESS_REWARD_POLICY_INSURANCE_FUND:
+		insuranceFundAmt = excessAmt
+	case types.EXCESS_REWARD_POLICY_RESTAKE:
+		sendCoinAmt = resp.Balance.Amount
 	}
 
-	//send coins to delegation account.
-	msg := &banktypes.MsgSend{
-		FromAddress: rewardsAddress.Address,
-		ToAddress:   delegationState.HostChainDelegationAddress,
-		Amount:      sdk.NewCoins(sdk.NewCoin(resp.Balance.Denom, sendCoinAmt)),
+	var msgs []proto.Message
+	if sendCoinAmt.IsPositive() {
+		//send coins to delegation account.
+		msgs = append(msgs, &banktypes.MsgSend{
+			FromAddress: rewardsAddress.Address,
+			ToAddress:   delegationState.HostChainDelegationAddress,
+			Amount:      sdk.NewCoins(sdk.NewCoin(resp.Balance.Denom, sendCoinAmt)),
+		})
+	}
+	if insuranceFundAmt.IsPositive() {
+		msgs = append(msgs, &banktypes.MsgSend{
+			FromAddress: rewardsAddress.Address,
+			ToAddress:   insuranceFundAddress,
+			Amount:      sdk.NewCoins(sdk.NewCoin(resp.Balance.Denom, insuranceFundAmt)),
+		})
+	}
+	record := k.NewRewardEpochRecord(
+		ctx, *resp.Balance, sdk.NewCoin(resp.Balance.Denom, sendCoinAmt), sdk.NewCoin(resp.Balance.Denom, insuranceFundAmt), policy,
+	)
+	if len(msgs) == 0 {
+		k.RecordRewards(ctx, record)
+		return nil
+	}
+
+	err = k.GenerateAndExecuteICATx(ctx, hostChainParams.ConnectionID, hostAccounts.RewardsAccountOwnerID, msgs)
+	if err != nil {
+		return err
+	}
+	// the rewards are recorded once the sends are acknowledged
+	k.SetPendingRewardEpochRecord(ctx, record)
+	return nil
+}
+
+// GetHostInsuranceFundAddress returns the insurance fund address if it has the address prefix of the host chain
+// rewards address, an empty string is returned otherwise
+func (k Keeper) GetHostInsuranceFundAddress(ctx sdk.Context, insuranceFundAddress, rewardsAddress string) string {
+	if insuranceFundAddress == "" {
+		return ""
+	}
+	hostPrefix, _, err := bech32.DecodeAndConvert(rewardsAddress)
+	if err != nil {
+		return ""
+	}
+	if _, err = types.ValAddressFromBech32(insuranceFundAddress, hostPrefix); err != nil {
+		k.Logger(ctx).Error("Insurance fund address is not a host chain address", "address", insuranceFundAddress, "err", err)
+		return ""
 	}
-	return k.GenerateAndExecuteICATx(ctx, hostChainParams.ConnectionID, hostAccounts.RewardsAccountOwnerID, []proto.Message{msg})
+	return insuranceFundAddress
 }
 
 // HandleDelegationCallback generates and executes delegation query
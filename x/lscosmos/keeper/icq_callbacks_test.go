@@ -44,6 +44,15 @@ func (suite *IntegrationTestSuite) TestHandleRewardsAccountCallbacks() {
 	queryBalancesResponse, err = proto.Marshal(&banktypes.QueryBalanceResponse{Balance: &balance})
 	suite.NoError(err)
 
+	// nothing is restaked without stk supply, the rewards are carried forward
+	err = lscosmosKeeper.HandleRewardsAccountBalanceCallback(ctx, queryBalancesResponse, icqtypes.Query{})
+	suite.NoError(err)
+	suite.Equal(balance, lscosmosKeeper.GetCarriedOverRewards(ctx))
+
+	params := lscosmosKeeper.GetParams(ctx)
+	params.ExcessRewardPolicy = types.EXCESS_REWARD_POLICY_RESTAKE
+	lscosmosKeeper.SetParams(ctx, params)
+
 	err = lscosmosKeeper.HandleRewardsAccountBalanceCallback(ctx, queryBalancesResponse, icqtypes.Query{})
 	suite.Error(err)
 }
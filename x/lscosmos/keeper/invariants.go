@@ -11,6 +11,29 @@ import (
 // RegisterInvariants registers the lscosmos module invariants
 func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
 	ir.RegisterRoute(types.ModuleName, "c-value-range", CValueRangeInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "unbonding-epoch-entries", UnbondingEpochEntriesInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "undelegation-module-account", UndelegationModuleAccountInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "deposit-module-account", DepositModuleAccountInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "ibc-transient-store", IBCTransientStoreInvariant(k))
+}
+
+// AllInvariants runs all invariants of the lscosmos module.
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		for _, inv := range []func(Keeper) sdk.Invariant{
+			CValueRangeInvariant,
+			UnbondingEpochEntriesInvariant,
+			UndelegationModuleAccountInvariant,
+			DepositModuleAccountInvariant,
+			IBCTransientStoreInvariant,
+		} {
+			res, stop := inv(k)(ctx)
+			if stop {
+				return res, stop
+			}
+		}
+		return "", false
+	}
 }
 
 // CValueRangeInvariant checks that if CValue is within module safety range
@@ -33,3 +56,146 @@ func CValueRangeInvariant(k Keeper) sdk.Invariant {
 		), false
 	}
 }
+
+// UnbondingEpochEntriesInvariant checks that the delegator unbonding epoch entries of every epoch with an
+// UnbondingEpochCValue add up to its STKBurn. Claims remove entries once the epoch is matured or failed,
+// so the entries can only add up to less than STKBurn after that.
+func UnbondingEpochEntriesInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		entriesSum := map[int64]sdk.Int{}
+		for _, entry := range k.IterateAllDelegatorUnbondingEpochEntry(ctx) {
+			sum, ok := entriesSum[entry.EpochNumber]
+			if !ok {
+				sum = sdk.ZeroInt()
+			}
+			entriesSum[entry.EpochNumber] = sum.Add(entry.Amount.Amount)
+		}
+
+		msg := ""
+		broken := false
+		for _, unbondingEpochCValue := range k.IterateAllUnbondingEpochCValues(ctx) {
+			stkBurn := unbondingEpochCValue.STKBurn.Amount
+			if stkBurn.IsNil() {
+				stkBurn = sdk.ZeroInt()
+			}
+			sum, ok := entriesSum[unbondingEpochCValue.EpochNumber]
+			if !ok {
+				sum = sdk.ZeroInt()
+			}
+
+			settled := unbondingEpochCValue.IsMatured || unbondingEpochCValue.IsFailed
+			if (!settled && !sum.Equal(stkBurn)) || (settled && sum.GT(stkBurn)) {
+				msg += fmt.Sprintf("\tepoch %d: delegator unbonding entries add up to %s, STKBurn is %s (matured: %v, failed: %v)\n",
+					unbondingEpochCValue.EpochNumber, sum, stkBurn, unbondingEpochCValue.IsMatured, unbondingEpochCValue.IsFailed)
+				broken = true
+			}
+		}
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "delegator unbonding epoch entries",
+			msg,
+		), broken
+	}
+}
+
+// UndelegationModuleAccountInvariant checks that the undelegation module account holds at least the amount
+// claimable from the unclaimed entries of matured epochs.
+func UndelegationModuleAccountInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		hostChainParams := k.GetHostChainParams(ctx)
+		if hostChainParams.IsEmpty() {
+			return "", false
+		}
+
+		unbondingEpochCValues := map[int64]types.UnbondingEpochCValue{}
+		for _, unbondingEpochCValue := range k.IterateAllUnbondingEpochCValues(ctx) {
+			if unbondingEpochCValue.IsMatured && isPositiveCoin(unbondingEpochCValue.STKBurn) && isPositiveCoin(unbondingEpochCValue.AmountUnbonded) {
+				unbondingEpochCValues[unbondingEpochCValue.EpochNumber] = unbondingEpochCValue
+			}
+		}
+
+		// claimable amounts are truncated per entry the same way as in claims
+		claimable := sdk.ZeroInt()
+		for _, entry := range k.IterateAllDelegatorUnbondingEpochEntry(ctx) {
+			unbondingEpochCValue, ok := unbondingEpochCValues[entry.EpochNumber]
+			if !ok {
+				continue
+			}
+			claimable = claimable.Add(sdk.NewDecFromInt(entry.Amount.Amount).Quo(unbondingEpochCValue.GetUnbondingEpochCValue()).TruncateInt())
+		}
+
+		balance := k.bankKeeper.GetBalance(ctx, k.GetUndelegationModuleAccount(ctx).GetAddress(), k.GetIBCDenom(ctx))
+		broken := balance.Amount.LT(claimable)
+		return sdk.FormatInvariant(
+			types.ModuleName, "undelegation module account balance",
+			fmt.Sprintf("\tundelegation module account balance: %s\n"+
+				"\tclaimable from matured epochs: %s\n", balance.Amount, claimable),
+		), broken
+	}
+}
+
+// DepositModuleAccountInvariant checks that the deposit module account only holds the IBC denom of the
+// host chain base denom.
+func DepositModuleAccountInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		hostChainParams := k.GetHostChainParams(ctx)
+		if hostChainParams.IsEmpty() {
+			return "", false
+		}
+
+		ibcDenom := k.GetIBCDenom(ctx)
+		balances := k.bankKeeper.GetAllBalances(ctx, k.GetDepositModuleAccount(ctx).GetAddress())
+		msg := ""
+		broken := false
+		for _, balance := range balances {
+			if balance.Denom != ibcDenom {
+				msg += fmt.Sprintf("\tunexpected balance %s, only %s is expected\n", balance, ibcDenom)
+				broken = true
+			}
+		}
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "deposit module account denoms",
+			msg,
+		), broken
+	}
+}
+
+// IBCTransientStoreInvariant checks that all the IBCAmountTransientStore entries are non-negative.
+func IBCTransientStoreInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		transientStore := k.GetIBCTransientStore(ctx)
+		msg := ""
+		broken := false
+		if transientStore.IBCTransfer.IsAnyNegative() {
+			msg += fmt.Sprintf("\tnegative ibc transfer amount: %s\n", transientStore.IBCTransfer)
+			broken = true
+		}
+		if isNegativeCoin(transientStore.ICADelegate) {
+			msg += fmt.Sprintf("\tnegative ica delegate amount: %s\n", transientStore.ICADelegate)
+			broken = true
+		}
+		for _, undelegationTransfer := range transientStore.UndelegatonCompleteIBCTransfer {
+			if isNegativeCoin(undelegationTransfer.AmountUnbonded) {
+				msg += fmt.Sprintf("\tnegative undelegation transfer amount for epoch %d: %s\n",
+					undelegationTransfer.EpochNumber, undelegationTransfer.AmountUnbonded)
+				broken = true
+			}
+		}
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "ibc transient store amounts",
+			msg,
+		), broken
+	}
+}
+
+// isPositiveCoin returns true if the coin amount is set and positive
+func isPositiveCoin(coin sdk.Coin) bool {
+	return !coin.Amount.IsNil() && coin.Amount.IsPositive()
+}
+
+// isNegativeCoin returns true if the coin amount is set and negative
+func isNegativeCoin(coin sdk.Coin) bool {
+	return !coin.Amount.IsNil() && coin.Amount.IsNegative()
+}
@@ -0,0 +1,81 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
+
+	lscosmoskeeper "github.com/persistenceOne/pstake-native/v2/x/lscosmos/keeper"
+	"github.com/persistenceOne/pstake-native/v2/x/lscosmos/types"
+)
+
+func (suite *IntegrationTestSuite) TestUnbondingInvariants() {
+	app, ctx := suite.app, suite.ctx
+	keeper := app.LSCosmosKeeper
+
+	addr1 := sdk.AccAddress("addr1_______________")
+	addr2 := sdk.AccAddress("addr2_______________")
+	ibcDenom := keeper.GetIBCDenom(ctx)
+
+	keeper.AddDelegatorUnbondingEpochEntry(ctx, addr1, 4, sdk.NewInt64Coin(MintDenom, 1000))
+	keeper.AddDelegatorUnbondingEpochEntry(ctx, addr2, 4, sdk.NewInt64Coin(MintDenom, 500))
+
+	// entries of epochs without UnbondingEpochCValue are not checked
+	_, broken := lscosmoskeeper.UnbondingEpochEntriesInvariant(keeper)(ctx)
+	suite.False(broken)
+
+	keeper.SetUnbondingEpochCValue(ctx, types.UnbondingEpochCValue{
+		EpochNumber:    4,
+		STKBurn:        sdk.NewInt64Coin(MintDenom, 2000),
+		AmountUnbonded: sdk.NewInt64Coin(BaseDenom, 2000),
+	})
+	_, broken = lscosmoskeeper.UnbondingEpochEntriesInvariant(keeper)(ctx)
+	suite.True(broken)
+
+	keeper.AddDelegatorUnbondingEpochEntry(ctx, addr2, 4, sdk.NewInt64Coin(MintDenom, 500))
+	_, broken = lscosmoskeeper.UnbondingEpochEntriesInvariant(keeper)(ctx)
+	suite.False(broken)
+
+	// matured entries must be covered by the undelegation module account
+	keeper.MatureUnbondingEpochCValue(ctx, 4)
+	_, broken = lscosmoskeeper.UndelegationModuleAccountInvariant(keeper)(ctx)
+	suite.True(broken)
+
+	keeper.GetUndelegationModuleAccount(ctx)
+	suite.Require().NoError(testutil.FundModuleAccount(app.BankKeeper, ctx, types.UndelegationModuleAccount, sdk.NewCoins(sdk.NewInt64Coin(ibcDenom, 2000))))
+	_, broken = lscosmoskeeper.UndelegationModuleAccountInvariant(keeper)(ctx)
+	suite.False(broken)
+
+	// claims keep both invariants
+	suite.NoError(keeper.ClaimDelegatorUnbondingEpochEntries(ctx, addr1))
+	_, broken = lscosmoskeeper.AllInvariants(keeper)(ctx)
+	suite.False(broken)
+}
+
+func (suite *IntegrationTestSuite) TestDepositModuleAccountInvariant() {
+	app, ctx := suite.app, suite.ctx
+	keeper := app.LSCosmosKeeper
+
+	keeper.GetDepositModuleAccount(ctx)
+	suite.Require().NoError(testutil.FundModuleAccount(app.BankKeeper, ctx, types.DepositModuleAccount, sdk.NewCoins(sdk.NewInt64Coin(keeper.GetIBCDenom(ctx), 1000))))
+	_, broken := lscosmoskeeper.DepositModuleAccountInvariant(keeper)(ctx)
+	suite.False(broken)
+
+	suite.Require().NoError(testutil.FundModuleAccount(app.BankKeeper, ctx, types.DepositModuleAccount, sdk.NewCoins(sdk.NewInt64Coin(MintDenom, 1))))
+	_, broken = lscosmoskeeper.DepositModuleAccountInvariant(keeper)(ctx)
+	suite.True(broken)
+}
+
+func (suite *IntegrationTestSuite) TestIBCTransientStoreInvariant() {
+	app, ctx := suite.app, suite.ctx
+	keeper := app.LSCosmosKeeper
+
+	keeper.AddICADelegateToTransientStore(ctx, sdk.NewInt64Coin(BaseDenom, 100))
+	_, broken := lscosmoskeeper.IBCTransientStoreInvariant(keeper)(ctx)
+	suite.False(broken)
+
+	keeper.SetIBCTransientStore(ctx, types.IBCAmountTransientStore{
+		ICADelegate: sdk.Coin{Denom: BaseDenom, Amount: sdk.NewInt(-1)},
+	})
+	_, broken = lscosmoskeeper.IBCTransientStoreInvariant(keeper)(ctx)
+	suite.True(broken)
+}
@@ -27,6 +27,7 @@ type Keeper struct {
 
 	bankKeeper           types.BankKeeper
 	accountKeeper        types.AccountKeeper
+	distrKeeper          types.DistributionKeeper
 	epochKeeper          types.EpochKeeper
 	ics4WrapperKeeper    types.ICS4WrapperKeeper
 	channelKeeper        types.ChannelKeeper
@@ -47,6 +48,7 @@ func NewKeeper(
 	ps paramtypes.Subspace,
 	bankKeeper types.BankKeeper,
 	accKeeper types.AccountKeeper,
+	distrKeeper types.DistributionKeeper,
 	epochKeeper types.EpochKeeper,
 	ics4WrapperKeeper types.ICS4WrapperKeeper,
 	channelKeeper types.ChannelKeeper,
@@ -65,6 +67,7 @@ func NewKeeper(
 	return Keeper{
 		bankKeeper:           bankKeeper,
 		accountKeeper:        accKeeper,
+		distrKeeper:          distrKeeper,
 		epochKeeper:          epochKeeper,
 		ics4WrapperKeeper:    ics4WrapperKeeper,
 		channelKeeper:        channelKeeper,
@@ -170,16 +173,3 @@ func (k Keeper) MintTokens(ctx sdk.Context, mintCoin sdk.Coin, delegatorAddress
 func (k Keeper) SendTokensToDepositModule(ctx sdk.Context, depositCoin sdk.Coins, senderAddress sdk.AccAddress) error {
 	return k.bankKeeper.SendCoinsFromAccountToModule(ctx, senderAddress, types.DepositModuleAccount, depositCoin)
 }
-
-// SendProtocolFee to the community pool
-func (k Keeper) SendProtocolFee(ctx sdk.Context, protocolFee sdk.Coins, moduleAccount, pstakeFeeAddressString string) error {
-	addr, err := sdk.AccAddressFromBech32(pstakeFeeAddressString)
-	if err != nil {
-		return err
-	}
-	err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, moduleAccount, addr, protocolFee)
-	if err != nil {
-		return err
-	}
-	return nil
-}
@@ -126,6 +126,7 @@ func (suite *IntegrationTestSuite) SetupTest() {
 		redemptionFee,
 	)
 	suite.app.LSCosmosKeeper.SetHostChainParams(suite.ctx, hostChainParams)
+	suite.app.LSCosmosKeeper.SetRoles(suite.ctx, types.Roles{Admins: []string{PstakeFeeAddress}})
 	suite.app.LSCosmosKeeper.SetHostAccounts(suite.ctx, types.HostAccounts{
 		DelegatorAccountOwnerID: "Del_acc",
 		RewardsAccountOwnerID:   "Rew_acc",
@@ -0,0 +1,38 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	v3 "github.com/persistenceOne/pstake-native/v2/x/lscosmos/migrations/v3"
+	v4 "github.com/persistenceOne/pstake-native/v2/x/lscosmos/migrations/v4"
+	v5 "github.com/persistenceOne/pstake-native/v2/x/lscosmos/migrations/v5"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate2to3 migrates the lscosmos store from consensus version 2 to 3, epoch numbers in store keys are
+// re-encoded as big endian bytes.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	return v3.MigrateStore(ctx, m.keeper.storeKey)
+}
+
+// Migrate3to4 migrates the lscosmos store from consensus version 3 to 4, host account delegations and
+// undelegations are stored per validator and per epoch.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	return v4.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc)
+}
+
+// Migrate4to5 migrates the lscosmos store from consensus version 4 to 5, the delegators with an unbonding epoch
+// entry are indexed per epoch, the pstake fee address is seeded as the admin if no admins are set and the signaling
+// host chain proposals are indexed by voting end time.
+func (m Migrator) Migrate4to5(ctx sdk.Context) error {
+	return v5.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc)
+}
@@ -4,11 +4,13 @@ import (
 	"context"
 	"fmt"
 	"strconv"
+	"strings"
 
 	errorsmod "cosmossdk.io/errors"
 	sdktypes "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	ibctransfertypes "github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
 	ibcchanneltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
@@ -38,6 +40,9 @@ func (m msgServer) LiquidStake(goCtx context.Context, msg *types.MsgLiquidStake)
 	if !m.GetModuleState(ctx) {
 		return nil, types.ErrModuleDisabled
 	}
+	if err := m.CheckNotPaused(ctx, types.PauseSwitchDeposits); err != nil {
+		return nil, err
+	}
 
 	//GetParams
 	hostChainParams := m.GetHostChainParams(ctx)
@@ -76,6 +81,11 @@ func (m msgServer) LiquidStake(goCtx context.Context, msg *types.MsgLiquidStake)
 		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "got error : %s", err)
 	}
 
+	// check the deposit against the tvl cap, per address deposit cap and per epoch deposit limit
+	if err = m.CheckDepositLimits(ctx, delegatorAddress, msg.Amount.Amount); err != nil {
+		return nil, err
+	}
+
 	// amount of stk tokens to be minted. We calculate this before depositing any amount so as to not affect minting c-value.
 	// We do not care about residue here because it won't be minted and bank.TotalSupply invariant should not be affected
 	cValue := m.GetCValue(ctx)
@@ -89,6 +99,7 @@ func (m msgServer) LiquidStake(goCtx context.Context, msg *types.MsgLiquidStake)
 			types.ErrFailedDeposit, "failed to deposit tokens to module account %s, got error : %s", types.DepositModuleAccount, err,
 		)
 	}
+	m.AddDeposit(ctx, delegatorAddress, msg.Amount.Amount)
 
 	//Mint staked representative tokens in lscosmos module account
 	err = m.bankKeeper.MintCoins(ctx, types.ModuleName, sdktypes.NewCoins(mintToken))
@@ -116,11 +127,10 @@ func (m msgServer) LiquidStake(goCtx context.Context, msg *types.MsgLiquidStake)
 
 	//Send protocol fee to protocol pool
 	if protocolCoin.IsPositive() {
-		err = m.SendProtocolFee(ctx, sdktypes.NewCoins(protocolCoin), types.ModuleName, hostChainParams.PstakeParams.PstakeFeeAddress)
+		err = m.SendProtocolFee(ctx, sdktypes.NewCoins(protocolCoin), types.ModuleName, types.FeeTypeDeposit)
 		if err != nil {
 			return nil, errorsmod.Wrapf(
-				types.ErrFailedDeposit, "failed to send protocol fee to pstake fee address %s, got error : %s",
-				hostChainParams.PstakeParams.PstakeFeeAddress, err,
+				types.ErrFailedDeposit, "failed to send protocol fee to fee recipients, got error : %s", err,
 			)
 		}
 	}
@@ -149,6 +159,9 @@ func (m msgServer) LiquidUnstake(goCtx context.Context, msg *types.MsgLiquidUnst
 	if !m.GetModuleState(ctx) {
 		return nil, types.ErrModuleDisabled
 	}
+	if err := m.CheckNotPaused(ctx, types.PauseSwitchUnstakes); err != nil {
+		return nil, err
+	}
 
 	hostChainParams := m.GetHostChainParams(ctx)
 
@@ -170,13 +183,17 @@ func (m msgServer) LiquidUnstake(goCtx context.Context, msg *types.MsgLiquidUnst
 	pstakeFeeAmt := hostChainParams.PstakeParams.PstakeUnstakeFee.MulInt(msg.Amount.Amount).TruncateInt()
 	pstakeFee := sdktypes.NewCoin(msg.Amount.Denom, pstakeFeeAmt)
 	if pstakeFeeAmt.IsPositive() {
-		err = m.SendProtocolFee(ctx, sdktypes.NewCoins(pstakeFee), types.UndelegationModuleAccount, hostChainParams.PstakeParams.PstakeFeeAddress)
+		err = m.SendProtocolFee(ctx, sdktypes.NewCoins(pstakeFee), types.UndelegationModuleAccount, types.FeeTypeUnstake)
 		if err != nil {
 			return nil, err
 		}
 		unstakeCoin = msg.Amount.Sub(pstakeFee)
 	}
 
+	// the unstaked stk tokens no longer count against the per address deposit cap
+	unstakeToken, _ := m.ConvertStkToToken(ctx, sdktypes.NewDecCoinFromCoin(msg.Amount), m.GetCValue(ctx))
+	m.RemoveDeposit(ctx, delegatorAddress, unstakeToken.Amount)
+
 	// Add entry to unbonding db
 	epoch := m.epochKeeper.GetEpochInfo(ctx, types.UndelegationEpochIdentifier)
 	unbondingEpochNumber := types.CurrentUnbondingEpoch(epoch.CurrentEpoch)
@@ -186,12 +203,11 @@ func (m msgServer) LiquidUnstake(goCtx context.Context, msg *types.MsgLiquidUnst
 	// check is there are delegations worth the amount to be undelegated.
 	// there are chances where the delegation epoch is not yet done so stkAtom are more than delegated amount
 	// in this case users should just redeem tokens. (as tokens should be present as part of deposit tokens)
-	delegationState := m.GetDelegationState(ctx)
 	undelegations, err := m.GetHostAccountUndelegationForEpoch(ctx, unbondingEpochNumber)
 	if err != nil {
 		return nil, err
 	}
-	totalDelegations := delegationState.TotalDelegations(hostChainParams.BaseDenom)
+	totalDelegations := sdktypes.NewCoin(hostChainParams.BaseDenom, m.GetStakedAmount(ctx))
 	baseDenomUndelegations, _ := m.ConvertStkToToken(ctx, sdktypes.NewDecCoinFromCoin(undelegations.TotalUndelegationAmount), m.GetCValue(ctx))
 	if totalDelegations.IsLT(sdktypes.NewCoin(hostChainParams.BaseDenom, baseDenomUndelegations.Amount)) {
 		return nil, errorsmod.Wrapf(types.ErrHostChainDelegationsLTUndelegations, "Delegated amount: %s is less than total undelegations for the epoch: %s", totalDelegations, undelegations.TotalUndelegationAmount)
@@ -222,6 +238,9 @@ func (m msgServer) Redeem(goCtx context.Context, msg *types.MsgRedeem) (*types.M
 	if !m.GetModuleState(ctx) {
 		return nil, types.ErrModuleDisabled
 	}
+	if err := m.CheckNotPaused(ctx, types.PauseSwitchRedeems); err != nil {
+		return nil, err
+	}
 
 	// take redeem address from msg address string
 	redeemAddress, err := sdktypes.AccAddressFromBech32(msg.DelegatorAddress)
@@ -256,11 +275,10 @@ func (m msgServer) Redeem(goCtx context.Context, msg *types.MsgRedeem) (*types.M
 
 	// send protocol fee to protocol pool
 	if protocolCoin.IsPositive() {
-		err = m.SendProtocolFee(ctx, sdktypes.NewCoins(protocolCoin), types.ModuleName, hostChainParams.PstakeParams.PstakeFeeAddress)
+		err = m.SendProtocolFee(ctx, sdktypes.NewCoins(protocolCoin), types.ModuleName, types.FeeTypeRedemption)
 		if err != nil {
 			return nil, errorsmod.Wrapf(
-				types.ErrFailedDeposit, "failed to send protocol fee to pstake fee address %s, got error : %s",
-				hostChainParams.PstakeParams.PstakeFeeAddress, err,
+				types.ErrFailedDeposit, "failed to send protocol fee to fee recipients, got error : %s", err,
 			)
 		}
 	}
@@ -294,6 +312,10 @@ func (m msgServer) Redeem(goCtx context.Context, msg *types.MsgRedeem) (*types.M
 		)
 	}
 
+	// the redeemed stk tokens, fee included, no longer count against the per address deposit cap
+	redeemedDeposit, _ := m.ConvertStkToToken(ctx, sdktypes.NewDecCoinFromCoin(msg.Amount), m.GetCValue(ctx))
+	m.RemoveDeposit(ctx, redeemAddress, redeemedDeposit.Amount)
+
 	ctx.EventManager().EmitEvents(sdktypes.Events{
 		sdktypes.NewEvent(
 			types.EventTypeRedeem,
@@ -319,6 +341,9 @@ func (m msgServer) Claim(goCtx context.Context, msg *types.MsgClaim) (*types.Msg
 	if !m.GetModuleState(ctx) {
 		return nil, types.ErrModuleDisabled
 	}
+	if err := m.CheckNotPaused(ctx, types.PauseSwitchClaims); err != nil {
+		return nil, err
+	}
 
 	// get AccAddress from bech32 string
 	delegatorAddress, err := sdktypes.AccAddressFromBech32(msg.DelegatorAddress)
@@ -326,47 +351,8 @@ func (m msgServer) Claim(goCtx context.Context, msg *types.MsgClaim) (*types.Msg
 		return nil, err
 	}
 
-	// get all the entries corresponding to the delegator address
-	delegatorUnbondingEntries := m.IterateDelegatorUnbondingEpochEntry(ctx, delegatorAddress)
-
-	// loop through all the epoch and send tokens if an entry has matured.
-	for _, unbondingEntry := range delegatorUnbondingEntries {
-		unbondingEpochCValue := m.GetUnbondingEpochCValue(ctx, unbondingEntry.EpochNumber)
-		if unbondingEpochCValue.IsMatured {
-			// get c value from the UnbondingEpochCValue struct
-			// calculate claimable amount from un inverse c value
-			claimableAmount := sdktypes.NewDecFromInt(unbondingEntry.Amount.Amount).Quo(unbondingEpochCValue.GetUnbondingEpochCValue())
-
-			// calculate claimable coin and community coin to be sent to delegator account and community pool respectively
-			claimableCoin, _ := sdktypes.NewDecCoinFromDec(m.GetIBCDenom(ctx), claimableAmount).TruncateDecimal()
-
-			// send coin to delegator address from undelegation module account
-			err = m.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.UndelegationModuleAccount, delegatorAddress, sdktypes.NewCoins(claimableCoin))
-			if err != nil {
-				return nil, err
-			}
-
-			ctx.EventManager().EmitEvents(sdktypes.Events{
-				sdktypes.NewEvent(
-					types.EventTypeClaim,
-					sdktypes.NewAttribute(types.AttributeDelegatorAddress, delegatorAddress.String()),
-					sdktypes.NewAttribute(types.AttributeAmount, unbondingEntry.Amount.String()),
-					sdktypes.NewAttribute(types.AttributeClaimedAmount, claimableAmount.String()),
-				)},
-			)
-
-			// remove entry from unbonding epoch entry
-			m.RemoveDelegatorUnbondingEpochEntry(ctx, delegatorAddress, unbondingEntry.EpochNumber)
-		}
-		if unbondingEpochCValue.IsFailed {
-			err = m.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.UndelegationModuleAccount, delegatorAddress, sdktypes.NewCoins(unbondingEntry.Amount))
-			if err != nil {
-				return nil, err
-			}
-
-			// remove entry from unbonding epoch entry
-			m.RemoveDelegatorUnbondingEpochEntry(ctx, delegatorAddress, unbondingEntry.EpochNumber)
-		}
+	if err = m.ClaimDelegatorUnbondingEpochEntries(ctx, delegatorAddress); err != nil {
+		return nil, err
 	}
 
 	// emit event
@@ -385,10 +371,10 @@ func (m msgServer) Claim(goCtx context.Context, msg *types.MsgClaim) (*types.Msg
 func (m msgServer) JumpStart(goCtx context.Context, msg *types.MsgJumpStart) (*types.MsgJumpStartResponse, error) {
 	ctx := sdktypes.UnwrapSDKContext(goCtx)
 
-	// check pstake fee address == from addr
+	// check from addr is an admin
 	hostChainParams := m.GetHostChainParams(ctx)
-	if msg.PstakeAddress != hostChainParams.PstakeParams.PstakeFeeAddress {
-		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("msg.pstakeAddress should be equal to msg.PstakeParams.PstakeFeeAddress, got %s expected %s", msg.PstakeAddress, hostChainParams.PstakeParams.PstakeFeeAddress))
+	if !m.IsAdmin(ctx, msg.PstakeAddress) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "only admins are allowed to call this method, got %s", msg.PstakeAddress)
 	}
 
 	// check module disabled
@@ -530,8 +516,12 @@ func (m msgServer) ChangeModuleState(goCtx context.Context, msg *types.MsgChange
 	if hostChainParams.IsEmpty() {
 		return nil, types.ErrModuleNotInitialised
 	}
-	if hostChainParams.PstakeParams.PstakeFeeAddress != msg.PstakeAddress {
-		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, fmt.Sprintf("Only admin address is allowed to call this method, current admin address: %s", hostChainParams.PstakeParams.PstakeFeeAddress))
+	// pausers can only disable the module, enabling it is left to the admins
+	if msg.ModuleState && !m.IsAdmin(ctx, msg.PstakeAddress) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "only admins are allowed to enable the module, got %s", msg.PstakeAddress)
+	}
+	if !msg.ModuleState && !m.IsPauser(ctx, msg.PstakeAddress) && !m.IsAdmin(ctx, msg.PstakeAddress) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "only pausers and admins are allowed to disable the module, got %s", msg.PstakeAddress)
 	}
 	moduleState := m.Keeper.GetModuleState(ctx)
 	if moduleState == msg.ModuleState {
@@ -567,11 +557,11 @@ func (m msgServer) ReportSlashing(goCtx context.Context, msg *types.MsgReportSla
 	if hostChainParams.IsEmpty() {
 		return nil, types.ErrModuleNotInitialised
 	}
-	if hostChainParams.PstakeParams.PstakeFeeAddress != msg.PstakeAddress {
-		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "Only admin address is allowed to call this method, current admin address: %s", hostChainParams.PstakeParams.PstakeFeeAddress)
+	if !m.IsSlashingReporter(ctx, msg.PstakeAddress) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "only slashing reporters are allowed to call this method, got %s", msg.PstakeAddress)
 	}
 
-	delegationState := m.Keeper.GetDelegationState(ctx)
+	delegationState := m.Keeper.getDelegationAccountState(ctx)
 	_, hostAccountDelegations := m.Keeper.GetAllValidatorsState(ctx, hostChainParams.BaseDenom)
 	exists := false
 	for _, val := range hostAccountDelegations {
@@ -613,3 +603,263 @@ func (m msgServer) ReportSlashing(goCtx context.Context, msg *types.MsgReportSla
 	)
 	return &types.MsgReportSlashingResponse{}, nil
 }
+
+// TransferUnbondingEntry defines a method for transferring a delegator unbonding epoch entry to another address
+func (m msgServer) TransferUnbondingEntry(goCtx context.Context, msg *types.MsgTransferUnbondingEntry) (*types.MsgTransferUnbondingEntryResponse, error) {
+	ctx := sdktypes.UnwrapSDKContext(goCtx)
+
+	// check if module is inactive or active
+	if !m.GetModuleState(ctx) {
+		return nil, types.ErrModuleDisabled
+	}
+
+	delegatorAddress, err := sdktypes.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "got error : %s", err)
+	}
+	recipientAddress, err := sdktypes.AccAddressFromBech32(msg.RecipientAddress)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "got error : %s", err)
+	}
+
+	err = m.TransferDelegatorUnbondingEpochEntry(ctx, delegatorAddress, recipientAddress, msg.EpochNumber, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdktypes.Events{
+		sdktypes.NewEvent(
+			types.EventTypeTransferUnbonding,
+			sdktypes.NewAttribute(types.AttributeDelegatorAddress, msg.DelegatorAddress),
+			sdktypes.NewAttribute(types.AttributeRecipientAddress, msg.RecipientAddress),
+			sdktypes.NewAttribute(types.AttributeEpochNumber, strconv.FormatInt(msg.EpochNumber, 10)),
+			sdktypes.NewAttribute(types.AttributeAmount, msg.Amount.String()),
+		),
+		sdktypes.NewEvent(
+			sdktypes.EventTypeMessage,
+			sdktypes.NewAttribute(sdktypes.AttributeKeyModule, types.AttributeValueCategory),
+			sdktypes.NewAttribute(sdktypes.AttributeKeySender, msg.DelegatorAddress),
+		)},
+	)
+	return &types.MsgTransferUnbondingEntryResponse{}, nil
+}
+
+// ClaimFor defines a method for claiming unstaked mature tokens or failed unbondings on behalf of a delegator,
+// tokens are always sent to the delegator address
+func (m msgServer) ClaimFor(goCtx context.Context, msg *types.MsgClaimFor) (*types.MsgClaimForResponse, error) {
+	ctx := sdktypes.UnwrapSDKContext(goCtx)
+
+	// check if module is inactive or active
+	if !m.GetModuleState(ctx) {
+		return nil, types.ErrModuleDisabled
+	}
+	if err := m.CheckNotPaused(ctx, types.PauseSwitchClaims); err != nil {
+		return nil, err
+	}
+
+	delegatorAddress, err := sdktypes.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "got error : %s", err)
+	}
+
+	if err = m.ClaimDelegatorUnbondingEpochEntries(ctx, delegatorAddress); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdktypes.Events{
+		sdktypes.NewEvent(
+			types.EventTypeClaimFor,
+			sdktypes.NewAttribute(types.AttributeClaimerAddress, msg.ClaimerAddress),
+			sdktypes.NewAttribute(types.AttributeDelegatorAddress, msg.DelegatorAddress),
+		),
+		sdktypes.NewEvent(
+			sdktypes.EventTypeMessage,
+			sdktypes.NewAttribute(sdktypes.AttributeKeyModule, types.AttributeValueCategory),
+			sdktypes.NewAttribute(sdktypes.AttributeKeySender, msg.ClaimerAddress),
+		)},
+	)
+	return &types.MsgClaimForResponse{}, nil
+}
+
+// SetAutoClaim defines a method for opting a delegator in or out of automatic claims of matured unbonding entries
+func (m msgServer) SetAutoClaim(goCtx context.Context, msg *types.MsgSetAutoClaim) (*types.MsgSetAutoClaimResponse, error) {
+	ctx := sdktypes.UnwrapSDKContext(goCtx)
+
+	// check if module is inactive or active
+	if !m.GetModuleState(ctx) {
+		return nil, types.ErrModuleDisabled
+	}
+
+	delegatorAddress, err := sdktypes.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "got error : %s", err)
+	}
+
+	m.SetAutoClaimState(ctx, delegatorAddress, msg.Enabled)
+
+	ctx.EventManager().EmitEvents(sdktypes.Events{
+		sdktypes.NewEvent(
+			types.EventTypeSetAutoClaim,
+			sdktypes.NewAttribute(types.AttributeDelegatorAddress, msg.DelegatorAddress),
+			sdktypes.NewAttribute(types.AttributeAutoClaimEnabled, strconv.FormatBool(msg.Enabled)),
+		),
+		sdktypes.NewEvent(
+			sdktypes.EventTypeMessage,
+			sdktypes.NewAttribute(sdktypes.AttributeKeyModule, types.AttributeValueCategory),
+			sdktypes.NewAttribute(sdktypes.AttributeKeySender, msg.DelegatorAddress),
+		)},
+	)
+	return &types.MsgSetAutoClaimResponse{}, nil
+}
+
+// UpdateRoles defines an admin method for updating the module roles, admin changes are applied
+// after the admin timelock
+func (m msgServer) UpdateRoles(goCtx context.Context, msg *types.MsgUpdateRoles) (*types.MsgUpdateRolesResponse, error) {
+	ctx := sdktypes.UnwrapSDKContext(goCtx)
+
+	if !m.IsAdmin(ctx, msg.AdminAddress) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "only admins are allowed to call this method, got %s", msg.AdminAddress)
+	}
+	if err := msg.Roles.Validate(); err != nil {
+		return nil, err
+	}
+
+	msgAttributes := []sdktypes.Attribute{
+		sdktypes.NewAttribute(types.AttributeAdminAddress, msg.AdminAddress),
+		sdktypes.NewAttribute(types.AttributeAdmins, strings.Join(msg.Roles.Admins, ",")),
+		sdktypes.NewAttribute(types.AttributePausers, strings.Join(msg.Roles.Pausers, ",")),
+		sdktypes.NewAttribute(types.AttributeSlashingReporters, strings.Join(msg.Roles.SlashingReporters, ",")),
+	}
+	pendingAdminChange, err := m.Keeper.UpdateRoles(ctx, msg.Roles)
+	if err != nil {
+		return nil, err
+	}
+	if pendingAdminChange != nil {
+		msgAttributes = append(msgAttributes, sdktypes.NewAttribute(types.AttributeEffectiveTime, pendingAdminChange.EffectiveTime.String()))
+	}
+
+	ctx.EventManager().EmitEvents(sdktypes.Events{
+		sdktypes.NewEvent(
+			types.EventTypeUpdateRoles,
+			msgAttributes...,
+		),
+		sdktypes.NewEvent(
+			sdktypes.EventTypeMessage,
+			sdktypes.NewAttribute(sdktypes.AttributeKeyModule, types.AttributeValueCategory),
+			sdktypes.NewAttribute(sdktypes.AttributeKeySender, msg.AdminAddress),
+		)},
+	)
+	return &types.MsgUpdateRolesResponse{}, nil
+}
+
+// SetPauseSwitches defines a method for pausing and resuming operations of the module, pausers can only
+// pause operations while resuming any of them is left to the admins
+func (m msgServer) SetPauseSwitches(goCtx context.Context, msg *types.MsgSetPauseSwitches) (*types.MsgSetPauseSwitchesResponse, error) {
+	ctx := sdktypes.UnwrapSDKContext(goCtx)
+
+	if msg.PauseSwitches.Resumes(m.GetPauseSwitches(ctx)) {
+		if !m.IsAdmin(ctx, msg.PstakeAddress) {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "only admins are allowed to resume operations, got %s", msg.PstakeAddress)
+		}
+	} else if !m.IsPauser(ctx, msg.PstakeAddress) && !m.IsAdmin(ctx, msg.PstakeAddress) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "only pausers and admins are allowed to pause operations, got %s", msg.PstakeAddress)
+	}
+
+	m.Keeper.SetPauseSwitches(ctx, msg.PauseSwitches)
+
+	ctx.EventManager().EmitEvents(sdktypes.Events{
+		sdktypes.NewEvent(
+			types.EventTypeSetPauseSwitches,
+			sdktypes.NewAttribute(types.AttributePaused, strings.Join(msg.PauseSwitches.PausedNames(), ",")),
+		),
+		sdktypes.NewEvent(
+			sdktypes.EventTypeMessage,
+			sdktypes.NewAttribute(sdktypes.AttributeKeyModule, types.AttributeValueCategory),
+			sdktypes.NewAttribute(sdktypes.AttributeKeySender, msg.PstakeAddress),
+		)},
+	)
+	return &types.MsgSetPauseSwitchesResponse{}, nil
+}
+
+// RegisterHostProposal defines an admin method for registering a host chain proposal, stk holders can signal
+// their votes on it until the weighted vote is sent before the end of its voting period
+func (m msgServer) RegisterHostProposal(goCtx context.Context, msg *types.MsgRegisterHostProposal) (*types.MsgRegisterHostProposalResponse, error) {
+	ctx := sdktypes.UnwrapSDKContext(goCtx)
+
+	if !m.IsAdmin(ctx, msg.AdminAddress) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "only admins are allowed to call this method, got %s", msg.AdminAddress)
+	}
+	if _, found := m.GetHostProposal(ctx, msg.ProposalId); found {
+		return nil, errorsmod.Wrapf(types.ErrHostProposalExists, "host proposal %d", msg.ProposalId)
+	}
+	if !msg.VotingEndTime.After(ctx.BlockTime()) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "voting end time %s is not after the block time", msg.VotingEndTime)
+	}
+
+	m.SetHostProposal(ctx, types.HostProposal{
+		ProposalId:    msg.ProposalId,
+		VotingEndTime: msg.VotingEndTime,
+		Status:        types.HOST_PROPOSAL_STATUS_SIGNALING,
+		Tally:         types.NewHostProposalTally(),
+	})
+
+	ctx.EventManager().EmitEvents(sdktypes.Events{
+		sdktypes.NewEvent(
+			types.EventTypeRegisterHostProposal,
+			sdktypes.NewAttribute(types.AttributeProposalID, strconv.FormatUint(msg.ProposalId, 10)),
+			sdktypes.NewAttribute(types.AttributeVotingEndTime, msg.VotingEndTime.String()),
+		),
+		sdktypes.NewEvent(
+			sdktypes.EventTypeMessage,
+			sdktypes.NewAttribute(sdktypes.AttributeKeyModule, types.AttributeValueCategory),
+			sdktypes.NewAttribute(sdktypes.AttributeKeySender, msg.AdminAddress),
+		)},
+	)
+	return &types.MsgRegisterHostProposalResponse{}, nil
+}
+
+// VoteHostProposal defines a method for stk holders to signal their vote on a host chain proposal, a new signal
+// replaces the previous one of the voter
+func (m msgServer) VoteHostProposal(goCtx context.Context, msg *types.MsgVoteHostProposal) (*types.MsgVoteHostProposalResponse, error) {
+	ctx := sdktypes.UnwrapSDKContext(goCtx)
+
+	hostProposal, found := m.GetHostProposal(ctx, msg.ProposalId)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrHostProposalNotFound, "host proposal %d", msg.ProposalId)
+	}
+	if hostProposal.Status != types.HOST_PROPOSAL_STATUS_SIGNALING {
+		return nil, errorsmod.Wrapf(types.ErrHostProposalNotSignaling, "host proposal %d has status %s", msg.ProposalId, hostProposal.Status)
+	}
+	if hostProposal.SnapshotHeight != 0 {
+		return nil, errorsmod.Wrapf(types.ErrHostProposalNotSignaling, "host proposal %d was tallied at height %d", msg.ProposalId, hostProposal.SnapshotHeight)
+	}
+
+	voter, err := sdktypes.AccAddressFromBech32(msg.Voter)
+	if err != nil {
+		return nil, err
+	}
+	mintDenom := m.GetHostChainParams(ctx).MintDenom
+	if !m.bankKeeper.GetBalance(ctx, voter, mintDenom).IsPositive() {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInsufficientFunds, "voter %s has no %s", msg.Voter, mintDenom)
+	}
+
+	m.SetHostProposalVote(ctx, types.HostProposalVote{
+		ProposalId: msg.ProposalId,
+		Voter:      msg.Voter,
+		Options:    msg.Options,
+	})
+
+	ctx.EventManager().EmitEvents(sdktypes.Events{
+		sdktypes.NewEvent(
+			types.EventTypeVoteHostProposal,
+			sdktypes.NewAttribute(types.AttributeProposalID, strconv.FormatUint(msg.ProposalId, 10)),
+			sdktypes.NewAttribute(types.AttributeVoteOptions, govtypes.WeightedVoteOptions(msg.Options).String()),
+		),
+		sdktypes.NewEvent(
+			sdktypes.EventTypeMessage,
+			sdktypes.NewAttribute(sdktypes.AttributeKeyModule, types.AttributeValueCategory),
+			sdktypes.NewAttribute(sdktypes.AttributeKeySender, msg.Voter),
+		)},
+	)
+	return &types.MsgVoteHostProposalResponse{}, nil
+}
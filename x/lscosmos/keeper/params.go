@@ -5,9 +5,11 @@ import (
 	"github.com/persistenceOne/pstake-native/v2/x/lscosmos/types"
 )
 
-// GetParams get all parameters as types.Params
+// GetParams get all parameters as types.Params, params missing from the store keep their default value
 func (k Keeper) GetParams(ctx sdk.Context) types.Params {
-	return types.NewParams()
+	params := types.DefaultParams()
+	k.paramstore.GetParamSetIfExists(ctx, &params)
+	return params
 }
 
 // SetParams set the params
@@ -1,6 +1,10 @@
 package keeper_test
 
 import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"github.com/persistenceOne/pstake-native/v2/x/lscosmos/types"
 )
 
@@ -10,3 +14,18 @@ func (suite *IntegrationTestSuite) TestGetParams() {
 	params := types.DefaultParams()
 	suite.Equal(params, app.LSCosmosKeeper.GetParams(ctx))
 }
+
+func (suite *IntegrationTestSuite) TestSetParams() {
+	app, ctx := suite.app, suite.ctx
+
+	validatorWeighting := types.DefaultValidatorWeighting()
+	validatorWeighting.Enabled = true
+	validatorWeighting.WeightCap = sdk.NewDecWithPrec(5, 1)
+	params := types.NewParams(
+		sdk.NewInt(1000000), sdk.NewInt(1000), sdk.NewInt(10000), time.Hour, validatorWeighting,
+		sdk.NewDecWithPrec(1, 3), types.EXCESS_REWARD_POLICY_INSURANCE_FUND,
+		sdk.MustBech32ifyAddressBytes(types.CosmosAccountPrefix, sdk.AccAddress("insurance___________")), time.Hour,
+	)
+	app.LSCosmosKeeper.SetParams(ctx, params)
+	suite.Equal(params, app.LSCosmosKeeper.GetParams(ctx))
+}
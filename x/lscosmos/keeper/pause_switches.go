@@ -0,0 +1,39 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/persistenceOne/pstake-native/v2/x/lscosmos/types"
+)
+
+// SetPauseSwitches sets the pause switches in the store
+func (k Keeper) SetPauseSwitches(ctx sdk.Context, pauseSwitches types.PauseSwitches) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.PauseSwitchesKey, k.cdc.MustMarshal(&pauseSwitches))
+}
+
+// GetPauseSwitches gets the pause switches from the store, nothing is paused if they were never set
+func (k Keeper) GetPauseSwitches(ctx sdk.Context) types.PauseSwitches {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.PauseSwitchesKey)
+	if bz == nil {
+		return types.PauseSwitches{}
+	}
+	var pauseSwitches types.PauseSwitches
+	k.cdc.MustUnmarshal(bz, &pauseSwitches)
+	return pauseSwitches
+}
+
+// IsPaused returns true if the named operation is paused
+func (k Keeper) IsPaused(ctx sdk.Context, name string) bool {
+	return k.GetPauseSwitches(ctx).IsPaused(name)
+}
+
+// CheckNotPaused returns ErrOperationPaused if the named operation is paused
+func (k Keeper) CheckNotPaused(ctx sdk.Context, name string) error {
+	if k.IsPaused(ctx, name) {
+		return errorsmod.Wrap(types.ErrOperationPaused, name)
+	}
+	return nil
+}
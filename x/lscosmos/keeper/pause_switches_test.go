@@ -0,0 +1,85 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	lscosmoskeeper "github.com/persistenceOne/pstake-native/v2/x/lscosmos/keeper"
+	"github.com/persistenceOne/pstake-native/v2/x/lscosmos/types"
+)
+
+func (suite *IntegrationTestSuite) TestSetPauseSwitches() {
+	app, ctx := suite.app, suite.ctx
+	keeper := app.LSCosmosKeeper
+	msgServer := lscosmoskeeper.NewMsgServerImpl(keeper)
+
+	pauser := sdk.AccAddress("pauser______________").String()
+	delegator := sdk.AccAddress("delegator___________")
+	keeper.SetRoles(ctx, types.Roles{Admins: []string{PstakeFeeAddress}, Pausers: []string{pauser}})
+	keeper.SetModuleState(ctx, true)
+
+	pauseSwitches, err := types.NewPauseSwitches([]string{types.PauseSwitchDeposits, types.PauseSwitchUnstakes})
+	suite.NoError(err)
+	_, err = msgServer.SetPauseSwitches(sdk.WrapSDKContext(ctx), types.NewMsgSetPauseSwitches(delegator, pauseSwitches))
+	suite.ErrorIs(err, sdkerrors.ErrUnauthorized)
+	_, err = msgServer.SetPauseSwitches(sdk.WrapSDKContext(ctx), &types.MsgSetPauseSwitches{PstakeAddress: pauser, PauseSwitches: pauseSwitches})
+	suite.NoError(err)
+	suite.Equal([]string{types.PauseSwitchDeposits, types.PauseSwitchUnstakes}, keeper.GetPauseSwitches(ctx).PausedNames())
+
+	// deposits are stopped while claims still go through
+	_, err = msgServer.LiquidStake(sdk.WrapSDKContext(ctx), types.NewMsgLiquidStake(sdk.NewInt64Coin(keeper.GetIBCDenom(ctx), 100), delegator))
+	suite.ErrorIs(err, types.ErrOperationPaused)
+	_, err = msgServer.Claim(sdk.WrapSDKContext(ctx), types.NewMsgClaim(delegator))
+	suite.NoError(err)
+
+	// pausers cannot resume operations
+	_, err = msgServer.SetPauseSwitches(sdk.WrapSDKContext(ctx), &types.MsgSetPauseSwitches{PstakeAddress: pauser, PauseSwitches: types.PauseSwitches{Deposits: true}})
+	suite.ErrorIs(err, sdkerrors.ErrUnauthorized)
+	_, err = msgServer.SetPauseSwitches(sdk.WrapSDKContext(ctx), &types.MsgSetPauseSwitches{PstakeAddress: PstakeFeeAddress, PauseSwitches: types.PauseSwitches{Deposits: true}})
+	suite.NoError(err)
+	suite.False(keeper.IsPaused(ctx, types.PauseSwitchUnstakes))
+
+	res, err := keeper.ModuleStatus(sdk.WrapSDKContext(ctx), &types.QueryModuleStatusRequest{})
+	suite.NoError(err)
+	suite.True(res.ModuleEnabled)
+	suite.Equal(types.PauseSwitches{Deposits: true}, res.PauseSwitches)
+}
+
+func (suite *IntegrationTestSuite) TestPausedUndelegationEpochCarriedOver() {
+	app, ctx := suite.app, suite.ctx
+	keeper := app.LSCosmosKeeper
+	keeper.SetModuleState(ctx, true)
+	keeper.SetPauseSwitches(ctx, types.PauseSwitches{UndelegationEpoch: true})
+
+	mintDenom := keeper.GetHostChainParams(ctx).MintDenom
+	delegator1 := sdk.AccAddress("delegator1__________")
+	delegator2 := sdk.AccAddress("delegator2__________")
+	epochNumber := types.UndelegationEpochNumberFactor
+	nextEpochNumber := epochNumber + types.UndelegationEpochNumberFactor
+
+	keeper.AddDelegatorUnbondingEpochEntry(ctx, delegator1, epochNumber, sdk.NewInt64Coin(mintDenom, 100))
+	keeper.AddDelegatorUnbondingEpochEntry(ctx, delegator2, epochNumber, sdk.NewInt64Coin(mintDenom, 200))
+	keeper.AddTotalUndelegationForEpoch(ctx, epochNumber, sdk.NewInt64Coin(mintDenom, 300))
+	// delegator2 already unstaked for the next epoch
+	keeper.AddDelegatorUnbondingEpochEntry(ctx, delegator2, nextEpochNumber, sdk.NewInt64Coin(mintDenom, 50))
+	keeper.AddTotalUndelegationForEpoch(ctx, nextEpochNumber, sdk.NewInt64Coin(mintDenom, 50))
+
+	suite.NoError(keeper.AfterEpochEnd(ctx, types.UndelegationEpochIdentifier, epochNumber))
+
+	// the epoch is neither undelegated nor failed
+	_, err := keeper.GetHostAccountUndelegationForEpoch(ctx, epochNumber)
+	suite.ErrorIs(err, types.ErrUndelegationEpochNotFound)
+	suite.Empty(keeper.IterateUnbondingEpochCValuesInRange(ctx, epochNumber, epochNumber))
+	suite.Empty(keeper.GetDelegatorUnbondingEpochEntry(ctx, delegator1, epochNumber).DelegatorAddress)
+	suite.Empty(keeper.GetDelegatorUnbondingEpochEntry(ctx, delegator2, epochNumber).DelegatorAddress)
+
+	nextUndelegation, err := keeper.GetHostAccountUndelegationForEpoch(ctx, nextEpochNumber)
+	suite.NoError(err)
+	suite.Equal(sdk.NewInt64Coin(mintDenom, 350), nextUndelegation.TotalUndelegationAmount)
+	suite.Equal(sdk.NewInt64Coin(mintDenom, 100), keeper.GetDelegatorUnbondingEpochEntry(ctx, delegator1, nextEpochNumber).Amount)
+	suite.Equal(sdk.NewInt64Coin(mintDenom, 250), keeper.GetDelegatorUnbondingEpochEntry(ctx, delegator2, nextEpochNumber).Amount)
+
+	res, err := keeper.UnbondingEpochDelegatorEntries(sdk.WrapSDKContext(ctx), &types.QueryUnbondingEpochDelegatorEntriesRequest{EpochNumber: nextEpochNumber})
+	suite.NoError(err)
+	suite.Len(res.DelegatorUnbondingEpochEntries, 2)
+}
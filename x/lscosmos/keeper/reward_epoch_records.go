@@ -0,0 +1,160 @@
+package keeper
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/persistenceOne/pstake-native/v2/x/lscosmos/types"
+)
+
+// SetCarriedOverRewards sets the rewards left in the rewards account after the last reward epoch
+func (k Keeper) SetCarriedOverRewards(ctx sdk.Context, carriedOver sdk.Coin) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.CarriedOverRewardsKey, k.cdc.MustMarshal(&carriedOver))
+}
+
+// GetCarriedOverRewards gets the rewards left in the rewards account after the last reward epoch, zero coins of the
+// host chain base denom are returned if none were carried over
+func (k Keeper) GetCarriedOverRewards(ctx sdk.Context) sdk.Coin {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.CarriedOverRewardsKey)
+	if bz == nil {
+		return sdk.Coin{Denom: k.GetHostChainParams(ctx).BaseDenom, Amount: sdk.ZeroInt()}
+	}
+
+	var carriedOver sdk.Coin
+	k.cdc.MustUnmarshal(bz, &carriedOver)
+	return carriedOver
+}
+
+// SetRewardEpochRecord sets the reward epoch record of its reward epoch
+func (k Keeper) SetRewardEpochRecord(ctx sdk.Context, record types.RewardEpochRecord) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetRewardEpochRecordKey(record.EpochNumber), k.cdc.MustMarshal(&record))
+}
+
+// GetRewardEpochRecord gets the reward epoch record of a reward epoch, false is returned if no rewards were
+// handled in the epoch
+func (k Keeper) GetRewardEpochRecord(ctx sdk.Context, epochNumber int64) (types.RewardEpochRecord, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetRewardEpochRecordKey(epochNumber))
+	if bz == nil {
+		return types.RewardEpochRecord{}, false
+	}
+
+	var record types.RewardEpochRecord
+	k.cdc.MustUnmarshal(bz, &record)
+	return record, true
+}
+
+// GetAllRewardEpochRecords returns all the reward epoch records in epoch order
+func (k Keeper) GetAllRewardEpochRecords(ctx sdk.Context) []types.RewardEpochRecord {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.RewardEpochRecordKey)
+	defer iterator.Close()
+
+	var records []types.RewardEpochRecord
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.RewardEpochRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &record)
+		records = append(records, record)
+	}
+	return records
+}
+
+// SetPendingRewardEpochRecord sets the reward epoch record of the rewards being sent from the rewards account
+func (k Keeper) SetPendingRewardEpochRecord(ctx sdk.Context, record types.RewardEpochRecord) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.PendingRewardEpochRecordKey, k.cdc.MustMarshal(&record))
+}
+
+// GetPendingRewardEpochRecord gets the reward epoch record of the rewards being sent from the rewards account, nil
+// is returned if there is none
+func (k Keeper) GetPendingRewardEpochRecord(ctx sdk.Context) *types.RewardEpochRecord {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.PendingRewardEpochRecordKey)
+	if bz == nil {
+		return nil
+	}
+
+	var record types.RewardEpochRecord
+	k.cdc.MustUnmarshal(bz, &record)
+	return &record
+}
+
+// RemovePendingRewardEpochRecord removes the reward epoch record of the rewards being sent from the rewards account
+func (k Keeper) RemovePendingRewardEpochRecord(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.PendingRewardEpochRecordKey)
+}
+
+// NewRewardEpochRecord returns the record of the rewards handled from the rewards account balance in the current
+// reward epoch. The rewards accrued since the previous reward epoch are the balance minus the rewards carried
+// over by it.
+func (k Keeper) NewRewardEpochRecord(
+	ctx sdk.Context, balance, restaked, sentToInsuranceFund sdk.Coin, policy types.ExcessRewardPolicy,
+) types.RewardEpochRecord {
+	accrued := balance
+	if previous := k.GetCarriedOverRewards(ctx); previous.Denom == balance.Denom {
+		accrued.Amount = sdk.MaxInt(balance.Amount.Sub(previous.Amount), sdk.ZeroInt())
+	}
+
+	return types.RewardEpochRecord{
+		EpochNumber:         k.epochKeeper.GetEpochInfo(ctx, types.RewardEpochIdentifier).CurrentEpoch,
+		Accrued:             accrued,
+		Restaked:            restaked,
+		SentToInsuranceFund: sentToInsuranceFund,
+		CarriedOver:         balance.Sub(restaked).Sub(sentToInsuranceFund),
+		ExcessRewardPolicy:  policy,
+	}
+}
+
+// RecordRewards adds the handled rewards to the record of their reward epoch and sets the rewards carried over
+// to the next reward epochs.
+func (k Keeper) RecordRewards(ctx sdk.Context, handled types.RewardEpochRecord) {
+	record, found := k.GetRewardEpochRecord(ctx, handled.EpochNumber)
+	if !found {
+		zero := sdk.NewCoin(handled.Accrued.Denom, sdk.ZeroInt())
+		record = types.RewardEpochRecord{
+			EpochNumber:         handled.EpochNumber,
+			Accrued:             zero,
+			Restaked:            zero,
+			SentToInsuranceFund: zero,
+		}
+	}
+	record.Accrued = record.Accrued.Add(handled.Accrued)
+	record.Restaked = record.Restaked.Add(handled.Restaked)
+	record.SentToInsuranceFund = record.SentToInsuranceFund.Add(handled.SentToInsuranceFund)
+	record.CarriedOver = handled.CarriedOver
+	record.ExcessRewardPolicy = handled.ExcessRewardPolicy
+	k.SetRewardEpochRecord(ctx, record)
+	k.SetCarriedOverRewards(ctx, handled.CarriedOver)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeRestakeRewards,
+		sdk.NewAttribute(types.AttributeEpochNumber, strconv.FormatInt(handled.EpochNumber, 10)),
+		sdk.NewAttribute(types.AttributeAccruedRewards, handled.Accrued.String()),
+		sdk.NewAttribute(types.AttributeRestakedRewards, handled.Restaked.String()),
+		sdk.NewAttribute(types.AttributeInsuranceFundRewards, handled.SentToInsuranceFund.String()),
+		sdk.NewAttribute(types.AttributeCarriedOverRewards, handled.CarriedOver.String()),
+	))
+}
+
+// RecordPendingRewards records the pending reward epoch record once the ICA tx sending the rewards is acknowledged.
+// The rewards of a failed or timed out tx are still in the rewards account, they are recorded as carried over.
+func (k Keeper) RecordPendingRewards(ctx sdk.Context, success bool) {
+	pending := k.GetPendingRewardEpochRecord(ctx)
+	if pending == nil {
+		return
+	}
+	k.RemovePendingRewardEpochRecord(ctx)
+
+	if !success {
+		zero := sdk.NewCoin(pending.Accrued.Denom, sdk.ZeroInt())
+		pending.CarriedOver = pending.CarriedOver.Add(pending.Restaked).Add(pending.SentToInsuranceFund)
+		pending.Restaked = zero
+		pending.SentToInsuranceFund = zero
+	}
+	k.RecordRewards(ctx, *pending)
+}
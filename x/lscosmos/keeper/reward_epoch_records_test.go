@@ -0,0 +1,134 @@
+package keeper_test
+
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	icatypes "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/persistenceOne/pstake-native/v2/x/lscosmos/types"
+)
+
+func (suite *IntegrationTestSuite) TestRecordRewards() {
+	app, ctx := suite.app, suite.ctx
+	k := app.LSCosmosKeeper
+
+	epochNumber := app.EpochsKeeper.GetEpochInfo(ctx, types.RewardEpochIdentifier).CurrentEpoch
+	suite.Equal(sdk.NewInt64Coin(BaseDenom, 0), k.GetCarriedOverRewards(ctx))
+
+	// 100 accrued, 60 restaked and 40 carried over
+	k.RecordRewards(ctx, k.NewRewardEpochRecord(ctx, sdk.NewInt64Coin(BaseDenom, 100), sdk.NewInt64Coin(BaseDenom, 60), sdk.NewInt64Coin(BaseDenom, 0), types.EXCESS_REWARD_POLICY_CARRY_FORWARD))
+	record, found := k.GetRewardEpochRecord(ctx, epochNumber)
+	suite.True(found)
+	suite.Equal(sdk.NewInt64Coin(BaseDenom, 100), record.Accrued)
+	suite.Equal(sdk.NewInt64Coin(BaseDenom, 60), record.Restaked)
+	suite.Equal(sdk.NewInt64Coin(BaseDenom, 40), record.CarriedOver)
+	suite.Equal(sdk.NewInt64Coin(BaseDenom, 40), k.GetCarriedOverRewards(ctx))
+
+	// the carried over rewards are not accrued again
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	k.RecordRewards(ctx, k.NewRewardEpochRecord(ctx, sdk.NewInt64Coin(BaseDenom, 90), sdk.NewInt64Coin(BaseDenom, 60), sdk.NewInt64Coin(BaseDenom, 30), types.EXCESS_REWARD_POLICY_INSURANCE_FUND))
+	record, found = k.GetRewardEpochRecord(ctx, epochNumber)
+	suite.True(found)
+	suite.Equal(sdk.NewInt64Coin(BaseDenom, 150), record.Accrued)
+	suite.Equal(sdk.NewInt64Coin(BaseDenom, 120), record.Restaked)
+	suite.Equal(sdk.NewInt64Coin(BaseDenom, 30), record.SentToInsuranceFund)
+	suite.Equal(sdk.NewInt64Coin(BaseDenom, 0), record.CarriedOver)
+	suite.Equal(types.EXCESS_REWARD_POLICY_INSURANCE_FUND, record.ExcessRewardPolicy)
+	suite.Equal(sdk.NewInt64Coin(BaseDenom, 0), k.GetCarriedOverRewards(ctx))
+}
+
+func (suite *IntegrationTestSuite) TestRecordPendingRewardsOnAck() {
+	app, ctx := suite.app, suite.ctx
+	k := app.LSCosmosKeeper
+
+	epochNumber := app.EpochsKeeper.GetEpochInfo(ctx, types.RewardEpochIdentifier).CurrentEpoch
+	rewardsAddress := sdk.MustBech32ifyAddressBytes(types.CosmosAccountPrefix, sdk.AccAddress("rewards_____________"))
+	delegationAddress := sdk.MustBech32ifyAddressBytes(types.CosmosAccountPrefix, sdk.AccAddress("delegation__________"))
+	k.SetHostChainRewardAddress(ctx, types.NewHostChainRewardAddress(rewardsAddress))
+	k.SetDelegationState(ctx, types.DelegationState{HostChainDelegationAddress: delegationAddress})
+
+	msgSend := &banktypes.MsgSend{
+		FromAddress: rewardsAddress,
+		ToAddress:   delegationAddress,
+		Amount:      sdk.NewCoins(sdk.NewInt64Coin(BaseDenom, 60)),
+	}
+	data, err := icatypes.SerializeCosmosTx(app.AppCodec(), []proto.Message{msgSend})
+	suite.NoError(err)
+	packetData := icatypes.InterchainAccountPacketData{Type: icatypes.EXECUTE_TX, Data: data}
+	packet := channeltypes.Packet{Data: packetData.GetBytes()}
+
+	// a timed out send leaves the rewards in the rewards account
+	k.SetPendingRewardEpochRecord(ctx, k.NewRewardEpochRecord(ctx, sdk.NewInt64Coin(BaseDenom, 100), sdk.NewInt64Coin(BaseDenom, 60), sdk.NewInt64Coin(BaseDenom, 0), types.EXCESS_REWARD_POLICY_CARRY_FORWARD))
+	suite.NoError(k.OnTimeoutPacket(ctx, packet, nil))
+	suite.Nil(k.GetPendingRewardEpochRecord(ctx))
+	record, found := k.GetRewardEpochRecord(ctx, epochNumber)
+	suite.True(found)
+	suite.Equal(sdk.NewInt64Coin(BaseDenom, 100), record.Accrued)
+	suite.Equal(sdk.NewInt64Coin(BaseDenom, 0), record.Restaked)
+	suite.Equal(sdk.NewInt64Coin(BaseDenom, 100), k.GetCarriedOverRewards(ctx))
+
+	// the acknowledged send records the restaked rewards
+	k.SetPendingRewardEpochRecord(ctx, k.NewRewardEpochRecord(ctx, sdk.NewInt64Coin(BaseDenom, 100), sdk.NewInt64Coin(BaseDenom, 60), sdk.NewInt64Coin(BaseDenom, 0), types.EXCESS_REWARD_POLICY_CARRY_FORWARD))
+	suite.Equal(sdk.NewInt64Coin(BaseDenom, 100), k.GetCarriedOverRewards(ctx))
+	msgResponse, err := codectypes.NewAnyWithValue(&banktypes.MsgSendResponse{})
+	suite.NoError(err)
+	txMsgData, err := proto.Marshal(&sdk.TxMsgData{MsgResponses: []*codectypes.Any{msgResponse}})
+	suite.NoError(err)
+	suite.NoError(k.OnAcknowledgementPacket(ctx, packet, channeltypes.NewResultAcknowledgement(txMsgData).Acknowledgement(), nil))
+	suite.Nil(k.GetPendingRewardEpochRecord(ctx))
+	record, found = k.GetRewardEpochRecord(ctx, epochNumber)
+	suite.True(found)
+	suite.Equal(sdk.NewInt64Coin(BaseDenom, 100), record.Accrued)
+	suite.Equal(sdk.NewInt64Coin(BaseDenom, 60), record.Restaked)
+	suite.Equal(sdk.NewInt64Coin(BaseDenom, 40), record.CarriedOver)
+	suite.Equal(sdk.NewInt64Coin(BaseDenom, 40), k.GetCarriedOverRewards(ctx))
+}
+
+func (suite *IntegrationTestSuite) TestGetHostInsuranceFundAddress() {
+	app, ctx := suite.app, suite.ctx
+	k := app.LSCosmosKeeper
+
+	rewardsAddress := sdk.MustBech32ifyAddressBytes(types.CosmosAccountPrefix, sdk.AccAddress("rewards_____________"))
+	insuranceFund := sdk.MustBech32ifyAddressBytes(types.CosmosAccountPrefix, sdk.AccAddress("insurance___________"))
+	suite.Equal(insuranceFund, k.GetHostInsuranceFundAddress(ctx, insuranceFund, rewardsAddress))
+	// empty and non host chain addresses fall back to restaking
+	suite.Empty(k.GetHostInsuranceFundAddress(ctx, "", rewardsAddress))
+	suite.Empty(k.GetHostInsuranceFundAddress(ctx, sdk.AccAddress("insurance___________").String(), rewardsAddress))
+	suite.Empty(k.GetHostInsuranceFundAddress(ctx, insuranceFund, ""))
+}
+
+func (suite *IntegrationTestSuite) TestQueryRewardEpochRecords() {
+	app, ctx := suite.app, suite.ctx
+	qrysrv := types.QueryServer(app.LSCosmosKeeper)
+	c := sdk.WrapSDKContext(ctx)
+
+	_, err := qrysrv.RewardEpochRecords(c, nil)
+	suite.Error(err)
+
+	for _, epochNumber := range []int64{300, 2, 10} {
+		app.LSCosmosKeeper.SetRewardEpochRecord(ctx, types.RewardEpochRecord{
+			EpochNumber:         epochNumber,
+			Accrued:             sdk.NewInt64Coin(BaseDenom, epochNumber),
+			Restaked:            sdk.NewInt64Coin(BaseDenom, epochNumber),
+			SentToInsuranceFund: sdk.NewInt64Coin(BaseDenom, 0),
+			CarriedOver:         sdk.NewInt64Coin(BaseDenom, 0),
+		})
+	}
+	app.LSCosmosKeeper.SetCarriedOverRewards(ctx, sdk.NewInt64Coin(BaseDenom, 5))
+
+	res, err := qrysrv.RewardEpochRecords(c, &types.QueryRewardEpochRecordsRequest{})
+	suite.NoError(err)
+	suite.Len(res.RewardEpochRecords, 3)
+	suite.Equal(int64(2), res.RewardEpochRecords[0].EpochNumber)
+	suite.Equal(int64(300), res.RewardEpochRecords[2].EpochNumber)
+	suite.Equal(sdk.NewInt64Coin(BaseDenom, 5), res.CarriedOverRewards)
+
+	res, err = qrysrv.RewardEpochRecords(c, &types.QueryRewardEpochRecordsRequest{Pagination: &query.PageRequest{Offset: 1, Limit: 1}})
+	suite.NoError(err)
+	suite.Len(res.RewardEpochRecords, 1)
+	suite.Equal(int64(10), res.RewardEpochRecords[0].EpochNumber)
+}
@@ -0,0 +1,152 @@
+package keeper
+
+import (
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/persistenceOne/pstake-native/v2/x/lscosmos/types"
+)
+
+// SetRoles sets the module roles in the store
+func (k Keeper) SetRoles(ctx sdk.Context, roles types.Roles) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.RolesKey, k.cdc.MustMarshal(&roles))
+}
+
+// GetRoles gets the module roles from the store
+func (k Keeper) GetRoles(ctx sdk.Context) types.Roles {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.RolesKey)
+	if bz == nil {
+		return types.Roles{}
+	}
+	var roles types.Roles
+	k.cdc.MustUnmarshal(bz, &roles)
+	return roles
+}
+
+// SetPendingAdminChange sets the admin change waiting for the admin timelock
+func (k Keeper) SetPendingAdminChange(ctx sdk.Context, pendingAdminChange types.PendingAdminChange) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.PendingAdminChangeKey, k.cdc.MustMarshal(&pendingAdminChange))
+}
+
+// GetPendingAdminChange gets the admin change waiting for the admin timelock, nil is returned if
+// there is none
+func (k Keeper) GetPendingAdminChange(ctx sdk.Context) *types.PendingAdminChange {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.PendingAdminChangeKey)
+	if bz == nil {
+		return nil
+	}
+	var pendingAdminChange types.PendingAdminChange
+	k.cdc.MustUnmarshal(bz, &pendingAdminChange)
+	return &pendingAdminChange
+}
+
+// RemovePendingAdminChange removes the admin change waiting for the admin timelock
+func (k Keeper) RemovePendingAdminChange(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.PendingAdminChangeKey)
+}
+
+// GetAdmins returns the module admins
+func (k Keeper) GetAdmins(ctx sdk.Context) []string {
+	return k.GetRoles(ctx).Admins
+}
+
+// SeedAdmins sets the admin as the only module admin if no admins are set yet, it returns false if admins were
+// already set
+func (k Keeper) SeedAdmins(ctx sdk.Context, admin string) bool {
+	roles := k.GetRoles(ctx)
+	if len(roles.Admins) != 0 {
+		return false
+	}
+	roles.Admins = []string{admin}
+	k.SetRoles(ctx, roles)
+	return true
+}
+
+// GetPausers returns the module pausers, the admins act as pausers until pausers are set
+func (k Keeper) GetPausers(ctx sdk.Context) []string {
+	pausers := k.GetRoles(ctx).Pausers
+	if len(pausers) != 0 {
+		return pausers
+	}
+	return k.GetAdmins(ctx)
+}
+
+// GetSlashingReporters returns the module slashing reporters, the admins act as slashing reporters
+// until slashing reporters are set
+func (k Keeper) GetSlashingReporters(ctx sdk.Context) []string {
+	slashingReporters := k.GetRoles(ctx).SlashingReporters
+	if len(slashingReporters) != 0 {
+		return slashingReporters
+	}
+	return k.GetAdmins(ctx)
+}
+
+// IsAdmin returns true if the address is a module admin
+func (k Keeper) IsAdmin(ctx sdk.Context, address string) bool {
+	return types.HasAddress(k.GetAdmins(ctx), address)
+}
+
+// IsPauser returns true if the address is a module pauser
+func (k Keeper) IsPauser(ctx sdk.Context, address string) bool {
+	return types.HasAddress(k.GetPausers(ctx), address)
+}
+
+// IsSlashingReporter returns true if the address is a module slashing reporter
+func (k Keeper) IsSlashingReporter(ctx sdk.Context, address string) bool {
+	return types.HasAddress(k.GetSlashingReporters(ctx), address)
+}
+
+// UpdateRoles sets the pausers and slashing reporters right away. A change of admins is stored as
+// a pending admin change applied after the admin timelock, it replaces any previous pending change
+// and is cancelled if the admins are left unchanged. An empty admin set is rejected.
+func (k Keeper) UpdateRoles(ctx sdk.Context, roles types.Roles) (*types.PendingAdminChange, error) {
+	if len(roles.Admins) == 0 {
+		return nil, errorsmod.Wrap(types.ErrInvalidRoles, "at least one admin is required")
+	}
+
+	currentRoles := k.GetRoles(ctx)
+	currentRoles.Pausers = roles.Pausers
+	currentRoles.SlashingReporters = roles.SlashingReporters
+
+	if types.EqualAddresses(roles.Admins, k.GetAdmins(ctx)) {
+		currentRoles.Admins = roles.Admins
+		k.SetRoles(ctx, currentRoles)
+		k.RemovePendingAdminChange(ctx)
+		return nil, nil
+	}
+	k.SetRoles(ctx, currentRoles)
+
+	pendingAdminChange := types.PendingAdminChange{
+		Admins:        roles.Admins,
+		EffectiveTime: ctx.BlockTime().Add(k.GetParams(ctx).AdminTimelock),
+	}
+	k.SetPendingAdminChange(ctx, pendingAdminChange)
+	return &pendingAdminChange, nil
+}
+
+// ProcessPendingAdminChange applies the pending admin change once its timelock has expired
+func (k Keeper) ProcessPendingAdminChange(ctx sdk.Context) {
+	pendingAdminChange := k.GetPendingAdminChange(ctx)
+	if pendingAdminChange == nil || ctx.BlockTime().Before(pendingAdminChange.EffectiveTime) {
+		return
+	}
+
+	roles := k.GetRoles(ctx)
+	roles.Admins = pendingAdminChange.Admins
+	k.SetRoles(ctx, roles)
+	k.RemovePendingAdminChange(ctx)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAdminChange,
+			sdk.NewAttribute(types.AttributeAdmins, strings.Join(roles.Admins, ",")),
+		),
+	)
+}
@@ -0,0 +1,140 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	lscosmoskeeper "github.com/persistenceOne/pstake-native/v2/x/lscosmos/keeper"
+	"github.com/persistenceOne/pstake-native/v2/x/lscosmos/types"
+)
+
+func (suite *IntegrationTestSuite) TestRolesFallback() {
+	app, ctx := suite.app, suite.ctx
+	keeper := app.LSCosmosKeeper
+
+	// the admins hold every role until pausers and slashing reporters are set
+	suite.Equal([]string{PstakeFeeAddress}, keeper.GetAdmins(ctx))
+	suite.True(keeper.IsAdmin(ctx, PstakeFeeAddress))
+	suite.True(keeper.IsPauser(ctx, PstakeFeeAddress))
+	suite.True(keeper.IsSlashingReporter(ctx, PstakeFeeAddress))
+
+	pauser := sdk.AccAddress("pauser______________").String()
+	keeper.SetRoles(ctx, types.Roles{Admins: []string{PstakeFeeAddress}, Pausers: []string{pauser}})
+	suite.True(keeper.IsPauser(ctx, pauser))
+	suite.False(keeper.IsPauser(ctx, PstakeFeeAddress))
+	suite.True(keeper.IsSlashingReporter(ctx, PstakeFeeAddress))
+
+	// the pstake fee address is not an admin without roles
+	keeper.SetRoles(ctx, types.Roles{})
+	suite.Empty(keeper.GetAdmins(ctx))
+	suite.False(keeper.IsAdmin(ctx, PstakeFeeAddress))
+	suite.False(keeper.IsPauser(ctx, PstakeFeeAddress))
+}
+
+func (suite *IntegrationTestSuite) TestSeedAdminsFromPstakeFeeAddressChange() {
+	app, ctx := suite.app, suite.ctx
+	keeper := app.LSCosmosKeeper
+
+	keeper.SetRoles(ctx, types.Roles{})
+	feeAddress1 := sdk.AccAddress("feeaddress1_________").String()
+	feeAddress2 := sdk.AccAddress("feeaddress2_________").String()
+
+	// the first fee address seeds the admins
+	suite.NoError(lscosmoskeeper.HandlePstakeFeeAddressChangeProposal(ctx, keeper, types.PstakeFeeAddressChangeProposal{PstakeFeeAddress: feeAddress1}))
+	suite.Equal([]string{feeAddress1}, keeper.GetAdmins(ctx))
+
+	// later fee address changes leave the admins untouched
+	suite.NoError(lscosmoskeeper.HandlePstakeFeeAddressChangeProposal(ctx, keeper, types.PstakeFeeAddressChangeProposal{PstakeFeeAddress: feeAddress2}))
+	suite.Equal(feeAddress2, keeper.GetHostChainParams(ctx).PstakeParams.PstakeFeeAddress)
+	suite.Equal([]string{feeAddress1}, keeper.GetAdmins(ctx))
+}
+
+func (suite *IntegrationTestSuite) TestUpdateRoles() {
+	app, ctx := suite.app, suite.ctx
+	keeper := app.LSCosmosKeeper
+	msgServer := lscosmoskeeper.NewMsgServerImpl(keeper)
+
+	admin1 := sdk.AccAddress("admin1______________").String()
+	admin2 := sdk.AccAddress("admin2______________").String()
+	pauser := sdk.AccAddress("pauser______________").String()
+	reporter := sdk.AccAddress("reporter____________").String()
+
+	params := keeper.GetParams(ctx)
+	params.AdminTimelock = time.Hour
+	keeper.SetParams(ctx, params)
+
+	roles := types.Roles{
+		Admins:            []string{admin1, admin2},
+		Pausers:           []string{pauser},
+		SlashingReporters: []string{reporter},
+	}
+	_, err := msgServer.UpdateRoles(sdk.WrapSDKContext(ctx), &types.MsgUpdateRoles{AdminAddress: pauser, Roles: roles})
+	suite.ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	_, err = msgServer.UpdateRoles(sdk.WrapSDKContext(ctx), &types.MsgUpdateRoles{AdminAddress: PstakeFeeAddress, Roles: types.Roles{Pausers: []string{pauser}}})
+	suite.ErrorIs(err, types.ErrInvalidRoles)
+	suite.Equal([]string{PstakeFeeAddress}, keeper.GetAdmins(ctx))
+
+	_, err = msgServer.UpdateRoles(sdk.WrapSDKContext(ctx), &types.MsgUpdateRoles{AdminAddress: PstakeFeeAddress, Roles: roles})
+	suite.NoError(err)
+
+	// pausers and slashing reporters are updated right away, admins after the timelock
+	suite.True(keeper.IsPauser(ctx, pauser))
+	suite.True(keeper.IsSlashingReporter(ctx, reporter))
+	suite.True(keeper.IsAdmin(ctx, PstakeFeeAddress))
+	suite.False(keeper.IsAdmin(ctx, admin1))
+	pendingAdminChange := keeper.GetPendingAdminChange(ctx)
+	suite.Require().NotNil(pendingAdminChange)
+	suite.Equal(ctx.BlockTime().Add(time.Hour), pendingAdminChange.EffectiveTime)
+
+	keeper.ProcessPendingAdminChange(ctx.WithBlockTime(ctx.BlockTime().Add(time.Minute)))
+	suite.False(keeper.IsAdmin(ctx, admin1))
+
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))
+	keeper.ProcessPendingAdminChange(ctx)
+	suite.Nil(keeper.GetPendingAdminChange(ctx))
+	suite.True(keeper.IsAdmin(ctx, admin1))
+	suite.True(keeper.IsAdmin(ctx, admin2))
+	suite.False(keeper.IsAdmin(ctx, PstakeFeeAddress))
+
+	// rotating the fee address does not rotate the admins
+	hostChainParams := keeper.GetHostChainParams(ctx)
+	hostChainParams.PstakeParams.PstakeFeeAddress = sdk.AccAddress("feeaddress__________").String()
+	keeper.SetHostChainParams(ctx, hostChainParams)
+	suite.Equal([]string{admin1, admin2}, keeper.GetAdmins(ctx))
+
+	// a pending admin change is cancelled when the admins are left unchanged
+	_, err = msgServer.UpdateRoles(sdk.WrapSDKContext(ctx), &types.MsgUpdateRoles{AdminAddress: admin2, Roles: types.Roles{Admins: []string{admin2}}})
+	suite.NoError(err)
+	suite.NotNil(keeper.GetPendingAdminChange(ctx))
+	_, err = msgServer.UpdateRoles(sdk.WrapSDKContext(ctx), &types.MsgUpdateRoles{AdminAddress: admin1, Roles: types.Roles{Admins: []string{admin2, admin1}}})
+	suite.NoError(err)
+	suite.Nil(keeper.GetPendingAdminChange(ctx))
+	suite.True(keeper.IsPauser(ctx, admin1))
+}
+
+func (suite *IntegrationTestSuite) TestChangeModuleStateRoles() {
+	app, ctx := suite.app, suite.ctx
+	keeper := app.LSCosmosKeeper
+	msgServer := lscosmoskeeper.NewMsgServerImpl(keeper)
+
+	pauser := sdk.AccAddress("pauser______________").String()
+	reporter := sdk.AccAddress("reporter____________").String()
+	keeper.SetRoles(ctx, types.Roles{Admins: []string{PstakeFeeAddress}, Pausers: []string{pauser}, SlashingReporters: []string{reporter}})
+	keeper.SetModuleState(ctx, true)
+
+	_, err := msgServer.ChangeModuleState(sdk.WrapSDKContext(ctx), &types.MsgChangeModuleState{PstakeAddress: reporter, ModuleState: false})
+	suite.ErrorIs(err, sdkerrors.ErrUnauthorized)
+	_, err = msgServer.ChangeModuleState(sdk.WrapSDKContext(ctx), &types.MsgChangeModuleState{PstakeAddress: pauser, ModuleState: false})
+	suite.NoError(err)
+	suite.False(keeper.GetModuleState(ctx))
+
+	// pausers cannot enable the module
+	_, err = msgServer.ChangeModuleState(sdk.WrapSDKContext(ctx), &types.MsgChangeModuleState{PstakeAddress: pauser, ModuleState: true})
+	suite.ErrorIs(err, sdkerrors.ErrUnauthorized)
+	_, err = msgServer.ChangeModuleState(sdk.WrapSDKContext(ctx), &types.MsgChangeModuleState{PstakeAddress: PstakeFeeAddress, ModuleState: true})
+	suite.NoError(err)
+	suite.True(keeper.GetModuleState(ctx))
+}
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/persistenceOne/pstake-native/v2/x/lscosmos/types"
 )
@@ -39,11 +40,33 @@ func (k Keeper) IterateAllUnbondingEpochCValues(ctx sdk.Context) []types.Unbondi
 	return unbondingEpochCValues
 }
 
-// MatureUnbondingEpochCValue sets unbonding epochCValue as matured
+// IterateUnbondingEpochCValuesInRange returns the unbonding epoch c values of the epochs from startEpoch to
+// endEpoch, both included, in epoch order
+func (k Keeper) IterateUnbondingEpochCValuesInRange(ctx sdk.Context, startEpoch, endEpoch int64) []types.UnbondingEpochCValue {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.UnbondingEpochCValueKey)
+	var unbondingEpochCValues []types.UnbondingEpochCValue
+	iterator := store.Iterator(types.GetEpochNumberBytes(startEpoch), types.GetEpochNumberBytes(endEpoch+1))
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var unbondingEpochCValue types.UnbondingEpochCValue
+		k.cdc.MustUnmarshal(iterator.Value(), &unbondingEpochCValue)
+
+		unbondingEpochCValues = append(unbondingEpochCValues, unbondingEpochCValue)
+	}
+
+	return unbondingEpochCValues
+}
+
+// MatureUnbondingEpochCValue sets unbonding epochCValue as matured and queues the epoch for auto claims
 func (k Keeper) MatureUnbondingEpochCValue(ctx sdk.Context, epochNumber int64) {
 	unbondingEpochCValue := k.GetUnbondingEpochCValue(ctx, epochNumber)
 	unbondingEpochCValue.IsMatured = true
 	k.SetUnbondingEpochCValue(ctx, unbondingEpochCValue)
+
+	// queue the epoch so that entries of delegators opted in to auto claim are paid out in BeginBlock
+	k.SetPendingAutoClaimEpoch(ctx, epochNumber)
 }
 
 // FailUnbondingEpochCValue sets unbonding epochCValue as timeout for undelegation
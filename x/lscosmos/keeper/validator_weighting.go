@@ -0,0 +1,304 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	icqtypes "github.com/persistenceOne/persistence-sdk/v2/x/interchainquery/types"
+
+	"github.com/persistenceOne/pstake-native/v2/x/lscosmos/types"
+)
+
+// SetValidatorMetrics sets the host chain metrics of a validator
+func (k Keeper) SetValidatorMetrics(ctx sdk.Context, metrics types.ValidatorMetrics) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetValidatorMetricsKey(metrics.ValidatorAddress), k.cdc.MustMarshal(&metrics))
+}
+
+// GetValidatorMetrics gets the host chain metrics of a validator, false is returned if none were received
+func (k Keeper) GetValidatorMetrics(ctx sdk.Context, validatorAddress string) (types.ValidatorMetrics, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetValidatorMetricsKey(validatorAddress))
+	if bz == nil {
+		return types.ValidatorMetrics{}, false
+	}
+
+	var metrics types.ValidatorMetrics
+	k.cdc.MustUnmarshal(bz, &metrics)
+	return metrics, true
+}
+
+// RemoveValidatorMetrics removes the host chain metrics of a validator
+func (k Keeper) RemoveValidatorMetrics(ctx sdk.Context, validatorAddress string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetValidatorMetricsKey(validatorAddress))
+}
+
+// GetAllValidatorMetrics returns the host chain metrics of all the validators, ordered by validator address
+func (k Keeper) GetAllValidatorMetrics(ctx sdk.Context) []types.ValidatorMetrics {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.ValidatorMetricsKey)
+	defer iterator.Close()
+
+	var allMetrics []types.ValidatorMetrics
+	for ; iterator.Valid(); iterator.Next() {
+		var metrics types.ValidatorMetrics
+		k.cdc.MustUnmarshal(iterator.Value(), &metrics)
+		allMetrics = append(allMetrics, metrics)
+	}
+	return allMetrics
+}
+
+// SetEffectiveAllowListedValidators sets the effective weights of the allow listed validators
+func (k Keeper) SetEffectiveAllowListedValidators(ctx sdk.Context, effective types.AllowListedValidators) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.EffectiveAllowListedValidatorsKey, k.cdc.MustMarshal(&effective))
+}
+
+// GetComputedEffectiveAllowListedValidators gets the stored effective weights of the allow listed validators, false is
+// returned if none were computed since the last allow listed validator set change
+func (k Keeper) GetComputedEffectiveAllowListedValidators(ctx sdk.Context) (types.AllowListedValidators, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.EffectiveAllowListedValidatorsKey)
+	if bz == nil {
+		return types.AllowListedValidators{}, false
+	}
+
+	var effective types.AllowListedValidators
+	k.cdc.MustUnmarshal(bz, &effective)
+	return effective, true
+}
+
+// RemoveEffectiveAllowListedValidators removes the effective weights of the allow listed validators, the base
+// weights are used until they are computed again
+func (k Keeper) RemoveEffectiveAllowListedValidators(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.EffectiveAllowListedValidatorsKey)
+}
+
+// GetEffectiveAllowListedValidators returns the allow listed validators with the weights used for delegations and
+// undelegations, the computed effective weights if the validator weighting is enabled and the governance base
+// weights otherwise
+func (k Keeper) GetEffectiveAllowListedValidators(ctx sdk.Context) types.AllowListedValidators {
+	if k.GetParams(ctx).ValidatorWeighting.Enabled {
+		if effective, found := k.GetComputedEffectiveAllowListedValidators(ctx); found {
+			return effective
+		}
+	}
+	return k.GetAllowListedValidators(ctx)
+}
+
+// GetValidatorWeights returns the base and effective weights and the host chain metrics of the allow listed
+// validators
+func (k Keeper) GetValidatorWeights(ctx sdk.Context) []types.ValidatorWeight {
+	effectiveWeights := types.GetAddressMap(k.GetEffectiveAllowListedValidators(ctx))
+
+	var validatorWeights []types.ValidatorWeight
+	for _, validator := range k.GetAllowListedValidators(ctx).AllowListedValidators {
+		validatorWeight := types.ValidatorWeight{
+			ValidatorAddress: validator.ValidatorAddress,
+			BaseWeight:       validator.TargetWeight,
+			EffectiveWeight:  sdk.ZeroDec(),
+		}
+		if effectiveWeight, ok := effectiveWeights[validator.ValidatorAddress]; ok {
+			validatorWeight.EffectiveWeight = effectiveWeight
+		}
+		if metrics, found := k.GetValidatorMetrics(ctx, validator.ValidatorAddress); found {
+			validatorWeight.Metrics = &metrics
+		}
+		validatorWeights = append(validatorWeights, validatorWeight)
+	}
+	return validatorWeights
+}
+
+// ResetValidatorWeighting drops the effective weights and the metrics of the validators that are not allow listed
+// anymore, it is called when the allow listed validator set changes
+func (k Keeper) ResetValidatorWeighting(ctx sdk.Context) {
+	k.RemoveEffectiveAllowListedValidators(ctx)
+
+	allowListed := types.GetAddressMap(k.GetAllowListedValidators(ctx))
+	for _, metrics := range k.GetAllValidatorMetrics(ctx) {
+		if _, ok := allowListed[metrics.ValidatorAddress]; !ok {
+			k.RemoveValidatorMetrics(ctx, metrics.ValidatorAddress)
+		}
+	}
+}
+
+// UpdateEffectiveWeights computes the effective weights of the allow listed validators from the stored host chain
+// metrics, they are left unchanged if types.ComputeEffectiveWeights can not compute them
+func (k Keeper) UpdateEffectiveWeights(ctx sdk.Context) {
+	config := k.GetParams(ctx).ValidatorWeighting
+	base := k.GetAllowListedValidators(ctx)
+
+	metrics := make(map[string]types.ValidatorMetrics)
+	for _, validatorMetrics := range k.GetAllValidatorMetrics(ctx) {
+		metrics[validatorMetrics.ValidatorAddress] = validatorMetrics
+	}
+	previous := types.GetAddressMap(k.GetEffectiveAllowListedValidators(ctx))
+
+	effective, ok := types.ComputeEffectiveWeights(base, previous, metrics, config)
+	if !ok {
+		k.Logger(ctx).Info("Validator metrics missing or no eligible validator, effective weights not updated")
+		return
+	}
+	k.SetEffectiveAllowListedValidators(ctx, effective)
+
+	events := make(sdk.Events, 0, len(effective.AllowListedValidators))
+	for i, validator := range effective.AllowListedValidators {
+		events = append(events, sdk.NewEvent(
+			types.EventTypeValidatorWeights,
+			sdk.NewAttribute(types.AttributeValidatorAddress, validator.ValidatorAddress),
+			sdk.NewAttribute(types.AttributeBaseWeight, base.AllowListedValidators[i].TargetWeight.String()),
+			sdk.NewAttribute(types.AttributeEffectiveWeight, validator.TargetWeight.String()),
+		))
+	}
+	ctx.EventManager().EmitEvents(events)
+}
+
+// RequestValidatorMetrics makes the interchain queries for the host chain validators of the allow listed validators
+// with a positive base weight, the signing info and self bond queries follow the validator query responses
+func (k Keeper) RequestValidatorMetrics(ctx sdk.Context, hostChainParams types.HostChainParams) error {
+	for _, validator := range k.GetAllowListedValidators(ctx).AllowListedValidators {
+		if !validator.TargetWeight.IsPositive() {
+			continue
+		}
+
+		validatorQuery := stakingtypes.QueryValidatorRequest{ValidatorAddr: validator.ValidatorAddress}
+		bz, err := k.cdc.Marshal(&validatorQuery)
+		if err != nil {
+			return err
+		}
+		k.icqKeeper.MakeRequest(
+			ctx,
+			hostChainParams.ConnectionID,
+			hostChainParams.ChainID,
+			"cosmos.staking.v1beta1.Query/Validator",
+			bz,
+			sdk.NewInt(int64(-1)),
+			types.ModuleName,
+			ValidatorMetrics,
+			0,
+		)
+	}
+	return nil
+}
+
+// ValidatorWeightingWorkFlow updates the effective weights from the metrics received since the last update and
+// requests fresh metrics, it runs every update interval reward epochs if the validator weighting is enabled
+func (k Keeper) ValidatorWeightingWorkFlow(ctx sdk.Context, hostChainParams types.HostChainParams, epochNumber int64) error {
+	config := k.GetParams(ctx).ValidatorWeighting
+	if !config.Enabled || epochNumber%config.UpdateInterval != 0 {
+		return nil
+	}
+
+	k.UpdateEffectiveWeights(ctx)
+	return k.RequestValidatorMetrics(ctx, hostChainParams)
+}
+
+// HandleValidatorMetricsCallback stores the metrics of the host chain validator in the validator query response
+// and makes the signing info and self bond queries of the validator
+func (k Keeper) HandleValidatorMetricsCallback(ctx sdk.Context, response []byte, query icqtypes.Query) error {
+	resp := stakingtypes.QueryValidatorResponse{}
+	if err := k.cdc.Unmarshal(response, &resp); err != nil {
+		return err
+	}
+	validator := resp.Validator
+	if _, ok := types.GetAddressMap(k.GetAllowListedValidators(ctx))[validator.OperatorAddress]; !ok {
+		k.Logger(ctx).Info("Validator not allow listed anymore, metrics dropped", "validator", validator.OperatorAddress)
+		return nil
+	}
+
+	if err := validator.UnpackInterfaces(k.cdc); err != nil {
+		return err
+	}
+	consAddr, err := validator.GetConsAddr()
+	if err != nil {
+		return err
+	}
+	consAddress, err := types.Bech32FromValAddress(sdk.ValAddress(consAddr), types.CosmosValConsPrefix)
+	if err != nil {
+		return err
+	}
+	valAddr, err := types.ValAddressFromBech32(validator.OperatorAddress, types.CosmosValOperPrefix)
+	if err != nil {
+		return err
+	}
+	selfDelegatorAddress, err := types.Bech32FromValAddress(valAddr, types.CosmosAccountPrefix)
+	if err != nil {
+		return err
+	}
+
+	// tombstoning is permanent, the self bond is zero unless the self bond query finds a delegation
+	previous, _ := k.GetValidatorMetrics(ctx, validator.OperatorAddress)
+	k.SetValidatorMetrics(ctx, types.ValidatorMetrics{
+		ValidatorAddress: validator.OperatorAddress,
+		ConsensusAddress: consAddress,
+		Commission:       validator.Commission.Rate,
+		Jailed:           validator.Jailed,
+		Tombstoned:       previous.Tombstoned,
+		Bonded:           validator.IsBonded(),
+		Tokens:           validator.Tokens,
+		SelfBond:         sdk.ZeroInt(),
+		UpdatedHeight:    ctx.BlockHeight(),
+	})
+
+	signingInfoQuery := slashingtypes.QuerySigningInfoRequest{ConsAddress: consAddress}
+	bz, err := k.cdc.Marshal(&signingInfoQuery)
+	if err != nil {
+		return err
+	}
+	k.icqKeeper.MakeRequest(ctx, query.ConnectionId, query.ChainId, "cosmos.slashing.v1beta1.Query/SigningInfo",
+		bz, sdk.NewInt(int64(-1)), types.ModuleName, ValidatorSigningInfo, 0)
+
+	selfBondQuery := stakingtypes.QueryDelegationRequest{DelegatorAddr: selfDelegatorAddress, ValidatorAddr: validator.OperatorAddress}
+	bz, err = k.cdc.Marshal(&selfBondQuery)
+	if err != nil {
+		return err
+	}
+	k.icqKeeper.MakeRequest(ctx, query.ConnectionId, query.ChainId, "cosmos.staking.v1beta1.Query/Delegation",
+		bz, sdk.NewInt(int64(-1)), types.ModuleName, ValidatorSelfBond, 0)
+
+	return nil
+}
+
+// HandleValidatorSigningInfoCallback stores whether the host chain validator in the signing info query response
+// is tombstoned
+func (k Keeper) HandleValidatorSigningInfoCallback(ctx sdk.Context, response []byte, _ icqtypes.Query) error {
+	resp := slashingtypes.QuerySigningInfoResponse{}
+	if err := k.cdc.Unmarshal(response, &resp); err != nil {
+		return err
+	}
+
+	for _, metrics := range k.GetAllValidatorMetrics(ctx) {
+		if metrics.ConsensusAddress == resp.ValSigningInfo.Address {
+			metrics.Tombstoned = metrics.Tombstoned || resp.ValSigningInfo.Tombstoned
+			k.SetValidatorMetrics(ctx, metrics)
+			return nil
+		}
+	}
+
+	k.Logger(ctx).Info(fmt.Sprintf("No validator metrics for consensus address %s", resp.ValSigningInfo.Address))
+	return nil
+}
+
+// HandleValidatorSelfBondCallback stores the self bond of the host chain validator in the delegation query response
+func (k Keeper) HandleValidatorSelfBondCallback(ctx sdk.Context, response []byte, _ icqtypes.Query) error {
+	resp := stakingtypes.QueryDelegationResponse{}
+	if err := k.cdc.Unmarshal(response, &resp); err != nil {
+		return err
+	}
+	if resp.DelegationResponse == nil {
+		return nil
+	}
+
+	metrics, found := k.GetValidatorMetrics(ctx, resp.DelegationResponse.Delegation.ValidatorAddress)
+	if !found {
+		k.Logger(ctx).Info(fmt.Sprintf("No validator metrics for validator %s", resp.DelegationResponse.Delegation.ValidatorAddress))
+		return nil
+	}
+	metrics.SelfBond = resp.DelegationResponse.Balance.Amount
+	k.SetValidatorMetrics(ctx, metrics)
+	return nil
+}
@@ -0,0 +1,174 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	icqtypes "github.com/persistenceOne/persistence-sdk/v2/x/interchainquery/types"
+
+	"github.com/persistenceOne/pstake-native/v2/x/lscosmos/types"
+)
+
+func (suite *IntegrationTestSuite) TestEffectiveAllowListedValidators() {
+	app, ctx := suite.app, suite.ctx
+	k := app.LSCosmosKeeper
+
+	effective := types.AllowListedValidators{AllowListedValidators: []types.AllowListedValidator{
+		{ValidatorAddress: allowListedValidators.AllowListedValidators[0].ValidatorAddress, TargetWeight: sdk.ZeroDec()},
+		{ValidatorAddress: allowListedValidators.AllowListedValidators[1].ValidatorAddress, TargetWeight: sdk.NewDecWithPrec(5, 1)},
+		{ValidatorAddress: allowListedValidators.AllowListedValidators[2].ValidatorAddress, TargetWeight: sdk.NewDecWithPrec(5, 1)},
+	}}
+	k.SetEffectiveAllowListedValidators(ctx, effective)
+
+	// the base weights are used while the validator weighting is disabled
+	suite.Equal(allowListedValidators, k.GetEffectiveAllowListedValidators(ctx))
+
+	params := k.GetParams(ctx)
+	params.ValidatorWeighting.Enabled = true
+	k.SetParams(ctx, params)
+	suite.Equal(effective, k.GetEffectiveAllowListedValidators(ctx))
+
+	validatorWeights := k.GetValidatorWeights(ctx)
+	suite.Len(validatorWeights, 3)
+	for i, validatorWeight := range validatorWeights {
+		suite.Equal(allowListedValidators.AllowListedValidators[i].TargetWeight, validatorWeight.BaseWeight)
+		suite.Equal(effective.AllowListedValidators[i].TargetWeight, validatorWeight.EffectiveWeight)
+		suite.Nil(validatorWeight.Metrics)
+	}
+
+	k.ResetValidatorWeighting(ctx)
+	_, found := k.GetComputedEffectiveAllowListedValidators(ctx)
+	suite.False(found)
+	suite.Equal(allowListedValidators, k.GetEffectiveAllowListedValidators(ctx))
+}
+
+func (suite *IntegrationTestSuite) TestUpdateEffectiveWeights() {
+	app, ctx := suite.app, suite.ctx
+	k := app.LSCosmosKeeper
+
+	params := k.GetParams(ctx)
+	params.ValidatorWeighting.Enabled = true
+	params.ValidatorWeighting.MaxWeightChange = sdk.OneDec()
+	k.SetParams(ctx, params)
+
+	// effective weights are not computed without the metrics of every validator
+	k.UpdateEffectiveWeights(ctx)
+	_, found := k.GetComputedEffectiveAllowListedValidators(ctx)
+	suite.False(found)
+
+	for i, validator := range allowListedValidators.AllowListedValidators {
+		k.SetValidatorMetrics(ctx, types.ValidatorMetrics{
+			ValidatorAddress: validator.ValidatorAddress,
+			Commission:       sdk.NewDecWithPrec(5, 2),
+			Jailed:           i == 0,
+			Bonded:           true,
+			Tokens:           sdk.NewInt(1000),
+			SelfBond:         sdk.NewInt(100),
+		})
+	}
+	k.UpdateEffectiveWeights(ctx)
+
+	effective, found := k.GetComputedEffectiveAllowListedValidators(ctx)
+	suite.True(found)
+	suite.True(effective.Valid())
+	suite.Equal(sdk.ZeroDec(), effective.AllowListedValidators[0].TargetWeight)
+	suite.Equal(sdk.NewDecWithPrec(33, 2).Quo(sdk.NewDecWithPrec(67, 2)), effective.AllowListedValidators[1].TargetWeight)
+	suite.Equal(effective, k.GetEffectiveAllowListedValidators(ctx))
+
+	// changing the allow listed validators drops the effective weights and the metrics of removed validators
+	k.SetAllowListedValidators(ctx, types.AllowListedValidators{AllowListedValidators: allowListedValidators.AllowListedValidators[1:2]})
+	k.ResetValidatorWeighting(ctx)
+	_, found = k.GetComputedEffectiveAllowListedValidators(ctx)
+	suite.False(found)
+	suite.Len(k.GetAllValidatorMetrics(ctx), 1)
+}
+
+func (suite *IntegrationTestSuite) TestHandleValidatorMetricsCallbacks() {
+	app, ctx := suite.app, suite.ctx
+	k := app.LSCosmosKeeper
+
+	validatorAddress := allowListedValidators.AllowListedValidators[0].ValidatorAddress
+	pubKey := ed25519.GenPrivKey().PubKey()
+	validator, err := stakingtypes.NewValidator(sdk.ValAddress(pubKey.Address()), pubKey, stakingtypes.Description{})
+	suite.NoError(err)
+	validator.OperatorAddress = validatorAddress
+	validator.Status = stakingtypes.Bonded
+	validator.Tokens = sdk.NewInt(1000)
+	validator.Commission.Rate = sdk.NewDecWithPrec(5, 2)
+
+	response, err := app.AppCodec().Marshal(&stakingtypes.QueryValidatorResponse{Validator: validator})
+	suite.NoError(err)
+	suite.NoError(k.HandleValidatorMetricsCallback(ctx, response, icqtypes.Query{ConnectionId: ConnectionID, ChainId: ChainID}))
+
+	metrics, found := k.GetValidatorMetrics(ctx, validatorAddress)
+	suite.True(found)
+	consAddress, err := types.Bech32FromValAddress(sdk.ValAddress(pubKey.Address()), types.CosmosValConsPrefix)
+	suite.NoError(err)
+	suite.Equal(consAddress, metrics.ConsensusAddress)
+	suite.Equal(sdk.NewDecWithPrec(5, 2), metrics.Commission)
+	suite.True(metrics.Bonded)
+	suite.False(metrics.Tombstoned)
+	suite.Equal(sdk.NewInt(1000), metrics.Tokens)
+	suite.Equal(sdk.ZeroInt(), metrics.SelfBond)
+
+	signingInfo := slashingtypes.QuerySigningInfoResponse{
+		ValSigningInfo: slashingtypes.ValidatorSigningInfo{Address: consAddress, Tombstoned: true},
+	}
+	response, err = app.AppCodec().Marshal(&signingInfo)
+	suite.NoError(err)
+	suite.NoError(k.HandleValidatorSigningInfoCallback(ctx, response, icqtypes.Query{}))
+
+	selfBond := stakingtypes.QueryDelegationResponse{DelegationResponse: &stakingtypes.DelegationResponse{
+		Delegation: stakingtypes.Delegation{ValidatorAddress: validatorAddress},
+		Balance:    sdk.NewInt64Coin(BaseDenom, 100),
+	}}
+	response, err = app.AppCodec().Marshal(&selfBond)
+	suite.NoError(err)
+	suite.NoError(k.HandleValidatorSelfBondCallback(ctx, response, icqtypes.Query{}))
+
+	metrics, found = k.GetValidatorMetrics(ctx, validatorAddress)
+	suite.True(found)
+	suite.True(metrics.Tombstoned)
+	suite.Equal(sdk.NewInt(100), metrics.SelfBond)
+	suite.False(metrics.IsEligible(k.GetParams(ctx).ValidatorWeighting))
+
+	// metrics of validators that are not allow listed are dropped
+	validator.OperatorAddress = "cosmosvaloper1qypqxpq9qcrsszg2pvxq6rs0zqg3yyc5lzv7xu"
+	response, err = app.AppCodec().Marshal(&stakingtypes.QueryValidatorResponse{Validator: validator})
+	suite.NoError(err)
+	suite.NoError(k.HandleValidatorMetricsCallback(ctx, response, icqtypes.Query{ConnectionId: ConnectionID, ChainId: ChainID}))
+	suite.Len(k.GetAllValidatorMetrics(ctx), 1)
+}
+
+func (suite *IntegrationTestSuite) TestQueryValidatorWeights() {
+	app, ctx := suite.app, suite.ctx
+	qrysrv := types.QueryServer(app.LSCosmosKeeper)
+	c := sdk.WrapSDKContext(ctx)
+
+	_, err := qrysrv.ValidatorWeights(c, nil)
+	suite.Error(err)
+
+	metrics := types.ValidatorMetrics{
+		ValidatorAddress: allowListedValidators.AllowListedValidators[1].ValidatorAddress,
+		Commission:       sdk.NewDecWithPrec(5, 2),
+		Bonded:           true,
+		Tokens:           sdk.NewInt(1000),
+		SelfBond:         sdk.NewInt(100),
+	}
+	app.LSCosmosKeeper.SetValidatorMetrics(ctx, metrics)
+
+	res, err := qrysrv.ValidatorWeights(c, &types.QueryValidatorWeightsRequest{})
+	suite.NoError(err)
+	suite.Len(res.ValidatorWeights, 3)
+	suite.Equal(uint64(3), res.Pagination.Total)
+
+	res, err = qrysrv.ValidatorWeights(c, &types.QueryValidatorWeightsRequest{Pagination: &query.PageRequest{Offset: 1, Limit: 1}})
+	suite.NoError(err)
+	suite.Len(res.ValidatorWeights, 1)
+	suite.Equal(metrics.ValidatorAddress, res.ValidatorWeights[0].ValidatorAddress)
+	suite.Equal(allowListedValidators.AllowListedValidators[1].TargetWeight, res.ValidatorWeights[0].BaseWeight)
+	suite.Equal(allowListedValidators.AllowListedValidators[1].TargetWeight, res.ValidatorWeights[0].EffectiveWeight)
+	suite.Equal(&metrics, res.ValidatorWeights[0].Metrics)
+}
@@ -0,0 +1,79 @@
+package v3
+
+import (
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/persistenceOne/pstake-native/v2/x/lscosmos/types"
+)
+
+// migratedKey is a store entry moved from its legacy key to its new key
+type migratedKey struct {
+	legacyKey []byte
+	newKey    []byte
+	value     []byte
+}
+
+// MigrateStore performs in-place store migrations from consensus version 2 to 3. The epoch numbers ending the
+// unbonding epoch c value, delegator unbonding epoch entry and pending auto claim epoch keys are re-encoded
+// from decimal strings, which iterate in lexicographic order, to fixed width big endian bytes.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey) error {
+	store := ctx.KVStore(storeKey)
+
+	if err := migrateEpochKeys(store, types.UnbondingEpochCValueKey, false); err != nil {
+		return err
+	}
+	if err := migrateEpochKeys(store, types.DelegatorUnbondingEpochEntryKey, true); err != nil {
+		return err
+	}
+	return migrateEpochKeys(store, types.PendingAutoClaimEpochKey, false)
+}
+
+// migrateEpochKeys re-encodes the epoch number ending every key under keyPrefix, the epoch number follows a
+// length prefixed address if addressPrefixed is set. Values are kept as is.
+func migrateEpochKeys(store sdk.KVStore, keyPrefix []byte, addressPrefixed bool) error {
+	prefixStore := prefix.NewStore(store, keyPrefix)
+
+	// collect the entries first, the store can not be written while iterating
+	var migrated []migratedKey
+	iterator := prefixStore.Iterator(nil, nil)
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()
+
+		epochOffset := 0
+		if addressPrefixed {
+			if len(key) == 0 || len(key) < 1+int(key[0]) {
+				iterator.Close()
+				return errorsmod.Wrapf(types.ErrInvalidArgs, "invalid legacy key %X", append(keyPrefix, key...))
+			}
+			epochOffset = 1 + int(key[0])
+		}
+
+		epochNumber, err := strconv.ParseInt(string(key[epochOffset:]), 10, 64)
+		if err != nil {
+			iterator.Close()
+			return errorsmod.Wrapf(types.ErrInvalidArgs, "invalid legacy key %X: %s", append(keyPrefix, key...), err)
+		}
+
+		migrated = append(migrated, migratedKey{
+			legacyKey: append([]byte{}, key...),
+			newKey:    append(append([]byte{}, key[:epochOffset]...), types.GetEpochNumberBytes(epochNumber)...),
+			value:     append([]byte{}, iterator.Value()...),
+		})
+	}
+	iterator.Close()
+
+	// legacy keys end with ascii digits and new keys with a zero byte, so they never collide
+	for _, entry := range migrated {
+		prefixStore.Delete(entry.legacyKey)
+	}
+	for _, entry := range migrated {
+		prefixStore.Set(entry.newKey, entry.value)
+	}
+
+	return nil
+}
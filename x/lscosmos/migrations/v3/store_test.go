@@ -0,0 +1,81 @@
+package v3_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/stretchr/testify/require"
+
+	"github.com/persistenceOne/pstake-native/v2/app"
+	v3 "github.com/persistenceOne/pstake-native/v2/x/lscosmos/migrations/v3"
+	"github.com/persistenceOne/pstake-native/v2/x/lscosmos/types"
+)
+
+// legacyEpochKey returns the consensus version 2 key made of keyPrefix and the epoch number as a decimal string
+func legacyEpochKey(keyPrefix []byte, epochNumber int64) []byte {
+	return append(append([]byte{}, keyPrefix...), []byte(strconv.FormatInt(epochNumber, 10))...)
+}
+
+func TestMigrateStore(t *testing.T) {
+	cdc := app.MakeEncodingConfig().Marshaler
+	storeKey := sdk.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(storeKey, sdk.NewTransientStoreKey("transient_test"))
+	store := ctx.KVStore(storeKey)
+
+	delegator := sdk.AccAddress("delegator___________")
+	delegatorPrefix := types.GetPartialDelegatorUnbondingEpochEntryKey(delegator)
+	epochNumbers := []int64{4, 8, 12, 100}
+
+	for _, epochNumber := range epochNumbers {
+		cValue := types.UnbondingEpochCValue{EpochNumber: epochNumber, STKBurn: sdk.NewInt64Coin("stkuatom", epochNumber)}
+		store.Set(legacyEpochKey(types.UnbondingEpochCValueKey, epochNumber), cdc.MustMarshal(&cValue))
+
+		entry := types.NewDelegatorUnbondingEpochEntry(delegator.String(), epochNumber, sdk.NewInt64Coin("stkuatom", epochNumber))
+		store.Set(legacyEpochKey(delegatorPrefix, epochNumber), cdc.MustMarshal(&entry))
+	}
+	cursor := address.MustLengthPrefix(sdk.AccAddress("cursor"))
+	store.Set(legacyEpochKey(types.PendingAutoClaimEpochKey, 12), cursor)
+
+	require.NoError(t, v3.MigrateStore(ctx, storeKey))
+
+	// entries are found with the new keys and iterate in epoch order
+	iterator := sdk.KVStorePrefixIterator(store, types.UnbondingEpochCValueKey)
+	for _, epochNumber := range epochNumbers {
+		require.True(t, iterator.Valid())
+		require.Equal(t, types.GetUnbondingEpochCValueKey(epochNumber), iterator.Key())
+
+		var cValue types.UnbondingEpochCValue
+		cdc.MustUnmarshal(iterator.Value(), &cValue)
+		require.Equal(t, epochNumber, cValue.EpochNumber)
+		iterator.Next()
+	}
+	require.False(t, iterator.Valid())
+	iterator.Close()
+
+	iterator = sdk.KVStorePrefixIterator(store, types.DelegatorUnbondingEpochEntryKey)
+	for _, epochNumber := range epochNumbers {
+		require.True(t, iterator.Valid())
+		require.Equal(t, types.GetDelegatorUnbondingEpochEntryKey(delegator, epochNumber), iterator.Key())
+
+		var entry types.DelegatorUnbondingEpochEntry
+		cdc.MustUnmarshal(iterator.Value(), &entry)
+		require.Equal(t, epochNumber, entry.EpochNumber)
+		iterator.Next()
+	}
+	require.False(t, iterator.Valid())
+	iterator.Close()
+
+	require.False(t, store.Has(legacyEpochKey(types.PendingAutoClaimEpochKey, 12)))
+	require.Equal(t, cursor, store.Get(types.GetPendingAutoClaimEpochKey(12)))
+}
+
+func TestMigrateStoreInvalidKey(t *testing.T) {
+	storeKey := sdk.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(storeKey, sdk.NewTransientStoreKey("transient_test"))
+
+	ctx.KVStore(storeKey).Set(append(append([]byte{}, types.UnbondingEpochCValueKey...), []byte("four")...), []byte{})
+	require.Error(t, v3.MigrateStore(ctx, storeKey))
+}
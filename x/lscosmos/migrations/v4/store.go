@@ -0,0 +1,61 @@
+package v4
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/persistenceOne/pstake-native/v2/x/lscosmos/types"
+)
+
+// MigrateStore performs in-place store migrations from consensus version 3 to 4. The host account
+// delegations and undelegations are moved out of the delegation state into entries per validator and
+// per epoch, the delegation state keeps the host delegation account balance and address.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec) error {
+	store := ctx.KVStore(storeKey)
+
+	bz := store.Get(types.DelegationStateKey)
+	if bz == nil {
+		return nil
+	}
+	var delegationState types.DelegationState
+	if err := cdc.Unmarshal(bz, &delegationState); err != nil {
+		return err
+	}
+
+	// entries of the same validator or epoch are merged, the legacy state did not prevent duplicates
+	for _, delegation := range delegationState.HostAccountDelegations {
+		key := types.GetHostAccountDelegationKey(delegation.ValidatorAddress)
+		if existing := store.Get(key); existing != nil {
+			var existingDelegation types.HostAccountDelegation
+			if err := cdc.Unmarshal(existing, &existingDelegation); err != nil {
+				return err
+			}
+			delegation.Amount = existingDelegation.Amount.Add(delegation.Amount)
+		}
+		store.Set(key, cdc.MustMarshal(&delegation))
+	}
+
+	for _, undelegation := range delegationState.HostAccountUndelegations {
+		key := types.GetHostAccountUndelegationKey(undelegation.EpochNumber)
+		if existing := store.Get(key); existing != nil {
+			var existingUndelegation types.HostAccountUndelegation
+			if err := cdc.Unmarshal(existing, &existingUndelegation); err != nil {
+				return err
+			}
+			existingUndelegation.TotalUndelegationAmount = existingUndelegation.TotalUndelegationAmount.Add(undelegation.TotalUndelegationAmount)
+			existingUndelegation.UndelegationEntries = append(existingUndelegation.UndelegationEntries, undelegation.UndelegationEntries...)
+			if existingUndelegation.CompletionTime.Before(undelegation.CompletionTime) {
+				existingUndelegation.CompletionTime = undelegation.CompletionTime
+			}
+			undelegation = existingUndelegation
+		}
+		store.Set(key, cdc.MustMarshal(&undelegation))
+	}
+
+	delegationState.HostAccountDelegations = nil
+	delegationState.HostAccountUndelegations = nil
+	store.Set(types.DelegationStateKey, cdc.MustMarshal(&delegationState))
+
+	return nil
+}
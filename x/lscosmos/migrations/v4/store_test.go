@@ -0,0 +1,76 @@
+package v4_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/persistenceOne/pstake-native/v2/app"
+	v4 "github.com/persistenceOne/pstake-native/v2/x/lscosmos/migrations/v4"
+	"github.com/persistenceOne/pstake-native/v2/x/lscosmos/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	cdc := app.MakeEncodingConfig().Marshaler
+	storeKey := sdk.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(storeKey, sdk.NewTransientStoreKey("transient_test"))
+	store := ctx.KVStore(storeKey)
+
+	completionTime := time.Unix(1_700_000_000, 0).UTC()
+	legacyState := types.DelegationState{
+		HostDelegationAccountBalance: sdk.NewCoins(sdk.NewInt64Coin("uatom", 100)),
+		HostChainDelegationAddress:   "cosmos1delegation",
+		HostAccountDelegations: []types.HostAccountDelegation{
+			types.NewHostAccountDelegation("cosmosvaloper1b", sdk.NewInt64Coin("uatom", 20)),
+			types.NewHostAccountDelegation("cosmosvaloper1a", sdk.NewInt64Coin("uatom", 10)),
+			types.NewHostAccountDelegation("cosmosvaloper1b", sdk.NewInt64Coin("uatom", 5)),
+		},
+		HostAccountUndelegations: []types.HostAccountUndelegation{
+			{
+				EpochNumber:             8,
+				TotalUndelegationAmount: sdk.NewInt64Coin("stkuatom", 3),
+				CompletionTime:          completionTime,
+				UndelegationEntries:     []types.UndelegationEntry{{ValidatorAddress: "cosmosvaloper1a", Amount: sdk.NewInt64Coin("uatom", 3)}},
+			},
+			{
+				EpochNumber:             4,
+				TotalUndelegationAmount: sdk.NewInt64Coin("stkuatom", 1),
+			},
+		},
+	}
+	store.Set(types.DelegationStateKey, cdc.MustMarshal(&legacyState))
+
+	require.NoError(t, v4.MigrateStore(ctx, storeKey, cdc))
+
+	var delegationState types.DelegationState
+	cdc.MustUnmarshal(store.Get(types.DelegationStateKey), &delegationState)
+	require.Equal(t, legacyState.HostDelegationAccountBalance, delegationState.HostDelegationAccountBalance)
+	require.Equal(t, legacyState.HostChainDelegationAddress, delegationState.HostChainDelegationAddress)
+	require.Empty(t, delegationState.HostAccountDelegations)
+	require.Empty(t, delegationState.HostAccountUndelegations)
+
+	// duplicate validator entries are merged
+	var delegation types.HostAccountDelegation
+	cdc.MustUnmarshal(store.Get(types.GetHostAccountDelegationKey("cosmosvaloper1b")), &delegation)
+	require.Equal(t, sdk.NewInt64Coin("uatom", 25), delegation.Amount)
+	cdc.MustUnmarshal(store.Get(types.GetHostAccountDelegationKey("cosmosvaloper1a")), &delegation)
+	require.Equal(t, sdk.NewInt64Coin("uatom", 10), delegation.Amount)
+
+	var undelegation types.HostAccountUndelegation
+	cdc.MustUnmarshal(store.Get(types.GetHostAccountUndelegationKey(8)), &undelegation)
+	require.Equal(t, legacyState.HostAccountUndelegations[0].TotalUndelegationAmount, undelegation.TotalUndelegationAmount)
+	require.Equal(t, completionTime, undelegation.CompletionTime)
+	require.Len(t, undelegation.UndelegationEntries, 1)
+	require.True(t, store.Has(types.GetHostAccountUndelegationKey(4)))
+}
+
+func TestMigrateStoreEmpty(t *testing.T) {
+	storeKey := sdk.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(storeKey, sdk.NewTransientStoreKey("transient_test"))
+
+	require.NoError(t, v4.MigrateStore(ctx, storeKey, app.MakeEncodingConfig().Marshaler))
+	require.False(t, ctx.KVStore(storeKey).Has(types.DelegationStateKey))
+}
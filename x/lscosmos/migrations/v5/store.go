@@ -0,0 +1,96 @@
+package v5
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/persistenceOne/pstake-native/v2/x/lscosmos/types"
+)
+
+// MigrateStore performs in-place store migrations from consensus version 4 to 5. The delegators with an
+// unbonding epoch entry are indexed per epoch, so the auto claims of an epoch only iterate its own entries, and
+// the pstake fee address is stored as the admin if no admins are set, the admins no longer fall back to it. The
+// signaling host chain proposals are indexed by voting end time.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec) error {
+	store := ctx.KVStore(storeKey)
+
+	// collect the index keys first, the store can not be written while iterating
+	var indexKeys [][]byte
+	iterator := prefix.NewStore(store, types.DelegatorUnbondingEpochEntryKey).Iterator(nil, nil)
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()
+		if len(key) == 0 || len(key) != 1+int(key[0])+8 {
+			iterator.Close()
+			return errorsmod.Wrapf(types.ErrInvalidArgs, "invalid delegator unbonding epoch entry key %X", append(types.DelegatorUnbondingEpochEntryKey, key...))
+		}
+		delegatorAddress := sdk.AccAddress(key[1 : 1+int(key[0])])
+		epochNumber := types.ParseEpochNumberBytes(key[1+int(key[0]):])
+		indexKeys = append(indexKeys, types.GetUnbondingEpochDelegatorKey(epochNumber, delegatorAddress))
+	}
+	iterator.Close()
+
+	for _, indexKey := range indexKeys {
+		store.Set(indexKey, []byte{})
+	}
+
+	if err := migrateAdmins(store, cdc); err != nil {
+		return err
+	}
+	return indexSignalingHostProposals(store, cdc)
+}
+
+func migrateAdmins(store sdk.KVStore, cdc codec.BinaryCodec) error {
+	var roles types.Roles
+	if bz := store.Get(types.RolesKey); bz != nil {
+		if err := cdc.Unmarshal(bz, &roles); err != nil {
+			return err
+		}
+	}
+	if len(roles.Admins) != 0 {
+		return nil
+	}
+
+	bz := store.Get(types.HostChainParamsKey)
+	if bz == nil {
+		return nil
+	}
+	var hostChainParams types.HostChainParams
+	if err := cdc.Unmarshal(bz, &hostChainParams); err != nil {
+		return err
+	}
+	if hostChainParams.PstakeParams.PstakeFeeAddress == "" {
+		return nil
+	}
+
+	roles.Admins = []string{hostChainParams.PstakeParams.PstakeFeeAddress}
+	bz, err := cdc.Marshal(&roles)
+	if err != nil {
+		return err
+	}
+	store.Set(types.RolesKey, bz)
+	return nil
+}
+
+func indexSignalingHostProposals(store sdk.KVStore, cdc codec.BinaryCodec) error {
+	var indexKeys [][]byte
+	iterator := prefix.NewStore(store, types.HostProposalKey).Iterator(nil, nil)
+	for ; iterator.Valid(); iterator.Next() {
+		var hostProposal types.HostProposal
+		if err := cdc.Unmarshal(iterator.Value(), &hostProposal); err != nil {
+			iterator.Close()
+			return err
+		}
+		if hostProposal.Status == types.HOST_PROPOSAL_STATUS_SIGNALING {
+			indexKeys = append(indexKeys, types.GetSignalingHostProposalKey(hostProposal.VotingEndTime, hostProposal.ProposalId))
+		}
+	}
+	iterator.Close()
+
+	for _, indexKey := range indexKeys {
+		store.Set(indexKey, []byte{})
+	}
+	return nil
+}
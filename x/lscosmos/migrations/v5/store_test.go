@@ -0,0 +1,87 @@
+package v5_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/persistenceOne/pstake-native/v2/app"
+	v5 "github.com/persistenceOne/pstake-native/v2/x/lscosmos/migrations/v5"
+	"github.com/persistenceOne/pstake-native/v2/x/lscosmos/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	cdc := app.MakeEncodingConfig().Marshaler
+	storeKey := sdk.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(storeKey, sdk.NewTransientStoreKey("transient_test"))
+	store := ctx.KVStore(storeKey)
+
+	addr1 := sdk.AccAddress("addr1_______________")
+	addr2 := sdk.AccAddress("addr2_______________")
+	for _, entry := range []types.DelegatorUnbondingEpochEntry{
+		types.NewDelegatorUnbondingEpochEntry(addr1.String(), 4, sdk.NewInt64Coin("stkuatom", 10)),
+		types.NewDelegatorUnbondingEpochEntry(addr1.String(), 8, sdk.NewInt64Coin("stkuatom", 20)),
+		types.NewDelegatorUnbondingEpochEntry(addr2.String(), 8, sdk.NewInt64Coin("stkuatom", 30)),
+	} {
+		store.Set(types.GetDelegatorUnbondingEpochEntryKey(sdk.MustAccAddressFromBech32(entry.DelegatorAddress), entry.EpochNumber), cdc.MustMarshal(&entry))
+	}
+
+	require.NoError(t, v5.MigrateStore(ctx, storeKey, cdc))
+
+	epochDelegators := func(epochNumber int64) []sdk.AccAddress {
+		var delegators []sdk.AccAddress
+		iterator := prefix.NewStore(store, types.GetUnbondingEpochDelegatorsKey(epochNumber)).Iterator(nil, nil)
+		defer iterator.Close()
+		for ; iterator.Valid(); iterator.Next() {
+			delegators = append(delegators, sdk.AccAddress(iterator.Key()[1:]))
+		}
+		return delegators
+	}
+	require.Equal(t, []sdk.AccAddress{addr1}, epochDelegators(4))
+	require.Equal(t, []sdk.AccAddress{addr1, addr2}, epochDelegators(8))
+	require.Empty(t, epochDelegators(12))
+}
+
+func TestMigrateStoreInvalidKey(t *testing.T) {
+	cdc := app.MakeEncodingConfig().Marshaler
+	storeKey := sdk.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(storeKey, sdk.NewTransientStoreKey("transient_test"))
+	ctx.KVStore(storeKey).Set(append(types.DelegatorUnbondingEpochEntryKey, 0x14, 0x01), []byte{})
+
+	require.Error(t, v5.MigrateStore(ctx, storeKey, cdc))
+}
+
+func TestMigrateStoreAdmins(t *testing.T) {
+	cdc := app.MakeEncodingConfig().Marshaler
+	storeKey := sdk.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(storeKey, sdk.NewTransientStoreKey("transient_test"))
+	store := ctx.KVStore(storeKey)
+
+	getRoles := func() types.Roles {
+		var roles types.Roles
+		cdc.MustUnmarshal(store.Get(types.RolesKey), &roles)
+		return roles
+	}
+
+	// nothing is seeded before the host chain is registered
+	require.NoError(t, v5.MigrateStore(ctx, storeKey, cdc))
+	require.Nil(t, store.Get(types.RolesKey))
+
+	feeAddress := sdk.AccAddress("feeaddress__________").String()
+	pauser := sdk.AccAddress("pauser______________").String()
+	hostChainParams := types.HostChainParams{PstakeParams: types.PstakeParams{PstakeFeeAddress: feeAddress}}
+	store.Set(types.HostChainParamsKey, cdc.MustMarshal(&hostChainParams))
+	store.Set(types.RolesKey, cdc.MustMarshal(&types.Roles{Pausers: []string{pauser}}))
+
+	require.NoError(t, v5.MigrateStore(ctx, storeKey, cdc))
+	require.Equal(t, types.Roles{Admins: []string{feeAddress}, Pausers: []string{pauser}}, getRoles())
+
+	// admins already set are kept
+	admin := sdk.AccAddress("admin_______________").String()
+	store.Set(types.RolesKey, cdc.MustMarshal(&types.Roles{Admins: []string{admin}}))
+	require.NoError(t, v5.MigrateStore(ctx, storeKey, cdc))
+	require.Equal(t, []string{admin}, getRoles().Admins)
+}
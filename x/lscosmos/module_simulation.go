@@ -3,44 +3,25 @@ package lscosmos
 import (
 	"math/rand"
 
-	"github.com/cosmos/cosmos-sdk/baseapp"
-	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
-	"github.com/cosmos/cosmos-sdk/x/simulation"
+
 	lscosmossimulation "github.com/persistenceOne/pstake-native/v2/x/lscosmos/simulation"
 	"github.com/persistenceOne/pstake-native/v2/x/lscosmos/types"
 )
 
-// avoid unused import issue
-var (
-	_ = lscosmossimulation.FindAccount
-	_ = simappparams.StakePerAccount
-	_ = simulation.MsgEntryKind
-	_ = baseapp.Paramspace
-)
-
-const (
-// this line is used by starport scaffolding # simapp/module/const
-)
+var _ module.AppModuleSimulation = AppModule{}
 
 // GenerateGenesisState creates a randomized GenState of the module
 func (AppModule) GenerateGenesisState(simState *module.SimulationState) {
-	accs := make([]string, len(simState.Accounts))
-	for i, acc := range simState.Accounts {
-		accs[i] = acc.Address.String()
-	}
-	lscosmosGenesis := types.GenesisState{
-		Params: types.DefaultParams(),
-		// this line is used by starport scaffolding # simapp/module/genesisState
-	}
-	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(&lscosmosGenesis)
+	lscosmossimulation.RandomizedGenState(simState)
 }
 
-// ProposalContents doesn't return any content functions for governance proposals
+// ProposalContents returns all the lscosmos content functions used to
+// simulate governance proposals.
 func (AppModule) ProposalContents(_ module.SimulationState) []simtypes.WeightedProposalContent {
-	return nil
+	return lscosmossimulation.ProposalContents()
 }
 
 // RandomizedParams creates randomized  param changes for the simulator
@@ -49,14 +30,14 @@ func (am AppModule) RandomizedParams(_ *rand.Rand) []simtypes.ParamChange {
 	return []simtypes.ParamChange{}
 }
 
-// RegisterStoreDecoder registers a decoder
-func (am AppModule) RegisterStoreDecoder(_ sdk.StoreDecoderRegistry) {}
+// RegisterStoreDecoder registers a decoder for lscosmos module's types
+func (am AppModule) RegisterStoreDecoder(sdr sdk.StoreDecoderRegistry) {
+	sdr[types.StoreKey] = lscosmossimulation.NewDecodeStore(am.cdc)
+}
 
-// WeightedOperations returns the all the gov module operations with their respective weights.
+// WeightedOperations returns the all the lscosmos module operations with their respective weights.
 func (am AppModule) WeightedOperations(simState module.SimulationState) []simtypes.WeightedOperation {
-	operations := make([]simtypes.WeightedOperation, 0)
-
-	// this line is used by starport scaffolding # simapp/module/operation
-
-	return operations
+	return lscosmossimulation.WeightedOperations(
+		simState.AppParams, simState.Cdc, am.accountKeeper, am.bankKeeper, am.keeper,
+	)
 }
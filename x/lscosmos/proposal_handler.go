@@ -20,6 +20,8 @@ func NewLSCosmosProposalHandler(k keeper.Keeper) govtypes.Handler {
 			return keeper.HandlePstakeFeeAddressChangeProposal(ctx, k, *c)
 		case *types.AllowListedValidatorSetChangeProposal:
 			return keeper.HandleAllowListedValidatorSetChangeProposal(ctx, k, *c)
+		case *types.FeeSplitChangeProposal:
+			return keeper.HandleFeeSplitChangeProposal(ctx, k, *c)
 
 		default:
 			return errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized proposal content type: %T", c)
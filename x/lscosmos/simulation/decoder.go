@@ -0,0 +1,176 @@
+package simulation
+
+import (
+	"bytes"
+	"fmt"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+
+	"github.com/persistenceOne/pstake-native/v2/x/lscosmos/types"
+)
+
+// NewDecodeStore returns a decoder function closure that unmarshals the KVPair's
+// Value to the corresponding lscosmos type.
+func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
+	return func(kvA, kvB kv.Pair) string {
+		switch {
+		case bytes.Equal(kvA.Key[:1], types.ModuleEnableKey):
+			return fmt.Sprintf("%s\n%s", kvA.Value, kvB.Value)
+
+		case bytes.Equal(kvA.Key[:1], types.HostChainParamsKey):
+			var cA, cB types.HostChainParams
+			cdc.MustUnmarshal(kvA.Value, &cA)
+			cdc.MustUnmarshal(kvB.Value, &cB)
+			return fmt.Sprintf("%v\n%v", cA, cB)
+
+		case bytes.Equal(kvA.Key[:1], types.AllowListedValidatorsKey):
+			var cA, cB types.AllowListedValidators
+			cdc.MustUnmarshal(kvA.Value, &cA)
+			cdc.MustUnmarshal(kvB.Value, &cB)
+			return fmt.Sprintf("%v\n%v", cA, cB)
+
+		case bytes.Equal(kvA.Key[:1], types.DelegationStateKey):
+			var cA, cB types.DelegationState
+			cdc.MustUnmarshal(kvA.Value, &cA)
+			cdc.MustUnmarshal(kvB.Value, &cB)
+			return fmt.Sprintf("%v\n%v", cA, cB)
+
+		case bytes.Equal(kvA.Key[:1], types.HostAccountDelegationKey):
+			var cA, cB types.HostAccountDelegation
+			cdc.MustUnmarshal(kvA.Value, &cA)
+			cdc.MustUnmarshal(kvB.Value, &cB)
+			return fmt.Sprintf("%v\n%v", cA, cB)
+
+		case bytes.Equal(kvA.Key[:1], types.HostAccountUndelegationKey):
+			var cA, cB types.HostAccountUndelegation
+			cdc.MustUnmarshal(kvA.Value, &cA)
+			cdc.MustUnmarshal(kvB.Value, &cB)
+			return fmt.Sprintf("%v\n%v", cA, cB)
+
+		case bytes.Equal(kvA.Key[:1], types.HostChainRewardAddressKey):
+			var cA, cB types.HostChainRewardAddress
+			cdc.MustUnmarshal(kvA.Value, &cA)
+			cdc.MustUnmarshal(kvB.Value, &cB)
+			return fmt.Sprintf("%v\n%v", cA, cB)
+
+		case bytes.Equal(kvA.Key[:1], types.IBCTransientStoreKey):
+			var cA, cB types.IBCAmountTransientStore
+			cdc.MustUnmarshal(kvA.Value, &cA)
+			cdc.MustUnmarshal(kvB.Value, &cB)
+			return fmt.Sprintf("%v\n%v", cA, cB)
+
+		case bytes.Equal(kvA.Key[:1], types.UnbondingEpochCValueKey):
+			var cA, cB types.UnbondingEpochCValue
+			cdc.MustUnmarshal(kvA.Value, &cA)
+			cdc.MustUnmarshal(kvB.Value, &cB)
+			return fmt.Sprintf("%v\n%v", cA, cB)
+
+		case bytes.Equal(kvA.Key[:1], types.DelegatorUnbondingEpochEntryKey):
+			var cA, cB types.DelegatorUnbondingEpochEntry
+			cdc.MustUnmarshal(kvA.Value, &cA)
+			cdc.MustUnmarshal(kvB.Value, &cB)
+			return fmt.Sprintf("%v\n%v", cA, cB)
+
+		case bytes.Equal(kvA.Key[:1], types.HostAccountsKey):
+			var cA, cB types.HostAccounts
+			cdc.MustUnmarshal(kvA.Value, &cA)
+			cdc.MustUnmarshal(kvB.Value, &cB)
+			return fmt.Sprintf("%v\n%v", cA, cB)
+
+		case bytes.Equal(kvA.Key[:1], types.AutoClaimKey),
+			bytes.Equal(kvA.Key[:1], types.PendingAutoClaimEpochKey),
+			bytes.Equal(kvA.Key[:1], types.UnbondingEpochDelegatorKey),
+			bytes.Equal(kvA.Key[:1], types.SignalingHostProposalKey):
+			return fmt.Sprintf("%X\n%X", kvA.Value, kvB.Value)
+
+		case bytes.Equal(kvA.Key[:1], types.AddressDepositsKey):
+			var cA, cB math.Int
+			if err := cA.Unmarshal(kvA.Value); err != nil {
+				panic(err)
+			}
+			if err := cB.Unmarshal(kvB.Value); err != nil {
+				panic(err)
+			}
+			return fmt.Sprintf("%v\n%v", cA, cB)
+
+		case bytes.Equal(kvA.Key[:1], types.EpochDepositsKey):
+			var cA, cB types.EpochDeposits
+			cdc.MustUnmarshal(kvA.Value, &cA)
+			cdc.MustUnmarshal(kvB.Value, &cB)
+			return fmt.Sprintf("%v\n%v", cA, cB)
+
+		case bytes.Equal(kvA.Key[:1], types.FeeSplitKey):
+			var cA, cB types.FeeSplit
+			cdc.MustUnmarshal(kvA.Value, &cA)
+			cdc.MustUnmarshal(kvB.Value, &cB)
+			return fmt.Sprintf("%v\n%v", cA, cB)
+
+		case bytes.Equal(kvA.Key[:1], types.CollectedFeesKey):
+			var cA, cB types.CollectedFee
+			cdc.MustUnmarshal(kvA.Value, &cA)
+			cdc.MustUnmarshal(kvB.Value, &cB)
+			return fmt.Sprintf("%v\n%v", cA, cB)
+
+		case bytes.Equal(kvA.Key[:1], types.RolesKey):
+			var cA, cB types.Roles
+			cdc.MustUnmarshal(kvA.Value, &cA)
+			cdc.MustUnmarshal(kvB.Value, &cB)
+			return fmt.Sprintf("%v\n%v", cA, cB)
+
+		case bytes.Equal(kvA.Key[:1], types.PendingAdminChangeKey):
+			var cA, cB types.PendingAdminChange
+			cdc.MustUnmarshal(kvA.Value, &cA)
+			cdc.MustUnmarshal(kvB.Value, &cB)
+			return fmt.Sprintf("%v\n%v", cA, cB)
+
+		case bytes.Equal(kvA.Key[:1], types.PauseSwitchesKey):
+			var cA, cB types.PauseSwitches
+			cdc.MustUnmarshal(kvA.Value, &cA)
+			cdc.MustUnmarshal(kvB.Value, &cB)
+			return fmt.Sprintf("%v\n%v", cA, cB)
+
+		case bytes.Equal(kvA.Key[:1], types.ValidatorMetricsKey):
+			var cA, cB types.ValidatorMetrics
+			cdc.MustUnmarshal(kvA.Value, &cA)
+			cdc.MustUnmarshal(kvB.Value, &cB)
+			return fmt.Sprintf("%v\n%v", cA, cB)
+
+		case bytes.Equal(kvA.Key[:1], types.EffectiveAllowListedValidatorsKey):
+			var cA, cB types.AllowListedValidators
+			cdc.MustUnmarshal(kvA.Value, &cA)
+			cdc.MustUnmarshal(kvB.Value, &cB)
+			return fmt.Sprintf("%v\n%v", cA, cB)
+
+		case bytes.Equal(kvA.Key[:1], types.CarriedOverRewardsKey):
+			var cA, cB sdk.Coin
+			cdc.MustUnmarshal(kvA.Value, &cA)
+			cdc.MustUnmarshal(kvB.Value, &cB)
+			return fmt.Sprintf("%v\n%v", cA, cB)
+
+		case bytes.Equal(kvA.Key[:1], types.RewardEpochRecordKey),
+			bytes.Equal(kvA.Key[:1], types.PendingRewardEpochRecordKey):
+			var cA, cB types.RewardEpochRecord
+			cdc.MustUnmarshal(kvA.Value, &cA)
+			cdc.MustUnmarshal(kvB.Value, &cB)
+			return fmt.Sprintf("%v\n%v", cA, cB)
+
+		case bytes.Equal(kvA.Key[:1], types.HostProposalKey):
+			var cA, cB types.HostProposal
+			cdc.MustUnmarshal(kvA.Value, &cA)
+			cdc.MustUnmarshal(kvB.Value, &cB)
+			return fmt.Sprintf("%v\n%v", cA, cB)
+
+		case bytes.Equal(kvA.Key[:1], types.HostProposalVoteKey):
+			var cA, cB types.HostProposalVote
+			cdc.MustUnmarshal(kvA.Value, &cA)
+			cdc.MustUnmarshal(kvB.Value, &cB)
+			return fmt.Sprintf("%v\n%v", cA, cB)
+
+		default:
+			panic(fmt.Sprintf("invalid lscosmos key prefix %X", kvA.Key[:1]))
+		}
+	}
+}
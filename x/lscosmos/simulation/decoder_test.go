@@ -0,0 +1,131 @@
+package simulation_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+
+	"github.com/persistenceOne/pstake-native/v2/x/lscosmos/simulation"
+	"github.com/persistenceOne/pstake-native/v2/x/lscosmos/types"
+)
+
+func TestDecodeLSCosmosStore(t *testing.T) {
+
+	cdc := simapp.MakeTestEncodingConfig()
+	dec := simulation.NewDecodeStore(cdc.Codec)
+
+	delegator := sdk.AccAddress("addr1_______________")
+
+	allowListedValidators := types.AllowListedValidators{
+		AllowListedValidators: []types.AllowListedValidator{
+			{
+				ValidatorAddress: "cosmosvaloper13w4ueuk80d3kmwk7ntlhp84fk0arlm3m9ammr5",
+				TargetWeight:     sdk.OneDec(),
+			},
+		},
+	}
+	validatorMetrics := types.ValidatorMetrics{
+		ValidatorAddress: "cosmosvaloper13w4ueuk80d3kmwk7ntlhp84fk0arlm3m9ammr5",
+		Commission:       sdk.NewDecWithPrec(5, 2),
+		Bonded:           true,
+		Tokens:           sdk.NewInt(1000),
+		SelfBond:         sdk.NewInt(10),
+	}
+	rewardEpochRecord := types.RewardEpochRecord{
+		EpochNumber:         3,
+		Accrued:             sdk.NewInt64Coin("uatom", 100),
+		Restaked:            sdk.NewInt64Coin("uatom", 60),
+		SentToInsuranceFund: sdk.NewInt64Coin("uatom", 0),
+		CarriedOver:         sdk.NewInt64Coin("uatom", 40),
+	}
+	hostProposal := types.HostProposal{
+		ProposalId:    7,
+		VotingEndTime: time.Unix(1000, 0).UTC(),
+		Status:        types.HOST_PROPOSAL_STATUS_SIGNALING,
+		Tally:         types.NewHostProposalTally(),
+	}
+	hostProposalVote := types.HostProposalVote{
+		ProposalId: 7,
+		Voter:      delegator.String(),
+		Options:    govtypes.NewNonSplitVoteOption(govtypes.OptionYes),
+	}
+	hostAccountDelegation := types.HostAccountDelegation{
+		ValidatorAddress: "cosmosvaloper13w4ueuk80d3kmwk7ntlhp84fk0arlm3m9ammr5",
+		Amount:           sdk.NewInt64Coin("uatom", 1000),
+	}
+	hostAccountUndelegation := types.HostAccountUndelegation{
+		EpochNumber:             4,
+		TotalUndelegationAmount: sdk.NewInt64Coin("stk/uatom", 100),
+		CompletionTime:          time.Unix(2000, 0).UTC(),
+		UndelegationEntries: []types.UndelegationEntry{
+			{ValidatorAddress: hostAccountDelegation.ValidatorAddress, Amount: sdk.NewInt64Coin("uatom", 100)},
+		},
+	}
+	unbondingEntry := types.NewDelegatorUnbondingEpochEntry(delegator.String(), 4, sdk.NewInt64Coin("stk/uatom", 100))
+	deposits := sdk.NewInt(1000)
+	depositsBz, err := deposits.Marshal()
+	require.NoError(t, err)
+
+	kvPairs := kv.Pairs{
+		Pairs: []kv.Pair{
+			{Key: types.ModuleEnableKey, Value: []byte("true")},
+			{Key: types.AllowListedValidatorsKey, Value: cdc.Codec.MustMarshal(&allowListedValidators)},
+			{Key: types.GetHostAccountDelegationKey(hostAccountDelegation.ValidatorAddress), Value: cdc.Codec.MustMarshal(&hostAccountDelegation)},
+			{Key: types.GetHostAccountUndelegationKey(hostAccountUndelegation.EpochNumber), Value: cdc.Codec.MustMarshal(&hostAccountUndelegation)},
+			{Key: types.GetDelegatorUnbondingEpochEntryKey(delegator, 4), Value: cdc.Codec.MustMarshal(&unbondingEntry)},
+			{Key: types.GetAutoClaimKey(delegator), Value: []byte{0x01}},
+			{Key: types.GetUnbondingEpochDelegatorKey(4, delegator), Value: []byte{}},
+			{Key: types.GetAddressDepositsKey(delegator), Value: depositsBz},
+			{Key: types.GetValidatorMetricsKey(validatorMetrics.ValidatorAddress), Value: cdc.Codec.MustMarshal(&validatorMetrics)},
+			{Key: types.EffectiveAllowListedValidatorsKey, Value: cdc.Codec.MustMarshal(&allowListedValidators)},
+			{Key: types.CarriedOverRewardsKey, Value: cdc.Codec.MustMarshal(&rewardEpochRecord.CarriedOver)},
+			{Key: types.GetRewardEpochRecordKey(rewardEpochRecord.EpochNumber), Value: cdc.Codec.MustMarshal(&rewardEpochRecord)},
+			{Key: types.PendingRewardEpochRecordKey, Value: cdc.Codec.MustMarshal(&rewardEpochRecord)},
+			{Key: types.GetHostProposalKey(hostProposal.ProposalId), Value: cdc.Codec.MustMarshal(&hostProposal)},
+			{Key: types.GetHostProposalVoteKey(hostProposal.ProposalId, delegator), Value: cdc.Codec.MustMarshal(&hostProposalVote)},
+			{Key: types.GetSignalingHostProposalKey(hostProposal.VotingEndTime, hostProposal.ProposalId), Value: []byte{}},
+			{Key: []byte{0x99}, Value: []byte{0x99}},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		expectedLog string
+	}{
+		{"ModuleEnable", "true\ntrue"},
+		{"AllowListedValidators", fmt.Sprintf("%v\n%v", allowListedValidators, allowListedValidators)},
+		{"HostAccountDelegation", fmt.Sprintf("%v\n%v", hostAccountDelegation, hostAccountDelegation)},
+		{"HostAccountUndelegation", fmt.Sprintf("%v\n%v", hostAccountUndelegation, hostAccountUndelegation)},
+		{"DelegatorUnbondingEpochEntry", fmt.Sprintf("%v\n%v", unbondingEntry, unbondingEntry)},
+		{"AutoClaim", "01\n01"},
+		{"UnbondingEpochDelegator", "\n"},
+		{"AddressDeposits", fmt.Sprintf("%v\n%v", deposits, deposits)},
+		{"ValidatorMetrics", fmt.Sprintf("%v\n%v", validatorMetrics, validatorMetrics)},
+		{"EffectiveAllowListedValidators", fmt.Sprintf("%v\n%v", allowListedValidators, allowListedValidators)},
+		{"CarriedOverRewards", fmt.Sprintf("%v\n%v", rewardEpochRecord.CarriedOver, rewardEpochRecord.CarriedOver)},
+		{"RewardEpochRecord", fmt.Sprintf("%v\n%v", rewardEpochRecord, rewardEpochRecord)},
+		{"PendingRewardEpochRecord", fmt.Sprintf("%v\n%v", rewardEpochRecord, rewardEpochRecord)},
+		{"HostProposal", fmt.Sprintf("%v\n%v", hostProposal, hostProposal)},
+		{"HostProposalVote", fmt.Sprintf("%v\n%v", hostProposalVote, hostProposalVote)},
+		{"SignalingHostProposal", "\n"},
+		{"other", ""},
+	}
+	for i, tt := range tests {
+		i, tt := i, tt
+		t.Run(tt.name, func(t *testing.T) {
+			switch i {
+			case len(tests) - 1:
+				require.Panics(t, func() { dec(kvPairs.Pairs[i], kvPairs.Pairs[i]) }, tt.name)
+			default:
+				require.Equal(t, tt.expectedLog, dec(kvPairs.Pairs[i], kvPairs.Pairs[i]), tt.name)
+			}
+		})
+	}
+}
@@ -0,0 +1,153 @@
+package simulation
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	ibctransfertypes "github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
+
+	"github.com/persistenceOne/pstake-native/v2/x/lscosmos/types"
+)
+
+// DONTCOVER
+
+// Simulation genesis constants
+const (
+	hostChainParams       = "host_chain_params"
+	allowListedValidators = "allow_listed_validators"
+
+	SimHostChainID       = "cosmoshub-4"
+	SimConnectionID      = "connection-0"
+	SimTransferChannel   = "channel-0"
+	SimTransferPort      = ibctransfertypes.PortID
+	SimBaseDenom         = "uatom"
+	SimMintDenom         = types.LiquidStakedDenomPrefix + "/" + SimBaseDenom
+	SimHostAccountPrefix = "cosmos"
+
+	MaxAllowListedValidators = 10
+)
+
+// genFee returns a random fee below the max fee
+func genFee(r *rand.Rand, maxFee sdk.Dec) sdk.Dec {
+	return simtypes.RandomDecAmount(r, maxFee.Sub(sdk.SmallestDec()))
+}
+
+func genMinDeposit(r *rand.Rand) math.Int {
+	return sdk.NewInt(int64(simtypes.RandIntBetween(r, 1, 1_000_000)))
+}
+
+// genHostChainParams returns randomized host chain params, the pstake fee address is picked from the
+// simulation accounts.
+func genHostChainParams(r *rand.Rand, accs []simtypes.Account) types.HostChainParams {
+	feeAccount, _ := simtypes.RandomAcc(r, accs)
+
+	return types.NewHostChainParams(
+		SimHostChainID,
+		SimConnectionID,
+		SimTransferChannel,
+		SimTransferPort,
+		SimBaseDenom,
+		SimMintDenom,
+		feeAccount.Address.String(),
+		genMinDeposit(r),
+		genFee(r, types.MaxPstakeDepositFee),
+		genFee(r, types.MaxPstakeRestakeFee),
+		genFee(r, types.MaxPstakeUnstakeFee),
+		genFee(r, types.MaxPstakeRedemptionFee),
+	)
+}
+
+// genAllowListedValidators returns randomized host chain validators with target weights adding up to one.
+func genAllowListedValidators(r *rand.Rand) types.AllowListedValidators {
+	n := simtypes.RandIntBetween(r, 1, MaxAllowListedValidators+1)
+
+	validators := make([]types.AllowListedValidator, n)
+	remaining := sdk.OneDec()
+	for i := range validators {
+		valAddr, err := types.Bech32FromValAddress(sdk.ValAddress(simtypes.RandomAccounts(r, 1)[0].Address), types.CosmosValOperPrefix)
+		if err != nil {
+			panic(err)
+		}
+
+		weight := remaining
+		if i < n-1 {
+			weight = simtypes.RandomDecAmount(r, remaining)
+		}
+		remaining = remaining.Sub(weight)
+
+		validators[i] = types.AllowListedValidator{
+			ValidatorAddress: valAddr,
+			TargetWeight:     weight,
+		}
+	}
+	return types.AllowListedValidators{AllowListedValidators: validators}
+}
+
+// genHostAddress returns a random host chain account address.
+func genHostAddress(r *rand.Rand) string {
+	hostAddress, err := bech32.ConvertAndEncode(SimHostAccountPrefix, simtypes.RandomAccounts(r, 1)[0].Address)
+	if err != nil {
+		panic(err)
+	}
+	return hostAddress
+}
+
+// RandomizedGenState generates a random GenesisState for lscosmos. The module is enabled right away, the
+// ICA and ICQ round trips are mocked by the simulation operations.
+func RandomizedGenState(simState *module.SimulationState) {
+	genesis := types.DefaultGenesis()
+	genesis.ModuleEnabled = true
+
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, hostChainParams, &genesis.HostChainParams, simState.Rand,
+		func(r *rand.Rand) { genesis.HostChainParams = genHostChainParams(r, simState.Accounts) },
+	)
+
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, allowListedValidators, &genesis.AllowListedValidators, simState.Rand,
+		func(r *rand.Rand) { genesis.AllowListedValidators = genAllowListedValidators(r) },
+	)
+
+	genesis.DelegationState = types.DelegationState{
+		HostDelegationAccountBalance: sdk.NewCoins(),
+		HostChainDelegationAddress:   genHostAddress(simState.Rand),
+	}
+	genesis.HostChainRewardAddress = types.HostChainRewardAddress{
+		Address: genHostAddress(simState.Rand),
+	}
+
+	bz, _ := json.MarshalIndent(&genesis, "", " ")
+	fmt.Printf("Selected randomly generated lscosmos parameters:\n%s\n", bz)
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(genesis)
+
+	registerSimDenomTrace(simState, genesis.HostChainParams)
+}
+
+// registerSimDenomTrace adds the denom trace of the simulated ibc denom to the transfer genesis, as if the
+// host chain base denom was already received over the transfer channel.
+func registerSimDenomTrace(simState *module.SimulationState, hostChainParams types.HostChainParams) {
+	bz, ok := simState.GenState[ibctransfertypes.ModuleName]
+	if !ok {
+		return
+	}
+
+	var transferGenesis ibctransfertypes.GenesisState
+	simState.Cdc.MustUnmarshalJSON(bz, &transferGenesis)
+
+	denomTrace := ibctransfertypes.ParseDenomTrace(
+		ibctransfertypes.GetPrefixedDenom(hostChainParams.TransferPort, hostChainParams.TransferChannel, hostChainParams.BaseDenom),
+	)
+	for _, trace := range transferGenesis.DenomTraces {
+		if trace.IBCDenom() == denomTrace.IBCDenom() {
+			return
+		}
+	}
+	transferGenesis.DenomTraces = append(transferGenesis.DenomTraces, denomTrace)
+	simState.GenState[ibctransfertypes.ModuleName] = simState.Cdc.MustMarshalJSON(&transferGenesis)
+}
@@ -0,0 +1,88 @@
+package simulation_test
+
+import (
+	"encoding/json"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	ibctransfertypes "github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
+
+	"github.com/persistenceOne/pstake-native/v2/x/lscosmos/simulation"
+	"github.com/persistenceOne/pstake-native/v2/x/lscosmos/types"
+)
+
+// TestRandomizedGenState tests the normal scenario of applying RandomizedGenState.
+// Abnormal scenarios are not tested here.
+func TestRandomizedGenState(t *testing.T) {
+	interfaceRegistry := codectypes.NewInterfaceRegistry()
+	cdc := codec.NewProtoCodec(interfaceRegistry)
+	s := rand.NewSource(2)
+	r := rand.New(s)
+
+	simState := module.SimulationState{
+		AppParams:    make(simtypes.AppParams),
+		Cdc:          cdc,
+		Rand:         r,
+		NumBonded:    3,
+		Accounts:     simtypes.RandomAccounts(r, 3),
+		InitialStake: sdk.NewInt(1000),
+		GenState:     make(map[string]json.RawMessage),
+	}
+	simState.GenState[ibctransfertypes.ModuleName] = cdc.MustMarshalJSON(ibctransfertypes.DefaultGenesisState())
+
+	simulation.RandomizedGenState(&simState)
+
+	var genState types.GenesisState
+	simState.Cdc.MustUnmarshalJSON(simState.GenState[types.ModuleName], &genState)
+
+	require.NoError(t, genState.Validate())
+	require.True(t, genState.ModuleEnabled)
+	require.False(t, genState.HostChainParams.IsEmpty())
+	require.Equal(t, simulation.SimMintDenom, genState.HostChainParams.MintDenom)
+	require.True(t, genState.HostChainParams.MinDeposit.IsPositive())
+	require.True(t, genState.HostChainParams.PstakeParams.PstakeDepositFee.LT(types.MaxPstakeDepositFee))
+	require.True(t, genState.AllowListedValidators.Valid())
+	require.NotEmpty(t, genState.DelegationState.HostChainDelegationAddress)
+
+	var transferGenState ibctransfertypes.GenesisState
+	simState.Cdc.MustUnmarshalJSON(simState.GenState[ibctransfertypes.ModuleName], &transferGenState)
+
+	require.Len(t, transferGenState.DenomTraces, 1)
+	require.Equal(t, "transfer/channel-0", transferGenState.DenomTraces[0].Path)
+	require.Equal(t, simulation.SimBaseDenom, transferGenState.DenomTraces[0].BaseDenom)
+}
+
+// TestRandomizedGenState1 tests abnormal scenarios of applying RandomizedGenState.
+func TestRandomizedGenState1(t *testing.T) {
+	interfaceRegistry := codectypes.NewInterfaceRegistry()
+	cdc := codec.NewProtoCodec(interfaceRegistry)
+
+	s := rand.NewSource(1)
+	r := rand.New(s)
+
+	// all these tests will panic
+	tests := []struct {
+		simState module.SimulationState
+		panicMsg string
+	}{
+		{ // panic => reason: incomplete initialization of the simState
+			module.SimulationState{}, "invalid memory address or nil pointer dereference"},
+		{ // panic => reason: incomplete initialization of the simState
+			module.SimulationState{
+				AppParams: make(simtypes.AppParams),
+				Cdc:       cdc,
+				Rand:      r,
+			}, "assignment to entry in nil map"},
+	}
+
+	for _, tt := range tests {
+		require.Panicsf(t, func() { simulation.RandomizedGenState(&tt.simState) }, tt.panicMsg)
+	}
+}
@@ -0,0 +1,341 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	appparams "github.com/persistenceOne/pstake-native/v2/app/params"
+	"github.com/persistenceOne/pstake-native/v2/x/lscosmos/keeper"
+	"github.com/persistenceOne/pstake-native/v2/x/lscosmos/types"
+)
+
+// Simulation operation weights constants.
+//
+//nolint:gosec
+const (
+	OpWeightMsgLiquidStake   = "op_weight_msg_lscosmos_liquid_stake"
+	OpWeightMsgLiquidUnstake = "op_weight_msg_lscosmos_liquid_unstake"
+	OpWeightMsgRedeem        = "op_weight_msg_lscosmos_redeem"
+	OpWeightMsgClaim         = "op_weight_msg_lscosmos_claim"
+)
+
+var (
+	Gas  = uint64(20000000)
+	Fees = sdk.Coins{
+		{
+			Denom:  "stake",
+			Amount: sdk.NewInt(0),
+		},
+	}
+)
+
+// WeightedOperations returns all the operations from the module with their respective weights.
+func WeightedOperations(
+	appParams simtypes.AppParams, cdc codec.JSONCodec, ak types.AccountKeeper,
+	bk types.BankKeeper, k keeper.Keeper,
+) simulation.WeightedOperations {
+
+	var weightMsgLiquidStake int
+	appParams.GetOrGenerate(cdc, OpWeightMsgLiquidStake, &weightMsgLiquidStake, nil,
+		func(_ *rand.Rand) {
+			weightMsgLiquidStake = appparams.DefaultWeightMsgLSCosmosLiquidStake
+		},
+	)
+
+	var weightMsgLiquidUnstake int
+	appParams.GetOrGenerate(cdc, OpWeightMsgLiquidUnstake, &weightMsgLiquidUnstake, nil,
+		func(_ *rand.Rand) {
+			weightMsgLiquidUnstake = appparams.DefaultWeightMsgLSCosmosLiquidUnstake
+		},
+	)
+
+	var weightMsgRedeem int
+	appParams.GetOrGenerate(cdc, OpWeightMsgRedeem, &weightMsgRedeem, nil,
+		func(_ *rand.Rand) {
+			weightMsgRedeem = appparams.DefaultWeightMsgLSCosmosRedeem
+		},
+	)
+
+	var weightMsgClaim int
+	appParams.GetOrGenerate(cdc, OpWeightMsgClaim, &weightMsgClaim, nil,
+		func(_ *rand.Rand) {
+			weightMsgClaim = appparams.DefaultWeightMsgLSCosmosClaim
+		},
+	)
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(
+			weightMsgLiquidStake,
+			SimulateMsgLiquidStake(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgLiquidUnstake,
+			SimulateMsgLiquidUnstake(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgRedeem,
+			SimulateMsgRedeem(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgClaim,
+			SimulateMsgClaim(ak, bk, k),
+		),
+	}
+}
+
+// SimulateMsgLiquidStake generates a MsgLiquidStake with random values. The ibc tokens are minted to the
+// delegator, as if they were received from the host chain over the transfer channel.
+func SimulateMsgLiquidStake(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation { //nolint: interfacer
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+
+		if !k.GetModuleState(ctx) || k.IsPaused(ctx, types.PauseSwitchDeposits) {
+			return simtypes.NoOpMsg(types.ModuleName, types.MsgTypeLiquidStake, "deposits disabled"), nil, nil
+		}
+
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		account := ak.GetAccount(ctx, simAccount.Address)
+		delegator := account.GetAddress()
+
+		hostChainParams := k.GetHostChainParams(ctx)
+		minDeposit := hostChainParams.MinDeposit.Int64()
+		amount := sdk.NewInt(int64(simtypes.RandIntBetween(r, int(minDeposit), int(minDeposit)+100_000_000)))
+		if err := k.CheckDepositLimits(ctx, delegator, amount); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.MsgTypeLiquidStake, "deposit limits reached"), nil, nil
+		}
+
+		depositCoin := sdk.NewCoin(k.GetIBCDenom(ctx), amount)
+		spendable := bk.SpendableCoins(ctx, delegator)
+		if !spendable.AmountOf(depositCoin.Denom).GTE(depositCoin.Amount) {
+			if err := bk.MintCoins(ctx, types.ModuleName, sdk.NewCoins(depositCoin)); err != nil {
+				panic(err)
+			}
+			if err := bk.SendCoinsFromModuleToAccount(ctx, types.ModuleName, delegator, sdk.NewCoins(depositCoin)); err != nil {
+				panic(err)
+			}
+			spendable = bk.SpendableCoins(ctx, delegator)
+		}
+
+		msg := types.NewMsgLiquidStake(depositCoin, delegator)
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           simappparams.MakeTestEncodingConfig().TxConfig,
+			Cdc:             nil,
+			Msg:             msg,
+			MsgType:         msg.Type(),
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: spendable,
+		}
+		return simulation.GenAndDeliverTx(txCtx, Fees)
+	}
+}
+
+// SimulateMsgLiquidUnstake generates a MsgLiquidUnstake with random values. When the host chain delegations
+// do not cover the undelegations, the missing amount is delegated out of the deposits first, as if the
+// delegation epoch went through.
+func SimulateMsgLiquidUnstake(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation { //nolint: interfacer
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+
+		if !k.GetModuleState(ctx) || k.IsPaused(ctx, types.PauseSwitchUnstakes) {
+			return simtypes.NoOpMsg(types.ModuleName, types.MsgTypeLiquidUnstake, "unstakes disabled"), nil, nil
+		}
+
+		hostChainParams := k.GetHostChainParams(ctx)
+		simAccount, spendable, found := randomAccWithBalance(r, ctx, ak, bk, accs, hostChainParams.MintDenom)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.MsgTypeLiquidUnstake, "insufficient funds"), nil, nil
+		}
+		unstakeCoin := sdk.NewCoin(
+			hostChainParams.MintDenom,
+			simtypes.RandomAmount(r, spendable.AmountOf(hostChainParams.MintDenom)),
+		)
+		if !unstakeCoin.IsPositive() {
+			return simtypes.NoOpMsg(types.ModuleName, types.MsgTypeLiquidUnstake, "unstake amount is zero"), nil, nil
+		}
+
+		// undelegations of all the pending epochs are counted, which is never less than the current epoch
+		delegationState := k.GetDelegationState(ctx)
+		undelegations := unstakeCoin
+		for _, undelegation := range delegationState.HostAccountUndelegations {
+			undelegations = undelegations.AddAmount(undelegation.TotalUndelegationAmount.Amount)
+		}
+		required, _ := k.ConvertStkToToken(ctx, sdk.NewDecCoinFromCoin(undelegations), k.GetCValue(ctx))
+		shortfall := required.Amount.Sub(delegationState.TotalDelegations(hostChainParams.BaseDenom).Amount)
+		if shortfall.IsPositive() {
+			if shortfall.GT(k.GetDepositAccountAmount(ctx)) || len(k.GetAllowListedValidators(ctx).AllowListedValidators) == 0 {
+				return simtypes.NoOpMsg(types.ModuleName, types.MsgTypeLiquidUnstake, "insufficient delegations"), nil, nil
+			}
+			mockDelegation(ctx, bk, k, shortfall)
+		}
+
+		msg := types.NewMsgLiquidUnstake(simAccount.Address, unstakeCoin)
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           simappparams.MakeTestEncodingConfig().TxConfig,
+			Cdc:             nil,
+			Msg:             msg,
+			MsgType:         msg.Type(),
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: spendable,
+		}
+		return simulation.GenAndDeliverTx(txCtx, Fees)
+	}
+}
+
+// SimulateMsgRedeem generates a MsgRedeem with random values, redeeming no more than the deposits hold
+func SimulateMsgRedeem(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation { //nolint: interfacer
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+
+		if !k.GetModuleState(ctx) || k.IsPaused(ctx, types.PauseSwitchRedeems) {
+			return simtypes.NoOpMsg(types.ModuleName, types.MsgTypeRedeem, "redeems disabled"), nil, nil
+		}
+
+		hostChainParams := k.GetHostChainParams(ctx)
+		simAccount, spendable, found := randomAccWithBalance(r, ctx, ak, bk, accs, hostChainParams.MintDenom)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.MsgTypeRedeem, "insufficient funds"), nil, nil
+		}
+		redeemCoin := sdk.NewCoin(
+			hostChainParams.MintDenom,
+			simtypes.RandomAmount(r, spendable.AmountOf(hostChainParams.MintDenom)),
+		)
+		if !redeemCoin.IsPositive() {
+			return simtypes.NoOpMsg(types.ModuleName, types.MsgTypeRedeem, "redeem amount is zero"), nil, nil
+		}
+
+		redemptionFee := hostChainParams.PstakeParams.PstakeRedemptionFee.MulInt(redeemCoin.Amount).TruncateInt()
+		redeemToken, _ := k.ConvertStkToToken(
+			ctx, sdk.NewDecCoinFromCoin(redeemCoin.SubAmount(redemptionFee)), k.GetCValue(ctx),
+		)
+		if redeemToken.Amount.GTE(k.GetDepositAccountAmount(ctx)) {
+			return simtypes.NoOpMsg(types.ModuleName, types.MsgTypeRedeem, "insufficient deposits"), nil, nil
+		}
+
+		msg := types.NewMsgRedeem(simAccount.Address, redeemCoin)
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           simappparams.MakeTestEncodingConfig().TxConfig,
+			Cdc:             nil,
+			Msg:             msg,
+			MsgType:         msg.Type(),
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: spendable,
+		}
+		return simulation.GenAndDeliverTx(txCtx, Fees)
+	}
+}
+
+// SimulateMsgClaim generates a MsgClaim for a random delegator with unbonding epoch entries
+func SimulateMsgClaim(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation { //nolint: interfacer
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+
+		if !k.GetModuleState(ctx) || k.IsPaused(ctx, types.PauseSwitchClaims) {
+			return simtypes.NoOpMsg(types.ModuleName, types.MsgTypeClaim, "claims disabled"), nil, nil
+		}
+
+		var claimers []simtypes.Account
+		for _, acc := range accs {
+			if len(k.IterateDelegatorUnbondingEpochEntry(ctx, acc.Address)) > 0 {
+				claimers = append(claimers, acc)
+			}
+		}
+		if len(claimers) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.MsgTypeClaim, "no unbonding epoch entries"), nil, nil
+		}
+		simAccount, _ := simtypes.RandomAcc(r, claimers)
+
+		msg := types.NewMsgClaim(simAccount.Address)
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           simappparams.MakeTestEncodingConfig().TxConfig,
+			Cdc:             nil,
+			Msg:             msg,
+			MsgType:         msg.Type(),
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: bk.SpendableCoins(ctx, simAccount.Address),
+		}
+		return simulation.GenAndDeliverTx(txCtx, Fees)
+	}
+}
+
+// randomAccWithBalance returns a random account holding a positive spendable balance of the denom
+func randomAccWithBalance(
+	r *rand.Rand, ctx sdk.Context, ak types.AccountKeeper, bk types.BankKeeper, accs []simtypes.Account, denom string,
+) (simtypes.Account, sdk.Coins, bool) {
+	for i := 0; i < len(accs); i++ {
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+
+		account := ak.GetAccount(ctx, simAccount.Address)
+		spendable := bk.SpendableCoins(ctx, account.GetAddress())
+		if spendable.AmountOf(denom).IsPositive() {
+			return simAccount, spendable, true
+		}
+	}
+	return simtypes.Account{}, nil, false
+}
+
+// mockDelegation stands in for the ibc transfer and the ICA delegation of the delegation epoch. The ibc tokens
+// leave the deposit module account and are burnt, as the escrowed tokens are released on the host chain, and the
+// amount is added to the host account delegations following the allow listed validator weights.
+func mockDelegation(ctx sdk.Context, bk types.BankKeeper, k keeper.Keeper, amount math.Int) {
+	ibcCoins := sdk.NewCoins(sdk.NewCoin(k.GetIBCDenom(ctx), amount))
+	if err := bk.SendCoinsFromModuleToModule(ctx, types.DepositModuleAccount, types.ModuleName, ibcCoins); err != nil {
+		panic(err)
+	}
+	if err := bk.BurnCoins(ctx, types.ModuleName, ibcCoins); err != nil {
+		panic(err)
+	}
+
+	hostChainParams := k.GetHostChainParams(ctx)
+	validators := k.GetAllowListedValidators(ctx).AllowListedValidators
+	remaining := amount
+	for i, validator := range validators {
+		share := remaining
+		if i < len(validators)-1 {
+			share = sdk.MinInt(validator.TargetWeight.MulInt(amount).TruncateInt(), remaining)
+		}
+		if !share.IsPositive() {
+			continue
+		}
+		remaining = remaining.Sub(share)
+
+		k.AddHostAccountDelegation(ctx, types.HostAccountDelegation{
+			ValidatorAddress: validator.ValidatorAddress,
+			Amount:           sdk.NewCoin(hostChainParams.BaseDenom, share),
+		})
+	}
+}
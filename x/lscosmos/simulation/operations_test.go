@@ -0,0 +1,117 @@
+package simulation_test
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	ibctransfertypes "github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	chain "github.com/persistenceOne/pstake-native/v2/app"
+	testhelpers "github.com/persistenceOne/pstake-native/v2/app/helpers"
+	"github.com/persistenceOne/pstake-native/v2/app/params"
+	lscosmoskeeper "github.com/persistenceOne/pstake-native/v2/x/lscosmos/keeper"
+	"github.com/persistenceOne/pstake-native/v2/x/lscosmos/simulation"
+	"github.com/persistenceOne/pstake-native/v2/x/lscosmos/types"
+)
+
+// TestWeightedOperations tests the weights of the operations and runs them in order, so the unstake and redeem
+// operations find liquid staked tokens and the claim operation finds unbonding epoch entries.
+func TestWeightedOperations(t *testing.T) {
+	app, ctx := createTestApp(t)
+
+	cdc := types.ModuleCdc
+	appParams := make(simtypes.AppParams)
+
+	weightedOps := simulation.WeightedOperations(appParams, cdc, app.AccountKeeper, app.BankKeeper, app.LSCosmosKeeper)
+
+	s := rand.NewSource(2)
+	r := rand.New(s)
+	accs := getTestingAccounts(t, r, app, ctx, 1)
+	setupModule(app, ctx, accs)
+
+	expected := []struct {
+		weight     int
+		opMsgRoute string
+		opMsgName  string
+	}{
+		{params.DefaultWeightMsgLSCosmosLiquidStake, types.ModuleName, types.MsgTypeLiquidStake},
+		{params.DefaultWeightMsgLSCosmosLiquidUnstake, types.ModuleName, types.MsgTypeLiquidUnstake},
+		{params.DefaultWeightMsgLSCosmosRedeem, types.ModuleName, types.MsgTypeRedeem},
+		{params.DefaultWeightMsgLSCosmosClaim, types.ModuleName, types.MsgTypeClaim},
+	}
+
+	for i, w := range weightedOps {
+		operationMsg, _, err := w.Op()(r, app.BaseApp, ctx, accs, ctx.ChainID())
+		require.NoError(t, err)
+		// the following checks are very much dependent from the ordering of the output given
+		// by WeightedOperations. if the ordering in WeightedOperations changes some tests
+		// will fail
+		require.Equal(t, expected[i].weight, w.Weight(), "weight should be the same")
+		require.Equal(t, expected[i].opMsgRoute, operationMsg.Route, "route should be the same")
+		require.Equal(t, expected[i].opMsgName, operationMsg.Name, "operation Msg name should be the same")
+		require.True(t, operationMsg.OK, operationMsg.Comment)
+	}
+
+	// the mocked delegation keeps the tokens backing the liquid staked tokens
+	delegationState := app.LSCosmosKeeper.GetDelegationState(ctx)
+	require.True(t, delegationState.TotalDelegations(simulation.SimBaseDenom).IsPositive())
+	require.Len(t, app.LSCosmosKeeper.IterateDelegatorUnbondingEpochEntry(ctx, accs[0].Address), 1)
+	msg, broken := lscosmoskeeper.AllInvariants(app.LSCosmosKeeper)(ctx)
+	require.False(t, broken, msg)
+}
+
+func createTestApp(t *testing.T) (*chain.PstakeApp, sdk.Context) {
+	app := testhelpers.Setup(t, false, 5)
+
+	// begin a new block
+	header := tmproto.Header{Height: app.LastBlockHeight() + 1, AppHash: app.LastCommitID().Hash, Time: time.Now().UTC()}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	return app, app.BaseApp.NewContext(false, header)
+}
+
+// setupModule enables lscosmos as after the jump start and registers the denom trace of the ibc denom
+func setupModule(app *chain.PstakeApp, ctx sdk.Context, accs []simtypes.Account) {
+	hostChainParams := types.NewHostChainParams(
+		simulation.SimHostChainID, simulation.SimConnectionID, simulation.SimTransferChannel,
+		simulation.SimTransferPort, simulation.SimBaseDenom, simulation.SimMintDenom, accs[0].Address.String(),
+		sdk.OneInt(), sdk.ZeroDec(), sdk.ZeroDec(), sdk.MustNewDecFromStr("0.01"), sdk.MustNewDecFromStr("0.01"),
+	)
+	app.LSCosmosKeeper.SetHostChainParams(ctx, hostChainParams)
+	app.LSCosmosKeeper.SetAllowListedValidators(ctx, types.AllowListedValidators{
+		AllowListedValidators: []types.AllowListedValidator{
+			{
+				ValidatorAddress: "cosmosvaloper13w4ueuk80d3kmwk7ntlhp84fk0arlm3m9ammr5",
+				TargetWeight:     sdk.OneDec(),
+			},
+		},
+	})
+	app.LSCosmosKeeper.SetModuleState(ctx, true)
+	app.TransferKeeper.SetDenomTrace(ctx, ibctransfertypes.ParseDenomTrace(
+		ibctransfertypes.GetPrefixedDenom(simulation.SimTransferPort, simulation.SimTransferChannel, simulation.SimBaseDenom),
+	))
+}
+
+func getTestingAccounts(t *testing.T, r *rand.Rand, app *chain.PstakeApp, ctx sdk.Context, n int) []simtypes.Account {
+	accounts := simtypes.RandomAccounts(r, n)
+
+	initAmt := app.StakingKeeper.TokensFromConsensusPower(ctx, 100_000_000)
+	initCoins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, initAmt))
+
+	// add coins to the accounts
+	for _, account := range accounts {
+		acc := app.AccountKeeper.NewAccountWithAddress(ctx, account.Address)
+		app.AccountKeeper.SetAccount(ctx, acc)
+		err := testutil.FundAccount(app.BankKeeper, ctx, account.Address, initCoins)
+		require.NoError(t, err)
+	}
+
+	return accounts
+}
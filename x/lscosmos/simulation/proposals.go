@@ -0,0 +1,134 @@
+package simulation
+
+import (
+	"math/rand"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/persistenceOne/pstake-native/v2/app/params"
+	"github.com/persistenceOne/pstake-native/v2/x/lscosmos/types"
+)
+
+// Simulation operation weights constants.
+//
+//nolint:gosec
+const (
+	OpWeightSimulateMinDepositAndFeeChangeProposal        = "op_weight_min_deposit_and_fee_change_proposal"
+	OpWeightSimulatePstakeFeeAddressChangeProposal        = "op_weight_pstake_fee_address_change_proposal"
+	OpWeightSimulateAllowListedValidatorSetChangeProposal = "op_weight_allow_listed_validator_set_change_proposal"
+	OpWeightSimulateFeeSplitChangeProposal                = "op_weight_fee_split_change_proposal"
+	MaxFeeRecipients                                      = 3
+)
+
+// ProposalContents defines the module weighted proposals' contents
+func ProposalContents() []simtypes.WeightedProposalContent {
+	return []simtypes.WeightedProposalContent{
+		simulation.NewWeightedProposalContent(
+			OpWeightSimulateMinDepositAndFeeChangeProposal,
+			params.DefaultWeightMinDepositAndFeeChangeProposal,
+			SimulateMinDepositAndFeeChangeProposal,
+		),
+		simulation.NewWeightedProposalContent(
+			OpWeightSimulatePstakeFeeAddressChangeProposal,
+			params.DefaultWeightPstakeFeeAddressChangeProposal,
+			SimulatePstakeFeeAddressChangeProposal,
+		),
+		simulation.NewWeightedProposalContent(
+			OpWeightSimulateAllowListedValidatorSetChangeProposal,
+			params.DefaultWeightAllowListedValidatorSetChangeProposal,
+			SimulateAllowListedValidatorSetChangeProposal,
+		),
+		simulation.NewWeightedProposalContent(
+			OpWeightSimulateFeeSplitChangeProposal,
+			params.DefaultWeightFeeSplitChangeProposal,
+			SimulateFeeSplitChangeProposal,
+		),
+	}
+}
+
+// SimulateMinDepositAndFeeChangeProposal generates random min deposit and fee change proposal content.
+func SimulateMinDepositAndFeeChangeProposal(r *rand.Rand, _ sdk.Context, _ []simtypes.Account) simtypes.Content {
+	return types.NewMinDepositAndFeeChangeProposal(
+		simtypes.RandStringOfLength(r, 10),
+		simtypes.RandStringOfLength(r, 100),
+		genMinDeposit(r),
+		genFee(r, types.MaxPstakeDepositFee),
+		genFee(r, types.MaxPstakeRestakeFee),
+		genFee(r, types.MaxPstakeUnstakeFee),
+		genFee(r, types.MaxPstakeRedemptionFee),
+	)
+}
+
+// SimulatePstakeFeeAddressChangeProposal generates random pstake fee address change proposal content.
+func SimulatePstakeFeeAddressChangeProposal(r *rand.Rand, _ sdk.Context, accs []simtypes.Account) simtypes.Content {
+	feeAccount, _ := simtypes.RandomAcc(r, accs)
+
+	return types.NewPstakeFeeAddressChangeProposal(
+		simtypes.RandStringOfLength(r, 10),
+		simtypes.RandStringOfLength(r, 100),
+		feeAccount.Address.String(),
+	)
+}
+
+// SimulateAllowListedValidatorSetChangeProposal generates random allow listed validator set change proposal content.
+func SimulateAllowListedValidatorSetChangeProposal(r *rand.Rand, _ sdk.Context, _ []simtypes.Account) simtypes.Content {
+	return types.NewAllowListedValidatorSetChangeProposal(
+		simtypes.RandStringOfLength(r, 10),
+		simtypes.RandStringOfLength(r, 100),
+		genAllowListedValidators(r),
+	)
+}
+
+// SimulateFeeSplitChangeProposal generates random fee split change proposal content.
+func SimulateFeeSplitChangeProposal(r *rand.Rand, _ sdk.Context, accs []simtypes.Account) simtypes.Content {
+	return types.NewFeeSplitChangeProposal(
+		simtypes.RandStringOfLength(r, 10),
+		simtypes.RandStringOfLength(r, 100),
+		genFeeSplit(r, accs),
+	)
+}
+
+// genFeeSplit returns a random fee split, a fee type is left without recipients half of the time.
+func genFeeSplit(r *rand.Rand, accs []simtypes.Account) types.FeeSplit {
+	return types.FeeSplit{
+		DepositFeeRecipients:    genFeeRecipients(r, accs),
+		RestakeFeeRecipients:    genFeeRecipients(r, accs),
+		UnstakeFeeRecipients:    genFeeRecipients(r, accs),
+		RedemptionFeeRecipients: genFeeRecipients(r, accs),
+	}
+}
+
+// genFeeRecipients returns distinct random recipients with positive weights adding up to one.
+func genFeeRecipients(r *rand.Rand, accs []simtypes.Account) []types.FeeRecipient {
+	if r.Intn(2) == 0 {
+		return nil
+	}
+
+	n := simtypes.RandIntBetween(r, 1, MaxFeeRecipients+1)
+	if n > len(accs) {
+		n = len(accs)
+	}
+
+	recipients := make([]types.FeeRecipient, n)
+	remaining := sdk.OneDec()
+	for i, idx := range r.Perm(len(accs))[:n] {
+		// every recipient left after this one keeps at least one unit of weight
+		weight := remaining
+		if i < n-1 {
+			maxWeight := remaining.Sub(sdk.NewDecWithPrec(int64(n-i-1), sdk.Precision))
+			weight = simtypes.RandomDecAmount(r, maxWeight)
+			if !weight.IsPositive() {
+				weight = sdk.SmallestDec()
+			}
+		}
+		remaining = remaining.Sub(weight)
+
+		recipients[i] = types.FeeRecipient{
+			Address: accs[idx].Address.String(),
+			Weight:  weight,
+		}
+	}
+	return recipients
+}
@@ -0,0 +1,50 @@
+package simulation_test
+
+import (
+	"math/rand"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/stretchr/testify/require"
+
+	"github.com/persistenceOne/pstake-native/v2/app/params"
+	"github.com/persistenceOne/pstake-native/v2/x/lscosmos/simulation"
+	"github.com/persistenceOne/pstake-native/v2/x/lscosmos/types"
+)
+
+func TestProposalContents(t *testing.T) {
+	s := rand.NewSource(1)
+	r := rand.New(s)
+
+	ctx := sdk.Context{}
+	accounts := simtypes.RandomAccounts(r, 10)
+
+	// execute ProposalContents function
+	weightedProposalContent := simulation.ProposalContents()
+	require.Len(t, weightedProposalContent, 4)
+
+	expected := []struct {
+		appParamsKey  string
+		defaultWeight int
+		proposalType  string
+	}{
+		{simulation.OpWeightSimulateMinDepositAndFeeChangeProposal, params.DefaultWeightMinDepositAndFeeChangeProposal, types.ProposalTypeMinDepositAndFeeChange},
+		{simulation.OpWeightSimulatePstakeFeeAddressChangeProposal, params.DefaultWeightPstakeFeeAddressChangeProposal, types.ProposalPstakeFeeAddressChange},
+		{simulation.OpWeightSimulateAllowListedValidatorSetChangeProposal, params.DefaultWeightAllowListedValidatorSetChangeProposal, types.ProposalAllowListedValidatorSetChange},
+		{simulation.OpWeightSimulateFeeSplitChangeProposal, params.DefaultWeightFeeSplitChangeProposal, types.ProposalFeeSplitChange},
+	}
+
+	for i, w := range weightedProposalContent {
+		require.Equal(t, expected[i].appParamsKey, w.AppParamsKey())
+		require.Equal(t, expected[i].defaultWeight, w.DefaultWeight())
+
+		// generated contents always pass the stateless checks
+		for j := 0; j < 20; j++ {
+			content := w.ContentSimulatorFn()(r, ctx, accounts)
+			require.Equal(t, types.RouterKey, content.ProposalRoute())
+			require.Equal(t, expected[i].proposalType, content.ProposalType())
+			require.NoError(t, content.ValidateBasic())
+		}
+	}
+}
@@ -0,0 +1,80 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+var _ authz.Authorization = &TransferUnbondingEntryAuthorization{}
+
+// NewTransferUnbondingEntryAuthorization creates a new TransferUnbondingEntryAuthorization object.
+func NewTransferUnbondingEntryAuthorization(spendLimit sdk.Coins, allowList []sdk.AccAddress) *TransferUnbondingEntryAuthorization {
+	allowed := make([]string, len(allowList))
+	for i, addr := range allowList {
+		allowed[i] = addr.String()
+	}
+	return &TransferUnbondingEntryAuthorization{
+		SpendLimit: spendLimit,
+		AllowList:  allowed,
+	}
+}
+
+// MsgTypeURL implements Authorization.MsgTypeURL.
+func (a TransferUnbondingEntryAuthorization) MsgTypeURL() string {
+	return sdk.MsgTypeURL(&MsgTransferUnbondingEntry{})
+}
+
+// Accept implements Authorization.Accept.
+func (a TransferUnbondingEntryAuthorization) Accept(_ sdk.Context, msg sdk.Msg) (authz.AcceptResponse, error) {
+	mTransfer, ok := msg.(*MsgTransferUnbondingEntry)
+	if !ok {
+		return authz.AcceptResponse{}, sdkerrors.ErrInvalidType.Wrap("type mismatch")
+	}
+
+	if len(a.AllowList) > 0 {
+		allowed := false
+		for _, addr := range a.AllowList {
+			if addr == mTransfer.RecipientAddress {
+				allowed = true
+				break
+			}
+		}
+		if !allowed {
+			return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrapf("cannot transfer unbonding entry to %s", mTransfer.RecipientAddress)
+		}
+	}
+
+	limitLeft, isNegative := a.SpendLimit.SafeSub(mTransfer.Amount)
+	if isNegative {
+		return authz.AcceptResponse{}, sdkerrors.ErrInsufficientFunds.Wrapf("requested amount is more than spend limit")
+	}
+	if limitLeft.IsZero() {
+		return authz.AcceptResponse{Accept: true, Delete: true}, nil
+	}
+
+	return authz.AcceptResponse{Accept: true, Delete: false, Updated: &TransferUnbondingEntryAuthorization{SpendLimit: limitLeft, AllowList: a.AllowList}}, nil
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (a TransferUnbondingEntryAuthorization) ValidateBasic() error {
+	if a.SpendLimit == nil {
+		return sdkerrors.ErrInvalidCoins.Wrap("spend limit cannot be nil")
+	}
+	if !a.SpendLimit.IsAllPositive() {
+		return sdkerrors.ErrInvalidCoins.Wrapf("spend limit must be positive")
+	}
+
+	found := make(map[string]bool)
+	for _, addr := range a.AllowList {
+		if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, addr)
+		}
+		if found[addr] {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "duplicate address %s in allow list", addr)
+		}
+		found[addr] = true
+	}
+	return nil
+}
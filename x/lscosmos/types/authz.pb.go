@@ -0,0 +1,401 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: pstake/lscosmos/v1beta1/authz.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// TransferUnbondingEntryAuthorization allows the grantee to transfer up to
+// spend_limit of the granter's unbonding epoch entries.
+type TransferUnbondingEntryAuthorization struct {
+	SpendLimit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=spend_limit,json=spendLimit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"spend_limit"`
+	// allow_list specifies an optional list of recipient addresses the grantee
+	// can transfer unbonding entries to. If empty, any recipient is allowed.
+	AllowList []string `protobuf:"bytes,2,rep,name=allow_list,json=allowList,proto3" json:"allow_list,omitempty"`
+}
+
+func (m *TransferUnbondingEntryAuthorization) Reset()         { *m = TransferUnbondingEntryAuthorization{} }
+func (m *TransferUnbondingEntryAuthorization) String() string { return proto.CompactTextString(m) }
+func (*TransferUnbondingEntryAuthorization) ProtoMessage()    {}
+func (*TransferUnbondingEntryAuthorization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_932fe7e361242d53, []int{0}
+}
+func (m *TransferUnbondingEntryAuthorization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TransferUnbondingEntryAuthorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TransferUnbondingEntryAuthorization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TransferUnbondingEntryAuthorization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransferUnbondingEntryAuthorization.Merge(m, src)
+}
+func (m *TransferUnbondingEntryAuthorization) XXX_Size() int {
+	return m.Size()
+}
+func (m *TransferUnbondingEntryAuthorization) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransferUnbondingEntryAuthorization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransferUnbondingEntryAuthorization proto.InternalMessageInfo
+
+func (m *TransferUnbondingEntryAuthorization) GetSpendLimit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.SpendLimit
+	}
+	return nil
+}
+
+func (m *TransferUnbondingEntryAuthorization) GetAllowList() []string {
+	if m != nil {
+		return m.AllowList
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*TransferUnbondingEntryAuthorization)(nil), "pstake.lscosmos.v1beta1.TransferUnbondingEntryAuthorization")
+}
+
+func init() {
+	proto.RegisterFile("pstake/lscosmos/v1beta1/authz.proto", fileDescriptor_932fe7e361242d53)
+}
+
+var fileDescriptor_932fe7e361242d53 = []byte{
+	// 333 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x91, 0x31, 0x4f, 0x02, 0x31,
+	0x14, 0xc7, 0xef, 0x24, 0x31, 0xe1, 0xd8, 0x08, 0x89, 0xc0, 0x50, 0x88, 0x2c, 0x2c, 0x5c, 0x05,
+	0x07, 0x07, 0x27, 0x30, 0x6e, 0x24, 0x26, 0x28, 0x8b, 0x0b, 0xe9, 0xdd, 0xd5, 0xa3, 0xe1, 0x68,
+	0x2f, 0x7d, 0x0f, 0x14, 0x3e, 0x85, 0x9f, 0xc3, 0xd9, 0x0f, 0xc1, 0xe0, 0x40, 0x9c, 0x9c, 0xd4,
+	0xc0, 0x17, 0x31, 0xd7, 0x2b, 0xea, 0xd4, 0x36, 0xef, 0xf7, 0x7e, 0xef, 0x9f, 0x57, 0xaf, 0x95,
+	0x02, 0xb2, 0x19, 0xa7, 0x09, 0x84, 0x0a, 0xe6, 0x0a, 0xe8, 0xb2, 0x1b, 0x70, 0x64, 0x5d, 0xca,
+	0x16, 0x38, 0x5d, 0xfb, 0xa9, 0x56, 0xa8, 0xca, 0x27, 0x39, 0xe4, 0x1f, 0x20, 0xdf, 0x42, 0xf5,
+	0x4a, 0xac, 0x62, 0x65, 0x18, 0x9a, 0xdd, 0x72, 0xbc, 0x4e, 0xac, 0x2a, 0x60, 0xc0, 0x7f, 0x7d,
+	0xa1, 0x12, 0xd2, 0xd6, 0x6b, 0x79, 0x7d, 0x92, 0x37, 0x5a, 0xa5, 0x79, 0x9c, 0xbe, 0xb9, 0x5e,
+	0xeb, 0x4e, 0x33, 0x09, 0x0f, 0x5c, 0x8f, 0x65, 0xa0, 0x64, 0x24, 0x64, 0x7c, 0x2d, 0x51, 0xaf,
+	0xfa, 0x0b, 0x9c, 0x2a, 0x2d, 0xd6, 0x0c, 0x85, 0x92, 0xe5, 0xc4, 0x2b, 0x41, 0xca, 0x65, 0x34,
+	0x49, 0xc4, 0x5c, 0x60, 0xd5, 0x6d, 0x16, 0xda, 0xa5, 0x5e, 0xcd, 0xb7, 0xae, 0x6c, 0xf0, 0x21,
+	0xa3, 0x7f, 0xa5, 0x84, 0x1c, 0x9c, 0x6d, 0x3e, 0x1b, 0xce, 0xcb, 0x57, 0xa3, 0x1d, 0x0b, 0x9c,
+	0x2e, 0x02, 0x3f, 0x54, 0x73, 0x3b, 0xd8, 0x1e, 0x1d, 0x88, 0x66, 0x14, 0x57, 0x29, 0x07, 0xd3,
+	0x00, 0x23, 0xcf, 0xf8, 0x87, 0x99, 0xbe, 0x7c, 0xe1, 0x79, 0x2c, 0x49, 0xd4, 0xe3, 0x24, 0x11,
+	0x80, 0xd5, 0xa3, 0x66, 0xa1, 0x5d, 0x1c, 0x54, 0xdf, 0x5f, 0x3b, 0x15, 0x3b, 0xaf, 0x1f, 0x45,
+	0x9a, 0x03, 0xdc, 0xa2, 0x16, 0x32, 0x1e, 0x15, 0x0d, 0x3b, 0x14, 0x80, 0x83, 0xf1, 0x66, 0x47,
+	0xdc, 0xed, 0x8e, 0xb8, 0xdf, 0x3b, 0xe2, 0x3e, 0xef, 0x89, 0xb3, 0xdd, 0x13, 0xe7, 0x63, 0x4f,
+	0x9c, 0xfb, 0xcb, 0x7f, 0x41, 0x52, 0xae, 0x41, 0x00, 0x72, 0x19, 0xf2, 0x1b, 0xc9, 0x69, 0xbe,
+	0xec, 0x8e, 0x64, 0x28, 0x96, 0x9c, 0x2e, 0x7b, 0xf4, 0xe9, 0xef, 0x77, 0x4c, 0xc2, 0xe0, 0xd8,
+	0x2c, 0xeb, 0xfc, 0x67, 0x00, 0xe2, 0x70, 0xad, 0x26, 0xbd, 0x01, 0x00, 0x00,
+}
+
+func (m *TransferUnbondingEntryAuthorization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TransferUnbondingEntryAuthorization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TransferUnbondingEntryAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AllowList) > 0 {
+		for iNdEx := len(m.AllowList) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowList[iNdEx])
+			copy(dAtA[i:], m.AllowList[iNdEx])
+			i = encodeVarintAuthz(dAtA, i, uint64(len(m.AllowList[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.SpendLimit) > 0 {
+		for iNdEx := len(m.SpendLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SpendLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAuthz(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAuthz(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *TransferUnbondingEntryAuthorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SpendLimit) > 0 {
+		for _, e := range m.SpendLimit {
+			l = e.Size()
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	if len(m.AllowList) > 0 {
+		for _, s := range m.AllowList {
+			l = len(s)
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovAuthz(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAuthz(x uint64) (n int) {
+	return sovAuthz(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *TransferUnbondingEntryAuthorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransferUnbondingEntryAuthorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransferUnbondingEntryAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpendLimit = append(m.SpendLimit, types.Coin{})
+			if err := m.SpendLimit[len(m.SpendLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowList", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowList = append(m.AllowList, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAuthz(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAuthz
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAuthz
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAuthz
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAuthz        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAuthz          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAuthz = fmt.Errorf("proto: unexpected end of group")
+)
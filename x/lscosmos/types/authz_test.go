@@ -0,0 +1,60 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/persistenceOne/pstake-native/v2/x/lscosmos/types"
+)
+
+func TestTransferUnbondingEntryAuthorization(t *testing.T) {
+	granter := sdk.AccAddress("granter_____________")
+	recipient := sdk.AccAddress("recipient___________")
+	other := sdk.AccAddress("other_______________")
+	limit := sdk.NewCoins(sdk.NewInt64Coin("stk/uatom", 100))
+
+	auth := types.NewTransferUnbondingEntryAuthorization(limit, []sdk.AccAddress{recipient})
+	require.NoError(t, auth.ValidateBasic())
+	require.Equal(t, "/pstake.lscosmos.v1beta1.MsgTransferUnbondingEntry", auth.MsgTypeURL())
+
+	// type mismatch
+	_, err := auth.Accept(sdk.Context{}, banktypes.NewMsgSend(granter, recipient, limit))
+	require.Error(t, err)
+
+	// recipient not in allow list
+	_, err = auth.Accept(sdk.Context{}, types.NewMsgTransferUnbondingEntry(granter, other, 4, sdk.NewInt64Coin("stk/uatom", 10)))
+	require.Error(t, err)
+
+	// more than spend limit
+	_, err = auth.Accept(sdk.Context{}, types.NewMsgTransferUnbondingEntry(granter, recipient, 4, sdk.NewInt64Coin("stk/uatom", 101)))
+	require.Error(t, err)
+
+	// partial spend
+	resp, err := auth.Accept(sdk.Context{}, types.NewMsgTransferUnbondingEntry(granter, recipient, 4, sdk.NewInt64Coin("stk/uatom", 40)))
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+	require.False(t, resp.Delete)
+	updated, ok := resp.Updated.(*types.TransferUnbondingEntryAuthorization)
+	require.True(t, ok)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stk/uatom", 60)), updated.SpendLimit)
+	require.Equal(t, auth.AllowList, updated.AllowList)
+
+	// full spend deletes the grant
+	resp, err = updated.Accept(sdk.Context{}, types.NewMsgTransferUnbondingEntry(granter, recipient, 4, sdk.NewInt64Coin("stk/uatom", 60)))
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+	require.True(t, resp.Delete)
+
+	// any recipient is allowed with an empty allow list
+	auth = types.NewTransferUnbondingEntryAuthorization(limit, nil)
+	resp, err = auth.Accept(sdk.Context{}, types.NewMsgTransferUnbondingEntry(granter, other, 4, sdk.NewInt64Coin("stk/uatom", 10)))
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+
+	// invalid authorizations
+	require.Error(t, types.NewTransferUnbondingEntryAuthorization(nil, nil).ValidateBasic())
+	require.Error(t, types.NewTransferUnbondingEntryAuthorization(limit, []sdk.AccAddress{recipient, recipient}).ValidateBasic())
+}
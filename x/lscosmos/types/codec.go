@@ -6,6 +6,7 @@ import (
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 )
 
@@ -15,6 +16,7 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MinDepositAndFeeChangeProposal{}, "cosmos/MinDepositAndFeeChangeProposal", nil)
 	cdc.RegisterConcrete(&PstakeFeeAddressChangeProposal{}, "cosmos/PstakeFeeAddressChangeProposal", nil)
 	cdc.RegisterConcrete(&AllowListedValidatorSetChangeProposal{}, "cosmos/AllowListedValidatorSetChangeProposal", nil)
+	cdc.RegisterConcrete(&FeeSplitChangeProposal{}, "cosmos/FeeSplitChangeProposal", nil)
 	cdc.RegisterConcrete(&MsgLiquidStake{}, "cosmos/MsgLiquidStake", nil)
 	cdc.RegisterConcrete(&MsgLiquidUnstake{}, "cosmos/MsgLiquidUnstake", nil)
 	cdc.RegisterConcrete(&MsgRedeem{}, "cosmos/MsgRedeem", nil)
@@ -23,6 +25,14 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgJumpStart{}, "cosmos/MsgJumpStart", nil)
 	cdc.RegisterConcrete(&MsgChangeModuleState{}, "cosmos/MsgChangeModuleState", nil)
 	cdc.RegisterConcrete(&MsgReportSlashing{}, "cosmos/MsgReportSlashing", nil)
+	cdc.RegisterConcrete(&MsgTransferUnbondingEntry{}, "cosmos/MsgTransferUnbondingEntry", nil)
+	cdc.RegisterConcrete(&MsgClaimFor{}, "cosmos/MsgClaimFor", nil)
+	cdc.RegisterConcrete(&MsgSetAutoClaim{}, "cosmos/MsgSetAutoClaim", nil)
+	cdc.RegisterConcrete(&MsgUpdateRoles{}, "cosmos/MsgUpdateRoles", nil)
+	cdc.RegisterConcrete(&MsgSetPauseSwitches{}, "cosmos/MsgSetPauseSwitches", nil)
+	cdc.RegisterConcrete(&MsgRegisterHostProposal{}, "cosmos/MsgRegisterHostProposal", nil)
+	cdc.RegisterConcrete(&MsgVoteHostProposal{}, "cosmos/MsgVoteHostProposal", nil)
+	cdc.RegisterConcrete(&TransferUnbondingEntryAuthorization{}, "cosmos/TransferUnbondingEntryAuthorization", nil)
 }
 
 // RegisterInterfaces registers the x/lscosmos interfaces types with the interface registry
@@ -36,6 +46,13 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgJumpStart{},
 		&MsgChangeModuleState{},
 		&MsgReportSlashing{},
+		&MsgTransferUnbondingEntry{},
+		&MsgClaimFor{},
+		&MsgSetAutoClaim{},
+		&MsgUpdateRoles{},
+		&MsgSetPauseSwitches{},
+		&MsgRegisterHostProposal{},
+		&MsgVoteHostProposal{},
 	) // add the structs that implements sdk.Msg interface
 
 	registry.RegisterImplementations((*govtypes.Content)(nil),
@@ -43,6 +60,11 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MinDepositAndFeeChangeProposal{},
 		&PstakeFeeAddressChangeProposal{},
 		&AllowListedValidatorSetChangeProposal{},
+		&FeeSplitChangeProposal{},
+	)
+
+	registry.RegisterImplementations((*authz.Authorization)(nil),
+		&TransferUnbondingEntryAuthorization{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -39,4 +39,17 @@ var (
 	ErrInvalidMintDenom                      = errorsmod.Register(ModuleName, 89, "InvalidMintDenom, MintDenom should be stk/BaseDenom")
 	ErrModuleNotInitialised                  = errorsmod.Register(ModuleName, 90, "ErrModuleNotInitialised, Module was never initialised")
 	ErrModuleAlreadyInExpectedState          = errorsmod.Register(ModuleName, 91, "ModuleAlreadyInExpectedState, Module is already in expected state")
+	ErrUnbondingEntryNotFound                = errorsmod.Register(ModuleName, 92, "delegator unbonding epoch entry not found")
+	ErrInsufficientUnbondingEntryAmount      = errorsmod.Register(ModuleName, 93, "transfer amount greater than delegator unbonding epoch entry amount")
+	ErrTVLCapExceeded                        = errorsmod.Register(ModuleName, 94, "deposit exceeds total value locked cap")
+	ErrAddressDepositCapExceeded             = errorsmod.Register(ModuleName, 95, "deposit exceeds per address deposit cap")
+	ErrEpochDepositLimitExceeded             = errorsmod.Register(ModuleName, 96, "deposit exceeds per epoch deposit limit")
+	ErrInvalidFeeSplit                       = errorsmod.Register(ModuleName, 97, "invalid fee split")
+	ErrInvalidRoles                          = errorsmod.Register(ModuleName, 98, "invalid roles")
+	ErrInvalidPauseSwitch                    = errorsmod.Register(ModuleName, 99, "invalid pause switch")
+	ErrOperationPaused                       = errorsmod.Register(ModuleName, 100, "operation is paused")
+	ErrInvalidValidatorWeighting             = errorsmod.Register(ModuleName, 101, "invalid validator weighting")
+	ErrHostProposalExists                    = errorsmod.Register(ModuleName, 102, "host proposal already registered")
+	ErrHostProposalNotFound                  = errorsmod.Register(ModuleName, 103, "host proposal not found")
+	ErrHostProposalNotSignaling              = errorsmod.Register(ModuleName, 104, "host proposal does not accept vote signals")
 )
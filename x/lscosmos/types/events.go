@@ -2,17 +2,30 @@ package types
 
 // IBC events
 const (
-	EventTypePacket            = "ics27_packet"
-	EventTypeTimeout           = "timeout"
-	EventTypeLiquidStake       = "liquid-stake"
-	EventTypeRedeem            = "redeem"
-	EventTypeLiquidUnstake     = "liquid-unstake"
-	EventTypeClaim             = "claim"
-	EventTypeJumpStart         = "jump-start"
-	EventTypeRecreateICA       = "recreate-ica"
-	EventTypeChangeModuleState = "change-module-state"
-	EventTypeReportSlashing    = "report-slashing"
-	EventTypePerformSlashing   = "perform-slashing"
+	EventTypePacket               = "ics27_packet"
+	EventTypeTimeout              = "timeout"
+	EventTypeLiquidStake          = "liquid-stake"
+	EventTypeRedeem               = "redeem"
+	EventTypeLiquidUnstake        = "liquid-unstake"
+	EventTypeClaim                = "claim"
+	EventTypeJumpStart            = "jump-start"
+	EventTypeRecreateICA          = "recreate-ica"
+	EventTypeChangeModuleState    = "change-module-state"
+	EventTypeReportSlashing       = "report-slashing"
+	EventTypePerformSlashing      = "perform-slashing"
+	EventTypeTransferUnbonding    = "transfer-unbonding-entry"
+	EventTypeClaimFor             = "claim-for"
+	EventTypeSetAutoClaim         = "set-auto-claim"
+	EventTypeAutoClaim            = "auto-claim"
+	EventTypeProtocolFee          = "protocol-fee"
+	EventTypeUpdateRoles          = "update-roles"
+	EventTypeAdminChange          = "admin-change"
+	EventTypeSetPauseSwitches     = "set-pause-switches"
+	EventTypeValidatorWeights     = "validator-weights"
+	EventTypeRestakeRewards       = "restake-rewards"
+	EventTypeRegisterHostProposal = "register-host-proposal"
+	EventTypeVoteHostProposal     = "vote-host-proposal"
+	EventTypeHostVote             = "host-vote"
 	// this line is used by starport scaffolding # ibc/packet/event
 
 	AttributeKeyAckSuccess         = "success"
@@ -36,5 +49,26 @@ const (
 	AttributeExistingDelegation    = "existing-delegation"
 	AttributeUpdatedDelegation     = "updated-delegation"
 	AttributeSlashedAmount         = "slashed-amount"
+	AttributeRecipientAddress      = "recipient-address"
+	AttributeEpochNumber           = "epoch-number"
+	AttributeClaimerAddress        = "claimer-address"
+	AttributeAutoClaimEnabled      = "auto-claim-enabled"
+	AttributeFeeType               = "fee-type"
+	AttributeAdminAddress          = "admin-address"
+	AttributeAdmins                = "admins"
+	AttributePausers               = "pausers"
+	AttributeSlashingReporters     = "slashing-reporters"
+	AttributeEffectiveTime         = "effective-time"
+	AttributePaused                = "paused"
+	AttributeBaseWeight            = "base-weight"
+	AttributeEffectiveWeight       = "effective-weight"
+	AttributeAccruedRewards        = "accrued-rewards"
+	AttributeRestakedRewards       = "restaked-rewards"
+	AttributeInsuranceFundRewards  = "insurance-fund-rewards"
+	AttributeCarriedOverRewards    = "carried-over-rewards"
+	AttributeProposalID            = "proposal-id"
+	AttributeVotingEndTime         = "voting-end-time"
+	AttributeVoteOptions           = "options"
+	AttributeHostProposalStatus    = "status"
 	AttributeValueCategory         = ModuleName
 )
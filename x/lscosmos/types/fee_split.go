@@ -0,0 +1,80 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// protocol fee types
+const (
+	FeeTypeDeposit    = "deposit"
+	FeeTypeRestake    = "restake"
+	FeeTypeUnstake    = "unstake"
+	FeeTypeRedemption = "redemption"
+)
+
+// FeeTypes lists all the protocol fee types
+var FeeTypes = []string{FeeTypeDeposit, FeeTypeRestake, FeeTypeUnstake, FeeTypeRedemption}
+
+// moduleAccounts lists the module accounts of the module, the protocol fees are paid out of them
+var moduleAccounts = []string{
+	ModuleName, DepositModuleAccount, DelegationModuleAccount, RewardModuleAccount, UndelegationModuleAccount,
+	RewardBoosterModuleAccount,
+}
+
+// GetRecipients returns the recipients of the fee type, nil is returned for an unknown fee type
+func (fs FeeSplit) GetRecipients(feeType string) []FeeRecipient {
+	switch feeType {
+	case FeeTypeDeposit:
+		return fs.DepositFeeRecipients
+	case FeeTypeRestake:
+		return fs.RestakeFeeRecipients
+	case FeeTypeUnstake:
+		return fs.UnstakeFeeRecipients
+	case FeeTypeRedemption:
+		return fs.RedemptionFeeRecipients
+	default:
+		return nil
+	}
+}
+
+// Validate checks that the recipients of every fee type have valid and unique addresses other than the module
+// accounts of the module, positive weights and weights adding up to one. A fee type without recipients is valid.
+func (fs FeeSplit) Validate() error {
+	moduleAddresses := make(map[string]bool, len(moduleAccounts))
+	for _, moduleAccount := range moduleAccounts {
+		moduleAddresses[authtypes.NewModuleAddress(moduleAccount).String()] = true
+	}
+	for _, feeType := range FeeTypes {
+		recipients := fs.GetRecipients(feeType)
+		if len(recipients) == 0 {
+			continue
+		}
+
+		noDuplicate := make(map[string]bool)
+		sum := sdk.ZeroDec()
+		for _, recipient := range recipients {
+			if _, err := sdk.AccAddressFromBech32(recipient.Address); err != nil {
+				return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "%s fee recipient %s", feeType, recipient.Address)
+			}
+			if moduleAddresses[recipient.Address] {
+				return errorsmod.Wrapf(ErrInvalidFeeSplit, "%s fee recipient %s is a module account", feeType, recipient.Address)
+			}
+			if noDuplicate[recipient.Address] {
+				return errorsmod.Wrapf(ErrInvalidFeeSplit, "duplicate %s fee recipient %s", feeType, recipient.Address)
+			}
+			noDuplicate[recipient.Address] = true
+
+			if recipient.Weight.IsNil() || !recipient.Weight.IsPositive() {
+				return errorsmod.Wrapf(ErrInvalidFeeSplit, "%s fee recipient %s weight must be positive", feeType, recipient.Address)
+			}
+			sum = sum.Add(recipient.Weight)
+		}
+		if !sum.Equal(sdk.OneDec()) {
+			return errorsmod.Wrapf(ErrInvalidFeeSplit, "%s fee recipient weights must add up to 1, got %s", feeType, sum)
+		}
+	}
+	return nil
+}
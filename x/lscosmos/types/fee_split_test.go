@@ -0,0 +1,56 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/persistenceOne/pstake-native/v2/x/lscosmos/types"
+)
+
+func TestFeeSplitValidate(t *testing.T) {
+	addr1 := sdk.AccAddress("addr1_______________").String()
+	addr2 := sdk.AccAddress("addr2_______________").String()
+
+	cases := []struct {
+		name     string
+		feeSplit types.FeeSplit
+		valid    bool
+	}{
+		{"empty", types.FeeSplit{}, true},
+		{"single recipient", types.FeeSplit{DepositFeeRecipients: []types.FeeRecipient{{Address: addr1, Weight: sdk.OneDec()}}}, true},
+		{"weighted recipients", types.FeeSplit{UnstakeFeeRecipients: []types.FeeRecipient{
+			{Address: addr1, Weight: sdk.NewDecWithPrec(5, 1)},
+			{Address: addr2, Weight: sdk.NewDecWithPrec(5, 1)},
+		}}, true},
+		{"weights not adding up to one", types.FeeSplit{RedemptionFeeRecipients: []types.FeeRecipient{
+			{Address: addr1, Weight: sdk.NewDecWithPrec(5, 1)},
+			{Address: addr2, Weight: sdk.NewDecWithPrec(4, 1)},
+		}}, false},
+		{"duplicate recipient", types.FeeSplit{RestakeFeeRecipients: []types.FeeRecipient{
+			{Address: addr1, Weight: sdk.NewDecWithPrec(5, 1)},
+			{Address: addr1, Weight: sdk.NewDecWithPrec(5, 1)},
+		}}, false},
+		{"zero weight", types.FeeSplit{DepositFeeRecipients: []types.FeeRecipient{
+			{Address: addr1, Weight: sdk.OneDec()},
+			{Address: addr2, Weight: sdk.ZeroDec()},
+		}}, false},
+		{"invalid address", types.FeeSplit{DepositFeeRecipients: []types.FeeRecipient{{Address: "addr", Weight: sdk.OneDec()}}}, false},
+		{"module account", types.FeeSplit{DepositFeeRecipients: []types.FeeRecipient{
+			{Address: authtypes.NewModuleAddress(types.DepositModuleAccount).String(), Weight: sdk.OneDec()},
+		}}, false},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.feeSplit.Validate()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
@@ -1,5 +1,11 @@
 package types
 
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
 // DefaultGenesis returns the default Capability genesis state
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
@@ -14,6 +20,7 @@ func DefaultGenesis() *GenesisState {
 		IBCAmountTransientStore:        IBCAmountTransientStore{},
 		UnbondingEpochCValues:          nil,
 		DelegatorUnbondingEpochEntries: nil,
+		EpochDeposits:                  EpochDeposits{Amount: sdk.ZeroInt()},
 		HostAccounts: HostAccounts{
 			DelegatorAccountOwnerID: DelegationModuleAccount,
 			RewardsAccountOwnerID:   RewardModuleAccount,
@@ -32,9 +39,91 @@ func (gs GenesisState) Validate() error {
 			return ErrInValidAllowListedValidators
 		}
 	}
+	if len(gs.EffectiveAllowListedValidators.AllowListedValidators) != 0 {
+		ok := gs.EffectiveAllowListedValidators.Valid()
+		if !ok {
+			return errorsmod.Wrap(ErrInValidAllowListedValidators, "effective allow listed validators")
+		}
+	}
+	for _, metrics := range gs.ValidatorMetrics {
+		if _, err := ValAddressFromBech32(metrics.ValidatorAddress, CosmosValOperPrefix); err != nil {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, metrics.ValidatorAddress)
+		}
+	}
+	for _, hostProposal := range gs.HostProposals {
+		if err := hostProposal.Validate(); err != nil {
+			return err
+		}
+	}
+	for _, vote := range gs.HostProposalVotes {
+		if err := vote.Validate(); err != nil {
+			return err
+		}
+	}
+	if gs.CarriedOverRewards.Denom != "" {
+		if err := gs.CarriedOverRewards.Validate(); err != nil {
+			return err
+		}
+	}
+	if record := gs.PendingRewardEpochRecord; record != nil {
+		for _, coin := range []sdk.Coin{record.Accrued, record.Restaked, record.SentToInsuranceFund, record.CarriedOver} {
+			if err := coin.Validate(); err != nil {
+				return errorsmod.Wrapf(err, "pending reward epoch record of epoch %d", record.EpochNumber)
+			}
+		}
+	}
 	err := gs.HostAccounts.Validate()
 	if err != nil {
 		return err
 	}
+	autoClaimDelegators := make(map[string]bool, len(gs.AutoClaimDelegators))
+	for _, delegator := range gs.AutoClaimDelegators {
+		if _, err = sdk.AccAddressFromBech32(delegator); err != nil {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, delegator)
+		}
+		if autoClaimDelegators[delegator] {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate auto claim delegator %s", delegator)
+		}
+		autoClaimDelegators[delegator] = true
+	}
+	pendingAutoClaimEpochs := make(map[int64]bool, len(gs.PendingAutoClaimEpochs))
+	for _, pendingEpoch := range gs.PendingAutoClaimEpochs {
+		if pendingEpoch.EpochNumber <= 0 {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid pending auto claim epoch %d", pendingEpoch.EpochNumber)
+		}
+		if pendingAutoClaimEpochs[pendingEpoch.EpochNumber] {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate pending auto claim epoch %d", pendingEpoch.EpochNumber)
+		}
+		pendingAutoClaimEpochs[pendingEpoch.EpochNumber] = true
+		if pendingEpoch.LastDelegatorAddress != "" {
+			if _, err = sdk.AccAddressFromBech32(pendingEpoch.LastDelegatorAddress); err != nil {
+				return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, pendingEpoch.LastDelegatorAddress)
+			}
+		}
+	}
+	for _, addressDeposits := range gs.AddressDeposits {
+		if _, err = sdk.AccAddressFromBech32(addressDeposits.Address); err != nil {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, addressDeposits.Address)
+		}
+		if addressDeposits.Amount.IsNil() || addressDeposits.Amount.IsNegative() {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid deposits %s for %s", addressDeposits.Amount, addressDeposits.Address)
+		}
+	}
+	if err = gs.FeeSplit.Validate(); err != nil {
+		return err
+	}
+	for _, collectedFee := range gs.CollectedFees {
+		if _, err = sdk.AccAddressFromBech32(collectedFee.Recipient); err != nil {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, collectedFee.Recipient)
+		}
+	}
+	if err = gs.Roles.Validate(); err != nil {
+		return err
+	}
+	if gs.PendingAdminChange != nil {
+		if err = gs.PendingAdminChange.Validate(); err != nil {
+			return err
+		}
+	}
 	return gs.Params.Validate()
 }
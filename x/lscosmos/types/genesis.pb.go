@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -36,6 +37,25 @@ type GenesisState struct {
 	UnbondingEpochCValues          []UnbondingEpochCValue         `protobuf:"bytes,8,rep,name=unbonding_epoch_c_values,json=unbondingEpochCValues,proto3" json:"unbonding_epoch_c_values"`
 	DelegatorUnbondingEpochEntries []DelegatorUnbondingEpochEntry `protobuf:"bytes,9,rep,name=delegator_unbonding_epoch_entries,json=delegatorUnbondingEpochEntries,proto3" json:"delegator_unbonding_epoch_entries"`
 	HostAccounts                   HostAccounts                   `protobuf:"bytes,10,opt,name=host_accounts,json=hostAccounts,proto3" json:"host_accounts"`
+	AutoClaimDelegators            []string                       `protobuf:"bytes,11,rep,name=auto_claim_delegators,json=autoClaimDelegators,proto3" json:"auto_claim_delegators,omitempty"`
+	PendingAutoClaimEpochs         []PendingAutoClaimEpoch        `protobuf:"bytes,12,rep,name=pending_auto_claim_epochs,json=pendingAutoClaimEpochs,proto3" json:"pending_auto_claim_epochs"`
+	AddressDeposits                []AddressDeposits              `protobuf:"bytes,13,rep,name=address_deposits,json=addressDeposits,proto3" json:"address_deposits"`
+	EpochDeposits                  EpochDeposits                  `protobuf:"bytes,14,opt,name=epoch_deposits,json=epochDeposits,proto3" json:"epoch_deposits"`
+	FeeSplit                       FeeSplit                       `protobuf:"bytes,15,opt,name=fee_split,json=feeSplit,proto3" json:"fee_split"`
+	CollectedFees                  []CollectedFee                 `protobuf:"bytes,16,rep,name=collected_fees,json=collectedFees,proto3" json:"collected_fees"`
+	Roles                          Roles                          `protobuf:"bytes,17,opt,name=roles,proto3" json:"roles"`
+	PendingAdminChange             *PendingAdminChange            `protobuf:"bytes,18,opt,name=pending_admin_change,json=pendingAdminChange,proto3" json:"pending_admin_change,omitempty"`
+	PauseSwitches                  PauseSwitches                  `protobuf:"bytes,19,opt,name=pause_switches,json=pauseSwitches,proto3" json:"pause_switches"`
+	EffectiveAllowListedValidators AllowListedValidators          `protobuf:"bytes,20,opt,name=effective_allow_listed_validators,json=effectiveAllowListedValidators,proto3" json:"effective_allow_listed_validators"`
+	ValidatorMetrics               []ValidatorMetrics             `protobuf:"bytes,21,rep,name=validator_metrics,json=validatorMetrics,proto3" json:"validator_metrics"`
+	CarriedOverRewards             types.Coin                     `protobuf:"bytes,22,opt,name=carried_over_rewards,json=carriedOverRewards,proto3" json:"carried_over_rewards"`
+	RewardEpochRecords             []RewardEpochRecord            `protobuf:"bytes,23,rep,name=reward_epoch_records,json=rewardEpochRecords,proto3" json:"reward_epoch_records"`
+	HostProposals                  []HostProposal                 `protobuf:"bytes,24,rep,name=host_proposals,json=hostProposals,proto3" json:"host_proposals"`
+	HostProposalVotes              []HostProposalVote             `protobuf:"bytes,25,rep,name=host_proposal_votes,json=hostProposalVotes,proto3" json:"host_proposal_votes"`
+	// pending_reward_epoch_record is the reward epoch record of the rewards
+	// being sent from the rewards account, it is recorded once the ICA tx is
+	// acknowledged
+	PendingRewardEpochRecord *RewardEpochRecord `protobuf:"bytes,26,opt,name=pending_reward_epoch_record,json=pendingRewardEpochRecord,proto3" json:"pending_reward_epoch_record,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -141,6 +161,118 @@ func (m *GenesisState) GetHostAccounts() HostAccounts {
 	return HostAccounts{}
 }
 
+func (m *GenesisState) GetAutoClaimDelegators() []string {
+	if m != nil {
+		return m.AutoClaimDelegators
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPendingAutoClaimEpochs() []PendingAutoClaimEpoch {
+	if m != nil {
+		return m.PendingAutoClaimEpochs
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAddressDeposits() []AddressDeposits {
+	if m != nil {
+		return m.AddressDeposits
+	}
+	return nil
+}
+
+func (m *GenesisState) GetEpochDeposits() EpochDeposits {
+	if m != nil {
+		return m.EpochDeposits
+	}
+	return EpochDeposits{}
+}
+
+func (m *GenesisState) GetFeeSplit() FeeSplit {
+	if m != nil {
+		return m.FeeSplit
+	}
+	return FeeSplit{}
+}
+
+func (m *GenesisState) GetCollectedFees() []CollectedFee {
+	if m != nil {
+		return m.CollectedFees
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRoles() Roles {
+	if m != nil {
+		return m.Roles
+	}
+	return Roles{}
+}
+
+func (m *GenesisState) GetPendingAdminChange() *PendingAdminChange {
+	if m != nil {
+		return m.PendingAdminChange
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPauseSwitches() PauseSwitches {
+	if m != nil {
+		return m.PauseSwitches
+	}
+	return PauseSwitches{}
+}
+
+func (m *GenesisState) GetEffectiveAllowListedValidators() AllowListedValidators {
+	if m != nil {
+		return m.EffectiveAllowListedValidators
+	}
+	return AllowListedValidators{}
+}
+
+func (m *GenesisState) GetValidatorMetrics() []ValidatorMetrics {
+	if m != nil {
+		return m.ValidatorMetrics
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCarriedOverRewards() types.Coin {
+	if m != nil {
+		return m.CarriedOverRewards
+	}
+	return types.Coin{}
+}
+
+func (m *GenesisState) GetRewardEpochRecords() []RewardEpochRecord {
+	if m != nil {
+		return m.RewardEpochRecords
+	}
+	return nil
+}
+
+func (m *GenesisState) GetHostProposals() []HostProposal {
+	if m != nil {
+		return m.HostProposals
+	}
+	return nil
+}
+
+func (m *GenesisState) GetHostProposalVotes() []HostProposalVote {
+	if m != nil {
+		return m.HostProposalVotes
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPendingRewardEpochRecord() *RewardEpochRecord {
+	if m != nil {
+		return m.PendingRewardEpochRecord
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "pstake.lscosmos.v1beta1.GenesisState")
 }
@@ -150,44 +282,70 @@ func init() {
 }
 
 var fileDescriptor_8e8a773682684460 = []byte{
-	// 577 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x94, 0x4f, 0x6f, 0xd3, 0x4c,
-	0x10, 0x87, 0xe3, 0xb7, 0x7d, 0xdb, 0xb2, 0x6d, 0xf9, 0x63, 0x01, 0x35, 0x15, 0x72, 0x0b, 0x22,
-	0x28, 0x97, 0xda, 0x24, 0x88, 0x13, 0xe2, 0x90, 0x86, 0x08, 0x90, 0x90, 0xa8, 0x52, 0x5a, 0x89,
-	0x5e, 0x56, 0x6b, 0x7b, 0x64, 0xaf, 0x70, 0x76, 0xad, 0x9d, 0x75, 0x4a, 0xbf, 0x00, 0x67, 0x3e,
-	0x56, 0x25, 0x2e, 0x3d, 0x72, 0x42, 0x28, 0xf9, 0x22, 0xc8, 0x6b, 0x27, 0x34, 0x28, 0x26, 0xb7,
-	0x68, 0xe6, 0xf7, 0xf8, 0xf1, 0xcc, 0x44, 0x26, 0xcd, 0x0c, 0x35, 0xfb, 0x0c, 0x7e, 0x8a, 0xa1,
-	0xc4, 0xa1, 0x44, 0x7f, 0xd4, 0x0e, 0x40, 0xb3, 0xb6, 0x1f, 0x83, 0x00, 0xe4, 0xe8, 0x65, 0x4a,
-	0x6a, 0x69, 0xef, 0x94, 0x31, 0x6f, 0x1a, 0xf3, 0xaa, 0xd8, 0xee, 0xdd, 0x58, 0xc6, 0xd2, 0x64,
-	0xfc, 0xe2, 0x57, 0x19, 0xdf, 0x7d, 0x52, 0xf7, 0xd4, 0x8c, 0x29, 0x36, 0xac, 0x1e, 0xba, 0xfb,
-	0xb4, 0x2e, 0x35, 0xb3, 0x94, 0xb9, 0x76, 0xed, 0x3b, 0xca, 0x11, 0x28, 0xc1, 0x44, 0x08, 0x34,
-	0x53, 0x32, 0x93, 0xc8, 0xd2, 0x12, 0x79, 0xfc, 0x7d, 0x9d, 0x6c, 0xbd, 0x29, 0x27, 0x38, 0xd6,
-	0x4c, 0x83, 0xfd, 0x8a, 0xac, 0x95, 0x6e, 0xc7, 0xda, 0xb7, 0x5a, 0x9b, 0x9d, 0x3d, 0xaf, 0x66,
-	0x22, 0xef, 0xc8, 0xc4, 0x0e, 0x57, 0x2f, 0x7f, 0xee, 0x35, 0x06, 0x15, 0x64, 0x37, 0xc9, 0xcd,
-	0xa1, 0x8c, 0xf2, 0x14, 0x28, 0x08, 0x16, 0xa4, 0x10, 0x39, 0xff, 0xed, 0x5b, 0xad, 0x8d, 0xc1,
-	0x76, 0x59, 0xed, 0x97, 0x45, 0xfb, 0x8c, 0xdc, 0x49, 0x24, 0x6a, 0x1a, 0x26, 0x8c, 0x0b, 0x5a,
-	0x09, 0x57, 0x8c, 0xb0, 0x55, 0x2b, 0x7c, 0x2b, 0x51, 0xf7, 0x0a, 0x60, 0xce, 0x7c, 0x2b, 0x99,
-	0x2f, 0xdb, 0x29, 0xd9, 0x61, 0x69, 0x2a, 0xcf, 0x69, 0xca, 0x51, 0x43, 0x44, 0x47, 0x2c, 0xe5,
-	0x11, 0xd3, 0x52, 0xa1, 0xb3, 0x6a, 0x0c, 0x5e, 0xad, 0xa1, 0x5b, 0x70, 0xef, 0x0d, 0x76, 0x3a,
-	0xa3, 0x2a, 0xcf, 0x3d, 0xb6, 0xa8, 0x69, 0x7f, 0x22, 0xb7, 0x23, 0x48, 0x21, 0x66, 0x9a, 0x4b,
-	0x41, 0xb1, 0xd8, 0xa1, 0xf3, 0xff, 0x92, 0x41, 0x5e, 0xcf, 0x00, 0xb3, 0xf3, 0xe9, 0x20, 0xd1,
-	0x7c, 0xd9, 0xce, 0xc8, 0x83, 0x6b, 0x4b, 0x52, 0x70, 0xce, 0x54, 0x44, 0x59, 0x14, 0x29, 0x40,
-	0x74, 0xd6, 0x8c, 0xc3, 0x5f, 0xbe, 0xac, 0x81, 0xe1, 0xba, 0x25, 0x56, 0xa9, 0xee, 0x27, 0x0b,
-	0xbb, 0x76, 0x4e, 0x1e, 0x72, 0x1a, 0xd0, 0x90, 0xb2, 0xa1, 0xcc, 0x85, 0xa6, 0x5a, 0x31, 0x81,
-	0x1c, 0x84, 0xa6, 0xa8, 0xa5, 0x02, 0x67, 0xdd, 0x48, 0x9f, 0xd5, 0x4a, 0xdf, 0x1d, 0xf6, 0xba,
-	0x86, 0xfc, 0x38, 0x05, 0x8f, 0x0b, 0xae, 0xb2, 0xee, 0xf0, 0xc5, 0x6d, 0x3b, 0x25, 0x4e, 0x2e,
-	0x02, 0x29, 0x22, 0x2e, 0x62, 0x0a, 0x99, 0x0c, 0x13, 0x1a, 0x16, 0x67, 0xcb, 0x01, 0x9d, 0x8d,
-	0xfd, 0x95, 0xd6, 0x66, 0xe7, 0xa0, 0x56, 0x79, 0x32, 0x05, 0xfb, 0x05, 0xd7, 0x3b, 0x2d, 0xa8,
-	0xe9, 0xc5, 0xf2, 0x05, 0x3d, 0xb4, 0xbf, 0x5a, 0xe4, 0x51, 0xb5, 0x6a, 0xa9, 0xe8, 0xdf, 0x62,
-	0x10, 0x5a, 0x71, 0x40, 0xe7, 0x86, 0xf1, 0xbe, 0x58, 0x76, 0x43, 0xa9, 0xe6, 0x5f, 0xa0, 0x2f,
-	0xb4, 0xba, 0xa8, 0xfc, 0x6e, 0x54, 0x9f, 0xe1, 0x80, 0xf6, 0x11, 0xd9, 0x36, 0xf7, 0x65, 0x61,
-	0x58, 0x2c, 0x05, 0x1d, 0x62, 0xd6, 0xdb, 0xfc, 0xe7, 0x4d, 0xbb, 0x55, 0xb8, 0x72, 0x6c, 0x25,
-	0xd7, 0x6b, 0x27, 0x97, 0x63, 0xd7, 0xba, 0x1a, 0xbb, 0xd6, 0xaf, 0xb1, 0x6b, 0x7d, 0x9b, 0xb8,
-	0x8d, 0xab, 0x89, 0xdb, 0xf8, 0x31, 0x71, 0x1b, 0x67, 0x2f, 0x63, 0xae, 0x93, 0x3c, 0xf0, 0x42,
-	0x39, 0xf4, 0x33, 0x50, 0x58, 0xfc, 0x8d, 0x45, 0x08, 0x1f, 0x04, 0xf8, 0xa5, 0xed, 0x40, 0x30,
-	0xcd, 0x47, 0xe0, 0x8f, 0x3a, 0xfe, 0x97, 0x3f, 0x1f, 0x10, 0x7d, 0x91, 0x01, 0x06, 0x6b, 0xe6,
-	0x5b, 0xf1, 0xfc, 0x77, 0x00, 0x00, 0x00, 0xff, 0xff, 0x55, 0x01, 0x90, 0xb3, 0x04, 0x05, 0x00,
-	0x00,
+	// 1008 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0x8f, 0x71, 0x5b, 0x92, 0xc9, 0x9f, 0x26, 0x93, 0xa4, 0x99, 0x04, 0xe4, 0x3a, 0x88, 0x54,
+	0x01, 0x54, 0x9b, 0x04, 0x71, 0x01, 0x71, 0x48, 0x9d, 0x14, 0x90, 0x40, 0x0d, 0x0e, 0x8d, 0x44,
+	0x05, 0x1a, 0x8d, 0x77, 0x5f, 0xbc, 0x23, 0xd6, 0x33, 0xab, 0x79, 0x63, 0x87, 0x9e, 0xb8, 0x71,
+	0xe6, 0x63, 0xf5, 0xd8, 0x23, 0x27, 0x54, 0x25, 0x5f, 0x04, 0xcd, 0xec, 0xec, 0x62, 0xa7, 0xde,
+	0x58, 0x88, 0x5b, 0xf2, 0xde, 0xef, 0xcf, 0xcc, 0x7b, 0x6f, 0x9e, 0x97, 0xec, 0x65, 0x68, 0xc5,
+	0xaf, 0xd0, 0x4e, 0x31, 0xd2, 0x38, 0xd0, 0xd8, 0x1e, 0x1d, 0xf4, 0xc0, 0x8a, 0x83, 0x76, 0x1f,
+	0x14, 0xa0, 0xc4, 0x56, 0x66, 0xb4, 0xd5, 0x74, 0x2b, 0x87, 0xb5, 0x0a, 0x58, 0x2b, 0xc0, 0x76,
+	0x36, 0xfa, 0xba, 0xaf, 0x3d, 0xa6, 0xed, 0xfe, 0xca, 0xe1, 0x3b, 0x8d, 0x20, 0xd6, 0x13, 0x08,
+	0xa5, 0x62, 0xa4, 0xa5, 0x0a, 0xf9, 0x0f, 0xab, 0x5c, 0x33, 0x61, 0xc4, 0x20, 0x98, 0xee, 0x3c,
+	0xaa, 0x42, 0x95, 0xa7, 0xc8, 0x71, 0x07, 0x95, 0x77, 0xd0, 0x23, 0x30, 0x4a, 0xa8, 0x08, 0x78,
+	0x66, 0x74, 0xa6, 0x51, 0xa4, 0x39, 0xe5, 0x83, 0x37, 0x94, 0x2c, 0x7d, 0x9d, 0xdf, 0xf0, 0xcc,
+	0x0a, 0x0b, 0xf4, 0x2b, 0x72, 0x2f, 0xf7, 0x66, 0xb5, 0x66, 0x6d, 0x7f, 0xf1, 0xf0, 0x61, 0xab,
+	0xe2, 0xc6, 0xad, 0x53, 0x0f, 0x7b, 0x72, 0xe7, 0xd5, 0xdf, 0x0f, 0xe7, 0xba, 0x81, 0x44, 0xf7,
+	0xc8, 0xca, 0x40, 0xc7, 0xc3, 0x14, 0x38, 0x28, 0xd1, 0x4b, 0x21, 0x66, 0xef, 0x34, 0x6b, 0xfb,
+	0xf3, 0xdd, 0xe5, 0x3c, 0x7a, 0x92, 0x07, 0xe9, 0x0b, 0xb2, 0x96, 0x68, 0xb4, 0x3c, 0x4a, 0x84,
+	0x54, 0x3c, 0x18, 0xd6, 0xbd, 0xe1, 0x7e, 0xa5, 0xe1, 0x37, 0x1a, 0x6d, 0xc7, 0x11, 0x26, 0x9c,
+	0xef, 0x27, 0x93, 0x61, 0x9a, 0x92, 0x2d, 0x91, 0xa6, 0xfa, 0x92, 0xa7, 0x12, 0x2d, 0xc4, 0x7c,
+	0x24, 0x52, 0x19, 0x0b, 0xab, 0x0d, 0xb2, 0x3b, 0xde, 0xa1, 0x55, 0xe9, 0x70, 0xe4, 0x78, 0xdf,
+	0x79, 0xda, 0x79, 0xc9, 0x0a, 0x3e, 0x9b, 0x62, 0x5a, 0x92, 0xfe, 0x44, 0x56, 0x63, 0x48, 0xa1,
+	0x2f, 0xac, 0xd4, 0x8a, 0xa3, 0xab, 0x21, 0xbb, 0x3b, 0xe3, 0x22, 0xc7, 0x25, 0xc1, 0xd7, 0xbc,
+	0xb8, 0x48, 0x3c, 0x19, 0xa6, 0x19, 0xd9, 0x1e, 0x2b, 0x92, 0x81, 0x4b, 0x61, 0x62, 0x2e, 0xe2,
+	0xd8, 0x00, 0x22, 0xbb, 0xe7, 0x3d, 0xda, 0xb3, 0x8b, 0xd5, 0xf5, 0xbc, 0xa3, 0x9c, 0x16, 0xac,
+	0x1e, 0x24, 0x53, 0xb3, 0x74, 0x48, 0xde, 0x97, 0xbc, 0xc7, 0x23, 0x2e, 0x06, 0x7a, 0xa8, 0x2c,
+	0xb7, 0x46, 0x28, 0x94, 0xa0, 0x2c, 0x47, 0xab, 0x0d, 0xb0, 0x77, 0xbd, 0xe9, 0xa7, 0x95, 0xa6,
+	0xdf, 0x3e, 0xe9, 0x1c, 0x79, 0xe6, 0x8f, 0x05, 0xf1, 0xcc, 0xf1, 0x82, 0xeb, 0x96, 0x9c, 0x9e,
+	0xa6, 0x29, 0x61, 0x43, 0xd5, 0xd3, 0x2a, 0x96, 0xaa, 0xcf, 0x21, 0xd3, 0x51, 0xc2, 0x23, 0xd7,
+	0xb6, 0x21, 0x20, 0x9b, 0x6f, 0xd6, 0xf7, 0x17, 0x0f, 0x1f, 0x57, 0x5a, 0x3e, 0x2f, 0x88, 0x27,
+	0x8e, 0xd7, 0x39, 0x77, 0xac, 0xa2, 0x63, 0xc3, 0x29, 0x39, 0xa4, 0x7f, 0xd4, 0xc8, 0x6e, 0x28,
+	0xb5, 0x36, 0xfc, 0xa6, 0x31, 0x28, 0x6b, 0x24, 0x20, 0x5b, 0xf0, 0xbe, 0x9f, 0xcf, 0xea, 0xa1,
+	0x36, 0x93, 0x07, 0x38, 0x51, 0xd6, 0xbc, 0x0c, 0xfe, 0x8d, 0xb8, 0x1a, 0x23, 0x01, 0xe9, 0x29,
+	0x59, 0xf6, 0xfd, 0x15, 0x51, 0xe4, 0x8a, 0x82, 0x8c, 0xf8, 0xf2, 0xee, 0xdd, 0xda, 0xd3, 0xa3,
+	0x00, 0x0e, 0x1e, 0x4b, 0xc9, 0x58, 0x8c, 0x1e, 0x92, 0x4d, 0x31, 0xb4, 0x9a, 0x47, 0xa9, 0x90,
+	0x03, 0x5e, 0xda, 0x23, 0x5b, 0x6c, 0xd6, 0xf7, 0x17, 0xba, 0xeb, 0x2e, 0xd9, 0x71, 0xb9, 0xf2,
+	0xf4, 0x48, 0x35, 0xd9, 0xce, 0x20, 0xaf, 0xc0, 0x18, 0xd7, 0x17, 0x03, 0xd9, 0x52, 0xb3, 0x7e,
+	0xeb, 0x83, 0x39, 0xcd, 0x99, 0x47, 0x85, 0xae, 0xbf, 0x5f, 0x31, 0x64, 0xd9, 0xb4, 0xa4, 0x7f,
+	0x31, 0x61, 0x88, 0x79, 0x0c, 0x99, 0x46, 0x69, 0x91, 0x2d, 0x37, 0xeb, 0xb7, 0xbe, 0x98, 0x30,
+	0xa0, 0xc7, 0x01, 0x5f, 0xbc, 0x18, 0x31, 0x19, 0xa6, 0x67, 0x64, 0x25, 0xef, 0x62, 0x29, 0xbc,
+	0xe2, 0x4b, 0xfa, 0xa8, 0x52, 0xd8, 0x9f, 0xe9, 0x86, 0xec, 0x32, 0x8c, 0x07, 0xe9, 0x31, 0x59,
+	0xb8, 0x00, 0xe0, 0x98, 0xa5, 0xd2, 0xb2, 0xfb, 0x5e, 0x6f, 0xb7, 0x52, 0xef, 0x29, 0xc0, 0x99,
+	0x03, 0x06, 0xa9, 0xf9, 0x8b, 0xf0, 0x3f, 0xed, 0x92, 0x95, 0x48, 0xa7, 0x29, 0x44, 0x6e, 0x25,
+	0x5d, 0x00, 0x20, 0x5b, 0x6d, 0xd6, 0x6f, 0xed, 0x76, 0xa7, 0x80, 0x3f, 0x85, 0x62, 0xa2, 0x97,
+	0xa3, 0xb1, 0x18, 0xd2, 0x2f, 0xc8, 0x5d, 0xa3, 0x53, 0x40, 0xb6, 0xe6, 0x4f, 0xd5, 0xa8, 0x94,
+	0xea, 0x3a, 0x54, 0xd0, 0xc8, 0x29, 0xf4, 0x17, 0xb2, 0x51, 0xb6, 0x3d, 0x1e, 0x48, 0xe5, 0xb6,
+	0x8c, 0xea, 0x03, 0xa3, 0x5e, 0xea, 0x93, 0x99, 0x1d, 0x77, 0x9c, 0x8e, 0xa7, 0x74, 0x69, 0xf6,
+	0x56, 0xcc, 0x75, 0x22, 0x13, 0x43, 0x04, 0x8e, 0x97, 0xd2, 0x46, 0x09, 0x20, 0x5b, 0x9f, 0xd1,
+	0x89, 0x53, 0x07, 0x3f, 0x0b, 0xe8, 0xe2, 0xbe, 0xd9, 0x78, 0x90, 0xfe, 0x4e, 0x76, 0xe1, 0xe2,
+	0x02, 0x22, 0x2b, 0x47, 0xc0, 0xab, 0x76, 0xfc, 0xc6, 0xff, 0xd8, 0xf1, 0x8d, 0x52, 0x7e, 0x2a,
+	0x8a, 0xfe, 0x4c, 0xd6, 0x4a, 0x27, 0x3e, 0x00, 0x6b, 0x64, 0x84, 0x6c, 0xd3, 0xf7, 0xf1, 0xa3,
+	0x4a, 0xc3, 0x92, 0xff, 0x7d, 0x4e, 0x08, 0x5e, 0xab, 0xa3, 0x1b, 0x71, 0xfa, 0x03, 0xd9, 0x88,
+	0x84, 0x31, 0x12, 0x62, 0xee, 0x7e, 0xaf, 0xc3, 0xc6, 0x47, 0xf6, 0xc0, 0xdf, 0x68, 0xbb, 0x15,
+	0x74, 0x7b, 0x02, 0x61, 0x6c, 0x48, 0xa4, 0x0a, 0x82, 0x34, 0x90, 0x9f, 0x8d, 0xc0, 0xe4, 0x6b,
+	0x1d, 0x69, 0x8f, 0x6c, 0x84, 0xdf, 0x8d, 0xfc, 0x5d, 0x18, 0x88, 0xb4, 0x93, 0xdc, 0xf2, 0x67,
+	0xfe, 0xb8, 0x7a, 0x60, 0x3c, 0xc9, 0x3f, 0x8e, 0xae, 0xa7, 0x14, 0x1e, 0xe6, 0x66, 0x02, 0xdd,
+	0x64, 0xfb, 0x35, 0x56, 0x7c, 0x59, 0x20, 0x63, 0xcd, 0xfa, 0xcc, 0x3d, 0x76, 0x1a, 0xd0, 0x45,
+	0xa7, 0x93, 0xb1, 0x18, 0x52, 0x4e, 0xd6, 0x27, 0x34, 0xf9, 0x48, 0x5b, 0x40, 0xb6, 0x3d, 0xa3,
+	0xd4, 0xe3, 0xc2, 0xe7, 0xba, 0xfc, 0x65, 0x5d, 0x4b, 0x6e, 0xc4, 0x91, 0x4a, 0xf2, 0x5e, 0x31,
+	0xfe, 0x53, 0x0a, 0xc4, 0x76, 0x9a, 0xb5, 0xff, 0x56, 0x9f, 0x2e, 0x0b, 0x72, 0x6f, 0x57, 0xee,
+	0xf9, 0xab, 0xab, 0x46, 0xed, 0xf5, 0x55, 0xa3, 0xf6, 0xe6, 0xaa, 0x51, 0xfb, 0xf3, 0xba, 0x31,
+	0xf7, 0xfa, 0xba, 0x31, 0xf7, 0xd7, 0x75, 0x63, 0xee, 0xc5, 0x97, 0x7d, 0x69, 0x93, 0x61, 0xaf,
+	0x15, 0xe9, 0x41, 0x3b, 0x03, 0x83, 0x6e, 0xdc, 0x54, 0x04, 0xcf, 0x14, 0xb4, 0x73, 0xe3, 0xc7,
+	0x4a, 0xb8, 0x61, 0x6c, 0x8f, 0x0e, 0xdb, 0xbf, 0xfd, 0xfb, 0x55, 0x67, 0x5f, 0x66, 0x80, 0xbd,
+	0x7b, 0xfe, 0x03, 0xee, 0xb3, 0x7f, 0x06, 0x00, 0x31, 0x74, 0x92, 0x4d, 0xb9, 0x0a, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -210,6 +368,219 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.PendingRewardEpochRecord != nil {
+		{
+			size, err := m.PendingRewardEpochRecord.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd2
+	}
+	if len(m.HostProposalVotes) > 0 {
+		for iNdEx := len(m.HostProposalVotes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HostProposalVotes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xca
+		}
+	}
+	if len(m.HostProposals) > 0 {
+		for iNdEx := len(m.HostProposals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HostProposals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xc2
+		}
+	}
+	if len(m.RewardEpochRecords) > 0 {
+		for iNdEx := len(m.RewardEpochRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardEpochRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xba
+		}
+	}
+	{
+		size, err := m.CarriedOverRewards.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xb2
+	if len(m.ValidatorMetrics) > 0 {
+		for iNdEx := len(m.ValidatorMetrics) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorMetrics[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xaa
+		}
+	}
+	{
+		size, err := m.EffectiveAllowListedValidators.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xa2
+	{
+		size, err := m.PauseSwitches.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x9a
+	if m.PendingAdminChange != nil {
+		{
+			size, err := m.PendingAdminChange.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	{
+		size, err := m.Roles.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x8a
+	if len(m.CollectedFees) > 0 {
+		for iNdEx := len(m.CollectedFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CollectedFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	{
+		size, err := m.FeeSplit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x7a
+	{
+		size, err := m.EpochDeposits.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x72
+	if len(m.AddressDeposits) > 0 {
+		for iNdEx := len(m.AddressDeposits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AddressDeposits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.PendingAutoClaimEpochs) > 0 {
+		for iNdEx := len(m.PendingAutoClaimEpochs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingAutoClaimEpochs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.AutoClaimDelegators) > 0 {
+		for iNdEx := len(m.AutoClaimDelegators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AutoClaimDelegators[iNdEx])
+			copy(dAtA[i:], m.AutoClaimDelegators[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.AutoClaimDelegators[iNdEx])))
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
 	{
 		size, err := m.HostAccounts.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -367,6 +738,74 @@ func (m *GenesisState) Size() (n int) {
 	}
 	l = m.HostAccounts.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.AutoClaimDelegators) > 0 {
+		for _, s := range m.AutoClaimDelegators {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PendingAutoClaimEpochs) > 0 {
+		for _, e := range m.PendingAutoClaimEpochs {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AddressDeposits) > 0 {
+		for _, e := range m.AddressDeposits {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.EpochDeposits.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.FeeSplit.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.CollectedFees) > 0 {
+		for _, e := range m.CollectedFees {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.Roles.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	if m.PendingAdminChange != nil {
+		l = m.PendingAdminChange.Size()
+		n += 2 + l + sovGenesis(uint64(l))
+	}
+	l = m.PauseSwitches.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	l = m.EffectiveAllowListedValidators.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	if len(m.ValidatorMetrics) > 0 {
+		for _, e := range m.ValidatorMetrics {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.CarriedOverRewards.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	if len(m.RewardEpochRecords) > 0 {
+		for _, e := range m.RewardEpochRecords {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.HostProposals) > 0 {
+		for _, e := range m.HostProposals {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.HostProposalVotes) > 0 {
+		for _, e := range m.HostProposalVotes {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.PendingRewardEpochRecord != nil {
+		l = m.PendingRewardEpochRecord.Size()
+		n += 2 + l + sovGenesis(uint64(l))
+	}
 	return n
 }
 
@@ -724,6 +1163,546 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoClaimDelegators", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AutoClaimDelegators = append(m.AutoClaimDelegators, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingAutoClaimEpochs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingAutoClaimEpochs = append(m.PendingAutoClaimEpochs, PendingAutoClaimEpoch{})
+			if err := m.PendingAutoClaimEpochs[len(m.PendingAutoClaimEpochs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddressDeposits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AddressDeposits = append(m.AddressDeposits, AddressDeposits{})
+			if err := m.AddressDeposits[len(m.AddressDeposits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochDeposits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EpochDeposits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeSplit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeSplit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollectedFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CollectedFees = append(m.CollectedFees, CollectedFee{})
+			if err := m.CollectedFees[len(m.CollectedFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Roles", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Roles.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingAdminChange", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PendingAdminChange == nil {
+				m.PendingAdminChange = &PendingAdminChange{}
+			}
+			if err := m.PendingAdminChange.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PauseSwitches", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PauseSwitches.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveAllowListedValidators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EffectiveAllowListedValidators.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorMetrics", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorMetrics = append(m.ValidatorMetrics, ValidatorMetrics{})
+			if err := m.ValidatorMetrics[len(m.ValidatorMetrics)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CarriedOverRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CarriedOverRewards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardEpochRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardEpochRecords = append(m.RewardEpochRecords, RewardEpochRecord{})
+			if err := m.RewardEpochRecords[len(m.RewardEpochRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostProposals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HostProposals = append(m.HostProposals, HostProposal{})
+			if err := m.HostProposals[len(m.HostProposals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostProposalVotes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HostProposalVotes = append(m.HostProposalVotes, HostProposalVote{})
+			if err := m.HostProposalVotes[len(m.HostProposalVotes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingRewardEpochRecord", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PendingRewardEpochRecord == nil {
+				m.PendingRewardEpochRecord = &RewardEpochRecord{}
+			}
+			if err := m.PendingRewardEpochRecord.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -3,6 +3,7 @@ package types_test
 import (
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
 	"github.com/persistenceOne/pstake-native/v2/x/lscosmos/types"
@@ -26,6 +27,84 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: false,
 		},
+		{
+			desc: "invalid effective allow listed validators",
+			genState: func() *types.GenesisState {
+				genState := types.DefaultGenesis()
+				genState.EffectiveAllowListedValidators = types.AllowListedValidators{AllowListedValidators: []types.AllowListedValidator{
+					{ValidatorAddress: "cosmosvaloper1hcqg5wj9t42zawqkqucs7la85ffyv08le09ljt", TargetWeight: sdk.NewDecWithPrec(5, 1)},
+				}}
+				return genState
+			}(),
+			valid: false,
+		},
+		{
+			desc: "invalid validator metrics address",
+			genState: func() *types.GenesisState {
+				genState := types.DefaultGenesis()
+				genState.ValidatorMetrics = []types.ValidatorMetrics{{ValidatorAddress: "cosmosvaloper"}}
+				return genState
+			}(),
+			valid: false,
+		},
+		{
+			desc: "invalid host proposal vote options",
+			genState: func() *types.GenesisState {
+				genState := types.DefaultGenesis()
+				genState.HostProposalVotes = []types.HostProposalVote{{ProposalId: 1, Voter: sdk.AccAddress("voter_______________").String()}}
+				return genState
+			}(),
+			valid: false,
+		},
+		{
+			desc: "duplicate auto claim delegator",
+			genState: func() *types.GenesisState {
+				genState := types.DefaultGenesis()
+				delegator := sdk.AccAddress("delegator___________").String()
+				genState.AutoClaimDelegators = []string{delegator, delegator}
+				return genState
+			}(),
+			valid: false,
+		},
+		{
+			desc: "pending auto claim epoch with a cursor",
+			genState: func() *types.GenesisState {
+				genState := types.DefaultGenesis()
+				genState.PendingAutoClaimEpochs = []types.PendingAutoClaimEpoch{
+					{EpochNumber: 4},
+					{EpochNumber: 8, LastDelegatorAddress: sdk.AccAddress("delegator___________").String()},
+				}
+				return genState
+			}(),
+			valid: true,
+		},
+		{
+			desc: "duplicate pending auto claim epoch",
+			genState: func() *types.GenesisState {
+				genState := types.DefaultGenesis()
+				genState.PendingAutoClaimEpochs = []types.PendingAutoClaimEpoch{{EpochNumber: 4}, {EpochNumber: 4}}
+				return genState
+			}(),
+			valid: false,
+		},
+		{
+			desc: "invalid pending auto claim epoch cursor",
+			genState: func() *types.GenesisState {
+				genState := types.DefaultGenesis()
+				genState.PendingAutoClaimEpochs = []types.PendingAutoClaimEpoch{{EpochNumber: 4, LastDelegatorAddress: "cosmos"}}
+				return genState
+			}(),
+			valid: false,
+		},
+		{
+			desc: "invalid pending reward epoch record",
+			genState: func() *types.GenesisState {
+				genState := types.DefaultGenesis()
+				genState.PendingRewardEpochRecord = &types.RewardEpochRecord{EpochNumber: 3}
+				return genState
+			}(),
+			valid: false,
+		},
 		// this line is used by starport scaffolding # types/genesis/testcase
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
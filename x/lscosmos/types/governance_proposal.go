@@ -14,18 +14,21 @@ const (
 	ProposalTypeMinDepositAndFeeChange    = "MinDepositAndFeeChange"
 	ProposalPstakeFeeAddressChange        = "PstakeFeeAddressChange"
 	ProposalAllowListedValidatorSetChange = "AllowListedValidatorSetChange"
+	ProposalFeeSplitChange                = "FeeSplitChange"
 )
 
 var (
 	_ govtypes.Content = &MinDepositAndFeeChangeProposal{}
 	_ govtypes.Content = &PstakeFeeAddressChangeProposal{}
 	_ govtypes.Content = &AllowListedValidatorSetChangeProposal{}
+	_ govtypes.Content = &FeeSplitChangeProposal{}
 )
 
 func init() {
 	govtypes.RegisterProposalType(ProposalTypeMinDepositAndFeeChange)
 	govtypes.RegisterProposalType(ProposalPstakeFeeAddressChange)
 	govtypes.RegisterProposalType(ProposalAllowListedValidatorSetChange)
+	govtypes.RegisterProposalType(ProposalFeeSplitChange)
 }
 
 // NewHostChainParams returns HostChainParams with the input provided
@@ -274,3 +277,59 @@ AllowListedValidators: 	   %s
 	)
 	return b.String()
 }
+
+// NewFeeSplitChangeProposal creates a protocol fee split change proposal.
+func NewFeeSplitChangeProposal(title, description string, feeSplit FeeSplit) *FeeSplitChangeProposal {
+	return &FeeSplitChangeProposal{
+		Title:       title,
+		Description: description,
+		FeeSplit:    feeSplit,
+	}
+}
+
+// GetTitle returns the title of fee split change proposal.
+func (m *FeeSplitChangeProposal) GetTitle() string {
+	return m.Title
+}
+
+// GetDescription returns the description of fee split change proposal.
+func (m *FeeSplitChangeProposal) GetDescription() string {
+	return m.Description
+}
+
+// ProposalRoute returns the proposal-route of fee split change proposal.
+func (m *FeeSplitChangeProposal) ProposalRoute() string {
+	return RouterKey
+}
+
+// ProposalType returns the proposal-type of fee split change proposal.
+func (m *FeeSplitChangeProposal) ProposalType() string {
+	return ProposalFeeSplitChange
+}
+
+// ValidateBasic runs basic stateless validity checks
+func (m *FeeSplitChangeProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(m)
+	if err != nil {
+		return err
+	}
+
+	return m.FeeSplit.Validate()
+}
+
+// String returns the string of proposal details
+func (m *FeeSplitChangeProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`FeeSplitChange:
+Title:                 %s
+Description:           %s
+FeeSplit: 	   %s
+
+`,
+		m.Title,
+		m.Description,
+		m.FeeSplit.String(),
+	),
+	)
+	return b.String()
+}
@@ -144,10 +144,49 @@ func (m *AllowListedValidatorSetChangeProposal) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_AllowListedValidatorSetChangeProposal proto.InternalMessageInfo
 
+type FeeSplitChangeProposal struct {
+	Title       string   `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	FeeSplit    FeeSplit `protobuf:"bytes,3,opt,name=fee_split,json=feeSplit,proto3" json:"fee_split"`
+}
+
+func (m *FeeSplitChangeProposal) Reset()      { *m = FeeSplitChangeProposal{} }
+func (*FeeSplitChangeProposal) ProtoMessage() {}
+func (*FeeSplitChangeProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_47404a6acaa6ce8f, []int{3}
+}
+func (m *FeeSplitChangeProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeSplitChangeProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeSplitChangeProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeSplitChangeProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeSplitChangeProposal.Merge(m, src)
+}
+func (m *FeeSplitChangeProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeSplitChangeProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeSplitChangeProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeSplitChangeProposal proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MinDepositAndFeeChangeProposal)(nil), "pstake.lscosmos.v1beta1.MinDepositAndFeeChangeProposal")
 	proto.RegisterType((*PstakeFeeAddressChangeProposal)(nil), "pstake.lscosmos.v1beta1.PstakeFeeAddressChangeProposal")
 	proto.RegisterType((*AllowListedValidatorSetChangeProposal)(nil), "pstake.lscosmos.v1beta1.AllowListedValidatorSetChangeProposal")
+	proto.RegisterType((*FeeSplitChangeProposal)(nil), "pstake.lscosmos.v1beta1.FeeSplitChangeProposal")
 }
 
 func init() {
@@ -155,41 +194,43 @@ func init() {
 }
 
 var fileDescriptor_47404a6acaa6ce8f = []byte{
-	// 544 bytes of a gzipped FileDescriptorProto
+	// 572 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0x31, 0x6f, 0xd3, 0x40,
-	0x14, 0xc7, 0x6d, 0x48, 0x0b, 0x5c, 0x16, 0x64, 0x52, 0x35, 0x74, 0xb8, 0x54, 0x95, 0xa8, 0x58,
-	0x62, 0x2b, 0x65, 0x03, 0x96, 0x84, 0x28, 0x52, 0x25, 0x10, 0x55, 0x2a, 0x18, 0x90, 0x90, 0x75,
-	0xb1, 0x5f, 0xdd, 0xa3, 0xf6, 0x9d, 0xe5, 0xbb, 0x1a, 0xd8, 0xf8, 0x08, 0x8c, 0x8c, 0x1d, 0x11,
-	0x73, 0x3f, 0x44, 0xc6, 0xaa, 0x0b, 0x88, 0xa1, 0x02, 0x67, 0xe1, 0x1b, 0xb0, 0x22, 0xfb, 0xce,
-	0x8e, 0x91, 0xda, 0xa1, 0x92, 0xa7, 0x4b, 0xfc, 0xde, 0xfb, 0xff, 0xfe, 0xef, 0xee, 0xe9, 0xa1,
-	0x41, 0x2c, 0x24, 0x39, 0x02, 0x27, 0x14, 0x1e, 0x17, 0x11, 0x17, 0x4e, 0x3a, 0x98, 0x81, 0x24,
-	0x03, 0x27, 0xe0, 0x29, 0x24, 0x8c, 0x30, 0x0f, 0xdc, 0x38, 0xe1, 0x31, 0x17, 0x24, 0xb4, 0xe3,
-	0x84, 0x4b, 0x6e, 0xad, 0xab, 0x12, 0xbb, 0x2c, 0xb1, 0x75, 0xc9, 0x46, 0x27, 0xe0, 0x01, 0x2f,
-	0x72, 0x9c, 0xfc, 0x97, 0x4a, 0xdf, 0xc0, 0x5a, 0x78, 0x46, 0x04, 0x54, 0xea, 0x1e, 0xa7, 0x4c,
-	0xc7, 0xef, 0xab, 0xb8, 0xab, 0x0a, 0xb5, 0xa4, 0x0a, 0x6d, 0x5f, 0x65, 0xae, 0x42, 0x17, 0x79,
-	0x5b, 0x7f, 0x5b, 0x08, 0xbf, 0xa0, 0x6c, 0x0c, 0x31, 0x17, 0x54, 0x0e, 0x99, 0x3f, 0x01, 0x78,
-	0x76, 0x48, 0x58, 0x00, 0x7b, 0xda, 0xba, 0xd5, 0x41, 0x2b, 0x92, 0xca, 0x10, 0xba, 0xe6, 0xa6,
-	0xf9, 0xf0, 0xce, 0x54, 0xfd, 0xb1, 0x36, 0x51, 0xdb, 0x07, 0xe1, 0x25, 0x34, 0x96, 0x94, 0xb3,
-	0xee, 0x8d, 0x22, 0x56, 0xff, 0x64, 0xbd, 0x45, 0xed, 0x88, 0x32, 0xd7, 0x57, 0xd2, 0xdd, 0x9b,
-	0x79, 0xc6, 0xe8, 0xe9, 0xfc, 0xa2, 0x67, 0xfc, 0xbc, 0xe8, 0x6d, 0x07, 0x54, 0x1e, 0x1e, 0xcf,
-	0x6c, 0x8f, 0x47, 0xda, 0xb8, 0x3e, 0xfa, 0xc2, 0x3f, 0x72, 0xe4, 0xc7, 0x18, 0x84, 0xbd, 0xcb,
-	0xe4, 0xf9, 0x69, 0x1f, 0x69, 0xbf, 0xbb, 0x4c, 0x4e, 0x51, 0x54, 0x59, 0xb5, 0xde, 0x21, 0x4b,
-	0xf5, 0x58, 0x12, 0xdc, 0x03, 0x80, 0x6e, 0xeb, 0xda, 0x94, 0x31, 0x78, 0x35, 0xca, 0x18, 0xbc,
-	0xe9, 0x5d, 0xa5, 0xab, 0x41, 0x13, 0x80, 0x1a, 0x2b, 0x01, 0x75, 0xe6, 0xac, 0x95, 0xe6, 0x58,
-	0x53, 0x25, 0xfb, 0x3f, 0xeb, 0x98, 0x2d, 0x59, 0xab, 0xcd, 0xb1, 0x5e, 0xb1, 0x8a, 0x15, 0xa3,
-	0xb5, 0xaa, 0x2f, 0x1f, 0xa2, 0xe2, 0xdd, 0x0a, 0xdc, 0xad, 0x06, 0x70, 0xf7, 0xca, 0xd6, 0x4a,
-	0xe5, 0x09, 0xc0, 0xe3, 0xdb, 0x5f, 0x4e, 0x7a, 0xc6, 0x9f, 0x93, 0x9e, 0xb1, 0xf5, 0xcd, 0x44,
-	0x78, 0xaf, 0x74, 0x32, 0xf4, 0xfd, 0x04, 0x84, 0x68, 0x68, 0xf2, 0x26, 0xd5, 0x15, 0x1e, 0x00,
-	0xb8, 0x44, 0x69, 0xeb, 0x01, 0xec, 0x9e, 0x9f, 0xf6, 0x3b, 0xda, 0xa5, 0xa6, 0xee, 0xcb, 0x84,
-	0xb2, 0xa0, 0xbc, 0x9e, 0xa5, 0x9b, 0x9a, 0xd9, 0xef, 0x26, 0x7a, 0x30, 0x0c, 0x43, 0xfe, 0xfe,
-	0x39, 0x15, 0x12, 0xfc, 0xd7, 0x24, 0xa4, 0x3e, 0x91, 0x3c, 0xd9, 0x07, 0xd9, 0x90, 0xe7, 0x10,
-	0xad, 0x93, 0x1c, 0xe0, 0x86, 0x05, 0xc1, 0x4d, 0x4b, 0x84, 0x32, 0xde, 0xde, 0xb1, 0xed, 0x2b,
-	0x96, 0x87, 0x7d, 0x99, 0x31, 0x31, 0x6a, 0xe5, 0x8f, 0x37, 0x5d, 0x23, 0x97, 0x05, 0x97, 0x9d,
-	0x8d, 0xc8, 0xfc, 0x37, 0x36, 0x3e, 0x65, 0xd8, 0xf8, 0x9a, 0x61, 0x73, 0x9e, 0x61, 0xf3, 0x2c,
-	0xc3, 0xe6, 0xaf, 0x0c, 0x9b, 0x9f, 0x17, 0xd8, 0x38, 0x5b, 0x60, 0xe3, 0xc7, 0x02, 0x1b, 0x6f,
-	0x9e, 0xd4, 0x26, 0x20, 0x86, 0x44, 0xe4, 0x7a, 0xcc, 0x83, 0x97, 0x0c, 0x1c, 0xe5, 0xaa, 0xcf,
-	0x88, 0xa4, 0x29, 0x38, 0xe9, 0x8e, 0xf3, 0x61, 0xb9, 0x74, 0x8a, 0xd1, 0x98, 0xad, 0x16, 0xab,
-	0xe6, 0xd1, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x8f, 0x37, 0xa1, 0x50, 0x31, 0x05, 0x00, 0x00,
+	0x14, 0xc7, 0x6d, 0x48, 0x4b, 0x7b, 0x59, 0x90, 0x49, 0x69, 0xe8, 0x70, 0x29, 0x95, 0xa8, 0x58,
+	0x62, 0x2b, 0x65, 0x03, 0x96, 0x84, 0x28, 0x52, 0x25, 0x10, 0x55, 0x22, 0x18, 0x90, 0x90, 0x75,
+	0xb1, 0x5f, 0xdc, 0xa3, 0xce, 0x9d, 0xe5, 0xbb, 0x06, 0xd8, 0xf8, 0x08, 0x8c, 0x8c, 0x19, 0x11,
+	0x73, 0x3f, 0x44, 0xc6, 0xaa, 0x0b, 0x88, 0xa1, 0x82, 0x64, 0xe1, 0x1b, 0xb0, 0x22, 0xfb, 0xce,
+	0x8e, 0x91, 0x9a, 0xa1, 0x92, 0x27, 0xc7, 0x79, 0x77, 0xff, 0xdf, 0xef, 0x9d, 0x9f, 0x0e, 0xb5,
+	0x22, 0x21, 0xc9, 0x09, 0x38, 0xa1, 0xf0, 0xb8, 0x18, 0x73, 0xe1, 0x4c, 0x5a, 0x43, 0x90, 0xa4,
+	0xe5, 0x04, 0x7c, 0x02, 0x31, 0x23, 0xcc, 0x03, 0x37, 0x8a, 0x79, 0xc4, 0x05, 0x09, 0xed, 0x28,
+	0xe6, 0x92, 0x5b, 0xdb, 0x6a, 0x8b, 0x9d, 0x6d, 0xb1, 0xf5, 0x96, 0x9d, 0x5a, 0xc0, 0x03, 0x9e,
+	0xae, 0x71, 0x92, 0x5f, 0x6a, 0xf9, 0x0e, 0xd6, 0xc1, 0x43, 0x22, 0x20, 0x4f, 0xf7, 0x38, 0x65,
+	0xba, 0x7e, 0x4f, 0xd5, 0x5d, 0xb5, 0x51, 0x47, 0xaa, 0xd2, 0xfe, 0x2a, 0xb9, 0x50, 0x14, 0xd7,
+	0xed, 0xfd, 0xad, 0x20, 0xfc, 0x82, 0xb2, 0x2e, 0x44, 0x5c, 0x50, 0xd9, 0x66, 0x7e, 0x0f, 0xe0,
+	0xd9, 0x31, 0x61, 0x01, 0x1c, 0x69, 0x75, 0xab, 0x86, 0xd6, 0x24, 0x95, 0x21, 0xd4, 0xcd, 0x5d,
+	0xf3, 0xe1, 0x66, 0x5f, 0xbd, 0x58, 0xbb, 0xa8, 0xea, 0x83, 0xf0, 0x62, 0x1a, 0x49, 0xca, 0x59,
+	0xfd, 0x46, 0x5a, 0x2b, 0xfe, 0x65, 0xbd, 0x45, 0xd5, 0x31, 0x65, 0xae, 0xaf, 0xa2, 0xeb, 0x37,
+	0x93, 0x15, 0x9d, 0xa7, 0xb3, 0xcb, 0x86, 0xf1, 0xf3, 0xb2, 0xb1, 0x1f, 0x50, 0x79, 0x7c, 0x3a,
+	0xb4, 0x3d, 0x3e, 0xd6, 0xe2, 0xfa, 0xd1, 0x14, 0xfe, 0x89, 0x23, 0x3f, 0x46, 0x20, 0xec, 0x43,
+	0x26, 0x2f, 0xce, 0x9a, 0x48, 0xfb, 0x1e, 0x32, 0xd9, 0x47, 0xe3, 0x5c, 0xd5, 0x7a, 0x87, 0x2c,
+	0xd5, 0x63, 0x46, 0x70, 0x47, 0x00, 0xf5, 0xca, 0xb5, 0x29, 0x5d, 0xf0, 0x0a, 0x94, 0x2e, 0x78,
+	0xfd, 0xdb, 0x2a, 0x57, 0x83, 0x7a, 0x00, 0x05, 0x56, 0x0c, 0xea, 0x99, 0xb0, 0xd6, 0xca, 0x63,
+	0xf5, 0x55, 0xec, 0xff, 0xac, 0x53, 0xb6, 0x64, 0xad, 0x97, 0xc7, 0x7a, 0xc5, 0x72, 0x56, 0x84,
+	0xb6, 0xf2, 0xbe, 0x7c, 0x18, 0xa7, 0xdf, 0x2d, 0xc5, 0xdd, 0x2a, 0x01, 0x77, 0x27, 0x6b, 0x2d,
+	0x4b, 0xee, 0x01, 0x3c, 0xde, 0xf8, 0x32, 0x6d, 0x18, 0x7f, 0xa6, 0x0d, 0x63, 0xef, 0x9b, 0x89,
+	0xf0, 0x51, 0x66, 0xd2, 0xf6, 0xfd, 0x18, 0x84, 0x28, 0x69, 0xf2, 0x7a, 0xf9, 0x11, 0x8e, 0x00,
+	0x5c, 0xa2, 0xb2, 0xf5, 0x00, 0xd6, 0x2f, 0xce, 0x9a, 0x35, 0x6d, 0xa9, 0xa9, 0x03, 0x19, 0x53,
+	0x16, 0x64, 0xc7, 0xb3, 0xb4, 0x29, 0xc8, 0x7e, 0x37, 0xd1, 0x83, 0x76, 0x18, 0xf2, 0xf7, 0xcf,
+	0xa9, 0x90, 0xe0, 0xbf, 0x26, 0x21, 0xf5, 0x89, 0xe4, 0xf1, 0x00, 0x64, 0x49, 0xce, 0x21, 0xda,
+	0x26, 0x09, 0xc0, 0x0d, 0x53, 0x82, 0x3b, 0xc9, 0x10, 0x4a, 0xbc, 0x7a, 0x60, 0xdb, 0x2b, 0x2e,
+	0x0f, 0xfb, 0x2a, 0x31, 0xd1, 0xa9, 0x24, 0x1f, 0xaf, 0xbf, 0x45, 0xae, 0x2a, 0x16, 0x3a, 0x9b,
+	0x9a, 0xe8, 0x6e, 0x0f, 0x60, 0x10, 0x85, 0xb4, 0xac, 0x56, 0xba, 0x68, 0x33, 0x39, 0x77, 0x91,
+	0x44, 0x6a, 0xf9, 0xfb, 0x2b, 0xe5, 0x33, 0xb6, 0xf6, 0xdd, 0x18, 0xe9, 0xf7, 0xa5, 0x62, 0x87,
+	0xcc, 0x7e, 0x63, 0xe3, 0xd3, 0x1c, 0x1b, 0x5f, 0xe7, 0xd8, 0x9c, 0xcd, 0xb1, 0x79, 0x3e, 0xc7,
+	0xe6, 0xaf, 0x39, 0x36, 0x3f, 0x2f, 0xb0, 0x71, 0xbe, 0xc0, 0xc6, 0x8f, 0x05, 0x36, 0xde, 0x3c,
+	0x29, 0x0c, 0x69, 0x04, 0xb1, 0x48, 0x5a, 0x66, 0x1e, 0xbc, 0x64, 0xe0, 0x28, 0x76, 0x93, 0x11,
+	0x49, 0x27, 0xe0, 0x4c, 0x0e, 0x9c, 0x0f, 0xcb, 0x7b, 0x31, 0x9d, 0xde, 0xe1, 0x7a, 0x7a, 0x1b,
+	0x3e, 0xfa, 0x37, 0x00, 0xbe, 0x7e, 0xa1, 0x37, 0xd4, 0x05, 0x00, 0x00,
 }
 
 func (m *MinDepositAndFeeChangeProposal) Marshal() (dAtA []byte, err error) {
@@ -370,6 +411,53 @@ func (m *AllowListedValidatorSetChangeProposal) MarshalToSizedBuffer(dAtA []byte
 	return len(dAtA) - i, nil
 }
 
+func (m *FeeSplitChangeProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeSplitChangeProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeSplitChangeProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.FeeSplit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGovernanceProposal(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGovernanceProposal(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGovernanceProposal(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGovernanceProposal(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGovernanceProposal(v)
 	base := offset
@@ -448,6 +536,25 @@ func (m *AllowListedValidatorSetChangeProposal) Size() (n int) {
 	return n
 }
 
+func (m *FeeSplitChangeProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGovernanceProposal(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGovernanceProposal(uint64(l))
+	}
+	l = m.FeeSplit.Size()
+	n += 1 + l + sovGovernanceProposal(uint64(l))
+	return n
+}
+
 func sovGovernanceProposal(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1031,6 +1138,153 @@ func (m *AllowListedValidatorSetChangeProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *FeeSplitChangeProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGovernanceProposal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeSplitChangeProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeSplitChangeProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGovernanceProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGovernanceProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGovernanceProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGovernanceProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGovernanceProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGovernanceProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeSplit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGovernanceProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGovernanceProposal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGovernanceProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeSplit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGovernanceProposal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGovernanceProposal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGovernanceProposal(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,125 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+)
+
+// ValidateWeightedVoteOptions checks that the options are valid, unique and that their weights sum up to one
+func ValidateWeightedVoteOptions(options []govtypes.WeightedVoteOption) error {
+	if len(options) == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "no vote options")
+	}
+
+	totalWeight := sdk.ZeroDec()
+	usedOptions := make(map[govtypes.VoteOption]bool)
+	for _, option := range options {
+		if !govtypes.ValidWeightedVoteOption(option) {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, option.String())
+		}
+		if usedOptions[option.Option] {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "duplicated vote option %s", option.Option)
+		}
+		usedOptions[option.Option] = true
+		totalWeight = totalWeight.Add(option.Weight)
+	}
+
+	if !totalWeight.Equal(sdk.OneDec()) {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "total weight of vote options %s is not 1", totalWeight)
+	}
+	return nil
+}
+
+// NewHostProposalTally returns an empty tally
+func NewHostProposalTally() HostProposalTally {
+	return HostProposalTally{
+		Yes:        sdk.ZeroInt(),
+		Abstain:    sdk.ZeroInt(),
+		No:         sdk.ZeroInt(),
+		NoWithVeto: sdk.ZeroInt(),
+	}
+}
+
+// Add splits the voting power over the options by their weights
+func (t *HostProposalTally) Add(options []govtypes.WeightedVoteOption, power sdk.Int) {
+	for _, option := range options {
+		amount := option.Weight.MulInt(power).TruncateInt()
+		switch option.Option {
+		case govtypes.OptionYes:
+			t.Yes = t.Yes.Add(amount)
+		case govtypes.OptionAbstain:
+			t.Abstain = t.Abstain.Add(amount)
+		case govtypes.OptionNo:
+			t.No = t.No.Add(amount)
+		case govtypes.OptionNoWithVeto:
+			t.NoWithVeto = t.NoWithVeto.Add(amount)
+		}
+	}
+}
+
+// Total returns the voting power tallied over all the options
+func (t HostProposalTally) Total() sdk.Int {
+	return t.Yes.Add(t.Abstain).Add(t.No).Add(t.NoWithVeto)
+}
+
+// WeightedVoteOptions converts the tally to the options of a weighted vote, options without voting power are
+// left out and the rounding remainder goes to the option with the most voting power. Nil is returned for an
+// empty tally.
+func (t HostProposalTally) WeightedVoteOptions() []govtypes.WeightedVoteOption {
+	total := t.Total()
+	if !total.IsPositive() {
+		return nil
+	}
+
+	var options []govtypes.WeightedVoteOption
+	largest, largestPower := 0, sdk.ZeroInt()
+	weightSum := sdk.ZeroDec()
+	for _, bucket := range []struct {
+		option govtypes.VoteOption
+		power  sdk.Int
+	}{
+		{govtypes.OptionYes, t.Yes},
+		{govtypes.OptionAbstain, t.Abstain},
+		{govtypes.OptionNo, t.No},
+		{govtypes.OptionNoWithVeto, t.NoWithVeto},
+	} {
+		if !bucket.power.IsPositive() {
+			continue
+		}
+		if bucket.power.GT(largestPower) {
+			largest, largestPower = len(options), bucket.power
+		}
+		weight := sdk.NewDecFromInt(bucket.power).QuoInt(total)
+		weightSum = weightSum.Add(weight)
+		options = append(options, govtypes.WeightedVoteOption{Option: bucket.option, Weight: weight})
+	}
+	options[largest].Weight = options[largest].Weight.Add(sdk.OneDec().Sub(weightSum))
+	return options
+}
+
+// Validate checks the host proposal
+func (p HostProposal) Validate() error {
+	if p.ProposalId == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "host proposal id cannot be 0")
+	}
+	if _, ok := HostProposalStatus_name[int32(p.Status)]; !ok {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid status %d of host proposal %d", p.Status, p.ProposalId)
+	}
+	if p.Status != HOST_PROPOSAL_STATUS_SIGNALING && p.Status != HOST_PROPOSAL_STATUS_NO_SIGNAL {
+		return ValidateWeightedVoteOptions(p.Options)
+	}
+	return nil
+}
+
+// Validate checks the vote signal on a host proposal
+func (v HostProposalVote) Validate() error {
+	if v.ProposalId == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "host proposal id cannot be 0")
+	}
+	if _, err := sdk.AccAddressFromBech32(v.Voter); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, v.Voter)
+	}
+	return ValidateWeightedVoteOptions(v.Options)
+}
@@ -0,0 +1,42 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+	"github.com/stretchr/testify/require"
+
+	"github.com/persistenceOne/pstake-native/v2/x/lscosmos/types"
+)
+
+func TestHostProposalTally(t *testing.T) {
+	tally := types.NewHostProposalTally()
+	require.Nil(t, tally.WeightedVoteOptions())
+
+	tally.Add(govtypes.NewNonSplitVoteOption(govtypes.OptionYes), sdk.NewInt(100))
+	tally.Add(govtypes.WeightedVoteOptions{
+		govtypes.WeightedVoteOption{Option: govtypes.OptionNo, Weight: sdk.NewDecWithPrec(5, 1)},
+		govtypes.WeightedVoteOption{Option: govtypes.OptionAbstain, Weight: sdk.NewDecWithPrec(5, 1)},
+	}, sdk.NewInt(100))
+	require.Equal(t, sdk.NewInt(100), tally.Yes)
+	require.Equal(t, sdk.NewInt(50), tally.No)
+	require.Equal(t, sdk.NewInt(50), tally.Abstain)
+	require.Equal(t, sdk.ZeroInt(), tally.NoWithVeto)
+	require.Equal(t, sdk.NewInt(200), tally.Total())
+
+	require.Equal(t, []govtypes.WeightedVoteOption{
+		govtypes.WeightedVoteOption{Option: govtypes.OptionYes, Weight: sdk.NewDecWithPrec(5, 1)},
+		govtypes.WeightedVoteOption{Option: govtypes.OptionAbstain, Weight: sdk.NewDecWithPrec(25, 2)},
+		govtypes.WeightedVoteOption{Option: govtypes.OptionNo, Weight: sdk.NewDecWithPrec(25, 2)},
+	}, tally.WeightedVoteOptions())
+
+	// the rounding remainder goes to the option with the most voting power
+	tally = types.NewHostProposalTally()
+	tally.Add(govtypes.NewNonSplitVoteOption(govtypes.OptionYes), sdk.NewInt(1))
+	tally.Add(govtypes.NewNonSplitVoteOption(govtypes.OptionNo), sdk.NewInt(2))
+	options := tally.WeightedVoteOptions()
+	require.NoError(t, types.ValidateWeightedVoteOptions(options))
+	require.Equal(t, sdk.MustNewDecFromStr("0.333333333333333333"), options[0].Weight)
+	require.Equal(t, sdk.MustNewDecFromStr("0.666666666666666667"), options[1].Weight)
+}
@@ -1,7 +1,6 @@
 package types
 
 import (
-	"strconv"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -48,6 +47,27 @@ const (
 	// MsgTypeReportSlashing is the type of message Report Slashing
 	MsgTypeReportSlashing = "msg_report_slashing"
 
+	// MsgTypeTransferUnbondingEntry is the type of message Transfer Unbonding Entry
+	MsgTypeTransferUnbondingEntry = "msg_transfer_unbonding_entry"
+
+	// MsgTypeClaimFor is the type of message Claim For
+	MsgTypeClaimFor = "msg_claim_for"
+
+	// MsgTypeSetAutoClaim is the type of message Set Auto Claim
+	MsgTypeSetAutoClaim = "msg_set_auto_claim"
+
+	// MsgTypeUpdateRoles is the type of message Update Roles
+	MsgTypeUpdateRoles = "msg_update_roles"
+
+	// MsgTypeSetPauseSwitches is the type of message Set Pause Switches
+	MsgTypeSetPauseSwitches = "msg_set_pause_switches"
+
+	// MsgTypeRegisterHostProposal is the type of message Register Host Proposal
+	MsgTypeRegisterHostProposal = "msg_register_host_proposal"
+
+	// MsgTypeVoteHostProposal is the type of message Vote Host Proposal
+	MsgTypeVoteHostProposal = "msg_vote_host_proposal"
+
 	// DepositModuleAccount DepositModuleAccountName
 	DepositModuleAccount = ModuleName + "_pstake_deposit_account"
 
@@ -88,6 +108,12 @@ const (
 	// CosmosValOperPrefix is the prefix for cosmos validator address
 	CosmosValOperPrefix = "cosmosvaloper"
 
+	// CosmosAccountPrefix is the prefix for cosmos account address
+	CosmosAccountPrefix = "cosmos"
+
+	// CosmosValConsPrefix is the prefix for cosmos validator consensus address
+	CosmosValConsPrefix = "cosmosvalcons"
+
 	LiquidStakedDenomPrefix = "stk"
 )
 
@@ -98,33 +124,70 @@ var (
 	MaxPstakeUnstakeFee    = sdk.MustNewDecFromStr("0.5")
 	MaxPstakeRedemptionFee = sdk.MustNewDecFromStr("0.2")
 	MaxCValue              = sdk.MustNewDecFromStr("1.1")
-	RestakeCapPerDay       = sdk.MustNewDecFromStr("0.00069") //0.25185 or ~25% APY
 )
 
+// AutoClaimBatchSize is the maximum number of delegator entries of an epoch processed for auto claims in a block
+const AutoClaimBatchSize = 100
+
+// MaxHostProposalTallySignalsPerBlock is the number of vote signals after which no more host chain proposals are
+// tallied in a block, a proposal is always tallied in full in a single block
+const MaxHostProposalTallySignalsPerBlock = 1000
+
 var (
 	// PortKey defines the key to store the port ID in store
 
-	ModuleEnableKey                 = []byte{0x01} // key for module state
-	HostChainParamsKey              = []byte{0x02} // key for host chain params
-	AllowListedValidatorsKey        = []byte{0x03} // key for allow listed validators
-	DelegationStateKey              = []byte{0x04} // key for delegation state
-	HostChainRewardAddressKey       = []byte{0x05} // key for host chain address
-	IBCTransientStoreKey            = []byte{0x06} // key for IBC transient store
-	UnbondingEpochCValueKey         = []byte{0x07} // prefix for unbodning epoch c value store
-	DelegatorUnbondingEpochEntryKey = []byte{0x08} // prefix for delegator unbonding epoch entry
-	HostAccountsKey                 = []byte{0x09} // key for host accounts
+	ModuleEnableKey                   = []byte{0x01} // key for module state
+	HostChainParamsKey                = []byte{0x02} // key for host chain params
+	AllowListedValidatorsKey          = []byte{0x03} // key for allow listed validators
+	DelegationStateKey                = []byte{0x04} // key for delegation state
+	HostChainRewardAddressKey         = []byte{0x05} // key for host chain address
+	IBCTransientStoreKey              = []byte{0x06} // key for IBC transient store
+	UnbondingEpochCValueKey           = []byte{0x07} // prefix for unbodning epoch c value store
+	DelegatorUnbondingEpochEntryKey   = []byte{0x08} // prefix for delegator unbonding epoch entry
+	HostAccountsKey                   = []byte{0x09} // key for host accounts
+	AutoClaimKey                      = []byte{0x0A} // prefix for delegators opted in to auto claim
+	PendingAutoClaimEpochKey          = []byte{0x0B} // prefix for matured epochs pending auto claim
+	AddressDepositsKey                = []byte{0x0C} // prefix for total deposits per address
+	EpochDepositsKey                  = []byte{0x0D} // key for deposits of the current delegation epoch
+	FeeSplitKey                       = []byte{0x0E} // key for protocol fee split
+	CollectedFeesKey                  = []byte{0x0F} // prefix for cumulative protocol fees per fee type and recipient
+	RolesKey                          = []byte{0x10} // key for admin, pauser and slashing reporter roles
+	PendingAdminChangeKey             = []byte{0x11} // key for admin change waiting for the admin timelock
+	PauseSwitchesKey                  = []byte{0x12} // key for pause switches
+	HostAccountDelegationKey          = []byte{0x13} // prefix for host account delegations per validator
+	HostAccountUndelegationKey        = []byte{0x14} // prefix for host account undelegations per epoch
+	ValidatorMetricsKey               = []byte{0x15} // prefix for host chain metrics per allow listed validator
+	EffectiveAllowListedValidatorsKey = []byte{0x16} // key for the effective weights of the allow listed validators
+	CarriedOverRewardsKey             = []byte{0x17} // key for the rewards left in the rewards account
+	RewardEpochRecordKey              = []byte{0x18} // prefix for accrued and restaked rewards per reward epoch
+	HostProposalKey                   = []byte{0x19} // prefix for host chain proposals registered for vote signals
+	HostProposalVoteKey               = []byte{0x1A} // prefix for vote signals per host chain proposal and voter
+	UnbondingEpochDelegatorKey        = []byte{0x1B} // prefix for delegators with an unbonding epoch entry per epoch
+	PendingRewardEpochRecordKey       = []byte{0x1C} // key for the reward epoch record waiting for its ICA ack
+	SignalingHostProposalKey          = []byte{0x1D} // prefix for signaling host chain proposals by voting end time
 )
 
+// GetEpochNumberBytes returns the epoch number as fixed width big endian bytes, keys ending with it iterate
+// in epoch order
+func GetEpochNumberBytes(epochNumber int64) []byte {
+	return sdk.Uint64ToBigEndian(uint64(epochNumber))
+}
+
+// ParseEpochNumberBytes returns the epoch number encoded by GetEpochNumberBytes
+func ParseEpochNumberBytes(bz []byte) int64 {
+	return int64(sdk.BigEndianToUint64(bz))
+}
+
 // GetUnbondingEpochCValueKey returns a slice of byte made of UnbondingEpochCValueKey and epoch number
 // converted to bytes
 func GetUnbondingEpochCValueKey(epochNumber int64) []byte {
-	return append(UnbondingEpochCValueKey, []byte(strconv.FormatInt(epochNumber, 10))...)
+	return append(UnbondingEpochCValueKey, GetEpochNumberBytes(epochNumber)...)
 }
 
 // GetDelegatorUnbondingEpochEntryKey returns a slice of byte made of DelegatorUnbondingEpochEntryKey,
 // delegator address as bytes and epoch number converted to bytes
 func GetDelegatorUnbondingEpochEntryKey(delegatorAddress sdk.AccAddress, epochNumber int64) []byte {
-	return append(append(DelegatorUnbondingEpochEntryKey, address.MustLengthPrefix(delegatorAddress)...), []byte(strconv.FormatInt(epochNumber, 10))...)
+	return append(GetPartialDelegatorUnbondingEpochEntryKey(delegatorAddress), GetEpochNumberBytes(epochNumber)...)
 }
 
 // GetPartialDelegatorUnbondingEpochEntryKey returns a slice of byte made of DelegatorUnbondingEpochEntryKey
@@ -132,3 +195,91 @@ func GetDelegatorUnbondingEpochEntryKey(delegatorAddress sdk.AccAddress, epochNu
 func GetPartialDelegatorUnbondingEpochEntryKey(delegatorAddress sdk.AccAddress) []byte {
 	return append(DelegatorUnbondingEpochEntryKey, address.MustLengthPrefix(delegatorAddress)...)
 }
+
+// GetUnbondingEpochDelegatorKey returns a slice of byte made of UnbondingEpochDelegatorKey, epoch number
+// converted to bytes and delegator address as bytes
+func GetUnbondingEpochDelegatorKey(epochNumber int64, delegatorAddress sdk.AccAddress) []byte {
+	return append(GetUnbondingEpochDelegatorsKey(epochNumber), address.MustLengthPrefix(delegatorAddress)...)
+}
+
+// GetUnbondingEpochDelegatorsKey returns a slice of byte made of UnbondingEpochDelegatorKey and epoch number
+// converted to bytes
+func GetUnbondingEpochDelegatorsKey(epochNumber int64) []byte {
+	return append(UnbondingEpochDelegatorKey, GetEpochNumberBytes(epochNumber)...)
+}
+
+// GetHostAccountDelegationKey returns a slice of byte made of HostAccountDelegationKey and the host chain
+// validator address as bytes
+func GetHostAccountDelegationKey(validatorAddress string) []byte {
+	return append(HostAccountDelegationKey, address.MustLengthPrefix([]byte(validatorAddress))...)
+}
+
+// GetHostAccountUndelegationKey returns a slice of byte made of HostAccountUndelegationKey and epoch number
+// converted to bytes
+func GetHostAccountUndelegationKey(epochNumber int64) []byte {
+	return append(HostAccountUndelegationKey, GetEpochNumberBytes(epochNumber)...)
+}
+
+// GetHostProposalKey returns the key of the host chain proposal
+func GetHostProposalKey(proposalID uint64) []byte {
+	return append(HostProposalKey, sdk.Uint64ToBigEndian(proposalID)...)
+}
+
+// GetSignalingHostProposalKey returns the key indexing the signaling host chain proposal by its voting end time
+func GetSignalingHostProposalKey(votingEndTime time.Time, proposalID uint64) []byte {
+	return append(append(SignalingHostProposalKey, sdk.FormatTimeBytes(votingEndTime)...), sdk.Uint64ToBigEndian(proposalID)...)
+}
+
+// ParseSignalingHostProposalKey returns the proposal id of a key returned by GetSignalingHostProposalKey, without
+// the SignalingHostProposalKey prefix
+func ParseSignalingHostProposalKey(key []byte) uint64 {
+	return sdk.BigEndianToUint64(key[len(key)-8:])
+}
+
+// GetHostProposalVotesKey returns the prefix of the vote signals on the host chain proposal
+func GetHostProposalVotesKey(proposalID uint64) []byte {
+	return append(HostProposalVoteKey, sdk.Uint64ToBigEndian(proposalID)...)
+}
+
+// GetHostProposalVoteKey returns the key of the vote signal of the voter on the host chain proposal
+func GetHostProposalVoteKey(proposalID uint64, voter sdk.AccAddress) []byte {
+	return append(GetHostProposalVotesKey(proposalID), address.MustLengthPrefix(voter)...)
+}
+
+// GetRewardEpochRecordKey returns the key of the reward epoch record of the reward epoch
+func GetRewardEpochRecordKey(epochNumber int64) []byte {
+	return append(RewardEpochRecordKey, GetEpochNumberBytes(epochNumber)...)
+}
+
+// GetValidatorMetricsKey returns a slice of byte made of ValidatorMetricsKey and the host chain validator address
+// as bytes
+func GetValidatorMetricsKey(validatorAddress string) []byte {
+	return append(ValidatorMetricsKey, address.MustLengthPrefix([]byte(validatorAddress))...)
+}
+
+// GetAutoClaimKey returns a slice of byte made of AutoClaimKey and delegator address as bytes
+func GetAutoClaimKey(delegatorAddress sdk.AccAddress) []byte {
+	return append(AutoClaimKey, address.MustLengthPrefix(delegatorAddress)...)
+}
+
+// GetPendingAutoClaimEpochKey returns a slice of byte made of PendingAutoClaimEpochKey and epoch number
+// converted to bytes
+func GetPendingAutoClaimEpochKey(epochNumber int64) []byte {
+	return append(PendingAutoClaimEpochKey, GetEpochNumberBytes(epochNumber)...)
+}
+
+// GetAddressDepositsKey returns a slice of byte made of AddressDepositsKey and delegator address as bytes
+func GetAddressDepositsKey(delegatorAddress sdk.AccAddress) []byte {
+	return append(AddressDepositsKey, address.MustLengthPrefix(delegatorAddress)...)
+}
+
+// GetCollectedFeesKey returns a slice of byte made of CollectedFeesKey, fee type as bytes and
+// recipient address as bytes
+func GetCollectedFeesKey(feeType string, recipient sdk.AccAddress) []byte {
+	return append(GetPartialCollectedFeesKey(feeType), address.MustLengthPrefix(recipient)...)
+}
+
+// GetPartialCollectedFeesKey returns a slice of byte made of CollectedFeesKey and fee type as bytes
+func GetPartialCollectedFeesKey(feeType string) []byte {
+	return append(CollectedFeesKey, address.MustLengthPrefix([]byte(feeType))...)
+}
@@ -8,6 +8,7 @@ import (
 	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
+	v1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
@@ -30,6 +31,48 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// HostProposalStatus is the status of the lscosmos vote on a host chain
+// governance proposal
+type HostProposalStatus int32
+
+const (
+	// HOST_PROPOSAL_STATUS_SIGNALING accepts vote signals from stk holders
+	HOST_PROPOSAL_STATUS_SIGNALING HostProposalStatus = 0
+	// HOST_PROPOSAL_STATUS_VOTE_SENT has the weighted vote waiting for the
+	// delegator ICA acknowledgement
+	HOST_PROPOSAL_STATUS_VOTE_SENT HostProposalStatus = 1
+	// HOST_PROPOSAL_STATUS_VOTED has the weighted vote cast on the host chain
+	HOST_PROPOSAL_STATUS_VOTED HostProposalStatus = 2
+	// HOST_PROPOSAL_STATUS_FAILED has the weighted vote failed or timed out
+	HOST_PROPOSAL_STATUS_FAILED HostProposalStatus = 3
+	// HOST_PROPOSAL_STATUS_NO_SIGNAL had no stk backed signal, no vote is cast
+	HOST_PROPOSAL_STATUS_NO_SIGNAL HostProposalStatus = 4
+)
+
+var HostProposalStatus_name = map[int32]string{
+	0: "HOST_PROPOSAL_STATUS_SIGNALING",
+	1: "HOST_PROPOSAL_STATUS_VOTE_SENT",
+	2: "HOST_PROPOSAL_STATUS_VOTED",
+	3: "HOST_PROPOSAL_STATUS_FAILED",
+	4: "HOST_PROPOSAL_STATUS_NO_SIGNAL",
+}
+
+var HostProposalStatus_value = map[string]int32{
+	"HOST_PROPOSAL_STATUS_SIGNALING": 0,
+	"HOST_PROPOSAL_STATUS_VOTE_SENT": 1,
+	"HOST_PROPOSAL_STATUS_VOTED":     2,
+	"HOST_PROPOSAL_STATUS_FAILED":    3,
+	"HOST_PROPOSAL_STATUS_NO_SIGNAL": 4,
+}
+
+func (x HostProposalStatus) String() string {
+	return proto.EnumName(HostProposalStatus_name, int32(x))
+}
+
+func (HostProposalStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_4dec47e30da12f63, []int{0}
+}
+
 type AllowListedValidators struct {
 	AllowListedValidators []AllowListedValidator `protobuf:"bytes,1,rep,name=allow_listed_validators,json=allowListedValidators,proto3" json:"allow_listed_validators" yaml:"allow_lised_validators"`
 }
@@ -553,6 +596,46 @@ func (m *DelegatorUnbondingEpochEntry) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_DelegatorUnbondingEpochEntry proto.InternalMessageInfo
 
+// PendingAutoClaimEpoch is a matured epoch queued for auto claims with the last delegator processed, the next
+// batch resumes after it
+type PendingAutoClaimEpoch struct {
+	EpochNumber          int64  `protobuf:"varint,1,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
+	LastDelegatorAddress string `protobuf:"bytes,2,opt,name=last_delegator_address,json=lastDelegatorAddress,proto3" json:"last_delegator_address,omitempty"`
+}
+
+func (m *PendingAutoClaimEpoch) Reset()         { *m = PendingAutoClaimEpoch{} }
+func (m *PendingAutoClaimEpoch) String() string { return proto.CompactTextString(m) }
+func (*PendingAutoClaimEpoch) ProtoMessage()    {}
+func (*PendingAutoClaimEpoch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4dec47e30da12f63, []int{13}
+}
+func (m *PendingAutoClaimEpoch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingAutoClaimEpoch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingAutoClaimEpoch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingAutoClaimEpoch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingAutoClaimEpoch.Merge(m, src)
+}
+func (m *PendingAutoClaimEpoch) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingAutoClaimEpoch) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingAutoClaimEpoch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingAutoClaimEpoch proto.InternalMessageInfo
+
 type HostAccounts struct {
 	DelegatorAccountOwnerID string `protobuf:"bytes,1,opt,name=delegator_account_owner_i_d,json=delegatorAccountOwnerID,proto3" json:"delegator_account_owner_i_d,omitempty"`
 	RewardsAccountOwnerID   string `protobuf:"bytes,2,opt,name=rewards_account_owner_i_d,json=rewardsAccountOwnerID,proto3" json:"rewards_account_owner_i_d,omitempty"`
@@ -562,7 +645,7 @@ func (m *HostAccounts) Reset()         { *m = HostAccounts{} }
 func (m *HostAccounts) String() string { return proto.CompactTextString(m) }
 func (*HostAccounts) ProtoMessage()    {}
 func (*HostAccounts) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4dec47e30da12f63, []int{13}
+	return fileDescriptor_4dec47e30da12f63, []int{14}
 }
 func (m *HostAccounts) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -591,261 +674,809 @@ func (m *HostAccounts) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_HostAccounts proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*AllowListedValidators)(nil), "pstake.lscosmos.v1beta1.AllowListedValidators")
-	proto.RegisterType((*AllowListedValidator)(nil), "pstake.lscosmos.v1beta1.AllowListedValidator")
-	proto.RegisterType((*PstakeParams)(nil), "pstake.lscosmos.v1beta1.PstakeParams")
-	proto.RegisterType((*HostChainParams)(nil), "pstake.lscosmos.v1beta1.HostChainParams")
-	proto.RegisterType((*DelegationState)(nil), "pstake.lscosmos.v1beta1.DelegationState")
-	proto.RegisterType((*HostAccountDelegation)(nil), "pstake.lscosmos.v1beta1.HostAccountDelegation")
-	proto.RegisterType((*HostAccountUndelegation)(nil), "pstake.lscosmos.v1beta1.HostAccountUndelegation")
-	proto.RegisterType((*UndelegationEntry)(nil), "pstake.lscosmos.v1beta1.UndelegationEntry")
-	proto.RegisterType((*HostChainRewardAddress)(nil), "pstake.lscosmos.v1beta1.HostChainRewardAddress")
-	proto.RegisterType((*IBCAmountTransientStore)(nil), "pstake.lscosmos.v1beta1.IBCAmountTransientStore")
-	proto.RegisterType((*TransientUndelegationTransfer)(nil), "pstake.lscosmos.v1beta1.TransientUndelegationTransfer")
-	proto.RegisterType((*UnbondingEpochCValue)(nil), "pstake.lscosmos.v1beta1.UnbondingEpochCValue")
-	proto.RegisterType((*DelegatorUnbondingEpochEntry)(nil), "pstake.lscosmos.v1beta1.DelegatorUnbondingEpochEntry")
-	proto.RegisterType((*HostAccounts)(nil), "pstake.lscosmos.v1beta1.HostAccounts")
+type AddressDeposits struct {
+	Address string                                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Amount  github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
 }
 
-func init() {
-	proto.RegisterFile("pstake/lscosmos/v1beta1/lscosmos.proto", fileDescriptor_4dec47e30da12f63)
+func (m *AddressDeposits) Reset()         { *m = AddressDeposits{} }
+func (m *AddressDeposits) String() string { return proto.CompactTextString(m) }
+func (*AddressDeposits) ProtoMessage()    {}
+func (*AddressDeposits) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4dec47e30da12f63, []int{15}
+}
+func (m *AddressDeposits) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AddressDeposits) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AddressDeposits.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AddressDeposits) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddressDeposits.Merge(m, src)
+}
+func (m *AddressDeposits) XXX_Size() int {
+	return m.Size()
+}
+func (m *AddressDeposits) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddressDeposits.DiscardUnknown(m)
 }
 
-var fileDescriptor_4dec47e30da12f63 = []byte{
-	// 1356 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0xcf, 0x6f, 0x1b, 0xc5,
-	0x17, 0xcf, 0xc6, 0xf9, 0x36, 0xc9, 0xd8, 0x4d, 0xda, 0x6d, 0xd2, 0x38, 0x69, 0x63, 0xf7, 0xbb,
-	0xa5, 0x55, 0x40, 0x8a, 0xdd, 0x06, 0x04, 0xa8, 0xf4, 0x12, 0xdb, 0xad, 0x6a, 0xd1, 0xd2, 0x68,
-	0x9b, 0x16, 0x89, 0x0a, 0x8d, 0xc6, 0xbb, 0x13, 0x7b, 0xe8, 0xee, 0xcc, 0x6a, 0x67, 0x9c, 0x90,
-	0x1b, 0x27, 0x24, 0xa4, 0x1e, 0x2a, 0x4e, 0xe5, 0x80, 0xc4, 0x09, 0x21, 0xce, 0xfc, 0x03, 0xdc,
-	0x7a, 0x41, 0xaa, 0x38, 0x21, 0x24, 0x52, 0x48, 0x4f, 0x5c, 0x2b, 0xae, 0x48, 0x68, 0x7e, 0xec,
-	0x7a, 0x9d, 0xda, 0xc5, 0x41, 0x45, 0xe2, 0x94, 0xec, 0x7b, 0x6f, 0x3e, 0x9f, 0xcf, 0x7b, 0xf3,
-	0xe6, 0xcd, 0x18, 0x9c, 0x8f, 0xb8, 0x40, 0xf7, 0x70, 0x35, 0xe0, 0x1e, 0xe3, 0x21, 0xe3, 0xd5,
-	0xed, 0x8b, 0x2d, 0x2c, 0xd0, 0xc5, 0xd4, 0x50, 0x89, 0x62, 0x26, 0x98, 0xbd, 0xa0, 0xe3, 0x2a,
-	0xa9, 0xd9, 0xc4, 0x2d, 0xcd, 0xb5, 0x59, 0x9b, 0xa9, 0x98, 0xaa, 0xfc, 0x4f, 0x87, 0x2f, 0x95,
-	0x0c, 0x5a, 0x0b, 0x71, 0x9c, 0x42, 0x7a, 0x8c, 0x50, 0xe3, 0x2f, 0xb7, 0x19, 0x6b, 0x07, 0xb8,
-	0xaa, 0xbe, 0x5a, 0xdd, 0xad, 0xaa, 0x20, 0x21, 0xe6, 0x02, 0x85, 0x91, 0x09, 0x58, 0xd4, 0x00,
-	0x50, 0x23, 0x67, 0xa5, 0x38, 0x5f, 0x5b, 0x60, 0x7e, 0x3d, 0x08, 0xd8, 0xce, 0x75, 0xc2, 0x05,
-	0xf6, 0xef, 0xa0, 0x80, 0xf8, 0x48, 0xb0, 0x98, 0xdb, 0xf7, 0x2d, 0xb0, 0x80, 0xa4, 0x07, 0x06,
-	0xca, 0x05, 0xb7, 0x53, 0x5f, 0xd1, 0x3a, 0x93, 0x5b, 0xc9, 0xaf, 0xad, 0x56, 0x86, 0xe4, 0x51,
-	0x19, 0x84, 0x58, 0x3b, 0xf7, 0x68, 0xaf, 0x3c, 0xf6, 0x6c, 0xaf, 0xbc, 0xbc, 0x8b, 0xc2, 0xe0,
-	0x92, 0x93, 0x62, 0xf7, 0x41, 0x3b, 0xee, 0x3c, 0x1a, 0x24, 0xc7, 0xf9, 0xc3, 0x02, 0x73, 0x83,
-	0x60, 0x6d, 0x04, 0x8e, 0xa7, 0xcb, 0x21, 0xf2, 0xfd, 0x18, 0x73, 0x29, 0xd0, 0x5a, 0x99, 0xae,
-	0xbd, 0xf1, 0x6c, 0xaf, 0x5c, 0xd4, 0x6c, 0xcf, 0x85, 0x38, 0x3f, 0x7e, 0xb7, 0x3a, 0x67, 0x64,
-	0xaf, 0x6b, 0xd3, 0x2d, 0x11, 0x13, 0xda, 0x76, 0x8f, 0xa5, 0xb1, 0xc6, 0x6e, 0xef, 0x82, 0xa3,
-	0x02, 0xc5, 0x6d, 0x2c, 0xe0, 0x0e, 0x26, 0xed, 0x8e, 0x28, 0x8e, 0x2b, 0xf8, 0x4d, 0x99, 0xd0,
-	0xcf, 0x7b, 0xe5, 0xf3, 0x6d, 0x22, 0x3a, 0xdd, 0x56, 0xc5, 0x63, 0xa1, 0x29, 0xae, 0xf9, 0xb3,
-	0xca, 0xfd, 0x7b, 0x55, 0xb1, 0x1b, 0x61, 0x5e, 0x69, 0x60, 0xef, 0xd9, 0x5e, 0x79, 0x4e, 0x8b,
-	0xe9, 0x03, 0x93, 0x42, 0x80, 0x11, 0xd2, 0xc0, 0x9e, 0x5b, 0xd0, 0xde, 0xf7, 0xb5, 0xf3, 0xfe,
-	0x04, 0x28, 0x6c, 0xa8, 0x2a, 0x6f, 0xa0, 0x18, 0x85, 0xdc, 0xfe, 0x08, 0xd8, 0xba, 0xea, 0xd0,
-	0xc7, 0x11, 0xe3, 0x44, 0xc0, 0x2d, 0x8c, 0x4d, 0xbe, 0x97, 0x0f, 0x27, 0xe8, 0x00, 0xf1, 0x31,
-	0x8d, 0xdb, 0xd0, 0xb0, 0x57, 0x31, 0xce, 0x70, 0xc5, 0x58, 0xff, 0x95, 0x5c, 0xe3, 0x2f, 0x8f,
-	0xcb, 0xd5, 0xb0, 0xfd, 0x5c, 0x5d, 0xda, 0xe3, 0xca, 0xbd, 0x3c, 0xae, 0xdb, 0x34, 0xe5, 0x8a,
-	0xc0, 0x7c, 0x9a, 0x97, 0x8f, 0xc3, 0x48, 0x10, 0x46, 0x15, 0xdd, 0xc4, 0x4b, 0xa0, 0x3b, 0x91,
-	0xa4, 0x96, 0x20, 0x4b, 0xc6, 0xab, 0x69, 0x76, 0x5b, 0x18, 0xa7, 0x5d, 0xfa, 0x3f, 0x45, 0x57,
-	0x1c, 0xde, 0x89, 0x51, 0x22, 0xd9, 0xd8, 0x9d, 0x87, 0x39, 0x30, 0x7b, 0x8d, 0x71, 0x51, 0xef,
-	0x20, 0x42, 0x4d, 0x47, 0x2c, 0x81, 0x69, 0x4f, 0x7e, 0x42, 0x02, 0x7d, 0xdd, 0x08, 0xee, 0xa4,
-	0x32, 0x34, 0x1b, 0xf6, 0x2b, 0x60, 0xc6, 0x63, 0x94, 0x62, 0x4f, 0xa5, 0x28, 0x03, 0xd4, 0xee,
-	0xb9, 0x85, 0x9e, 0xb5, 0xd9, 0xb0, 0x5f, 0x05, 0xc7, 0x44, 0x8c, 0x28, 0xdf, 0xc2, 0x31, 0xf4,
-	0x3a, 0x88, 0x52, 0x1c, 0xe8, 0xca, 0xbb, 0xb3, 0x89, 0xbd, 0xae, 0xcd, 0xf6, 0x59, 0x70, 0x34,
-	0x0d, 0x8d, 0x58, 0x2c, 0x74, 0xc9, 0xdc, 0x42, 0x62, 0xdc, 0x60, 0xb1, 0xb0, 0x97, 0x01, 0x90,
-	0xb3, 0x0a, 0xfa, 0x98, 0xb2, 0x50, 0x67, 0xe9, 0x4e, 0x4b, 0x4b, 0x43, 0x1a, 0xa4, 0x3b, 0x24,
-	0x54, 0x18, 0xf7, 0x11, 0xed, 0x96, 0x16, 0xed, 0xfe, 0x10, 0xe4, 0x43, 0x42, 0x93, 0xf6, 0x2e,
-	0x4e, 0x1e, 0x7a, 0x4f, 0x9a, 0x54, 0x64, 0xf6, 0xa4, 0x49, 0x85, 0x2b, 0xf9, 0x4c, 0x5f, 0xdb,
-	0x1b, 0xe0, 0xa8, 0xd9, 0x8a, 0x48, 0xd5, 0xaf, 0x38, 0x75, 0xc6, 0x5a, 0xc9, 0xaf, 0x9d, 0x1b,
-	0x3a, 0xcc, 0xb2, 0xc7, 0xaf, 0x36, 0x21, 0x75, 0xb8, 0x85, 0x28, 0x63, 0xbb, 0x34, 0xf1, 0xf0,
-	0xab, 0xb2, 0xe5, 0xfc, 0x9e, 0x03, 0xb3, 0x0d, 0x1c, 0xe0, 0x36, 0x92, 0x55, 0xbd, 0x25, 0x90,
-	0xc0, 0xf6, 0xe7, 0x16, 0x28, 0x77, 0x18, 0x97, 0xa9, 0x26, 0x0e, 0x88, 0x3c, 0x8f, 0x75, 0xa9,
-	0x80, 0x2d, 0x14, 0x20, 0xea, 0x61, 0x33, 0x4b, 0x17, 0x2b, 0x86, 0x55, 0x96, 0x29, 0xa5, 0xae,
-	0x33, 0x42, 0x6b, 0x17, 0x24, 0xe5, 0xb7, 0x4f, 0xca, 0x2b, 0x23, 0xa4, 0x2e, 0x17, 0x70, 0xf7,
-	0xb4, 0xe4, 0xec, 0x69, 0x59, 0xd7, 0x8c, 0x35, 0x4d, 0x68, 0xdf, 0x05, 0xcb, 0x4a, 0x93, 0x6e,
-	0x9a, 0xac, 0x32, 0xd3, 0x96, 0xe3, 0x7f, 0xd3, 0x96, 0x4b, 0x9d, 0xa4, 0x03, 0x33, 0x1c, 0x66,
-	0x54, 0x52, 0x50, 0x54, 0xe0, 0x49, 0x96, 0x3d, 0x78, 0x5e, 0xcc, 0xa9, 0x4c, 0x2b, 0x43, 0x0b,
-	0x2d, 0x1b, 0xdb, 0x68, 0xed, 0x01, 0x9b, 0x8a, 0x9f, 0xec, 0x0c, 0x72, 0x72, 0x5b, 0x80, 0xa5,
-	0x3e, 0xbe, 0x2e, 0xcd, 0x32, 0x4e, 0x28, 0xc6, 0x0b, 0xa3, 0x30, 0xde, 0xce, 0x2c, 0x34, 0x9c,
-	0xc5, 0xce, 0x60, 0x37, 0x77, 0xbe, 0xb4, 0xc0, 0xfc, 0x40, 0xb5, 0xf6, 0x95, 0xe1, 0xb7, 0x51,
-	0xf1, 0x10, 0x37, 0xce, 0x5b, 0xe0, 0x08, 0x0a, 0x25, 0xb4, 0xda, 0x8c, 0x17, 0xb6, 0x87, 0xd6,
-	0x6a, 0xc2, 0x4d, 0x2f, 0xfe, 0x30, 0x0e, 0x16, 0x86, 0xe4, 0x66, 0xff, 0x1f, 0x14, 0x70, 0xc4,
-	0xbc, 0x0e, 0xa4, 0xdd, 0xb0, 0x85, 0x63, 0x25, 0x2e, 0xe7, 0xe6, 0x95, 0xed, 0x3d, 0x65, 0xb2,
-	0xef, 0x82, 0x45, 0xc1, 0x04, 0x0a, 0xfa, 0xaa, 0x09, 0x0f, 0x27, 0x68, 0x41, 0x21, 0x64, 0x99,
-	0xd7, 0xd5, 0x7a, 0xfb, 0x06, 0x98, 0xf5, 0x58, 0x18, 0x05, 0x58, 0x81, 0xca, 0xa7, 0x8a, 0x9a,
-	0x35, 0xf9, 0xb5, 0xa5, 0x8a, 0x7e, 0xc7, 0x54, 0x92, 0x77, 0x4c, 0x65, 0x33, 0x79, 0xc7, 0xd4,
-	0xa6, 0x24, 0xe6, 0x83, 0x27, 0x65, 0xcb, 0x9d, 0xe9, 0x2d, 0x96, 0x6e, 0xdb, 0x03, 0x73, 0x7d,
-	0x2a, 0x31, 0x15, 0x31, 0xc1, 0xc9, 0xd6, 0xbf, 0x36, 0x74, 0xeb, 0xb3, 0xca, 0xae, 0x50, 0x11,
-	0xef, 0x1a, 0xdd, 0x27, 0xba, 0x07, 0x1c, 0x04, 0x73, 0xe7, 0x0b, 0x0b, 0x1c, 0x7f, 0x6e, 0xc1,
-	0x7f, 0x64, 0xaf, 0xaf, 0x83, 0x93, 0xe9, 0x8d, 0xe0, 0xe2, 0x1d, 0x14, 0xfb, 0x09, 0xf0, 0x1a,
-	0x98, 0x1c, 0x55, 0x55, 0x12, 0xe8, 0xfc, 0x32, 0x0e, 0x16, 0x9a, 0xb5, 0xba, 0xde, 0xab, 0x4d,
-	0x39, 0xd4, 0x09, 0xa6, 0xe2, 0x96, 0x60, 0xb1, 0xbc, 0x36, 0x67, 0x08, 0x6c, 0x41, 0x0f, 0x26,
-	0xc3, 0xfe, 0xdf, 0x98, 0x5d, 0x79, 0x52, 0xab, 0x6f, 0x1a, 0x7c, 0xbb, 0x21, 0x19, 0x3d, 0x88,
-	0x92, 0x31, 0x82, 0x47, 0x2d, 0x51, 0x9e, 0xd4, 0xd7, 0xcd, 0xa9, 0xc4, 0xf6, 0x67, 0x16, 0x38,
-	0x9b, 0xee, 0x2a, 0xa3, 0xd0, 0x74, 0x10, 0x86, 0x07, 0xb2, 0xd1, 0xf3, 0xe9, 0xcd, 0xa1, 0x2d,
-	0x93, 0x96, 0x23, 0xdb, 0x0a, 0x89, 0x56, 0x43, 0x5c, 0xca, 0x10, 0xd5, 0x0d, 0x4f, 0xb3, 0x97,
-	0x91, 0x73, 0xdf, 0x02, 0xcb, 0x2f, 0xc4, 0x19, 0xe5, 0x7c, 0x5e, 0x03, 0xb3, 0xba, 0x05, 0x60,
-	0x97, 0xb6, 0x18, 0xf5, 0xb1, 0x3f, 0x6a, 0x5d, 0x66, 0xf4, 0xba, 0xdb, 0x66, 0x99, 0xf3, 0xa7,
-	0x05, 0xe6, 0xf4, 0x07, 0xa1, 0xed, 0x2b, 0x92, 0xa2, 0x7e, 0x07, 0x05, 0x5d, 0x3c, 0x8a, 0x8a,
-	0xcb, 0x00, 0x70, 0x28, 0xe0, 0x3d, 0xd8, 0xea, 0xc6, 0x74, 0x54, 0x01, 0x93, 0x7c, 0xf3, 0xdd,
-	0x5a, 0x37, 0xa6, 0x83, 0x72, 0xc8, 0xfd, 0xa3, 0x1c, 0xe4, 0x73, 0x82, 0x70, 0x18, 0x22, 0xd1,
-	0x8d, 0xb1, 0xaf, 0xde, 0x23, 0x53, 0xee, 0x34, 0xe1, 0x37, 0xb4, 0xc1, 0x3e, 0x05, 0xa6, 0x09,
-	0x87, 0x5b, 0x88, 0x04, 0xd8, 0x57, 0x6f, 0x91, 0x29, 0x77, 0x8a, 0xf0, 0xab, 0xea, 0xdb, 0xf9,
-	0xde, 0x02, 0xa7, 0x4d, 0x9f, 0xb0, 0xb8, 0xbf, 0x10, 0xe9, 0x19, 0x4f, 0xf6, 0xf3, 0x10, 0x67,
-	0x3c, 0x5d, 0x92, 0x1c, 0xc5, 0x83, 0xe5, 0x1c, 0x7f, 0xbe, 0x9c, 0xbd, 0x31, 0x90, 0x3b, 0xd4,
-	0x18, 0x70, 0x3e, 0xb5, 0x40, 0x21, 0x33, 0xec, 0xb9, 0x7d, 0x19, 0x9c, 0xca, 0x68, 0x36, 0x17,
-	0x23, 0xdb, 0xa1, 0x38, 0xce, 0x3c, 0x11, 0x17, 0x7a, 0x1a, 0x75, 0xc4, 0x4d, 0x19, 0xd0, 0x6c,
-	0xd8, 0x6f, 0x83, 0xc5, 0x58, 0x8d, 0x11, 0x3e, 0x60, 0xad, 0x7e, 0x3d, 0xce, 0x9b, 0x80, 0xfe,
-	0x95, 0x35, 0xf4, 0xe8, 0xb7, 0xd2, 0xd8, 0x27, 0xfb, 0xa5, 0xb1, 0x6f, 0xf6, 0x4b, 0xd6, 0xa3,
-	0xfd, 0x92, 0xf5, 0x78, 0xbf, 0x64, 0xfd, 0xba, 0x5f, 0xb2, 0x1e, 0x3c, 0x2d, 0x8d, 0x3d, 0x7e,
-	0x5a, 0x1a, 0xfb, 0xe9, 0x69, 0x69, 0xec, 0x83, 0x77, 0x32, 0xa3, 0x20, 0xc2, 0x31, 0x97, 0x3f,
-	0xe7, 0xa8, 0x87, 0x6f, 0x52, 0x5c, 0xd5, 0x07, 0x6f, 0x95, 0x22, 0x41, 0xb6, 0x71, 0x75, 0x7b,
-	0xad, 0xfa, 0x71, 0xef, 0xa7, 0xb4, 0x9a, 0x11, 0xad, 0x23, 0xea, 0x6e, 0x78, 0xfd, 0xaf, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0xb6, 0x40, 0xba, 0xc3, 0x6a, 0x0f, 0x00, 0x00,
+var xxx_messageInfo_AddressDeposits proto.InternalMessageInfo
+
+type EpochDeposits struct {
+	EpochNumber int64                                  `protobuf:"varint,1,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
+	Amount      github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
 }
 
-func (this *AllowListedValidators) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *EpochDeposits) Reset()         { *m = EpochDeposits{} }
+func (m *EpochDeposits) String() string { return proto.CompactTextString(m) }
+func (*EpochDeposits) ProtoMessage()    {}
+func (*EpochDeposits) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4dec47e30da12f63, []int{16}
+}
+func (m *EpochDeposits) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EpochDeposits) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EpochDeposits.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
+}
+func (m *EpochDeposits) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EpochDeposits.Merge(m, src)
+}
+func (m *EpochDeposits) XXX_Size() int {
+	return m.Size()
+}
+func (m *EpochDeposits) XXX_DiscardUnknown() {
+	xxx_messageInfo_EpochDeposits.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*AllowListedValidators)
-	if !ok {
-		that2, ok := that.(AllowListedValidators)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+var xxx_messageInfo_EpochDeposits proto.InternalMessageInfo
+
+// FeeRecipient is a recipient of a share of a protocol fee
+type FeeRecipient struct {
+	Address string                                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Weight  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=weight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"weight"`
+}
+
+func (m *FeeRecipient) Reset()         { *m = FeeRecipient{} }
+func (m *FeeRecipient) String() string { return proto.CompactTextString(m) }
+func (*FeeRecipient) ProtoMessage()    {}
+func (*FeeRecipient) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4dec47e30da12f63, []int{17}
+}
+func (m *FeeRecipient) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeRecipient) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeRecipient.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if len(this.AllowListedValidators) != len(that1.AllowListedValidators) {
-		return false
-	}
-	for i := range this.AllowListedValidators {
-		if !this.AllowListedValidators[i].Equal(&that1.AllowListedValidators[i]) {
-			return false
+}
+func (m *FeeRecipient) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeRecipient.Merge(m, src)
+}
+func (m *FeeRecipient) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeRecipient) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeRecipient.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeRecipient proto.InternalMessageInfo
+
+// FeeSplit defines the weighted recipients of each protocol fee type, fees of
+// a type without recipients are sent to the pstake fee address
+type FeeSplit struct {
+	DepositFeeRecipients    []FeeRecipient `protobuf:"bytes,1,rep,name=deposit_fee_recipients,json=depositFeeRecipients,proto3" json:"deposit_fee_recipients"`
+	RestakeFeeRecipients    []FeeRecipient `protobuf:"bytes,2,rep,name=restake_fee_recipients,json=restakeFeeRecipients,proto3" json:"restake_fee_recipients"`
+	UnstakeFeeRecipients    []FeeRecipient `protobuf:"bytes,3,rep,name=unstake_fee_recipients,json=unstakeFeeRecipients,proto3" json:"unstake_fee_recipients"`
+	RedemptionFeeRecipients []FeeRecipient `protobuf:"bytes,4,rep,name=redemption_fee_recipients,json=redemptionFeeRecipients,proto3" json:"redemption_fee_recipients"`
+}
+
+func (m *FeeSplit) Reset()         { *m = FeeSplit{} }
+func (m *FeeSplit) String() string { return proto.CompactTextString(m) }
+func (*FeeSplit) ProtoMessage()    {}
+func (*FeeSplit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4dec47e30da12f63, []int{18}
+}
+func (m *FeeSplit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeSplit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeSplit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return true
 }
-func (this *AllowListedValidator) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *FeeSplit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeSplit.Merge(m, src)
+}
+func (m *FeeSplit) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeSplit) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeSplit.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*AllowListedValidator)
-	if !ok {
-		that2, ok := that.(AllowListedValidator)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+var xxx_messageInfo_FeeSplit proto.InternalMessageInfo
+
+// CollectedFee is the cumulative amount of a protocol fee type sent to a
+// recipient
+type CollectedFee struct {
+	FeeType   string                                   `protobuf:"bytes,1,opt,name=fee_type,json=feeType,proto3" json:"fee_type,omitempty"`
+	Recipient string                                   `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	Amount    github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *CollectedFee) Reset()         { *m = CollectedFee{} }
+func (m *CollectedFee) String() string { return proto.CompactTextString(m) }
+func (*CollectedFee) ProtoMessage()    {}
+func (*CollectedFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4dec47e30da12f63, []int{19}
+}
+func (m *CollectedFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CollectedFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CollectedFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.ValidatorAddress != that1.ValidatorAddress {
-		return false
-	}
-	if !this.TargetWeight.Equal(that1.TargetWeight) {
-		return false
-	}
-	return true
 }
-func (this *PstakeParams) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *CollectedFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CollectedFee.Merge(m, src)
+}
+func (m *CollectedFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *CollectedFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_CollectedFee.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*PstakeParams)
-	if !ok {
-		that2, ok := that.(PstakeParams)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+var xxx_messageInfo_CollectedFee proto.InternalMessageInfo
+
+// Roles defines the addresses allowed to perform privileged operations, every
+// role accepts several addresses and any of them can be an x/group policy
+// account
+type Roles struct {
+	// admins can jump start the module, enable it and update the roles
+	Admins []string `protobuf:"bytes,1,rep,name=admins,proto3" json:"admins,omitempty"`
+	// pausers can disable the module
+	Pausers []string `protobuf:"bytes,2,rep,name=pausers,proto3" json:"pausers,omitempty"`
+	// slashing_reporters can report slashing on host chain validators
+	SlashingReporters []string `protobuf:"bytes,3,rep,name=slashing_reporters,json=slashingReporters,proto3" json:"slashing_reporters,omitempty"`
+}
+
+func (m *Roles) Reset()         { *m = Roles{} }
+func (m *Roles) String() string { return proto.CompactTextString(m) }
+func (*Roles) ProtoMessage()    {}
+func (*Roles) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4dec47e30da12f63, []int{20}
+}
+func (m *Roles) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Roles) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Roles.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.PstakeDepositFee.Equal(that1.PstakeDepositFee) {
-		return false
-	}
-	if !this.PstakeRestakeFee.Equal(that1.PstakeRestakeFee) {
-		return false
-	}
-	if !this.PstakeUnstakeFee.Equal(that1.PstakeUnstakeFee) {
-		return false
-	}
-	if !this.PstakeRedemptionFee.Equal(that1.PstakeRedemptionFee) {
-		return false
-	}
-	if this.PstakeFeeAddress != that1.PstakeFeeAddress {
-		return false
-	}
-	return true
 }
-func (this *HostChainParams) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *Roles) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Roles.Merge(m, src)
+}
+func (m *Roles) XXX_Size() int {
+	return m.Size()
+}
+func (m *Roles) XXX_DiscardUnknown() {
+	xxx_messageInfo_Roles.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*HostChainParams)
-	if !ok {
-		that2, ok := that.(HostChainParams)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+var xxx_messageInfo_Roles proto.InternalMessageInfo
+
+// PendingAdminChange is an admin role change waiting for the admin timelock
+// to expire
+type PendingAdminChange struct {
+	Admins        []string  `protobuf:"bytes,1,rep,name=admins,proto3" json:"admins,omitempty"`
+	EffectiveTime time.Time `protobuf:"bytes,2,opt,name=effective_time,json=effectiveTime,proto3,stdtime" json:"effective_time"`
+}
+
+func (m *PendingAdminChange) Reset()         { *m = PendingAdminChange{} }
+func (m *PendingAdminChange) String() string { return proto.CompactTextString(m) }
+func (*PendingAdminChange) ProtoMessage()    {}
+func (*PendingAdminChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4dec47e30da12f63, []int{21}
+}
+func (m *PendingAdminChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingAdminChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingAdminChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.ChainID != that1.ChainID {
-		return false
-	}
-	if this.ConnectionID != that1.ConnectionID {
-		return false
+}
+func (m *PendingAdminChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingAdminChange.Merge(m, src)
+}
+func (m *PendingAdminChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingAdminChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingAdminChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingAdminChange proto.InternalMessageInfo
+
+// PauseSwitches defines the independent pause switches of the module, a true
+// value pauses the operation
+type PauseSwitches struct {
+	Deposits          bool `protobuf:"varint,1,opt,name=deposits,proto3" json:"deposits,omitempty"`
+	Unstakes          bool `protobuf:"varint,2,opt,name=unstakes,proto3" json:"unstakes,omitempty"`
+	Redeems           bool `protobuf:"varint,3,opt,name=redeems,proto3" json:"redeems,omitempty"`
+	Claims            bool `protobuf:"varint,4,opt,name=claims,proto3" json:"claims,omitempty"`
+	DelegationEpoch   bool `protobuf:"varint,5,opt,name=delegation_epoch,json=delegationEpoch,proto3" json:"delegation_epoch,omitempty"`
+	RewardEpoch       bool `protobuf:"varint,6,opt,name=reward_epoch,json=rewardEpoch,proto3" json:"reward_epoch,omitempty"`
+	UndelegationEpoch bool `protobuf:"varint,7,opt,name=undelegation_epoch,json=undelegationEpoch,proto3" json:"undelegation_epoch,omitempty"`
+	HostVotes         bool `protobuf:"varint,8,opt,name=host_votes,json=hostVotes,proto3" json:"host_votes,omitempty"`
+}
+
+func (m *PauseSwitches) Reset()         { *m = PauseSwitches{} }
+func (m *PauseSwitches) String() string { return proto.CompactTextString(m) }
+func (*PauseSwitches) ProtoMessage()    {}
+func (*PauseSwitches) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4dec47e30da12f63, []int{22}
+}
+func (m *PauseSwitches) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PauseSwitches) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PauseSwitches.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if this.TransferChannel != that1.TransferChannel {
-		return false
+}
+func (m *PauseSwitches) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PauseSwitches.Merge(m, src)
+}
+func (m *PauseSwitches) XXX_Size() int {
+	return m.Size()
+}
+func (m *PauseSwitches) XXX_DiscardUnknown() {
+	xxx_messageInfo_PauseSwitches.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PauseSwitches proto.InternalMessageInfo
+
+// ValidatorMetrics are the host chain metrics of an allow listed validator
+// fetched over interchain queries, used to compute its effective weight
+type ValidatorMetrics struct {
+	ValidatorAddress string                                 `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	ConsensusAddress string                                 `protobuf:"bytes,2,opt,name=consensus_address,json=consensusAddress,proto3" json:"consensus_address,omitempty"`
+	Commission       github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=commission,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"commission"`
+	Jailed           bool                                   `protobuf:"varint,4,opt,name=jailed,proto3" json:"jailed,omitempty"`
+	Tombstoned       bool                                   `protobuf:"varint,5,opt,name=tombstoned,proto3" json:"tombstoned,omitempty"`
+	Bonded           bool                                   `protobuf:"varint,6,opt,name=bonded,proto3" json:"bonded,omitempty"`
+	// tokens is the voting power of the validator
+	Tokens github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,7,opt,name=tokens,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"tokens"`
+	// self_bond is the amount the validator operator delegates to itself
+	SelfBond github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,8,opt,name=self_bond,json=selfBond,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"self_bond"`
+	// updated_height is the height the validator query response was received at
+	UpdatedHeight int64 `protobuf:"varint,9,opt,name=updated_height,json=updatedHeight,proto3" json:"updated_height,omitempty"`
+}
+
+func (m *ValidatorMetrics) Reset()         { *m = ValidatorMetrics{} }
+func (m *ValidatorMetrics) String() string { return proto.CompactTextString(m) }
+func (*ValidatorMetrics) ProtoMessage()    {}
+func (*ValidatorMetrics) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4dec47e30da12f63, []int{23}
+}
+func (m *ValidatorMetrics) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorMetrics) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorMetrics.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if this.TransferPort != that1.TransferPort {
-		return false
+}
+func (m *ValidatorMetrics) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorMetrics.Merge(m, src)
+}
+func (m *ValidatorMetrics) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorMetrics) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorMetrics.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorMetrics proto.InternalMessageInfo
+
+// ValidatorWeight is the governance base weight and the effective weight used
+// for delegations of an allow listed validator
+type ValidatorWeight struct {
+	ValidatorAddress string                                 `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	BaseWeight       github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=base_weight,json=baseWeight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"base_weight"`
+	EffectiveWeight  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=effective_weight,json=effectiveWeight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"effective_weight"`
+	Metrics          *ValidatorMetrics                      `protobuf:"bytes,4,opt,name=metrics,proto3" json:"metrics,omitempty"`
+}
+
+func (m *ValidatorWeight) Reset()         { *m = ValidatorWeight{} }
+func (m *ValidatorWeight) String() string { return proto.CompactTextString(m) }
+func (*ValidatorWeight) ProtoMessage()    {}
+func (*ValidatorWeight) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4dec47e30da12f63, []int{24}
+}
+func (m *ValidatorWeight) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorWeight) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorWeight.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if this.BaseDenom != that1.BaseDenom {
-		return false
+}
+func (m *ValidatorWeight) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorWeight.Merge(m, src)
+}
+func (m *ValidatorWeight) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorWeight) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorWeight.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorWeight proto.InternalMessageInfo
+
+// RewardEpochRecord is the rewards of the rewards account handled in a reward
+// epoch
+type RewardEpochRecord struct {
+	EpochNumber int64 `protobuf:"varint,1,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
+	// accrued is the rewards withdrawn since the previous reward epoch
+	Accrued types.Coin `protobuf:"bytes,2,opt,name=accrued,proto3" json:"accrued"`
+	// restaked is the rewards sent to the delegation account
+	Restaked types.Coin `protobuf:"bytes,3,opt,name=restaked,proto3" json:"restaked"`
+	// sent_to_insurance_fund is the excess rewards sent to the insurance fund
+	SentToInsuranceFund types.Coin `protobuf:"bytes,4,opt,name=sent_to_insurance_fund,json=sentToInsuranceFund,proto3" json:"sent_to_insurance_fund"`
+	// carried_over is the rewards left in the rewards account for the next
+	// reward epochs
+	CarriedOver        types.Coin         `protobuf:"bytes,5,opt,name=carried_over,json=carriedOver,proto3" json:"carried_over"`
+	ExcessRewardPolicy ExcessRewardPolicy `protobuf:"varint,6,opt,name=excess_reward_policy,json=excessRewardPolicy,proto3,enum=pstake.lscosmos.v1beta1.ExcessRewardPolicy" json:"excess_reward_policy,omitempty"`
+}
+
+func (m *RewardEpochRecord) Reset()         { *m = RewardEpochRecord{} }
+func (m *RewardEpochRecord) String() string { return proto.CompactTextString(m) }
+func (*RewardEpochRecord) ProtoMessage()    {}
+func (*RewardEpochRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4dec47e30da12f63, []int{25}
+}
+func (m *RewardEpochRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RewardEpochRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RewardEpochRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if this.MintDenom != that1.MintDenom {
-		return false
+}
+func (m *RewardEpochRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RewardEpochRecord.Merge(m, src)
+}
+func (m *RewardEpochRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *RewardEpochRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_RewardEpochRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RewardEpochRecord proto.InternalMessageInfo
+
+// HostProposal is a host chain governance proposal registered for stk holders
+// to signal votes on
+type HostProposal struct {
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// voting_end_time is the end of the host chain voting period
+	VotingEndTime time.Time          `protobuf:"bytes,2,opt,name=voting_end_time,json=votingEndTime,proto3,stdtime" json:"voting_end_time"`
+	Status        HostProposalStatus `protobuf:"varint,3,opt,name=status,proto3,enum=pstake.lscosmos.v1beta1.HostProposalStatus" json:"status,omitempty"`
+	// snapshot_height is the height the signals were tallied at with the stk
+	// balances of the voters, the signals are tallied in a single block
+	SnapshotHeight int64             `protobuf:"varint,4,opt,name=snapshot_height,json=snapshotHeight,proto3" json:"snapshot_height,omitempty"`
+	Tally          HostProposalTally `protobuf:"bytes,5,opt,name=tally,proto3" json:"tally"`
+	// options are the options of the weighted vote sent to the host chain
+	Options []v1beta1.WeightedVoteOption `protobuf:"bytes,6,rep,name=options,proto3" json:"options"`
+}
+
+func (m *HostProposal) Reset()         { *m = HostProposal{} }
+func (m *HostProposal) String() string { return proto.CompactTextString(m) }
+func (*HostProposal) ProtoMessage()    {}
+func (*HostProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4dec47e30da12f63, []int{26}
+}
+func (m *HostProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HostProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HostProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if !this.MinDeposit.Equal(that1.MinDeposit) {
-		return false
+}
+func (m *HostProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HostProposal.Merge(m, src)
+}
+func (m *HostProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *HostProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_HostProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HostProposal proto.InternalMessageInfo
+
+// HostProposalTally is the stk balance backing every vote option of the
+// signals on a host chain proposal
+type HostProposalTally struct {
+	Yes        github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=yes,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"yes"`
+	Abstain    github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=abstain,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"abstain"`
+	No         github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=no,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"no"`
+	NoWithVeto github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=no_with_veto,json=noWithVeto,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"no_with_veto"`
+}
+
+func (m *HostProposalTally) Reset()         { *m = HostProposalTally{} }
+func (m *HostProposalTally) String() string { return proto.CompactTextString(m) }
+func (*HostProposalTally) ProtoMessage()    {}
+func (*HostProposalTally) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4dec47e30da12f63, []int{27}
+}
+func (m *HostProposalTally) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HostProposalTally) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HostProposalTally.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if !this.PstakeParams.Equal(&that1.PstakeParams) {
-		return false
+}
+func (m *HostProposalTally) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HostProposalTally.Merge(m, src)
+}
+func (m *HostProposalTally) XXX_Size() int {
+	return m.Size()
+}
+func (m *HostProposalTally) XXX_DiscardUnknown() {
+	xxx_messageInfo_HostProposalTally.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HostProposalTally proto.InternalMessageInfo
+
+// HostProposalVote is the vote signal of an stk holder on a host chain proposal
+type HostProposalVote struct {
+	ProposalId uint64                       `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	Voter      string                       `protobuf:"bytes,2,opt,name=voter,proto3" json:"voter,omitempty"`
+	Options    []v1beta1.WeightedVoteOption `protobuf:"bytes,3,rep,name=options,proto3" json:"options"`
+}
+
+func (m *HostProposalVote) Reset()         { *m = HostProposalVote{} }
+func (m *HostProposalVote) String() string { return proto.CompactTextString(m) }
+func (*HostProposalVote) ProtoMessage()    {}
+func (*HostProposalVote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4dec47e30da12f63, []int{28}
+}
+func (m *HostProposalVote) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HostProposalVote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HostProposalVote.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return true
 }
-func (this *DelegationState) Equal(that interface{}) bool {
+func (m *HostProposalVote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HostProposalVote.Merge(m, src)
+}
+func (m *HostProposalVote) XXX_Size() int {
+	return m.Size()
+}
+func (m *HostProposalVote) XXX_DiscardUnknown() {
+	xxx_messageInfo_HostProposalVote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HostProposalVote proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterEnum("pstake.lscosmos.v1beta1.HostProposalStatus", HostProposalStatus_name, HostProposalStatus_value)
+	proto.RegisterType((*AllowListedValidators)(nil), "pstake.lscosmos.v1beta1.AllowListedValidators")
+	proto.RegisterType((*AllowListedValidator)(nil), "pstake.lscosmos.v1beta1.AllowListedValidator")
+	proto.RegisterType((*PstakeParams)(nil), "pstake.lscosmos.v1beta1.PstakeParams")
+	proto.RegisterType((*HostChainParams)(nil), "pstake.lscosmos.v1beta1.HostChainParams")
+	proto.RegisterType((*DelegationState)(nil), "pstake.lscosmos.v1beta1.DelegationState")
+	proto.RegisterType((*HostAccountDelegation)(nil), "pstake.lscosmos.v1beta1.HostAccountDelegation")
+	proto.RegisterType((*HostAccountUndelegation)(nil), "pstake.lscosmos.v1beta1.HostAccountUndelegation")
+	proto.RegisterType((*UndelegationEntry)(nil), "pstake.lscosmos.v1beta1.UndelegationEntry")
+	proto.RegisterType((*HostChainRewardAddress)(nil), "pstake.lscosmos.v1beta1.HostChainRewardAddress")
+	proto.RegisterType((*IBCAmountTransientStore)(nil), "pstake.lscosmos.v1beta1.IBCAmountTransientStore")
+	proto.RegisterType((*TransientUndelegationTransfer)(nil), "pstake.lscosmos.v1beta1.TransientUndelegationTransfer")
+	proto.RegisterType((*UnbondingEpochCValue)(nil), "pstake.lscosmos.v1beta1.UnbondingEpochCValue")
+	proto.RegisterType((*DelegatorUnbondingEpochEntry)(nil), "pstake.lscosmos.v1beta1.DelegatorUnbondingEpochEntry")
+	proto.RegisterType((*PendingAutoClaimEpoch)(nil), "pstake.lscosmos.v1beta1.PendingAutoClaimEpoch")
+	proto.RegisterType((*HostAccounts)(nil), "pstake.lscosmos.v1beta1.HostAccounts")
+	proto.RegisterType((*AddressDeposits)(nil), "pstake.lscosmos.v1beta1.AddressDeposits")
+	proto.RegisterType((*EpochDeposits)(nil), "pstake.lscosmos.v1beta1.EpochDeposits")
+	proto.RegisterType((*FeeRecipient)(nil), "pstake.lscosmos.v1beta1.FeeRecipient")
+	proto.RegisterType((*FeeSplit)(nil), "pstake.lscosmos.v1beta1.FeeSplit")
+	proto.RegisterType((*CollectedFee)(nil), "pstake.lscosmos.v1beta1.CollectedFee")
+	proto.RegisterType((*Roles)(nil), "pstake.lscosmos.v1beta1.Roles")
+	proto.RegisterType((*PendingAdminChange)(nil), "pstake.lscosmos.v1beta1.PendingAdminChange")
+	proto.RegisterType((*PauseSwitches)(nil), "pstake.lscosmos.v1beta1.PauseSwitches")
+	proto.RegisterType((*ValidatorMetrics)(nil), "pstake.lscosmos.v1beta1.ValidatorMetrics")
+	proto.RegisterType((*ValidatorWeight)(nil), "pstake.lscosmos.v1beta1.ValidatorWeight")
+	proto.RegisterType((*RewardEpochRecord)(nil), "pstake.lscosmos.v1beta1.RewardEpochRecord")
+	proto.RegisterType((*HostProposal)(nil), "pstake.lscosmos.v1beta1.HostProposal")
+	proto.RegisterType((*HostProposalTally)(nil), "pstake.lscosmos.v1beta1.HostProposalTally")
+	proto.RegisterType((*HostProposalVote)(nil), "pstake.lscosmos.v1beta1.HostProposalVote")
+}
+
+func init() {
+	proto.RegisterFile("pstake/lscosmos/v1beta1/lscosmos.proto", fileDescriptor_4dec47e30da12f63)
+}
+
+var fileDescriptor_4dec47e30da12f63 = []byte{
+	// 2460 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x59, 0x5d, 0x6c, 0x23, 0x57,
+	0xf5, 0xcf, 0xd8, 0xde, 0xc4, 0x39, 0xce, 0x87, 0x73, 0x9b, 0xdd, 0x78, 0xd3, 0xae, 0xdd, 0xff,
+	0xf4, 0xe3, 0xbf, 0x2d, 0x5a, 0xa7, 0x0d, 0xa8, 0x40, 0xdb, 0x97, 0xd8, 0x4e, 0xba, 0x56, 0xd3,
+	0x24, 0x1a, 0x7b, 0xb7, 0x82, 0x52, 0x46, 0xe3, 0x99, 0x1b, 0x7b, 0xba, 0xe3, 0x7b, 0x47, 0x73,
+	0xaf, 0xb3, 0xcd, 0x1b, 0xbc, 0xa0, 0x82, 0xfa, 0x50, 0x81, 0x90, 0x8a, 0x00, 0xa9, 0x12, 0x52,
+	0x41, 0xbc, 0x21, 0x21, 0xde, 0x79, 0x81, 0xbe, 0x20, 0x55, 0x3c, 0x21, 0x24, 0xb6, 0xb0, 0x15,
+	0x12, 0x7d, 0xad, 0x78, 0x45, 0x42, 0xf7, 0x63, 0xc6, 0xe3, 0x6c, 0xbc, 0xeb, 0x64, 0x5d, 0x89,
+	0xa7, 0x64, 0xce, 0x3d, 0xe7, 0xfc, 0xce, 0xb9, 0xe7, 0xdc, 0x73, 0xce, 0xbd, 0x86, 0xa7, 0x43,
+	0xc6, 0x9d, 0x5b, 0x78, 0x23, 0x60, 0x2e, 0x65, 0x7d, 0xca, 0x36, 0x8e, 0x9e, 0xef, 0x60, 0xee,
+	0x3c, 0x9f, 0x10, 0xaa, 0x61, 0x44, 0x39, 0x45, 0x6b, 0x8a, 0xaf, 0x9a, 0x90, 0x35, 0xdf, 0xfa,
+	0x6a, 0x97, 0x76, 0xa9, 0xe4, 0xd9, 0x10, 0xff, 0x29, 0xf6, 0xf5, 0xb2, 0xd6, 0xd6, 0x71, 0x18,
+	0x4e, 0x54, 0xba, 0xd4, 0x27, 0x7a, 0xbd, 0xd2, 0xa5, 0xb4, 0x1b, 0xe0, 0x0d, 0xf9, 0xd5, 0x19,
+	0x1c, 0x6e, 0x70, 0xbf, 0x8f, 0x19, 0x77, 0xfa, 0xa1, 0x66, 0xb8, 0xac, 0x14, 0xd8, 0x4a, 0x73,
+	0xda, 0x94, 0xf5, 0x27, 0xc7, 0x99, 0x1c, 0x3a, 0x91, 0xd3, 0x8f, 0xb9, 0x1e, 0xd3, 0x8b, 0x5d,
+	0x7a, 0x94, 0x30, 0x74, 0xe9, 0x91, 0x5a, 0x35, 0x3f, 0x34, 0xe0, 0xe2, 0x56, 0x10, 0xd0, 0xdb,
+	0xbb, 0x3e, 0xe3, 0xd8, 0xbb, 0xe9, 0x04, 0xbe, 0xe7, 0x70, 0x1a, 0x31, 0xf4, 0xae, 0x01, 0x6b,
+	0x8e, 0x58, 0xb1, 0x03, 0xb9, 0x64, 0x1f, 0x25, 0x6b, 0x25, 0xe3, 0xf1, 0xec, 0xd5, 0xc2, 0xe6,
+	0xb5, 0xea, 0x98, 0xbd, 0xa8, 0x9e, 0xa6, 0xb1, 0xf6, 0xd4, 0x47, 0x77, 0x2a, 0x33, 0x9f, 0xdf,
+	0xa9, 0x5c, 0x39, 0x76, 0xfa, 0xc1, 0x8b, 0x66, 0xa2, 0x7b, 0x44, 0xb5, 0x69, 0x5d, 0x74, 0x4e,
+	0x33, 0xc7, 0xfc, 0xb7, 0x01, 0xab, 0xa7, 0xa9, 0x45, 0x0e, 0xac, 0x24, 0xe2, 0xb6, 0xe3, 0x79,
+	0x11, 0x66, 0xc2, 0x40, 0xe3, 0xea, 0x7c, 0xed, 0x2b, 0x9f, 0xdf, 0xa9, 0x94, 0x14, 0xda, 0x3d,
+	0x2c, 0xe6, 0x9f, 0x7f, 0x7b, 0x6d, 0x55, 0x9b, 0xbd, 0xa5, 0x48, 0x2d, 0x1e, 0xf9, 0xa4, 0x6b,
+	0x15, 0x13, 0x5e, 0x4d, 0x47, 0xc7, 0xb0, 0xc8, 0x9d, 0xa8, 0x8b, 0xb9, 0x7d, 0x1b, 0xfb, 0xdd,
+	0x1e, 0x2f, 0x65, 0xa4, 0xfa, 0xb6, 0x70, 0xe8, 0xaf, 0x77, 0x2a, 0x4f, 0x77, 0x7d, 0xde, 0x1b,
+	0x74, 0xaa, 0x2e, 0xed, 0xeb, 0x00, 0xe9, 0x3f, 0xd7, 0x98, 0x77, 0x6b, 0x83, 0x1f, 0x87, 0x98,
+	0x55, 0x1b, 0xd8, 0xfd, 0xfc, 0x4e, 0x65, 0x55, 0x19, 0x33, 0xa2, 0x4c, 0x18, 0x02, 0xda, 0x90,
+	0x06, 0x76, 0xad, 0x05, 0xb5, 0xfa, 0xba, 0x5a, 0x7c, 0x37, 0x07, 0x0b, 0x07, 0x72, 0x97, 0x0f,
+	0x64, 0x50, 0xd1, 0x5b, 0x80, 0xd4, 0xae, 0xdb, 0x1e, 0x0e, 0x29, 0xf3, 0xb9, 0x7d, 0x88, 0xb1,
+	0xf6, 0xf7, 0xe5, 0xb3, 0x19, 0x74, 0x02, 0xb8, 0xa8, 0xf4, 0x36, 0x94, 0xda, 0x1d, 0x8c, 0x53,
+	0x58, 0x11, 0x56, 0x7f, 0x05, 0x56, 0x66, 0x7a, 0x58, 0x96, 0x52, 0x3b, 0x8a, 0x35, 0x20, 0x43,
+	0xac, 0xec, 0xf4, 0xb0, 0x6e, 0x90, 0x04, 0x2b, 0x84, 0x8b, 0x89, 0x5f, 0x1e, 0xee, 0x87, 0xdc,
+	0xa7, 0x44, 0xc2, 0xe5, 0xa6, 0x00, 0xf7, 0x48, 0xec, 0x5a, 0xac, 0x59, 0x20, 0xee, 0x24, 0xde,
+	0x1d, 0x62, 0x9c, 0x64, 0xe9, 0x05, 0x09, 0x57, 0x1a, 0x9f, 0x89, 0x61, 0x6c, 0xb2, 0xa6, 0x9b,
+	0xef, 0x67, 0x61, 0xf9, 0x3a, 0x65, 0xbc, 0xde, 0x73, 0x7c, 0xa2, 0x33, 0x62, 0x1d, 0xe6, 0x5d,
+	0xf1, 0x69, 0xfb, 0xb6, 0xa7, 0x12, 0xc1, 0x9a, 0x93, 0x84, 0x66, 0x03, 0x3d, 0x09, 0x4b, 0x2e,
+	0x25, 0x04, 0xbb, 0xd2, 0x45, 0xc1, 0x20, 0xa3, 0x67, 0x2d, 0x0c, 0xa9, 0xcd, 0x06, 0x7a, 0x06,
+	0x8a, 0x3c, 0x72, 0x08, 0x3b, 0xc4, 0x91, 0xed, 0xf6, 0x1c, 0x42, 0x70, 0xa0, 0x76, 0xde, 0x5a,
+	0x8e, 0xe9, 0x75, 0x45, 0x46, 0x4f, 0xc0, 0x62, 0xc2, 0x1a, 0xd2, 0x88, 0xab, 0x2d, 0xb3, 0x16,
+	0x62, 0xe2, 0x01, 0x8d, 0x38, 0xba, 0x02, 0x20, 0xea, 0x9d, 0xed, 0x61, 0x42, 0xfb, 0xca, 0x4b,
+	0x6b, 0x5e, 0x50, 0x1a, 0x82, 0x20, 0x96, 0xfb, 0x3e, 0xe1, 0x7a, 0x79, 0x56, 0x2d, 0x0b, 0x8a,
+	0x5a, 0x7e, 0x13, 0x0a, 0x7d, 0x9f, 0xc4, 0xe9, 0x5d, 0x9a, 0x3b, 0x73, 0x4c, 0x9a, 0x84, 0xa7,
+	0x62, 0xd2, 0x24, 0xdc, 0x12, 0x78, 0x3a, 0xaf, 0xd1, 0x01, 0x2c, 0xea, 0x50, 0xa8, 0x32, 0x59,
+	0xca, 0x3f, 0x6e, 0x5c, 0x2d, 0x6c, 0x3e, 0x35, 0xb6, 0x98, 0xa5, 0x8f, 0x5f, 0x2d, 0x27, 0xec,
+	0xb0, 0x16, 0xc2, 0x14, 0xed, 0xc5, 0xdc, 0xfb, 0x1f, 0x54, 0x0c, 0xf3, 0xb3, 0x2c, 0x2c, 0x37,
+	0x70, 0x80, 0xbb, 0x8e, 0xd8, 0xd5, 0x16, 0x77, 0x38, 0x46, 0x3f, 0x34, 0xa0, 0xd2, 0xa3, 0x4c,
+	0xb8, 0x1a, 0x2f, 0xd8, 0x8e, 0xeb, 0xd2, 0x01, 0xe1, 0x76, 0xc7, 0x09, 0x1c, 0xe2, 0x62, 0x5d,
+	0x4b, 0x2f, 0x57, 0x35, 0xaa, 0xd8, 0xa6, 0x04, 0xba, 0x4e, 0x7d, 0x52, 0x7b, 0x4e, 0x40, 0xfe,
+	0xfa, 0x93, 0xca, 0xd5, 0x09, 0x5c, 0x17, 0x02, 0xcc, 0x7a, 0x4c, 0x60, 0x0e, 0x6d, 0xd9, 0x52,
+	0x88, 0x35, 0x05, 0x88, 0xde, 0x80, 0x2b, 0xd2, 0x26, 0x95, 0x34, 0x69, 0xcb, 0x74, 0x5a, 0x66,
+	0x1e, 0x90, 0x96, 0xeb, 0xbd, 0x38, 0x03, 0x53, 0x18, 0xba, 0x54, 0x12, 0x28, 0x49, 0xe5, 0xb1,
+	0x97, 0x43, 0xf5, 0xac, 0x94, 0x95, 0x9e, 0x56, 0xc7, 0x6e, 0xb4, 0x48, 0x6c, 0x6d, 0xeb, 0x50,
+	0xb1, 0xde, 0xf1, 0x4b, 0xbd, 0xd3, 0x16, 0x19, 0xe2, 0xb0, 0x3e, 0x82, 0x37, 0x20, 0x69, 0xc4,
+	0x9c, 0x44, 0x7c, 0x6e, 0x12, 0xc4, 0x1b, 0xc4, 0x3b, 0x89, 0x59, 0xea, 0x9d, 0xbe, 0xcc, 0xcc,
+	0x9f, 0x1b, 0x70, 0xf1, 0x54, 0x6b, 0xd1, 0xf6, 0xf8, 0x6e, 0x54, 0x3a, 0x43, 0xc7, 0xf9, 0x2a,
+	0xcc, 0x3a, 0x7d, 0xa1, 0x5a, 0x06, 0xe3, 0xbe, 0xe9, 0xa1, 0x6c, 0xd5, 0xec, 0x3a, 0x17, 0xff,
+	0x94, 0x81, 0xb5, 0x31, 0xbe, 0xa1, 0xff, 0x83, 0x05, 0x1c, 0x52, 0xb7, 0x67, 0x93, 0x41, 0xbf,
+	0x83, 0x23, 0x69, 0x5c, 0xd6, 0x2a, 0x48, 0xda, 0x9e, 0x24, 0xa1, 0x37, 0xe0, 0x32, 0xa7, 0xdc,
+	0x09, 0x46, 0x76, 0xd3, 0x3e, 0x9b, 0x41, 0x6b, 0x52, 0x43, 0x1a, 0x79, 0x4b, 0xca, 0xa3, 0xd7,
+	0x60, 0xd9, 0xa5, 0xfd, 0x30, 0xc0, 0x52, 0xa9, 0x18, 0x77, 0x64, 0xad, 0x29, 0x6c, 0xae, 0x57,
+	0xd5, 0x2c, 0x54, 0x8d, 0x67, 0xa1, 0x6a, 0x3b, 0x9e, 0x85, 0x6a, 0x79, 0xa1, 0xf3, 0xbd, 0x4f,
+	0x2a, 0x86, 0xb5, 0x34, 0x14, 0x16, 0xcb, 0xc8, 0x85, 0xd5, 0x11, 0x2b, 0x31, 0xe1, 0x91, 0x8f,
+	0xe3, 0xd0, 0x3f, 0x3b, 0x36, 0xf4, 0x69, 0xcb, 0xb6, 0x09, 0x8f, 0x8e, 0xb5, 0xdd, 0x8f, 0x0c,
+	0x4e, 0x2c, 0xf8, 0x98, 0x99, 0x3f, 0x31, 0x60, 0xe5, 0x1e, 0x81, 0xff, 0x91, 0x58, 0xef, 0xc2,
+	0xa5, 0xa4, 0x23, 0x58, 0xf8, 0xb6, 0x13, 0x79, 0xb1, 0xe2, 0x4d, 0x98, 0x9b, 0xd4, 0xaa, 0x98,
+	0xd1, 0xfc, 0x5b, 0x06, 0xd6, 0x9a, 0xb5, 0xba, 0x8a, 0x55, 0x5b, 0x14, 0x75, 0x1f, 0x13, 0xde,
+	0xe2, 0x34, 0x12, 0x6d, 0x73, 0xc9, 0xb7, 0x3b, 0xb6, 0x6b, 0xc7, 0xc5, 0xfe, 0x8b, 0xa8, 0x5d,
+	0x05, 0xbf, 0x56, 0x6f, 0x6b, 0xfd, 0xa8, 0x21, 0x10, 0x5d, 0xdb, 0x89, 0xcb, 0x08, 0x9e, 0x74,
+	0x8b, 0x0a, 0x7e, 0x7d, 0x4b, 0x9f, 0x4a, 0x8c, 0xbe, 0x6f, 0xc0, 0x13, 0x49, 0x54, 0x29, 0xb1,
+	0x75, 0x06, 0x61, 0xfb, 0x84, 0x37, 0xaa, 0x3e, 0xbd, 0x30, 0x36, 0x65, 0x92, 0xed, 0x48, 0xa7,
+	0x42, 0x6c, 0xab, 0x06, 0x2e, 0xa7, 0x80, 0xea, 0x1a, 0xa7, 0x39, 0xf4, 0xc8, 0x7c, 0xd7, 0x80,
+	0x2b, 0xf7, 0xd5, 0x33, 0xc9, 0xf9, 0xbc, 0x0e, 0xcb, 0x2a, 0x05, 0xec, 0x01, 0xe9, 0x50, 0xe2,
+	0x61, 0x6f, 0xd2, 0x7d, 0x59, 0x52, 0x72, 0x37, 0xb4, 0x98, 0xf9, 0x1f, 0x03, 0x56, 0xd5, 0x87,
+	0x4f, 0xba, 0xdb, 0x02, 0xa2, 0x7e, 0xd3, 0x09, 0x06, 0x78, 0x12, 0x2b, 0x5e, 0x06, 0x60, 0x36,
+	0xb7, 0x6f, 0xd9, 0x9d, 0x41, 0x44, 0x26, 0x35, 0x60, 0x8e, 0xb5, 0x5f, 0xad, 0x0d, 0x22, 0x72,
+	0x9a, 0x0f, 0xd9, 0x73, 0xf9, 0x20, 0xc6, 0x09, 0x9f, 0xd9, 0x7d, 0x87, 0x0f, 0x22, 0xec, 0xc9,
+	0x79, 0x24, 0x6f, 0xcd, 0xfb, 0xec, 0x35, 0x45, 0x40, 0x8f, 0xc2, 0xbc, 0xcf, 0xec, 0x43, 0xc7,
+	0x0f, 0xb0, 0x27, 0x67, 0x91, 0xbc, 0x95, 0xf7, 0xd9, 0x8e, 0xfc, 0x36, 0x7f, 0x6f, 0xc0, 0x63,
+	0x3a, 0x4f, 0x68, 0x34, 0xba, 0x11, 0xc9, 0x19, 0x8f, 0xe3, 0x79, 0x86, 0x33, 0x9e, 0x88, 0xc4,
+	0x47, 0xf1, 0xe4, 0x76, 0x66, 0xee, 0xdd, 0xce, 0x61, 0x19, 0xc8, 0x9e, 0xa9, 0x0c, 0x98, 0x3f,
+	0x30, 0xe0, 0xe2, 0x01, 0x96, 0x86, 0x6f, 0x0d, 0x38, 0xad, 0x07, 0x8e, 0xdf, 0x97, 0x1e, 0x4c,
+	0x12, 0xc4, 0x3d, 0xb8, 0x14, 0x38, 0xc3, 0x01, 0x25, 0xe5, 0xe4, 0x83, 0xa6, 0x80, 0x55, 0x21,
+	0xd7, 0x38, 0xe1, 0xa8, 0xf9, 0x3d, 0x03, 0x16, 0x52, 0x9d, 0x87, 0xa1, 0x97, 0xe1, 0xd1, 0x94,
+	0x6e, 0x45, 0xb5, 0xe9, 0x6d, 0x82, 0xa3, 0xd4, 0xbc, 0xba, 0x36, 0xdc, 0x30, 0xc5, 0xb1, 0x2f,
+	0x18, 0x9a, 0x0d, 0xf4, 0x35, 0xb8, 0x1c, 0xc9, 0x9a, 0xc6, 0x4e, 0x91, 0x55, 0xa3, 0xec, 0x45,
+	0xcd, 0x30, 0x2a, 0x69, 0xfe, 0xd4, 0x80, 0x65, 0x6d, 0x94, 0x9e, 0xfc, 0xce, 0x55, 0x10, 0x51,
+	0x7b, 0xa4, 0x3a, 0x3f, 0xec, 0x20, 0x1a, 0xc7, 0xec, 0x1d, 0x03, 0x16, 0x65, 0x8c, 0x12, 0xdb,
+	0x26, 0x88, 0xd5, 0x17, 0x63, 0xca, 0xfb, 0x06, 0x2c, 0xec, 0x60, 0x6c, 0x61, 0xd7, 0x0f, 0x45,
+	0x51, 0x3a, 0xef, 0x2e, 0x8d, 0x5c, 0x8d, 0x1f, 0xee, 0x0a, 0xa5, 0x75, 0x99, 0x3f, 0xcb, 0x42,
+	0x7e, 0x07, 0xe3, 0x56, 0x18, 0xf8, 0x1c, 0x39, 0x70, 0x29, 0x75, 0xe3, 0xb5, 0xa3, 0xd8, 0xde,
+	0xf8, 0x35, 0x62, 0xfc, 0x00, 0x9f, 0xf6, 0x4e, 0x9f, 0x9d, 0x55, 0x2f, 0xb9, 0xe5, 0x26, 0x4b,
+	0x4c, 0x40, 0xa4, 0x2e, 0xba, 0x69, 0x88, 0xcc, 0x39, 0x20, 0xa2, 0xe4, 0x72, 0x3b, 0x0a, 0x31,
+	0x20, 0xa7, 0x42, 0x64, 0xcf, 0x01, 0x31, 0x20, 0xa7, 0x40, 0x74, 0xc5, 0x99, 0x49, 0x5f, 0x6b,
+	0xd3, 0x28, 0xb9, 0xb3, 0xa3, 0xac, 0x45, 0xe9, 0xab, 0xec, 0x10, 0xc8, 0xfc, 0x83, 0x01, 0x0b,
+	0x75, 0x1a, 0x04, 0xd8, 0xe5, 0xd8, 0x13, 0xb7, 0xdc, 0xcb, 0x90, 0x17, 0x70, 0x22, 0xac, 0xf1,
+	0x45, 0xf4, 0x10, 0xe3, 0xf6, 0x71, 0x88, 0xd1, 0x0b, 0x30, 0x9f, 0x58, 0xf1, 0xc0, 0xd2, 0x32,
+	0x64, 0x45, 0x6e, 0xaa, 0x2a, 0x4e, 0x7d, 0xd6, 0x88, 0x8f, 0xc0, 0xef, 0x0c, 0xb8, 0x60, 0xd1,
+	0x00, 0x33, 0xf4, 0x1c, 0xcc, 0x3a, 0x5e, 0xdf, 0x27, 0x2a, 0xa9, 0xee, 0x67, 0xa3, 0xe6, 0x13,
+	0xa7, 0x25, 0x74, 0x06, 0x0c, 0x47, 0x2a, 0x49, 0xee, 0x7b, 0x5a, 0x34, 0x23, 0x7a, 0x05, 0x10,
+	0x0b, 0x1c, 0xd6, 0xf3, 0x49, 0xd7, 0x8e, 0xb0, 0xb8, 0x46, 0x0b, 0xf1, 0xec, 0x03, 0xc4, 0x57,
+	0x62, 0x19, 0x2b, 0x16, 0x31, 0x7f, 0x64, 0x00, 0x8a, 0x4b, 0xbf, 0x30, 0x47, 0xdc, 0xd2, 0xbb,
+	0xf8, 0x1c, 0x5e, 0xbc, 0x0a, 0x4b, 0xf8, 0xf0, 0x10, 0xbb, 0xdc, 0x3f, 0xc2, 0x6a, 0x26, 0xcf,
+	0x9c, 0x61, 0x26, 0x5f, 0x4c, 0x64, 0xc5, 0xaa, 0xf9, 0xe3, 0x0c, 0x2c, 0x1e, 0x08, 0x57, 0x5b,
+	0xb7, 0x7d, 0xee, 0xf6, 0xb0, 0x78, 0xa2, 0xc8, 0xeb, 0x03, 0xa7, 0x6a, 0x4a, 0xde, 0x4a, 0xbe,
+	0xc5, 0x9a, 0x4e, 0x63, 0xd5, 0x73, 0xf2, 0x56, 0xf2, 0x8d, 0x4a, 0x30, 0x27, 0x92, 0x0f, 0xf7,
+	0x99, 0x6c, 0x8a, 0x79, 0x2b, 0xfe, 0x44, 0x97, 0x60, 0xd6, 0x15, 0x8d, 0x8e, 0xe9, 0x86, 0xaf,
+	0xbf, 0xc4, 0x53, 0x46, 0xfa, 0x32, 0x20, 0xaa, 0xa7, 0x6e, 0xfa, 0xcb, 0x43, 0x7a, 0xd2, 0x1d,
+	0x55, 0xeb, 0xd0, 0x6c, 0xb3, 0x92, 0xad, 0xa0, 0x68, 0x8a, 0xe5, 0x1a, 0xa0, 0xd1, 0xcb, 0x85,
+	0x64, 0x9c, 0x93, 0x8c, 0x2b, 0x23, 0x17, 0x05, 0xc9, 0x7e, 0x05, 0x40, 0x5e, 0x46, 0x8f, 0x28,
+	0xc7, 0xea, 0x5d, 0x21, 0x6f, 0xcd, 0x0b, 0xca, 0x4d, 0x41, 0x30, 0x3f, 0xcc, 0x41, 0x31, 0x79,
+	0xb7, 0x7c, 0x0d, 0xf3, 0xc8, 0x77, 0xd9, 0xb4, 0x2e, 0x11, 0xdb, 0xb0, 0xe2, 0x52, 0xc2, 0x30,
+	0x61, 0x03, 0x36, 0x71, 0x0b, 0x2f, 0x26, 0x22, 0xb1, 0x9a, 0x6f, 0x01, 0xb8, 0xb4, 0xdf, 0xf7,
+	0x19, 0xf3, 0x29, 0x99, 0xca, 0xeb, 0x5b, 0x4a, 0x9f, 0x08, 0xda, 0x5b, 0x6a, 0x0e, 0xd3, 0x41,
+	0x53, 0x5f, 0xa8, 0x0c, 0xc0, 0x69, 0xbf, 0xc3, 0x38, 0x25, 0xc9, 0x8c, 0x96, 0xa2, 0x08, 0x39,
+	0x3d, 0x22, 0xaa, 0x18, 0xe9, 0x2f, 0xd1, 0x75, 0x38, 0xbd, 0x85, 0x09, 0x9b, 0xca, 0x23, 0x91,
+	0xd6, 0x85, 0xbe, 0x01, 0xf3, 0x0c, 0x07, 0x87, 0xb6, 0x00, 0x29, 0xe5, 0xa7, 0xa0, 0x38, 0x2f,
+	0xd4, 0xd5, 0x28, 0xf1, 0xd0, 0x53, 0xb0, 0x34, 0x08, 0x3d, 0x47, 0xbc, 0xa6, 0xf7, 0x54, 0xbb,
+	0x9c, 0x97, 0x6d, 0x7e, 0x51, 0x53, 0xaf, 0xab, 0xbe, 0xf7, 0xcf, 0x0c, 0x2c, 0x27, 0x89, 0xa2,
+	0x1e, 0x82, 0xa7, 0x95, 0x27, 0x6f, 0x42, 0x41, 0x3e, 0xcd, 0x4d, 0xb1, 0x5b, 0xcb, 0xb7, 0x3e,
+	0x6d, 0x65, 0x17, 0x8a, 0xc3, 0x3a, 0xa2, 0x31, 0xa6, 0x91, 0x45, 0xcb, 0x89, 0x56, 0x0d, 0x54,
+	0x87, 0xb9, 0xbe, 0x3a, 0x41, 0x32, 0x97, 0x0a, 0x9b, 0xcf, 0x8c, 0x6d, 0x69, 0x27, 0x8f, 0x9c,
+	0x15, 0x4b, 0x9a, 0xbf, 0xcc, 0xc2, 0x8a, 0x35, 0x3c, 0xee, 0x16, 0x76, 0x69, 0xe4, 0x4d, 0x32,
+	0x89, 0x7d, 0x1d, 0xe6, 0x1c, 0xd7, 0x8d, 0x06, 0x93, 0x5f, 0xbc, 0x62, 0x7e, 0xf4, 0x12, 0xe4,
+	0xf5, 0x60, 0x30, 0xf1, 0x85, 0x27, 0x11, 0x40, 0x6d, 0xb8, 0xc4, 0x30, 0xe1, 0x36, 0xa7, 0xb6,
+	0x4f, 0xd8, 0x20, 0x12, 0xef, 0x79, 0xf6, 0xe1, 0x80, 0x78, 0xa5, 0xdc, 0x64, 0xaa, 0x1e, 0x11,
+	0xe2, 0x6d, 0xda, 0x8c, 0x85, 0x77, 0x06, 0xc4, 0x43, 0x35, 0x58, 0x70, 0x9d, 0x28, 0xf2, 0xb1,
+	0x67, 0xd3, 0x23, 0x1c, 0x95, 0x2e, 0x4c, 0xa6, 0xab, 0xa0, 0x85, 0xf6, 0x8f, 0x70, 0x84, 0xde,
+	0x84, 0x55, 0xfc, 0xb6, 0x8b, 0x19, 0xb3, 0x75, 0x4d, 0x0d, 0x69, 0xe0, 0xbb, 0xc7, 0xf2, 0xc0,
+	0x2e, 0x6d, 0x7e, 0x69, 0x6c, 0x70, 0xb6, 0xa5, 0x90, 0x0a, 0xc2, 0x81, 0x14, 0xb1, 0x10, 0xbe,
+	0x87, 0x66, 0x7e, 0x37, 0xab, 0xae, 0x15, 0x07, 0x11, 0x0d, 0x29, 0x73, 0x02, 0x54, 0x81, 0x42,
+	0xa8, 0xff, 0xb7, 0x7d, 0x75, 0x8d, 0xc8, 0x59, 0x10, 0x93, 0x9a, 0x1e, 0xda, 0x85, 0xe5, 0x23,
+	0xca, 0x45, 0x87, 0xc5, 0xc4, 0x3b, 0x47, 0x4b, 0x53, 0xc2, 0xdb, 0xc4, 0x13, 0xab, 0xa8, 0x0e,
+	0xb3, 0x8c, 0x3b, 0x7c, 0xa0, 0xfa, 0xd0, 0xfd, 0x1c, 0x4a, 0x5b, 0xd9, 0x92, 0x22, 0x96, 0x16,
+	0x45, 0xff, 0x0f, 0xcb, 0x8c, 0x38, 0x21, 0xeb, 0x51, 0x1e, 0x1f, 0xff, 0x9c, 0xcc, 0xad, 0xa5,
+	0x98, 0xac, 0xce, 0x3f, 0xda, 0x81, 0x0b, 0xdc, 0x09, 0x82, 0x63, 0x1d, 0x89, 0x67, 0x27, 0x02,
+	0x6b, 0x0b, 0x09, 0x1d, 0x1a, 0x25, 0x8e, 0x76, 0x60, 0x8e, 0x86, 0xea, 0x25, 0x74, 0x56, 0x4e,
+	0x4f, 0x4f, 0xc7, 0x31, 0x15, 0x3f, 0x00, 0xc6, 0x4a, 0xd4, 0x89, 0xc2, 0x9e, 0x68, 0x52, 0xfb,
+	0x61, 0xea, 0xfd, 0x33, 0x16, 0x7e, 0x31, 0xf7, 0xaf, 0x0f, 0x2a, 0x33, 0xe6, 0x67, 0x19, 0x58,
+	0xb9, 0x07, 0x10, 0xed, 0x41, 0xf6, 0x18, 0xb3, 0x92, 0x31, 0x85, 0x3a, 0x29, 0x14, 0xa1, 0x9b,
+	0x30, 0xe7, 0x74, 0x18, 0x77, 0x7c, 0x32, 0x95, 0x5b, 0x4e, 0xac, 0x0c, 0xed, 0x42, 0x86, 0xd0,
+	0x52, 0x76, 0x0a, 0x2a, 0x33, 0x84, 0xa2, 0x6f, 0xc3, 0x02, 0xa1, 0xf6, 0x6d, 0x9f, 0xf7, 0xec,
+	0x23, 0xcc, 0x69, 0x29, 0x37, 0x05, 0xbd, 0x40, 0xe8, 0xeb, 0x3e, 0xef, 0xdd, 0xc4, 0x9c, 0x9a,
+	0xbf, 0x31, 0xa0, 0x98, 0xde, 0x6b, 0x11, 0x9b, 0x07, 0xe7, 0x7c, 0x15, 0x2e, 0x88, 0xd1, 0x23,
+	0x7a, 0x60, 0xe3, 0x57, 0x6c, 0xe9, 0xfc, 0xc8, 0x3e, 0x74, 0x7e, 0x3c, 0xfb, 0x47, 0x03, 0xd0,
+	0xbd, 0xd9, 0x8f, 0x4c, 0x28, 0x5f, 0xdf, 0x6f, 0xb5, 0xed, 0x03, 0x6b, 0xff, 0x60, 0xbf, 0xb5,
+	0xb5, 0x6b, 0xb7, 0xda, 0x5b, 0xed, 0x1b, 0x2d, 0xbb, 0xd5, 0x7c, 0x65, 0x6f, 0x6b, 0xb7, 0xb9,
+	0xf7, 0x4a, 0x71, 0x66, 0x2c, 0xcf, 0xcd, 0xfd, 0xf6, 0xb6, 0xdd, 0xda, 0xde, 0x6b, 0x17, 0x0d,
+	0x54, 0x86, 0xf5, 0xb1, 0x3c, 0x8d, 0x62, 0x06, 0x55, 0xe0, 0xd1, 0x53, 0xd7, 0x77, 0xb6, 0x9a,
+	0xbb, 0xdb, 0x8d, 0x62, 0x76, 0x2c, 0xc8, 0xde, 0xbe, 0xb6, 0xa5, 0x98, 0x5b, 0xcf, 0xbd, 0xf3,
+	0x8b, 0xf2, 0x4c, 0xcd, 0xf9, 0xe8, 0x1f, 0xe5, 0x99, 0xef, 0xdc, 0x2d, 0xcf, 0xfc, 0xea, 0x6e,
+	0xd9, 0xf8, 0xe8, 0x6e, 0xd9, 0xf8, 0xf8, 0x6e, 0xd9, 0xf8, 0xfb, 0xdd, 0xb2, 0xf1, 0xde, 0xa7,
+	0xe5, 0x99, 0x8f, 0x3f, 0x2d, 0xcf, 0xfc, 0xe5, 0xd3, 0xf2, 0xcc, 0x37, 0x5f, 0x4a, 0x45, 0x39,
+	0xc4, 0x11, 0xf3, 0x19, 0xc7, 0xc4, 0xc5, 0xfb, 0x04, 0x6f, 0xa8, 0xf3, 0x7a, 0x8d, 0x38, 0xa2,
+	0x63, 0x6d, 0x1c, 0x6d, 0x6e, 0xbc, 0x3d, 0xfc, 0x91, 0x5e, 0x86, 0xbf, 0x33, 0x2b, 0xab, 0xcf,
+	0x97, 0xff, 0x3b, 0x00, 0x16, 0x2d, 0xb1, 0x14, 0x77, 0x20, 0x00, 0x00,
+}
+
+func (this *AllowListedValidators) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*DelegationState)
+	that1, ok := that.(*AllowListedValidators)
 	if !ok {
-		that2, ok := that.(DelegationState)
+		that2, ok := that.(AllowListedValidators)
 		if ok {
 			that1 = &that2
 		} else {
@@ -857,43 +1488,24 @@ func (this *DelegationState) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if len(this.HostDelegationAccountBalance) != len(that1.HostDelegationAccountBalance) {
-		return false
-	}
-	for i := range this.HostDelegationAccountBalance {
-		if !this.HostDelegationAccountBalance[i].Equal(&that1.HostDelegationAccountBalance[i]) {
-			return false
-		}
-	}
-	if this.HostChainDelegationAddress != that1.HostChainDelegationAddress {
-		return false
-	}
-	if len(this.HostAccountDelegations) != len(that1.HostAccountDelegations) {
-		return false
-	}
-	for i := range this.HostAccountDelegations {
-		if !this.HostAccountDelegations[i].Equal(&that1.HostAccountDelegations[i]) {
-			return false
-		}
-	}
-	if len(this.HostAccountUndelegations) != len(that1.HostAccountUndelegations) {
+	if len(this.AllowListedValidators) != len(that1.AllowListedValidators) {
 		return false
 	}
-	for i := range this.HostAccountUndelegations {
-		if !this.HostAccountUndelegations[i].Equal(&that1.HostAccountUndelegations[i]) {
+	for i := range this.AllowListedValidators {
+		if !this.AllowListedValidators[i].Equal(&that1.AllowListedValidators[i]) {
 			return false
 		}
 	}
 	return true
 }
-func (this *HostAccountDelegation) Equal(that interface{}) bool {
+func (this *AllowListedValidator) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*HostAccountDelegation)
+	that1, ok := that.(*AllowListedValidator)
 	if !ok {
-		that2, ok := that.(HostAccountDelegation)
+		that2, ok := that.(AllowListedValidator)
 		if ok {
 			that1 = &that2
 		} else {
@@ -908,19 +1520,19 @@ func (this *HostAccountDelegation) Equal(that interface{}) bool {
 	if this.ValidatorAddress != that1.ValidatorAddress {
 		return false
 	}
-	if !this.Amount.Equal(&that1.Amount) {
+	if !this.TargetWeight.Equal(that1.TargetWeight) {
 		return false
 	}
 	return true
 }
-func (this *HostAccountUndelegation) Equal(that interface{}) bool {
+func (this *PstakeParams) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*HostAccountUndelegation)
+	that1, ok := that.(*PstakeParams)
 	if !ok {
-		that2, ok := that.(HostAccountUndelegation)
+		that2, ok := that.(PstakeParams)
 		if ok {
 			that1 = &that2
 		} else {
@@ -932,33 +1544,189 @@ func (this *HostAccountUndelegation) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.EpochNumber != that1.EpochNumber {
+	if !this.PstakeDepositFee.Equal(that1.PstakeDepositFee) {
 		return false
 	}
-	if !this.TotalUndelegationAmount.Equal(&that1.TotalUndelegationAmount) {
+	if !this.PstakeRestakeFee.Equal(that1.PstakeRestakeFee) {
 		return false
 	}
-	if !this.CompletionTime.Equal(that1.CompletionTime) {
+	if !this.PstakeUnstakeFee.Equal(that1.PstakeUnstakeFee) {
 		return false
 	}
-	if len(this.UndelegationEntries) != len(that1.UndelegationEntries) {
+	if !this.PstakeRedemptionFee.Equal(that1.PstakeRedemptionFee) {
 		return false
 	}
-	for i := range this.UndelegationEntries {
-		if !this.UndelegationEntries[i].Equal(&that1.UndelegationEntries[i]) {
-			return false
-		}
+	if this.PstakeFeeAddress != that1.PstakeFeeAddress {
+		return false
 	}
 	return true
 }
-func (this *UndelegationEntry) Equal(that interface{}) bool {
+func (this *HostChainParams) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*UndelegationEntry)
+	that1, ok := that.(*HostChainParams)
 	if !ok {
-		that2, ok := that.(UndelegationEntry)
+		that2, ok := that.(HostChainParams)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ChainID != that1.ChainID {
+		return false
+	}
+	if this.ConnectionID != that1.ConnectionID {
+		return false
+	}
+	if this.TransferChannel != that1.TransferChannel {
+		return false
+	}
+	if this.TransferPort != that1.TransferPort {
+		return false
+	}
+	if this.BaseDenom != that1.BaseDenom {
+		return false
+	}
+	if this.MintDenom != that1.MintDenom {
+		return false
+	}
+	if !this.MinDeposit.Equal(that1.MinDeposit) {
+		return false
+	}
+	if !this.PstakeParams.Equal(&that1.PstakeParams) {
+		return false
+	}
+	return true
+}
+func (this *DelegationState) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DelegationState)
+	if !ok {
+		that2, ok := that.(DelegationState)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.HostDelegationAccountBalance) != len(that1.HostDelegationAccountBalance) {
+		return false
+	}
+	for i := range this.HostDelegationAccountBalance {
+		if !this.HostDelegationAccountBalance[i].Equal(&that1.HostDelegationAccountBalance[i]) {
+			return false
+		}
+	}
+	if this.HostChainDelegationAddress != that1.HostChainDelegationAddress {
+		return false
+	}
+	if len(this.HostAccountDelegations) != len(that1.HostAccountDelegations) {
+		return false
+	}
+	for i := range this.HostAccountDelegations {
+		if !this.HostAccountDelegations[i].Equal(&that1.HostAccountDelegations[i]) {
+			return false
+		}
+	}
+	if len(this.HostAccountUndelegations) != len(that1.HostAccountUndelegations) {
+		return false
+	}
+	for i := range this.HostAccountUndelegations {
+		if !this.HostAccountUndelegations[i].Equal(&that1.HostAccountUndelegations[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *HostAccountDelegation) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*HostAccountDelegation)
+	if !ok {
+		that2, ok := that.(HostAccountDelegation)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ValidatorAddress != that1.ValidatorAddress {
+		return false
+	}
+	if !this.Amount.Equal(&that1.Amount) {
+		return false
+	}
+	return true
+}
+func (this *HostAccountUndelegation) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*HostAccountUndelegation)
+	if !ok {
+		that2, ok := that.(HostAccountUndelegation)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.EpochNumber != that1.EpochNumber {
+		return false
+	}
+	if !this.TotalUndelegationAmount.Equal(&that1.TotalUndelegationAmount) {
+		return false
+	}
+	if !this.CompletionTime.Equal(that1.CompletionTime) {
+		return false
+	}
+	if len(this.UndelegationEntries) != len(that1.UndelegationEntries) {
+		return false
+	}
+	for i := range this.UndelegationEntries {
+		if !this.UndelegationEntries[i].Equal(&that1.UndelegationEntries[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *UndelegationEntry) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*UndelegationEntry)
+	if !ok {
+		that2, ok := that.(UndelegationEntry)
 		if ok {
 			that1 = &that2
 		} else {
@@ -1135,6 +1903,33 @@ func (this *DelegatorUnbondingEpochEntry) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *PendingAutoClaimEpoch) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PendingAutoClaimEpoch)
+	if !ok {
+		that2, ok := that.(PendingAutoClaimEpoch)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.EpochNumber != that1.EpochNumber {
+		return false
+	}
+	if this.LastDelegatorAddress != that1.LastDelegatorAddress {
+		return false
+	}
+	return true
+}
 func (this *HostAccounts) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -1162,297 +1957,474 @@ func (this *HostAccounts) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (m *AllowListedValidators) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *AddressDeposits) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
 
-func (m *AllowListedValidators) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	that1, ok := that.(*AddressDeposits)
+	if !ok {
+		that2, ok := that.(AddressDeposits)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	if !this.Amount.Equal(that1.Amount) {
+		return false
+	}
+	return true
 }
+func (this *EpochDeposits) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *AllowListedValidators) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.AllowListedValidators) > 0 {
-		for iNdEx := len(m.AllowListedValidators) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.AllowListedValidators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintLscosmos(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+	that1, ok := that.(*EpochDeposits)
+	if !ok {
+		that2, ok := that.(EpochDeposits)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	return len(dAtA) - i, nil
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.EpochNumber != that1.EpochNumber {
+		return false
+	}
+	if !this.Amount.Equal(that1.Amount) {
+		return false
+	}
+	return true
 }
+func (this *FeeRecipient) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *AllowListedValidator) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *AllowListedValidator) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *AllowListedValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.TargetWeight.Size()
-		i -= size
-		if _, err := m.TargetWeight.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	that1, ok := that.(*FeeRecipient)
+	if !ok {
+		that2, ok := that.(FeeRecipient)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i = encodeVarintLscosmos(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.ValidatorAddress) > 0 {
-		i -= len(m.ValidatorAddress)
-		copy(dAtA[i:], m.ValidatorAddress)
-		i = encodeVarintLscosmos(dAtA, i, uint64(len(m.ValidatorAddress)))
-		i--
-		dAtA[i] = 0xa
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *PstakeParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.Address != that1.Address {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *PstakeParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if !this.Weight.Equal(that1.Weight) {
+		return false
+	}
+	return true
 }
+func (this *FeeSplit) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *PstakeParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.PstakeFeeAddress) > 0 {
-		i -= len(m.PstakeFeeAddress)
-		copy(dAtA[i:], m.PstakeFeeAddress)
-		i = encodeVarintLscosmos(dAtA, i, uint64(len(m.PstakeFeeAddress)))
-		i--
-		dAtA[i] = 0x2a
+	that1, ok := that.(*FeeSplit)
+	if !ok {
+		that2, ok := that.(FeeSplit)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	{
-		size := m.PstakeRedemptionFee.Size()
-		i -= size
-		if _, err := m.PstakeRedemptionFee.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.DepositFeeRecipients) != len(that1.DepositFeeRecipients) {
+		return false
+	}
+	for i := range this.DepositFeeRecipients {
+		if !this.DepositFeeRecipients[i].Equal(&that1.DepositFeeRecipients[i]) {
+			return false
 		}
-		i = encodeVarintLscosmos(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size := m.PstakeUnstakeFee.Size()
-		i -= size
-		if _, err := m.PstakeUnstakeFee.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if len(this.RestakeFeeRecipients) != len(that1.RestakeFeeRecipients) {
+		return false
+	}
+	for i := range this.RestakeFeeRecipients {
+		if !this.RestakeFeeRecipients[i].Equal(&that1.RestakeFeeRecipients[i]) {
+			return false
 		}
-		i = encodeVarintLscosmos(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size := m.PstakeRestakeFee.Size()
-		i -= size
-		if _, err := m.PstakeRestakeFee.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if len(this.UnstakeFeeRecipients) != len(that1.UnstakeFeeRecipients) {
+		return false
+	}
+	for i := range this.UnstakeFeeRecipients {
+		if !this.UnstakeFeeRecipients[i].Equal(&that1.UnstakeFeeRecipients[i]) {
+			return false
 		}
-		i = encodeVarintLscosmos(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size := m.PstakeDepositFee.Size()
-		i -= size
-		if _, err := m.PstakeDepositFee.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if len(this.RedemptionFeeRecipients) != len(that1.RedemptionFeeRecipients) {
+		return false
+	}
+	for i := range this.RedemptionFeeRecipients {
+		if !this.RedemptionFeeRecipients[i].Equal(&that1.RedemptionFeeRecipients[i]) {
+			return false
 		}
-		i = encodeVarintLscosmos(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return true
 }
-
-func (m *HostChainParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *CollectedFee) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
 
-func (m *HostChainParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	that1, ok := that.(*CollectedFee)
+	if !ok {
+		that2, ok := that.(CollectedFee)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.FeeType != that1.FeeType {
+		return false
+	}
+	if this.Recipient != that1.Recipient {
+		return false
+	}
+	if len(this.Amount) != len(that1.Amount) {
+		return false
+	}
+	for i := range this.Amount {
+		if !this.Amount[i].Equal(&that1.Amount[i]) {
+			return false
+		}
+	}
+	return true
 }
+func (this *Roles) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *HostChainParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.PstakeParams.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	that1, ok := that.(*Roles)
+	if !ok {
+		that2, ok := that.(Roles)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i -= size
-		i = encodeVarintLscosmos(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x42
-	{
-		size := m.MinDeposit.Size()
-		i -= size
-		if _, err := m.MinDeposit.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintLscosmos(dAtA, i, uint64(size))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	i--
-	dAtA[i] = 0x3a
-	if len(m.MintDenom) > 0 {
-		i -= len(m.MintDenom)
-		copy(dAtA[i:], m.MintDenom)
-		i = encodeVarintLscosmos(dAtA, i, uint64(len(m.MintDenom)))
-		i--
-		dAtA[i] = 0x32
+	if len(this.Admins) != len(that1.Admins) {
+		return false
 	}
-	if len(m.BaseDenom) > 0 {
-		i -= len(m.BaseDenom)
-		copy(dAtA[i:], m.BaseDenom)
-		i = encodeVarintLscosmos(dAtA, i, uint64(len(m.BaseDenom)))
-		i--
-		dAtA[i] = 0x2a
+	for i := range this.Admins {
+		if this.Admins[i] != that1.Admins[i] {
+			return false
+		}
 	}
-	if len(m.TransferPort) > 0 {
-		i -= len(m.TransferPort)
-		copy(dAtA[i:], m.TransferPort)
-		i = encodeVarintLscosmos(dAtA, i, uint64(len(m.TransferPort)))
-		i--
-		dAtA[i] = 0x22
+	if len(this.Pausers) != len(that1.Pausers) {
+		return false
 	}
-	if len(m.TransferChannel) > 0 {
-		i -= len(m.TransferChannel)
-		copy(dAtA[i:], m.TransferChannel)
-		i = encodeVarintLscosmos(dAtA, i, uint64(len(m.TransferChannel)))
-		i--
-		dAtA[i] = 0x1a
+	for i := range this.Pausers {
+		if this.Pausers[i] != that1.Pausers[i] {
+			return false
+		}
 	}
-	if len(m.ConnectionID) > 0 {
-		i -= len(m.ConnectionID)
-		copy(dAtA[i:], m.ConnectionID)
-		i = encodeVarintLscosmos(dAtA, i, uint64(len(m.ConnectionID)))
-		i--
-		dAtA[i] = 0x12
+	if len(this.SlashingReporters) != len(that1.SlashingReporters) {
+		return false
 	}
-	if len(m.ChainID) > 0 {
-		i -= len(m.ChainID)
-		copy(dAtA[i:], m.ChainID)
-		i = encodeVarintLscosmos(dAtA, i, uint64(len(m.ChainID)))
-		i--
-		dAtA[i] = 0xa
+	for i := range this.SlashingReporters {
+		if this.SlashingReporters[i] != that1.SlashingReporters[i] {
+			return false
+		}
 	}
-	return len(dAtA) - i, nil
+	return true
 }
-
-func (m *DelegationState) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *PendingAdminChange) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PendingAdminChange)
+	if !ok {
+		that2, ok := that.(PendingAdminChange)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Admins) != len(that1.Admins) {
+		return false
+	}
+	for i := range this.Admins {
+		if this.Admins[i] != that1.Admins[i] {
+			return false
+		}
+	}
+	if !this.EffectiveTime.Equal(that1.EffectiveTime) {
+		return false
+	}
+	return true
+}
+func (this *PauseSwitches) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PauseSwitches)
+	if !ok {
+		that2, ok := that.(PauseSwitches)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Deposits != that1.Deposits {
+		return false
+	}
+	if this.Unstakes != that1.Unstakes {
+		return false
+	}
+	if this.Redeems != that1.Redeems {
+		return false
+	}
+	if this.Claims != that1.Claims {
+		return false
+	}
+	if this.DelegationEpoch != that1.DelegationEpoch {
+		return false
+	}
+	if this.RewardEpoch != that1.RewardEpoch {
+		return false
+	}
+	if this.UndelegationEpoch != that1.UndelegationEpoch {
+		return false
+	}
+	if this.HostVotes != that1.HostVotes {
+		return false
+	}
+	return true
+}
+func (this *ValidatorMetrics) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ValidatorMetrics)
+	if !ok {
+		that2, ok := that.(ValidatorMetrics)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ValidatorAddress != that1.ValidatorAddress {
+		return false
+	}
+	if this.ConsensusAddress != that1.ConsensusAddress {
+		return false
+	}
+	if !this.Commission.Equal(that1.Commission) {
+		return false
+	}
+	if this.Jailed != that1.Jailed {
+		return false
+	}
+	if this.Tombstoned != that1.Tombstoned {
+		return false
+	}
+	if this.Bonded != that1.Bonded {
+		return false
+	}
+	if !this.Tokens.Equal(that1.Tokens) {
+		return false
+	}
+	if !this.SelfBond.Equal(that1.SelfBond) {
+		return false
+	}
+	if this.UpdatedHeight != that1.UpdatedHeight {
+		return false
+	}
+	return true
+}
+func (this *ValidatorWeight) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ValidatorWeight)
+	if !ok {
+		that2, ok := that.(ValidatorWeight)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ValidatorAddress != that1.ValidatorAddress {
+		return false
+	}
+	if !this.BaseWeight.Equal(that1.BaseWeight) {
+		return false
+	}
+	if !this.EffectiveWeight.Equal(that1.EffectiveWeight) {
+		return false
+	}
+	if !this.Metrics.Equal(that1.Metrics) {
+		return false
+	}
+	return true
+}
+func (this *RewardEpochRecord) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RewardEpochRecord)
+	if !ok {
+		that2, ok := that.(RewardEpochRecord)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.EpochNumber != that1.EpochNumber {
+		return false
+	}
+	if !this.Accrued.Equal(&that1.Accrued) {
+		return false
+	}
+	if !this.Restaked.Equal(&that1.Restaked) {
+		return false
+	}
+	if !this.SentToInsuranceFund.Equal(&that1.SentToInsuranceFund) {
+		return false
+	}
+	if !this.CarriedOver.Equal(&that1.CarriedOver) {
+		return false
+	}
+	if this.ExcessRewardPolicy != that1.ExcessRewardPolicy {
+		return false
+	}
+	return true
+}
+func (this *HostProposalTally) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*HostProposalTally)
+	if !ok {
+		that2, ok := that.(HostProposalTally)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Yes.Equal(that1.Yes) {
+		return false
+	}
+	if !this.Abstain.Equal(that1.Abstain) {
+		return false
+	}
+	if !this.No.Equal(that1.No) {
+		return false
+	}
+	if !this.NoWithVeto.Equal(that1.NoWithVeto) {
+		return false
+	}
+	return true
+}
+func (m *AllowListedValidators) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
 	return dAtA[:n], nil
 }
 
-func (m *DelegationState) MarshalTo(dAtA []byte) (int, error) {
+func (m *AllowListedValidators) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DelegationState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AllowListedValidators) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.HostAccountUndelegations) > 0 {
-		for iNdEx := len(m.HostAccountUndelegations) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.HostAccountUndelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintLscosmos(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
-		}
-	}
-	if len(m.HostAccountDelegations) > 0 {
-		for iNdEx := len(m.HostAccountDelegations) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.HostAccountDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintLscosmos(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if len(m.HostChainDelegationAddress) > 0 {
-		i -= len(m.HostChainDelegationAddress)
-		copy(dAtA[i:], m.HostChainDelegationAddress)
-		i = encodeVarintLscosmos(dAtA, i, uint64(len(m.HostChainDelegationAddress)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.HostDelegationAccountBalance) > 0 {
-		for iNdEx := len(m.HostDelegationAccountBalance) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.AllowListedValidators) > 0 {
+		for iNdEx := len(m.AllowListedValidators) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.HostDelegationAccountBalance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.AllowListedValidators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -1466,7 +2438,7 @@ func (m *DelegationState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *HostAccountDelegation) Marshal() (dAtA []byte, err error) {
+func (m *AllowListedValidator) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1476,22 +2448,22 @@ func (m *HostAccountDelegation) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *HostAccountDelegation) MarshalTo(dAtA []byte) (int, error) {
+func (m *AllowListedValidator) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *HostAccountDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AllowListedValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
+		size := m.TargetWeight.Size()
+		i -= size
+		if _, err := m.TargetWeight.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
-		i -= size
 		i = encodeVarintLscosmos(dAtA, i, uint64(size))
 	}
 	i--
@@ -1506,7 +2478,7 @@ func (m *HostAccountDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *HostAccountUndelegation) Marshal() (dAtA []byte, err error) {
+func (m *PstakeParams) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1516,57 +2488,67 @@ func (m *HostAccountUndelegation) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *HostAccountUndelegation) MarshalTo(dAtA []byte) (int, error) {
+func (m *PstakeParams) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *HostAccountUndelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PstakeParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.UndelegationEntries) > 0 {
-		for iNdEx := len(m.UndelegationEntries) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.UndelegationEntries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintLscosmos(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
+	if len(m.PstakeFeeAddress) > 0 {
+		i -= len(m.PstakeFeeAddress)
+		copy(dAtA[i:], m.PstakeFeeAddress)
+		i = encodeVarintLscosmos(dAtA, i, uint64(len(m.PstakeFeeAddress)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size := m.PstakeRedemptionFee.Size()
+		i -= size
+		if _, err := m.PstakeRedemptionFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintLscosmos(dAtA, i, uint64(size))
 	}
-	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime):])
-	if err3 != nil {
-		return 0, err3
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.PstakeUnstakeFee.Size()
+		i -= size
+		if _, err := m.PstakeUnstakeFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLscosmos(dAtA, i, uint64(size))
 	}
-	i -= n3
-	i = encodeVarintLscosmos(dAtA, i, uint64(n3))
 	i--
 	dAtA[i] = 0x1a
 	{
-		size, err := m.TotalUndelegationAmount.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
+		size := m.PstakeRestakeFee.Size()
+		i -= size
+		if _, err := m.PstakeRestakeFee.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
-		i -= size
 		i = encodeVarintLscosmos(dAtA, i, uint64(size))
 	}
 	i--
 	dAtA[i] = 0x12
-	if m.EpochNumber != 0 {
-		i = encodeVarintLscosmos(dAtA, i, uint64(m.EpochNumber))
-		i--
-		dAtA[i] = 0x8
+	{
+		size := m.PstakeDepositFee.Size()
+		i -= size
+		if _, err := m.PstakeDepositFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLscosmos(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *UndelegationEntry) Marshal() (dAtA []byte, err error) {
+func (m *HostChainParams) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1576,18 +2558,18 @@ func (m *UndelegationEntry) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *UndelegationEntry) MarshalTo(dAtA []byte) (int, error) {
+func (m *HostChainParams) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *UndelegationEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *HostChainParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.PstakeParams.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -1595,48 +2577,63 @@ func (m *UndelegationEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i = encodeVarintLscosmos(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x12
-	if len(m.ValidatorAddress) > 0 {
-		i -= len(m.ValidatorAddress)
-		copy(dAtA[i:], m.ValidatorAddress)
-		i = encodeVarintLscosmos(dAtA, i, uint64(len(m.ValidatorAddress)))
+	dAtA[i] = 0x42
+	{
+		size := m.MinDeposit.Size()
+		i -= size
+		if _, err := m.MinDeposit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLscosmos(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if len(m.MintDenom) > 0 {
+		i -= len(m.MintDenom)
+		copy(dAtA[i:], m.MintDenom)
+		i = encodeVarintLscosmos(dAtA, i, uint64(len(m.MintDenom)))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x32
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *HostChainRewardAddress) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if len(m.BaseDenom) > 0 {
+		i -= len(m.BaseDenom)
+		copy(dAtA[i:], m.BaseDenom)
+		i = encodeVarintLscosmos(dAtA, i, uint64(len(m.BaseDenom)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	return dAtA[:n], nil
-}
-
-func (m *HostChainRewardAddress) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *HostChainRewardAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintLscosmos(dAtA, i, uint64(len(m.Address)))
+	if len(m.TransferPort) > 0 {
+		i -= len(m.TransferPort)
+		copy(dAtA[i:], m.TransferPort)
+		i = encodeVarintLscosmos(dAtA, i, uint64(len(m.TransferPort)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.TransferChannel) > 0 {
+		i -= len(m.TransferChannel)
+		copy(dAtA[i:], m.TransferChannel)
+		i = encodeVarintLscosmos(dAtA, i, uint64(len(m.TransferChannel)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ConnectionID) > 0 {
+		i -= len(m.ConnectionID)
+		copy(dAtA[i:], m.ConnectionID)
+		i = encodeVarintLscosmos(dAtA, i, uint64(len(m.ConnectionID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainID) > 0 {
+		i -= len(m.ChainID)
+		copy(dAtA[i:], m.ChainID)
+		i = encodeVarintLscosmos(dAtA, i, uint64(len(m.ChainID)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *IBCAmountTransientStore) Marshal() (dAtA []byte, err error) {
+func (m *DelegationState) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1646,20 +2643,20 @@ func (m *IBCAmountTransientStore) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *IBCAmountTransientStore) MarshalTo(dAtA []byte) (int, error) {
+func (m *DelegationState) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *IBCAmountTransientStore) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DelegationState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.UndelegatonCompleteIBCTransfer) > 0 {
-		for iNdEx := len(m.UndelegatonCompleteIBCTransfer) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.HostAccountUndelegations) > 0 {
+		for iNdEx := len(m.HostAccountUndelegations) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.UndelegatonCompleteIBCTransfer[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.HostAccountUndelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -1667,23 +2664,34 @@ func (m *IBCAmountTransientStore) MarshalToSizedBuffer(dAtA []byte) (int, error)
 				i = encodeVarintLscosmos(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x1a
+			dAtA[i] = 0x22
 		}
 	}
-	{
-		size, err := m.ICADelegate.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if len(m.HostAccountDelegations) > 0 {
+		for iNdEx := len(m.HostAccountDelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HostAccountDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLscosmos(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
 		}
-		i -= size
-		i = encodeVarintLscosmos(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.IBCTransfer) > 0 {
-		for iNdEx := len(m.IBCTransfer) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.HostChainDelegationAddress) > 0 {
+		i -= len(m.HostChainDelegationAddress)
+		copy(dAtA[i:], m.HostChainDelegationAddress)
+		i = encodeVarintLscosmos(dAtA, i, uint64(len(m.HostChainDelegationAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.HostDelegationAccountBalance) > 0 {
+		for iNdEx := len(m.HostDelegationAccountBalance) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.IBCTransfer[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.HostDelegationAccountBalance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -1697,7 +2705,7 @@ func (m *IBCAmountTransientStore) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *TransientUndelegationTransfer) Marshal() (dAtA []byte, err error) {
+func (m *HostAccountDelegation) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1707,18 +2715,18 @@ func (m *TransientUndelegationTransfer) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TransientUndelegationTransfer) MarshalTo(dAtA []byte) (int, error) {
+func (m *HostAccountDelegation) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TransientUndelegationTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *HostAccountDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size, err := m.AmountUnbonded.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -1727,15 +2735,17 @@ func (m *TransientUndelegationTransfer) MarshalToSizedBuffer(dAtA []byte) (int,
 	}
 	i--
 	dAtA[i] = 0x12
-	if m.EpochNumber != 0 {
-		i = encodeVarintLscosmos(dAtA, i, uint64(m.EpochNumber))
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintLscosmos(dAtA, i, uint64(len(m.ValidatorAddress)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *UnbondingEpochCValue) Marshal() (dAtA []byte, err error) {
+func (m *HostAccountUndelegation) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1745,48 +2755,40 @@ func (m *UnbondingEpochCValue) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *UnbondingEpochCValue) MarshalTo(dAtA []byte) (int, error) {
+func (m *HostAccountUndelegation) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *UnbondingEpochCValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *HostAccountUndelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.IsFailed {
-		i--
-		if m.IsFailed {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.IsMatured {
-		i--
-		if m.IsMatured {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	if len(m.UndelegationEntries) > 0 {
+		for iNdEx := len(m.UndelegationEntries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UndelegationEntries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLscosmos(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
 		}
-		i--
-		dAtA[i] = 0x20
 	}
-	{
-		size, err := m.AmountUnbonded.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintLscosmos(dAtA, i, uint64(size))
+	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime):])
+	if err3 != nil {
+		return 0, err3
 	}
+	i -= n3
+	i = encodeVarintLscosmos(dAtA, i, uint64(n3))
 	i--
 	dAtA[i] = 0x1a
 	{
-		size, err := m.STKBurn.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.TotalUndelegationAmount.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -1803,7 +2805,7 @@ func (m *UnbondingEpochCValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *DelegatorUnbondingEpochEntry) Marshal() (dAtA []byte, err error) {
+func (m *UndelegationEntry) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1813,12 +2815,12 @@ func (m *DelegatorUnbondingEpochEntry) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DelegatorUnbondingEpochEntry) MarshalTo(dAtA []byte) (int, error) {
+func (m *UndelegationEntry) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DelegatorUnbondingEpochEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *UndelegationEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1832,23 +2834,18 @@ func (m *DelegatorUnbondingEpochEntry) MarshalToSizedBuffer(dAtA []byte) (int, e
 		i = encodeVarintLscosmos(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x1a
-	if m.EpochNumber != 0 {
-		i = encodeVarintLscosmos(dAtA, i, uint64(m.EpochNumber))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.DelegatorAddress) > 0 {
-		i -= len(m.DelegatorAddress)
-		copy(dAtA[i:], m.DelegatorAddress)
-		i = encodeVarintLscosmos(dAtA, i, uint64(len(m.DelegatorAddress)))
+	dAtA[i] = 0x12
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintLscosmos(dAtA, i, uint64(len(m.ValidatorAddress)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *HostAccounts) Marshal() (dAtA []byte, err error) {
+func (m *HostChainRewardAddress) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1858,329 +2855,4309 @@ func (m *HostAccounts) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *HostAccounts) MarshalTo(dAtA []byte) (int, error) {
+func (m *HostChainRewardAddress) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *HostAccounts) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *HostChainRewardAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.RewardsAccountOwnerID) > 0 {
-		i -= len(m.RewardsAccountOwnerID)
-		copy(dAtA[i:], m.RewardsAccountOwnerID)
-		i = encodeVarintLscosmos(dAtA, i, uint64(len(m.RewardsAccountOwnerID)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.DelegatorAccountOwnerID) > 0 {
-		i -= len(m.DelegatorAccountOwnerID)
-		copy(dAtA[i:], m.DelegatorAccountOwnerID)
-		i = encodeVarintLscosmos(dAtA, i, uint64(len(m.DelegatorAccountOwnerID)))
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintLscosmos(dAtA, i, uint64(len(m.Address)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintLscosmos(dAtA []byte, offset int, v uint64) int {
-	offset -= sovLscosmos(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *IBCAmountTransientStore) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *AllowListedValidators) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *IBCAmountTransientStore) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IBCAmountTransientStore) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.AllowListedValidators) > 0 {
-		for _, e := range m.AllowListedValidators {
-			l = e.Size()
-			n += 1 + l + sovLscosmos(uint64(l))
+	if len(m.UndelegatonCompleteIBCTransfer) > 0 {
+		for iNdEx := len(m.UndelegatonCompleteIBCTransfer) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UndelegatonCompleteIBCTransfer[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLscosmos(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
 		}
 	}
-	return n
-}
-
-func (m *AllowListedValidator) Size() (n int) {
-	if m == nil {
-		return 0
+	{
+		size, err := m.ICADelegate.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLscosmos(dAtA, i, uint64(size))
 	}
-	var l int
-	_ = l
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovLscosmos(uint64(l))
+	i--
+	dAtA[i] = 0x12
+	if len(m.IBCTransfer) > 0 {
+		for iNdEx := len(m.IBCTransfer) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.IBCTransfer[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLscosmos(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	l = m.TargetWeight.Size()
-	n += 1 + l + sovLscosmos(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *PstakeParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.PstakeDepositFee.Size()
-	n += 1 + l + sovLscosmos(uint64(l))
-	l = m.PstakeRestakeFee.Size()
-	n += 1 + l + sovLscosmos(uint64(l))
-	l = m.PstakeUnstakeFee.Size()
-	n += 1 + l + sovLscosmos(uint64(l))
-	l = m.PstakeRedemptionFee.Size()
-	n += 1 + l + sovLscosmos(uint64(l))
-	l = len(m.PstakeFeeAddress)
-	if l > 0 {
-		n += 1 + l + sovLscosmos(uint64(l))
+func (m *TransientUndelegationTransfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *HostChainParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *TransientUndelegationTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TransientUndelegationTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ChainID)
-	if l > 0 {
-		n += 1 + l + sovLscosmos(uint64(l))
-	}
-	l = len(m.ConnectionID)
-	if l > 0 {
-		n += 1 + l + sovLscosmos(uint64(l))
-	}
-	l = len(m.TransferChannel)
-	if l > 0 {
-		n += 1 + l + sovLscosmos(uint64(l))
-	}
-	l = len(m.TransferPort)
-	if l > 0 {
-		n += 1 + l + sovLscosmos(uint64(l))
-	}
-	l = len(m.BaseDenom)
-	if l > 0 {
-		n += 1 + l + sovLscosmos(uint64(l))
+	{
+		size, err := m.AmountUnbonded.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLscosmos(dAtA, i, uint64(size))
 	}
-	l = len(m.MintDenom)
-	if l > 0 {
-		n += 1 + l + sovLscosmos(uint64(l))
+	i--
+	dAtA[i] = 0x12
+	if m.EpochNumber != 0 {
+		i = encodeVarintLscosmos(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x8
 	}
-	l = m.MinDeposit.Size()
-	n += 1 + l + sovLscosmos(uint64(l))
-	l = m.PstakeParams.Size()
-	n += 1 + l + sovLscosmos(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *DelegationState) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *UnbondingEpochCValue) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *UnbondingEpochCValue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnbondingEpochCValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.HostDelegationAccountBalance) > 0 {
-		for _, e := range m.HostDelegationAccountBalance {
-			l = e.Size()
-			n += 1 + l + sovLscosmos(uint64(l))
+	if m.IsFailed {
+		i--
+		if m.IsFailed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x28
 	}
-	l = len(m.HostChainDelegationAddress)
-	if l > 0 {
-		n += 1 + l + sovLscosmos(uint64(l))
-	}
-	if len(m.HostAccountDelegations) > 0 {
-		for _, e := range m.HostAccountDelegations {
-			l = e.Size()
-			n += 1 + l + sovLscosmos(uint64(l))
+	if m.IsMatured {
+		i--
+		if m.IsMatured {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x20
 	}
-	if len(m.HostAccountUndelegations) > 0 {
-		for _, e := range m.HostAccountUndelegations {
-			l = e.Size()
-			n += 1 + l + sovLscosmos(uint64(l))
+	{
+		size, err := m.AmountUnbonded.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintLscosmos(dAtA, i, uint64(size))
 	}
-	return n
-}
-
-func (m *HostAccountDelegation) Size() (n int) {
-	if m == nil {
-		return 0
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.STKBurn.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLscosmos(dAtA, i, uint64(size))
 	}
-	var l int
-	_ = l
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovLscosmos(uint64(l))
+	i--
+	dAtA[i] = 0x12
+	if m.EpochNumber != 0 {
+		i = encodeVarintLscosmos(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x8
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovLscosmos(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *HostAccountUndelegation) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *DelegatorUnbondingEpochEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegatorUnbondingEpochEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegatorUnbondingEpochEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLscosmos(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
 	if m.EpochNumber != 0 {
-		n += 1 + sovLscosmos(uint64(m.EpochNumber))
+		i = encodeVarintLscosmos(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = m.TotalUndelegationAmount.Size()
-	n += 1 + l + sovLscosmos(uint64(l))
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime)
-	n += 1 + l + sovLscosmos(uint64(l))
-	if len(m.UndelegationEntries) > 0 {
-		for _, e := range m.UndelegationEntries {
-			l = e.Size()
-			n += 1 + l + sovLscosmos(uint64(l))
-		}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintLscosmos(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *UndelegationEntry) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovLscosmos(uint64(l))
+func (m *PendingAutoClaimEpoch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovLscosmos(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *HostChainRewardAddress) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovLscosmos(uint64(l))
-	}
-	return n
+func (m *PendingAutoClaimEpoch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *IBCAmountTransientStore) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *PendingAutoClaimEpoch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.IBCTransfer) > 0 {
-		for _, e := range m.IBCTransfer {
-			l = e.Size()
-			n += 1 + l + sovLscosmos(uint64(l))
-		}
+	if len(m.LastDelegatorAddress) > 0 {
+		i -= len(m.LastDelegatorAddress)
+		copy(dAtA[i:], m.LastDelegatorAddress)
+		i = encodeVarintLscosmos(dAtA, i, uint64(len(m.LastDelegatorAddress)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = m.ICADelegate.Size()
-	n += 1 + l + sovLscosmos(uint64(l))
-	if len(m.UndelegatonCompleteIBCTransfer) > 0 {
-		for _, e := range m.UndelegatonCompleteIBCTransfer {
-			l = e.Size()
-			n += 1 + l + sovLscosmos(uint64(l))
-		}
+	if m.EpochNumber != 0 {
+		i = encodeVarintLscosmos(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *TransientUndelegationTransfer) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *HostAccounts) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *HostAccounts) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HostAccounts) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.EpochNumber != 0 {
-		n += 1 + sovLscosmos(uint64(m.EpochNumber))
+	if len(m.RewardsAccountOwnerID) > 0 {
+		i -= len(m.RewardsAccountOwnerID)
+		copy(dAtA[i:], m.RewardsAccountOwnerID)
+		i = encodeVarintLscosmos(dAtA, i, uint64(len(m.RewardsAccountOwnerID)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = m.AmountUnbonded.Size()
-	n += 1 + l + sovLscosmos(uint64(l))
-	return n
+	if len(m.DelegatorAccountOwnerID) > 0 {
+		i -= len(m.DelegatorAccountOwnerID)
+		copy(dAtA[i:], m.DelegatorAccountOwnerID)
+		i = encodeVarintLscosmos(dAtA, i, uint64(len(m.DelegatorAccountOwnerID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *UnbondingEpochCValue) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *AddressDeposits) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *AddressDeposits) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddressDeposits) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.EpochNumber != 0 {
-		n += 1 + sovLscosmos(uint64(m.EpochNumber))
-	}
-	l = m.STKBurn.Size()
-	n += 1 + l + sovLscosmos(uint64(l))
-	l = m.AmountUnbonded.Size()
-	n += 1 + l + sovLscosmos(uint64(l))
-	if m.IsMatured {
-		n += 2
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLscosmos(dAtA, i, uint64(size))
 	}
-	if m.IsFailed {
-		n += 2
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintLscosmos(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *DelegatorUnbondingEpochEntry) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EpochDeposits) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EpochDeposits) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EpochDeposits) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovLscosmos(uint64(l))
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLscosmos(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0x12
 	if m.EpochNumber != 0 {
-		n += 1 + sovLscosmos(uint64(m.EpochNumber))
+		i = encodeVarintLscosmos(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x8
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovLscosmos(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *HostAccounts) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *FeeRecipient) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeRecipient) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeRecipient) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.DelegatorAccountOwnerID)
-	if l > 0 {
-		n += 1 + l + sovLscosmos(uint64(l))
+	{
+		size := m.Weight.Size()
+		i -= size
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLscosmos(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintLscosmos(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeSplit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeSplit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeSplit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RedemptionFeeRecipients) > 0 {
+		for iNdEx := len(m.RedemptionFeeRecipients) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RedemptionFeeRecipients[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLscosmos(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.UnstakeFeeRecipients) > 0 {
+		for iNdEx := len(m.UnstakeFeeRecipients) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UnstakeFeeRecipients[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLscosmos(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.RestakeFeeRecipients) > 0 {
+		for iNdEx := len(m.RestakeFeeRecipients) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RestakeFeeRecipients[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLscosmos(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.DepositFeeRecipients) > 0 {
+		for iNdEx := len(m.DepositFeeRecipients) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DepositFeeRecipients[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLscosmos(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CollectedFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CollectedFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CollectedFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLscosmos(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintLscosmos(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FeeType) > 0 {
+		i -= len(m.FeeType)
+		copy(dAtA[i:], m.FeeType)
+		i = encodeVarintLscosmos(dAtA, i, uint64(len(m.FeeType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Roles) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Roles) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Roles) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SlashingReporters) > 0 {
+		for iNdEx := len(m.SlashingReporters) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SlashingReporters[iNdEx])
+			copy(dAtA[i:], m.SlashingReporters[iNdEx])
+			i = encodeVarintLscosmos(dAtA, i, uint64(len(m.SlashingReporters[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Pausers) > 0 {
+		for iNdEx := len(m.Pausers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Pausers[iNdEx])
+			copy(dAtA[i:], m.Pausers[iNdEx])
+			i = encodeVarintLscosmos(dAtA, i, uint64(len(m.Pausers[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Admins) > 0 {
+		for iNdEx := len(m.Admins) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Admins[iNdEx])
+			copy(dAtA[i:], m.Admins[iNdEx])
+			i = encodeVarintLscosmos(dAtA, i, uint64(len(m.Admins[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingAdminChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingAdminChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingAdminChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n11, err11 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EffectiveTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EffectiveTime):])
+	if err11 != nil {
+		return 0, err11
+	}
+	i -= n11
+	i = encodeVarintLscosmos(dAtA, i, uint64(n11))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Admins) > 0 {
+		for iNdEx := len(m.Admins) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Admins[iNdEx])
+			copy(dAtA[i:], m.Admins[iNdEx])
+			i = encodeVarintLscosmos(dAtA, i, uint64(len(m.Admins[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PauseSwitches) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PauseSwitches) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PauseSwitches) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.HostVotes {
+		i--
+		if m.HostVotes {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.UndelegationEpoch {
+		i--
+		if m.UndelegationEpoch {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.RewardEpoch {
+		i--
+		if m.RewardEpoch {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.DelegationEpoch {
+		i--
+		if m.DelegationEpoch {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Claims {
+		i--
+		if m.Claims {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Redeems {
+		i--
+		if m.Redeems {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Unstakes {
+		i--
+		if m.Unstakes {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Deposits {
+		i--
+		if m.Deposits {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorMetrics) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorMetrics) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorMetrics) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UpdatedHeight != 0 {
+		i = encodeVarintLscosmos(dAtA, i, uint64(m.UpdatedHeight))
+		i--
+		dAtA[i] = 0x48
+	}
+	{
+		size := m.SelfBond.Size()
+		i -= size
+		if _, err := m.SelfBond.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLscosmos(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.Tokens.Size()
+		i -= size
+		if _, err := m.Tokens.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLscosmos(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.Bonded {
+		i--
+		if m.Bonded {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Tombstoned {
+		i--
+		if m.Tombstoned {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Jailed {
+		i--
+		if m.Jailed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.Commission.Size()
+		i -= size
+		if _, err := m.Commission.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLscosmos(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ConsensusAddress) > 0 {
+		i -= len(m.ConsensusAddress)
+		copy(dAtA[i:], m.ConsensusAddress)
+		i = encodeVarintLscosmos(dAtA, i, uint64(len(m.ConsensusAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintLscosmos(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorWeight) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorWeight) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorWeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Metrics != nil {
+		{
+			size, err := m.Metrics.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLscosmos(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size := m.EffectiveWeight.Size()
+		i -= size
+		if _, err := m.EffectiveWeight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLscosmos(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.BaseWeight.Size()
+		i -= size
+		if _, err := m.BaseWeight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLscosmos(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintLscosmos(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RewardEpochRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RewardEpochRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RewardEpochRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExcessRewardPolicy != 0 {
+		i = encodeVarintLscosmos(dAtA, i, uint64(m.ExcessRewardPolicy))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size, err := m.CarriedOver.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLscosmos(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.SentToInsuranceFund.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLscosmos(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.Restaked.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLscosmos(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Accrued.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLscosmos(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.EpochNumber != 0 {
+		i = encodeVarintLscosmos(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HostProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HostProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HostProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Options) > 0 {
+		for iNdEx := len(m.Options) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Options[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLscosmos(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	{
+		size, err := m.Tally.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLscosmos(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.SnapshotHeight != 0 {
+		i = encodeVarintLscosmos(dAtA, i, uint64(m.SnapshotHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Status != 0 {
+		i = encodeVarintLscosmos(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x18
+	}
+	n18, err18 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.VotingEndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.VotingEndTime):])
+	if err18 != nil {
+		return 0, err18
+	}
+	i -= n18
+	i = encodeVarintLscosmos(dAtA, i, uint64(n18))
+	i--
+	dAtA[i] = 0x12
+	if m.ProposalId != 0 {
+		i = encodeVarintLscosmos(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HostProposalTally) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HostProposalTally) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HostProposalTally) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.NoWithVeto.Size()
+		i -= size
+		if _, err := m.NoWithVeto.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLscosmos(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.No.Size()
+		i -= size
+		if _, err := m.No.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLscosmos(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Abstain.Size()
+		i -= size
+		if _, err := m.Abstain.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLscosmos(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Yes.Size()
+		i -= size
+		if _, err := m.Yes.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLscosmos(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *HostProposalVote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HostProposalVote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HostProposalVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Options) > 0 {
+		for iNdEx := len(m.Options) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Options[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLscosmos(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Voter) > 0 {
+		i -= len(m.Voter)
+		copy(dAtA[i:], m.Voter)
+		i = encodeVarintLscosmos(dAtA, i, uint64(len(m.Voter)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintLscosmos(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintLscosmos(dAtA []byte, offset int, v uint64) int {
+	offset -= sovLscosmos(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *AllowListedValidators) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AllowListedValidators) > 0 {
+		for _, e := range m.AllowListedValidators {
+			l = e.Size()
+			n += 1 + l + sovLscosmos(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AllowListedValidator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovLscosmos(uint64(l))
+	}
+	l = m.TargetWeight.Size()
+	n += 1 + l + sovLscosmos(uint64(l))
+	return n
+}
+
+func (m *PstakeParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PstakeDepositFee.Size()
+	n += 1 + l + sovLscosmos(uint64(l))
+	l = m.PstakeRestakeFee.Size()
+	n += 1 + l + sovLscosmos(uint64(l))
+	l = m.PstakeUnstakeFee.Size()
+	n += 1 + l + sovLscosmos(uint64(l))
+	l = m.PstakeRedemptionFee.Size()
+	n += 1 + l + sovLscosmos(uint64(l))
+	l = len(m.PstakeFeeAddress)
+	if l > 0 {
+		n += 1 + l + sovLscosmos(uint64(l))
+	}
+	return n
+}
+
+func (m *HostChainParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovLscosmos(uint64(l))
+	}
+	l = len(m.ConnectionID)
+	if l > 0 {
+		n += 1 + l + sovLscosmos(uint64(l))
+	}
+	l = len(m.TransferChannel)
+	if l > 0 {
+		n += 1 + l + sovLscosmos(uint64(l))
+	}
+	l = len(m.TransferPort)
+	if l > 0 {
+		n += 1 + l + sovLscosmos(uint64(l))
+	}
+	l = len(m.BaseDenom)
+	if l > 0 {
+		n += 1 + l + sovLscosmos(uint64(l))
+	}
+	l = len(m.MintDenom)
+	if l > 0 {
+		n += 1 + l + sovLscosmos(uint64(l))
+	}
+	l = m.MinDeposit.Size()
+	n += 1 + l + sovLscosmos(uint64(l))
+	l = m.PstakeParams.Size()
+	n += 1 + l + sovLscosmos(uint64(l))
+	return n
+}
+
+func (m *DelegationState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.HostDelegationAccountBalance) > 0 {
+		for _, e := range m.HostDelegationAccountBalance {
+			l = e.Size()
+			n += 1 + l + sovLscosmos(uint64(l))
+		}
+	}
+	l = len(m.HostChainDelegationAddress)
+	if l > 0 {
+		n += 1 + l + sovLscosmos(uint64(l))
+	}
+	if len(m.HostAccountDelegations) > 0 {
+		for _, e := range m.HostAccountDelegations {
+			l = e.Size()
+			n += 1 + l + sovLscosmos(uint64(l))
+		}
+	}
+	if len(m.HostAccountUndelegations) > 0 {
+		for _, e := range m.HostAccountUndelegations {
+			l = e.Size()
+			n += 1 + l + sovLscosmos(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *HostAccountDelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovLscosmos(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovLscosmos(uint64(l))
+	return n
+}
+
+func (m *HostAccountUndelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochNumber != 0 {
+		n += 1 + sovLscosmos(uint64(m.EpochNumber))
+	}
+	l = m.TotalUndelegationAmount.Size()
+	n += 1 + l + sovLscosmos(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime)
+	n += 1 + l + sovLscosmos(uint64(l))
+	if len(m.UndelegationEntries) > 0 {
+		for _, e := range m.UndelegationEntries {
+			l = e.Size()
+			n += 1 + l + sovLscosmos(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *UndelegationEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovLscosmos(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovLscosmos(uint64(l))
+	return n
+}
+
+func (m *HostChainRewardAddress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovLscosmos(uint64(l))
+	}
+	return n
+}
+
+func (m *IBCAmountTransientStore) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.IBCTransfer) > 0 {
+		for _, e := range m.IBCTransfer {
+			l = e.Size()
+			n += 1 + l + sovLscosmos(uint64(l))
+		}
+	}
+	l = m.ICADelegate.Size()
+	n += 1 + l + sovLscosmos(uint64(l))
+	if len(m.UndelegatonCompleteIBCTransfer) > 0 {
+		for _, e := range m.UndelegatonCompleteIBCTransfer {
+			l = e.Size()
+			n += 1 + l + sovLscosmos(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TransientUndelegationTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochNumber != 0 {
+		n += 1 + sovLscosmos(uint64(m.EpochNumber))
+	}
+	l = m.AmountUnbonded.Size()
+	n += 1 + l + sovLscosmos(uint64(l))
+	return n
+}
+
+func (m *UnbondingEpochCValue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochNumber != 0 {
+		n += 1 + sovLscosmos(uint64(m.EpochNumber))
+	}
+	l = m.STKBurn.Size()
+	n += 1 + l + sovLscosmos(uint64(l))
+	l = m.AmountUnbonded.Size()
+	n += 1 + l + sovLscosmos(uint64(l))
+	if m.IsMatured {
+		n += 2
+	}
+	if m.IsFailed {
+		n += 2
+	}
+	return n
+}
+
+func (m *DelegatorUnbondingEpochEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovLscosmos(uint64(l))
+	}
+	if m.EpochNumber != 0 {
+		n += 1 + sovLscosmos(uint64(m.EpochNumber))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovLscosmos(uint64(l))
+	return n
+}
+
+func (m *PendingAutoClaimEpoch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochNumber != 0 {
+		n += 1 + sovLscosmos(uint64(m.EpochNumber))
+	}
+	l = len(m.LastDelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovLscosmos(uint64(l))
+	}
+	return n
+}
+
+func (m *HostAccounts) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAccountOwnerID)
+	if l > 0 {
+		n += 1 + l + sovLscosmos(uint64(l))
+	}
+	l = len(m.RewardsAccountOwnerID)
+	if l > 0 {
+		n += 1 + l + sovLscosmos(uint64(l))
+	}
+	return n
+}
+
+func (m *AddressDeposits) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovLscosmos(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovLscosmos(uint64(l))
+	return n
+}
+
+func (m *EpochDeposits) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochNumber != 0 {
+		n += 1 + sovLscosmos(uint64(m.EpochNumber))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovLscosmos(uint64(l))
+	return n
+}
+
+func (m *FeeRecipient) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovLscosmos(uint64(l))
+	}
+	l = m.Weight.Size()
+	n += 1 + l + sovLscosmos(uint64(l))
+	return n
+}
+
+func (m *FeeSplit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.DepositFeeRecipients) > 0 {
+		for _, e := range m.DepositFeeRecipients {
+			l = e.Size()
+			n += 1 + l + sovLscosmos(uint64(l))
+		}
+	}
+	if len(m.RestakeFeeRecipients) > 0 {
+		for _, e := range m.RestakeFeeRecipients {
+			l = e.Size()
+			n += 1 + l + sovLscosmos(uint64(l))
+		}
+	}
+	if len(m.UnstakeFeeRecipients) > 0 {
+		for _, e := range m.UnstakeFeeRecipients {
+			l = e.Size()
+			n += 1 + l + sovLscosmos(uint64(l))
+		}
+	}
+	if len(m.RedemptionFeeRecipients) > 0 {
+		for _, e := range m.RedemptionFeeRecipients {
+			l = e.Size()
+			n += 1 + l + sovLscosmos(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CollectedFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FeeType)
+	if l > 0 {
+		n += 1 + l + sovLscosmos(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovLscosmos(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovLscosmos(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Roles) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Admins) > 0 {
+		for _, s := range m.Admins {
+			l = len(s)
+			n += 1 + l + sovLscosmos(uint64(l))
+		}
+	}
+	if len(m.Pausers) > 0 {
+		for _, s := range m.Pausers {
+			l = len(s)
+			n += 1 + l + sovLscosmos(uint64(l))
+		}
+	}
+	if len(m.SlashingReporters) > 0 {
+		for _, s := range m.SlashingReporters {
+			l = len(s)
+			n += 1 + l + sovLscosmos(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PendingAdminChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Admins) > 0 {
+		for _, s := range m.Admins {
+			l = len(s)
+			n += 1 + l + sovLscosmos(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.EffectiveTime)
+	n += 1 + l + sovLscosmos(uint64(l))
+	return n
+}
+
+func (m *PauseSwitches) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Deposits {
+		n += 2
+	}
+	if m.Unstakes {
+		n += 2
+	}
+	if m.Redeems {
+		n += 2
+	}
+	if m.Claims {
+		n += 2
+	}
+	if m.DelegationEpoch {
+		n += 2
+	}
+	if m.RewardEpoch {
+		n += 2
+	}
+	if m.UndelegationEpoch {
+		n += 2
+	}
+	if m.HostVotes {
+		n += 2
+	}
+	return n
+}
+
+func (m *ValidatorMetrics) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovLscosmos(uint64(l))
+	}
+	l = len(m.ConsensusAddress)
+	if l > 0 {
+		n += 1 + l + sovLscosmos(uint64(l))
+	}
+	l = m.Commission.Size()
+	n += 1 + l + sovLscosmos(uint64(l))
+	if m.Jailed {
+		n += 2
+	}
+	if m.Tombstoned {
+		n += 2
+	}
+	if m.Bonded {
+		n += 2
+	}
+	l = m.Tokens.Size()
+	n += 1 + l + sovLscosmos(uint64(l))
+	l = m.SelfBond.Size()
+	n += 1 + l + sovLscosmos(uint64(l))
+	if m.UpdatedHeight != 0 {
+		n += 1 + sovLscosmos(uint64(m.UpdatedHeight))
+	}
+	return n
+}
+
+func (m *ValidatorWeight) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovLscosmos(uint64(l))
+	}
+	l = m.BaseWeight.Size()
+	n += 1 + l + sovLscosmos(uint64(l))
+	l = m.EffectiveWeight.Size()
+	n += 1 + l + sovLscosmos(uint64(l))
+	if m.Metrics != nil {
+		l = m.Metrics.Size()
+		n += 1 + l + sovLscosmos(uint64(l))
+	}
+	return n
+}
+
+func (m *RewardEpochRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochNumber != 0 {
+		n += 1 + sovLscosmos(uint64(m.EpochNumber))
+	}
+	l = m.Accrued.Size()
+	n += 1 + l + sovLscosmos(uint64(l))
+	l = m.Restaked.Size()
+	n += 1 + l + sovLscosmos(uint64(l))
+	l = m.SentToInsuranceFund.Size()
+	n += 1 + l + sovLscosmos(uint64(l))
+	l = m.CarriedOver.Size()
+	n += 1 + l + sovLscosmos(uint64(l))
+	if m.ExcessRewardPolicy != 0 {
+		n += 1 + sovLscosmos(uint64(m.ExcessRewardPolicy))
+	}
+	return n
+}
+
+func (m *HostProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovLscosmos(uint64(m.ProposalId))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.VotingEndTime)
+	n += 1 + l + sovLscosmos(uint64(l))
+	if m.Status != 0 {
+		n += 1 + sovLscosmos(uint64(m.Status))
+	}
+	if m.SnapshotHeight != 0 {
+		n += 1 + sovLscosmos(uint64(m.SnapshotHeight))
+	}
+	l = m.Tally.Size()
+	n += 1 + l + sovLscosmos(uint64(l))
+	if len(m.Options) > 0 {
+		for _, e := range m.Options {
+			l = e.Size()
+			n += 1 + l + sovLscosmos(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *HostProposalTally) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Yes.Size()
+	n += 1 + l + sovLscosmos(uint64(l))
+	l = m.Abstain.Size()
+	n += 1 + l + sovLscosmos(uint64(l))
+	l = m.No.Size()
+	n += 1 + l + sovLscosmos(uint64(l))
+	l = m.NoWithVeto.Size()
+	n += 1 + l + sovLscosmos(uint64(l))
+	return n
+}
+
+func (m *HostProposalVote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovLscosmos(uint64(m.ProposalId))
+	}
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovLscosmos(uint64(l))
+	}
+	if len(m.Options) > 0 {
+		for _, e := range m.Options {
+			l = e.Size()
+			n += 1 + l + sovLscosmos(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovLscosmos(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozLscosmos(x uint64) (n int) {
+	return sovLscosmos(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *AllowListedValidators) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLscosmos
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AllowListedValidators: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AllowListedValidators: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowListedValidators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLscosmos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLscosmos
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLscosmos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowListedValidators = append(m.AllowListedValidators, AllowListedValidator{})
+			if err := m.AllowListedValidators[len(m.AllowListedValidators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLscosmos(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLscosmos
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AllowListedValidator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLscosmos
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AllowListedValidator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AllowListedValidator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLscosmos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLscosmos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLscosmos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetWeight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLscosmos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLscosmos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLscosmos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TargetWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLscosmos(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLscosmos
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PstakeParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLscosmos
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PstakeParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PstakeParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PstakeDepositFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLscosmos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLscosmos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLscosmos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PstakeDepositFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PstakeRestakeFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLscosmos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLscosmos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLscosmos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PstakeRestakeFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PstakeUnstakeFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLscosmos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLscosmos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLscosmos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PstakeUnstakeFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PstakeRedemptionFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLscosmos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLscosmos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLscosmos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PstakeRedemptionFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PstakeFeeAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLscosmos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLscosmos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLscosmos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PstakeFeeAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLscosmos(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLscosmos
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HostChainParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLscosmos
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HostChainParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HostChainParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLscosmos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLscosmos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLscosmos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLscosmos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLscosmos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLscosmos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferChannel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLscosmos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLscosmos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLscosmos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TransferChannel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferPort", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLscosmos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLscosmos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLscosmos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TransferPort = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLscosmos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLscosmos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLscosmos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLscosmos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLscosmos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLscosmos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MintDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinDeposit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLscosmos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLscosmos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLscosmos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinDeposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PstakeParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLscosmos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLscosmos
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLscosmos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PstakeParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLscosmos(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLscosmos
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DelegationState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLscosmos
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelegationState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelegationState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostDelegationAccountBalance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLscosmos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLscosmos
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLscosmos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HostDelegationAccountBalance = append(m.HostDelegationAccountBalance, types.Coin{})
+			if err := m.HostDelegationAccountBalance[len(m.HostDelegationAccountBalance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostChainDelegationAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLscosmos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLscosmos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLscosmos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HostChainDelegationAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostAccountDelegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLscosmos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLscosmos
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLscosmos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HostAccountDelegations = append(m.HostAccountDelegations, HostAccountDelegation{})
+			if err := m.HostAccountDelegations[len(m.HostAccountDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostAccountUndelegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLscosmos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLscosmos
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLscosmos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HostAccountUndelegations = append(m.HostAccountUndelegations, HostAccountUndelegation{})
+			if err := m.HostAccountUndelegations[len(m.HostAccountUndelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLscosmos(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLscosmos
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HostAccountDelegation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLscosmos
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HostAccountDelegation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HostAccountDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLscosmos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLscosmos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLscosmos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLscosmos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLscosmos
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLscosmos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLscosmos(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLscosmos
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HostAccountUndelegation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLscosmos
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HostAccountUndelegation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HostAccountUndelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLscosmos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalUndelegationAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLscosmos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLscosmos
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLscosmos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalUndelegationAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLscosmos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLscosmos
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLscosmos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UndelegationEntries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLscosmos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLscosmos
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLscosmos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UndelegationEntries = append(m.UndelegationEntries, UndelegationEntry{})
+			if err := m.UndelegationEntries[len(m.UndelegationEntries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLscosmos(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLscosmos
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UndelegationEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLscosmos
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UndelegationEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UndelegationEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLscosmos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLscosmos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLscosmos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLscosmos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLscosmos
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLscosmos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLscosmos(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLscosmos
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HostChainRewardAddress) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLscosmos
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HostChainRewardAddress: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HostChainRewardAddress: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLscosmos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLscosmos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLscosmos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLscosmos(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLscosmos
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IBCAmountTransientStore) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLscosmos
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IBCAmountTransientStore: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IBCAmountTransientStore: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IBCTransfer", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLscosmos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLscosmos
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLscosmos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IBCTransfer = append(m.IBCTransfer, types.Coin{})
+			if err := m.IBCTransfer[len(m.IBCTransfer)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ICADelegate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLscosmos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLscosmos
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLscosmos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ICADelegate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UndelegatonCompleteIBCTransfer", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLscosmos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLscosmos
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLscosmos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UndelegatonCompleteIBCTransfer = append(m.UndelegatonCompleteIBCTransfer, TransientUndelegationTransfer{})
+			if err := m.UndelegatonCompleteIBCTransfer[len(m.UndelegatonCompleteIBCTransfer)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLscosmos(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLscosmos
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TransientUndelegationTransfer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLscosmos
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransientUndelegationTransfer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransientUndelegationTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLscosmos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountUnbonded", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLscosmos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLscosmos
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLscosmos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AmountUnbonded.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLscosmos(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLscosmos
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnbondingEpochCValue) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLscosmos
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnbondingEpochCValue: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnbondingEpochCValue: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLscosmos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field STKBurn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLscosmos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLscosmos
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLscosmos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.STKBurn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountUnbonded", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLscosmos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLscosmos
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLscosmos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AmountUnbonded.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsMatured", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLscosmos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsMatured = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsFailed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLscosmos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsFailed = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLscosmos(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLscosmos
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DelegatorUnbondingEpochEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLscosmos
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelegatorUnbondingEpochEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelegatorUnbondingEpochEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLscosmos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLscosmos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLscosmos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLscosmos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLscosmos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLscosmos
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLscosmos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLscosmos(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLscosmos
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PendingAutoClaimEpoch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLscosmos
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingAutoClaimEpoch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingAutoClaimEpoch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLscosmos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastDelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLscosmos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLscosmos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLscosmos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LastDelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLscosmos(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLscosmos
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HostAccounts) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLscosmos
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HostAccounts: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HostAccounts: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAccountOwnerID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLscosmos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLscosmos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLscosmos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAccountOwnerID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardsAccountOwnerID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLscosmos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLscosmos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLscosmos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardsAccountOwnerID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLscosmos(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLscosmos
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AddressDeposits) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLscosmos
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddressDeposits: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddressDeposits: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLscosmos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLscosmos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLscosmos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLscosmos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLscosmos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLscosmos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLscosmos(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLscosmos
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	l = len(m.RewardsAccountOwnerID)
-	if l > 0 {
-		n += 1 + l + sovLscosmos(uint64(l))
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
+func (m *EpochDeposits) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLscosmos
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EpochDeposits: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EpochDeposits: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLscosmos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLscosmos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLscosmos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLscosmos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLscosmos(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLscosmos
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovLscosmos(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func sozLscosmos(x uint64) (n int) {
-	return sovLscosmos(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *FeeRecipient) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLscosmos
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeRecipient: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeRecipient: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLscosmos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLscosmos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLscosmos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLscosmos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLscosmos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLscosmos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLscosmos(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLscosmos
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *AllowListedValidators) Unmarshal(dAtA []byte) error {
+func (m *FeeSplit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2190,28 +7167,62 @@ func (m *AllowListedValidators) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowLscosmos
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeSplit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeSplit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositFeeRecipients", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLscosmos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLscosmos
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLscosmos
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.DepositFeeRecipients = append(m.DepositFeeRecipients, FeeRecipient{})
+			if err := m.DepositFeeRecipients[len(m.DepositFeeRecipients)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: AllowListedValidators: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AllowListedValidators: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllowListedValidators", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RestakeFeeRecipients", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2238,8 +7249,76 @@ func (m *AllowListedValidators) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AllowListedValidators = append(m.AllowListedValidators, AllowListedValidator{})
-			if err := m.AllowListedValidators[len(m.AllowListedValidators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.RestakeFeeRecipients = append(m.RestakeFeeRecipients, FeeRecipient{})
+			if err := m.RestakeFeeRecipients[len(m.RestakeFeeRecipients)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnstakeFeeRecipients", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLscosmos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLscosmos
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLscosmos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnstakeFeeRecipients = append(m.UnstakeFeeRecipients, FeeRecipient{})
+			if err := m.UnstakeFeeRecipients[len(m.UnstakeFeeRecipients)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedemptionFeeRecipients", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLscosmos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLscosmos
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLscosmos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RedemptionFeeRecipients = append(m.RedemptionFeeRecipients, FeeRecipient{})
+			if err := m.RedemptionFeeRecipients[len(m.RedemptionFeeRecipients)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2264,7 +7343,7 @@ func (m *AllowListedValidators) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AllowListedValidator) Unmarshal(dAtA []byte) error {
+func (m *CollectedFee) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2287,15 +7366,15 @@ func (m *AllowListedValidator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AllowListedValidator: wiretype end group for non-group")
+			return fmt.Errorf("proto: CollectedFee: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AllowListedValidator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CollectedFee: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeType", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2323,11 +7402,11 @@ func (m *AllowListedValidator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			m.FeeType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetWeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2355,7 +7434,39 @@ func (m *AllowListedValidator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TargetWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLscosmos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLscosmos
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLscosmos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2380,7 +7491,7 @@ func (m *AllowListedValidator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PstakeParams) Unmarshal(dAtA []byte) error {
+func (m *Roles) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2403,15 +7514,15 @@ func (m *PstakeParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PstakeParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: Roles: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PstakeParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Roles: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PstakeDepositFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Admins", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2439,13 +7550,11 @@ func (m *PstakeParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PstakeDepositFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Admins = append(m.Admins, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PstakeRestakeFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pausers", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2473,13 +7582,11 @@ func (m *PstakeParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PstakeRestakeFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Pausers = append(m.Pausers, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PstakeUnstakeFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashingReporters", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2507,13 +7614,61 @@ func (m *PstakeParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PstakeUnstakeFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.SlashingReporters = append(m.SlashingReporters, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLscosmos(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 4:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLscosmos
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PendingAdminChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLscosmos
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingAdminChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingAdminChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PstakeRedemptionFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Admins", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2541,15 +7696,13 @@ func (m *PstakeParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PstakeRedemptionFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Admins = append(m.Admins, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PstakeFeeAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLscosmos
@@ -2559,23 +7712,24 @@ func (m *PstakeParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthLscosmos
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthLscosmos
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PstakeFeeAddress = string(dAtA[iNdEx:postIndex])
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.EffectiveTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2598,7 +7752,7 @@ func (m *PstakeParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HostChainParams) Unmarshal(dAtA []byte) error {
+func (m *PauseSwitches) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2621,17 +7775,17 @@ func (m *HostChainParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HostChainParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: PauseSwitches: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HostChainParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PauseSwitches: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposits", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLscosmos
@@ -2641,29 +7795,17 @@ func (m *HostChainParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthLscosmos
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthLscosmos
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ChainID = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Deposits = bool(v != 0)
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionID", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unstakes", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLscosmos
@@ -2673,29 +7815,17 @@ func (m *HostChainParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthLscosmos
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthLscosmos
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.ConnectionID = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Unstakes = bool(v != 0)
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TransferChannel", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Redeems", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLscosmos
@@ -2705,29 +7835,17 @@ func (m *HostChainParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthLscosmos
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthLscosmos
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TransferChannel = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Redeems = bool(v != 0)
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TransferPort", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Claims", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLscosmos
@@ -2737,29 +7855,17 @@ func (m *HostChainParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthLscosmos
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthLscosmos
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TransferPort = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Claims = bool(v != 0)
 		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegationEpoch", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLscosmos
@@ -2769,29 +7875,17 @@ func (m *HostChainParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthLscosmos
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthLscosmos
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.BaseDenom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.DelegationEpoch = bool(v != 0)
 		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MintDenom", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardEpoch", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLscosmos
@@ -2801,29 +7895,17 @@ func (m *HostChainParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthLscosmos
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthLscosmos
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.MintDenom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.RewardEpoch = bool(v != 0)
 		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinDeposit", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UndelegationEpoch", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLscosmos
@@ -2833,31 +7915,17 @@ func (m *HostChainParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthLscosmos
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthLscosmos
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.MinDeposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.UndelegationEpoch = bool(v != 0)
 		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PstakeParams", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostVotes", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLscosmos
@@ -2867,25 +7935,12 @@ func (m *HostChainParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthLscosmos
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthLscosmos
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.PstakeParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.HostVotes = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLscosmos(dAtA[iNdEx:])
@@ -2907,7 +7962,7 @@ func (m *HostChainParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DelegationState) Unmarshal(dAtA []byte) error {
+func (m *ValidatorMetrics) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2930,17 +7985,17 @@ func (m *DelegationState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DelegationState: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorMetrics: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DelegationState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorMetrics: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HostDelegationAccountBalance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLscosmos
@@ -2950,29 +8005,27 @@ func (m *DelegationState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthLscosmos
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthLscosmos
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HostDelegationAccountBalance = append(m.HostDelegationAccountBalance, types.Coin{})
-			if err := m.HostDelegationAccountBalance[len(m.HostDelegationAccountBalance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HostChainDelegationAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3000,13 +8053,13 @@ func (m *DelegationState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HostChainDelegationAddress = string(dAtA[iNdEx:postIndex])
+			m.ConsensusAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HostAccountDelegations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLscosmos
@@ -3016,31 +8069,31 @@ func (m *DelegationState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthLscosmos
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthLscosmos
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HostAccountDelegations = append(m.HostAccountDelegations, HostAccountDelegation{})
-			if err := m.HostAccountDelegations[len(m.HostAccountDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Commission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HostAccountUndelegations", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Jailed", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLscosmos
@@ -3050,79 +8103,55 @@ func (m *DelegationState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthLscosmos
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthLscosmos
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.HostAccountUndelegations = append(m.HostAccountUndelegations, HostAccountUndelegation{})
-			if err := m.HostAccountUndelegations[len(m.HostAccountUndelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipLscosmos(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthLscosmos
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *HostAccountDelegation) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowLscosmos
+			m.Jailed = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tombstoned", wireType)
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLscosmos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Tombstoned = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bonded", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: HostAccountDelegation: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HostAccountDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLscosmos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Bonded = bool(v != 0)
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3150,13 +8179,15 @@ func (m *HostAccountDelegation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.Tokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SelfBond", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLscosmos
@@ -3166,25 +8197,45 @@ func (m *HostAccountDelegation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthLscosmos
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthLscosmos
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SelfBond.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedHeight", wireType)
+			}
+			m.UpdatedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLscosmos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpdatedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLscosmos(dAtA[iNdEx:])
@@ -3206,7 +8257,7 @@ func (m *HostAccountDelegation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HostAccountUndelegation) Unmarshal(dAtA []byte) error {
+func (m *ValidatorWeight) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3229,17 +8280,17 @@ func (m *HostAccountUndelegation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HostAccountUndelegation: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorWeight: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HostAccountUndelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorWeight: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
-			m.EpochNumber = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLscosmos
@@ -3249,16 +8300,29 @@ func (m *HostAccountUndelegation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EpochNumber |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLscosmos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLscosmos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalUndelegationAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseWeight", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLscosmos
@@ -3268,30 +8332,31 @@ func (m *HostAccountUndelegation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthLscosmos
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthLscosmos
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TotalUndelegationAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.BaseWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveWeight", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLscosmos
@@ -3301,28 +8366,29 @@ func (m *HostAccountUndelegation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthLscosmos
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthLscosmos
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.EffectiveWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UndelegationEntries", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metrics", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3349,8 +8415,10 @@ func (m *HostAccountUndelegation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UndelegationEntries = append(m.UndelegationEntries, UndelegationEntry{})
-			if err := m.UndelegationEntries[len(m.UndelegationEntries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Metrics == nil {
+				m.Metrics = &ValidatorMetrics{}
+			}
+			if err := m.Metrics.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3375,7 +8443,7 @@ func (m *HostAccountUndelegation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UndelegationEntry) Unmarshal(dAtA []byte) error {
+func (m *RewardEpochRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3398,17 +8466,17 @@ func (m *UndelegationEntry) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UndelegationEntry: wiretype end group for non-group")
+			return fmt.Errorf("proto: RewardEpochRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UndelegationEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RewardEpochRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
 			}
-			var stringLen uint64
+			m.EpochNumber = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLscosmos
@@ -3418,27 +8486,14 @@ func (m *UndelegationEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.EpochNumber |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthLscosmos
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthLscosmos
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Accrued", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3465,145 +8520,13 @@ func (m *UndelegationEntry) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipLscosmos(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.Accrued.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthLscosmos
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *HostChainRewardAddress) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowLscosmos
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: HostChainRewardAddress: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HostChainRewardAddress: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLscosmos
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthLscosmos
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthLscosmos
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipLscosmos(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthLscosmos
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *IBCAmountTransientStore) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowLscosmos
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: IBCAmountTransientStore: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IBCAmountTransientStore: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IBCTransfer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Restaked", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3629,15 +8552,14 @@ func (m *IBCAmountTransientStore) Unmarshal(dAtA []byte) error {
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
-			}
-			m.IBCTransfer = append(m.IBCTransfer, types.Coin{})
-			if err := m.IBCTransfer[len(m.IBCTransfer)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			}
+			if err := m.Restaked.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ICADelegate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SentToInsuranceFund", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3664,13 +8586,13 @@ func (m *IBCAmountTransientStore) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ICADelegate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SentToInsuranceFund.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UndelegatonCompleteIBCTransfer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CarriedOver", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3697,11 +8619,29 @@ func (m *IBCAmountTransientStore) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UndelegatonCompleteIBCTransfer = append(m.UndelegatonCompleteIBCTransfer, TransientUndelegationTransfer{})
-			if err := m.UndelegatonCompleteIBCTransfer[len(m.UndelegatonCompleteIBCTransfer)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CarriedOver.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExcessRewardPolicy", wireType)
+			}
+			m.ExcessRewardPolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLscosmos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExcessRewardPolicy |= ExcessRewardPolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLscosmos(dAtA[iNdEx:])
@@ -3723,7 +8663,7 @@ func (m *IBCAmountTransientStore) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TransientUndelegationTransfer) Unmarshal(dAtA []byte) error {
+func (m *HostProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3746,17 +8686,17 @@ func (m *TransientUndelegationTransfer) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TransientUndelegationTransfer: wiretype end group for non-group")
+			return fmt.Errorf("proto: HostProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TransientUndelegationTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HostProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
 			}
-			m.EpochNumber = 0
+			m.ProposalId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLscosmos
@@ -3766,14 +8706,14 @@ func (m *TransientUndelegationTransfer) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EpochNumber |= int64(b&0x7F) << shift
+				m.ProposalId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AmountUnbonded", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingEndTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3800,65 +8740,34 @@ func (m *TransientUndelegationTransfer) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AmountUnbonded.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.VotingEndTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipLscosmos(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthLscosmos
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *UnbondingEpochCValue) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowLscosmos
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLscosmos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= HostProposalStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: UnbondingEpochCValue: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UnbondingEpochCValue: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotHeight", wireType)
 			}
-			m.EpochNumber = 0
+			m.SnapshotHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLscosmos
@@ -3868,14 +8777,14 @@ func (m *UnbondingEpochCValue) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EpochNumber |= int64(b&0x7F) << shift
+				m.SnapshotHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field STKBurn", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tally", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3902,13 +8811,13 @@ func (m *UnbondingEpochCValue) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.STKBurn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Tally.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AmountUnbonded", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3935,50 +8844,11 @@ func (m *UnbondingEpochCValue) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AmountUnbonded.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Options = append(m.Options, v1beta1.WeightedVoteOption{})
+			if err := m.Options[len(m.Options)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsMatured", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLscosmos
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.IsMatured = bool(v != 0)
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsFailed", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLscosmos
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.IsFailed = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLscosmos(dAtA[iNdEx:])
@@ -4000,7 +8870,7 @@ func (m *UnbondingEpochCValue) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DelegatorUnbondingEpochEntry) Unmarshal(dAtA []byte) error {
+func (m *HostProposalTally) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4023,15 +8893,15 @@ func (m *DelegatorUnbondingEpochEntry) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DelegatorUnbondingEpochEntry: wiretype end group for non-group")
+			return fmt.Errorf("proto: HostProposalTally: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DelegatorUnbondingEpochEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HostProposalTally: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Yes", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4059,13 +8929,15 @@ func (m *DelegatorUnbondingEpochEntry) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.Yes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Abstain", wireType)
 			}
-			m.EpochNumber = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLscosmos
@@ -4075,16 +8947,31 @@ func (m *DelegatorUnbondingEpochEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EpochNumber |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLscosmos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLscosmos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Abstain.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field No", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLscosmos
@@ -4094,22 +8981,57 @@ func (m *DelegatorUnbondingEpochEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthLscosmos
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthLscosmos
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.No.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NoWithVeto", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLscosmos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLscosmos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLscosmos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NoWithVeto.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4134,7 +9056,7 @@ func (m *DelegatorUnbondingEpochEntry) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HostAccounts) Unmarshal(dAtA []byte) error {
+func (m *HostProposalVote) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4157,15 +9079,34 @@ func (m *HostAccounts) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HostAccounts: wiretype end group for non-group")
+			return fmt.Errorf("proto: HostProposalVote: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HostAccounts: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HostProposalVote: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLscosmos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAccountOwnerID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4193,13 +9134,13 @@ func (m *HostAccounts) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatorAccountOwnerID = string(dAtA[iNdEx:postIndex])
+			m.Voter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardsAccountOwnerID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLscosmos
@@ -4209,23 +9150,25 @@ func (m *HostAccounts) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthLscosmos
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthLscosmos
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RewardsAccountOwnerID = string(dAtA[iNdEx:postIndex])
+			m.Options = append(m.Options, v1beta1.WeightedVoteOption{})
+			if err := m.Options[len(m.Options)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
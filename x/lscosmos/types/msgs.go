@@ -1,12 +1,13 @@
 package types
 
 import (
-	"fmt"
+	"time"
 
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 	ibctransfertypes "github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
 )
 
@@ -19,6 +20,13 @@ var (
 	_ sdk.Msg = &MsgJumpStart{}
 	_ sdk.Msg = &MsgChangeModuleState{}
 	_ sdk.Msg = &MsgReportSlashing{}
+	_ sdk.Msg = &MsgTransferUnbondingEntry{}
+	_ sdk.Msg = &MsgClaimFor{}
+	_ sdk.Msg = &MsgSetAutoClaim{}
+	_ sdk.Msg = &MsgUpdateRoles{}
+	_ sdk.Msg = &MsgSetPauseSwitches{}
+	_ sdk.Msg = &MsgRegisterHostProposal{}
+	_ sdk.Msg = &MsgVoteHostProposal{}
 )
 
 // NewMsgLiquidStake returns a new MsgLiquidStake
@@ -283,9 +291,6 @@ func (m *MsgJumpStart) ValidateBasic() error {
 	if _, err := sdk.AccAddressFromBech32(m.PstakeParams.PstakeFeeAddress); err != nil {
 		return errorsmod.Wrap(sdkErrors.ErrInvalidAddress, m.PstakeParams.PstakeFeeAddress)
 	}
-	if m.PstakeParams.PstakeFeeAddress != m.PstakeAddress {
-		return errorsmod.Wrap(sdkErrors.ErrInvalidAddress, fmt.Sprintf("pstakeAddress should be equal to PstakeParams.PstakeFeeAddress, got %s, %s", m.PstakeParams.PstakeFeeAddress, m.PstakeAddress))
-	}
 	if m.MinDeposit.LTE(sdk.ZeroInt()) {
 		return errorsmod.Wrapf(ErrInvalidDeposit, "min deposit must be positive")
 	}
@@ -393,3 +398,299 @@ func (m *MsgReportSlashing) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{acc}
 }
+
+// NewMsgTransferUnbondingEntry returns a new MsgTransferUnbondingEntry
+//
+//nolint:interfacer
+func NewMsgTransferUnbondingEntry(delegatorAddress, recipientAddress sdk.AccAddress, epochNumber int64, amount sdk.Coin) *MsgTransferUnbondingEntry {
+	return &MsgTransferUnbondingEntry{
+		DelegatorAddress: delegatorAddress.String(),
+		RecipientAddress: recipientAddress.String(),
+		EpochNumber:      epochNumber,
+		Amount:           amount,
+	}
+}
+
+// Route should return the name of the module
+func (m *MsgTransferUnbondingEntry) Route() string { return RouterKey }
+
+// Type should return the action
+func (m *MsgTransferUnbondingEntry) Type() string { return MsgTypeTransferUnbondingEntry }
+
+// ValidateBasic performs stateless checks
+func (m *MsgTransferUnbondingEntry) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.DelegatorAddress); err != nil {
+		return errorsmod.Wrap(sdkErrors.ErrInvalidAddress, m.DelegatorAddress)
+	}
+	if _, err := sdk.AccAddressFromBech32(m.RecipientAddress); err != nil {
+		return errorsmod.Wrap(sdkErrors.ErrInvalidAddress, m.RecipientAddress)
+	}
+	if m.DelegatorAddress == m.RecipientAddress {
+		return errorsmod.Wrap(sdkErrors.ErrInvalidAddress, "recipient address cannot be the same as delegator address")
+	}
+	if m.EpochNumber <= 0 {
+		return errorsmod.Wrapf(ErrInvalidArgs, "epoch number must be positive, got %d", m.EpochNumber)
+	}
+
+	if !m.Amount.IsValid() {
+		return errorsmod.Wrap(sdkErrors.ErrInvalidCoins, m.Amount.String())
+	}
+
+	if !m.Amount.IsPositive() {
+		return errorsmod.Wrap(sdkErrors.ErrInvalidCoins, m.Amount.String())
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (m *MsgTransferUnbondingEntry) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(m))
+}
+
+// GetSigners defines whose signature is required
+func (m *MsgTransferUnbondingEntry) GetSigners() []sdk.AccAddress {
+	acc, err := sdk.AccAddressFromBech32(m.DelegatorAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{acc}
+}
+
+// NewMsgClaimFor returns a new MsgClaimFor
+//
+//nolint:interfacer
+func NewMsgClaimFor(claimerAddress, delegatorAddress sdk.AccAddress) *MsgClaimFor {
+	return &MsgClaimFor{
+		ClaimerAddress:   claimerAddress.String(),
+		DelegatorAddress: delegatorAddress.String(),
+	}
+}
+
+// Route should return the name of the module
+func (m *MsgClaimFor) Route() string { return RouterKey }
+
+// Type should return the action
+func (m *MsgClaimFor) Type() string { return MsgTypeClaimFor }
+
+// ValidateBasic performs stateless checks
+func (m *MsgClaimFor) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.ClaimerAddress); err != nil {
+		return errorsmod.Wrap(sdkErrors.ErrInvalidAddress, m.ClaimerAddress)
+	}
+	if _, err := sdk.AccAddressFromBech32(m.DelegatorAddress); err != nil {
+		return errorsmod.Wrap(sdkErrors.ErrInvalidAddress, m.DelegatorAddress)
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (m *MsgClaimFor) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(m))
+}
+
+// GetSigners defines whose signature is required
+func (m *MsgClaimFor) GetSigners() []sdk.AccAddress {
+	acc, err := sdk.AccAddressFromBech32(m.ClaimerAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{acc}
+}
+
+// NewMsgSetAutoClaim returns a new MsgSetAutoClaim
+//
+//nolint:interfacer
+func NewMsgSetAutoClaim(delegatorAddress sdk.AccAddress, enabled bool) *MsgSetAutoClaim {
+	return &MsgSetAutoClaim{
+		DelegatorAddress: delegatorAddress.String(),
+		Enabled:          enabled,
+	}
+}
+
+// Route should return the name of the module
+func (m *MsgSetAutoClaim) Route() string { return RouterKey }
+
+// Type should return the action
+func (m *MsgSetAutoClaim) Type() string { return MsgTypeSetAutoClaim }
+
+// ValidateBasic performs stateless checks
+func (m *MsgSetAutoClaim) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.DelegatorAddress); err != nil {
+		return errorsmod.Wrap(sdkErrors.ErrInvalidAddress, m.DelegatorAddress)
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (m *MsgSetAutoClaim) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(m))
+}
+
+// GetSigners defines whose signature is required
+func (m *MsgSetAutoClaim) GetSigners() []sdk.AccAddress {
+	acc, err := sdk.AccAddressFromBech32(m.DelegatorAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{acc}
+}
+
+// NewMsgUpdateRoles returns a new MsgUpdateRoles
+//
+//nolint:interfacer
+func NewMsgUpdateRoles(adminAddress sdk.AccAddress, roles Roles) *MsgUpdateRoles {
+	return &MsgUpdateRoles{
+		AdminAddress: adminAddress.String(),
+		Roles:        roles,
+	}
+}
+
+// Route should return the name of the module
+func (m *MsgUpdateRoles) Route() string { return RouterKey }
+
+// Type should return the action
+func (m *MsgUpdateRoles) Type() string { return MsgTypeUpdateRoles }
+
+// ValidateBasic performs stateless checks
+func (m *MsgUpdateRoles) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.AdminAddress); err != nil {
+		return errorsmod.Wrap(sdkErrors.ErrInvalidAddress, m.AdminAddress)
+	}
+	if len(m.Roles.Admins) == 0 {
+		return errorsmod.Wrap(ErrInvalidRoles, "at least one admin is required")
+	}
+	return m.Roles.Validate()
+}
+
+// GetSignBytes encodes the message for signing
+func (m *MsgUpdateRoles) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(m))
+}
+
+// GetSigners defines whose signature is required
+func (m *MsgUpdateRoles) GetSigners() []sdk.AccAddress {
+	acc, err := sdk.AccAddressFromBech32(m.AdminAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{acc}
+}
+
+// NewMsgSetPauseSwitches returns a new MsgSetPauseSwitches
+//
+//nolint:interfacer
+func NewMsgSetPauseSwitches(pstakeAddress sdk.AccAddress, pauseSwitches PauseSwitches) *MsgSetPauseSwitches {
+	return &MsgSetPauseSwitches{
+		PstakeAddress: pstakeAddress.String(),
+		PauseSwitches: pauseSwitches,
+	}
+}
+
+// Route should return the name of the module
+func (m *MsgSetPauseSwitches) Route() string { return RouterKey }
+
+// Type should return the action
+func (m *MsgSetPauseSwitches) Type() string { return MsgTypeSetPauseSwitches }
+
+// ValidateBasic performs stateless checks
+func (m *MsgSetPauseSwitches) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.PstakeAddress); err != nil {
+		return errorsmod.Wrap(sdkErrors.ErrInvalidAddress, m.PstakeAddress)
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (m *MsgSetPauseSwitches) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(m))
+}
+
+// GetSigners defines whose signature is required
+func (m *MsgSetPauseSwitches) GetSigners() []sdk.AccAddress {
+	acc, err := sdk.AccAddressFromBech32(m.PstakeAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{acc}
+}
+
+// NewMsgRegisterHostProposal returns a new MsgRegisterHostProposal
+//
+//nolint:interfacer
+func NewMsgRegisterHostProposal(adminAddress sdk.AccAddress, proposalID uint64, votingEndTime time.Time) *MsgRegisterHostProposal {
+	return &MsgRegisterHostProposal{
+		AdminAddress:  adminAddress.String(),
+		ProposalId:    proposalID,
+		VotingEndTime: votingEndTime,
+	}
+}
+
+// Route should return the name of the module
+func (m *MsgRegisterHostProposal) Route() string { return RouterKey }
+
+// Type should return the action
+func (m *MsgRegisterHostProposal) Type() string { return MsgTypeRegisterHostProposal }
+
+// ValidateBasic performs stateless checks
+func (m *MsgRegisterHostProposal) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.AdminAddress); err != nil {
+		return errorsmod.Wrap(sdkErrors.ErrInvalidAddress, m.AdminAddress)
+	}
+	if m.ProposalId == 0 {
+		return errorsmod.Wrap(sdkErrors.ErrInvalidRequest, "host proposal id cannot be 0")
+	}
+	if m.VotingEndTime.IsZero() {
+		return errorsmod.Wrap(sdkErrors.ErrInvalidRequest, "voting end time cannot be empty")
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (m *MsgRegisterHostProposal) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(m))
+}
+
+// GetSigners defines whose signature is required
+func (m *MsgRegisterHostProposal) GetSigners() []sdk.AccAddress {
+	acc, err := sdk.AccAddressFromBech32(m.AdminAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{acc}
+}
+
+// NewMsgVoteHostProposal returns a new MsgVoteHostProposal
+//
+//nolint:interfacer
+func NewMsgVoteHostProposal(voter sdk.AccAddress, proposalID uint64, options govtypes.WeightedVoteOptions) *MsgVoteHostProposal {
+	return &MsgVoteHostProposal{
+		Voter:      voter.String(),
+		ProposalId: proposalID,
+		Options:    options,
+	}
+}
+
+// Route should return the name of the module
+func (m *MsgVoteHostProposal) Route() string { return RouterKey }
+
+// Type should return the action
+func (m *MsgVoteHostProposal) Type() string { return MsgTypeVoteHostProposal }
+
+// ValidateBasic performs stateless checks
+func (m *MsgVoteHostProposal) ValidateBasic() error {
+	return HostProposalVote{ProposalId: m.ProposalId, Voter: m.Voter, Options: m.Options}.Validate()
+}
+
+// GetSignBytes encodes the message for signing
+func (m *MsgVoteHostProposal) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(m))
+}
+
+// GetSigners defines whose signature is required
+func (m *MsgVoteHostProposal) GetSigners() []sdk.AccAddress {
+	acc, err := sdk.AccAddressFromBech32(m.Voter)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{acc}
+}
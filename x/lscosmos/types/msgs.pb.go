@@ -11,22 +11,27 @@ import (
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
+	v1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -787,1225 +792,4059 @@ func (m *MsgReportSlashingResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgReportSlashingResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgLiquidStake)(nil), "pstake.lscosmos.v1beta1.MsgLiquidStake")
-	proto.RegisterType((*MsgLiquidStakeResponse)(nil), "pstake.lscosmos.v1beta1.MsgLiquidStakeResponse")
-	proto.RegisterType((*MsgLiquidUnstake)(nil), "pstake.lscosmos.v1beta1.MsgLiquidUnstake")
-	proto.RegisterType((*MsgLiquidUnstakeResponse)(nil), "pstake.lscosmos.v1beta1.MsgLiquidUnstakeResponse")
-	proto.RegisterType((*MsgRedeem)(nil), "pstake.lscosmos.v1beta1.MsgRedeem")
-	proto.RegisterType((*MsgRedeemResponse)(nil), "pstake.lscosmos.v1beta1.MsgRedeemResponse")
-	proto.RegisterType((*MsgClaim)(nil), "pstake.lscosmos.v1beta1.MsgClaim")
-	proto.RegisterType((*MsgClaimResponse)(nil), "pstake.lscosmos.v1beta1.MsgClaimResponse")
-	proto.RegisterType((*MsgRecreateICA)(nil), "pstake.lscosmos.v1beta1.MsgRecreateICA")
-	proto.RegisterType((*MsgRecreateICAResponse)(nil), "pstake.lscosmos.v1beta1.MsgRecreateICAResponse")
-	proto.RegisterType((*MsgJumpStart)(nil), "pstake.lscosmos.v1beta1.MsgJumpStart")
-	proto.RegisterType((*MsgJumpStartResponse)(nil), "pstake.lscosmos.v1beta1.MsgJumpStartResponse")
-	proto.RegisterType((*MsgChangeModuleState)(nil), "pstake.lscosmos.v1beta1.MsgChangeModuleState")
-	proto.RegisterType((*MsgChangeModuleStateResponse)(nil), "pstake.lscosmos.v1beta1.MsgChangeModuleStateResponse")
-	proto.RegisterType((*MsgReportSlashing)(nil), "pstake.lscosmos.v1beta1.MsgReportSlashing")
-	proto.RegisterType((*MsgReportSlashingResponse)(nil), "pstake.lscosmos.v1beta1.MsgReportSlashingResponse")
+// MsgTransferUnbondingEntry moves the whole or a part of a delegator unbonding
+// epoch entry to another address
+type MsgTransferUnbondingEntry struct {
+	DelegatorAddress string     `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	RecipientAddress string     `protobuf:"bytes,2,opt,name=recipient_address,json=recipientAddress,proto3" json:"recipient_address,omitempty"`
+	EpochNumber      int64      `protobuf:"varint,3,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
+	Amount           types.Coin `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount"`
 }
 
-func init() {
-	proto.RegisterFile("pstake/lscosmos/v1beta1/msgs.proto", fileDescriptor_2c178418d9a52b7e)
+func (m *MsgTransferUnbondingEntry) Reset()         { *m = MsgTransferUnbondingEntry{} }
+func (m *MsgTransferUnbondingEntry) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferUnbondingEntry) ProtoMessage()    {}
+func (*MsgTransferUnbondingEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c178418d9a52b7e, []int{16}
 }
-
-var fileDescriptor_2c178418d9a52b7e = []byte{
-	// 1081 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0xcf, 0x6f, 0xdc, 0xc4,
-	0x17, 0x8f, 0xdb, 0x26, 0xcd, 0xce, 0x26, 0xf9, 0x26, 0x6e, 0xbe, 0x89, 0x63, 0xca, 0x26, 0x31,
-	0x69, 0x7e, 0xd1, 0xd8, 0x4a, 0x10, 0x42, 0x6a, 0x0f, 0x28, 0x3f, 0x90, 0x08, 0xea, 0xaa, 0xd1,
-	0x46, 0xe5, 0xc0, 0xc5, 0x4c, 0xec, 0x89, 0xd7, 0xaa, 0x3d, 0x63, 0x3c, 0xb3, 0x0b, 0x3d, 0x70,
-	0xa0, 0x77, 0x04, 0x12, 0x02, 0x4e, 0x1c, 0x10, 0x17, 0x84, 0x84, 0xc4, 0x81, 0x23, 0x7f, 0x40,
-	0x8f, 0x15, 0x5c, 0x10, 0x87, 0x0a, 0x25, 0x48, 0xfc, 0x1b, 0x68, 0xc6, 0xe3, 0x59, 0x27, 0xbb,
-	0xde, 0x5d, 0x54, 0x0e, 0x3d, 0x25, 0xfb, 0xde, 0xe7, 0xbd, 0xcf, 0x67, 0x3c, 0x1f, 0xbf, 0xb7,
-	0x0b, 0xac, 0x84, 0x32, 0xf8, 0x10, 0x39, 0x11, 0xf5, 0x08, 0x8d, 0x09, 0x75, 0xda, 0xdb, 0x27,
-	0x88, 0xc1, 0x6d, 0x27, 0xa6, 0x01, 0xb5, 0x93, 0x94, 0x30, 0xa2, 0xcf, 0x67, 0x18, 0x3b, 0xc7,
-	0xd8, 0x12, 0x63, 0xce, 0x06, 0x24, 0x20, 0x02, 0xe3, 0xf0, 0xff, 0x32, 0xb8, 0x79, 0x33, 0x20,
-	0x24, 0x88, 0x90, 0x03, 0x93, 0xd0, 0x81, 0x18, 0x13, 0x06, 0x59, 0x48, 0xb0, 0x6c, 0x66, 0x2e,
-	0xc8, 0xac, 0xf8, 0x74, 0xd2, 0x3a, 0x75, 0x20, 0x7e, 0x24, 0x53, 0x35, 0x29, 0xe1, 0x04, 0x52,
-	0xa4, 0x74, 0x78, 0x24, 0xc4, 0x79, 0x69, 0x96, 0x77, 0x33, 0x46, 0xa9, 0x25, 0x4b, 0xcd, 0xcb,
-	0xd2, 0x98, 0x06, 0x4e, 0x5b, 0x88, 0x97, 0x89, 0xd5, 0xb2, 0xf3, 0xa9, 0xc3, 0x08, 0x9c, 0xf5,
-	0xbd, 0x06, 0xa6, 0xea, 0x34, 0xb8, 0x17, 0x7e, 0xd0, 0x0a, 0xfd, 0x63, 0x5e, 0xa2, 0xbf, 0x05,
-	0x66, 0x7c, 0x14, 0xa1, 0x00, 0x32, 0x92, 0xba, 0xd0, 0xf7, 0x53, 0x44, 0xa9, 0xa1, 0x2d, 0x69,
-	0xeb, 0x95, 0x3d, 0xe3, 0xd7, 0x9f, 0xb7, 0x66, 0x65, 0xfd, 0x6e, 0x96, 0x39, 0x66, 0x69, 0x88,
-	0x83, 0xc6, 0xb4, 0x2a, 0x91, 0x71, 0xfd, 0x0d, 0x30, 0x06, 0x63, 0xd2, 0xc2, 0xcc, 0xb8, 0xb2,
-	0xa4, 0xad, 0x57, 0x77, 0x16, 0x6c, 0x59, 0xc8, 0x8f, 0x99, 0x3f, 0x4a, 0x7b, 0x9f, 0x84, 0x78,
-	0xef, 0xda, 0x93, 0x67, 0x8b, 0x23, 0x0d, 0x09, 0xbf, 0x33, 0xf7, 0xf8, 0xef, 0x9f, 0x36, 0xbb,
-	0x25, 0x58, 0x06, 0x98, 0xbb, 0xa8, 0xb4, 0x81, 0x68, 0x42, 0x30, 0x45, 0xd6, 0x0f, 0x1a, 0x98,
-	0x56, 0xa9, 0x07, 0x98, 0xbe, 0xd0, 0xc7, 0x30, 0x81, 0x71, 0x59, 0xab, 0x3a, 0xc8, 0x77, 0x1a,
-	0xa8, 0xd4, 0x69, 0xd0, 0x40, 0x3e, 0x42, 0xf1, 0x0b, 0x7b, 0x82, 0x1b, 0x60, 0x46, 0x89, 0x54,
-	0xd2, 0x43, 0x30, 0x5e, 0xa7, 0xc1, 0x7e, 0x04, 0xc3, 0xff, 0x4a, 0x78, 0x29, 0xbf, 0x2e, 0x6e,
-	0x5b, 0x50, 0x29, 0xfa, 0xf7, 0x85, 0x8d, 0x1b, 0xc8, 0x4b, 0x11, 0x64, 0xe8, 0x70, 0x7f, 0x57,
-	0xbf, 0x0b, 0x26, 0x4e, 0x53, 0x12, 0x0f, 0xcd, 0x5f, 0xe5, 0xe8, 0x9c, 0x7a, 0x86, 0x53, 0x5f,
-	0xa8, 0x97, 0xf6, 0x2b, 0x30, 0x28, 0xee, 0xaf, 0x47, 0xc1, 0x44, 0x9d, 0x06, 0xef, 0xb4, 0xe2,
-	0xe4, 0x98, 0xc1, 0x94, 0xe9, 0x6f, 0x82, 0xa9, 0xec, 0xf5, 0x1b, 0x9a, 0x7c, 0x32, 0xc3, 0xe7,
-	0x57, 0x66, 0x82, 0x8a, 0xd7, 0x84, 0x21, 0x76, 0x43, 0xd7, 0x17, 0xb7, 0x56, 0x69, 0x5c, 0x17,
-	0x81, 0xc3, 0x03, 0x7d, 0x05, 0x4c, 0x79, 0x04, 0x63, 0xe4, 0xf1, 0xe9, 0x22, 0x00, 0x57, 0x05,
-	0x60, 0xa2, 0x13, 0x3d, 0x3c, 0xd0, 0x37, 0xc0, 0x34, 0x4b, 0x21, 0xa6, 0xa7, 0x28, 0x75, 0xbd,
-	0x26, 0xc4, 0x18, 0x45, 0xc6, 0x35, 0x81, 0xfb, 0x5f, 0x1e, 0xdf, 0xcf, 0xc2, 0xfa, 0x2b, 0x60,
-	0x52, 0x41, 0x13, 0x92, 0x32, 0x63, 0x34, 0xeb, 0x97, 0x07, 0x8f, 0x48, 0xca, 0xf4, 0x97, 0x01,
-	0xe0, 0x76, 0x71, 0x7d, 0x84, 0x49, 0x6c, 0x8c, 0x09, 0x44, 0x85, 0x47, 0x0e, 0x78, 0x80, 0xa7,
-	0xe3, 0x10, 0x33, 0x99, 0xbe, 0x9e, 0xa5, 0x79, 0x24, 0x4b, 0xdf, 0x07, 0xd5, 0x38, 0xc4, 0xae,
-	0x8f, 0x12, 0x42, 0x43, 0x66, 0x8c, 0x8b, 0xa7, 0x61, 0x73, 0xb3, 0xfd, 0xf1, 0x6c, 0x71, 0x35,
-	0x08, 0x59, 0xb3, 0x75, 0x62, 0x7b, 0x24, 0x96, 0xc3, 0x4d, 0xfe, 0xd9, 0xa2, 0xfe, 0x43, 0x87,
-	0x3d, 0x4a, 0x10, 0xb5, 0x0f, 0x31, 0x6b, 0x70, 0x86, 0x83, 0xac, 0x83, 0x1e, 0x81, 0x79, 0x18,
-	0x45, 0xe4, 0x43, 0x37, 0x0a, 0x29, 0x43, 0xbe, 0xdb, 0x86, 0x51, 0xe8, 0x73, 0x93, 0x50, 0xa3,
-	0x22, 0x4c, 0x6e, 0xdb, 0x25, 0xc3, 0xdb, 0xde, 0xe5, 0x75, 0xf7, 0x44, 0xd9, 0xbb, 0xaa, 0x4a,
-	0x3a, 0xff, 0xff, 0xb0, 0x57, 0x52, 0x3f, 0x02, 0xf2, 0x7e, 0xdc, 0x04, 0xa6, 0x30, 0xa6, 0x06,
-	0x10, 0x1c, 0xb7, 0x4a, 0x39, 0x8e, 0x44, 0xfc, 0x48, 0x80, 0x65, 0xeb, 0x89, 0xa4, 0x10, 0xe3,
-	0x1d, 0x9b, 0x84, 0x32, 0x17, 0x7a, 0x1e, 0x7f, 0xd5, 0xa8, 0x51, 0x1d, 0xd0, 0xf1, 0x6d, 0x42,
-	0xd9, 0xae, 0x04, 0xe7, 0x1d, 0x9b, 0x85, 0xd8, 0x9d, 0x1b, 0xdc, 0xb1, 0x97, 0x6c, 0x67, 0xcd,
-	0x81, 0xd9, 0xa2, 0x31, 0x95, 0x63, 0x3f, 0xd3, 0x44, 0x82, 0x3b, 0x20, 0x40, 0x75, 0xe2, 0xb7,
-	0x22, 0x74, 0xcc, 0x20, 0x43, 0xcf, 0xef, 0xdc, 0x65, 0x30, 0x11, 0x8b, 0x7e, 0x2e, 0xe5, 0x0d,
-	0x85, 0x79, 0xc7, 0x1b, 0xd5, 0xb8, 0xc3, 0xd1, 0x5b, 0x69, 0x0d, 0xdc, 0xec, 0x25, 0x48, 0x29,
-	0xfe, 0x4a, 0x93, 0x43, 0x87, 0x3b, 0xf4, 0x38, 0x82, 0xb4, 0x19, 0xe2, 0xe0, 0xf9, 0xe5, 0xbe,
-	0x0a, 0x66, 0x94, 0x75, 0x54, 0x8f, 0xec, 0x85, 0x9b, 0x56, 0x89, 0x7c, 0x28, 0xf4, 0x14, 0xfe,
-	0x12, 0x58, 0xe8, 0xd2, 0x95, 0xab, 0xde, 0xf9, 0xa5, 0x02, 0xae, 0xd6, 0x69, 0xa0, 0x7f, 0xa9,
-	0x81, 0x6a, 0x71, 0xc5, 0xae, 0x95, 0xde, 0xf3, 0xc5, 0x0d, 0x67, 0x3a, 0x43, 0x02, 0xd5, 0x73,
-	0xba, 0xfd, 0xf8, 0xb7, 0xbf, 0xbe, 0xb8, 0xb2, 0x6a, 0xad, 0x38, 0x65, 0x5f, 0x00, 0x8a, 0x3a,
-	0xbe, 0xd1, 0xc0, 0xe4, 0xc5, 0xad, 0xb9, 0x31, 0x98, 0x50, 0x42, 0xcd, 0xed, 0xa1, 0xa1, 0x4a,
-	0x9d, 0x2d, 0xd4, 0xad, 0x5b, 0xab, 0x03, 0xd4, 0xe5, 0x6a, 0x3e, 0xd1, 0xc0, 0x98, 0x5c, 0x86,
-	0x56, 0x3f, 0xb6, 0x0c, 0x63, 0x6e, 0x0e, 0xc6, 0x28, 0x29, 0x6b, 0x42, 0xca, 0xb2, 0xb5, 0x58,
-	0x2a, 0x45, 0x12, 0x7f, 0x0c, 0x46, 0xb3, 0xad, 0xb6, 0xdc, 0xaf, 0xbb, 0x80, 0x98, 0x1b, 0x03,
-	0x21, 0x8a, 0x7f, 0x55, 0xf0, 0x2f, 0x59, 0xb5, 0x52, 0xfe, 0x8c, 0x95, 0x5b, 0xa7, 0xb8, 0xd6,
-	0xd6, 0xfa, 0x9f, 0x51, 0x01, 0xfb, 0x5b, 0xa7, 0xd7, 0x1a, 0x1b, 0x6c, 0x9d, 0xa2, 0x8e, 0x4f,
-	0x35, 0x50, 0xe9, 0x6c, 0xbc, 0x5b, 0xfd, 0xc8, 0x14, 0xcc, 0xdc, 0x1a, 0x0a, 0xa6, 0x14, 0x6d,
-	0x0a, 0x45, 0x2b, 0x96, 0x55, 0xaa, 0xa8, 0xa3, 0xe0, 0x47, 0x0d, 0xcc, 0x74, 0xcf, 0xb3, 0xbe,
-	0x84, 0x5d, 0x70, 0xf3, 0xf5, 0x7f, 0x05, 0x57, 0x3a, 0x77, 0x84, 0xce, 0xdb, 0xd6, 0x66, 0xf9,
-	0x5d, 0x76, 0x29, 0xfb, 0x56, 0x03, 0x53, 0x97, 0xa6, 0xd9, 0x00, 0xfb, 0x16, 0xb1, 0xe6, 0xce,
-	0xf0, 0x58, 0x25, 0xd3, 0x11, 0x32, 0x37, 0xac, 0xb5, 0x3e, 0x17, 0x5c, 0x2c, 0xdc, 0x7b, 0xf0,
-	0xe4, 0xac, 0xa6, 0x3d, 0x3d, 0xab, 0x69, 0x7f, 0x9e, 0xd5, 0xb4, 0xcf, 0xcf, 0x6b, 0x23, 0x4f,
-	0xcf, 0x6b, 0x23, 0xbf, 0x9f, 0xd7, 0x46, 0xde, 0xbb, 0x5b, 0xd8, 0xd9, 0x09, 0x4a, 0x29, 0xdf,
-	0x98, 0xd8, 0x43, 0xf7, 0x31, 0x92, 0xbd, 0xb7, 0x30, 0x64, 0x61, 0x1b, 0x39, 0xed, 0x1d, 0xe7,
-	0xa3, 0x0e, 0x8f, 0x58, 0xe6, 0x27, 0x63, 0xe2, 0xa7, 0xc7, 0x6b, 0xff, 0x04, 0x00, 0x00, 0xff,
-	0xff, 0x7b, 0x90, 0x5c, 0x56, 0x84, 0x0d, 0x00, 0x00,
+func (m *MsgTransferUnbondingEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	LiquidStake(ctx context.Context, in *MsgLiquidStake, opts ...grpc.CallOption) (*MsgLiquidStakeResponse, error)
-	LiquidUnstake(ctx context.Context, in *MsgLiquidUnstake, opts ...grpc.CallOption) (*MsgLiquidUnstakeResponse, error)
-	Redeem(ctx context.Context, in *MsgRedeem, opts ...grpc.CallOption) (*MsgRedeemResponse, error)
-	Claim(ctx context.Context, in *MsgClaim, opts ...grpc.CallOption) (*MsgClaimResponse, error)
-	RecreateICA(ctx context.Context, in *MsgRecreateICA, opts ...grpc.CallOption) (*MsgRecreateICAResponse, error)
-	JumpStart(ctx context.Context, in *MsgJumpStart, opts ...grpc.CallOption) (*MsgJumpStartResponse, error)
-	ChangeModuleState(ctx context.Context, in *MsgChangeModuleState, opts ...grpc.CallOption) (*MsgChangeModuleStateResponse, error)
-	ReportSlashing(ctx context.Context, in *MsgReportSlashing, opts ...grpc.CallOption) (*MsgReportSlashingResponse, error)
+func (m *MsgTransferUnbondingEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferUnbondingEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgTransferUnbondingEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferUnbondingEntry.Merge(m, src)
 }
-
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgTransferUnbondingEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferUnbondingEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferUnbondingEntry.DiscardUnknown(m)
 }
 
-func (c *msgClient) LiquidStake(ctx context.Context, in *MsgLiquidStake, opts ...grpc.CallOption) (*MsgLiquidStakeResponse, error) {
-	out := new(MsgLiquidStakeResponse)
-	err := c.cc.Invoke(ctx, "/pstake.lscosmos.v1beta1.Msg/LiquidStake", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgTransferUnbondingEntry proto.InternalMessageInfo
+
+func (m *MsgTransferUnbondingEntry) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) LiquidUnstake(ctx context.Context, in *MsgLiquidUnstake, opts ...grpc.CallOption) (*MsgLiquidUnstakeResponse, error) {
-	out := new(MsgLiquidUnstakeResponse)
-	err := c.cc.Invoke(ctx, "/pstake.lscosmos.v1beta1.Msg/LiquidUnstake", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgTransferUnbondingEntry) GetRecipientAddress() string {
+	if m != nil {
+		return m.RecipientAddress
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) Redeem(ctx context.Context, in *MsgRedeem, opts ...grpc.CallOption) (*MsgRedeemResponse, error) {
-	out := new(MsgRedeemResponse)
-	err := c.cc.Invoke(ctx, "/pstake.lscosmos.v1beta1.Msg/Redeem", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgTransferUnbondingEntry) GetEpochNumber() int64 {
+	if m != nil {
+		return m.EpochNumber
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) Claim(ctx context.Context, in *MsgClaim, opts ...grpc.CallOption) (*MsgClaimResponse, error) {
-	out := new(MsgClaimResponse)
-	err := c.cc.Invoke(ctx, "/pstake.lscosmos.v1beta1.Msg/Claim", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgTransferUnbondingEntry) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
 	}
-	return out, nil
+	return types.Coin{}
 }
 
-func (c *msgClient) RecreateICA(ctx context.Context, in *MsgRecreateICA, opts ...grpc.CallOption) (*MsgRecreateICAResponse, error) {
-	out := new(MsgRecreateICAResponse)
-	err := c.cc.Invoke(ctx, "/pstake.lscosmos.v1beta1.Msg/RecreateICA", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type MsgTransferUnbondingEntryResponse struct {
 }
 
-func (c *msgClient) JumpStart(ctx context.Context, in *MsgJumpStart, opts ...grpc.CallOption) (*MsgJumpStartResponse, error) {
-	out := new(MsgJumpStartResponse)
-	err := c.cc.Invoke(ctx, "/pstake.lscosmos.v1beta1.Msg/JumpStart", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgTransferUnbondingEntryResponse) Reset()         { *m = MsgTransferUnbondingEntryResponse{} }
+func (m *MsgTransferUnbondingEntryResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferUnbondingEntryResponse) ProtoMessage()    {}
+func (*MsgTransferUnbondingEntryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c178418d9a52b7e, []int{17}
+}
+func (m *MsgTransferUnbondingEntryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTransferUnbondingEntryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferUnbondingEntryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgTransferUnbondingEntryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferUnbondingEntryResponse.Merge(m, src)
+}
+func (m *MsgTransferUnbondingEntryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferUnbondingEntryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferUnbondingEntryResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) ChangeModuleState(ctx context.Context, in *MsgChangeModuleState, opts ...grpc.CallOption) (*MsgChangeModuleStateResponse, error) {
-	out := new(MsgChangeModuleStateResponse)
-	err := c.cc.Invoke(ctx, "/pstake.lscosmos.v1beta1.Msg/ChangeModuleState", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_MsgTransferUnbondingEntryResponse proto.InternalMessageInfo
+
+// MsgClaimFor claims matured or failed unbonding entries on behalf of a
+// delegator, funds are always sent to the delegator
+type MsgClaimFor struct {
+	ClaimerAddress   string `protobuf:"bytes,1,opt,name=claimer_address,json=claimerAddress,proto3" json:"claimer_address,omitempty"`
+	DelegatorAddress string `protobuf:"bytes,2,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
 }
 
-func (c *msgClient) ReportSlashing(ctx context.Context, in *MsgReportSlashing, opts ...grpc.CallOption) (*MsgReportSlashingResponse, error) {
-	out := new(MsgReportSlashingResponse)
-	err := c.cc.Invoke(ctx, "/pstake.lscosmos.v1beta1.Msg/ReportSlashing", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgClaimFor) Reset()         { *m = MsgClaimFor{} }
+func (m *MsgClaimFor) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimFor) ProtoMessage()    {}
+func (*MsgClaimFor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c178418d9a52b7e, []int{18}
+}
+func (m *MsgClaimFor) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimFor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimFor.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	LiquidStake(context.Context, *MsgLiquidStake) (*MsgLiquidStakeResponse, error)
-	LiquidUnstake(context.Context, *MsgLiquidUnstake) (*MsgLiquidUnstakeResponse, error)
-	Redeem(context.Context, *MsgRedeem) (*MsgRedeemResponse, error)
-	Claim(context.Context, *MsgClaim) (*MsgClaimResponse, error)
-	RecreateICA(context.Context, *MsgRecreateICA) (*MsgRecreateICAResponse, error)
-	JumpStart(context.Context, *MsgJumpStart) (*MsgJumpStartResponse, error)
-	ChangeModuleState(context.Context, *MsgChangeModuleState) (*MsgChangeModuleStateResponse, error)
-	ReportSlashing(context.Context, *MsgReportSlashing) (*MsgReportSlashingResponse, error)
+func (m *MsgClaimFor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimFor.Merge(m, src)
+}
+func (m *MsgClaimFor) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimFor) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimFor.DiscardUnknown(m)
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+var xxx_messageInfo_MsgClaimFor proto.InternalMessageInfo
+
+func (m *MsgClaimFor) GetClaimerAddress() string {
+	if m != nil {
+		return m.ClaimerAddress
+	}
+	return ""
 }
 
-func (*UnimplementedMsgServer) LiquidStake(ctx context.Context, req *MsgLiquidStake) (*MsgLiquidStakeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LiquidStake not implemented")
+func (m *MsgClaimFor) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
+	}
+	return ""
 }
-func (*UnimplementedMsgServer) LiquidUnstake(ctx context.Context, req *MsgLiquidUnstake) (*MsgLiquidUnstakeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LiquidUnstake not implemented")
+
+type MsgClaimForResponse struct {
 }
-func (*UnimplementedMsgServer) Redeem(ctx context.Context, req *MsgRedeem) (*MsgRedeemResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Redeem not implemented")
+
+func (m *MsgClaimForResponse) Reset()         { *m = MsgClaimForResponse{} }
+func (m *MsgClaimForResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimForResponse) ProtoMessage()    {}
+func (*MsgClaimForResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c178418d9a52b7e, []int{19}
 }
-func (*UnimplementedMsgServer) Claim(ctx context.Context, req *MsgClaim) (*MsgClaimResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Claim not implemented")
+func (m *MsgClaimForResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) RecreateICA(ctx context.Context, req *MsgRecreateICA) (*MsgRecreateICAResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RecreateICA not implemented")
+func (m *MsgClaimForResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimForResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) JumpStart(ctx context.Context, req *MsgJumpStart) (*MsgJumpStartResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method JumpStart not implemented")
+func (m *MsgClaimForResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimForResponse.Merge(m, src)
 }
-func (*UnimplementedMsgServer) ChangeModuleState(ctx context.Context, req *MsgChangeModuleState) (*MsgChangeModuleStateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ChangeModuleState not implemented")
+func (m *MsgClaimForResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) ReportSlashing(ctx context.Context, req *MsgReportSlashing) (*MsgReportSlashingResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ReportSlashing not implemented")
+func (m *MsgClaimForResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimForResponse.DiscardUnknown(m)
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+var xxx_messageInfo_MsgClaimForResponse proto.InternalMessageInfo
+
+// MsgSetAutoClaim opts a delegator in or out of automatic claims of matured
+// unbonding entries
+type MsgSetAutoClaim struct {
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	Enabled          bool   `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
 }
 
-func _Msg_LiquidStake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgLiquidStake)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).LiquidStake(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pstake.lscosmos.v1beta1.Msg/LiquidStake",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).LiquidStake(ctx, req.(*MsgLiquidStake))
+func (m *MsgSetAutoClaim) Reset()         { *m = MsgSetAutoClaim{} }
+func (m *MsgSetAutoClaim) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAutoClaim) ProtoMessage()    {}
+func (*MsgSetAutoClaim) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c178418d9a52b7e, []int{20}
+}
+func (m *MsgSetAutoClaim) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAutoClaim) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAutoClaim.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgSetAutoClaim) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAutoClaim.Merge(m, src)
+}
+func (m *MsgSetAutoClaim) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAutoClaim) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAutoClaim.DiscardUnknown(m)
 }
 
-func _Msg_LiquidUnstake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgLiquidUnstake)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).LiquidUnstake(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pstake.lscosmos.v1beta1.Msg/LiquidUnstake",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).LiquidUnstake(ctx, req.(*MsgLiquidUnstake))
+var xxx_messageInfo_MsgSetAutoClaim proto.InternalMessageInfo
+
+func (m *MsgSetAutoClaim) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_Redeem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRedeem)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).Redeem(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pstake.lscosmos.v1beta1.Msg/Redeem",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).Redeem(ctx, req.(*MsgRedeem))
+func (m *MsgSetAutoClaim) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
 	}
-	return interceptor(ctx, in, info, handler)
+	return false
 }
 
-func _Msg_Claim_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgClaim)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).Claim(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pstake.lscosmos.v1beta1.Msg/Claim",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).Claim(ctx, req.(*MsgClaim))
-	}
-	return interceptor(ctx, in, info, handler)
+type MsgSetAutoClaimResponse struct {
 }
 
-func _Msg_RecreateICA_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRecreateICA)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).RecreateICA(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pstake.lscosmos.v1beta1.Msg/RecreateICA",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RecreateICA(ctx, req.(*MsgRecreateICA))
+func (m *MsgSetAutoClaimResponse) Reset()         { *m = MsgSetAutoClaimResponse{} }
+func (m *MsgSetAutoClaimResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAutoClaimResponse) ProtoMessage()    {}
+func (*MsgSetAutoClaimResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c178418d9a52b7e, []int{21}
+}
+func (m *MsgSetAutoClaimResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAutoClaimResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAutoClaimResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgSetAutoClaimResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAutoClaimResponse.Merge(m, src)
+}
+func (m *MsgSetAutoClaimResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAutoClaimResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAutoClaimResponse.DiscardUnknown(m)
 }
 
-func _Msg_JumpStart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgJumpStart)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).JumpStart(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pstake.lscosmos.v1beta1.Msg/JumpStart",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).JumpStart(ctx, req.(*MsgJumpStart))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_MsgSetAutoClaimResponse proto.InternalMessageInfo
+
+// MsgUpdateRoles updates the module roles, pausers and slashing reporters are
+// updated right away while admin changes are applied after the admin timelock
+type MsgUpdateRoles struct {
+	AdminAddress string `protobuf:"bytes,1,opt,name=admin_address,json=adminAddress,proto3" json:"admin_address,omitempty"`
+	Roles        Roles  `protobuf:"bytes,2,opt,name=roles,proto3" json:"roles"`
 }
 
-func _Msg_ChangeModuleState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgChangeModuleState)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).ChangeModuleState(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pstake.lscosmos.v1beta1.Msg/ChangeModuleState",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ChangeModuleState(ctx, req.(*MsgChangeModuleState))
+func (m *MsgUpdateRoles) Reset()         { *m = MsgUpdateRoles{} }
+func (m *MsgUpdateRoles) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateRoles) ProtoMessage()    {}
+func (*MsgUpdateRoles) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c178418d9a52b7e, []int{22}
+}
+func (m *MsgUpdateRoles) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateRoles) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateRoles.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgUpdateRoles) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateRoles.Merge(m, src)
+}
+func (m *MsgUpdateRoles) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateRoles) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateRoles.DiscardUnknown(m)
 }
 
-func _Msg_ReportSlashing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgReportSlashing)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).ReportSlashing(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pstake.lscosmos.v1beta1.Msg/ReportSlashing",
+var xxx_messageInfo_MsgUpdateRoles proto.InternalMessageInfo
+
+func (m *MsgUpdateRoles) GetAdminAddress() string {
+	if m != nil {
+		return m.AdminAddress
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ReportSlashing(ctx, req.(*MsgReportSlashing))
+	return ""
+}
+
+func (m *MsgUpdateRoles) GetRoles() Roles {
+	if m != nil {
+		return m.Roles
 	}
-	return interceptor(ctx, in, info, handler)
+	return Roles{}
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "pstake.lscosmos.v1beta1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "LiquidStake",
-			Handler:    _Msg_LiquidStake_Handler,
-		},
-		{
-			MethodName: "LiquidUnstake",
-			Handler:    _Msg_LiquidUnstake_Handler,
-		},
-		{
-			MethodName: "Redeem",
-			Handler:    _Msg_Redeem_Handler,
-		},
-		{
-			MethodName: "Claim",
-			Handler:    _Msg_Claim_Handler,
-		},
-		{
-			MethodName: "RecreateICA",
-			Handler:    _Msg_RecreateICA_Handler,
-		},
-		{
-			MethodName: "JumpStart",
-			Handler:    _Msg_JumpStart_Handler,
-		},
-		{
-			MethodName: "ChangeModuleState",
-			Handler:    _Msg_ChangeModuleState_Handler,
-		},
-		{
-			MethodName: "ReportSlashing",
-			Handler:    _Msg_ReportSlashing_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "pstake/lscosmos/v1beta1/msgs.proto",
+type MsgUpdateRolesResponse struct {
 }
 
-func (m *MsgLiquidStake) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateRolesResponse) Reset()         { *m = MsgUpdateRolesResponse{} }
+func (m *MsgUpdateRolesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateRolesResponse) ProtoMessage()    {}
+func (*MsgUpdateRolesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c178418d9a52b7e, []int{23}
+}
+func (m *MsgUpdateRolesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateRolesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateRolesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgUpdateRolesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateRolesResponse.Merge(m, src)
+}
+func (m *MsgUpdateRolesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateRolesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateRolesResponse.DiscardUnknown(m)
 }
 
-func (m *MsgLiquidStake) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgUpdateRolesResponse proto.InternalMessageInfo
+
+// MsgSetPauseSwitches sets the pause switches of the module, pausers can only
+// pause operations while admins can also resume them
+type MsgSetPauseSwitches struct {
+	PstakeAddress string        `protobuf:"bytes,1,opt,name=pstake_address,json=pstakeAddress,proto3" json:"pstake_address,omitempty"`
+	PauseSwitches PauseSwitches `protobuf:"bytes,2,opt,name=pause_switches,json=pauseSwitches,proto3" json:"pause_switches"`
 }
 
-func (m *MsgLiquidStake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgSetPauseSwitches) Reset()         { *m = MsgSetPauseSwitches{} }
+func (m *MsgSetPauseSwitches) String() string { return proto.CompactTextString(m) }
+func (*MsgSetPauseSwitches) ProtoMessage()    {}
+func (*MsgSetPauseSwitches) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c178418d9a52b7e, []int{24}
+}
+func (m *MsgSetPauseSwitches) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetPauseSwitches) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetPauseSwitches.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintMsgs(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.DelegatorAddress) > 0 {
-		i -= len(m.DelegatorAddress)
-		copy(dAtA[i:], m.DelegatorAddress)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.DelegatorAddress)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgSetPauseSwitches) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetPauseSwitches.Merge(m, src)
+}
+func (m *MsgSetPauseSwitches) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetPauseSwitches) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetPauseSwitches.DiscardUnknown(m)
 }
 
-func (m *MsgLiquidStakeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgSetPauseSwitches proto.InternalMessageInfo
+
+func (m *MsgSetPauseSwitches) GetPstakeAddress() string {
+	if m != nil {
+		return m.PstakeAddress
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgLiquidStakeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSetPauseSwitches) GetPauseSwitches() PauseSwitches {
+	if m != nil {
+		return m.PauseSwitches
+	}
+	return PauseSwitches{}
 }
 
-func (m *MsgLiquidStakeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+type MsgSetPauseSwitchesResponse struct {
 }
 
-func (m *MsgLiquidUnstake) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgSetPauseSwitchesResponse) Reset()         { *m = MsgSetPauseSwitchesResponse{} }
+func (m *MsgSetPauseSwitchesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetPauseSwitchesResponse) ProtoMessage()    {}
+func (*MsgSetPauseSwitchesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c178418d9a52b7e, []int{25}
 }
-
-func (m *MsgLiquidUnstake) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSetPauseSwitchesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *MsgLiquidUnstake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgSetPauseSwitchesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetPauseSwitchesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintMsgs(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.DelegatorAddress) > 0 {
-		i -= len(m.DelegatorAddress)
-		copy(dAtA[i:], m.DelegatorAddress)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.DelegatorAddress)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgLiquidUnstakeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgSetPauseSwitchesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetPauseSwitchesResponse.Merge(m, src)
 }
-
-func (m *MsgLiquidUnstakeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSetPauseSwitchesResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *MsgLiquidUnstakeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgSetPauseSwitchesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetPauseSwitchesResponse.DiscardUnknown(m)
 }
 
-func (m *MsgRedeem) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
+var xxx_messageInfo_MsgSetPauseSwitchesResponse proto.InternalMessageInfo
 
-func (m *MsgRedeem) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgRegisterHostProposal registers a host chain governance proposal for stk
+// holders to signal votes on
+type MsgRegisterHostProposal struct {
+	AdminAddress string `protobuf:"bytes,1,opt,name=admin_address,json=adminAddress,proto3" json:"admin_address,omitempty"`
+	ProposalId   uint64 `protobuf:"varint,2,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// voting_end_time is the end of the host chain voting period
+	VotingEndTime time.Time `protobuf:"bytes,3,opt,name=voting_end_time,json=votingEndTime,proto3,stdtime" json:"voting_end_time"`
 }
 
-func (m *MsgRedeem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgRegisterHostProposal) Reset()         { *m = MsgRegisterHostProposal{} }
+func (m *MsgRegisterHostProposal) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterHostProposal) ProtoMessage()    {}
+func (*MsgRegisterHostProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c178418d9a52b7e, []int{26}
+}
+func (m *MsgRegisterHostProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterHostProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterHostProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintMsgs(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.DelegatorAddress) > 0 {
-		i -= len(m.DelegatorAddress)
-		copy(dAtA[i:], m.DelegatorAddress)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.DelegatorAddress)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgRedeemResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgRegisterHostProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterHostProposal.Merge(m, src)
 }
-
-func (m *MsgRedeemResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRegisterHostProposal) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *MsgRedeemResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgRegisterHostProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterHostProposal.DiscardUnknown(m)
 }
 
-func (m *MsgClaim) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
+var xxx_messageInfo_MsgRegisterHostProposal proto.InternalMessageInfo
 
-func (m *MsgClaim) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRegisterHostProposal) GetAdminAddress() string {
+	if m != nil {
+		return m.AdminAddress
+	}
+	return ""
 }
 
-func (m *MsgClaim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.DelegatorAddress) > 0 {
-		i -= len(m.DelegatorAddress)
-		copy(dAtA[i:], m.DelegatorAddress)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.DelegatorAddress)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgRegisterHostProposal) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *MsgClaimResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRegisterHostProposal) GetVotingEndTime() time.Time {
+	if m != nil {
+		return m.VotingEndTime
 	}
-	return dAtA[:n], nil
+	return time.Time{}
 }
 
-func (m *MsgClaimResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgRegisterHostProposalResponse struct {
 }
 
-func (m *MsgClaimResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgRegisterHostProposalResponse) Reset()         { *m = MsgRegisterHostProposalResponse{} }
+func (m *MsgRegisterHostProposalResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterHostProposalResponse) ProtoMessage()    {}
+func (*MsgRegisterHostProposalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c178418d9a52b7e, []int{27}
 }
-
-func (m *MsgRecreateICA) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRegisterHostProposalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterHostProposalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterHostProposalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgRecreateICA) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRegisterHostProposalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterHostProposalResponse.Merge(m, src)
+}
+func (m *MsgRegisterHostProposalResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterHostProposalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterHostProposalResponse.DiscardUnknown(m)
 }
 
-func (m *MsgRecreateICA) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.FromAddress) > 0 {
-		i -= len(m.FromAddress)
-		copy(dAtA[i:], m.FromAddress)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.FromAddress)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+var xxx_messageInfo_MsgRegisterHostProposalResponse proto.InternalMessageInfo
+
+// MsgVoteHostProposal signals the vote of an stk holder on a registered host
+// chain proposal, a later signal replaces the previous one
+type MsgVoteHostProposal struct {
+	Voter      string                       `protobuf:"bytes,1,opt,name=voter,proto3" json:"voter,omitempty"`
+	ProposalId uint64                       `protobuf:"varint,2,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	Options    []v1beta1.WeightedVoteOption `protobuf:"bytes,3,rep,name=options,proto3" json:"options"`
 }
 
-func (m *MsgRecreateICAResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgVoteHostProposal) Reset()         { *m = MsgVoteHostProposal{} }
+func (m *MsgVoteHostProposal) String() string { return proto.CompactTextString(m) }
+func (*MsgVoteHostProposal) ProtoMessage()    {}
+func (*MsgVoteHostProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c178418d9a52b7e, []int{28}
+}
+func (m *MsgVoteHostProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgVoteHostProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgVoteHostProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgVoteHostProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgVoteHostProposal.Merge(m, src)
+}
+func (m *MsgVoteHostProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgVoteHostProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgVoteHostProposal.DiscardUnknown(m)
 }
 
-func (m *MsgRecreateICAResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgVoteHostProposal proto.InternalMessageInfo
+
+func (m *MsgVoteHostProposal) GetVoter() string {
+	if m != nil {
+		return m.Voter
+	}
+	return ""
 }
 
-func (m *MsgRecreateICAResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgVoteHostProposal) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
 }
 
-func (m *MsgJumpStart) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgVoteHostProposal) GetOptions() []v1beta1.WeightedVoteOption {
+	if m != nil {
+		return m.Options
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgJumpStart) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgVoteHostProposalResponse struct {
 }
 
-func (m *MsgJumpStart) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.HostAccounts.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintMsgs(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x5a
-	{
-		size, err := m.PstakeParams.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintMsgs(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x52
-	{
-		size, err := m.AllowListedValidators.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgVoteHostProposalResponse) Reset()         { *m = MsgVoteHostProposalResponse{} }
+func (m *MsgVoteHostProposalResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgVoteHostProposalResponse) ProtoMessage()    {}
+func (*MsgVoteHostProposalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c178418d9a52b7e, []int{29}
+}
+func (m *MsgVoteHostProposalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgVoteHostProposalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgVoteHostProposalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintMsgs(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x4a
-	{
-		size := m.MinDeposit.Size()
-		i -= size
-		if _, err := m.MinDeposit.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+			return nil, err
 		}
-		i = encodeVarintMsgs(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x42
-	if len(m.MintDenom) > 0 {
-		i -= len(m.MintDenom)
-		copy(dAtA[i:], m.MintDenom)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.MintDenom)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.BaseDenom) > 0 {
-		i -= len(m.BaseDenom)
-		copy(dAtA[i:], m.BaseDenom)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.BaseDenom)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.TransferPort) > 0 {
-		i -= len(m.TransferPort)
-		copy(dAtA[i:], m.TransferPort)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.TransferPort)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.TransferChannel) > 0 {
-		i -= len(m.TransferChannel)
-		copy(dAtA[i:], m.TransferChannel)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.TransferChannel)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.ConnectionID) > 0 {
-		i -= len(m.ConnectionID)
-		copy(dAtA[i:], m.ConnectionID)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.ConnectionID)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.ChainID) > 0 {
-		i -= len(m.ChainID)
-		copy(dAtA[i:], m.ChainID)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.ChainID)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.PstakeAddress) > 0 {
-		i -= len(m.PstakeAddress)
-		copy(dAtA[i:], m.PstakeAddress)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.PstakeAddress)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgVoteHostProposalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgVoteHostProposalResponse.Merge(m, src)
+}
+func (m *MsgVoteHostProposalResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgVoteHostProposalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgVoteHostProposalResponse.DiscardUnknown(m)
 }
 
-func (m *MsgJumpStartResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+var xxx_messageInfo_MsgVoteHostProposalResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgLiquidStake)(nil), "pstake.lscosmos.v1beta1.MsgLiquidStake")
+	proto.RegisterType((*MsgLiquidStakeResponse)(nil), "pstake.lscosmos.v1beta1.MsgLiquidStakeResponse")
+	proto.RegisterType((*MsgLiquidUnstake)(nil), "pstake.lscosmos.v1beta1.MsgLiquidUnstake")
+	proto.RegisterType((*MsgLiquidUnstakeResponse)(nil), "pstake.lscosmos.v1beta1.MsgLiquidUnstakeResponse")
+	proto.RegisterType((*MsgRedeem)(nil), "pstake.lscosmos.v1beta1.MsgRedeem")
+	proto.RegisterType((*MsgRedeemResponse)(nil), "pstake.lscosmos.v1beta1.MsgRedeemResponse")
+	proto.RegisterType((*MsgClaim)(nil), "pstake.lscosmos.v1beta1.MsgClaim")
+	proto.RegisterType((*MsgClaimResponse)(nil), "pstake.lscosmos.v1beta1.MsgClaimResponse")
+	proto.RegisterType((*MsgRecreateICA)(nil), "pstake.lscosmos.v1beta1.MsgRecreateICA")
+	proto.RegisterType((*MsgRecreateICAResponse)(nil), "pstake.lscosmos.v1beta1.MsgRecreateICAResponse")
+	proto.RegisterType((*MsgJumpStart)(nil), "pstake.lscosmos.v1beta1.MsgJumpStart")
+	proto.RegisterType((*MsgJumpStartResponse)(nil), "pstake.lscosmos.v1beta1.MsgJumpStartResponse")
+	proto.RegisterType((*MsgChangeModuleState)(nil), "pstake.lscosmos.v1beta1.MsgChangeModuleState")
+	proto.RegisterType((*MsgChangeModuleStateResponse)(nil), "pstake.lscosmos.v1beta1.MsgChangeModuleStateResponse")
+	proto.RegisterType((*MsgReportSlashing)(nil), "pstake.lscosmos.v1beta1.MsgReportSlashing")
+	proto.RegisterType((*MsgReportSlashingResponse)(nil), "pstake.lscosmos.v1beta1.MsgReportSlashingResponse")
+	proto.RegisterType((*MsgTransferUnbondingEntry)(nil), "pstake.lscosmos.v1beta1.MsgTransferUnbondingEntry")
+	proto.RegisterType((*MsgTransferUnbondingEntryResponse)(nil), "pstake.lscosmos.v1beta1.MsgTransferUnbondingEntryResponse")
+	proto.RegisterType((*MsgClaimFor)(nil), "pstake.lscosmos.v1beta1.MsgClaimFor")
+	proto.RegisterType((*MsgClaimForResponse)(nil), "pstake.lscosmos.v1beta1.MsgClaimForResponse")
+	proto.RegisterType((*MsgSetAutoClaim)(nil), "pstake.lscosmos.v1beta1.MsgSetAutoClaim")
+	proto.RegisterType((*MsgSetAutoClaimResponse)(nil), "pstake.lscosmos.v1beta1.MsgSetAutoClaimResponse")
+	proto.RegisterType((*MsgUpdateRoles)(nil), "pstake.lscosmos.v1beta1.MsgUpdateRoles")
+	proto.RegisterType((*MsgUpdateRolesResponse)(nil), "pstake.lscosmos.v1beta1.MsgUpdateRolesResponse")
+	proto.RegisterType((*MsgSetPauseSwitches)(nil), "pstake.lscosmos.v1beta1.MsgSetPauseSwitches")
+	proto.RegisterType((*MsgSetPauseSwitchesResponse)(nil), "pstake.lscosmos.v1beta1.MsgSetPauseSwitchesResponse")
+	proto.RegisterType((*MsgRegisterHostProposal)(nil), "pstake.lscosmos.v1beta1.MsgRegisterHostProposal")
+	proto.RegisterType((*MsgRegisterHostProposalResponse)(nil), "pstake.lscosmos.v1beta1.MsgRegisterHostProposalResponse")
+	proto.RegisterType((*MsgVoteHostProposal)(nil), "pstake.lscosmos.v1beta1.MsgVoteHostProposal")
+	proto.RegisterType((*MsgVoteHostProposalResponse)(nil), "pstake.lscosmos.v1beta1.MsgVoteHostProposalResponse")
 }
 
-func (m *MsgJumpStartResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func init() {
+	proto.RegisterFile("pstake/lscosmos/v1beta1/msgs.proto", fileDescriptor_2c178418d9a52b7e)
 }
 
-func (m *MsgJumpStartResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var fileDescriptor_2c178418d9a52b7e = []byte{
+	// 1697 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xcb, 0x6f, 0x1c, 0x4d,
+	0x11, 0xf7, 0xf8, 0xed, 0x5e, 0x3f, 0x27, 0xfe, 0xec, 0xf5, 0x7c, 0x5f, 0xd6, 0xf6, 0xc4, 0xf1,
+	0x2b, 0xf6, 0x4c, 0x6c, 0x12, 0x05, 0x39, 0x42, 0xc8, 0xaf, 0x08, 0xa3, 0x98, 0x58, 0xbb, 0x71,
+	0x90, 0xb8, 0x0c, 0xb3, 0x3b, 0x9d, 0xd9, 0x51, 0x76, 0xba, 0x87, 0xe9, 0xde, 0x0d, 0x39, 0x70,
+	0x20, 0x37, 0x90, 0x10, 0x91, 0x10, 0x20, 0x0e, 0x11, 0x42, 0x5c, 0x00, 0x29, 0x12, 0x07, 0x2e,
+	0x70, 0x82, 0x5b, 0x8e, 0x11, 0x70, 0x40, 0x20, 0x05, 0x94, 0x20, 0xf1, 0x6f, 0xa0, 0xee, 0xe9,
+	0x69, 0xcf, 0x3e, 0x66, 0x1f, 0xb2, 0x0f, 0x39, 0xd9, 0xae, 0xfa, 0x55, 0xd5, 0xaf, 0xaa, 0xab,
+	0xab, 0xa7, 0x0c, 0xf4, 0x80, 0x50, 0xfb, 0x19, 0x34, 0x2b, 0xa4, 0x84, 0x89, 0x8f, 0x89, 0x59,
+	0xdb, 0x29, 0x42, 0x6a, 0xef, 0x98, 0x3e, 0x71, 0x89, 0x11, 0x84, 0x98, 0x62, 0x75, 0x3e, 0xc2,
+	0x18, 0x31, 0xc6, 0x10, 0x18, 0x6d, 0xd6, 0xc5, 0x2e, 0xe6, 0x18, 0x93, 0xfd, 0x16, 0xc1, 0xb5,
+	0x2f, 0x5c, 0x8c, 0xdd, 0x0a, 0x34, 0xed, 0xc0, 0x33, 0x6d, 0x84, 0x30, 0xb5, 0xa9, 0x87, 0x91,
+	0x70, 0xa6, 0x2d, 0x08, 0x2d, 0xff, 0xab, 0x58, 0x7d, 0x6a, 0xda, 0xe8, 0x85, 0x50, 0xe5, 0x04,
+	0x85, 0xa2, 0x4d, 0xa0, 0xe4, 0x51, 0xc2, 0x1e, 0x8a, 0x4d, 0x23, 0xbd, 0x15, 0x45, 0x14, 0x5c,
+	0x22, 0xd5, 0xbc, 0x30, 0xf5, 0x89, 0x6b, 0xd6, 0x38, 0xf9, 0x98, 0x8c, 0x50, 0xb8, 0xb8, 0x26,
+	0x5d, 0xba, 0xb8, 0x26, 0xb4, 0x8b, 0x8d, 0x64, 0xa8, 0xe7, 0x43, 0x42, 0x6d, 0x3f, 0x10, 0x80,
+	0xd5, 0xb4, 0xf2, 0xc8, 0x5a, 0x70, 0x9c, 0xfe, 0x1b, 0x05, 0x4c, 0x9e, 0x12, 0xf7, 0xa1, 0xf7,
+	0x9d, 0xaa, 0xe7, 0x14, 0x98, 0x89, 0x7a, 0x0c, 0x66, 0x1c, 0x58, 0x81, 0xae, 0x4d, 0x71, 0x68,
+	0xd9, 0x8e, 0x13, 0x42, 0x42, 0xb2, 0xca, 0x92, 0xb2, 0x3e, 0x76, 0x90, 0xfd, 0xeb, 0x1f, 0xb6,
+	0x67, 0x85, 0xfd, 0x7e, 0xa4, 0x29, 0xd0, 0xd0, 0x43, 0x6e, 0x7e, 0x5a, 0x9a, 0x08, 0xb9, 0x7a,
+	0x0f, 0x0c, 0xdb, 0x3e, 0xae, 0x22, 0x9a, 0xed, 0x5f, 0x52, 0xd6, 0x33, 0xbb, 0x0b, 0x86, 0x30,
+	0x64, 0x55, 0x8a, 0x4f, 0xc2, 0x38, 0xc4, 0x1e, 0x3a, 0x18, 0x7c, 0xfb, 0x7e, 0xb1, 0x2f, 0x2f,
+	0xe0, 0x7b, 0x73, 0x2f, 0xff, 0xf7, 0xfb, 0xcd, 0x66, 0x0a, 0x7a, 0x16, 0xcc, 0xd5, 0x33, 0xcd,
+	0x43, 0x12, 0x60, 0x44, 0xa0, 0xfe, 0x3b, 0x05, 0x4c, 0x4b, 0xd5, 0x39, 0x22, 0x9f, 0x74, 0x1a,
+	0x1a, 0xc8, 0x36, 0x72, 0x95, 0x89, 0xfc, 0x5a, 0x01, 0x63, 0xa7, 0xc4, 0xcd, 0x43, 0x07, 0x42,
+	0xff, 0x93, 0xcd, 0xe0, 0x1a, 0x98, 0x91, 0x24, 0x25, 0x75, 0x0f, 0x8c, 0x9e, 0x12, 0xf7, 0xb0,
+	0x62, 0x7b, 0x57, 0x45, 0x3c, 0x35, 0xbe, 0x0a, 0xa6, 0xe3, 0x50, 0x32, 0xfc, 0xb7, 0x79, 0x1b,
+	0xe7, 0x61, 0x29, 0x84, 0x36, 0x85, 0x27, 0x87, 0xfb, 0xea, 0x7d, 0x30, 0xfe, 0x34, 0xc4, 0x7e,
+	0xd7, 0xf1, 0x33, 0x0c, 0x1d, 0x87, 0x9e, 0x61, 0xa1, 0xeb, 0xec, 0x45, 0xfb, 0x25, 0x22, 0xc8,
+	0xd8, 0x3f, 0x1f, 0x02, 0xe3, 0xa7, 0xc4, 0xfd, 0x7a, 0xd5, 0x0f, 0x0a, 0xd4, 0x0e, 0xa9, 0xfa,
+	0x55, 0x30, 0x19, 0x5d, 0xbf, 0xae, 0x83, 0x4f, 0x44, 0xf8, 0xf8, 0xc8, 0x34, 0x30, 0x56, 0x2a,
+	0xdb, 0x1e, 0xb2, 0x3c, 0xcb, 0xe1, 0xa7, 0x36, 0x96, 0x1f, 0xe1, 0x82, 0x93, 0x23, 0x75, 0x05,
+	0x4c, 0x96, 0x30, 0x42, 0xb0, 0xc4, 0x86, 0x13, 0x07, 0x0c, 0x70, 0xc0, 0xf8, 0x85, 0xf4, 0xe4,
+	0x48, 0xdd, 0x00, 0xd3, 0x34, 0xb4, 0x11, 0x79, 0x0a, 0x43, 0xab, 0x54, 0xb6, 0x11, 0x82, 0x95,
+	0xec, 0x20, 0xc7, 0x4d, 0xc5, 0xf2, 0xc3, 0x48, 0xac, 0xde, 0x00, 0x13, 0x12, 0x1a, 0xe0, 0x90,
+	0x66, 0x87, 0x22, 0x7f, 0xb1, 0xf0, 0x0c, 0x87, 0x54, 0xbd, 0x0e, 0x00, 0x6b, 0x17, 0xcb, 0x81,
+	0x08, 0xfb, 0xd9, 0x61, 0x8e, 0x18, 0x63, 0x92, 0x23, 0x26, 0x60, 0x6a, 0xdf, 0x43, 0x54, 0xa8,
+	0x47, 0x22, 0x35, 0x93, 0x44, 0xea, 0x47, 0x20, 0xe3, 0x7b, 0xc8, 0x72, 0x60, 0x80, 0x89, 0x47,
+	0xb3, 0xa3, 0xbc, 0x1a, 0x06, 0x6b, 0xb6, 0x7f, 0xbe, 0x5f, 0x5c, 0x75, 0x3d, 0x5a, 0xae, 0x16,
+	0x8d, 0x12, 0xf6, 0xc5, 0x6c, 0x14, 0x3f, 0xb6, 0x89, 0xf3, 0xcc, 0xa4, 0x2f, 0x02, 0x48, 0x8c,
+	0x13, 0x44, 0xf3, 0x2c, 0xc2, 0x51, 0xe4, 0x41, 0xad, 0x80, 0x79, 0xbb, 0x52, 0xc1, 0xcf, 0xad,
+	0x8a, 0x47, 0x28, 0x74, 0xac, 0x9a, 0x5d, 0xf1, 0x1c, 0xd6, 0x24, 0x24, 0x3b, 0xc6, 0x9b, 0xdc,
+	0x30, 0x52, 0x66, 0xbf, 0xb1, 0xcf, 0xec, 0x1e, 0x72, 0xb3, 0x27, 0xd2, 0x4a, 0x74, 0xfe, 0x67,
+	0x76, 0x2b, 0xa5, 0x7a, 0x06, 0xc4, 0xf9, 0x58, 0x81, 0x1d, 0xda, 0x3e, 0xc9, 0x02, 0x1e, 0xe3,
+	0x66, 0x6a, 0x8c, 0x33, 0x2e, 0x3f, 0xe3, 0x60, 0xe1, 0x7a, 0x3c, 0x48, 0xc8, 0x98, 0xc7, 0x32,
+	0x26, 0xd4, 0xb2, 0x4b, 0x25, 0x76, 0xd5, 0x48, 0x36, 0xd3, 0xc1, 0xe3, 0xd7, 0x30, 0xa1, 0xfb,
+	0x02, 0x1c, 0x7b, 0x2c, 0x27, 0x64, 0x7b, 0xd7, 0x58, 0xc7, 0x36, 0xb4, 0x9d, 0x3e, 0x07, 0x66,
+	0x93, 0x8d, 0x29, 0x3b, 0xf6, 0xc7, 0x0a, 0x57, 0xb0, 0x0e, 0x70, 0xe1, 0x29, 0x76, 0xaa, 0x15,
+	0x58, 0xa0, 0x36, 0x85, 0x97, 0xef, 0xdc, 0x65, 0x30, 0xee, 0x73, 0x7f, 0x16, 0x61, 0x0e, 0x79,
+	0xf3, 0x8e, 0xe6, 0x33, 0xfe, 0x45, 0x8c, 0xd6, 0x4c, 0x73, 0xe0, 0x8b, 0x56, 0x84, 0x24, 0xe3,
+	0x9f, 0x29, 0x62, 0xe8, 0xb0, 0x0e, 0x2d, 0x54, 0x6c, 0x52, 0xf6, 0x90, 0x7b, 0x79, 0xba, 0xb7,
+	0xc0, 0x8c, 0x6c, 0x1d, 0xe9, 0x23, 0xba, 0x70, 0xd3, 0x52, 0x11, 0x0f, 0x85, 0x96, 0xc4, 0x3f,
+	0x07, 0x0b, 0x4d, 0xbc, 0x24, 0xeb, 0xd7, 0xfd, 0x5c, 0xfb, 0x58, 0xdc, 0xa4, 0x73, 0x54, 0xc4,
+	0xc8, 0xf1, 0x90, 0x7b, 0x8c, 0x68, 0xf8, 0xe2, 0xaa, 0xe6, 0xfb, 0x31, 0x98, 0x09, 0x61, 0xc9,
+	0x0b, 0x3c, 0x88, 0x68, 0x7d, 0x0e, 0xed, 0xdc, 0x48, 0x93, 0xc4, 0xc9, 0xc1, 0x00, 0x97, 0xca,
+	0x16, 0xaa, 0xfa, 0x45, 0x18, 0xf2, 0xa9, 0x32, 0x90, 0xcf, 0x70, 0xd9, 0x37, 0xb8, 0x28, 0xf1,
+	0x92, 0x0c, 0x5e, 0xcd, 0x4b, 0x72, 0x03, 0x2c, 0xa7, 0x96, 0x47, 0x16, 0xf1, 0x8d, 0x02, 0x32,
+	0xf1, 0xbc, 0x7f, 0x80, 0x43, 0x75, 0x1f, 0x4c, 0x95, 0xd8, 0xef, 0xb0, 0xfb, 0xa2, 0x4d, 0x0a,
+	0x83, 0x44, 0xc9, 0x9a, 0x2b, 0xdf, 0xdf, 0xf3, 0x03, 0x35, 0xcb, 0xd2, 0x6a, 0x24, 0xa3, 0x7f,
+	0x06, 0xae, 0x25, 0xe8, 0xca, 0x34, 0x7e, 0xa8, 0x80, 0xa9, 0x53, 0xe2, 0x16, 0x20, 0xdd, 0xaf,
+	0x52, 0x7c, 0x95, 0x0f, 0xa5, 0x9a, 0x05, 0x23, 0x10, 0xd9, 0xc5, 0x0a, 0x74, 0xc4, 0x7d, 0x8b,
+	0xff, 0x4c, 0x2d, 0xfc, 0x02, 0x98, 0x6f, 0xe0, 0x22, 0x79, 0xfe, 0x32, 0xfa, 0x22, 0x3c, 0x0f,
+	0x1c, 0x76, 0xff, 0x70, 0x05, 0x12, 0xf5, 0x2b, 0x60, 0xc2, 0x76, 0xd8, 0x00, 0xef, 0x96, 0xe2,
+	0x38, 0x87, 0xc7, 0xf4, 0xf6, 0xc0, 0x50, 0xc8, 0xfc, 0x88, 0xef, 0x8f, 0x5c, 0xea, 0x90, 0xe3,
+	0xd1, 0x44, 0xeb, 0x44, 0x26, 0x7b, 0x2a, 0x4b, 0xa0, 0x3e, 0xba, 0x78, 0x89, 0x13, 0x04, 0x25,
+	0xf7, 0x3f, 0x2b, 0xbc, 0xf6, 0x05, 0x48, 0xcf, 0xec, 0x2a, 0x81, 0x85, 0xe7, 0x1e, 0x2d, 0x95,
+	0x21, 0xb9, 0xfc, 0x9c, 0x28, 0x80, 0xc9, 0x80, 0x79, 0xb4, 0x88, 0x70, 0x29, 0x72, 0x59, 0x4d,
+	0x7f, 0x02, 0x92, 0x04, 0x44, 0x4e, 0x13, 0x41, 0x52, 0xd8, 0x7a, 0x9e, 0x5c, 0x07, 0x9f, 0xb7,
+	0xc8, 0x40, 0x66, 0xf8, 0x2f, 0x85, 0x9f, 0x5c, 0x1e, 0xba, 0xec, 0x91, 0x0a, 0xd9, 0xb3, 0x70,
+	0x16, 0xe2, 0x00, 0x13, 0xbb, 0x72, 0xd9, 0x63, 0x5a, 0x04, 0x99, 0x40, 0xb8, 0xb2, 0xbc, 0xa8,
+	0x93, 0x06, 0xf3, 0x20, 0x16, 0x9d, 0x38, 0xea, 0x43, 0x30, 0x55, 0xc3, 0xd4, 0x43, 0xae, 0x05,
+	0x91, 0x63, 0xb1, 0x8d, 0x83, 0x0f, 0x89, 0xcc, 0xae, 0x66, 0x44, 0xeb, 0x88, 0x11, 0xaf, 0x23,
+	0xc6, 0xe3, 0x78, 0x1d, 0x39, 0x18, 0x65, 0x99, 0xbf, 0xfa, 0xf7, 0xa2, 0x92, 0x9f, 0x88, 0x8c,
+	0x8f, 0x91, 0xc3, 0xb4, 0x2d, 0x4f, 0x76, 0x19, 0x2c, 0xa6, 0x24, 0x27, 0x0b, 0xf0, 0xa7, 0xe8,
+	0x88, 0x9f, 0x60, 0x0a, 0xeb, 0x92, 0x37, 0xc0, 0x50, 0x0d, 0x53, 0x18, 0x76, 0x4c, 0x3a, 0x82,
+	0x75, 0xce, 0xf6, 0x01, 0x18, 0xc1, 0x01, 0x5f, 0x00, 0xb3, 0x03, 0x4b, 0x03, 0xfc, 0xac, 0x85,
+	0x3f, 0xb6, 0x86, 0xc5, 0xc7, 0xfc, 0x4d, 0xe8, 0xb9, 0x65, 0xf6, 0xb5, 0x80, 0x29, 0x7c, 0xc4,
+	0xe1, 0xe2, 0xac, 0x63, 0xe3, 0x3d, 0xc0, 0xf2, 0x8c, 0x82, 0x8a, 0xc3, 0x6d, 0xe4, 0x1e, 0xe7,
+	0xb6, 0xfb, 0x77, 0x15, 0x0c, 0x9c, 0x12, 0x57, 0xfd, 0xa9, 0x02, 0x32, 0xc9, 0x8d, 0x6c, 0x2d,
+	0xb5, 0xcb, 0xea, 0x17, 0x22, 0xcd, 0xec, 0x12, 0x28, 0xab, 0xb9, 0xf5, 0xf2, 0x6f, 0xff, 0xfd,
+	0x49, 0xff, 0xaa, 0xbe, 0x62, 0xa6, 0xed, 0x8b, 0x49, 0x1e, 0xaf, 0x15, 0x30, 0x51, 0xbf, 0x64,
+	0x6d, 0x74, 0x0e, 0x28, 0xa0, 0xda, 0x4e, 0xd7, 0x50, 0xc9, 0xce, 0xe0, 0xec, 0xd6, 0xf5, 0xd5,
+	0x0e, 0xec, 0x62, 0x36, 0xdf, 0x57, 0xc0, 0xb0, 0xd8, 0x9d, 0xf4, 0x76, 0xd1, 0x22, 0x8c, 0xb6,
+	0xd9, 0x19, 0x23, 0xa9, 0xac, 0x71, 0x2a, 0xcb, 0xfa, 0x62, 0x2a, 0x15, 0x11, 0xf8, 0x7b, 0x60,
+	0x28, 0x9a, 0xed, 0xcb, 0xed, 0xbc, 0x73, 0x88, 0xb6, 0xd1, 0x11, 0x22, 0xe3, 0xaf, 0xf2, 0xf8,
+	0x4b, 0x7a, 0x2e, 0x35, 0x7e, 0x14, 0x95, 0xb5, 0x4e, 0x72, 0x0b, 0x5a, 0x6b, 0x9f, 0xa3, 0x04,
+	0x6a, 0x66, 0x97, 0xc0, 0x1e, 0x5a, 0x27, 0xc9, 0xe3, 0x47, 0x0a, 0x18, 0xbb, 0x58, 0x90, 0x6e,
+	0xb6, 0x0b, 0x26, 0x61, 0xda, 0x76, 0x57, 0x30, 0xc9, 0x68, 0x93, 0x33, 0x5a, 0xd1, 0xf5, 0x54,
+	0x46, 0x17, 0x0c, 0x58, 0x9d, 0x92, 0x4f, 0x5c, 0xdb, 0x3a, 0x25, 0x80, 0x9a, 0xd9, 0x25, 0xb0,
+	0x87, 0x3a, 0x25, 0x79, 0xfc, 0x56, 0x01, 0xd3, 0x4d, 0xcf, 0xd7, 0x56, 0xbb, 0x98, 0x8d, 0x68,
+	0xed, 0x4e, 0x2f, 0x68, 0x49, 0x73, 0x87, 0xd3, 0xbc, 0xa5, 0x6f, 0xa4, 0xd2, 0x6c, 0xa2, 0xf5,
+	0x47, 0x05, 0xcc, 0xb6, 0x7c, 0x88, 0x6e, 0xb7, 0xef, 0xa5, 0x66, 0x0b, 0xed, 0xcb, 0xbd, 0x5a,
+	0x48, 0xde, 0x77, 0x39, 0x6f, 0x53, 0xdf, 0x6e, 0xd3, 0x86, 0x2d, 0x28, 0xb2, 0x3a, 0x37, 0xbd,
+	0x21, 0x6d, 0xeb, 0xdc, 0x88, 0xd6, 0xee, 0xf4, 0x82, 0xee, 0xa1, 0xce, 0x4d, 0xb4, 0xde, 0x28,
+	0x60, 0xa6, 0x79, 0x55, 0x6b, 0x7b, 0x39, 0x9a, 0xe0, 0xda, 0xdd, 0x9e, 0xe0, 0x92, 0xee, 0x2e,
+	0xa7, 0xbb, 0xa5, 0x6f, 0xa6, 0xcf, 0x9d, 0x26, 0x66, 0xbf, 0x52, 0xc0, 0x64, 0xc3, 0xa2, 0xd6,
+	0x61, 0xd4, 0x26, 0xb1, 0xda, 0x6e, 0xf7, 0x58, 0x49, 0xd3, 0xe4, 0x34, 0x37, 0xf4, 0xb5, 0x36,
+	0x5d, 0x50, 0x47, 0xe8, 0x2f, 0x0a, 0x98, 0x4b, 0x59, 0xcb, 0xda, 0xc6, 0x6f, 0x6d, 0xa3, 0xed,
+	0xf5, 0x6e, 0x23, 0xb9, 0xdf, 0xe3, 0xdc, 0x77, 0x74, 0x33, 0x95, 0x7b, 0x0a, 0xd1, 0x1f, 0x28,
+	0x60, 0x54, 0x6e, 0x45, 0x2b, 0x1d, 0xdf, 0x92, 0x07, 0x38, 0xd4, 0xb6, 0xba, 0x41, 0x49, 0x66,
+	0x1b, 0x9c, 0xd9, 0x0d, 0x7d, 0xb9, 0xfd, 0xa3, 0xc3, 0xc2, 0xff, 0x42, 0x01, 0xe3, 0x75, 0xab,
+	0xcd, 0x7a, 0x87, 0x29, 0x24, 0x91, 0xda, 0xed, 0x6e, 0x91, 0x92, 0xd7, 0x36, 0xe7, 0xb5, 0xa6,
+	0xdf, 0x6c, 0x37, 0xab, 0xa4, 0xd9, 0xc1, 0xf9, 0xdb, 0x0f, 0x39, 0xe5, 0xdd, 0x87, 0x9c, 0xf2,
+	0x9f, 0x0f, 0x39, 0xe5, 0xd5, 0xc7, 0x5c, 0xdf, 0xbb, 0x8f, 0xb9, 0xbe, 0x7f, 0x7c, 0xcc, 0xf5,
+	0x7d, 0xeb, 0x7e, 0xe2, 0x5f, 0x4f, 0x01, 0x0c, 0x09, 0x9b, 0x13, 0xa8, 0x04, 0x1f, 0x21, 0x28,
+	0x3c, 0x6f, 0x23, 0x9b, 0x7a, 0x35, 0x68, 0xd6, 0x76, 0xcd, 0xef, 0x5e, 0x44, 0xe1, 0xff, 0x93,
+	0x2a, 0x0e, 0xf3, 0xaf, 0xdd, 0x2f, 0xfd, 0x7f, 0x00, 0x8d, 0x30, 0x37, 0x92, 0x8a, 0x18, 0x00,
+	0x00,
 }
 
-func (m *MsgChangeModuleState) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	LiquidStake(ctx context.Context, in *MsgLiquidStake, opts ...grpc.CallOption) (*MsgLiquidStakeResponse, error)
+	LiquidUnstake(ctx context.Context, in *MsgLiquidUnstake, opts ...grpc.CallOption) (*MsgLiquidUnstakeResponse, error)
+	Redeem(ctx context.Context, in *MsgRedeem, opts ...grpc.CallOption) (*MsgRedeemResponse, error)
+	Claim(ctx context.Context, in *MsgClaim, opts ...grpc.CallOption) (*MsgClaimResponse, error)
+	RecreateICA(ctx context.Context, in *MsgRecreateICA, opts ...grpc.CallOption) (*MsgRecreateICAResponse, error)
+	JumpStart(ctx context.Context, in *MsgJumpStart, opts ...grpc.CallOption) (*MsgJumpStartResponse, error)
+	UpdateRoles(ctx context.Context, in *MsgUpdateRoles, opts ...grpc.CallOption) (*MsgUpdateRolesResponse, error)
+	SetPauseSwitches(ctx context.Context, in *MsgSetPauseSwitches, opts ...grpc.CallOption) (*MsgSetPauseSwitchesResponse, error)
+	RegisterHostProposal(ctx context.Context, in *MsgRegisterHostProposal, opts ...grpc.CallOption) (*MsgRegisterHostProposalResponse, error)
+	VoteHostProposal(ctx context.Context, in *MsgVoteHostProposal, opts ...grpc.CallOption) (*MsgVoteHostProposalResponse, error)
+	ChangeModuleState(ctx context.Context, in *MsgChangeModuleState, opts ...grpc.CallOption) (*MsgChangeModuleStateResponse, error)
+	ReportSlashing(ctx context.Context, in *MsgReportSlashing, opts ...grpc.CallOption) (*MsgReportSlashingResponse, error)
+	TransferUnbondingEntry(ctx context.Context, in *MsgTransferUnbondingEntry, opts ...grpc.CallOption) (*MsgTransferUnbondingEntryResponse, error)
+	ClaimFor(ctx context.Context, in *MsgClaimFor, opts ...grpc.CallOption) (*MsgClaimForResponse, error)
+	SetAutoClaim(ctx context.Context, in *MsgSetAutoClaim, opts ...grpc.CallOption) (*MsgSetAutoClaimResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) LiquidStake(ctx context.Context, in *MsgLiquidStake, opts ...grpc.CallOption) (*MsgLiquidStakeResponse, error) {
+	out := new(MsgLiquidStakeResponse)
+	err := c.cc.Invoke(ctx, "/pstake.lscosmos.v1beta1.Msg/LiquidStake", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgChangeModuleState) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) LiquidUnstake(ctx context.Context, in *MsgLiquidUnstake, opts ...grpc.CallOption) (*MsgLiquidUnstakeResponse, error) {
+	out := new(MsgLiquidUnstakeResponse)
+	err := c.cc.Invoke(ctx, "/pstake.lscosmos.v1beta1.Msg/LiquidUnstake", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgChangeModuleState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ModuleState {
-		i--
-		if m.ModuleState {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x10
+func (c *msgClient) Redeem(ctx context.Context, in *MsgRedeem, opts ...grpc.CallOption) (*MsgRedeemResponse, error) {
+	out := new(MsgRedeemResponse)
+	err := c.cc.Invoke(ctx, "/pstake.lscosmos.v1beta1.Msg/Redeem", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.PstakeAddress) > 0 {
-		i -= len(m.PstakeAddress)
-		copy(dAtA[i:], m.PstakeAddress)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.PstakeAddress)))
-		i--
-		dAtA[i] = 0xa
+	return out, nil
+}
+
+func (c *msgClient) Claim(ctx context.Context, in *MsgClaim, opts ...grpc.CallOption) (*MsgClaimResponse, error) {
+	out := new(MsgClaimResponse)
+	err := c.cc.Invoke(ctx, "/pstake.lscosmos.v1beta1.Msg/Claim", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgChangeModuleStateResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) RecreateICA(ctx context.Context, in *MsgRecreateICA, opts ...grpc.CallOption) (*MsgRecreateICAResponse, error) {
+	out := new(MsgRecreateICAResponse)
+	err := c.cc.Invoke(ctx, "/pstake.lscosmos.v1beta1.Msg/RecreateICA", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgChangeModuleStateResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) JumpStart(ctx context.Context, in *MsgJumpStart, opts ...grpc.CallOption) (*MsgJumpStartResponse, error) {
+	out := new(MsgJumpStartResponse)
+	err := c.cc.Invoke(ctx, "/pstake.lscosmos.v1beta1.Msg/JumpStart", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgChangeModuleStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) UpdateRoles(ctx context.Context, in *MsgUpdateRoles, opts ...grpc.CallOption) (*MsgUpdateRolesResponse, error) {
+	out := new(MsgUpdateRolesResponse)
+	err := c.cc.Invoke(ctx, "/pstake.lscosmos.v1beta1.Msg/UpdateRoles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgReportSlashing) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) SetPauseSwitches(ctx context.Context, in *MsgSetPauseSwitches, opts ...grpc.CallOption) (*MsgSetPauseSwitchesResponse, error) {
+	out := new(MsgSetPauseSwitchesResponse)
+	err := c.cc.Invoke(ctx, "/pstake.lscosmos.v1beta1.Msg/SetPauseSwitches", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgReportSlashing) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgReportSlashing) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ValidatorAddress) > 0 {
-		i -= len(m.ValidatorAddress)
-		copy(dAtA[i:], m.ValidatorAddress)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.ValidatorAddress)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.PstakeAddress) > 0 {
-		i -= len(m.PstakeAddress)
-		copy(dAtA[i:], m.PstakeAddress)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.PstakeAddress)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) RegisterHostProposal(ctx context.Context, in *MsgRegisterHostProposal, opts ...grpc.CallOption) (*MsgRegisterHostProposalResponse, error) {
+	out := new(MsgRegisterHostProposalResponse)
+	err := c.cc.Invoke(ctx, "/pstake.lscosmos.v1beta1.Msg/RegisterHostProposal", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgReportSlashingResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) VoteHostProposal(ctx context.Context, in *MsgVoteHostProposal, opts ...grpc.CallOption) (*MsgVoteHostProposalResponse, error) {
+	out := new(MsgVoteHostProposalResponse)
+	err := c.cc.Invoke(ctx, "/pstake.lscosmos.v1beta1.Msg/VoteHostProposal", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgReportSlashingResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) ChangeModuleState(ctx context.Context, in *MsgChangeModuleState, opts ...grpc.CallOption) (*MsgChangeModuleStateResponse, error) {
+	out := new(MsgChangeModuleStateResponse)
+	err := c.cc.Invoke(ctx, "/pstake.lscosmos.v1beta1.Msg/ChangeModuleState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgReportSlashingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) ReportSlashing(ctx context.Context, in *MsgReportSlashing, opts ...grpc.CallOption) (*MsgReportSlashingResponse, error) {
+	out := new(MsgReportSlashingResponse)
+	err := c.cc.Invoke(ctx, "/pstake.lscosmos.v1beta1.Msg/ReportSlashing", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func encodeVarintMsgs(dAtA []byte, offset int, v uint64) int {
-	offset -= sovMsgs(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (c *msgClient) TransferUnbondingEntry(ctx context.Context, in *MsgTransferUnbondingEntry, opts ...grpc.CallOption) (*MsgTransferUnbondingEntryResponse, error) {
+	out := new(MsgTransferUnbondingEntryResponse)
+	err := c.cc.Invoke(ctx, "/pstake.lscosmos.v1beta1.Msg/TransferUnbondingEntry", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return out, nil
 }
-func (m *MsgLiquidStake) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
+
+func (c *msgClient) ClaimFor(ctx context.Context, in *MsgClaimFor, opts ...grpc.CallOption) (*MsgClaimForResponse, error) {
+	out := new(MsgClaimForResponse)
+	err := c.cc.Invoke(ctx, "/pstake.lscosmos.v1beta1.Msg/ClaimFor", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovMsgs(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *MsgLiquidStakeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) SetAutoClaim(ctx context.Context, in *MsgSetAutoClaim, opts ...grpc.CallOption) (*MsgSetAutoClaimResponse, error) {
+	out := new(MsgSetAutoClaimResponse)
+	err := c.cc.Invoke(ctx, "/pstake.lscosmos.v1beta1.Msg/SetAutoClaim", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *MsgLiquidUnstake) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
-	}
-	l = m.Amount.Size()
-	n += 1 + l + sovMsgs(uint64(l))
-	return n
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	LiquidStake(context.Context, *MsgLiquidStake) (*MsgLiquidStakeResponse, error)
+	LiquidUnstake(context.Context, *MsgLiquidUnstake) (*MsgLiquidUnstakeResponse, error)
+	Redeem(context.Context, *MsgRedeem) (*MsgRedeemResponse, error)
+	Claim(context.Context, *MsgClaim) (*MsgClaimResponse, error)
+	RecreateICA(context.Context, *MsgRecreateICA) (*MsgRecreateICAResponse, error)
+	JumpStart(context.Context, *MsgJumpStart) (*MsgJumpStartResponse, error)
+	UpdateRoles(context.Context, *MsgUpdateRoles) (*MsgUpdateRolesResponse, error)
+	SetPauseSwitches(context.Context, *MsgSetPauseSwitches) (*MsgSetPauseSwitchesResponse, error)
+	RegisterHostProposal(context.Context, *MsgRegisterHostProposal) (*MsgRegisterHostProposalResponse, error)
+	VoteHostProposal(context.Context, *MsgVoteHostProposal) (*MsgVoteHostProposalResponse, error)
+	ChangeModuleState(context.Context, *MsgChangeModuleState) (*MsgChangeModuleStateResponse, error)
+	ReportSlashing(context.Context, *MsgReportSlashing) (*MsgReportSlashingResponse, error)
+	TransferUnbondingEntry(context.Context, *MsgTransferUnbondingEntry) (*MsgTransferUnbondingEntryResponse, error)
+	ClaimFor(context.Context, *MsgClaimFor) (*MsgClaimForResponse, error)
+	SetAutoClaim(context.Context, *MsgSetAutoClaim) (*MsgSetAutoClaimResponse, error)
 }
 
-func (m *MsgLiquidUnstakeResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
 }
 
-func (m *MsgRedeem) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
-	}
-	l = m.Amount.Size()
-	n += 1 + l + sovMsgs(uint64(l))
-	return n
+func (*UnimplementedMsgServer) LiquidStake(ctx context.Context, req *MsgLiquidStake) (*MsgLiquidStakeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LiquidStake not implemented")
+}
+func (*UnimplementedMsgServer) LiquidUnstake(ctx context.Context, req *MsgLiquidUnstake) (*MsgLiquidUnstakeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LiquidUnstake not implemented")
+}
+func (*UnimplementedMsgServer) Redeem(ctx context.Context, req *MsgRedeem) (*MsgRedeemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Redeem not implemented")
+}
+func (*UnimplementedMsgServer) Claim(ctx context.Context, req *MsgClaim) (*MsgClaimResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Claim not implemented")
+}
+func (*UnimplementedMsgServer) RecreateICA(ctx context.Context, req *MsgRecreateICA) (*MsgRecreateICAResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecreateICA not implemented")
+}
+func (*UnimplementedMsgServer) JumpStart(ctx context.Context, req *MsgJumpStart) (*MsgJumpStartResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JumpStart not implemented")
+}
+func (*UnimplementedMsgServer) UpdateRoles(ctx context.Context, req *MsgUpdateRoles) (*MsgUpdateRolesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRoles not implemented")
+}
+func (*UnimplementedMsgServer) SetPauseSwitches(ctx context.Context, req *MsgSetPauseSwitches) (*MsgSetPauseSwitchesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPauseSwitches not implemented")
+}
+func (*UnimplementedMsgServer) RegisterHostProposal(ctx context.Context, req *MsgRegisterHostProposal) (*MsgRegisterHostProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterHostProposal not implemented")
+}
+func (*UnimplementedMsgServer) VoteHostProposal(ctx context.Context, req *MsgVoteHostProposal) (*MsgVoteHostProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VoteHostProposal not implemented")
+}
+func (*UnimplementedMsgServer) ChangeModuleState(ctx context.Context, req *MsgChangeModuleState) (*MsgChangeModuleStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeModuleState not implemented")
+}
+func (*UnimplementedMsgServer) ReportSlashing(ctx context.Context, req *MsgReportSlashing) (*MsgReportSlashingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportSlashing not implemented")
+}
+func (*UnimplementedMsgServer) TransferUnbondingEntry(ctx context.Context, req *MsgTransferUnbondingEntry) (*MsgTransferUnbondingEntryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferUnbondingEntry not implemented")
+}
+func (*UnimplementedMsgServer) ClaimFor(ctx context.Context, req *MsgClaimFor) (*MsgClaimForResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimFor not implemented")
+}
+func (*UnimplementedMsgServer) SetAutoClaim(ctx context.Context, req *MsgSetAutoClaim) (*MsgSetAutoClaimResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAutoClaim not implemented")
 }
 
-func (m *MsgRedeemResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
 }
 
-func (m *MsgClaim) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_LiquidStake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgLiquidStake)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).LiquidStake(ctx, in)
 	}
-	return n
-}
-
-func (m *MsgClaimResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.lscosmos.v1beta1.Msg/LiquidStake",
 	}
-	var l int
-	_ = l
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).LiquidStake(ctx, req.(*MsgLiquidStake))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgRecreateICA) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_LiquidUnstake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgLiquidUnstake)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.FromAddress)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).LiquidUnstake(ctx, in)
 	}
-	return n
-}
-
-func (m *MsgRecreateICAResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.lscosmos.v1beta1.Msg/LiquidUnstake",
 	}
-	var l int
-	_ = l
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).LiquidUnstake(ctx, req.(*MsgLiquidUnstake))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgJumpStart) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_Redeem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRedeem)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.PstakeAddress)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).Redeem(ctx, in)
 	}
-	l = len(m.ChainID)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.lscosmos.v1beta1.Msg/Redeem",
 	}
-	l = len(m.ConnectionID)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Redeem(ctx, req.(*MsgRedeem))
 	}
-	l = len(m.TransferChannel)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Claim_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaim)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.TransferPort)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).Claim(ctx, in)
 	}
-	l = len(m.BaseDenom)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.lscosmos.v1beta1.Msg/Claim",
 	}
-	l = len(m.MintDenom)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Claim(ctx, req.(*MsgClaim))
 	}
-	l = m.MinDeposit.Size()
-	n += 1 + l + sovMsgs(uint64(l))
-	l = m.AllowListedValidators.Size()
-	n += 1 + l + sovMsgs(uint64(l))
-	l = m.PstakeParams.Size()
-	n += 1 + l + sovMsgs(uint64(l))
-	l = m.HostAccounts.Size()
-	n += 1 + l + sovMsgs(uint64(l))
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgJumpStartResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_RecreateICA_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRecreateICA)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).RecreateICA(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.lscosmos.v1beta1.Msg/RecreateICA",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RecreateICA(ctx, req.(*MsgRecreateICA))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgChangeModuleState) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_JumpStart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgJumpStart)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.PstakeAddress)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).JumpStart(ctx, in)
 	}
-	if m.ModuleState {
-		n += 2
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.lscosmos.v1beta1.Msg/JumpStart",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).JumpStart(ctx, req.(*MsgJumpStart))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateRoles)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateRoles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.lscosmos.v1beta1.Msg/UpdateRoles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateRoles(ctx, req.(*MsgUpdateRoles))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetPauseSwitches_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetPauseSwitches)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetPauseSwitches(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.lscosmos.v1beta1.Msg/SetPauseSwitches",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetPauseSwitches(ctx, req.(*MsgSetPauseSwitches))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RegisterHostProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterHostProposal)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterHostProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.lscosmos.v1beta1.Msg/RegisterHostProposal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterHostProposal(ctx, req.(*MsgRegisterHostProposal))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_VoteHostProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgVoteHostProposal)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).VoteHostProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.lscosmos.v1beta1.Msg/VoteHostProposal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).VoteHostProposal(ctx, req.(*MsgVoteHostProposal))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ChangeModuleState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgChangeModuleState)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ChangeModuleState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.lscosmos.v1beta1.Msg/ChangeModuleState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ChangeModuleState(ctx, req.(*MsgChangeModuleState))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ReportSlashing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgReportSlashing)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ReportSlashing(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.lscosmos.v1beta1.Msg/ReportSlashing",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ReportSlashing(ctx, req.(*MsgReportSlashing))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_TransferUnbondingEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTransferUnbondingEntry)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TransferUnbondingEntry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.lscosmos.v1beta1.Msg/TransferUnbondingEntry",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TransferUnbondingEntry(ctx, req.(*MsgTransferUnbondingEntry))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ClaimFor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimFor)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimFor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.lscosmos.v1beta1.Msg/ClaimFor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimFor(ctx, req.(*MsgClaimFor))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetAutoClaim_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetAutoClaim)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetAutoClaim(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.lscosmos.v1beta1.Msg/SetAutoClaim",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetAutoClaim(ctx, req.(*MsgSetAutoClaim))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "pstake.lscosmos.v1beta1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "LiquidStake",
+			Handler:    _Msg_LiquidStake_Handler,
+		},
+		{
+			MethodName: "LiquidUnstake",
+			Handler:    _Msg_LiquidUnstake_Handler,
+		},
+		{
+			MethodName: "Redeem",
+			Handler:    _Msg_Redeem_Handler,
+		},
+		{
+			MethodName: "Claim",
+			Handler:    _Msg_Claim_Handler,
+		},
+		{
+			MethodName: "RecreateICA",
+			Handler:    _Msg_RecreateICA_Handler,
+		},
+		{
+			MethodName: "JumpStart",
+			Handler:    _Msg_JumpStart_Handler,
+		},
+		{
+			MethodName: "UpdateRoles",
+			Handler:    _Msg_UpdateRoles_Handler,
+		},
+		{
+			MethodName: "SetPauseSwitches",
+			Handler:    _Msg_SetPauseSwitches_Handler,
+		},
+		{
+			MethodName: "RegisterHostProposal",
+			Handler:    _Msg_RegisterHostProposal_Handler,
+		},
+		{
+			MethodName: "VoteHostProposal",
+			Handler:    _Msg_VoteHostProposal_Handler,
+		},
+		{
+			MethodName: "ChangeModuleState",
+			Handler:    _Msg_ChangeModuleState_Handler,
+		},
+		{
+			MethodName: "ReportSlashing",
+			Handler:    _Msg_ReportSlashing_Handler,
+		},
+		{
+			MethodName: "TransferUnbondingEntry",
+			Handler:    _Msg_TransferUnbondingEntry_Handler,
+		},
+		{
+			MethodName: "ClaimFor",
+			Handler:    _Msg_ClaimFor_Handler,
+		},
+		{
+			MethodName: "SetAutoClaim",
+			Handler:    _Msg_SetAutoClaim_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "pstake/lscosmos/v1beta1/msgs.proto",
+}
+
+func (m *MsgLiquidStake) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgLiquidStake) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgLiquidStake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMsgs(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgLiquidStakeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgLiquidStakeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgLiquidStakeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgLiquidUnstake) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgLiquidUnstake) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgLiquidUnstake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMsgs(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgLiquidUnstakeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgLiquidUnstakeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgLiquidUnstakeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRedeem) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRedeem) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRedeem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMsgs(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRedeemResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRedeemResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRedeemResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaim) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaim) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRecreateICA) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRecreateICA) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRecreateICA) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FromAddress) > 0 {
+		i -= len(m.FromAddress)
+		copy(dAtA[i:], m.FromAddress)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.FromAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRecreateICAResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRecreateICAResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRecreateICAResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgJumpStart) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgJumpStart) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgJumpStart) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.HostAccounts.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMsgs(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	{
+		size, err := m.PstakeParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMsgs(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size, err := m.AllowListedValidators.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMsgs(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.MinDeposit.Size()
+		i -= size
+		if _, err := m.MinDeposit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMsgs(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if len(m.MintDenom) > 0 {
+		i -= len(m.MintDenom)
+		copy(dAtA[i:], m.MintDenom)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.MintDenom)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.BaseDenom) > 0 {
+		i -= len(m.BaseDenom)
+		copy(dAtA[i:], m.BaseDenom)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.BaseDenom)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.TransferPort) > 0 {
+		i -= len(m.TransferPort)
+		copy(dAtA[i:], m.TransferPort)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.TransferPort)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.TransferChannel) > 0 {
+		i -= len(m.TransferChannel)
+		copy(dAtA[i:], m.TransferChannel)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.TransferChannel)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ConnectionID) > 0 {
+		i -= len(m.ConnectionID)
+		copy(dAtA[i:], m.ConnectionID)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.ConnectionID)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ChainID) > 0 {
+		i -= len(m.ChainID)
+		copy(dAtA[i:], m.ChainID)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.ChainID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PstakeAddress) > 0 {
+		i -= len(m.PstakeAddress)
+		copy(dAtA[i:], m.PstakeAddress)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.PstakeAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgJumpStartResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgJumpStartResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgJumpStartResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgChangeModuleState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgChangeModuleState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgChangeModuleState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ModuleState {
+		i--
+		if m.ModuleState {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.PstakeAddress) > 0 {
+		i -= len(m.PstakeAddress)
+		copy(dAtA[i:], m.PstakeAddress)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.PstakeAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgChangeModuleStateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgChangeModuleStateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgChangeModuleStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReportSlashing) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReportSlashing) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReportSlashing) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PstakeAddress) > 0 {
+		i -= len(m.PstakeAddress)
+		copy(dAtA[i:], m.PstakeAddress)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.PstakeAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReportSlashingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReportSlashingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReportSlashingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferUnbondingEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferUnbondingEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferUnbondingEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMsgs(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.EpochNumber != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.RecipientAddress) > 0 {
+		i -= len(m.RecipientAddress)
+		copy(dAtA[i:], m.RecipientAddress)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.RecipientAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferUnbondingEntryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferUnbondingEntryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferUnbondingEntryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimFor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimFor) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimFor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClaimerAddress) > 0 {
+		i -= len(m.ClaimerAddress)
+		copy(dAtA[i:], m.ClaimerAddress)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.ClaimerAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimForResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimForResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimForResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetAutoClaim) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAutoClaim) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAutoClaim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetAutoClaimResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAutoClaimResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAutoClaimResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateRoles) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateRoles) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateRoles) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Roles.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMsgs(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.AdminAddress) > 0 {
+		i -= len(m.AdminAddress)
+		copy(dAtA[i:], m.AdminAddress)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.AdminAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateRolesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateRolesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateRolesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetPauseSwitches) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetPauseSwitches) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetPauseSwitches) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.PauseSwitches.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMsgs(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.PstakeAddress) > 0 {
+		i -= len(m.PstakeAddress)
+		copy(dAtA[i:], m.PstakeAddress)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.PstakeAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetPauseSwitchesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetPauseSwitchesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetPauseSwitchesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterHostProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterHostProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterHostProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n10, err10 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.VotingEndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.VotingEndTime):])
+	if err10 != nil {
+		return 0, err10
+	}
+	i -= n10
+	i = encodeVarintMsgs(dAtA, i, uint64(n10))
+	i--
+	dAtA[i] = 0x1a
+	if m.ProposalId != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.AdminAddress) > 0 {
+		i -= len(m.AdminAddress)
+		copy(dAtA[i:], m.AdminAddress)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.AdminAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterHostProposalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterHostProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterHostProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgVoteHostProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgVoteHostProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgVoteHostProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Options) > 0 {
+		for iNdEx := len(m.Options) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Options[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMsgs(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Voter) > 0 {
+		i -= len(m.Voter)
+		copy(dAtA[i:], m.Voter)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Voter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgVoteHostProposalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgVoteHostProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgVoteHostProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMsgs(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMsgs(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgLiquidStake) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovMsgs(uint64(l))
+	return n
+}
+
+func (m *MsgLiquidStakeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgLiquidUnstake) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovMsgs(uint64(l))
+	return n
+}
+
+func (m *MsgLiquidUnstakeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRedeem) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovMsgs(uint64(l))
+	return n
+}
+
+func (m *MsgRedeemResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgClaim) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgClaimResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRecreateICA) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRecreateICAResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgJumpStart) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PstakeAddress)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.ConnectionID)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.TransferChannel)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.TransferPort)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.BaseDenom)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.MintDenom)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = m.MinDeposit.Size()
+	n += 1 + l + sovMsgs(uint64(l))
+	l = m.AllowListedValidators.Size()
+	n += 1 + l + sovMsgs(uint64(l))
+	l = m.PstakeParams.Size()
+	n += 1 + l + sovMsgs(uint64(l))
+	l = m.HostAccounts.Size()
+	n += 1 + l + sovMsgs(uint64(l))
+	return n
+}
+
+func (m *MsgJumpStartResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgChangeModuleState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PstakeAddress)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	if m.ModuleState {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgChangeModuleStateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgReportSlashing) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PstakeAddress)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgReportSlashingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgTransferUnbondingEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.RecipientAddress)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	if m.EpochNumber != 0 {
+		n += 1 + sovMsgs(uint64(m.EpochNumber))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovMsgs(uint64(l))
+	return n
+}
+
+func (m *MsgTransferUnbondingEntryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgClaimFor) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClaimerAddress)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgClaimForResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetAutoClaim) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSetAutoClaimResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateRoles) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AdminAddress)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = m.Roles.Size()
+	n += 1 + l + sovMsgs(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateRolesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetPauseSwitches) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PstakeAddress)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = m.PauseSwitches.Size()
+	n += 1 + l + sovMsgs(uint64(l))
+	return n
+}
+
+func (m *MsgSetPauseSwitchesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRegisterHostProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AdminAddress)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	if m.ProposalId != 0 {
+		n += 1 + sovMsgs(uint64(m.ProposalId))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.VotingEndTime)
+	n += 1 + l + sovMsgs(uint64(l))
+	return n
+}
+
+func (m *MsgRegisterHostProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgVoteHostProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	if m.ProposalId != 0 {
+		n += 1 + sovMsgs(uint64(m.ProposalId))
+	}
+	if len(m.Options) > 0 {
+		for _, e := range m.Options {
+			l = e.Size()
+			n += 1 + l + sovMsgs(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgVoteHostProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovMsgs(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMsgs(x uint64) (n int) {
+	return sovMsgs(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgLiquidStake) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgLiquidStake: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgLiquidStake: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgLiquidStakeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgLiquidStakeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgLiquidStakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgLiquidUnstake) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgLiquidUnstake: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgLiquidUnstake: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgLiquidUnstakeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgLiquidUnstakeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgLiquidUnstakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRedeem) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRedeem: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRedeem: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRedeemResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRedeemResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRedeemResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaim) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaim: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaim: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRecreateICA) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRecreateICA: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRecreateICA: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRecreateICAResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRecreateICAResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRecreateICAResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgJumpStart) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgJumpStart: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgJumpStart: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PstakeAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PstakeAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferChannel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TransferChannel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferPort", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TransferPort = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MintDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinDeposit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinDeposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowListedValidators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AllowListedValidators.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PstakeParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PstakeParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostAccounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.HostAccounts.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MsgChangeModuleStateResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
-
-func (m *MsgReportSlashing) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.PstakeAddress)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
-	}
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
+func (m *MsgJumpStartResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgJumpStartResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgJumpStartResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MsgReportSlashingResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovMsgs(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozMsgs(x uint64) (n int) {
-	return sovMsgs(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgLiquidStake) Unmarshal(dAtA []byte) error {
+func (m *MsgChangeModuleState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2028,15 +4867,15 @@ func (m *MsgLiquidStake) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgLiquidStake: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgChangeModuleState: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgLiquidStake: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgChangeModuleState: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PstakeAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2064,13 +4903,13 @@ func (m *MsgLiquidStake) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			m.PstakeAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleState", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -2080,25 +4919,12 @@ func (m *MsgLiquidStake) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthMsgs
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthMsgs
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.ModuleState = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMsgs(dAtA[iNdEx:])
@@ -2120,7 +4946,7 @@ func (m *MsgLiquidStake) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgLiquidStakeResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgChangeModuleStateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2143,10 +4969,10 @@ func (m *MsgLiquidStakeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgLiquidStakeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgChangeModuleStateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgLiquidStakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgChangeModuleStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2170,7 +4996,7 @@ func (m *MsgLiquidStakeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgLiquidUnstake) Unmarshal(dAtA []byte) error {
+func (m *MsgReportSlashing) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2193,15 +5019,15 @@ func (m *MsgLiquidUnstake) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgLiquidUnstake: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgReportSlashing: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgLiquidUnstake: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgReportSlashing: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PstakeAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2229,13 +5055,13 @@ func (m *MsgLiquidUnstake) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			m.PstakeAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -2245,24 +5071,23 @@ func (m *MsgLiquidUnstake) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMsgs
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMsgs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2285,7 +5110,7 @@ func (m *MsgLiquidUnstake) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgLiquidUnstakeResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgReportSlashingResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2308,10 +5133,10 @@ func (m *MsgLiquidUnstakeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgLiquidUnstakeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgReportSlashingResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgLiquidUnstakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgReportSlashingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2335,7 +5160,7 @@ func (m *MsgLiquidUnstakeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRedeem) Unmarshal(dAtA []byte) error {
+func (m *MsgTransferUnbondingEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2358,15 +5183,47 @@ func (m *MsgRedeem) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRedeem: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTransferUnbondingEntry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRedeem: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTransferUnbondingEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecipientAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2394,9 +5251,28 @@ func (m *MsgRedeem) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			m.RecipientAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
@@ -2450,7 +5326,7 @@ func (m *MsgRedeem) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRedeemResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgTransferUnbondingEntryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2473,10 +5349,10 @@ func (m *MsgRedeemResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRedeemResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTransferUnbondingEntryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRedeemResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTransferUnbondingEntryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2500,7 +5376,7 @@ func (m *MsgRedeemResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgClaim) Unmarshal(dAtA []byte) error {
+func (m *MsgClaimFor) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2523,13 +5399,45 @@ func (m *MsgClaim) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgClaim: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgClaimFor: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgClaim: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgClaimFor: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClaimerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
 			}
@@ -2582,7 +5490,7 @@ func (m *MsgClaim) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgClaimResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgClaimForResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2605,10 +5513,10 @@ func (m *MsgClaimResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgClaimResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgClaimForResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgClaimResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgClaimForResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2632,7 +5540,7 @@ func (m *MsgClaimResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRecreateICA) Unmarshal(dAtA []byte) error {
+func (m *MsgSetAutoClaim) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2655,15 +5563,15 @@ func (m *MsgRecreateICA) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRecreateICA: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetAutoClaim: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRecreateICA: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetAutoClaim: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2691,8 +5599,28 @@ func (m *MsgRecreateICA) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FromAddress = string(dAtA[iNdEx:postIndex])
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMsgs(dAtA[iNdEx:])
@@ -2714,7 +5642,7 @@ func (m *MsgRecreateICA) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRecreateICAResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetAutoClaimResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2737,10 +5665,10 @@ func (m *MsgRecreateICAResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRecreateICAResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetAutoClaimResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRecreateICAResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetAutoClaimResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2764,7 +5692,7 @@ func (m *MsgRecreateICAResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgJumpStart) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateRoles) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2787,175 +5715,15 @@ func (m *MsgJumpStart) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgJumpStart: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateRoles: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgJumpStart: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateRoles: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PstakeAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMsgs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMsgs
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMsgs
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PstakeAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMsgs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMsgs
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMsgs
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ChainID = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionID", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMsgs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMsgs
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMsgs
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ConnectionID = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TransferChannel", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMsgs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMsgs
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMsgs
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TransferChannel = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TransferPort", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMsgs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMsgs
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMsgs
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TransferPort = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AdminAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2983,13 +5751,13 @@ func (m *MsgJumpStart) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BaseDenom = string(dAtA[iNdEx:postIndex])
+			m.AdminAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MintDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Roles", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -2999,96 +5767,130 @@ func (m *MsgJumpStart) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMsgs
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthMsgs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MintDenom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinDeposit", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMsgs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.Roles.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMsgs
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthMsgs
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinDeposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateRolesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
 			}
-			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllowListedValidators", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMsgs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthMsgs
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateRolesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateRolesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthMsgs
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AllowListedValidators.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetPauseSwitches) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
 			}
-			iNdEx = postIndex
-		case 10:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetPauseSwitches: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetPauseSwitches: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PstakeParams", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PstakeAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -3098,28 +5900,27 @@ func (m *MsgJumpStart) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMsgs
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMsgs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PstakeParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PstakeAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HostAccounts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PauseSwitches", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3146,7 +5947,7 @@ func (m *MsgJumpStart) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.HostAccounts.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PauseSwitches.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3171,7 +5972,7 @@ func (m *MsgJumpStart) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgJumpStartResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetPauseSwitchesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3194,10 +5995,10 @@ func (m *MsgJumpStartResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgJumpStartResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetPauseSwitchesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgJumpStartResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetPauseSwitchesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3221,7 +6022,7 @@ func (m *MsgJumpStartResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgChangeModuleState) Unmarshal(dAtA []byte) error {
+func (m *MsgRegisterHostProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3244,15 +6045,15 @@ func (m *MsgChangeModuleState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgChangeModuleState: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRegisterHostProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgChangeModuleState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRegisterHostProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PstakeAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AdminAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3280,13 +6081,13 @@ func (m *MsgChangeModuleState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PstakeAddress = string(dAtA[iNdEx:postIndex])
+			m.AdminAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ModuleState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
 			}
-			var v int
+			m.ProposalId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -3296,12 +6097,44 @@ func (m *MsgChangeModuleState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.ProposalId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.ModuleState = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingEndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.VotingEndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMsgs(dAtA[iNdEx:])
@@ -3323,7 +6156,7 @@ func (m *MsgChangeModuleState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgChangeModuleStateResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRegisterHostProposalResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3346,10 +6179,10 @@ func (m *MsgChangeModuleStateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgChangeModuleStateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRegisterHostProposalResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgChangeModuleStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRegisterHostProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3373,7 +6206,7 @@ func (m *MsgChangeModuleStateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgReportSlashing) Unmarshal(dAtA []byte) error {
+func (m *MsgVoteHostProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3396,15 +6229,15 @@ func (m *MsgReportSlashing) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgReportSlashing: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgVoteHostProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgReportSlashing: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgVoteHostProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PstakeAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3432,13 +6265,32 @@ func (m *MsgReportSlashing) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PstakeAddress = string(dAtA[iNdEx:postIndex])
+			m.Voter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -3448,23 +6300,25 @@ func (m *MsgReportSlashing) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMsgs
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthMsgs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			m.Options = append(m.Options, v1beta1.WeightedVoteOption{})
+			if err := m.Options[len(m.Options)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3487,7 +6341,7 @@ func (m *MsgReportSlashing) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgReportSlashingResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgVoteHostProposalResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3510,10 +6364,10 @@ func (m *MsgReportSlashingResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgReportSlashingResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgVoteHostProposalResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgReportSlashingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgVoteHostProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
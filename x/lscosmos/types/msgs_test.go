@@ -3,8 +3,10 @@ package types_test
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 	"github.com/stretchr/testify/require"
 
 	"github.com/persistenceOne/pstake-native/v2/x/lscosmos/types"
@@ -71,3 +73,142 @@ func TestMsgLiquidStakeGetSigners(t *testing.T) {
 	res := msg.GetSigners()
 	require.Equal(t, fmt.Sprintf("%v", res), "[696E707574313131313131313131313131313131]")
 }
+
+func TestMsgTransferUnbondingEntryValidation(t *testing.T) {
+	addr1 := sdk.AccAddress("addr1_______________")
+	addr2 := sdk.AccAddress("addr2_______________")
+	addrEmpty := sdk.AccAddress("")
+
+	stk123 := sdk.NewInt64Coin("stk/uatom", 123)
+	stk0 := sdk.NewInt64Coin("stk/uatom", 0)
+
+	cases := []struct {
+		expectedErr string // empty means no error expected
+		msg         *types.MsgTransferUnbondingEntry
+	}{
+		{"", types.NewMsgTransferUnbondingEntry(addr1, addr2, 4, stk123)},
+		{": invalid address", types.NewMsgTransferUnbondingEntry(addrEmpty, addr2, 4, stk123)},
+		{": invalid address", types.NewMsgTransferUnbondingEntry(addr1, addrEmpty, 4, stk123)},
+		{"recipient address cannot be the same as delegator address: invalid address", types.NewMsgTransferUnbondingEntry(addr1, addr1, 4, stk123)},
+		{"epoch number must be positive, got 0: invalid arguments", types.NewMsgTransferUnbondingEntry(addr1, addr2, 0, stk123)},
+		{"0stk/uatom: invalid coins", types.NewMsgTransferUnbondingEntry(addr1, addr2, 4, stk0)},
+	}
+
+	for _, tc := range cases {
+		err := tc.msg.ValidateBasic()
+		if tc.expectedErr == "" {
+			require.Nil(t, err)
+		} else {
+			require.EqualError(t, err, tc.expectedErr)
+		}
+	}
+}
+
+func TestMsgClaimForValidation(t *testing.T) {
+	addr1 := sdk.AccAddress("addr1_______________")
+	addr2 := sdk.AccAddress("addr2_______________")
+	addrEmpty := sdk.AccAddress("")
+
+	cases := []struct {
+		expectedErr string // empty means no error expected
+		msg         *types.MsgClaimFor
+	}{
+		{"", types.NewMsgClaimFor(addr1, addr2)},
+		{"", types.NewMsgClaimFor(addr1, addr1)},
+		{": invalid address", types.NewMsgClaimFor(addrEmpty, addr2)},
+		{": invalid address", types.NewMsgClaimFor(addr1, addrEmpty)},
+	}
+
+	for _, tc := range cases {
+		err := tc.msg.ValidateBasic()
+		if tc.expectedErr == "" {
+			require.Nil(t, err)
+		} else {
+			require.EqualError(t, err, tc.expectedErr)
+		}
+	}
+
+	require.Equal(t, []sdk.AccAddress{addr1}, types.NewMsgClaimFor(addr1, addr2).GetSigners())
+}
+
+func TestMsgUpdateRolesValidation(t *testing.T) {
+	addr1 := sdk.AccAddress("addr1_______________")
+	addr2 := sdk.AccAddress("addr2_______________")
+
+	cases := []struct {
+		expectedErr string // empty means no error expected
+		msg         *types.MsgUpdateRoles
+	}{
+		{"", types.NewMsgUpdateRoles(addr1, types.Roles{Admins: []string{addr1.String()}})},
+		{"", types.NewMsgUpdateRoles(addr1, types.Roles{Admins: []string{addr1.String(), addr2.String()}, Pausers: []string{addr2.String()}, SlashingReporters: []string{addr2.String()}})},
+		{"at least one admin is required: invalid roles", types.NewMsgUpdateRoles(addr1, types.Roles{Pausers: []string{addr2.String()}})},
+		{"duplicate admin " + addr1.String() + ": invalid roles", types.NewMsgUpdateRoles(addr1, types.Roles{Admins: []string{addr1.String(), addr1.String()}})},
+		{"pauser addr: invalid address", types.NewMsgUpdateRoles(addr1, types.Roles{Admins: []string{addr1.String()}, Pausers: []string{"addr"}})},
+		{": invalid address", types.NewMsgUpdateRoles(sdk.AccAddress(""), types.Roles{Admins: []string{addr1.String()}})},
+	}
+
+	for _, tc := range cases {
+		err := tc.msg.ValidateBasic()
+		if tc.expectedErr == "" {
+			require.Nil(t, err)
+		} else {
+			require.EqualError(t, err, tc.expectedErr)
+		}
+	}
+
+	require.Equal(t, []sdk.AccAddress{addr1}, types.NewMsgUpdateRoles(addr1, types.Roles{}).GetSigners())
+}
+
+func TestMsgRegisterHostProposalValidation(t *testing.T) {
+	addr1 := sdk.AccAddress("addr1_______________")
+	votingEndTime := time.Unix(1000, 0).UTC()
+
+	cases := []struct {
+		expectedErr string // empty means no error expected
+		msg         *types.MsgRegisterHostProposal
+	}{
+		{"", types.NewMsgRegisterHostProposal(addr1, 1, votingEndTime)},
+		{"host proposal id cannot be 0: invalid request", types.NewMsgRegisterHostProposal(addr1, 0, votingEndTime)},
+		{"voting end time cannot be empty: invalid request", types.NewMsgRegisterHostProposal(addr1, 1, time.Time{})},
+		{": invalid address", types.NewMsgRegisterHostProposal(sdk.AccAddress(""), 1, votingEndTime)},
+	}
+
+	for _, tc := range cases {
+		err := tc.msg.ValidateBasic()
+		if tc.expectedErr == "" {
+			require.Nil(t, err)
+		} else {
+			require.EqualError(t, err, tc.expectedErr)
+		}
+	}
+
+	require.Equal(t, []sdk.AccAddress{addr1}, types.NewMsgRegisterHostProposal(addr1, 1, votingEndTime).GetSigners())
+}
+
+func TestMsgVoteHostProposalValidation(t *testing.T) {
+	addr1 := sdk.AccAddress("addr1_______________")
+	yes := govtypes.WeightedVoteOption{Option: govtypes.OptionYes, Weight: sdk.NewDecWithPrec(6, 1)}
+	no := govtypes.WeightedVoteOption{Option: govtypes.OptionNo, Weight: sdk.NewDecWithPrec(4, 1)}
+
+	cases := []struct {
+		expectedErr string // empty means no error expected
+		msg         *types.MsgVoteHostProposal
+	}{
+		{"", types.NewMsgVoteHostProposal(addr1, 1, govtypes.NewNonSplitVoteOption(govtypes.OptionYes))},
+		{"", types.NewMsgVoteHostProposal(addr1, 1, govtypes.WeightedVoteOptions{yes, no})},
+		{"host proposal id cannot be 0: invalid request", types.NewMsgVoteHostProposal(addr1, 0, govtypes.NewNonSplitVoteOption(govtypes.OptionYes))},
+		{"no vote options: invalid request", types.NewMsgVoteHostProposal(addr1, 1, nil)},
+		{"total weight of vote options 0.600000000000000000 is not 1: invalid request", types.NewMsgVoteHostProposal(addr1, 1, govtypes.WeightedVoteOptions{yes})},
+		{"duplicated vote option VOTE_OPTION_YES: invalid request", types.NewMsgVoteHostProposal(addr1, 1, govtypes.WeightedVoteOptions{yes, yes})},
+		{": invalid address", types.NewMsgVoteHostProposal(sdk.AccAddress(""), 1, govtypes.NewNonSplitVoteOption(govtypes.OptionYes))},
+	}
+
+	for _, tc := range cases {
+		err := tc.msg.ValidateBasic()
+		if tc.expectedErr == "" {
+			require.Nil(t, err)
+		} else {
+			require.EqualError(t, err, tc.expectedErr)
+		}
+	}
+}
@@ -1,10 +1,37 @@
 package types
 
 import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"gopkg.in/yaml.v2"
 )
 
+// Parameter store keys
+var (
+	KeyTVLCap               = []byte("TVLCap")
+	KeyPerAddressDepositCap = []byte("PerAddressDepositCap")
+	KeyPerEpochDepositLimit = []byte("PerEpochDepositLimit")
+	KeyAdminTimelock        = []byte("AdminTimelock")
+	KeyValidatorWeighting   = []byte("ValidatorWeighting")
+	KeyRestakeCapPerDay     = []byte("RestakeCapPerDay")
+	KeyExcessRewardPolicy   = []byte("ExcessRewardPolicy")
+	KeyInsuranceFundAddress = []byte("InsuranceFundAddress")
+	KeyHostVoteBuffer       = []byte("HostVoteBuffer")
+)
+
+// DefaultAdminTimelock is the default delay after which admin role changes take effect
+const DefaultAdminTimelock = 48 * time.Hour
+
+// DefaultHostVoteBuffer is the default time before the end of a host chain voting period the vote is sent
+const DefaultHostVoteBuffer = 24 * time.Hour
+
+// DefaultRestakeCapPerDay is the default share of the total value unbonded restaked in a reward epoch
+var DefaultRestakeCapPerDay = sdk.MustNewDecFromStr("0.00069") //0.25185 or ~25% APY
+
 var _ paramtypes.ParamSet = (*Params)(nil)
 
 // ParamKeyTable the param key table for launch module
@@ -13,22 +40,71 @@ func ParamKeyTable() paramtypes.KeyTable {
 }
 
 // NewParams creates a new Params instance
-func NewParams() Params {
-	return Params{}
+func NewParams(
+	tvlCap, perAddressDepositCap, perEpochDepositLimit sdk.Int, adminTimelock time.Duration,
+	validatorWeighting ValidatorWeighting, restakeCapPerDay sdk.Dec, excessRewardPolicy ExcessRewardPolicy,
+	insuranceFundAddress string, hostVoteBuffer time.Duration,
+) Params {
+	return Params{
+		TvlCap:               tvlCap,
+		PerAddressDepositCap: perAddressDepositCap,
+		PerEpochDepositLimit: perEpochDepositLimit,
+		AdminTimelock:        adminTimelock,
+		ValidatorWeighting:   validatorWeighting,
+		RestakeCapPerDay:     restakeCapPerDay,
+		ExcessRewardPolicy:   excessRewardPolicy,
+		InsuranceFundAddress: insuranceFundAddress,
+		HostVoteBuffer:       hostVoteBuffer,
+	}
 }
 
-// DefaultParams returns a default set of parameters
+// DefaultParams returns a default set of parameters, deposits are not capped, validators are not weighted
+// automatically and rewards above the restake cap are carried forward by default
 func DefaultParams() Params {
-	return NewParams()
+	return NewParams(
+		sdk.ZeroInt(), sdk.ZeroInt(), sdk.ZeroInt(), DefaultAdminTimelock, DefaultValidatorWeighting(),
+		DefaultRestakeCapPerDay, EXCESS_REWARD_POLICY_CARRY_FORWARD, "", DefaultHostVoteBuffer,
+	)
 }
 
 // ParamSetPairs get the params.ParamSet
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
-	return paramtypes.ParamSetPairs{}
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyTVLCap, &p.TvlCap, validateDepositCap),
+		paramtypes.NewParamSetPair(KeyPerAddressDepositCap, &p.PerAddressDepositCap, validateDepositCap),
+		paramtypes.NewParamSetPair(KeyPerEpochDepositLimit, &p.PerEpochDepositLimit, validateDepositCap),
+		paramtypes.NewParamSetPair(KeyAdminTimelock, &p.AdminTimelock, validateAdminTimelock),
+		paramtypes.NewParamSetPair(KeyValidatorWeighting, &p.ValidatorWeighting, validateValidatorWeighting),
+		paramtypes.NewParamSetPair(KeyRestakeCapPerDay, &p.RestakeCapPerDay, validateRestakeCapPerDay),
+		paramtypes.NewParamSetPair(KeyExcessRewardPolicy, &p.ExcessRewardPolicy, validateExcessRewardPolicy),
+		paramtypes.NewParamSetPair(KeyInsuranceFundAddress, &p.InsuranceFundAddress, validateInsuranceFundAddress),
+		paramtypes.NewParamSetPair(KeyHostVoteBuffer, &p.HostVoteBuffer, validateHostVoteBuffer),
+	}
 }
 
 // Validate validates the set of params
 func (p Params) Validate() error {
+	for _, v := range []struct {
+		value     interface{}
+		validator func(interface{}) error
+	}{
+		{p.TvlCap, validateDepositCap},
+		{p.PerAddressDepositCap, validateDepositCap},
+		{p.PerEpochDepositLimit, validateDepositCap},
+		{p.AdminTimelock, validateAdminTimelock},
+		{p.ValidatorWeighting, validateValidatorWeighting},
+		{p.RestakeCapPerDay, validateRestakeCapPerDay},
+		{p.ExcessRewardPolicy, validateExcessRewardPolicy},
+		{p.InsuranceFundAddress, validateInsuranceFundAddress},
+		{p.HostVoteBuffer, validateHostVoteBuffer},
+	} {
+		if err := v.validator(v.value); err != nil {
+			return err
+		}
+	}
+	if p.ExcessRewardPolicy == EXCESS_REWARD_POLICY_INSURANCE_FUND && p.InsuranceFundAddress == "" {
+		return fmt.Errorf("insurance fund address must be set with the %s excess reward policy", p.ExcessRewardPolicy)
+	}
 	return nil
 }
 
@@ -37,3 +113,115 @@ func (p Params) String() string {
 	out, _ := yaml.Marshal(p)
 	return string(out)
 }
+
+// validateDepositCap validates a deposit cap, zero means the cap is disabled.
+func validateDepositCap(i interface{}) error {
+	v, ok := i.(sdk.Int)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("deposit cap must not be nil")
+	}
+
+	if v.IsNegative() {
+		return fmt.Errorf("deposit cap must not be negative: %s", v)
+	}
+
+	return nil
+}
+
+// validateAdminTimelock validates the admin timelock, zero applies admin changes in the next block.
+func validateAdminTimelock(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return fmt.Errorf("admin timelock must not be negative: %s", v)
+	}
+
+	return nil
+}
+
+// validateValidatorWeighting validates the validator weighting config.
+func validateValidatorWeighting(i interface{}) error {
+	v, ok := i.(ValidatorWeighting)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return v.Validate()
+}
+
+// validateRestakeCapPerDay validates the restake cap, zero leaves all the rewards to the excess reward policy.
+func validateRestakeCapPerDay(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("restake cap per day must not be nil")
+	}
+
+	if v.IsNegative() || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("restake cap per day must be between 0 and 1: %s", v)
+	}
+
+	return nil
+}
+
+// validateExcessRewardPolicy validates the excess reward policy.
+func validateExcessRewardPolicy(i interface{}) error {
+	v, ok := i.(ExcessRewardPolicy)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if _, ok = ExcessRewardPolicy_name[int32(v)]; !ok {
+		return fmt.Errorf("invalid excess reward policy: %d", v)
+	}
+
+	return nil
+}
+
+// validateInsuranceFundAddress validates the host chain insurance fund address, it may be empty unless the excess
+// reward policy sends rewards to it.
+func validateInsuranceFundAddress(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == "" {
+		return nil
+	}
+
+	// the address prefix is checked against the host chain rewards address when the excess rewards are sent
+	hrp, _, err := bech32.DecodeAndConvert(v)
+	if err != nil {
+		return fmt.Errorf("invalid insurance fund address %s: %w", v, err)
+	}
+	if _, err = ValAddressFromBech32(v, hrp); err != nil {
+		return fmt.Errorf("invalid insurance fund address %s: %w", v, err)
+	}
+
+	return nil
+}
+
+// validateHostVoteBuffer validates the host vote buffer, it must leave time for the vote to reach the host chain.
+func validateHostVoteBuffer(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v <= 0 {
+		return fmt.Errorf("host vote buffer must be positive: %s", v)
+	}
+
+	return nil
+}
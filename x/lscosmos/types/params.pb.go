@@ -5,17 +5,23 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -23,8 +29,69 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// ExcessRewardPolicy is the handling of the rewards above the restake cap
+type ExcessRewardPolicy int32
+
+const (
+	// EXCESS_REWARD_POLICY_CARRY_FORWARD leaves the excess in the rewards
+	// account, it is restaked in the next reward epochs
+	EXCESS_REWARD_POLICY_CARRY_FORWARD ExcessRewardPolicy = 0
+	// EXCESS_REWARD_POLICY_INSURANCE_FUND sends the excess to the insurance fund
+	// address
+	EXCESS_REWARD_POLICY_INSURANCE_FUND ExcessRewardPolicy = 1
+	// EXCESS_REWARD_POLICY_RESTAKE restakes the excess with the capped rewards
+	EXCESS_REWARD_POLICY_RESTAKE ExcessRewardPolicy = 2
+)
+
+var ExcessRewardPolicy_name = map[int32]string{
+	0: "EXCESS_REWARD_POLICY_CARRY_FORWARD",
+	1: "EXCESS_REWARD_POLICY_INSURANCE_FUND",
+	2: "EXCESS_REWARD_POLICY_RESTAKE",
+}
+
+var ExcessRewardPolicy_value = map[string]int32{
+	"EXCESS_REWARD_POLICY_CARRY_FORWARD":  0,
+	"EXCESS_REWARD_POLICY_INSURANCE_FUND": 1,
+	"EXCESS_REWARD_POLICY_RESTAKE":        2,
+}
+
+func (x ExcessRewardPolicy) String() string {
+	return proto.EnumName(ExcessRewardPolicy_name, int32(x))
+}
+
+func (ExcessRewardPolicy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_079f228748144235, []int{0}
+}
+
 // Params defines the parameters for the module.
 type Params struct {
+	// tvl_cap is the maximum amount of host chain tokens backing the stk supply,
+	// zero means no cap
+	TvlCap github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=tvl_cap,json=tvlCap,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"tvl_cap"`
+	// per_address_deposit_cap is the maximum amount an address can have
+	// deposited at a time, unstaking and redeeming free it up, zero means no cap
+	PerAddressDepositCap github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=per_address_deposit_cap,json=perAddressDepositCap,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"per_address_deposit_cap"`
+	// per_epoch_deposit_limit is the maximum amount that can be deposited in a
+	// delegation epoch, zero means no limit
+	PerEpochDepositLimit github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=per_epoch_deposit_limit,json=perEpochDepositLimit,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"per_epoch_deposit_limit"`
+	// admin_timelock is the delay after which admin role changes take effect
+	AdminTimelock time.Duration `protobuf:"bytes,4,opt,name=admin_timelock,json=adminTimelock,proto3,stdduration" json:"admin_timelock"`
+	// validator_weighting configures the effective weights computed from host
+	// chain validator metrics
+	ValidatorWeighting ValidatorWeighting `protobuf:"bytes,5,opt,name=validator_weighting,json=validatorWeighting,proto3" json:"validator_weighting"`
+	// restake_cap_per_day is the maximum share of the total value unbonded that
+	// is restaked from the rewards account in a reward epoch
+	RestakeCapPerDay github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=restake_cap_per_day,json=restakeCapPerDay,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"restake_cap_per_day"`
+	// excess_reward_policy decides what happens to the rewards above the restake
+	// cap
+	ExcessRewardPolicy ExcessRewardPolicy `protobuf:"varint,7,opt,name=excess_reward_policy,json=excessRewardPolicy,proto3,enum=pstake.lscosmos.v1beta1.ExcessRewardPolicy" json:"excess_reward_policy,omitempty"`
+	// insurance_fund_address is the host chain address receiving the rewards
+	// above the restake cap with the insurance fund policy
+	InsuranceFundAddress string `protobuf:"bytes,8,opt,name=insurance_fund_address,json=insuranceFundAddress,proto3" json:"insurance_fund_address,omitempty"`
+	// host_vote_buffer is the time before the end of the host chain voting
+	// period the signals on a host chain proposal are tallied and the vote is
+	// sent
+	HostVoteBuffer time.Duration `protobuf:"bytes,9,opt,name=host_vote_buffer,json=hostVoteBuffer,proto3,stdduration" json:"host_vote_buffer"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -59,8 +126,115 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+func (m *Params) GetAdminTimelock() time.Duration {
+	if m != nil {
+		return m.AdminTimelock
+	}
+	return 0
+}
+
+func (m *Params) GetValidatorWeighting() ValidatorWeighting {
+	if m != nil {
+		return m.ValidatorWeighting
+	}
+	return ValidatorWeighting{}
+}
+
+func (m *Params) GetExcessRewardPolicy() ExcessRewardPolicy {
+	if m != nil {
+		return m.ExcessRewardPolicy
+	}
+	return EXCESS_REWARD_POLICY_CARRY_FORWARD
+}
+
+func (m *Params) GetInsuranceFundAddress() string {
+	if m != nil {
+		return m.InsuranceFundAddress
+	}
+	return ""
+}
+
+func (m *Params) GetHostVoteBuffer() time.Duration {
+	if m != nil {
+		return m.HostVoteBuffer
+	}
+	return 0
+}
+
+// ValidatorWeighting configures the automatic weighting of the allow listed
+// validators. Every update_interval reward epochs the governance base weights
+// are scaled by host chain validator metrics into effective weights, which are
+// used for delegations and undelegations instead of the base weights.
+type ValidatorWeighting struct {
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// update_interval is the number of reward epochs between two updates
+	UpdateInterval int64 `protobuf:"varint,2,opt,name=update_interval,json=updateInterval,proto3" json:"update_interval,omitempty"`
+	// max_commission excludes validators with a higher commission rate
+	MaxCommission github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=max_commission,json=maxCommission,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_commission"`
+	// min_self_bond_ratio excludes validators with a lower self bond to tokens
+	// ratio
+	MinSelfBondRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=min_self_bond_ratio,json=minSelfBondRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_self_bond_ratio"`
+	// weight_floor is the minimum effective weight of a validator that is not
+	// excluded
+	WeightFloor github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=weight_floor,json=weightFloor,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"weight_floor"`
+	// weight_cap is the maximum effective weight of a validator
+	WeightCap github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=weight_cap,json=weightCap,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"weight_cap"`
+	// max_weight_change is the maximum change of the effective weight of a
+	// validator that is not excluded in one update
+	MaxWeightChange github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=max_weight_change,json=maxWeightChange,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_weight_change"`
+}
+
+func (m *ValidatorWeighting) Reset()         { *m = ValidatorWeighting{} }
+func (m *ValidatorWeighting) String() string { return proto.CompactTextString(m) }
+func (*ValidatorWeighting) ProtoMessage()    {}
+func (*ValidatorWeighting) Descriptor() ([]byte, []int) {
+	return fileDescriptor_079f228748144235, []int{1}
+}
+func (m *ValidatorWeighting) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorWeighting) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorWeighting.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorWeighting) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorWeighting.Merge(m, src)
+}
+func (m *ValidatorWeighting) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorWeighting) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorWeighting.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorWeighting proto.InternalMessageInfo
+
+func (m *ValidatorWeighting) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+func (m *ValidatorWeighting) GetUpdateInterval() int64 {
+	if m != nil {
+		return m.UpdateInterval
+	}
+	return 0
+}
+
 func init() {
+	proto.RegisterEnum("pstake.lscosmos.v1beta1.ExcessRewardPolicy", ExcessRewardPolicy_name, ExcessRewardPolicy_value)
 	proto.RegisterType((*Params)(nil), "pstake.lscosmos.v1beta1.Params")
+	proto.RegisterType((*ValidatorWeighting)(nil), "pstake.lscosmos.v1beta1.ValidatorWeighting")
 }
 
 func init() {
@@ -68,19 +242,59 @@ func init() {
 }
 
 var fileDescriptor_079f228748144235 = []byte{
-	// 180 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x29, 0x28, 0x2e, 0x49,
-	0xcc, 0x4e, 0xd5, 0xcf, 0x29, 0x4e, 0xce, 0x2f, 0xce, 0xcd, 0x2f, 0xd6, 0x2f, 0x33, 0x4c, 0x4a,
-	0x2d, 0x49, 0x34, 0xd4, 0x2f, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9,
-	0x17, 0x12, 0x87, 0xa8, 0xd2, 0x83, 0xa9, 0xd2, 0x83, 0xaa, 0x92, 0x12, 0x49, 0xcf, 0x4f, 0xcf,
-	0x07, 0xab, 0xd1, 0x07, 0xb1, 0x20, 0xca, 0x95, 0xf8, 0xb8, 0xd8, 0x02, 0xc0, 0xda, 0xad, 0x58,
-	0x66, 0x2c, 0x90, 0x67, 0x70, 0x0a, 0x3d, 0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07,
-	0x8f, 0xe4, 0x18, 0x27, 0x3c, 0x96, 0x63, 0xb8, 0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86,
-	0x28, 0xeb, 0xf4, 0xcc, 0x92, 0x8c, 0xd2, 0x24, 0xbd, 0xe4, 0xfc, 0x5c, 0xfd, 0x82, 0xd4, 0xa2,
-	0xe2, 0xcc, 0xe2, 0x92, 0xd4, 0xbc, 0xe4, 0x54, 0xff, 0xbc, 0x54, 0x7d, 0x88, 0x95, 0xba, 0x79,
-	0x89, 0x25, 0x99, 0x65, 0xa9, 0xfa, 0x65, 0x46, 0xfa, 0x15, 0x08, 0x47, 0x96, 0x54, 0x16, 0xa4,
-	0x16, 0x27, 0xb1, 0x81, 0x6d, 0x33, 0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0xdf, 0x9f, 0xb8, 0x80,
-	0xc4, 0x00, 0x00, 0x00,
+	// 822 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xf6, 0xb6, 0xae, 0x93, 0x4c, 0xa9, 0x6b, 0xa6, 0x16, 0xdd, 0x46, 0xc8, 0x89, 0x02, 0x6a,
+	0x23, 0x50, 0x76, 0xd5, 0x72, 0x03, 0x2e, 0xfe, 0x15, 0xc9, 0x50, 0x92, 0x68, 0xdd, 0xb4, 0x14,
+	0x84, 0x46, 0xe3, 0xdd, 0xe7, 0xf5, 0x28, 0xbb, 0x33, 0xab, 0x99, 0xf1, 0xd6, 0xfe, 0x0f, 0xb8,
+	0x20, 0x71, 0xec, 0x11, 0x89, 0x7f, 0xa1, 0x7f, 0x44, 0x8f, 0xa5, 0x27, 0xc4, 0xa1, 0xa0, 0xe4,
+	0xc6, 0x5f, 0x81, 0x66, 0x76, 0x1d, 0x22, 0x39, 0x20, 0xa8, 0x7c, 0xb2, 0x77, 0xde, 0xfb, 0xbe,
+	0xcf, 0xf3, 0xbe, 0xef, 0xad, 0xd1, 0x87, 0x99, 0xd2, 0xf4, 0x04, 0xfc, 0x44, 0x85, 0x42, 0xa5,
+	0x42, 0xf9, 0xf9, 0xfd, 0x11, 0x68, 0x7a, 0xdf, 0xcf, 0xa8, 0xa4, 0xa9, 0xf2, 0x32, 0x29, 0xb4,
+	0xc0, 0xb7, 0x8b, 0x2e, 0x6f, 0xd1, 0xe5, 0x95, 0x5d, 0x9b, 0xcd, 0x58, 0xc4, 0xc2, 0xf6, 0xf8,
+	0xe6, 0x5b, 0xd1, 0xbe, 0x79, 0xa7, 0xe8, 0x22, 0x45, 0xa1, 0x84, 0x14, 0xa5, 0x56, 0x2c, 0x44,
+	0x9c, 0x80, 0x6f, 0x9f, 0x46, 0xd3, 0xb1, 0x1f, 0x4d, 0x25, 0xd5, 0x4c, 0xf0, 0xa2, 0xbe, 0xf3,
+	0x67, 0x0d, 0xd5, 0x8e, 0xac, 0x34, 0x3e, 0x46, 0x6b, 0x3a, 0x4f, 0x48, 0x48, 0x33, 0xd7, 0xd9,
+	0x76, 0x76, 0x37, 0x3a, 0x9f, 0xbf, 0x7c, 0xb3, 0x55, 0xf9, 0xed, 0xcd, 0xd6, 0xdd, 0x98, 0xe9,
+	0xc9, 0x74, 0xe4, 0x85, 0x22, 0x2d, 0xc9, 0xcb, 0x8f, 0x3d, 0x15, 0x9d, 0xf8, 0x7a, 0x9e, 0x81,
+	0xf2, 0x06, 0x5c, 0xbf, 0x7e, 0xb1, 0x87, 0x4a, 0xed, 0x01, 0xd7, 0x41, 0x4d, 0xe7, 0x49, 0x97,
+	0x66, 0x58, 0xa1, 0xdb, 0x19, 0x48, 0x42, 0xa3, 0x48, 0x82, 0x52, 0x24, 0x82, 0x4c, 0x28, 0xa6,
+	0xad, 0xcc, 0x95, 0x15, 0xc8, 0x34, 0x33, 0x90, 0xed, 0x82, 0xbb, 0x57, 0x50, 0x5f, 0x10, 0x85,
+	0x4c, 0x84, 0x93, 0x73, 0xc9, 0x84, 0xa5, 0x4c, 0xbb, 0x57, 0x57, 0x24, 0xda, 0x37, 0xdc, 0xa5,
+	0xe4, 0x43, 0xc3, 0x8c, 0xbf, 0x40, 0x75, 0x1a, 0xa5, 0x8c, 0x13, 0xcd, 0x52, 0x48, 0x44, 0x78,
+	0xe2, 0x56, 0xb7, 0x9d, 0xdd, 0xeb, 0x0f, 0xee, 0x78, 0x85, 0x09, 0xde, 0xc2, 0x04, 0xaf, 0x57,
+	0x9a, 0xd0, 0x59, 0x37, 0x3f, 0xe3, 0xf9, 0xef, 0x5b, 0x4e, 0x70, 0xc3, 0x42, 0x1f, 0x95, 0x48,
+	0x3c, 0x42, 0xb7, 0x72, 0x9a, 0xb0, 0x88, 0x6a, 0x21, 0xc9, 0x33, 0x60, 0xf1, 0x44, 0x33, 0x1e,
+	0xbb, 0xd7, 0x2c, 0xe1, 0xc7, 0xde, 0x3f, 0xe4, 0xc3, 0x7b, 0xbc, 0xc0, 0x3c, 0x59, 0x40, 0x3a,
+	0x55, 0x23, 0x11, 0xe0, 0x7c, 0xa9, 0x82, 0x4f, 0xd0, 0x2d, 0x09, 0x96, 0xc8, 0xb8, 0x41, 0xcc,
+	0xc0, 0x22, 0x3a, 0x77, 0x6b, 0xff, 0x7b, 0x40, 0x3d, 0x08, 0x2f, 0x0c, 0xa8, 0x07, 0x61, 0xd0,
+	0x28, 0x89, 0xbb, 0x34, 0x3b, 0x02, 0xd9, 0xa3, 0x73, 0xfc, 0x1d, 0x6a, 0xc2, 0x2c, 0x34, 0x09,
+	0x90, 0xf0, 0x8c, 0xca, 0x88, 0x64, 0x22, 0x61, 0xe1, 0xdc, 0x5d, 0xdb, 0x76, 0x76, 0xeb, 0xff,
+	0x72, 0xa3, 0xbe, 0x05, 0x05, 0x16, 0x73, 0x64, 0x21, 0x01, 0x86, 0xa5, 0x33, 0x7c, 0x80, 0xde,
+	0x63, 0x5c, 0x4d, 0x25, 0xe5, 0x21, 0x90, 0xf1, 0x94, 0x47, 0x8b, 0xc0, 0xb9, 0xeb, 0xf6, 0x3a,
+	0xee, 0xeb, 0x17, 0x7b, 0xcd, 0x92, 0xba, 0x8c, 0xcb, 0x50, 0x4b, 0xc6, 0xe3, 0xa0, 0x79, 0x8e,
+	0xdb, 0x9f, 0xf2, 0xa8, 0xac, 0xe1, 0xaf, 0x50, 0x63, 0x22, 0x94, 0x26, 0xb9, 0xd0, 0x40, 0x46,
+	0xd3, 0xf1, 0x18, 0xa4, 0xbb, 0xf1, 0xdf, 0xdd, 0xac, 0x1b, 0xf0, 0x63, 0xa1, 0xa1, 0x63, 0xa1,
+	0x9f, 0x56, 0x9f, 0xff, 0xb4, 0x55, 0xd9, 0xf9, 0xa5, 0x8a, 0xf0, 0xb2, 0x43, 0xd8, 0x45, 0x6b,
+	0xc0, 0xe9, 0x28, 0x81, 0xc8, 0x2e, 0xde, 0x7a, 0xb0, 0x78, 0xc4, 0xf7, 0xd0, 0xcd, 0x69, 0x16,
+	0x51, 0x0d, 0x84, 0x71, 0x0d, 0x32, 0xa7, 0x89, 0xdd, 0x99, 0xab, 0x41, 0xbd, 0x38, 0x1e, 0x94,
+	0xa7, 0x38, 0x44, 0xf5, 0x94, 0xce, 0x48, 0x28, 0xd2, 0x94, 0x29, 0xc5, 0x04, 0x7f, 0x8b, 0x98,
+	0x2f, 0xbb, 0x78, 0x23, 0xa5, 0xb3, 0xee, 0x39, 0xa5, 0xc9, 0x8b, 0x49, 0xb7, 0x82, 0x64, 0x4c,
+	0x46, 0x82, 0x47, 0xc4, 0x5e, 0xdb, 0xad, 0xae, 0x40, 0xa9, 0x91, 0x32, 0x3e, 0x84, 0x64, 0xdc,
+	0x11, 0x3c, 0x0a, 0x0c, 0x2b, 0x26, 0xe8, 0x9d, 0x22, 0xf6, 0x64, 0x9c, 0x08, 0x21, 0xdd, 0x6b,
+	0x2b, 0x50, 0xb9, 0x5e, 0x30, 0xee, 0x1b, 0x42, 0xfc, 0x2d, 0x42, 0xa5, 0x80, 0x79, 0x15, 0xad,
+	0x22, 0xf4, 0x1b, 0x05, 0x9f, 0x79, 0xff, 0x4c, 0xd0, 0xbb, 0xc6, 0x8f, 0x85, 0xc0, 0x84, 0xf2,
+	0x18, 0xdc, 0xb5, 0x15, 0x68, 0xdc, 0x4c, 0xe9, 0xac, 0x48, 0x4e, 0xd7, 0x92, 0x7e, 0xf4, 0x83,
+	0x83, 0xf0, 0xf2, 0x8e, 0xe0, 0xbb, 0x68, 0xa7, 0xff, 0x75, 0xb7, 0x3f, 0x1c, 0x92, 0xa0, 0xff,
+	0xa4, 0x1d, 0xf4, 0xc8, 0xd1, 0xe1, 0xc3, 0x41, 0xf7, 0x29, 0xe9, 0xb6, 0x83, 0xe0, 0x29, 0xd9,
+	0x3f, 0x0c, 0xcc, 0x61, 0xa3, 0x82, 0xef, 0xa1, 0x0f, 0x2e, 0xed, 0x1b, 0x1c, 0x0c, 0x8f, 0x83,
+	0xf6, 0x41, 0xb7, 0x4f, 0xf6, 0x8f, 0x0f, 0x7a, 0x0d, 0x07, 0x6f, 0xa3, 0xf7, 0x2f, 0x6d, 0x0c,
+	0xfa, 0xc3, 0x47, 0xed, 0x2f, 0xfb, 0x8d, 0x2b, 0x9b, 0xd5, 0xef, 0x7f, 0x6e, 0x55, 0x3a, 0xc7,
+	0x2f, 0x4f, 0x5b, 0xce, 0xab, 0xd3, 0x96, 0xf3, 0xc7, 0x69, 0xcb, 0xf9, 0xf1, 0xac, 0x55, 0x79,
+	0x75, 0xd6, 0xaa, 0xfc, 0x7a, 0xd6, 0xaa, 0x7c, 0xf3, 0xd9, 0x85, 0x0b, 0x67, 0x20, 0x15, 0x53,
+	0x1a, 0x78, 0x08, 0x87, 0x1c, 0xfc, 0x62, 0xf9, 0xf7, 0x38, 0xd5, 0x2c, 0x07, 0x3f, 0x7f, 0xe0,
+	0xcf, 0xfe, 0xfe, 0x83, 0xb4, 0x93, 0x18, 0xd5, 0xec, 0xb6, 0x7d, 0xf2, 0xd7, 0x00, 0xec, 0x0f,
+	0x73, 0x16, 0x40, 0x07, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -103,6 +317,172 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.HostVoteBuffer, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.HostVoteBuffer):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintParams(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x4a
+	if len(m.InsuranceFundAddress) > 0 {
+		i -= len(m.InsuranceFundAddress)
+		copy(dAtA[i:], m.InsuranceFundAddress)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.InsuranceFundAddress)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.ExcessRewardPolicy != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ExcessRewardPolicy))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size := m.RestakeCapPerDay.Size()
+		i -= size
+		if _, err := m.RestakeCapPerDay.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size, err := m.ValidatorWeighting.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	n3, err3 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.AdminTimelock, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.AdminTimelock):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintParams(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.PerEpochDepositLimit.Size()
+		i -= size
+		if _, err := m.PerEpochDepositLimit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.PerAddressDepositCap.Size()
+		i -= size
+		if _, err := m.PerAddressDepositCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.TvlCap.Size()
+		i -= size
+		if _, err := m.TvlCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorWeighting) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorWeighting) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorWeighting) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxWeightChange.Size()
+		i -= size
+		if _, err := m.MaxWeightChange.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.WeightCap.Size()
+		i -= size
+		if _, err := m.WeightCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.WeightFloor.Size()
+		i -= size
+		if _, err := m.WeightFloor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.MinSelfBondRatio.Size()
+		i -= size
+		if _, err := m.MinSelfBondRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.MaxCommission.Size()
+		i -= size
+		if _, err := m.MaxCommission.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.UpdateInterval != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.UpdateInterval))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -123,6 +503,52 @@ func (m *Params) Size() (n int) {
 	}
 	var l int
 	_ = l
+	l = m.TvlCap.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.PerAddressDepositCap.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.PerEpochDepositLimit.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.AdminTimelock)
+	n += 1 + l + sovParams(uint64(l))
+	l = m.ValidatorWeighting.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.RestakeCapPerDay.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.ExcessRewardPolicy != 0 {
+		n += 1 + sovParams(uint64(m.ExcessRewardPolicy))
+	}
+	l = len(m.InsuranceFundAddress)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.HostVoteBuffer)
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func (m *ValidatorWeighting) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Enabled {
+		n += 2
+	}
+	if m.UpdateInterval != 0 {
+		n += 1 + sovParams(uint64(m.UpdateInterval))
+	}
+	l = m.MaxCommission.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.MinSelfBondRatio.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.WeightFloor.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.WeightCap.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.MaxWeightChange.Size()
+	n += 1 + l + sovParams(uint64(l))
 	return n
 }
 
@@ -161,6 +587,551 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TvlCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TvlCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerAddressDepositCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PerAddressDepositCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerEpochDepositLimit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PerEpochDepositLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdminTimelock", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.AdminTimelock, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorWeighting", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ValidatorWeighting.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RestakeCapPerDay", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RestakeCapPerDay.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExcessRewardPolicy", wireType)
+			}
+			m.ExcessRewardPolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExcessRewardPolicy |= ExcessRewardPolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InsuranceFundAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InsuranceFundAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostVoteBuffer", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.HostVoteBuffer, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorWeighting) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorWeighting: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorWeighting: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateInterval", wireType)
+			}
+			m.UpdateInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpdateInterval |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCommission", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxCommission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSelfBondRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinSelfBondRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WeightFloor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.WeightFloor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WeightCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.WeightCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxWeightChange", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxWeightChange.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -0,0 +1,57 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/persistenceOne/pstake-native/v2/x/lscosmos/types"
+)
+
+func TestParamsValidate(t *testing.T) {
+	insuranceFund := sdk.MustBech32ifyAddressBytes(types.CosmosAccountPrefix, sdk.AccAddress("insurance___________"))
+
+	cases := []struct {
+		name     string
+		modifier func(*types.Params)
+		valid    bool
+	}{
+		{"default", func(*types.Params) {}, true},
+		{"negative tvl cap", func(p *types.Params) { p.TvlCap = sdk.NewInt(-1) }, false},
+		{"negative admin timelock", func(p *types.Params) { p.AdminTimelock = -1 }, false},
+		{"zero restake cap", func(p *types.Params) { p.RestakeCapPerDay = sdk.ZeroDec() }, true},
+		{"negative restake cap", func(p *types.Params) { p.RestakeCapPerDay = sdk.NewDec(-1) }, false},
+		{"restake cap above one", func(p *types.Params) { p.RestakeCapPerDay = sdk.NewDec(2) }, false},
+		{"nil restake cap", func(p *types.Params) { p.RestakeCapPerDay = sdk.Dec{} }, false},
+		{"restake policy", func(p *types.Params) { p.ExcessRewardPolicy = types.EXCESS_REWARD_POLICY_RESTAKE }, true},
+		{"zero host vote buffer", func(p *types.Params) { p.HostVoteBuffer = 0 }, false},
+		{"unknown policy", func(p *types.Params) { p.ExcessRewardPolicy = 3 }, false},
+		{"insurance fund policy", func(p *types.Params) {
+			p.ExcessRewardPolicy = types.EXCESS_REWARD_POLICY_INSURANCE_FUND
+			p.InsuranceFundAddress = insuranceFund
+		}, true},
+		{"insurance fund policy without address", func(p *types.Params) {
+			p.ExcessRewardPolicy = types.EXCESS_REWARD_POLICY_INSURANCE_FUND
+		}, false},
+		{"insurance fund address with another prefix", func(p *types.Params) {
+			p.InsuranceFundAddress = sdk.AccAddress("insurance___________").String()
+		}, true},
+		{"invalid insurance fund address", func(p *types.Params) {
+			p.InsuranceFundAddress = "cosmos1insurance"
+		}, false},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			params := types.DefaultParams()
+			tc.modifier(&params)
+			err := params.Validate()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
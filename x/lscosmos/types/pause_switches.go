@@ -0,0 +1,92 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// pause switch names
+const (
+	PauseSwitchDeposits          = "deposits"
+	PauseSwitchUnstakes          = "unstakes"
+	PauseSwitchRedeems           = "redeems"
+	PauseSwitchClaims            = "claims"
+	PauseSwitchDelegationEpoch   = "delegation_epoch"
+	PauseSwitchRewardEpoch       = "reward_epoch"
+	PauseSwitchUndelegationEpoch = "undelegation_epoch"
+	PauseSwitchHostVotes         = "host_votes"
+)
+
+// PauseSwitchNames lists all the pause switch names
+var PauseSwitchNames = []string{
+	PauseSwitchDeposits,
+	PauseSwitchUnstakes,
+	PauseSwitchRedeems,
+	PauseSwitchClaims,
+	PauseSwitchDelegationEpoch,
+	PauseSwitchRewardEpoch,
+	PauseSwitchUndelegationEpoch,
+	PauseSwitchHostVotes,
+}
+
+// NewPauseSwitches returns PauseSwitches with the named switches paused and all the others resumed
+func NewPauseSwitches(paused []string) (PauseSwitches, error) {
+	var pauseSwitches PauseSwitches
+	for _, name := range paused {
+		s := pauseSwitches.get(name)
+		if s == nil {
+			return PauseSwitches{}, errorsmod.Wrapf(ErrInvalidPauseSwitch, "%s, expected one of %v", name, PauseSwitchNames)
+		}
+		*s = true
+	}
+	return pauseSwitches, nil
+}
+
+// IsPaused returns true if the named switch is paused, unknown switches are never paused
+func (p PauseSwitches) IsPaused(name string) bool {
+	s := p.get(name)
+	return s != nil && *s
+}
+
+// PausedNames returns the names of the paused switches
+func (p PauseSwitches) PausedNames() []string {
+	var paused []string
+	for _, name := range PauseSwitchNames {
+		if p.IsPaused(name) {
+			paused = append(paused, name)
+		}
+	}
+	return paused
+}
+
+// Resumes returns true if any switch paused in current is not paused in p
+func (p PauseSwitches) Resumes(current PauseSwitches) bool {
+	for _, name := range PauseSwitchNames {
+		if current.IsPaused(name) && !p.IsPaused(name) {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *PauseSwitches) get(name string) *bool {
+	switch name {
+	case PauseSwitchDeposits:
+		return &p.Deposits
+	case PauseSwitchUnstakes:
+		return &p.Unstakes
+	case PauseSwitchRedeems:
+		return &p.Redeems
+	case PauseSwitchClaims:
+		return &p.Claims
+	case PauseSwitchDelegationEpoch:
+		return &p.DelegationEpoch
+	case PauseSwitchRewardEpoch:
+		return &p.RewardEpoch
+	case PauseSwitchUndelegationEpoch:
+		return &p.UndelegationEpoch
+	case PauseSwitchHostVotes:
+		return &p.HostVotes
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,31 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/persistenceOne/pstake-native/v2/x/lscosmos/types"
+)
+
+func TestNewPauseSwitches(t *testing.T) {
+	pauseSwitches, err := types.NewPauseSwitches(types.PauseSwitchNames)
+	require.NoError(t, err)
+	require.Equal(t, types.PauseSwitchNames, pauseSwitches.PausedNames())
+
+	pauseSwitches, err = types.NewPauseSwitches(nil)
+	require.NoError(t, err)
+	require.Equal(t, types.PauseSwitches{}, pauseSwitches)
+
+	_, err = types.NewPauseSwitches([]string{"staking"})
+	require.ErrorIs(t, err, types.ErrInvalidPauseSwitch)
+}
+
+func TestPauseSwitchesResumes(t *testing.T) {
+	current := types.PauseSwitches{Deposits: true, Claims: true}
+
+	require.False(t, types.PauseSwitches{Deposits: true, Claims: true, Redeems: true}.Resumes(current))
+	require.True(t, types.PauseSwitches{Deposits: true}.Resumes(current))
+	require.True(t, types.PauseSwitches{}.Resumes(current))
+	require.False(t, types.PauseSwitches{}.Resumes(types.PauseSwitches{}))
+}
@@ -6,9 +6,10 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
-	_ "github.com/cosmos/cosmos-sdk/types/query"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
@@ -201,6 +202,8 @@ func (m *QueryHostChainParamsResponse) GetHostChainParams() HostChainParams {
 
 // QueryDelegationStateRequest is request for the Ouery/DelegationState methods.
 type QueryDelegationStateRequest struct {
+	// pagination of the host account delegations
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *QueryDelegationStateRequest) Reset()         { *m = QueryDelegationStateRequest{} }
@@ -236,10 +239,18 @@ func (m *QueryDelegationStateRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryDelegationStateRequest proto.InternalMessageInfo
 
+func (m *QueryDelegationStateRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 // QueryDelegationStateResponse is response for the Ouery/DelegationState
 // methods.
 type QueryDelegationStateResponse struct {
-	DelegationState DelegationState `protobuf:"bytes,1,opt,name=delegation_state,json=delegationState,proto3" json:"delegation_state"`
+	DelegationState DelegationState     `protobuf:"bytes,1,opt,name=delegation_state,json=delegationState,proto3" json:"delegation_state"`
+	Pagination      *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *QueryDelegationStateResponse) Reset()         { *m = QueryDelegationStateResponse{} }
@@ -282,9 +293,18 @@ func (m *QueryDelegationStateResponse) GetDelegationState() DelegationState {
 	return DelegationState{}
 }
 
+func (m *QueryDelegationStateResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 // QueryListedValidatorsRequest is a request for the Query/AllowListedValidators
 // methods.
 type QueryAllowListedValidatorsRequest struct {
+	// only offset based pagination is supported
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *QueryAllowListedValidatorsRequest) Reset()         { *m = QueryAllowListedValidatorsRequest{} }
@@ -320,10 +340,18 @@ func (m *QueryAllowListedValidatorsRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryAllowListedValidatorsRequest proto.InternalMessageInfo
 
+func (m *QueryAllowListedValidatorsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 // QueryListedValidatorsResponse is a response for the
 // Query/AllowListedValidators methods.
 type QueryAllowListedValidatorsResponse struct {
 	AllowListedValidators AllowListedValidators `protobuf:"bytes,1,opt,name=allow_listed_validators,json=allowListedValidators,proto3" json:"allow_listed_validators"`
+	Pagination            *query.PageResponse   `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *QueryAllowListedValidatorsResponse) Reset()         { *m = QueryAllowListedValidatorsResponse{} }
@@ -366,6 +394,13 @@ func (m *QueryAllowListedValidatorsResponse) GetAllowListedValidators() AllowLis
 	return AllowListedValidators{}
 }
 
+func (m *QueryAllowListedValidatorsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 // QueryCValueRequest is a request for the Query/CValue methods.
 type QueryCValueRequest struct {
 }
@@ -609,7 +644,8 @@ func (m *QueryIBCTransientStoreResponse) GetIBCTransientStore() IBCAmountTransie
 
 // QueryUnclaimedRequest is a request for the Query/Unclaimed methods.
 type QueryUnclaimedRequest struct {
-	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	DelegatorAddress string             `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	Pagination       *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *QueryUnclaimedRequest) Reset()         { *m = QueryUnclaimedRequest{} }
@@ -652,9 +688,17 @@ func (m *QueryUnclaimedRequest) GetDelegatorAddress() string {
 	return ""
 }
 
+func (m *QueryUnclaimedRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 // QueryUnclaimedResponse is a response for the Query/Unclaimed methods.
 type QueryUnclaimedResponse struct {
-	Unclaimed []UnbondingEpochCValue `protobuf:"bytes,1,rep,name=unclaimed,proto3" json:"unclaimed"`
+	Unclaimed  []UnbondingEpochCValue `protobuf:"bytes,1,rep,name=unclaimed,proto3" json:"unclaimed"`
+	Pagination *query.PageResponse    `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *QueryUnclaimedResponse) Reset()         { *m = QueryUnclaimedResponse{} }
@@ -697,10 +741,18 @@ func (m *QueryUnclaimedResponse) GetUnclaimed() []UnbondingEpochCValue {
 	return nil
 }
 
+func (m *QueryUnclaimedResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 // QueryFailedUnbondingsRequest is a request for the Query/FailedUnbondings
 // methods.
 type QueryFailedUnbondingsRequest struct {
-	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	DelegatorAddress string             `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	Pagination       *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *QueryFailedUnbondingsRequest) Reset()         { *m = QueryFailedUnbondingsRequest{} }
@@ -743,10 +795,18 @@ func (m *QueryFailedUnbondingsRequest) GetDelegatorAddress() string {
 	return ""
 }
 
+func (m *QueryFailedUnbondingsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 // QueryFailedUnbondingsResponse a response for the Query/FailedUnbondings
 // methods.
 type QueryFailedUnbondingsResponse struct {
 	FailedUnbondings []UnbondingEpochCValue `protobuf:"bytes,1,rep,name=failed_unbondings,json=failedUnbondings,proto3" json:"failed_unbondings"`
+	Pagination       *query.PageResponse    `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *QueryFailedUnbondingsResponse) Reset()         { *m = QueryFailedUnbondingsResponse{} }
@@ -789,10 +849,18 @@ func (m *QueryFailedUnbondingsResponse) GetFailedUnbondings() []UnbondingEpochCV
 	return nil
 }
 
+func (m *QueryFailedUnbondingsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 // QueryPendingUnbondingsRequest is a request for the Query/PendingUnbondings
 // methods.
 type QueryPendingUnbondingsRequest struct {
-	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	DelegatorAddress string             `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	Pagination       *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *QueryPendingUnbondingsRequest) Reset()         { *m = QueryPendingUnbondingsRequest{} }
@@ -835,10 +903,18 @@ func (m *QueryPendingUnbondingsRequest) GetDelegatorAddress() string {
 	return ""
 }
 
+func (m *QueryPendingUnbondingsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 // QueryPendingUnbondingsResponse is a response for the Query/PendingUnbondings
 // methods.
 type QueryPendingUnbondingsResponse struct {
 	PendingUnbondings []UnbondingEpochCValue `protobuf:"bytes,1,rep,name=pending_unbondings,json=pendingUnbondings,proto3" json:"pending_unbondings"`
+	Pagination        *query.PageResponse    `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *QueryPendingUnbondingsResponse) Reset()         { *m = QueryPendingUnbondingsResponse{} }
@@ -881,6 +957,13 @@ func (m *QueryPendingUnbondingsResponse) GetPendingUnbondings() []UnbondingEpoch
 	return nil
 }
 
+func (m *QueryPendingUnbondingsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 // QueryUnbondingEpochCValueRequest is a request for the
 // Query/UnbondingEpochCValue methods.
 type QueryUnbondingEpochCValueRequest struct {
@@ -1340,7 +1423,8 @@ func (m *QueryDepositModuleAccountResponse) GetBalance() types.Coin {
 // QueryAllDelegatorUnbondingEpochEntriesRequest is a request for the
 // Query/DelegatorUnbondingEpochEntries methods.
 type QueryAllDelegatorUnbondingEpochEntriesRequest struct {
-	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	DelegatorAddress string             `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	Pagination       *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *QueryAllDelegatorUnbondingEpochEntriesRequest) Reset() {
@@ -1387,10 +1471,18 @@ func (m *QueryAllDelegatorUnbondingEpochEntriesRequest) GetDelegatorAddress() st
 	return ""
 }
 
+func (m *QueryAllDelegatorUnbondingEpochEntriesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 // QueryAllDelegatorUnbondingEpochEntriesResponse is a response for the
 // Query/DelegatorUnbondingEpochEntries methods.
 type QueryAllDelegatorUnbondingEpochEntriesResponse struct {
 	DelegatorUnbondingEpochEntries []DelegatorUnbondingEpochEntry `protobuf:"bytes,1,rep,name=delegator_unbonding_epoch_entries,json=delegatorUnbondingEpochEntries,proto3" json:"delegator_unbonding_epoch_entries"`
+	Pagination                     *query.PageResponse            `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *QueryAllDelegatorUnbondingEpochEntriesResponse) Reset() {
@@ -1437,2117 +1529,8819 @@ func (m *QueryAllDelegatorUnbondingEpochEntriesResponse) GetDelegatorUnbondingEp
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "pstake.lscosmos.v1beta1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "pstake.lscosmos.v1beta1.QueryParamsResponse")
-	proto.RegisterType((*QueryHostChainParamsRequest)(nil), "pstake.lscosmos.v1beta1.QueryHostChainParamsRequest")
-	proto.RegisterType((*QueryHostChainParamsResponse)(nil), "pstake.lscosmos.v1beta1.QueryHostChainParamsResponse")
-	proto.RegisterType((*QueryDelegationStateRequest)(nil), "pstake.lscosmos.v1beta1.QueryDelegationStateRequest")
-	proto.RegisterType((*QueryDelegationStateResponse)(nil), "pstake.lscosmos.v1beta1.QueryDelegationStateResponse")
-	proto.RegisterType((*QueryAllowListedValidatorsRequest)(nil), "pstake.lscosmos.v1beta1.QueryAllowListedValidatorsRequest")
-	proto.RegisterType((*QueryAllowListedValidatorsResponse)(nil), "pstake.lscosmos.v1beta1.QueryAllowListedValidatorsResponse")
-	proto.RegisterType((*QueryCValueRequest)(nil), "pstake.lscosmos.v1beta1.QueryCValueRequest")
-	proto.RegisterType((*QueryCValueResponse)(nil), "pstake.lscosmos.v1beta1.QueryCValueResponse")
-	proto.RegisterType((*QueryModuleStateRequest)(nil), "pstake.lscosmos.v1beta1.QueryModuleStateRequest")
-	proto.RegisterType((*QueryModuleStateResponse)(nil), "pstake.lscosmos.v1beta1.QueryModuleStateResponse")
-	proto.RegisterType((*QueryIBCTransientStoreRequest)(nil), "pstake.lscosmos.v1beta1.QueryIBCTransientStoreRequest")
-	proto.RegisterType((*QueryIBCTransientStoreResponse)(nil), "pstake.lscosmos.v1beta1.QueryIBCTransientStoreResponse")
-	proto.RegisterType((*QueryUnclaimedRequest)(nil), "pstake.lscosmos.v1beta1.QueryUnclaimedRequest")
-	proto.RegisterType((*QueryUnclaimedResponse)(nil), "pstake.lscosmos.v1beta1.QueryUnclaimedResponse")
-	proto.RegisterType((*QueryFailedUnbondingsRequest)(nil), "pstake.lscosmos.v1beta1.QueryFailedUnbondingsRequest")
-	proto.RegisterType((*QueryFailedUnbondingsResponse)(nil), "pstake.lscosmos.v1beta1.QueryFailedUnbondingsResponse")
-	proto.RegisterType((*QueryPendingUnbondingsRequest)(nil), "pstake.lscosmos.v1beta1.QueryPendingUnbondingsRequest")
-	proto.RegisterType((*QueryPendingUnbondingsResponse)(nil), "pstake.lscosmos.v1beta1.QueryPendingUnbondingsResponse")
-	proto.RegisterType((*QueryUnbondingEpochCValueRequest)(nil), "pstake.lscosmos.v1beta1.QueryUnbondingEpochCValueRequest")
-	proto.RegisterType((*QueryUnbondingEpochCValueResponse)(nil), "pstake.lscosmos.v1beta1.QueryUnbondingEpochCValueResponse")
-	proto.RegisterType((*QueryHostAccountUndelegationRequest)(nil), "pstake.lscosmos.v1beta1.QueryHostAccountUndelegationRequest")
-	proto.RegisterType((*QueryHostAccountUndelegationResponse)(nil), "pstake.lscosmos.v1beta1.QueryHostAccountUndelegationResponse")
-	proto.RegisterType((*QueryDelegatorUnbondingEpochEntryRequest)(nil), "pstake.lscosmos.v1beta1.QueryDelegatorUnbondingEpochEntryRequest")
-	proto.RegisterType((*QueryDelegatorUnbondingEpochEntryResponse)(nil), "pstake.lscosmos.v1beta1.QueryDelegatorUnbondingEpochEntryResponse")
-	proto.RegisterType((*QueryHostAccountsRequest)(nil), "pstake.lscosmos.v1beta1.QueryHostAccountsRequest")
-	proto.RegisterType((*QueryHostAccountsResponse)(nil), "pstake.lscosmos.v1beta1.QueryHostAccountsResponse")
-	proto.RegisterType((*QueryDepositModuleAccountRequest)(nil), "pstake.lscosmos.v1beta1.QueryDepositModuleAccountRequest")
-	proto.RegisterType((*QueryDepositModuleAccountResponse)(nil), "pstake.lscosmos.v1beta1.QueryDepositModuleAccountResponse")
-	proto.RegisterType((*QueryAllDelegatorUnbondingEpochEntriesRequest)(nil), "pstake.lscosmos.v1beta1.QueryAllDelegatorUnbondingEpochEntriesRequest")
-	proto.RegisterType((*QueryAllDelegatorUnbondingEpochEntriesResponse)(nil), "pstake.lscosmos.v1beta1.QueryAllDelegatorUnbondingEpochEntriesResponse")
+func (m *QueryAllDelegatorUnbondingEpochEntriesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func init() {
-	proto.RegisterFile("pstake/lscosmos/v1beta1/query.proto", fileDescriptor_799833dfb4f243e6)
+// QueryRemainingCapacityRequest is a request for the Query/RemainingCapacity
+// methods, the per address deposit cap is only considered if
+// delegator_address is set.
+type QueryRemainingCapacityRequest struct {
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
 }
 
-var fileDescriptor_799833dfb4f243e6 = []byte{
-	// 1587 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0x5b, 0x6f, 0xdc, 0xc4,
-	0x17, 0xcf, 0xa4, 0xff, 0x7f, 0x4a, 0x26, 0x41, 0x4d, 0x86, 0x84, 0x24, 0xdb, 0x76, 0x93, 0xb8,
-	0xb7, 0xf4, 0x12, 0xbb, 0x09, 0xbd, 0x97, 0x16, 0x72, 0xe9, 0x4d, 0x94, 0x92, 0xa6, 0x4d, 0x25,
-	0xca, 0xc5, 0x78, 0xbd, 0xd3, 0x8d, 0xa9, 0xd7, 0xe3, 0xda, 0xde, 0x40, 0x5a, 0x55, 0x02, 0x84,
-	0x90, 0xa8, 0x40, 0x20, 0x78, 0xe3, 0x8d, 0x07, 0xde, 0x10, 0x12, 0xbc, 0xc1, 0x07, 0x40, 0x05,
-	0x09, 0x51, 0x09, 0x09, 0x21, 0x1e, 0x2a, 0x68, 0xf9, 0x20, 0xc8, 0xe3, 0x63, 0xc7, 0x6b, 0x7b,
-	0xec, 0xcd, 0x86, 0xa7, 0x36, 0x73, 0x6e, 0xbf, 0xdf, 0x99, 0xe3, 0x39, 0xe7, 0x2c, 0xde, 0x61,
-	0xbb, 0x9e, 0x76, 0x93, 0x2a, 0xa6, 0xab, 0x33, 0xb7, 0xce, 0x5c, 0x65, 0x65, 0xaa, 0x42, 0x3d,
-	0x6d, 0x4a, 0xb9, 0xd5, 0xa0, 0xce, 0xaa, 0x6c, 0x3b, 0xcc, 0x63, 0x64, 0x28, 0x50, 0x92, 0x43,
-	0x25, 0x19, 0x94, 0x4a, 0x03, 0x35, 0x56, 0x63, 0x5c, 0x47, 0xf1, 0xff, 0x17, 0xa8, 0x97, 0xb6,
-	0xd5, 0x18, 0xab, 0x99, 0x54, 0xd1, 0x6c, 0x43, 0xd1, 0x2c, 0x8b, 0x79, 0x9a, 0x67, 0x30, 0xcb,
-	0x05, 0xe9, 0x3e, 0x08, 0x54, 0xd1, 0x5c, 0x1a, 0x44, 0x89, 0x62, 0xda, 0x5a, 0xcd, 0xb0, 0xb8,
-	0x32, 0xe8, 0xee, 0x14, 0xa1, 0xb3, 0x35, 0x47, 0xab, 0x87, 0x1e, 0xa7, 0x44, 0x5a, 0x35, 0xb6,
-	0x42, 0x1d, 0x4b, 0xb3, 0x74, 0xaa, 0xda, 0x0e, 0xb3, 0x99, 0xab, 0x99, 0x60, 0xb2, 0x5b, 0x64,
-	0x12, 0x51, 0x0c, 0xf4, 0xca, 0x71, 0xb0, 0xa1, 0x8e, 0xce, 0x8c, 0x10, 0xe0, 0x28, 0x50, 0xe5,
-	0x7f, 0x55, 0x1a, 0x37, 0x14, 0xcf, 0xa8, 0x53, 0xd7, 0xd3, 0xea, 0x76, 0xa0, 0x20, 0x0d, 0x60,
-	0x72, 0xd9, 0xe7, 0xb8, 0xc0, 0x01, 0x2f, 0xd2, 0x5b, 0x0d, 0xea, 0x7a, 0xd2, 0x55, 0xfc, 0x54,
-	0xd3, 0xa9, 0x6b, 0x33, 0xcb, 0xa5, 0xe4, 0x14, 0xee, 0x0a, 0x88, 0x0d, 0xa3, 0x31, 0x34, 0xd1,
-	0x33, 0x3d, 0x2a, 0x0b, 0x12, 0x2f, 0x07, 0x86, 0xb3, 0xff, 0xbb, 0xff, 0x70, 0xb4, 0x63, 0x11,
-	0x8c, 0xa4, 0xed, 0x78, 0x2b, 0xf7, 0x7a, 0x9e, 0xb9, 0xde, 0xdc, 0xb2, 0x66, 0x58, 0xcd, 0x41,
-	0x6f, 0xe3, 0x6d, 0xd9, 0x62, 0x88, 0x7e, 0x1d, 0xf7, 0x2f, 0x33, 0xd7, 0x53, 0x75, 0x5f, 0xa6,
-	0x36, 0x01, 0x99, 0x10, 0x02, 0x49, 0x38, 0x03, 0x44, 0x5b, 0x96, 0x9b, 0x8f, 0x23, 0x68, 0xf3,
-	0xd4, 0xa4, 0x35, 0x7e, 0xc3, 0x57, 0x3c, 0xcd, 0xa3, 0x21, 0xb4, 0x55, 0x80, 0x96, 0x12, 0x03,
-	0xb4, 0x97, 0x71, 0x5f, 0x35, 0x12, 0xa9, 0xae, 0x2f, 0x2b, 0x44, 0x96, 0xf0, 0x15, 0x22, 0xab,
-	0x36, 0x1f, 0x4b, 0x3b, 0xf0, 0x38, 0x0f, 0x3d, 0x63, 0x9a, 0xec, 0xad, 0x8b, 0x86, 0xeb, 0xd1,
-	0xea, 0x35, 0xcd, 0x34, 0xaa, 0x9a, 0xc7, 0x9c, 0x28, 0x75, 0x9f, 0x21, 0x2c, 0xe5, 0x69, 0x01,
-	0x4c, 0x13, 0x0f, 0x69, 0xbe, 0x82, 0x6a, 0x72, 0x0d, 0x75, 0x25, 0x52, 0x01, 0xb4, 0xb2, 0x10,
-	0x6d, 0xa6, 0x63, 0xc0, 0x3c, 0xa8, 0x65, 0x09, 0xa3, 0xd2, 0x9a, 0xbb, 0xa6, 0x99, 0x8d, 0x28,
-	0x95, 0xaf, 0x43, 0x69, 0x85, 0xa7, 0x00, 0xed, 0x1c, 0xde, 0xac, 0xfb, 0x78, 0x1a, 0x41, 0xe2,
-	0xba, 0x67, 0x65, 0xdf, 0xf5, 0x9f, 0x0f, 0x47, 0x77, 0xd7, 0x0c, 0x6f, 0xb9, 0x51, 0x91, 0x75,
-	0x56, 0x57, 0xa0, 0xd8, 0x83, 0x7f, 0x26, 0xdd, 0xea, 0x4d, 0xc5, 0x5b, 0xb5, 0xa9, 0x2b, 0xcf,
-	0x53, 0x7d, 0xb1, 0x4b, 0xe7, 0x0e, 0xa5, 0x11, 0x3c, 0xc4, 0xfd, 0xbf, 0xc8, 0xaa, 0x0d, 0x93,
-	0x36, 0xdd, 0xe2, 0x29, 0x3c, 0x9c, 0x16, 0x41, 0xfc, 0x71, 0xdc, 0x5b, 0xe7, 0xc7, 0xb1, 0xdb,
-	0x7b, 0x62, 0xb1, 0xa7, 0xbe, 0xa6, 0x2a, 0x8d, 0xe2, 0xed, 0xdc, 0xfc, 0xc2, 0xec, 0xdc, 0x55,
-	0x47, 0xb3, 0x5c, 0x83, 0x5a, 0xde, 0x15, 0x8f, 0x39, 0x91, 0xff, 0x7b, 0x08, 0x97, 0x45, 0x1a,
-	0x10, 0x66, 0x19, 0x0f, 0x1a, 0x6a, 0x45, 0xd5, 0x55, 0x2f, 0x94, 0xab, 0xae, 0xaf, 0x00, 0xf9,
-	0x3f, 0x28, 0xcc, 0xff, 0x85, 0xd9, 0xb9, 0x99, 0x3a, 0x6b, 0x58, 0x5e, 0xb3, 0x63, 0xb8, 0x81,
-	0x7e, 0x23, 0x19, 0x51, 0x9a, 0xc7, 0x83, 0x1c, 0xcb, 0x92, 0xa5, 0x9b, 0x9a, 0x51, 0xa7, 0x55,
-	0x40, 0x49, 0xf6, 0xe3, 0x7e, 0xa8, 0x31, 0xe6, 0xa8, 0x5a, 0xb5, 0xea, 0x50, 0x37, 0xb8, 0xfe,
-	0xee, 0xc5, 0xbe, 0x48, 0x30, 0x13, 0x9c, 0x4b, 0x37, 0xf1, 0xd3, 0x49, 0x2f, 0xc0, 0xe4, 0x32,
-	0xee, 0x6e, 0x84, 0x87, 0xc3, 0x68, 0x6c, 0xd3, 0x44, 0xcf, 0xf4, 0xa4, 0x10, 0xfd, 0x92, 0x55,
-	0x61, 0x56, 0xd5, 0xb0, 0x6a, 0x67, 0x6c, 0xa6, 0x2f, 0x07, 0x57, 0x0f, 0xd0, 0xd7, 0xbc, 0x48,
-	0x2f, 0xc0, 0x57, 0x76, 0x56, 0x33, 0x4c, 0x5a, 0x8d, 0x6c, 0xdc, 0xb6, 0x90, 0xbf, 0x8b, 0xe0,
-	0xba, 0xd2, 0xde, 0x80, 0xc1, 0x1b, 0xb8, 0xff, 0x06, 0x97, 0xa9, 0x8d, 0x48, 0xb8, 0x11, 0x26,
-	0x7d, 0x37, 0x12, 0x91, 0xa4, 0x8b, 0x00, 0x61, 0x81, 0xf2, 0x83, 0x0d, 0x32, 0x7a, 0x3f, 0x2c,
-	0xaf, 0x0c, 0x77, 0x40, 0xa9, 0x82, 0x89, 0x1d, 0x08, 0xff, 0x23, 0x4e, 0xfd, 0x76, 0x32, 0x96,
-	0x74, 0x06, 0x8f, 0x41, 0x49, 0xa4, 0xad, 0x42, 0x5e, 0xe3, 0xb8, 0x97, 0xfa, 0xa7, 0xaa, 0xd5,
-	0xa8, 0x57, 0xa8, 0xc3, 0x29, 0x6d, 0x5a, 0xec, 0xe1, 0x67, 0x97, 0xf8, 0x91, 0xf4, 0x09, 0x82,
-	0x87, 0x2d, 0xdb, 0x0f, 0x10, 0x7a, 0x13, 0x0f, 0x45, 0x44, 0xd4, 0xc0, 0x65, 0xfc, 0x99, 0x68,
-	0x93, 0xd5, 0x40, 0x23, 0x43, 0x26, 0x9d, 0xc7, 0x3b, 0xa2, 0xfe, 0x33, 0xa3, 0xeb, 0xfe, 0xc7,
-	0xb6, 0x64, 0xad, 0x3d, 0xc7, 0xeb, 0xe0, 0xf6, 0x05, 0xc2, 0x3b, 0xf3, 0x5d, 0x01, 0x3d, 0x07,
-	0x8f, 0xf0, 0x96, 0xa6, 0x05, 0x3a, 0x6a, 0x23, 0xa6, 0x54, 0xf8, 0x24, 0x08, 0x9c, 0x03, 0xc7,
-	0xa1, 0xe5, 0x6c, 0xb1, 0x74, 0x1b, 0x4f, 0xc4, 0x7b, 0x19, 0x73, 0x9a, 0x13, 0x75, 0xc6, 0xf2,
-	0x9c, 0xd5, 0x76, 0xea, 0x33, 0x95, 0x98, 0xce, 0x74, 0x62, 0xbe, 0x41, 0x78, 0x6f, 0x0b, 0xc1,
-	0x21, 0x3b, 0xef, 0x20, 0x5c, 0x5e, 0x0b, 0xef, 0xdf, 0x59, 0xac, 0x0c, 0xa8, 0xaf, 0x0a, 0x39,
-	0x3a, 0x5c, 0xd4, 0x64, 0x33, 0xe3, 0x40, 0xa2, 0xb6, 0x56, 0xe3, 0x3a, 0xcd, 0x2a, 0x52, 0x09,
-	0x5a, 0x46, 0x2c, 0xd7, 0x51, 0xd3, 0xad, 0xe3, 0x91, 0x0c, 0x19, 0x60, 0x5f, 0xc0, 0x4f, 0xc6,
-	0x6f, 0x36, 0x6c, 0xb0, 0xbb, 0x5a, 0xb9, 0xcd, 0xb0, 0xaf, 0xf6, 0xc6, 0xae, 0xd0, 0x95, 0x24,
-	0xf8, 0xee, 0xe6, 0xa9, 0xcd, 0x5c, 0xc3, 0x0b, 0x9a, 0x18, 0x48, 0xd7, 0x9a, 0xeb, 0x78, 0x8e,
-	0x0e, 0x40, 0x3b, 0x8e, 0x37, 0x57, 0x34, 0xd3, 0x9f, 0x3a, 0x01, 0xd4, 0x88, 0x0c, 0x58, 0xfc,
-	0x29, 0x32, 0x02, 0x34, 0xc7, 0x8c, 0xb0, 0x96, 0x42, 0x7d, 0xe9, 0x55, 0x3c, 0x19, 0x8e, 0x19,
-	0x39, 0x99, 0x35, 0x68, 0x7b, 0x0f, 0xdc, 0xf7, 0x08, 0xcb, 0xad, 0xba, 0x07, 0x2e, 0x1f, 0x20,
-	0x3c, 0xde, 0x5c, 0x22, 0x56, 0xa2, 0x46, 0x0c, 0x1a, 0x3e, 0x80, 0x1b, 0xaa, 0x92, 0x72, 0x35,
-	0x17, 0xd0, 0xf4, 0xaf, 0xc3, 0xf8, 0xff, 0x1c, 0x3b, 0xf9, 0x08, 0xe1, 0xae, 0x60, 0xaa, 0x24,
-	0xfb, 0x85, 0x11, 0xd3, 0x33, 0x77, 0xe9, 0x40, 0x6b, 0xca, 0x01, 0x71, 0x69, 0xcf, 0x7b, 0xbf,
-	0xfd, 0xf3, 0x79, 0xe7, 0x38, 0x19, 0x55, 0xf2, 0x57, 0x10, 0xf2, 0x1d, 0xc2, 0x5b, 0x12, 0x43,
-	0x30, 0x39, 0x94, 0x1f, 0x2a, 0x7b, 0x3e, 0x2f, 0x1d, 0x5e, 0xa7, 0x15, 0x20, 0x9d, 0xe6, 0x48,
-	0x0f, 0x90, 0x7d, 0x42, 0xa4, 0xa9, 0xa9, 0x9e, 0x7c, 0x8b, 0xf0, 0x96, 0xc4, 0x7c, 0x5c, 0x04,
-	0x3a, 0x7b, 0x72, 0x2f, 0x02, 0x2d, 0x18, 0xe8, 0xa5, 0x29, 0x0e, 0x7a, 0x3f, 0xd9, 0x2b, 0x04,
-	0x9d, 0x9c, 0xf7, 0xc9, 0xcf, 0x08, 0x0f, 0x66, 0x4e, 0xc9, 0xe4, 0x44, 0x3e, 0x86, 0xbc, 0xc9,
-	0xbe, 0x74, 0xb2, 0x2d, 0x5b, 0x60, 0x71, 0x8c, 0xb3, 0x98, 0x26, 0x07, 0x85, 0x2c, 0x04, 0xeb,
-	0x00, 0xf9, 0x18, 0xe1, 0xae, 0xa0, 0x2d, 0x16, 0x15, 0x71, 0x53, 0xe3, 0x2f, 0x2a, 0xe2, 0xe6,
-	0xee, 0x2e, 0x4d, 0x70, 0x7c, 0x12, 0x19, 0x13, 0xe2, 0x83, 0x66, 0x4f, 0xbe, 0x44, 0xb8, 0x27,
-	0x36, 0xb6, 0x93, 0x83, 0xf9, 0x71, 0xd2, 0xc3, 0x7f, 0x69, 0x6a, 0x1d, 0x16, 0x00, 0x6f, 0x92,
-	0xc3, 0xdb, 0x43, 0x76, 0x09, 0xe1, 0xc5, 0x57, 0x06, 0xf2, 0x03, 0xc2, 0xfd, 0xa9, 0xc9, 0x9f,
-	0x1c, 0xc9, 0x8f, 0x2b, 0x5a, 0x26, 0x4a, 0x47, 0xd7, 0x6d, 0x07, 0xa8, 0x0f, 0x71, 0xd4, 0x32,
-	0x39, 0x20, 0x44, 0x6d, 0x54, 0x52, 0xfb, 0x07, 0xf9, 0x1a, 0xe1, 0xee, 0x68, 0xc8, 0x27, 0x72,
-	0x7e, 0xf0, 0xe4, 0x4e, 0x51, 0x52, 0x5a, 0xd6, 0x07, 0x90, 0xa7, 0x39, 0xc8, 0x63, 0xe4, 0x88,
-	0x10, 0x64, 0xb4, 0x16, 0x28, 0x77, 0x52, 0x1d, 0xe4, 0x2e, 0xf9, 0x09, 0xe1, 0xbe, 0xe4, 0x60,
-	0x4f, 0x0a, 0xbe, 0x75, 0xc1, 0x5a, 0x51, 0x3a, 0xb2, 0x5e, 0x33, 0xe0, 0x70, 0x96, 0x73, 0x78,
-	0x9e, 0x9c, 0x16, 0x72, 0x48, 0xad, 0x17, 0x99, 0x5c, 0x7e, 0x41, 0xb8, 0x3f, 0x35, 0xd2, 0x17,
-	0xd5, 0x8d, 0x68, 0xa5, 0x28, 0xaa, 0x1b, 0xe1, 0xee, 0x20, 0x9d, 0xe3, 0x74, 0x66, 0xc8, 0x73,
-	0xe2, 0x8e, 0x92, 0x5a, 0x2d, 0x32, 0xf9, 0xfc, 0x8e, 0xf0, 0x40, 0xd6, 0xf0, 0x4d, 0x8e, 0x17,
-	0x55, 0x89, 0x70, 0xa1, 0x28, 0x9d, 0x68, 0xc7, 0xb4, 0x65, 0x62, 0x82, 0x15, 0x43, 0xb9, 0x13,
-	0x9f, 0x67, 0xef, 0x92, 0xbf, 0x11, 0x1e, 0x12, 0x0c, 0xdd, 0xe4, 0xd9, 0xe2, 0xe6, 0x28, 0xde,
-	0x29, 0x4a, 0xa7, 0xda, 0xb4, 0x06, 0x86, 0x17, 0x38, 0xc3, 0x39, 0x32, 0x93, 0xdf, 0x62, 0xb3,
-	0xb6, 0x8c, 0x24, 0xc7, 0x7b, 0x9d, 0x78, 0x5b, 0xde, 0x38, 0x44, 0x66, 0x5a, 0x6a, 0xa8, 0x79,
-	0x5b, 0x45, 0x69, 0x76, 0x23, 0x2e, 0x80, 0xb2, 0xce, 0x29, 0xbf, 0x46, 0x5e, 0x29, 0x6a, 0xd0,
-	0x82, 0xb1, 0x70, 0x35, 0xab, 0x74, 0x93, 0xc9, 0xf8, 0x0a, 0xe1, 0xde, 0xf8, 0x5c, 0x4e, 0xa6,
-	0x5a, 0xbe, 0xa7, 0xe8, 0x7b, 0x9c, 0x5e, 0x8f, 0x09, 0x90, 0x93, 0x39, 0xb9, 0x09, 0xb2, 0xbb,
-	0xa5, 0xfb, 0x74, 0xc9, 0x8f, 0x08, 0x0f, 0x64, 0x8d, 0xfc, 0x45, 0x5f, 0x5c, 0xce, 0x2a, 0x51,
-	0xf4, 0xc5, 0xe5, 0x6d, 0x18, 0xd2, 0x51, 0x8e, 0x7f, 0x8a, 0x28, 0x39, 0x97, 0xc3, 0xcd, 0x55,
-	0x68, 0xa0, 0xc0, 0x84, 0x7c, 0xd8, 0x89, 0xcb, 0xf9, 0x93, 0x3f, 0x39, 0x5b, 0x38, 0x10, 0xb5,
-	0xb4, 0x99, 0x94, 0xce, 0x6d, 0xd8, 0x0f, 0x90, 0xbd, 0xc6, 0xc9, 0x2e, 0x90, 0x4b, 0x6d, 0x56,
-	0xa2, 0x41, 0x33, 0x9f, 0xd1, 0xd9, 0xa5, 0xfb, 0x8f, 0xca, 0xe8, 0xc1, 0xa3, 0x32, 0xfa, 0xeb,
-	0x51, 0x19, 0x7d, 0xfa, 0xb8, 0xdc, 0xf1, 0xe0, 0x71, 0xb9, 0xe3, 0x8f, 0xc7, 0xe5, 0x8e, 0xeb,
-	0x27, 0x63, 0x3f, 0x89, 0xda, 0xd4, 0x71, 0xfd, 0xe9, 0xcd, 0xd2, 0xe9, 0x4b, 0x16, 0x05, 0x08,
-	0x93, 0x96, 0xe6, 0x19, 0x2b, 0x54, 0x59, 0x99, 0x56, 0xde, 0x5e, 0x83, 0xc3, 0x7f, 0x2b, 0xad,
-	0x74, 0xf1, 0xdf, 0xfd, 0x9f, 0xf9, 0x37, 0x00, 0x00, 0xff, 0xff, 0xdc, 0x70, 0x4e, 0x98, 0x59,
-	0x19, 0x00, 0x00,
+func (m *QueryRemainingCapacityRequest) Reset()         { *m = QueryRemainingCapacityRequest{} }
+func (m *QueryRemainingCapacityRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRemainingCapacityRequest) ProtoMessage()    {}
+func (*QueryRemainingCapacityRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_799833dfb4f243e6, []int{32}
+}
+func (m *QueryRemainingCapacityRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRemainingCapacityRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRemainingCapacityRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRemainingCapacityRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRemainingCapacityRequest.Merge(m, src)
+}
+func (m *QueryRemainingCapacityRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRemainingCapacityRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRemainingCapacityRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryRemainingCapacityRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Parameters queries the parameters of the module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	HostChainParams(ctx context.Context, in *QueryHostChainParamsRequest, opts ...grpc.CallOption) (*QueryHostChainParamsResponse, error)
-	DelegationState(ctx context.Context, in *QueryDelegationStateRequest, opts ...grpc.CallOption) (*QueryDelegationStateResponse, error)
-	AllowListedValidators(ctx context.Context, in *QueryAllowListedValidatorsRequest, opts ...grpc.CallOption) (*QueryAllowListedValidatorsResponse, error)
-	CValue(ctx context.Context, in *QueryCValueRequest, opts ...grpc.CallOption) (*QueryCValueResponse, error)
-	ModuleState(ctx context.Context, in *QueryModuleStateRequest, opts ...grpc.CallOption) (*QueryModuleStateResponse, error)
-	IBCTransientStore(ctx context.Context, in *QueryIBCTransientStoreRequest, opts ...grpc.CallOption) (*QueryIBCTransientStoreResponse, error)
-	Unclaimed(ctx context.Context, in *QueryUnclaimedRequest, opts ...grpc.CallOption) (*QueryUnclaimedResponse, error)
-	FailedUnbondings(ctx context.Context, in *QueryFailedUnbondingsRequest, opts ...grpc.CallOption) (*QueryFailedUnbondingsResponse, error)
-	PendingUnbondings(ctx context.Context, in *QueryPendingUnbondingsRequest, opts ...grpc.CallOption) (*QueryPendingUnbondingsResponse, error)
-	UnbondingEpochCValue(ctx context.Context, in *QueryUnbondingEpochCValueRequest, opts ...grpc.CallOption) (*QueryUnbondingEpochCValueResponse, error)
-	HostAccountUndelegation(ctx context.Context, in *QueryHostAccountUndelegationRequest, opts ...grpc.CallOption) (*QueryHostAccountUndelegationResponse, error)
-	DelegatorUnbondingEpochEntry(ctx context.Context, in *QueryDelegatorUnbondingEpochEntryRequest, opts ...grpc.CallOption) (*QueryDelegatorUnbondingEpochEntryResponse, error)
-	HostAccounts(ctx context.Context, in *QueryHostAccountsRequest, opts ...grpc.CallOption) (*QueryHostAccountsResponse, error)
-	DepositModuleAccount(ctx context.Context, in *QueryDepositModuleAccountRequest, opts ...grpc.CallOption) (*QueryDepositModuleAccountResponse, error)
-	DelegatorUnbondingEpochEntries(ctx context.Context, in *QueryAllDelegatorUnbondingEpochEntriesRequest, opts ...grpc.CallOption) (*QueryAllDelegatorUnbondingEpochEntriesResponse, error)
+func (m *QueryRemainingCapacityRequest) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
+	}
+	return ""
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+// QueryRemainingCapacityResponse is a response for the
+// Query/RemainingCapacity methods.
+type QueryRemainingCapacityResponse struct {
+	// remaining is the amount that can still be deposited, only meaningful if
+	// limited is true
+	Remaining github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=remaining,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"remaining"`
+	// limited is false if none of the deposit caps is set
+	Limited         bool                                   `protobuf:"varint,2,opt,name=limited,proto3" json:"limited,omitempty"`
+	Tvl             github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=tvl,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"tvl"`
+	EpochDeposits   github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=epoch_deposits,json=epochDeposits,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"epoch_deposits"`
+	AddressDeposits github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=address_deposits,json=addressDeposits,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"address_deposits"`
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryRemainingCapacityResponse) Reset()         { *m = QueryRemainingCapacityResponse{} }
+func (m *QueryRemainingCapacityResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRemainingCapacityResponse) ProtoMessage()    {}
+func (*QueryRemainingCapacityResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_799833dfb4f243e6, []int{33}
 }
-
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/pstake.lscosmos.v1beta1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryRemainingCapacityResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRemainingCapacityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRemainingCapacityResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryRemainingCapacityResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRemainingCapacityResponse.Merge(m, src)
+}
+func (m *QueryRemainingCapacityResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRemainingCapacityResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRemainingCapacityResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) HostChainParams(ctx context.Context, in *QueryHostChainParamsRequest, opts ...grpc.CallOption) (*QueryHostChainParamsResponse, error) {
-	out := new(QueryHostChainParamsResponse)
-	err := c.cc.Invoke(ctx, "/pstake.lscosmos.v1beta1.Query/HostChainParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryRemainingCapacityResponse proto.InternalMessageInfo
+
+func (m *QueryRemainingCapacityResponse) GetLimited() bool {
+	if m != nil {
+		return m.Limited
 	}
-	return out, nil
+	return false
 }
 
-func (c *queryClient) DelegationState(ctx context.Context, in *QueryDelegationStateRequest, opts ...grpc.CallOption) (*QueryDelegationStateResponse, error) {
-	out := new(QueryDelegationStateResponse)
-	err := c.cc.Invoke(ctx, "/pstake.lscosmos.v1beta1.Query/DelegationState", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryFeeSplitRequest is a request for the Query/FeeSplit methods.
+type QueryFeeSplitRequest struct {
 }
 
-func (c *queryClient) AllowListedValidators(ctx context.Context, in *QueryAllowListedValidatorsRequest, opts ...grpc.CallOption) (*QueryAllowListedValidatorsResponse, error) {
-	out := new(QueryAllowListedValidatorsResponse)
-	err := c.cc.Invoke(ctx, "/pstake.lscosmos.v1beta1.Query/AllowListedValidators", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryFeeSplitRequest) Reset()         { *m = QueryFeeSplitRequest{} }
+func (m *QueryFeeSplitRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeSplitRequest) ProtoMessage()    {}
+func (*QueryFeeSplitRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_799833dfb4f243e6, []int{34}
+}
+func (m *QueryFeeSplitRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeSplitRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeSplitRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryFeeSplitRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeSplitRequest.Merge(m, src)
+}
+func (m *QueryFeeSplitRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeSplitRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeSplitRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) CValue(ctx context.Context, in *QueryCValueRequest, opts ...grpc.CallOption) (*QueryCValueResponse, error) {
-	out := new(QueryCValueResponse)
-	err := c.cc.Invoke(ctx, "/pstake.lscosmos.v1beta1.Query/CValue", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_QueryFeeSplitRequest proto.InternalMessageInfo
+
+// QueryFeeSplitResponse is a response for the Query/FeeSplit methods.
+type QueryFeeSplitResponse struct {
+	FeeSplit FeeSplit `protobuf:"bytes,1,opt,name=fee_split,json=feeSplit,proto3" json:"fee_split"`
 }
 
-func (c *queryClient) ModuleState(ctx context.Context, in *QueryModuleStateRequest, opts ...grpc.CallOption) (*QueryModuleStateResponse, error) {
-	out := new(QueryModuleStateResponse)
-	err := c.cc.Invoke(ctx, "/pstake.lscosmos.v1beta1.Query/ModuleState", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryFeeSplitResponse) Reset()         { *m = QueryFeeSplitResponse{} }
+func (m *QueryFeeSplitResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeSplitResponse) ProtoMessage()    {}
+func (*QueryFeeSplitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_799833dfb4f243e6, []int{35}
+}
+func (m *QueryFeeSplitResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeSplitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeSplitResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryFeeSplitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeSplitResponse.Merge(m, src)
+}
+func (m *QueryFeeSplitResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeSplitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeSplitResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) IBCTransientStore(ctx context.Context, in *QueryIBCTransientStoreRequest, opts ...grpc.CallOption) (*QueryIBCTransientStoreResponse, error) {
-	out := new(QueryIBCTransientStoreResponse)
-	err := c.cc.Invoke(ctx, "/pstake.lscosmos.v1beta1.Query/IBCTransientStore", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryFeeSplitResponse proto.InternalMessageInfo
+
+func (m *QueryFeeSplitResponse) GetFeeSplit() FeeSplit {
+	if m != nil {
+		return m.FeeSplit
 	}
-	return out, nil
+	return FeeSplit{}
 }
 
-func (c *queryClient) Unclaimed(ctx context.Context, in *QueryUnclaimedRequest, opts ...grpc.CallOption) (*QueryUnclaimedResponse, error) {
-	out := new(QueryUnclaimedResponse)
-	err := c.cc.Invoke(ctx, "/pstake.lscosmos.v1beta1.Query/Unclaimed", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryCollectedFeesRequest is a request for the Query/CollectedFees methods,
+// all fee types are returned if fee_type is empty.
+type QueryCollectedFeesRequest struct {
+	FeeType string `protobuf:"bytes,1,opt,name=fee_type,json=feeType,proto3" json:"fee_type,omitempty"`
 }
 
-func (c *queryClient) FailedUnbondings(ctx context.Context, in *QueryFailedUnbondingsRequest, opts ...grpc.CallOption) (*QueryFailedUnbondingsResponse, error) {
-	out := new(QueryFailedUnbondingsResponse)
-	err := c.cc.Invoke(ctx, "/pstake.lscosmos.v1beta1.Query/FailedUnbondings", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryCollectedFeesRequest) Reset()         { *m = QueryCollectedFeesRequest{} }
+func (m *QueryCollectedFeesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCollectedFeesRequest) ProtoMessage()    {}
+func (*QueryCollectedFeesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_799833dfb4f243e6, []int{36}
 }
-
-func (c *queryClient) PendingUnbondings(ctx context.Context, in *QueryPendingUnbondingsRequest, opts ...grpc.CallOption) (*QueryPendingUnbondingsResponse, error) {
-	out := new(QueryPendingUnbondingsResponse)
-	err := c.cc.Invoke(ctx, "/pstake.lscosmos.v1beta1.Query/PendingUnbondings", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryCollectedFeesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCollectedFeesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCollectedFeesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryCollectedFeesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCollectedFeesRequest.Merge(m, src)
+}
+func (m *QueryCollectedFeesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCollectedFeesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCollectedFeesRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) UnbondingEpochCValue(ctx context.Context, in *QueryUnbondingEpochCValueRequest, opts ...grpc.CallOption) (*QueryUnbondingEpochCValueResponse, error) {
-	out := new(QueryUnbondingEpochCValueResponse)
-	err := c.cc.Invoke(ctx, "/pstake.lscosmos.v1beta1.Query/UnbondingEpochCValue", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryCollectedFeesRequest proto.InternalMessageInfo
+
+func (m *QueryCollectedFeesRequest) GetFeeType() string {
+	if m != nil {
+		return m.FeeType
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) HostAccountUndelegation(ctx context.Context, in *QueryHostAccountUndelegationRequest, opts ...grpc.CallOption) (*QueryHostAccountUndelegationResponse, error) {
-	out := new(QueryHostAccountUndelegationResponse)
-	err := c.cc.Invoke(ctx, "/pstake.lscosmos.v1beta1.Query/HostAccountUndelegation", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryCollectedFeesResponse is a response for the Query/CollectedFees
+// methods.
+type QueryCollectedFeesResponse struct {
+	CollectedFees []CollectedFee `protobuf:"bytes,1,rep,name=collected_fees,json=collectedFees,proto3" json:"collected_fees"`
 }
 
-func (c *queryClient) DelegatorUnbondingEpochEntry(ctx context.Context, in *QueryDelegatorUnbondingEpochEntryRequest, opts ...grpc.CallOption) (*QueryDelegatorUnbondingEpochEntryResponse, error) {
-	out := new(QueryDelegatorUnbondingEpochEntryResponse)
-	err := c.cc.Invoke(ctx, "/pstake.lscosmos.v1beta1.Query/DelegatorUnbondingEpochEntry", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryCollectedFeesResponse) Reset()         { *m = QueryCollectedFeesResponse{} }
+func (m *QueryCollectedFeesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCollectedFeesResponse) ProtoMessage()    {}
+func (*QueryCollectedFeesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_799833dfb4f243e6, []int{37}
+}
+func (m *QueryCollectedFeesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCollectedFeesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCollectedFeesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryCollectedFeesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCollectedFeesResponse.Merge(m, src)
+}
+func (m *QueryCollectedFeesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCollectedFeesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCollectedFeesResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) HostAccounts(ctx context.Context, in *QueryHostAccountsRequest, opts ...grpc.CallOption) (*QueryHostAccountsResponse, error) {
-	out := new(QueryHostAccountsResponse)
-	err := c.cc.Invoke(ctx, "/pstake.lscosmos.v1beta1.Query/HostAccounts", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryCollectedFeesResponse proto.InternalMessageInfo
+
+func (m *QueryCollectedFeesResponse) GetCollectedFees() []CollectedFee {
+	if m != nil {
+		return m.CollectedFees
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) DepositModuleAccount(ctx context.Context, in *QueryDepositModuleAccountRequest, opts ...grpc.CallOption) (*QueryDepositModuleAccountResponse, error) {
-	out := new(QueryDepositModuleAccountResponse)
-	err := c.cc.Invoke(ctx, "/pstake.lscosmos.v1beta1.Query/DepositModuleAccount", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryRolesRequest is a request for the Query/Roles methods.
+type QueryRolesRequest struct {
 }
 
-func (c *queryClient) DelegatorUnbondingEpochEntries(ctx context.Context, in *QueryAllDelegatorUnbondingEpochEntriesRequest, opts ...grpc.CallOption) (*QueryAllDelegatorUnbondingEpochEntriesResponse, error) {
-	out := new(QueryAllDelegatorUnbondingEpochEntriesResponse)
-	err := c.cc.Invoke(ctx, "/pstake.lscosmos.v1beta1.Query/DelegatorUnbondingEpochEntries", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryRolesRequest) Reset()         { *m = QueryRolesRequest{} }
+func (m *QueryRolesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRolesRequest) ProtoMessage()    {}
+func (*QueryRolesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_799833dfb4f243e6, []int{38}
+}
+func (m *QueryRolesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRolesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRolesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Parameters queries the parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	HostChainParams(context.Context, *QueryHostChainParamsRequest) (*QueryHostChainParamsResponse, error)
-	DelegationState(context.Context, *QueryDelegationStateRequest) (*QueryDelegationStateResponse, error)
-	AllowListedValidators(context.Context, *QueryAllowListedValidatorsRequest) (*QueryAllowListedValidatorsResponse, error)
-	CValue(context.Context, *QueryCValueRequest) (*QueryCValueResponse, error)
-	ModuleState(context.Context, *QueryModuleStateRequest) (*QueryModuleStateResponse, error)
-	IBCTransientStore(context.Context, *QueryIBCTransientStoreRequest) (*QueryIBCTransientStoreResponse, error)
-	Unclaimed(context.Context, *QueryUnclaimedRequest) (*QueryUnclaimedResponse, error)
-	FailedUnbondings(context.Context, *QueryFailedUnbondingsRequest) (*QueryFailedUnbondingsResponse, error)
-	PendingUnbondings(context.Context, *QueryPendingUnbondingsRequest) (*QueryPendingUnbondingsResponse, error)
-	UnbondingEpochCValue(context.Context, *QueryUnbondingEpochCValueRequest) (*QueryUnbondingEpochCValueResponse, error)
-	HostAccountUndelegation(context.Context, *QueryHostAccountUndelegationRequest) (*QueryHostAccountUndelegationResponse, error)
-	DelegatorUnbondingEpochEntry(context.Context, *QueryDelegatorUnbondingEpochEntryRequest) (*QueryDelegatorUnbondingEpochEntryResponse, error)
-	HostAccounts(context.Context, *QueryHostAccountsRequest) (*QueryHostAccountsResponse, error)
-	DepositModuleAccount(context.Context, *QueryDepositModuleAccountRequest) (*QueryDepositModuleAccountResponse, error)
-	DelegatorUnbondingEpochEntries(context.Context, *QueryAllDelegatorUnbondingEpochEntriesRequest) (*QueryAllDelegatorUnbondingEpochEntriesResponse, error)
+func (m *QueryRolesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRolesRequest.Merge(m, src)
 }
-
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryRolesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRolesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRolesRequest.DiscardUnknown(m)
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+var xxx_messageInfo_QueryRolesRequest proto.InternalMessageInfo
+
+// QueryRolesResponse is a response for the Query/Roles methods.
+type QueryRolesResponse struct {
+	Roles              Roles               `protobuf:"bytes,1,opt,name=roles,proto3" json:"roles"`
+	PendingAdminChange *PendingAdminChange `protobuf:"bytes,2,opt,name=pending_admin_change,json=pendingAdminChange,proto3" json:"pending_admin_change,omitempty"`
 }
-func (*UnimplementedQueryServer) HostChainParams(ctx context.Context, req *QueryHostChainParamsRequest) (*QueryHostChainParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method HostChainParams not implemented")
+
+func (m *QueryRolesResponse) Reset()         { *m = QueryRolesResponse{} }
+func (m *QueryRolesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRolesResponse) ProtoMessage()    {}
+func (*QueryRolesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_799833dfb4f243e6, []int{39}
 }
-func (*UnimplementedQueryServer) DelegationState(ctx context.Context, req *QueryDelegationStateRequest) (*QueryDelegationStateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DelegationState not implemented")
+func (m *QueryRolesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) AllowListedValidators(ctx context.Context, req *QueryAllowListedValidatorsRequest) (*QueryAllowListedValidatorsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AllowListedValidators not implemented")
+func (m *QueryRolesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRolesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) CValue(ctx context.Context, req *QueryCValueRequest) (*QueryCValueResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CValue not implemented")
+func (m *QueryRolesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRolesResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) ModuleState(ctx context.Context, req *QueryModuleStateRequest) (*QueryModuleStateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ModuleState not implemented")
+func (m *QueryRolesResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) IBCTransientStore(ctx context.Context, req *QueryIBCTransientStoreRequest) (*QueryIBCTransientStoreResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method IBCTransientStore not implemented")
+func (m *QueryRolesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRolesResponse.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) Unclaimed(ctx context.Context, req *QueryUnclaimedRequest) (*QueryUnclaimedResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Unclaimed not implemented")
+
+var xxx_messageInfo_QueryRolesResponse proto.InternalMessageInfo
+
+func (m *QueryRolesResponse) GetRoles() Roles {
+	if m != nil {
+		return m.Roles
+	}
+	return Roles{}
 }
-func (*UnimplementedQueryServer) FailedUnbondings(ctx context.Context, req *QueryFailedUnbondingsRequest) (*QueryFailedUnbondingsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FailedUnbondings not implemented")
+
+func (m *QueryRolesResponse) GetPendingAdminChange() *PendingAdminChange {
+	if m != nil {
+		return m.PendingAdminChange
+	}
+	return nil
 }
-func (*UnimplementedQueryServer) PendingUnbondings(ctx context.Context, req *QueryPendingUnbondingsRequest) (*QueryPendingUnbondingsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PendingUnbondings not implemented")
+
+// QueryModuleStatusRequest is a request for the Query/ModuleStatus methods.
+type QueryModuleStatusRequest struct {
 }
-func (*UnimplementedQueryServer) UnbondingEpochCValue(ctx context.Context, req *QueryUnbondingEpochCValueRequest) (*QueryUnbondingEpochCValueResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UnbondingEpochCValue not implemented")
+
+func (m *QueryModuleStatusRequest) Reset()         { *m = QueryModuleStatusRequest{} }
+func (m *QueryModuleStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryModuleStatusRequest) ProtoMessage()    {}
+func (*QueryModuleStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_799833dfb4f243e6, []int{40}
 }
-func (*UnimplementedQueryServer) HostAccountUndelegation(ctx context.Context, req *QueryHostAccountUndelegationRequest) (*QueryHostAccountUndelegationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method HostAccountUndelegation not implemented")
+func (m *QueryModuleStatusRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) DelegatorUnbondingEpochEntry(ctx context.Context, req *QueryDelegatorUnbondingEpochEntryRequest) (*QueryDelegatorUnbondingEpochEntryResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DelegatorUnbondingEpochEntry not implemented")
+func (m *QueryModuleStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryModuleStatusRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) HostAccounts(ctx context.Context, req *QueryHostAccountsRequest) (*QueryHostAccountsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method HostAccounts not implemented")
+func (m *QueryModuleStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryModuleStatusRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) DepositModuleAccount(ctx context.Context, req *QueryDepositModuleAccountRequest) (*QueryDepositModuleAccountResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DepositModuleAccount not implemented")
+func (m *QueryModuleStatusRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) DelegatorUnbondingEpochEntries(ctx context.Context, req *QueryAllDelegatorUnbondingEpochEntriesRequest) (*QueryAllDelegatorUnbondingEpochEntriesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DelegatorUnbondingEpochEntries not implemented")
+func (m *QueryModuleStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryModuleStatusRequest.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+var xxx_messageInfo_QueryModuleStatusRequest proto.InternalMessageInfo
+
+// QueryModuleStatusResponse is a response for the Query/ModuleStatus methods.
+type QueryModuleStatusResponse struct {
+	ModuleEnabled bool          `protobuf:"varint,1,opt,name=module_enabled,json=moduleEnabled,proto3" json:"module_enabled,omitempty"`
+	PauseSwitches PauseSwitches `protobuf:"bytes,2,opt,name=pause_switches,json=pauseSwitches,proto3" json:"pause_switches"`
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pstake.lscosmos.v1beta1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+func (m *QueryModuleStatusResponse) Reset()         { *m = QueryModuleStatusResponse{} }
+func (m *QueryModuleStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryModuleStatusResponse) ProtoMessage()    {}
+func (*QueryModuleStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_799833dfb4f243e6, []int{41}
+}
+func (m *QueryModuleStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryModuleStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryModuleStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryModuleStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryModuleStatusResponse.Merge(m, src)
+}
+func (m *QueryModuleStatusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryModuleStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryModuleStatusResponse.DiscardUnknown(m)
 }
 
-func _Query_HostChainParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryHostChainParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).HostChainParams(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pstake.lscosmos.v1beta1.Query/HostChainParams",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).HostChainParams(ctx, req.(*QueryHostChainParamsRequest))
+var xxx_messageInfo_QueryModuleStatusResponse proto.InternalMessageInfo
+
+func (m *QueryModuleStatusResponse) GetModuleEnabled() bool {
+	if m != nil {
+		return m.ModuleEnabled
 	}
-	return interceptor(ctx, in, info, handler)
+	return false
 }
 
-func _Query_DelegationState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryDelegationStateRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).DelegationState(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pstake.lscosmos.v1beta1.Query/DelegationState",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).DelegationState(ctx, req.(*QueryDelegationStateRequest))
+func (m *QueryModuleStatusResponse) GetPauseSwitches() PauseSwitches {
+	if m != nil {
+		return m.PauseSwitches
 	}
-	return interceptor(ctx, in, info, handler)
+	return PauseSwitches{}
 }
 
-func _Query_AllowListedValidators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllowListedValidatorsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).AllowListedValidators(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pstake.lscosmos.v1beta1.Query/AllowListedValidators",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AllowListedValidators(ctx, req.(*QueryAllowListedValidatorsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryUnbondingEpochCValuesRequest is a request for the
+// Query/UnbondingEpochCValues methods, both epochs are included.
+type QueryUnbondingEpochCValuesRequest struct {
+	StartEpoch int64 `protobuf:"varint,1,opt,name=start_epoch,json=startEpoch,proto3" json:"start_epoch,omitempty"`
+	EndEpoch   int64 `protobuf:"varint,2,opt,name=end_epoch,json=endEpoch,proto3" json:"end_epoch,omitempty"`
 }
 
-func _Query_CValue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryCValueRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).CValue(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pstake.lscosmos.v1beta1.Query/CValue",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).CValue(ctx, req.(*QueryCValueRequest))
+func (m *QueryUnbondingEpochCValuesRequest) Reset()         { *m = QueryUnbondingEpochCValuesRequest{} }
+func (m *QueryUnbondingEpochCValuesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryUnbondingEpochCValuesRequest) ProtoMessage()    {}
+func (*QueryUnbondingEpochCValuesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_799833dfb4f243e6, []int{42}
+}
+func (m *QueryUnbondingEpochCValuesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUnbondingEpochCValuesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUnbondingEpochCValuesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryUnbondingEpochCValuesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUnbondingEpochCValuesRequest.Merge(m, src)
+}
+func (m *QueryUnbondingEpochCValuesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUnbondingEpochCValuesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUnbondingEpochCValuesRequest.DiscardUnknown(m)
 }
 
-func _Query_ModuleState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryModuleStateRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ModuleState(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pstake.lscosmos.v1beta1.Query/ModuleState",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ModuleState(ctx, req.(*QueryModuleStateRequest))
+var xxx_messageInfo_QueryUnbondingEpochCValuesRequest proto.InternalMessageInfo
+
+func (m *QueryUnbondingEpochCValuesRequest) GetStartEpoch() int64 {
+	if m != nil {
+		return m.StartEpoch
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_IBCTransientStore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryIBCTransientStoreRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).IBCTransientStore(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pstake.lscosmos.v1beta1.Query/IBCTransientStore",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).IBCTransientStore(ctx, req.(*QueryIBCTransientStoreRequest))
+func (m *QueryUnbondingEpochCValuesRequest) GetEndEpoch() int64 {
+	if m != nil {
+		return m.EndEpoch
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_Unclaimed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryUnclaimedRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Unclaimed(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pstake.lscosmos.v1beta1.Query/Unclaimed",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Unclaimed(ctx, req.(*QueryUnclaimedRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryUnbondingEpochCValuesResponse is a response for the
+// Query/UnbondingEpochCValues methods.
+type QueryUnbondingEpochCValuesResponse struct {
+	UnbondingEpochCValues []UnbondingEpochCValue `protobuf:"bytes,1,rep,name=unbonding_epoch_c_values,json=unbondingEpochCValues,proto3" json:"unbonding_epoch_c_values"`
 }
 
-func _Query_FailedUnbondings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryFailedUnbondingsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).FailedUnbondings(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pstake.lscosmos.v1beta1.Query/FailedUnbondings",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).FailedUnbondings(ctx, req.(*QueryFailedUnbondingsRequest))
+func (m *QueryUnbondingEpochCValuesResponse) Reset()         { *m = QueryUnbondingEpochCValuesResponse{} }
+func (m *QueryUnbondingEpochCValuesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryUnbondingEpochCValuesResponse) ProtoMessage()    {}
+func (*QueryUnbondingEpochCValuesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_799833dfb4f243e6, []int{43}
+}
+func (m *QueryUnbondingEpochCValuesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUnbondingEpochCValuesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUnbondingEpochCValuesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryUnbondingEpochCValuesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUnbondingEpochCValuesResponse.Merge(m, src)
+}
+func (m *QueryUnbondingEpochCValuesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUnbondingEpochCValuesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUnbondingEpochCValuesResponse.DiscardUnknown(m)
 }
 
-func _Query_PendingUnbondings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPendingUnbondingsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).PendingUnbondings(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pstake.lscosmos.v1beta1.Query/PendingUnbondings",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).PendingUnbondings(ctx, req.(*QueryPendingUnbondingsRequest))
+var xxx_messageInfo_QueryUnbondingEpochCValuesResponse proto.InternalMessageInfo
+
+func (m *QueryUnbondingEpochCValuesResponse) GetUnbondingEpochCValues() []UnbondingEpochCValue {
+	if m != nil {
+		return m.UnbondingEpochCValues
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_UnbondingEpochCValue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryUnbondingEpochCValueRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).UnbondingEpochCValue(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pstake.lscosmos.v1beta1.Query/UnbondingEpochCValue",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).UnbondingEpochCValue(ctx, req.(*QueryUnbondingEpochCValueRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryDelegatorUnbondingEpochEntriesInRangeRequest is a request for the
+// Query/DelegatorUnbondingEpochEntriesInRange methods, both epochs are
+// included.
+type QueryDelegatorUnbondingEpochEntriesInRangeRequest struct {
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	StartEpoch       int64  `protobuf:"varint,2,opt,name=start_epoch,json=startEpoch,proto3" json:"start_epoch,omitempty"`
+	EndEpoch         int64  `protobuf:"varint,3,opt,name=end_epoch,json=endEpoch,proto3" json:"end_epoch,omitempty"`
 }
 
-func _Query_HostAccountUndelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryHostAccountUndelegationRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).HostAccountUndelegation(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pstake.lscosmos.v1beta1.Query/HostAccountUndelegation",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).HostAccountUndelegation(ctx, req.(*QueryHostAccountUndelegationRequest))
+func (m *QueryDelegatorUnbondingEpochEntriesInRangeRequest) Reset() {
+	*m = QueryDelegatorUnbondingEpochEntriesInRangeRequest{}
+}
+func (m *QueryDelegatorUnbondingEpochEntriesInRangeRequest) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryDelegatorUnbondingEpochEntriesInRangeRequest) ProtoMessage() {}
+func (*QueryDelegatorUnbondingEpochEntriesInRangeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_799833dfb4f243e6, []int{44}
+}
+func (m *QueryDelegatorUnbondingEpochEntriesInRangeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelegatorUnbondingEpochEntriesInRangeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegatorUnbondingEpochEntriesInRangeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryDelegatorUnbondingEpochEntriesInRangeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegatorUnbondingEpochEntriesInRangeRequest.Merge(m, src)
+}
+func (m *QueryDelegatorUnbondingEpochEntriesInRangeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegatorUnbondingEpochEntriesInRangeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegatorUnbondingEpochEntriesInRangeRequest.DiscardUnknown(m)
 }
 
-func _Query_DelegatorUnbondingEpochEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryDelegatorUnbondingEpochEntryRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).DelegatorUnbondingEpochEntry(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pstake.lscosmos.v1beta1.Query/DelegatorUnbondingEpochEntry",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).DelegatorUnbondingEpochEntry(ctx, req.(*QueryDelegatorUnbondingEpochEntryRequest))
+var xxx_messageInfo_QueryDelegatorUnbondingEpochEntriesInRangeRequest proto.InternalMessageInfo
+
+func (m *QueryDelegatorUnbondingEpochEntriesInRangeRequest) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_HostAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryHostAccountsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).HostAccounts(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pstake.lscosmos.v1beta1.Query/HostAccounts",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).HostAccounts(ctx, req.(*QueryHostAccountsRequest))
+func (m *QueryDelegatorUnbondingEpochEntriesInRangeRequest) GetStartEpoch() int64 {
+	if m != nil {
+		return m.StartEpoch
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_DepositModuleAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryDepositModuleAccountRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).DepositModuleAccount(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pstake.lscosmos.v1beta1.Query/DepositModuleAccount",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).DepositModuleAccount(ctx, req.(*QueryDepositModuleAccountRequest))
+func (m *QueryDelegatorUnbondingEpochEntriesInRangeRequest) GetEndEpoch() int64 {
+	if m != nil {
+		return m.EndEpoch
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_DelegatorUnbondingEpochEntries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllDelegatorUnbondingEpochEntriesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).DelegatorUnbondingEpochEntries(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pstake.lscosmos.v1beta1.Query/DelegatorUnbondingEpochEntries",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).DelegatorUnbondingEpochEntries(ctx, req.(*QueryAllDelegatorUnbondingEpochEntriesRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryDelegatorUnbondingEpochEntriesInRangeResponse is a response for the
+// Query/DelegatorUnbondingEpochEntriesInRange methods.
+type QueryDelegatorUnbondingEpochEntriesInRangeResponse struct {
+	DelegatorUnbondingEpochEntries []DelegatorUnbondingEpochEntry `protobuf:"bytes,1,rep,name=delegator_unbonding_epoch_entries,json=delegatorUnbondingEpochEntries,proto3" json:"delegator_unbonding_epoch_entries"`
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "pstake.lscosmos.v1beta1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "HostChainParams",
-			Handler:    _Query_HostChainParams_Handler,
-		},
-		{
-			MethodName: "DelegationState",
-			Handler:    _Query_DelegationState_Handler,
-		},
-		{
-			MethodName: "AllowListedValidators",
-			Handler:    _Query_AllowListedValidators_Handler,
-		},
-		{
-			MethodName: "CValue",
-			Handler:    _Query_CValue_Handler,
-		},
-		{
-			MethodName: "ModuleState",
-			Handler:    _Query_ModuleState_Handler,
-		},
-		{
-			MethodName: "IBCTransientStore",
-			Handler:    _Query_IBCTransientStore_Handler,
-		},
-		{
-			MethodName: "Unclaimed",
-			Handler:    _Query_Unclaimed_Handler,
-		},
-		{
-			MethodName: "FailedUnbondings",
-			Handler:    _Query_FailedUnbondings_Handler,
-		},
-		{
-			MethodName: "PendingUnbondings",
-			Handler:    _Query_PendingUnbondings_Handler,
-		},
-		{
-			MethodName: "UnbondingEpochCValue",
-			Handler:    _Query_UnbondingEpochCValue_Handler,
-		},
-		{
-			MethodName: "HostAccountUndelegation",
-			Handler:    _Query_HostAccountUndelegation_Handler,
-		},
-		{
-			MethodName: "DelegatorUnbondingEpochEntry",
-			Handler:    _Query_DelegatorUnbondingEpochEntry_Handler,
-		},
-		{
-			MethodName: "HostAccounts",
-			Handler:    _Query_HostAccounts_Handler,
-		},
-		{
-			MethodName: "DepositModuleAccount",
-			Handler:    _Query_DepositModuleAccount_Handler,
-		},
-		{
-			MethodName: "DelegatorUnbondingEpochEntries",
-			Handler:    _Query_DelegatorUnbondingEpochEntries_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "pstake/lscosmos/v1beta1/query.proto",
+func (m *QueryDelegatorUnbondingEpochEntriesInRangeResponse) Reset() {
+	*m = QueryDelegatorUnbondingEpochEntriesInRangeResponse{}
 }
-
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryDelegatorUnbondingEpochEntriesInRangeResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryDelegatorUnbondingEpochEntriesInRangeResponse) ProtoMessage() {}
+func (*QueryDelegatorUnbondingEpochEntriesInRangeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_799833dfb4f243e6, []int{45}
+}
+func (m *QueryDelegatorUnbondingEpochEntriesInRangeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelegatorUnbondingEpochEntriesInRangeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegatorUnbondingEpochEntriesInRangeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryDelegatorUnbondingEpochEntriesInRangeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegatorUnbondingEpochEntriesInRangeResponse.Merge(m, src)
 }
-
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryDelegatorUnbondingEpochEntriesInRangeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegatorUnbondingEpochEntriesInRangeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegatorUnbondingEpochEntriesInRangeResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryDelegatorUnbondingEpochEntriesInRangeResponse proto.InternalMessageInfo
+
+func (m *QueryDelegatorUnbondingEpochEntriesInRangeResponse) GetDelegatorUnbondingEpochEntries() []DelegatorUnbondingEpochEntry {
+	if m != nil {
+		return m.DelegatorUnbondingEpochEntries
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryHostAccountDelegationsRequest is a request for the
+// Query/HostAccountDelegations methods.
+type QueryHostAccountDelegationsRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryHostAccountDelegationsRequest) Reset()         { *m = QueryHostAccountDelegationsRequest{} }
+func (m *QueryHostAccountDelegationsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryHostAccountDelegationsRequest) ProtoMessage()    {}
+func (*QueryHostAccountDelegationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_799833dfb4f243e6, []int{46}
+}
+func (m *QueryHostAccountDelegationsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHostAccountDelegationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHostAccountDelegationsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryHostChainParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryHostAccountDelegationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHostAccountDelegationsRequest.Merge(m, src)
 }
-
-func (m *QueryHostChainParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryHostAccountDelegationsRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryHostChainParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryHostAccountDelegationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHostAccountDelegationsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryHostChainParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryHostAccountDelegationsRequest proto.InternalMessageInfo
+
+func (m *QueryHostAccountDelegationsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryHostChainParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryHostAccountDelegationsResponse is a response for the
+// Query/HostAccountDelegations methods.
+type QueryHostAccountDelegationsResponse struct {
+	HostAccountDelegations []HostAccountDelegation `protobuf:"bytes,1,rep,name=host_account_delegations,json=hostAccountDelegations,proto3" json:"host_account_delegations"`
+	Pagination             *query.PageResponse     `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryHostChainParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.HostChainParams.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryHostAccountDelegationsResponse) Reset()         { *m = QueryHostAccountDelegationsResponse{} }
+func (m *QueryHostAccountDelegationsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryHostAccountDelegationsResponse) ProtoMessage()    {}
+func (*QueryHostAccountDelegationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_799833dfb4f243e6, []int{47}
+}
+func (m *QueryHostAccountDelegationsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHostAccountDelegationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHostAccountDelegationsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryHostAccountDelegationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHostAccountDelegationsResponse.Merge(m, src)
+}
+func (m *QueryHostAccountDelegationsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHostAccountDelegationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHostAccountDelegationsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryDelegationStateRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryHostAccountDelegationsResponse proto.InternalMessageInfo
+
+func (m *QueryHostAccountDelegationsResponse) GetHostAccountDelegations() []HostAccountDelegation {
+	if m != nil {
+		return m.HostAccountDelegations
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryDelegationStateRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryHostAccountDelegationsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryDelegationStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// QueryHostAccountDelegationRequest is a request for the
+// Query/HostAccountDelegation methods.
+type QueryHostAccountDelegationRequest struct {
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
 }
 
-func (m *QueryDelegationStateResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryHostAccountDelegationRequest) Reset()         { *m = QueryHostAccountDelegationRequest{} }
+func (m *QueryHostAccountDelegationRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryHostAccountDelegationRequest) ProtoMessage()    {}
+func (*QueryHostAccountDelegationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_799833dfb4f243e6, []int{48}
+}
+func (m *QueryHostAccountDelegationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHostAccountDelegationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHostAccountDelegationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryDelegationStateResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryHostAccountDelegationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHostAccountDelegationRequest.Merge(m, src)
+}
+func (m *QueryHostAccountDelegationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHostAccountDelegationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHostAccountDelegationRequest.DiscardUnknown(m)
 }
 
-func (m *QueryDelegationStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.DelegationState.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
+var xxx_messageInfo_QueryHostAccountDelegationRequest proto.InternalMessageInfo
 
-func (m *QueryAllowListedValidatorsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryHostAccountDelegationRequest) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryAllowListedValidatorsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryHostAccountDelegationResponse is a response for the
+// Query/HostAccountDelegation methods.
+type QueryHostAccountDelegationResponse struct {
+	HostAccountDelegation HostAccountDelegation `protobuf:"bytes,1,opt,name=host_account_delegation,json=hostAccountDelegation,proto3" json:"host_account_delegation"`
 }
 
-func (m *QueryAllowListedValidatorsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryHostAccountDelegationResponse) Reset()         { *m = QueryHostAccountDelegationResponse{} }
+func (m *QueryHostAccountDelegationResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryHostAccountDelegationResponse) ProtoMessage()    {}
+func (*QueryHostAccountDelegationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_799833dfb4f243e6, []int{49}
+}
+func (m *QueryHostAccountDelegationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHostAccountDelegationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHostAccountDelegationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryHostAccountDelegationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHostAccountDelegationResponse.Merge(m, src)
+}
+func (m *QueryHostAccountDelegationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHostAccountDelegationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHostAccountDelegationResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAllowListedValidatorsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryHostAccountDelegationResponse proto.InternalMessageInfo
+
+func (m *QueryHostAccountDelegationResponse) GetHostAccountDelegation() HostAccountDelegation {
+	if m != nil {
+		return m.HostAccountDelegation
 	}
-	return dAtA[:n], nil
+	return HostAccountDelegation{}
 }
 
-func (m *QueryAllowListedValidatorsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryUnbondingEpochDelegatorEntriesRequest is a request for the
+// Query/UnbondingEpochDelegatorEntries methods.
+type QueryUnbondingEpochDelegatorEntriesRequest struct {
+	EpochNumber int64              `protobuf:"varint,1,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
+	Pagination  *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryAllowListedValidatorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.AllowListedValidators.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryUnbondingEpochDelegatorEntriesRequest) Reset() {
+	*m = QueryUnbondingEpochDelegatorEntriesRequest{}
+}
+func (m *QueryUnbondingEpochDelegatorEntriesRequest) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryUnbondingEpochDelegatorEntriesRequest) ProtoMessage() {}
+func (*QueryUnbondingEpochDelegatorEntriesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_799833dfb4f243e6, []int{50}
+}
+func (m *QueryUnbondingEpochDelegatorEntriesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUnbondingEpochDelegatorEntriesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUnbondingEpochDelegatorEntriesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryUnbondingEpochDelegatorEntriesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUnbondingEpochDelegatorEntriesRequest.Merge(m, src)
+}
+func (m *QueryUnbondingEpochDelegatorEntriesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUnbondingEpochDelegatorEntriesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUnbondingEpochDelegatorEntriesRequest.DiscardUnknown(m)
 }
 
-func (m *QueryCValueRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryUnbondingEpochDelegatorEntriesRequest proto.InternalMessageInfo
+
+func (m *QueryUnbondingEpochDelegatorEntriesRequest) GetEpochNumber() int64 {
+	if m != nil {
+		return m.EpochNumber
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryCValueRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryUnbondingEpochDelegatorEntriesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryCValueRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// QueryUnbondingEpochDelegatorEntriesResponse is a response for the
+// Query/UnbondingEpochDelegatorEntries methods.
+type QueryUnbondingEpochDelegatorEntriesResponse struct {
+	DelegatorUnbondingEpochEntries []DelegatorUnbondingEpochEntry `protobuf:"bytes,1,rep,name=delegator_unbonding_epoch_entries,json=delegatorUnbondingEpochEntries,proto3" json:"delegator_unbonding_epoch_entries"`
+	Pagination                     *query.PageResponse            `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryCValueResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryUnbondingEpochDelegatorEntriesResponse) Reset() {
+	*m = QueryUnbondingEpochDelegatorEntriesResponse{}
+}
+func (m *QueryUnbondingEpochDelegatorEntriesResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryUnbondingEpochDelegatorEntriesResponse) ProtoMessage() {}
+func (*QueryUnbondingEpochDelegatorEntriesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_799833dfb4f243e6, []int{51}
+}
+func (m *QueryUnbondingEpochDelegatorEntriesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUnbondingEpochDelegatorEntriesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUnbondingEpochDelegatorEntriesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryCValueResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryUnbondingEpochDelegatorEntriesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUnbondingEpochDelegatorEntriesResponse.Merge(m, src)
+}
+func (m *QueryUnbondingEpochDelegatorEntriesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUnbondingEpochDelegatorEntriesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUnbondingEpochDelegatorEntriesResponse.DiscardUnknown(m)
 }
 
-func (m *QueryCValueResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.CValue.Size()
-		i -= size
-		if _, err := m.CValue.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+var xxx_messageInfo_QueryUnbondingEpochDelegatorEntriesResponse proto.InternalMessageInfo
+
+func (m *QueryUnbondingEpochDelegatorEntriesResponse) GetDelegatorUnbondingEpochEntries() []DelegatorUnbondingEpochEntry {
+	if m != nil {
+		return m.DelegatorUnbondingEpochEntries
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryModuleStateRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryUnbondingEpochDelegatorEntriesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryModuleStateRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryValidatorWeightsRequest is a request for the Query/ValidatorWeights
+// methods.
+type QueryValidatorWeightsRequest struct {
+	// only offset based pagination is supported
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryModuleStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryValidatorWeightsRequest) Reset()         { *m = QueryValidatorWeightsRequest{} }
+func (m *QueryValidatorWeightsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorWeightsRequest) ProtoMessage()    {}
+func (*QueryValidatorWeightsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_799833dfb4f243e6, []int{52}
+}
+func (m *QueryValidatorWeightsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorWeightsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorWeightsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryValidatorWeightsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorWeightsRequest.Merge(m, src)
+}
+func (m *QueryValidatorWeightsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorWeightsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorWeightsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryModuleStateResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryValidatorWeightsRequest proto.InternalMessageInfo
+
+func (m *QueryValidatorWeightsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryModuleStateResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryValidatorWeightsResponse is a response for the Query/ValidatorWeights
+// methods.
+type QueryValidatorWeightsResponse struct {
+	ValidatorWeights []ValidatorWeight   `protobuf:"bytes,1,rep,name=validator_weights,json=validatorWeights,proto3" json:"validator_weights"`
+	Pagination       *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryModuleStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ModuleState {
-		i--
-		if m.ModuleState {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *QueryValidatorWeightsResponse) Reset()         { *m = QueryValidatorWeightsResponse{} }
+func (m *QueryValidatorWeightsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorWeightsResponse) ProtoMessage()    {}
+func (*QueryValidatorWeightsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_799833dfb4f243e6, []int{53}
+}
+func (m *QueryValidatorWeightsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorWeightsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorWeightsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryValidatorWeightsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorWeightsResponse.Merge(m, src)
+}
+func (m *QueryValidatorWeightsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorWeightsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorWeightsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryIBCTransientStoreRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryValidatorWeightsResponse proto.InternalMessageInfo
+
+func (m *QueryValidatorWeightsResponse) GetValidatorWeights() []ValidatorWeight {
+	if m != nil {
+		return m.ValidatorWeights
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryIBCTransientStoreRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryValidatorWeightsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryIBCTransientStoreRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// QueryRewardEpochRecordsRequest is a request for the Query/RewardEpochRecords
+// methods.
+type QueryRewardEpochRecordsRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryIBCTransientStoreResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryRewardEpochRecordsRequest) Reset()         { *m = QueryRewardEpochRecordsRequest{} }
+func (m *QueryRewardEpochRecordsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRewardEpochRecordsRequest) ProtoMessage()    {}
+func (*QueryRewardEpochRecordsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_799833dfb4f243e6, []int{54}
 }
-
-func (m *QueryIBCTransientStoreResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryRewardEpochRecordsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *QueryIBCTransientStoreResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.IBCTransientStore.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryRewardEpochRecordsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRewardEpochRecordsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryRewardEpochRecordsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRewardEpochRecordsRequest.Merge(m, src)
+}
+func (m *QueryRewardEpochRecordsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRewardEpochRecordsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRewardEpochRecordsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryUnclaimedRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryRewardEpochRecordsRequest proto.InternalMessageInfo
+
+func (m *QueryRewardEpochRecordsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryUnclaimedRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryRewardEpochRecordsResponse is a response for the
+// Query/RewardEpochRecords methods.
+type QueryRewardEpochRecordsResponse struct {
+	// reward_epoch_records are the accrued and restaked rewards per reward epoch
+	RewardEpochRecords []RewardEpochRecord `protobuf:"bytes,1,rep,name=reward_epoch_records,json=rewardEpochRecords,proto3" json:"reward_epoch_records"`
+	// carried_over_rewards is the rewards currently left in the rewards account
+	CarriedOverRewards types.Coin          `protobuf:"bytes,2,opt,name=carried_over_rewards,json=carriedOverRewards,proto3" json:"carried_over_rewards"`
+	Pagination         *query.PageResponse `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryUnclaimedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.DelegatorAddress) > 0 {
-		i -= len(m.DelegatorAddress)
-		copy(dAtA[i:], m.DelegatorAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *QueryRewardEpochRecordsResponse) Reset()         { *m = QueryRewardEpochRecordsResponse{} }
+func (m *QueryRewardEpochRecordsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRewardEpochRecordsResponse) ProtoMessage()    {}
+func (*QueryRewardEpochRecordsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_799833dfb4f243e6, []int{55}
 }
-
-func (m *QueryUnclaimedResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryRewardEpochRecordsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRewardEpochRecordsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRewardEpochRecordsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryRewardEpochRecordsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRewardEpochRecordsResponse.Merge(m, src)
+}
+func (m *QueryRewardEpochRecordsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRewardEpochRecordsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRewardEpochRecordsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryUnclaimedResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryRewardEpochRecordsResponse proto.InternalMessageInfo
+
+func (m *QueryRewardEpochRecordsResponse) GetRewardEpochRecords() []RewardEpochRecord {
+	if m != nil {
+		return m.RewardEpochRecords
+	}
+	return nil
 }
 
-func (m *QueryUnclaimedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Unclaimed) > 0 {
-		for iNdEx := len(m.Unclaimed) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Unclaimed[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (m *QueryRewardEpochRecordsResponse) GetCarriedOverRewards() types.Coin {
+	if m != nil {
+		return m.CarriedOverRewards
 	}
-	return len(dAtA) - i, nil
+	return types.Coin{}
 }
 
-func (m *QueryFailedUnbondingsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryRewardEpochRecordsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryFailedUnbondingsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryHostProposalsRequest is a request for the Query/HostProposals methods.
+type QueryHostProposalsRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryFailedUnbondingsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.DelegatorAddress) > 0 {
-		i -= len(m.DelegatorAddress)
-		copy(dAtA[i:], m.DelegatorAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryHostProposalsRequest) Reset()         { *m = QueryHostProposalsRequest{} }
+func (m *QueryHostProposalsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryHostProposalsRequest) ProtoMessage()    {}
+func (*QueryHostProposalsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_799833dfb4f243e6, []int{56}
+}
+func (m *QueryHostProposalsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHostProposalsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHostProposalsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryHostProposalsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHostProposalsRequest.Merge(m, src)
+}
+func (m *QueryHostProposalsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHostProposalsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHostProposalsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryFailedUnbondingsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryHostProposalsRequest proto.InternalMessageInfo
+
+func (m *QueryHostProposalsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryFailedUnbondingsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryHostProposalsResponse is a response for the Query/HostProposals
+// methods.
+type QueryHostProposalsResponse struct {
+	HostProposals []HostProposal      `protobuf:"bytes,1,rep,name=host_proposals,json=hostProposals,proto3" json:"host_proposals"`
+	Pagination    *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryFailedUnbondingsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.FailedUnbondings) > 0 {
-		for iNdEx := len(m.FailedUnbondings) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.FailedUnbondings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryHostProposalsResponse) Reset()         { *m = QueryHostProposalsResponse{} }
+func (m *QueryHostProposalsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryHostProposalsResponse) ProtoMessage()    {}
+func (*QueryHostProposalsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_799833dfb4f243e6, []int{57}
+}
+func (m *QueryHostProposalsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHostProposalsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHostProposalsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryPendingUnbondingsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryHostProposalsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHostProposalsResponse.Merge(m, src)
 }
-
-func (m *QueryPendingUnbondingsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryHostProposalsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHostProposalsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHostProposalsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryPendingUnbondingsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.DelegatorAddress) > 0 {
-		i -= len(m.DelegatorAddress)
-		copy(dAtA[i:], m.DelegatorAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryHostProposalsResponse proto.InternalMessageInfo
+
+func (m *QueryHostProposalsResponse) GetHostProposals() []HostProposal {
+	if m != nil {
+		return m.HostProposals
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryPendingUnbondingsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryHostProposalsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryPendingUnbondingsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryHostProposalRequest is a request for the Query/HostProposal methods.
+type QueryHostProposalRequest struct {
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
 }
 
-func (m *QueryPendingUnbondingsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.PendingUnbondings) > 0 {
-		for iNdEx := len(m.PendingUnbondings) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.PendingUnbondings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryHostProposalRequest) Reset()         { *m = QueryHostProposalRequest{} }
+func (m *QueryHostProposalRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryHostProposalRequest) ProtoMessage()    {}
+func (*QueryHostProposalRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_799833dfb4f243e6, []int{58}
+}
+func (m *QueryHostProposalRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHostProposalRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHostProposalRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryUnbondingEpochCValueRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryHostProposalRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHostProposalRequest.Merge(m, src)
 }
-
-func (m *QueryUnbondingEpochCValueRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryHostProposalRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryUnbondingEpochCValueRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.EpochNumber != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.EpochNumber))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func (m *QueryHostProposalRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHostProposalRequest.DiscardUnknown(m)
 }
 
-func (m *QueryUnbondingEpochCValueResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryHostProposalRequest proto.InternalMessageInfo
+
+func (m *QueryHostProposalRequest) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryUnbondingEpochCValueResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryHostProposalResponse is a response for the Query/HostProposal methods.
+type QueryHostProposalResponse struct {
+	HostProposal HostProposal `protobuf:"bytes,1,opt,name=host_proposal,json=hostProposal,proto3" json:"host_proposal"`
 }
 
-func (m *QueryUnbondingEpochCValueResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.UnbondingEpochCValue.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryHostProposalResponse) Reset()         { *m = QueryHostProposalResponse{} }
+func (m *QueryHostProposalResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryHostProposalResponse) ProtoMessage()    {}
+func (*QueryHostProposalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_799833dfb4f243e6, []int{59}
+}
+func (m *QueryHostProposalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHostProposalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHostProposalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryHostAccountUndelegationRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryHostProposalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHostProposalResponse.Merge(m, src)
 }
-
-func (m *QueryHostAccountUndelegationRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryHostProposalResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryHostAccountUndelegationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.EpochNumber != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.EpochNumber))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func (m *QueryHostProposalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHostProposalResponse.DiscardUnknown(m)
 }
 
-func (m *QueryHostAccountUndelegationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryHostProposalResponse proto.InternalMessageInfo
+
+func (m *QueryHostProposalResponse) GetHostProposal() HostProposal {
+	if m != nil {
+		return m.HostProposal
 	}
-	return dAtA[:n], nil
+	return HostProposal{}
 }
 
-func (m *QueryHostAccountUndelegationResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryHostProposalVotesRequest is a request for the Query/HostProposalVotes
+// methods.
+type QueryHostProposalVotesRequest struct {
+	ProposalId uint64             `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryHostAccountUndelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.HostAccountUndelegation.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryHostProposalVotesRequest) Reset()         { *m = QueryHostProposalVotesRequest{} }
+func (m *QueryHostProposalVotesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryHostProposalVotesRequest) ProtoMessage()    {}
+func (*QueryHostProposalVotesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_799833dfb4f243e6, []int{60}
+}
+func (m *QueryHostProposalVotesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHostProposalVotesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHostProposalVotesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryDelegatorUnbondingEpochEntryRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryHostProposalVotesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHostProposalVotesRequest.Merge(m, src)
 }
-
-func (m *QueryDelegatorUnbondingEpochEntryRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryHostProposalVotesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHostProposalVotesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHostProposalVotesRequest.DiscardUnknown(m)
 }
 
-func (m *QueryDelegatorUnbondingEpochEntryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.EpochNumber != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.EpochNumber))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.DelegatorAddress) > 0 {
-		i -= len(m.DelegatorAddress)
-		copy(dAtA[i:], m.DelegatorAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryHostProposalVotesRequest proto.InternalMessageInfo
+
+func (m *QueryHostProposalVotesRequest) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryDelegatorUnbondingEpochEntryResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryHostProposalVotesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryDelegatorUnbondingEpochEntryResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryHostProposalVotesResponse is a response for the Query/HostProposalVotes
+// methods.
+type QueryHostProposalVotesResponse struct {
+	Votes      []HostProposalVote  `protobuf:"bytes,1,rep,name=votes,proto3" json:"votes"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryDelegatorUnbondingEpochEntryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.DelegatorUnbodingEpochEntry.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryHostProposalVotesResponse) Reset()         { *m = QueryHostProposalVotesResponse{} }
+func (m *QueryHostProposalVotesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryHostProposalVotesResponse) ProtoMessage()    {}
+func (*QueryHostProposalVotesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_799833dfb4f243e6, []int{61}
+}
+func (m *QueryHostProposalVotesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHostProposalVotesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHostProposalVotesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryHostAccountsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryHostProposalVotesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHostProposalVotesResponse.Merge(m, src)
 }
-
-func (m *QueryHostAccountsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryHostProposalVotesResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryHostAccountsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryHostProposalVotesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHostProposalVotesResponse.DiscardUnknown(m)
 }
 
-func (m *QueryHostAccountsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryHostProposalVotesResponse proto.InternalMessageInfo
+
+func (m *QueryHostProposalVotesResponse) GetVotes() []HostProposalVote {
+	if m != nil {
+		return m.Votes
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryHostAccountsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryHostProposalVotesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryHostAccountsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.HostAccounts.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "pstake.lscosmos.v1beta1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "pstake.lscosmos.v1beta1.QueryParamsResponse")
+	proto.RegisterType((*QueryHostChainParamsRequest)(nil), "pstake.lscosmos.v1beta1.QueryHostChainParamsRequest")
+	proto.RegisterType((*QueryHostChainParamsResponse)(nil), "pstake.lscosmos.v1beta1.QueryHostChainParamsResponse")
+	proto.RegisterType((*QueryDelegationStateRequest)(nil), "pstake.lscosmos.v1beta1.QueryDelegationStateRequest")
+	proto.RegisterType((*QueryDelegationStateResponse)(nil), "pstake.lscosmos.v1beta1.QueryDelegationStateResponse")
+	proto.RegisterType((*QueryAllowListedValidatorsRequest)(nil), "pstake.lscosmos.v1beta1.QueryAllowListedValidatorsRequest")
+	proto.RegisterType((*QueryAllowListedValidatorsResponse)(nil), "pstake.lscosmos.v1beta1.QueryAllowListedValidatorsResponse")
+	proto.RegisterType((*QueryCValueRequest)(nil), "pstake.lscosmos.v1beta1.QueryCValueRequest")
+	proto.RegisterType((*QueryCValueResponse)(nil), "pstake.lscosmos.v1beta1.QueryCValueResponse")
+	proto.RegisterType((*QueryModuleStateRequest)(nil), "pstake.lscosmos.v1beta1.QueryModuleStateRequest")
+	proto.RegisterType((*QueryModuleStateResponse)(nil), "pstake.lscosmos.v1beta1.QueryModuleStateResponse")
+	proto.RegisterType((*QueryIBCTransientStoreRequest)(nil), "pstake.lscosmos.v1beta1.QueryIBCTransientStoreRequest")
+	proto.RegisterType((*QueryIBCTransientStoreResponse)(nil), "pstake.lscosmos.v1beta1.QueryIBCTransientStoreResponse")
+	proto.RegisterType((*QueryUnclaimedRequest)(nil), "pstake.lscosmos.v1beta1.QueryUnclaimedRequest")
+	proto.RegisterType((*QueryUnclaimedResponse)(nil), "pstake.lscosmos.v1beta1.QueryUnclaimedResponse")
+	proto.RegisterType((*QueryFailedUnbondingsRequest)(nil), "pstake.lscosmos.v1beta1.QueryFailedUnbondingsRequest")
+	proto.RegisterType((*QueryFailedUnbondingsResponse)(nil), "pstake.lscosmos.v1beta1.QueryFailedUnbondingsResponse")
+	proto.RegisterType((*QueryPendingUnbondingsRequest)(nil), "pstake.lscosmos.v1beta1.QueryPendingUnbondingsRequest")
+	proto.RegisterType((*QueryPendingUnbondingsResponse)(nil), "pstake.lscosmos.v1beta1.QueryPendingUnbondingsResponse")
+	proto.RegisterType((*QueryUnbondingEpochCValueRequest)(nil), "pstake.lscosmos.v1beta1.QueryUnbondingEpochCValueRequest")
+	proto.RegisterType((*QueryUnbondingEpochCValueResponse)(nil), "pstake.lscosmos.v1beta1.QueryUnbondingEpochCValueResponse")
+	proto.RegisterType((*QueryHostAccountUndelegationRequest)(nil), "pstake.lscosmos.v1beta1.QueryHostAccountUndelegationRequest")
+	proto.RegisterType((*QueryHostAccountUndelegationResponse)(nil), "pstake.lscosmos.v1beta1.QueryHostAccountUndelegationResponse")
+	proto.RegisterType((*QueryDelegatorUnbondingEpochEntryRequest)(nil), "pstake.lscosmos.v1beta1.QueryDelegatorUnbondingEpochEntryRequest")
+	proto.RegisterType((*QueryDelegatorUnbondingEpochEntryResponse)(nil), "pstake.lscosmos.v1beta1.QueryDelegatorUnbondingEpochEntryResponse")
+	proto.RegisterType((*QueryHostAccountsRequest)(nil), "pstake.lscosmos.v1beta1.QueryHostAccountsRequest")
+	proto.RegisterType((*QueryHostAccountsResponse)(nil), "pstake.lscosmos.v1beta1.QueryHostAccountsResponse")
+	proto.RegisterType((*QueryDepositModuleAccountRequest)(nil), "pstake.lscosmos.v1beta1.QueryDepositModuleAccountRequest")
+	proto.RegisterType((*QueryDepositModuleAccountResponse)(nil), "pstake.lscosmos.v1beta1.QueryDepositModuleAccountResponse")
+	proto.RegisterType((*QueryAllDelegatorUnbondingEpochEntriesRequest)(nil), "pstake.lscosmos.v1beta1.QueryAllDelegatorUnbondingEpochEntriesRequest")
+	proto.RegisterType((*QueryAllDelegatorUnbondingEpochEntriesResponse)(nil), "pstake.lscosmos.v1beta1.QueryAllDelegatorUnbondingEpochEntriesResponse")
+	proto.RegisterType((*QueryRemainingCapacityRequest)(nil), "pstake.lscosmos.v1beta1.QueryRemainingCapacityRequest")
+	proto.RegisterType((*QueryRemainingCapacityResponse)(nil), "pstake.lscosmos.v1beta1.QueryRemainingCapacityResponse")
+	proto.RegisterType((*QueryFeeSplitRequest)(nil), "pstake.lscosmos.v1beta1.QueryFeeSplitRequest")
+	proto.RegisterType((*QueryFeeSplitResponse)(nil), "pstake.lscosmos.v1beta1.QueryFeeSplitResponse")
+	proto.RegisterType((*QueryCollectedFeesRequest)(nil), "pstake.lscosmos.v1beta1.QueryCollectedFeesRequest")
+	proto.RegisterType((*QueryCollectedFeesResponse)(nil), "pstake.lscosmos.v1beta1.QueryCollectedFeesResponse")
+	proto.RegisterType((*QueryRolesRequest)(nil), "pstake.lscosmos.v1beta1.QueryRolesRequest")
+	proto.RegisterType((*QueryRolesResponse)(nil), "pstake.lscosmos.v1beta1.QueryRolesResponse")
+	proto.RegisterType((*QueryModuleStatusRequest)(nil), "pstake.lscosmos.v1beta1.QueryModuleStatusRequest")
+	proto.RegisterType((*QueryModuleStatusResponse)(nil), "pstake.lscosmos.v1beta1.QueryModuleStatusResponse")
+	proto.RegisterType((*QueryUnbondingEpochCValuesRequest)(nil), "pstake.lscosmos.v1beta1.QueryUnbondingEpochCValuesRequest")
+	proto.RegisterType((*QueryUnbondingEpochCValuesResponse)(nil), "pstake.lscosmos.v1beta1.QueryUnbondingEpochCValuesResponse")
+	proto.RegisterType((*QueryDelegatorUnbondingEpochEntriesInRangeRequest)(nil), "pstake.lscosmos.v1beta1.QueryDelegatorUnbondingEpochEntriesInRangeRequest")
+	proto.RegisterType((*QueryDelegatorUnbondingEpochEntriesInRangeResponse)(nil), "pstake.lscosmos.v1beta1.QueryDelegatorUnbondingEpochEntriesInRangeResponse")
+	proto.RegisterType((*QueryHostAccountDelegationsRequest)(nil), "pstake.lscosmos.v1beta1.QueryHostAccountDelegationsRequest")
+	proto.RegisterType((*QueryHostAccountDelegationsResponse)(nil), "pstake.lscosmos.v1beta1.QueryHostAccountDelegationsResponse")
+	proto.RegisterType((*QueryHostAccountDelegationRequest)(nil), "pstake.lscosmos.v1beta1.QueryHostAccountDelegationRequest")
+	proto.RegisterType((*QueryHostAccountDelegationResponse)(nil), "pstake.lscosmos.v1beta1.QueryHostAccountDelegationResponse")
+	proto.RegisterType((*QueryUnbondingEpochDelegatorEntriesRequest)(nil), "pstake.lscosmos.v1beta1.QueryUnbondingEpochDelegatorEntriesRequest")
+	proto.RegisterType((*QueryUnbondingEpochDelegatorEntriesResponse)(nil), "pstake.lscosmos.v1beta1.QueryUnbondingEpochDelegatorEntriesResponse")
+	proto.RegisterType((*QueryValidatorWeightsRequest)(nil), "pstake.lscosmos.v1beta1.QueryValidatorWeightsRequest")
+	proto.RegisterType((*QueryValidatorWeightsResponse)(nil), "pstake.lscosmos.v1beta1.QueryValidatorWeightsResponse")
+	proto.RegisterType((*QueryRewardEpochRecordsRequest)(nil), "pstake.lscosmos.v1beta1.QueryRewardEpochRecordsRequest")
+	proto.RegisterType((*QueryRewardEpochRecordsResponse)(nil), "pstake.lscosmos.v1beta1.QueryRewardEpochRecordsResponse")
+	proto.RegisterType((*QueryHostProposalsRequest)(nil), "pstake.lscosmos.v1beta1.QueryHostProposalsRequest")
+	proto.RegisterType((*QueryHostProposalsResponse)(nil), "pstake.lscosmos.v1beta1.QueryHostProposalsResponse")
+	proto.RegisterType((*QueryHostProposalRequest)(nil), "pstake.lscosmos.v1beta1.QueryHostProposalRequest")
+	proto.RegisterType((*QueryHostProposalResponse)(nil), "pstake.lscosmos.v1beta1.QueryHostProposalResponse")
+	proto.RegisterType((*QueryHostProposalVotesRequest)(nil), "pstake.lscosmos.v1beta1.QueryHostProposalVotesRequest")
+	proto.RegisterType((*QueryHostProposalVotesResponse)(nil), "pstake.lscosmos.v1beta1.QueryHostProposalVotesResponse")
 }
 
-func (m *QueryDepositModuleAccountRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func init() {
+	proto.RegisterFile("pstake/lscosmos/v1beta1/query.proto", fileDescriptor_799833dfb4f243e6)
 }
 
-func (m *QueryDepositModuleAccountRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var fileDescriptor_799833dfb4f243e6 = []byte{
+	// 2923 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x5b, 0xeb, 0x6f, 0x1c, 0x57,
+	0xd9, 0xcf, 0xb1, 0x9b, 0x8b, 0x9f, 0x5c, 0x7d, 0x6a, 0x27, 0xf6, 0x34, 0x5d, 0xc7, 0xd3, 0xe6,
+	0x1e, 0xef, 0xd6, 0x4e, 0x93, 0xb4, 0x49, 0xd3, 0xf7, 0x75, 0x9c, 0x4b, 0x4d, 0x6f, 0xce, 0xba,
+	0x09, 0xa2, 0x55, 0x19, 0x66, 0x67, 0x8f, 0x77, 0xa7, 0xdd, 0x9d, 0xd9, 0xce, 0xcc, 0x3a, 0xa4,
+	0x51, 0x24, 0xd4, 0x0f, 0xa8, 0x54, 0x45, 0x20, 0x0a, 0x42, 0xe2, 0x03, 0x52, 0x25, 0xf8, 0x80,
+	0x84, 0xb8, 0x54, 0xe1, 0x43, 0x91, 0xe0, 0x03, 0x12, 0xa8, 0x54, 0x2a, 0xaa, 0x40, 0x42, 0x08,
+	0xa4, 0x02, 0x0d, 0xfc, 0x15, 0x48, 0x80, 0xe6, 0xcc, 0x73, 0x66, 0x67, 0x67, 0xe7, 0xcc, 0xcc,
+	0xae, 0x57, 0x6a, 0xc5, 0xa7, 0xd6, 0xe7, 0x3c, 0x97, 0xdf, 0xf3, 0x9c, 0xdb, 0x33, 0xcf, 0x6f,
+	0x03, 0x0f, 0xb4, 0x5c, 0x4f, 0x7f, 0x99, 0x95, 0x1a, 0xae, 0x61, 0xbb, 0x4d, 0xdb, 0x2d, 0xad,
+	0xcf, 0x57, 0x98, 0xa7, 0xcf, 0x97, 0x5e, 0x69, 0x33, 0xe7, 0x66, 0xb1, 0xe5, 0xd8, 0x9e, 0x4d,
+	0xf7, 0x05, 0x42, 0x45, 0x21, 0x54, 0x44, 0x21, 0x65, 0xa2, 0x66, 0xd7, 0x6c, 0x2e, 0x53, 0xf2,
+	0xff, 0x2f, 0x10, 0x57, 0xf6, 0xd7, 0x6c, 0xbb, 0xd6, 0x60, 0x25, 0xbd, 0x65, 0x96, 0x74, 0xcb,
+	0xb2, 0x3d, 0xdd, 0x33, 0x6d, 0xcb, 0xc5, 0xd9, 0x63, 0xe8, 0xa8, 0xa2, 0xbb, 0x2c, 0xf0, 0x12,
+	0xfa, 0x6c, 0xe9, 0x35, 0xd3, 0xe2, 0xc2, 0x28, 0xfb, 0xa0, 0x0c, 0x5d, 0x4b, 0x77, 0xf4, 0xa6,
+	0xb0, 0x38, 0x2f, 0x93, 0xaa, 0xd9, 0xeb, 0xcc, 0xb1, 0x74, 0xcb, 0x60, 0x5a, 0xcb, 0xb1, 0x5b,
+	0xb6, 0xab, 0x37, 0x50, 0xe5, 0x90, 0x4c, 0x25, 0x0c, 0x31, 0x90, 0x2b, 0x44, 0xc1, 0x0a, 0x19,
+	0xc3, 0x36, 0x05, 0xc0, 0x19, 0x0c, 0x95, 0xff, 0x55, 0x69, 0xaf, 0x95, 0x3c, 0xb3, 0xc9, 0x5c,
+	0x4f, 0x6f, 0xb6, 0x50, 0x60, 0x3a, 0x30, 0xa0, 0x05, 0x49, 0x8a, 0xda, 0x56, 0x27, 0x80, 0x5e,
+	0xf5, 0xc3, 0x5f, 0xe1, 0xb1, 0x94, 0xd9, 0x2b, 0x6d, 0xe6, 0x7a, 0xea, 0x73, 0x70, 0x6f, 0xd7,
+	0xa8, 0xdb, 0xb2, 0x2d, 0x97, 0xd1, 0xf3, 0xb0, 0x25, 0x88, 0x79, 0x8a, 0x1c, 0x20, 0x47, 0xb6,
+	0x2f, 0xcc, 0x14, 0x25, 0x6b, 0x52, 0x0c, 0x14, 0x2f, 0xdc, 0xf3, 0xde, 0x47, 0x33, 0x9b, 0xca,
+	0xa8, 0xa4, 0xde, 0x0f, 0xf7, 0x71, 0xab, 0x4f, 0xd8, 0xae, 0xb7, 0x54, 0xd7, 0x4d, 0xab, 0xdb,
+	0xe9, 0xab, 0xb0, 0x3f, 0x79, 0x1a, 0xbd, 0x3f, 0x0f, 0xe3, 0x75, 0xdb, 0xf5, 0x34, 0xc3, 0x9f,
+	0xd3, 0xba, 0x80, 0x1c, 0x91, 0x02, 0x89, 0x19, 0x43, 0x44, 0xbb, 0xeb, 0xdd, 0xc3, 0x2a, 0x43,
+	0x68, 0x17, 0x59, 0x83, 0xd5, 0xf8, 0xe2, 0xaf, 0x7a, 0xba, 0xc7, 0x10, 0x1a, 0xbd, 0x0c, 0xd0,
+	0xd9, 0x16, 0xe8, 0xf3, 0x50, 0x11, 0x5d, 0xf9, 0xcb, 0x52, 0x0c, 0x76, 0x6a, 0x27, 0xfc, 0x9a,
+	0xd0, 0x2d, 0x47, 0x34, 0xd5, 0x5f, 0x11, 0xd8, 0x9f, 0xec, 0x07, 0x63, 0xfc, 0x1c, 0xec, 0xa9,
+	0x86, 0x53, 0x9a, 0xeb, 0xcf, 0x65, 0x86, 0x18, 0xb3, 0x25, 0x42, 0xac, 0x76, 0x0f, 0xd3, 0x2b,
+	0x5d, 0x31, 0x8c, 0x70, 0xa3, 0x87, 0x33, 0x63, 0x08, 0x70, 0x75, 0x05, 0xf1, 0x32, 0xcc, 0xf2,
+	0x18, 0x16, 0x1b, 0x0d, 0xfb, 0xc6, 0x53, 0xa6, 0xeb, 0xb1, 0xea, 0x75, 0xbd, 0x61, 0x56, 0x75,
+	0xcf, 0x76, 0xdc, 0x61, 0x67, 0xec, 0x2f, 0x04, 0xd4, 0x34, 0x6f, 0x98, 0xb7, 0x06, 0xec, 0xd3,
+	0x7d, 0x01, 0xad, 0xc1, 0x25, 0xb4, 0xf5, 0x50, 0x04, 0x7d, 0x17, 0xa5, 0xe9, 0x4b, 0x34, 0x8c,
+	0x49, 0x9c, 0xd4, 0x93, 0x26, 0x87, 0x97, 0x4a, 0x71, 0xfa, 0x96, 0xae, 0xeb, 0x8d, 0xb6, 0x88,
+	0x5f, 0xfd, 0x3c, 0xdc, 0xdb, 0x35, 0x8a, 0x31, 0x5e, 0x81, 0xad, 0x86, 0x1f, 0x58, 0x3b, 0xd8,
+	0x12, 0x63, 0x17, 0x8a, 0x3e, 0xc6, 0x3f, 0x7f, 0x34, 0x73, 0xa8, 0x66, 0x7a, 0xf5, 0x76, 0xa5,
+	0x68, 0xd8, 0x4d, 0x3c, 0xdc, 0xf8, 0x9f, 0x39, 0xb7, 0xfa, 0x72, 0xc9, 0xbb, 0xd9, 0x62, 0x6e,
+	0xf1, 0x22, 0x33, 0xca, 0x5b, 0x0c, 0x6e, 0x50, 0x9d, 0x86, 0x7d, 0xdc, 0xfe, 0xd3, 0x76, 0xb5,
+	0xdd, 0x60, 0xd1, 0x8d, 0xae, 0x9e, 0x87, 0xa9, 0xde, 0x29, 0xf4, 0x3f, 0x0b, 0x3b, 0x9a, 0x7c,
+	0x38, 0xb2, 0x2f, 0xb7, 0x95, 0xb7, 0x37, 0x3b, 0xa2, 0xea, 0x0c, 0xdc, 0xcf, 0xd5, 0x97, 0x2f,
+	0x2c, 0x3d, 0xe7, 0xe8, 0x96, 0x6b, 0x32, 0xcb, 0x5b, 0xf5, 0x6c, 0x27, 0xb4, 0xff, 0x06, 0x81,
+	0x82, 0x4c, 0x02, 0xdd, 0xd4, 0x61, 0xd2, 0xd4, 0x2a, 0x9a, 0xa1, 0x79, 0x62, 0x5e, 0x73, 0x7d,
+	0x01, 0x5c, 0xc8, 0x87, 0xa4, 0x0b, 0xb9, 0x7c, 0x61, 0x69, 0xb1, 0x69, 0xb7, 0x2d, 0xaf, 0xdb,
+	0x30, 0x2e, 0xe5, 0xb8, 0x19, 0xf7, 0xa8, 0xbe, 0x49, 0x60, 0x92, 0x83, 0xb9, 0x66, 0x19, 0x0d,
+	0xdd, 0x6c, 0xb2, 0xaa, 0xd8, 0xbd, 0xc7, 0x61, 0x1c, 0x8f, 0x8f, 0xed, 0x68, 0x7a, 0xb5, 0xea,
+	0x30, 0x37, 0xd8, 0x48, 0x63, 0xe5, 0x3d, 0xe1, 0xc4, 0x62, 0x30, 0x4e, 0x2f, 0x27, 0xec, 0x86,
+	0x41, 0xb6, 0xfa, 0x1d, 0x02, 0x7b, 0xe3, 0x70, 0x30, 0x27, 0x57, 0x61, 0xac, 0x2d, 0x06, 0xa7,
+	0xc8, 0x81, 0xd1, 0x23, 0xdb, 0x17, 0xe6, 0xa4, 0x79, 0xb8, 0x66, 0x55, 0x6c, 0xab, 0x6a, 0x5a,
+	0xb5, 0x4b, 0x2d, 0xdb, 0xa8, 0x07, 0x9b, 0x08, 0x93, 0xd0, 0xb1, 0x32, 0xbc, 0x3d, 0xfc, 0x96,
+	0xb8, 0xd3, 0x2e, 0xeb, 0x66, 0x83, 0x55, 0x43, 0xef, 0xee, 0x27, 0x9a, 0xcc, 0xf7, 0x09, 0xdc,
+	0x2f, 0x41, 0x85, 0x39, 0xfd, 0x02, 0x8c, 0xaf, 0xf1, 0x39, 0xad, 0x1d, 0x4e, 0x6e, 0x24, 0xb7,
+	0x7b, 0xd6, 0x62, 0x9e, 0x86, 0x97, 0xe2, 0x6f, 0x8a, 0x60, 0x56, 0x18, 0x37, 0xfd, 0x29, 0xc9,
+	0xf1, 0x07, 0xe2, 0x30, 0x27, 0xc0, 0xc2, 0x24, 0x57, 0x80, 0xb6, 0x82, 0xc9, 0x21, 0x65, 0x79,
+	0xbc, 0x15, 0xf7, 0x35, 0xbc, 0x34, 0x5f, 0x82, 0x03, 0x78, 0xfe, 0x7a, 0xdd, 0x8b, 0x44, 0xcf,
+	0xc2, 0x0e, 0xe6, 0x8f, 0x6a, 0x56, 0xbb, 0x59, 0x61, 0x0e, 0xcf, 0xf1, 0x68, 0x79, 0x3b, 0x1f,
+	0x7b, 0x86, 0x0f, 0xa9, 0x5f, 0x23, 0x30, 0x9b, 0x62, 0x07, 0x33, 0xf3, 0x12, 0xec, 0x0b, 0x33,
+	0xa2, 0x05, 0x26, 0xa3, 0xb7, 0xfb, 0x80, 0xe9, 0x99, 0x68, 0x27, 0xcc, 0xa9, 0x4f, 0xc0, 0x03,
+	0x61, 0x65, 0xb5, 0x68, 0x18, 0xfe, 0x1d, 0x79, 0xcd, 0xea, 0xd4, 0x07, 0x7d, 0xc4, 0xf6, 0x1d,
+	0x02, 0x0f, 0xa6, 0x9b, 0xc2, 0xf0, 0x1c, 0x98, 0xe6, 0xc5, 0x9a, 0x1e, 0xc8, 0x68, 0xed, 0x88,
+	0x50, 0xe6, 0x4d, 0x2e, 0x31, 0x8e, 0x31, 0xee, 0xab, 0x27, 0x4f, 0xab, 0xaf, 0xc2, 0x91, 0x68,
+	0x71, 0x65, 0x3b, 0xdd, 0x89, 0xba, 0x64, 0x79, 0xce, 0xcd, 0x81, 0x0e, 0x4c, 0x3c, 0x31, 0x23,
+	0xbd, 0x89, 0xf9, 0x11, 0x81, 0xa3, 0x39, 0x9c, 0x63, 0x76, 0xbe, 0x44, 0xa0, 0xd0, 0x71, 0xef,
+	0xaf, 0x59, 0x64, 0x1b, 0x30, 0x5f, 0x14, 0x73, 0x74, 0x2a, 0xab, 0xea, 0x4b, 0xf4, 0x83, 0x89,
+	0xba, 0xaf, 0x1a, 0x95, 0xe9, 0x16, 0x51, 0x15, 0x7c, 0xe9, 0x23, 0xb9, 0x0e, 0x2b, 0xf1, 0x26,
+	0x4c, 0x27, 0xcc, 0x21, 0xf6, 0x15, 0xd8, 0x19, 0x5d, 0x59, 0x51, 0x60, 0x1d, 0xcc, 0xb3, 0x9a,
+	0xa2, 0xae, 0xda, 0x11, 0x59, 0x42, 0x57, 0x55, 0xf1, 0xdc, 0x5d, 0x64, 0x2d, 0xdb, 0x35, 0xbd,
+	0xa0, 0xf6, 0xc0, 0xd9, 0x4e, 0x4d, 0x34, 0x9b, 0x22, 0x83, 0xd0, 0x1e, 0x85, 0xad, 0x15, 0xbd,
+	0xa1, 0x5b, 0x86, 0x38, 0x43, 0xd3, 0x5d, 0xd7, 0x80, 0x00, 0xb4, 0x64, 0x9b, 0x62, 0x2f, 0x09,
+	0x79, 0xf5, 0x7b, 0x04, 0xe6, 0x44, 0x9d, 0x99, 0x92, 0x5a, 0x93, 0x7d, 0xb2, 0x57, 0xee, 0x6b,
+	0x23, 0x50, 0xcc, 0x0b, 0x13, 0x93, 0xf2, 0x65, 0x02, 0xb3, 0xdd, 0x7b, 0xcd, 0x8a, 0x6d, 0x36,
+	0x93, 0x89, 0x2b, 0x79, 0x43, 0xdb, 0xad, 0x50, 0x4d, 0x05, 0x34, 0xbc, 0x7b, 0xfa, 0x29, 0x7c,
+	0x0d, 0xcb, 0xac, 0xa9, 0x9b, 0x96, 0x69, 0xd5, 0x96, 0xf4, 0x96, 0x6e, 0x98, 0xde, 0x40, 0x87,
+	0x5b, 0xbd, 0x33, 0x0a, 0x05, 0x99, 0xb9, 0xf0, 0xcb, 0x73, 0xcc, 0x11, 0x93, 0x58, 0x7b, 0x3f,
+	0xd6, 0x47, 0xed, 0xbd, 0x6c, 0x79, 0xbf, 0xbf, 0x33, 0x07, 0x18, 0xe9, 0xb2, 0xe5, 0x95, 0x3b,
+	0xe6, 0xe8, 0x14, 0x6c, 0x6d, 0x98, 0x4d, 0xd3, 0x63, 0x55, 0x9e, 0x92, 0x6d, 0x65, 0xf1, 0x27,
+	0x7d, 0x06, 0x46, 0xbd, 0xf5, 0xc6, 0xd4, 0xe8, 0x10, 0xfc, 0xf9, 0x86, 0xa8, 0x01, 0xbb, 0x82,
+	0x35, 0xaf, 0x06, 0x67, 0xc8, 0x9d, 0xba, 0x67, 0x08, 0xa6, 0x77, 0x72, 0x9b, 0x78, 0x2c, 0x5d,
+	0x5a, 0x83, 0x3d, 0x98, 0xf0, 0x8e, 0x9b, 0xcd, 0x43, 0x70, 0xb3, 0x1b, 0xad, 0x0a, 0x47, 0xea,
+	0x5e, 0x98, 0x08, 0xea, 0x3b, 0xc6, 0x56, 0x5b, 0x0d, 0x33, 0xbc, 0x28, 0x5e, 0x84, 0xc9, 0xd8,
+	0x38, 0x2e, 0xe2, 0x45, 0x18, 0x5b, 0x63, 0x4c, 0x73, 0xfd, 0x41, 0xbc, 0x1e, 0x66, 0xa5, 0xdb,
+	0x5d, 0x68, 0xe3, 0xd6, 0xde, 0xb6, 0x86, 0x7f, 0xab, 0xa7, 0xf1, 0x6a, 0x5c, 0xb2, 0x1b, 0x0d,
+	0x66, 0x78, 0xac, 0x7a, 0x99, 0x75, 0xae, 0x84, 0x69, 0xf0, 0x05, 0x35, 0x3f, 0x06, 0xdc, 0x6e,
+	0x5b, 0xd7, 0x18, 0x7b, 0xee, 0x66, 0x8b, 0xa9, 0x2d, 0x50, 0x92, 0xf4, 0x10, 0x5b, 0x19, 0x76,
+	0x19, 0x62, 0x42, 0x5b, 0x63, 0xe1, 0x79, 0x94, 0x5f, 0xaa, 0x51, 0x3b, 0x08, 0x72, 0xa7, 0x11,
+	0xb5, 0xad, 0xde, 0x0b, 0xe3, 0xc1, 0xb6, 0xb6, 0x1b, 0x21, 0x42, 0xf5, 0xc7, 0x04, 0x68, 0x74,
+	0x14, 0xfd, 0x9f, 0x85, 0xcd, 0x8e, 0x3f, 0x80, 0x79, 0x29, 0x48, 0xdd, 0x72, 0x35, 0xf4, 0x17,
+	0xa8, 0xd0, 0x17, 0x61, 0x42, 0x94, 0x78, 0x7a, 0xb5, 0x69, 0x5a, 0x7e, 0x7f, 0xc6, 0xaa, 0x31,
+	0x3c, 0xe0, 0xc7, 0xe5, 0x2d, 0xa2, 0x40, 0x69, 0xd1, 0xd7, 0x59, 0xe2, 0x2a, 0x65, 0xda, 0xea,
+	0x19, 0x0b, 0xdf, 0xa9, 0xce, 0x17, 0x69, 0x3b, 0x8c, 0xe6, 0xdb, 0x04, 0xa6, 0x13, 0x26, 0x31,
+	0xa8, 0x83, 0xb0, 0x0b, 0xbf, 0x57, 0x99, 0xa5, 0x57, 0x1a, 0xfc, 0xcb, 0xc9, 0x3f, 0x60, 0x3b,
+	0x83, 0xd1, 0x4b, 0xc1, 0x20, 0x5d, 0x85, 0x5d, 0x2d, 0xbd, 0xed, 0x32, 0xcd, 0xbd, 0x61, 0x7a,
+	0x46, 0x9d, 0xb9, 0xe1, 0xf5, 0x2c, 0x45, 0xee, 0x8b, 0xaf, 0xa2, 0xb4, 0x48, 0x7e, 0x2b, 0x3a,
+	0xa8, 0xea, 0x29, 0x25, 0x60, 0xb8, 0x5d, 0x66, 0x60, 0xbb, 0xeb, 0xe9, 0x8e, 0x17, 0x5c, 0xc5,
+	0x58, 0x6e, 0x01, 0x1f, 0xe2, 0xe2, 0xf4, 0x3e, 0x18, 0x63, 0x56, 0x15, 0xa7, 0x83, 0xa2, 0x63,
+	0x1b, 0xb3, 0xaa, 0x7c, 0x52, 0xfd, 0x86, 0xe8, 0x8c, 0x48, 0x7c, 0x84, 0x9d, 0x91, 0x29, 0x49,
+	0x9d, 0xb9, 0xa1, 0x3a, 0x7c, 0x32, 0xa9, 0xd0, 0x74, 0xd5, 0xef, 0x12, 0x98, 0xcf, 0x2a, 0x83,
+	0x4c, 0xe6, 0x2e, 0x5b, 0x65, 0xbe, 0xe0, 0x83, 0x3c, 0xa5, 0xb1, 0xac, 0x8d, 0xa4, 0x67, 0x6d,
+	0x34, 0x96, 0xb5, 0x5f, 0x10, 0x58, 0xe8, 0x07, 0xe0, 0xa7, 0xec, 0x11, 0x55, 0x1b, 0xb8, 0xe8,
+	0x91, 0xa2, 0xaa, 0xd3, 0xff, 0x1b, 0x7a, 0xf7, 0xed, 0x23, 0x02, 0x0f, 0xa4, 0xba, 0xc3, 0xf4,
+	0x58, 0x30, 0xd5, 0x55, 0xed, 0x77, 0x8a, 0x72, 0x91, 0x94, 0x62, 0x9e, 0xf2, 0xf0, 0x62, 0xbc,
+	0xd4, 0xdf, 0x5b, 0x4f, 0xf4, 0x3b, 0xbc, 0x52, 0x62, 0x05, 0xcf, 0x69, 0x22, 0x88, 0xc8, 0xf6,
+	0x0c, 0xfb, 0x89, 0xf1, 0xed, 0x19, 0x4e, 0x88, 0x72, 0x22, 0x3c, 0x96, 0x12, 0x93, 0x9d, 0x86,
+	0xa5, 0x24, 0x63, 0x99, 0x0d, 0xcb, 0xb4, 0x84, 0x4d, 0x26, 0x26, 0xcc, 0xbf, 0x28, 0x8f, 0x25,
+	0xdc, 0x15, 0xe1, 0x7e, 0x8c, 0x95, 0xb6, 0xd9, 0x1f, 0x82, 0x43, 0x2b, 0x68, 0xff, 0x43, 0xe0,
+	0x78, 0x2e, 0x64, 0xff, 0xb3, 0xd5, 0xec, 0x1a, 0xb6, 0xcf, 0xc2, 0xfe, 0xf2, 0x67, 0x99, 0x59,
+	0xab, 0x7b, 0x43, 0x3f, 0xcb, 0xbf, 0x16, 0x4d, 0xa4, 0x5e, 0x47, 0x98, 0xdb, 0x17, 0xa2, 0xfb,
+	0xfc, 0x46, 0x30, 0x89, 0xa9, 0x94, 0xb3, 0x0f, 0x31, 0x6b, 0xa2, 0x19, 0xb6, 0x1e, 0x73, 0x32,
+	0xbc, 0x7c, 0xd5, 0xc3, 0x72, 0xfd, 0x86, 0xee, 0x04, 0xb7, 0x7a, 0x99, 0x19, 0xb6, 0x53, 0x1d,
+	0x7a, 0xc6, 0xde, 0x1e, 0x81, 0x19, 0xa9, 0xab, 0xb0, 0xc1, 0x35, 0xe1, 0xf0, 0x59, 0xdc, 0x81,
+	0x4e, 0x30, 0x8f, 0x69, 0x3b, 0x26, 0x2f, 0xa4, 0xe2, 0x26, 0x31, 0x71, 0xd4, 0xe9, 0xf1, 0x45,
+	0xaf, 0xc2, 0x84, 0xa1, 0x3b, 0x8e, 0xc9, 0xaa, 0x9a, 0xbd, 0xce, 0x1c, 0x2d, 0x10, 0x11, 0x75,
+	0x4a, 0xe6, 0x37, 0x2e, 0x45, 0xe5, 0x67, 0xd7, 0x99, 0x13, 0xb8, 0x8d, 0xaf, 0xc6, 0xe8, 0xe0,
+	0xab, 0x61, 0x44, 0x5a, 0x05, 0x2b, 0x48, 0x6f, 0x0e, 0x7d, 0x21, 0xde, 0x25, 0xa0, 0x24, 0x79,
+	0xe9, 0x54, 0xcf, 0xfc, 0x2e, 0x15, 0xf4, 0x6a, 0x76, 0xf5, 0x1c, 0xb5, 0x23, 0x0a, 0xb8, 0x7a,
+	0xd4, 0xf6, 0xf0, 0xb6, 0xeb, 0xb9, 0x48, 0x9f, 0x45, 0x98, 0x8f, 0x14, 0x80, 0x02, 0xb3, 0x66,
+	0x06, 0xe5, 0xe9, 0x3d, 0x65, 0x10, 0x43, 0xcb, 0xd5, 0xae, 0x46, 0x4c, 0x47, 0x39, 0xd6, 0x88,
+	0x11, 0xf2, 0xb9, 0x1a, 0x31, 0xb1, 0xa8, 0x77, 0x44, 0xa3, 0x56, 0x5f, 0x17, 0x57, 0x44, 0x54,
+	0xf2, 0xba, 0xed, 0x75, 0x95, 0xac, 0xa9, 0x88, 0x87, 0xf6, 0x2e, 0xfc, 0x44, 0xf4, 0x96, 0x13,
+	0xa0, 0x60, 0xfc, 0x97, 0x60, 0xf3, 0xba, 0x3f, 0x80, 0xab, 0x7d, 0x34, 0x57, 0xdc, 0xbe, 0x09,
+	0xf1, 0xfd, 0xc2, 0xb5, 0x87, 0xb6, 0xd2, 0x0b, 0xff, 0x2a, 0xc1, 0x66, 0x0e, 0x99, 0xbe, 0x49,
+	0x60, 0x4b, 0x40, 0x2c, 0x53, 0xf9, 0xf7, 0x4f, 0x2f, 0xed, 0xae, 0x9c, 0xc8, 0x27, 0x1c, 0xf8,
+	0x56, 0x0f, 0xbf, 0xf6, 0x87, 0x7f, 0xbc, 0x35, 0x32, 0x4b, 0x67, 0x4a, 0xe9, 0x3f, 0x50, 0xa0,
+	0xef, 0x10, 0xd8, 0x1d, 0xe3, 0xc1, 0xe9, 0xc3, 0xe9, 0xae, 0x92, 0x29, 0x7a, 0xe5, 0x54, 0x9f,
+	0x5a, 0x88, 0x74, 0x81, 0x23, 0x3d, 0x41, 0x8f, 0x49, 0x91, 0xf6, 0x10, 0xfb, 0xf4, 0xa7, 0x04,
+	0x76, 0xc7, 0x98, 0xed, 0x2c, 0xd0, 0xc9, 0xe4, 0xbd, 0x72, 0xaa, 0x4f, 0x2d, 0x04, 0x3d, 0xcf,
+	0x41, 0x1f, 0xa7, 0x47, 0xa5, 0xa0, 0xe3, 0x4c, 0x3d, 0x7d, 0x9f, 0xc0, 0x64, 0x22, 0x9d, 0x4c,
+	0xcf, 0xa6, 0x63, 0x48, 0xa3, 0xd2, 0x95, 0x73, 0x03, 0xe9, 0x62, 0x14, 0x8f, 0xf0, 0x28, 0x16,
+	0xe8, 0x43, 0xd2, 0x28, 0x24, 0xbc, 0x39, 0xfd, 0x2a, 0x81, 0x2d, 0xc1, 0x67, 0x5d, 0xd6, 0x26,
+	0xee, 0x62, 0x48, 0x94, 0x13, 0xf9, 0x84, 0x11, 0xdf, 0x11, 0x8e, 0x4f, 0xa5, 0x07, 0xa4, 0xf8,
+	0xf0, 0x6b, 0x95, 0xbe, 0x4d, 0x60, 0x7b, 0x84, 0x96, 0xa6, 0x0f, 0xa5, 0xfb, 0xe9, 0x25, 0xb7,
+	0x95, 0xf9, 0x3e, 0x34, 0x10, 0xde, 0x1c, 0x87, 0x77, 0x98, 0x1e, 0x94, 0xc2, 0x8b, 0x52, 0xe2,
+	0xf4, 0xe7, 0x04, 0xc6, 0x7b, 0x98, 0x6d, 0x7a, 0x3a, 0xdd, 0xaf, 0x8c, 0x2c, 0x57, 0xce, 0xf4,
+	0xad, 0x87, 0xa8, 0x1f, 0xe6, 0xa8, 0x8b, 0xf4, 0x84, 0x14, 0xb5, 0x59, 0xe9, 0xe1, 0xd7, 0xe9,
+	0x0f, 0x09, 0x8c, 0x85, 0xd4, 0x33, 0x2d, 0xa6, 0x3b, 0x8f, 0x53, 0xe6, 0x4a, 0x29, 0xb7, 0x3c,
+	0x82, 0x7c, 0x9c, 0x83, 0x7c, 0x84, 0x9e, 0x96, 0x82, 0x0c, 0xc9, 0xea, 0xd2, 0xad, 0x9e, 0xf6,
+	0xc0, 0x6d, 0xfa, 0x5b, 0x02, 0x7b, 0xe2, 0xe4, 0x2e, 0xcd, 0x38, 0xeb, 0x12, 0x8a, 0x5a, 0x39,
+	0xdd, 0xaf, 0x1a, 0xc6, 0x70, 0x99, 0xc7, 0xf0, 0xff, 0xf4, 0x71, 0x69, 0x0c, 0x3d, 0x14, 0x73,
+	0x62, 0x2c, 0x1f, 0x10, 0x18, 0xef, 0x21, 0x51, 0xb3, 0xf6, 0x8d, 0x8c, 0x0c, 0x56, 0xce, 0xf4,
+	0xad, 0x87, 0xe1, 0x5c, 0xe1, 0xe1, 0x2c, 0xd2, 0xff, 0x93, 0xbf, 0x28, 0x3d, 0x64, 0x6e, 0x62,
+	0x3c, 0x7f, 0x24, 0x30, 0x91, 0xd4, 0x3c, 0xa2, 0x8f, 0x66, 0xed, 0x12, 0x29, 0xf3, 0xaa, 0x9c,
+	0x1d, 0x44, 0x35, 0x77, 0x60, 0x92, 0x1e, 0x59, 0xe9, 0x56, 0xf4, 0x43, 0xf8, 0x36, 0xfd, 0x3b,
+	0x81, 0x7d, 0x12, 0x76, 0x92, 0x3e, 0x96, 0xfd, 0x38, 0xca, 0xc9, 0x57, 0xe5, 0xfc, 0x80, 0xda,
+	0x18, 0xe1, 0x32, 0x8f, 0x70, 0x89, 0x2e, 0xa6, 0x3f, 0xb1, 0x49, 0x74, 0x6c, 0x3c, 0xc6, 0x37,
+	0x46, 0x60, 0x7f, 0xda, 0x07, 0x32, 0x5d, 0xcc, 0xf5, 0xa0, 0xa6, 0xd1, 0xaf, 0xca, 0x85, 0x8d,
+	0x98, 0xc0, 0x90, 0x0d, 0x1e, 0xf2, 0x8b, 0xf4, 0x85, 0xac, 0x07, 0x5a, 0xd2, 0x28, 0xb8, 0x99,
+	0xb4, 0x75, 0xe3, 0xc9, 0xf8, 0x3e, 0x81, 0x1d, 0x91, 0xdc, 0xbb, 0x74, 0x3e, 0xf7, 0x3a, 0x85,
+	0xe7, 0x71, 0xa1, 0x1f, 0x15, 0x0c, 0xae, 0xc8, 0x83, 0x3b, 0x42, 0x0f, 0xe5, 0x5a, 0x4f, 0x97,
+	0xfe, 0x86, 0xc0, 0x44, 0x12, 0x37, 0x9a, 0x75, 0xe2, 0x52, 0x38, 0x57, 0xe5, 0xec, 0x20, 0xaa,
+	0x88, 0xff, 0x0c, 0xc7, 0x3f, 0x4f, 0x4b, 0x29, 0x8b, 0xc3, 0xd5, 0x35, 0x7c, 0x40, 0x31, 0x12,
+	0xfa, 0x95, 0x11, 0x28, 0xa4, 0xf7, 0x66, 0xe9, 0xe5, 0xcc, 0x82, 0x28, 0x17, 0x83, 0xab, 0x5c,
+	0xd9, 0xb0, 0x1d, 0x0c, 0xf6, 0x3a, 0x0f, 0x76, 0x85, 0x3e, 0x33, 0xe0, 0x4e, 0x34, 0x59, 0xf2,
+	0x35, 0xfa, 0x2e, 0x81, 0xf1, 0x1e, 0x56, 0x32, 0xeb, 0x59, 0x90, 0xb1, 0xa2, 0xca, 0x99, 0xbe,
+	0xf5, 0x30, 0xbc, 0x93, 0x3c, 0xbc, 0x39, 0x7a, 0x5c, 0x1a, 0x5e, 0x48, 0x67, 0x6a, 0x86, 0x40,
+	0xf9, 0x2d, 0x02, 0xdb, 0x04, 0x8b, 0x46, 0xe7, 0x32, 0xde, 0xd7, 0x6e, 0x0e, 0x4f, 0x29, 0xe6,
+	0x15, 0x47, 0x80, 0xc7, 0x38, 0xc0, 0x07, 0xa9, 0x2a, 0x7f, 0x86, 0x05, 0xf3, 0x47, 0x7f, 0x40,
+	0x60, 0x67, 0x17, 0x09, 0x47, 0x33, 0x8e, 0x67, 0x12, 0xd3, 0xa7, 0x9c, 0xec, 0x4b, 0x07, 0x61,
+	0x96, 0x38, 0xcc, 0xa3, 0xf4, 0xb0, 0xbc, 0xd6, 0xed, 0x22, 0x01, 0xe9, 0xeb, 0x04, 0x36, 0x73,
+	0xc6, 0x8d, 0x1e, 0xcb, 0x58, 0xbb, 0x08, 0xc7, 0xa7, 0x1c, 0xcf, 0x25, 0x8b, 0x98, 0x0e, 0x71,
+	0x4c, 0x07, 0x68, 0x41, 0xbe, 0xb6, 0x1c, 0xc0, 0x3f, 0x09, 0x4c, 0x26, 0x12, 0x4d, 0x74, 0x80,
+	0x77, 0x39, 0xef, 0xa7, 0x4d, 0x2a, 0xb3, 0xa5, 0xae, 0x72, 0xe8, 0x4f, 0xd3, 0x27, 0xfb, 0x7d,
+	0xd4, 0xdd, 0xd2, 0xad, 0x08, 0x83, 0xe4, 0x5f, 0xf9, 0x82, 0x2e, 0xba, 0x4d, 0xdf, 0x19, 0x81,
+	0x83, 0xb9, 0xa8, 0x21, 0xfa, 0x99, 0x81, 0x9f, 0xb0, 0x1e, 0x02, 0x4c, 0x79, 0x72, 0x28, 0xb6,
+	0x30, 0x2f, 0x2d, 0x9e, 0x97, 0x97, 0x68, 0x7d, 0xb8, 0xb7, 0x51, 0x4a, 0xd2, 0x7e, 0x47, 0x60,
+	0x6f, 0x32, 0x43, 0x44, 0xcf, 0xe5, 0x7e, 0xfb, 0x7a, 0x69, 0x2c, 0xe5, 0xb1, 0xc1, 0x94, 0x31,
+	0x0f, 0x8f, 0xf2, 0x3c, 0x9c, 0xa4, 0xf3, 0xf9, 0x4a, 0xa2, 0x08, 0x67, 0x45, 0xff, 0x4a, 0x60,
+	0x32, 0xd1, 0x7a, 0xd6, 0x6e, 0x4f, 0xe3, 0x91, 0x94, 0x73, 0x03, 0xe9, 0x62, 0x34, 0x4f, 0xf3,
+	0x68, 0xae, 0xd0, 0x4b, 0x7d, 0x47, 0x53, 0xba, 0xd5, 0xc3, 0x5e, 0xdd, 0xa6, 0xff, 0x26, 0x50,
+	0x48, 0xa7, 0x5c, 0xe8, 0x52, 0x3f, 0x87, 0x53, 0x42, 0x25, 0x29, 0x17, 0x37, 0x66, 0x04, 0x83,
+	0xbf, 0xca, 0x83, 0x7f, 0x92, 0x2e, 0xe7, 0x3e, 0xea, 0x9d, 0xfd, 0x1b, 0x6e, 0xe9, 0xee, 0xc2,
+	0xee, 0x67, 0x04, 0xf6, 0xc4, 0x99, 0x90, 0xac, 0xcf, 0x47, 0x09, 0x45, 0xa3, 0x9c, 0xee, 0x57,
+	0x2d, 0x77, 0x5f, 0xac, 0x87, 0x8f, 0xa1, 0xbf, 0x24, 0x40, 0x7b, 0xf9, 0x08, 0x9a, 0xf9, 0xb8,
+	0x4b, 0xc8, 0x12, 0xe5, 0x91, 0xfe, 0x15, 0x11, 0xfd, 0x29, 0x8e, 0xbe, 0x44, 0xe7, 0x52, 0xca,
+	0x82, 0x5e, 0x66, 0x84, 0x3f, 0xc0, 0x5d, 0x7d, 0x7c, 0x9a, 0xa3, 0x3e, 0x8e, 0x53, 0x0b, 0xca,
+	0xc9, 0xbe, 0x74, 0x72, 0x3f, 0xc0, 0xdd, 0x3c, 0x02, 0xbd, 0x83, 0xd5, 0xbf, 0x30, 0x95, 0xa7,
+	0xfa, 0x8f, 0x35, 0xf9, 0x95, 0x85, 0x7e, 0x54, 0x10, 0xe8, 0x79, 0x0e, 0xf4, 0x0c, 0x3d, 0x95,
+	0x13, 0x68, 0xe9, 0x56, 0xa4, 0x2b, 0xcf, 0x5b, 0x23, 0xe3, 0x3d, 0x7d, 0xf3, 0xac, 0xba, 0x51,
+	0xd6, 0xf3, 0x57, 0xce, 0xf4, 0xad, 0x87, 0x51, 0x2c, 0xf1, 0x28, 0xce, 0xd3, 0x73, 0x03, 0x45,
+	0x51, 0x0a, 0xda, 0xf3, 0xfe, 0x07, 0x58, 0xf4, 0xe7, 0x3d, 0x34, 0x77, 0x17, 0xaf, 0x9d, 0xf7,
+	0x03, 0x2c, 0xe9, 0xd7, 0x43, 0x39, 0x3e, 0xc0, 0x22, 0x9d, 0xbf, 0xb6, 0x7b, 0xe1, 0xda, 0x7b,
+	0x1f, 0x17, 0xc8, 0x87, 0x1f, 0x17, 0xc8, 0xdf, 0x3e, 0x2e, 0x90, 0xaf, 0xdf, 0x2d, 0x6c, 0xfa,
+	0xf0, 0x6e, 0x61, 0xd3, 0x9f, 0xee, 0x16, 0x36, 0x3d, 0x7f, 0x2e, 0xf2, 0x83, 0xb7, 0x16, 0x73,
+	0x5c, 0xd3, 0xf5, 0x98, 0x65, 0xb0, 0x67, 0x2d, 0x86, 0xa6, 0xe7, 0x2c, 0xdd, 0x33, 0xd7, 0x59,
+	0x69, 0x7d, 0xa1, 0xf4, 0xc5, 0x8e, 0x1b, 0xfe, 0x4b, 0xb8, 0xca, 0x16, 0xfe, 0xcf, 0xf4, 0x4e,
+	0xfe, 0x77, 0x00, 0x6c, 0x8d, 0x37, 0x1e, 0x23, 0x39, 0x00, 0x00,
 }
 
-func (m *QueryDepositModuleAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	HostChainParams(ctx context.Context, in *QueryHostChainParamsRequest, opts ...grpc.CallOption) (*QueryHostChainParamsResponse, error)
+	DelegationState(ctx context.Context, in *QueryDelegationStateRequest, opts ...grpc.CallOption) (*QueryDelegationStateResponse, error)
+	AllowListedValidators(ctx context.Context, in *QueryAllowListedValidatorsRequest, opts ...grpc.CallOption) (*QueryAllowListedValidatorsResponse, error)
+	CValue(ctx context.Context, in *QueryCValueRequest, opts ...grpc.CallOption) (*QueryCValueResponse, error)
+	ModuleState(ctx context.Context, in *QueryModuleStateRequest, opts ...grpc.CallOption) (*QueryModuleStateResponse, error)
+	IBCTransientStore(ctx context.Context, in *QueryIBCTransientStoreRequest, opts ...grpc.CallOption) (*QueryIBCTransientStoreResponse, error)
+	Unclaimed(ctx context.Context, in *QueryUnclaimedRequest, opts ...grpc.CallOption) (*QueryUnclaimedResponse, error)
+	FailedUnbondings(ctx context.Context, in *QueryFailedUnbondingsRequest, opts ...grpc.CallOption) (*QueryFailedUnbondingsResponse, error)
+	PendingUnbondings(ctx context.Context, in *QueryPendingUnbondingsRequest, opts ...grpc.CallOption) (*QueryPendingUnbondingsResponse, error)
+	UnbondingEpochCValue(ctx context.Context, in *QueryUnbondingEpochCValueRequest, opts ...grpc.CallOption) (*QueryUnbondingEpochCValueResponse, error)
+	HostAccountUndelegation(ctx context.Context, in *QueryHostAccountUndelegationRequest, opts ...grpc.CallOption) (*QueryHostAccountUndelegationResponse, error)
+	DelegatorUnbondingEpochEntry(ctx context.Context, in *QueryDelegatorUnbondingEpochEntryRequest, opts ...grpc.CallOption) (*QueryDelegatorUnbondingEpochEntryResponse, error)
+	HostAccounts(ctx context.Context, in *QueryHostAccountsRequest, opts ...grpc.CallOption) (*QueryHostAccountsResponse, error)
+	DepositModuleAccount(ctx context.Context, in *QueryDepositModuleAccountRequest, opts ...grpc.CallOption) (*QueryDepositModuleAccountResponse, error)
+	DelegatorUnbondingEpochEntries(ctx context.Context, in *QueryAllDelegatorUnbondingEpochEntriesRequest, opts ...grpc.CallOption) (*QueryAllDelegatorUnbondingEpochEntriesResponse, error)
+	RemainingCapacity(ctx context.Context, in *QueryRemainingCapacityRequest, opts ...grpc.CallOption) (*QueryRemainingCapacityResponse, error)
+	FeeSplit(ctx context.Context, in *QueryFeeSplitRequest, opts ...grpc.CallOption) (*QueryFeeSplitResponse, error)
+	CollectedFees(ctx context.Context, in *QueryCollectedFeesRequest, opts ...grpc.CallOption) (*QueryCollectedFeesResponse, error)
+	Roles(ctx context.Context, in *QueryRolesRequest, opts ...grpc.CallOption) (*QueryRolesResponse, error)
+	UnbondingEpochCValues(ctx context.Context, in *QueryUnbondingEpochCValuesRequest, opts ...grpc.CallOption) (*QueryUnbondingEpochCValuesResponse, error)
+	DelegatorUnbondingEpochEntriesInRange(ctx context.Context, in *QueryDelegatorUnbondingEpochEntriesInRangeRequest, opts ...grpc.CallOption) (*QueryDelegatorUnbondingEpochEntriesInRangeResponse, error)
+	HostAccountDelegations(ctx context.Context, in *QueryHostAccountDelegationsRequest, opts ...grpc.CallOption) (*QueryHostAccountDelegationsResponse, error)
+	HostAccountDelegation(ctx context.Context, in *QueryHostAccountDelegationRequest, opts ...grpc.CallOption) (*QueryHostAccountDelegationResponse, error)
+	UnbondingEpochDelegatorEntries(ctx context.Context, in *QueryUnbondingEpochDelegatorEntriesRequest, opts ...grpc.CallOption) (*QueryUnbondingEpochDelegatorEntriesResponse, error)
+	ValidatorWeights(ctx context.Context, in *QueryValidatorWeightsRequest, opts ...grpc.CallOption) (*QueryValidatorWeightsResponse, error)
+	RewardEpochRecords(ctx context.Context, in *QueryRewardEpochRecordsRequest, opts ...grpc.CallOption) (*QueryRewardEpochRecordsResponse, error)
+	HostProposals(ctx context.Context, in *QueryHostProposalsRequest, opts ...grpc.CallOption) (*QueryHostProposalsResponse, error)
+	HostProposal(ctx context.Context, in *QueryHostProposalRequest, opts ...grpc.CallOption) (*QueryHostProposalResponse, error)
+	HostProposalVotes(ctx context.Context, in *QueryHostProposalVotesRequest, opts ...grpc.CallOption) (*QueryHostProposalVotesResponse, error)
+	ModuleStatus(ctx context.Context, in *QueryModuleStatusRequest, opts ...grpc.CallOption) (*QueryModuleStatusResponse, error)
 }
 
-func (m *QueryDepositModuleAccountResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type queryClient struct {
+	cc grpc1.ClientConn
 }
 
-func (m *QueryDepositModuleAccountResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
 }
 
-func (m *QueryDepositModuleAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Balance.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/pstake.lscosmos.v1beta1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryAllDelegatorUnbondingEpochEntriesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) HostChainParams(ctx context.Context, in *QueryHostChainParamsRequest, opts ...grpc.CallOption) (*QueryHostChainParamsResponse, error) {
+	out := new(QueryHostChainParamsResponse)
+	err := c.cc.Invoke(ctx, "/pstake.lscosmos.v1beta1.Query/HostChainParams", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryAllDelegatorUnbondingEpochEntriesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) DelegationState(ctx context.Context, in *QueryDelegationStateRequest, opts ...grpc.CallOption) (*QueryDelegationStateResponse, error) {
+	out := new(QueryDelegationStateResponse)
+	err := c.cc.Invoke(ctx, "/pstake.lscosmos.v1beta1.Query/DelegationState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryAllDelegatorUnbondingEpochEntriesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.DelegatorAddress) > 0 {
-		i -= len(m.DelegatorAddress)
-		copy(dAtA[i:], m.DelegatorAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) AllowListedValidators(ctx context.Context, in *QueryAllowListedValidatorsRequest, opts ...grpc.CallOption) (*QueryAllowListedValidatorsResponse, error) {
+	out := new(QueryAllowListedValidatorsResponse)
+	err := c.cc.Invoke(ctx, "/pstake.lscosmos.v1beta1.Query/AllowListedValidators", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryAllDelegatorUnbondingEpochEntriesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) CValue(ctx context.Context, in *QueryCValueRequest, opts ...grpc.CallOption) (*QueryCValueResponse, error) {
+	out := new(QueryCValueResponse)
+	err := c.cc.Invoke(ctx, "/pstake.lscosmos.v1beta1.Query/CValue", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryAllDelegatorUnbondingEpochEntriesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ModuleState(ctx context.Context, in *QueryModuleStateRequest, opts ...grpc.CallOption) (*QueryModuleStateResponse, error) {
+	out := new(QueryModuleStateResponse)
+	err := c.cc.Invoke(ctx, "/pstake.lscosmos.v1beta1.Query/ModuleState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryAllDelegatorUnbondingEpochEntriesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.DelegatorUnbondingEpochEntries) > 0 {
-		for iNdEx := len(m.DelegatorUnbondingEpochEntries) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.DelegatorUnbondingEpochEntries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *queryClient) IBCTransientStore(ctx context.Context, in *QueryIBCTransientStoreRequest, opts ...grpc.CallOption) (*QueryIBCTransientStoreResponse, error) {
+	out := new(QueryIBCTransientStoreResponse)
+	err := c.cc.Invoke(ctx, "/pstake.lscosmos.v1beta1.Query/IBCTransientStore", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (c *queryClient) Unclaimed(ctx context.Context, in *QueryUnclaimedRequest, opts ...grpc.CallOption) (*QueryUnclaimedResponse, error) {
+	out := new(QueryUnclaimedResponse)
+	err := c.cc.Invoke(ctx, "/pstake.lscosmos.v1beta1.Query/Unclaimed", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return out, nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (c *queryClient) FailedUnbondings(ctx context.Context, in *QueryFailedUnbondingsRequest, opts ...grpc.CallOption) (*QueryFailedUnbondingsResponse, error) {
+	out := new(QueryFailedUnbondingsResponse)
+	err := c.cc.Invoke(ctx, "/pstake.lscosmos.v1beta1.Query/FailedUnbondings", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) PendingUnbondings(ctx context.Context, in *QueryPendingUnbondingsRequest, opts ...grpc.CallOption) (*QueryPendingUnbondingsResponse, error) {
+	out := new(QueryPendingUnbondingsResponse)
+	err := c.cc.Invoke(ctx, "/pstake.lscosmos.v1beta1.Query/PendingUnbondings", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *QueryHostChainParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) UnbondingEpochCValue(ctx context.Context, in *QueryUnbondingEpochCValueRequest, opts ...grpc.CallOption) (*QueryUnbondingEpochCValueResponse, error) {
+	out := new(QueryUnbondingEpochCValueResponse)
+	err := c.cc.Invoke(ctx, "/pstake.lscosmos.v1beta1.Query/UnbondingEpochCValue", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *QueryHostChainParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) HostAccountUndelegation(ctx context.Context, in *QueryHostAccountUndelegationRequest, opts ...grpc.CallOption) (*QueryHostAccountUndelegationResponse, error) {
+	out := new(QueryHostAccountUndelegationResponse)
+	err := c.cc.Invoke(ctx, "/pstake.lscosmos.v1beta1.Query/HostAccountUndelegation", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.HostChainParams.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *QueryDelegationStateRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) DelegatorUnbondingEpochEntry(ctx context.Context, in *QueryDelegatorUnbondingEpochEntryRequest, opts ...grpc.CallOption) (*QueryDelegatorUnbondingEpochEntryResponse, error) {
+	out := new(QueryDelegatorUnbondingEpochEntryResponse)
+	err := c.cc.Invoke(ctx, "/pstake.lscosmos.v1beta1.Query/DelegatorUnbondingEpochEntry", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *QueryDelegationStateResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) HostAccounts(ctx context.Context, in *QueryHostAccountsRequest, opts ...grpc.CallOption) (*QueryHostAccountsResponse, error) {
+	out := new(QueryHostAccountsResponse)
+	err := c.cc.Invoke(ctx, "/pstake.lscosmos.v1beta1.Query/HostAccounts", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.DelegationState.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *QueryAllowListedValidatorsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) DepositModuleAccount(ctx context.Context, in *QueryDepositModuleAccountRequest, opts ...grpc.CallOption) (*QueryDepositModuleAccountResponse, error) {
+	out := new(QueryDepositModuleAccountResponse)
+	err := c.cc.Invoke(ctx, "/pstake.lscosmos.v1beta1.Query/DepositModuleAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *QueryAllowListedValidatorsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) DelegatorUnbondingEpochEntries(ctx context.Context, in *QueryAllDelegatorUnbondingEpochEntriesRequest, opts ...grpc.CallOption) (*QueryAllDelegatorUnbondingEpochEntriesResponse, error) {
+	out := new(QueryAllDelegatorUnbondingEpochEntriesResponse)
+	err := c.cc.Invoke(ctx, "/pstake.lscosmos.v1beta1.Query/DelegatorUnbondingEpochEntries", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.AllowListedValidators.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *QueryCValueRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) RemainingCapacity(ctx context.Context, in *QueryRemainingCapacityRequest, opts ...grpc.CallOption) (*QueryRemainingCapacityResponse, error) {
+	out := new(QueryRemainingCapacityResponse)
+	err := c.cc.Invoke(ctx, "/pstake.lscosmos.v1beta1.Query/RemainingCapacity", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *QueryCValueResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) FeeSplit(ctx context.Context, in *QueryFeeSplitRequest, opts ...grpc.CallOption) (*QueryFeeSplitResponse, error) {
+	out := new(QueryFeeSplitResponse)
+	err := c.cc.Invoke(ctx, "/pstake.lscosmos.v1beta1.Query/FeeSplit", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.CValue.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *QueryModuleStateRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) CollectedFees(ctx context.Context, in *QueryCollectedFeesRequest, opts ...grpc.CallOption) (*QueryCollectedFeesResponse, error) {
+	out := new(QueryCollectedFeesResponse)
+	err := c.cc.Invoke(ctx, "/pstake.lscosmos.v1beta1.Query/CollectedFees", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *QueryModuleStateResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ModuleState {
-		n += 2
+func (c *queryClient) Roles(ctx context.Context, in *QueryRolesRequest, opts ...grpc.CallOption) (*QueryRolesResponse, error) {
+	out := new(QueryRolesResponse)
+	err := c.cc.Invoke(ctx, "/pstake.lscosmos.v1beta1.Query/Roles", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryIBCTransientStoreRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) UnbondingEpochCValues(ctx context.Context, in *QueryUnbondingEpochCValuesRequest, opts ...grpc.CallOption) (*QueryUnbondingEpochCValuesResponse, error) {
+	out := new(QueryUnbondingEpochCValuesResponse)
+	err := c.cc.Invoke(ctx, "/pstake.lscosmos.v1beta1.Query/UnbondingEpochCValues", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *QueryIBCTransientStoreResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) DelegatorUnbondingEpochEntriesInRange(ctx context.Context, in *QueryDelegatorUnbondingEpochEntriesInRangeRequest, opts ...grpc.CallOption) (*QueryDelegatorUnbondingEpochEntriesInRangeResponse, error) {
+	out := new(QueryDelegatorUnbondingEpochEntriesInRangeResponse)
+	err := c.cc.Invoke(ctx, "/pstake.lscosmos.v1beta1.Query/DelegatorUnbondingEpochEntriesInRange", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.IBCTransientStore.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *QueryUnclaimedRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (c *queryClient) HostAccountDelegations(ctx context.Context, in *QueryHostAccountDelegationsRequest, opts ...grpc.CallOption) (*QueryHostAccountDelegationsResponse, error) {
+	out := new(QueryHostAccountDelegationsResponse)
+	err := c.cc.Invoke(ctx, "/pstake.lscosmos.v1beta1.Query/HostAccountDelegations", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryUnclaimedResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Unclaimed) > 0 {
-		for _, e := range m.Unclaimed {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+func (c *queryClient) HostAccountDelegation(ctx context.Context, in *QueryHostAccountDelegationRequest, opts ...grpc.CallOption) (*QueryHostAccountDelegationResponse, error) {
+	out := new(QueryHostAccountDelegationResponse)
+	err := c.cc.Invoke(ctx, "/pstake.lscosmos.v1beta1.Query/HostAccountDelegation", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryFailedUnbondingsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (c *queryClient) UnbondingEpochDelegatorEntries(ctx context.Context, in *QueryUnbondingEpochDelegatorEntriesRequest, opts ...grpc.CallOption) (*QueryUnbondingEpochDelegatorEntriesResponse, error) {
+	out := new(QueryUnbondingEpochDelegatorEntriesResponse)
+	err := c.cc.Invoke(ctx, "/pstake.lscosmos.v1beta1.Query/UnbondingEpochDelegatorEntries", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryFailedUnbondingsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.FailedUnbondings) > 0 {
-		for _, e := range m.FailedUnbondings {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+func (c *queryClient) ValidatorWeights(ctx context.Context, in *QueryValidatorWeightsRequest, opts ...grpc.CallOption) (*QueryValidatorWeightsResponse, error) {
+	out := new(QueryValidatorWeightsResponse)
+	err := c.cc.Invoke(ctx, "/pstake.lscosmos.v1beta1.Query/ValidatorWeights", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryPendingUnbondingsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (c *queryClient) RewardEpochRecords(ctx context.Context, in *QueryRewardEpochRecordsRequest, opts ...grpc.CallOption) (*QueryRewardEpochRecordsResponse, error) {
+	out := new(QueryRewardEpochRecordsResponse)
+	err := c.cc.Invoke(ctx, "/pstake.lscosmos.v1beta1.Query/RewardEpochRecords", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryPendingUnbondingsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.PendingUnbondings) > 0 {
-		for _, e := range m.PendingUnbondings {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+func (c *queryClient) HostProposals(ctx context.Context, in *QueryHostProposalsRequest, opts ...grpc.CallOption) (*QueryHostProposalsResponse, error) {
+	out := new(QueryHostProposalsResponse)
+	err := c.cc.Invoke(ctx, "/pstake.lscosmos.v1beta1.Query/HostProposals", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryUnbondingEpochCValueRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.EpochNumber != 0 {
-		n += 1 + sovQuery(uint64(m.EpochNumber))
+func (c *queryClient) HostProposal(ctx context.Context, in *QueryHostProposalRequest, opts ...grpc.CallOption) (*QueryHostProposalResponse, error) {
+	out := new(QueryHostProposalResponse)
+	err := c.cc.Invoke(ctx, "/pstake.lscosmos.v1beta1.Query/HostProposal", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryUnbondingEpochCValueResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) HostProposalVotes(ctx context.Context, in *QueryHostProposalVotesRequest, opts ...grpc.CallOption) (*QueryHostProposalVotesResponse, error) {
+	out := new(QueryHostProposalVotesResponse)
+	err := c.cc.Invoke(ctx, "/pstake.lscosmos.v1beta1.Query/HostProposalVotes", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.UnbondingEpochCValue.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *QueryHostAccountUndelegationRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.EpochNumber != 0 {
-		n += 1 + sovQuery(uint64(m.EpochNumber))
+func (c *queryClient) ModuleStatus(ctx context.Context, in *QueryModuleStatusRequest, opts ...grpc.CallOption) (*QueryModuleStatusResponse, error) {
+	out := new(QueryModuleStatusResponse)
+	err := c.cc.Invoke(ctx, "/pstake.lscosmos.v1beta1.Query/ModuleStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryHostAccountUndelegationResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.HostAccountUndelegation.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	HostChainParams(context.Context, *QueryHostChainParamsRequest) (*QueryHostChainParamsResponse, error)
+	DelegationState(context.Context, *QueryDelegationStateRequest) (*QueryDelegationStateResponse, error)
+	AllowListedValidators(context.Context, *QueryAllowListedValidatorsRequest) (*QueryAllowListedValidatorsResponse, error)
+	CValue(context.Context, *QueryCValueRequest) (*QueryCValueResponse, error)
+	ModuleState(context.Context, *QueryModuleStateRequest) (*QueryModuleStateResponse, error)
+	IBCTransientStore(context.Context, *QueryIBCTransientStoreRequest) (*QueryIBCTransientStoreResponse, error)
+	Unclaimed(context.Context, *QueryUnclaimedRequest) (*QueryUnclaimedResponse, error)
+	FailedUnbondings(context.Context, *QueryFailedUnbondingsRequest) (*QueryFailedUnbondingsResponse, error)
+	PendingUnbondings(context.Context, *QueryPendingUnbondingsRequest) (*QueryPendingUnbondingsResponse, error)
+	UnbondingEpochCValue(context.Context, *QueryUnbondingEpochCValueRequest) (*QueryUnbondingEpochCValueResponse, error)
+	HostAccountUndelegation(context.Context, *QueryHostAccountUndelegationRequest) (*QueryHostAccountUndelegationResponse, error)
+	DelegatorUnbondingEpochEntry(context.Context, *QueryDelegatorUnbondingEpochEntryRequest) (*QueryDelegatorUnbondingEpochEntryResponse, error)
+	HostAccounts(context.Context, *QueryHostAccountsRequest) (*QueryHostAccountsResponse, error)
+	DepositModuleAccount(context.Context, *QueryDepositModuleAccountRequest) (*QueryDepositModuleAccountResponse, error)
+	DelegatorUnbondingEpochEntries(context.Context, *QueryAllDelegatorUnbondingEpochEntriesRequest) (*QueryAllDelegatorUnbondingEpochEntriesResponse, error)
+	RemainingCapacity(context.Context, *QueryRemainingCapacityRequest) (*QueryRemainingCapacityResponse, error)
+	FeeSplit(context.Context, *QueryFeeSplitRequest) (*QueryFeeSplitResponse, error)
+	CollectedFees(context.Context, *QueryCollectedFeesRequest) (*QueryCollectedFeesResponse, error)
+	Roles(context.Context, *QueryRolesRequest) (*QueryRolesResponse, error)
+	UnbondingEpochCValues(context.Context, *QueryUnbondingEpochCValuesRequest) (*QueryUnbondingEpochCValuesResponse, error)
+	DelegatorUnbondingEpochEntriesInRange(context.Context, *QueryDelegatorUnbondingEpochEntriesInRangeRequest) (*QueryDelegatorUnbondingEpochEntriesInRangeResponse, error)
+	HostAccountDelegations(context.Context, *QueryHostAccountDelegationsRequest) (*QueryHostAccountDelegationsResponse, error)
+	HostAccountDelegation(context.Context, *QueryHostAccountDelegationRequest) (*QueryHostAccountDelegationResponse, error)
+	UnbondingEpochDelegatorEntries(context.Context, *QueryUnbondingEpochDelegatorEntriesRequest) (*QueryUnbondingEpochDelegatorEntriesResponse, error)
+	ValidatorWeights(context.Context, *QueryValidatorWeightsRequest) (*QueryValidatorWeightsResponse, error)
+	RewardEpochRecords(context.Context, *QueryRewardEpochRecordsRequest) (*QueryRewardEpochRecordsResponse, error)
+	HostProposals(context.Context, *QueryHostProposalsRequest) (*QueryHostProposalsResponse, error)
+	HostProposal(context.Context, *QueryHostProposalRequest) (*QueryHostProposalResponse, error)
+	HostProposalVotes(context.Context, *QueryHostProposalVotesRequest) (*QueryHostProposalVotesResponse, error)
+	ModuleStatus(context.Context, *QueryModuleStatusRequest) (*QueryModuleStatusResponse, error)
+}
 
-func (m *QueryDelegatorUnbondingEpochEntryRequest) Size() (n int) {
-	if m == nil {
-		return 0
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) HostChainParams(ctx context.Context, req *QueryHostChainParamsRequest) (*QueryHostChainParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HostChainParams not implemented")
+}
+func (*UnimplementedQueryServer) DelegationState(ctx context.Context, req *QueryDelegationStateRequest) (*QueryDelegationStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegationState not implemented")
+}
+func (*UnimplementedQueryServer) AllowListedValidators(ctx context.Context, req *QueryAllowListedValidatorsRequest) (*QueryAllowListedValidatorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllowListedValidators not implemented")
+}
+func (*UnimplementedQueryServer) CValue(ctx context.Context, req *QueryCValueRequest) (*QueryCValueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CValue not implemented")
+}
+func (*UnimplementedQueryServer) ModuleState(ctx context.Context, req *QueryModuleStateRequest) (*QueryModuleStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModuleState not implemented")
+}
+func (*UnimplementedQueryServer) IBCTransientStore(ctx context.Context, req *QueryIBCTransientStoreRequest) (*QueryIBCTransientStoreResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IBCTransientStore not implemented")
+}
+func (*UnimplementedQueryServer) Unclaimed(ctx context.Context, req *QueryUnclaimedRequest) (*QueryUnclaimedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Unclaimed not implemented")
+}
+func (*UnimplementedQueryServer) FailedUnbondings(ctx context.Context, req *QueryFailedUnbondingsRequest) (*QueryFailedUnbondingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FailedUnbondings not implemented")
+}
+func (*UnimplementedQueryServer) PendingUnbondings(ctx context.Context, req *QueryPendingUnbondingsRequest) (*QueryPendingUnbondingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingUnbondings not implemented")
+}
+func (*UnimplementedQueryServer) UnbondingEpochCValue(ctx context.Context, req *QueryUnbondingEpochCValueRequest) (*QueryUnbondingEpochCValueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnbondingEpochCValue not implemented")
+}
+func (*UnimplementedQueryServer) HostAccountUndelegation(ctx context.Context, req *QueryHostAccountUndelegationRequest) (*QueryHostAccountUndelegationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HostAccountUndelegation not implemented")
+}
+func (*UnimplementedQueryServer) DelegatorUnbondingEpochEntry(ctx context.Context, req *QueryDelegatorUnbondingEpochEntryRequest) (*QueryDelegatorUnbondingEpochEntryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegatorUnbondingEpochEntry not implemented")
+}
+func (*UnimplementedQueryServer) HostAccounts(ctx context.Context, req *QueryHostAccountsRequest) (*QueryHostAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HostAccounts not implemented")
+}
+func (*UnimplementedQueryServer) DepositModuleAccount(ctx context.Context, req *QueryDepositModuleAccountRequest) (*QueryDepositModuleAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DepositModuleAccount not implemented")
+}
+func (*UnimplementedQueryServer) DelegatorUnbondingEpochEntries(ctx context.Context, req *QueryAllDelegatorUnbondingEpochEntriesRequest) (*QueryAllDelegatorUnbondingEpochEntriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegatorUnbondingEpochEntries not implemented")
+}
+func (*UnimplementedQueryServer) RemainingCapacity(ctx context.Context, req *QueryRemainingCapacityRequest) (*QueryRemainingCapacityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemainingCapacity not implemented")
+}
+func (*UnimplementedQueryServer) FeeSplit(ctx context.Context, req *QueryFeeSplitRequest) (*QueryFeeSplitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeeSplit not implemented")
+}
+func (*UnimplementedQueryServer) CollectedFees(ctx context.Context, req *QueryCollectedFeesRequest) (*QueryCollectedFeesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CollectedFees not implemented")
+}
+func (*UnimplementedQueryServer) Roles(ctx context.Context, req *QueryRolesRequest) (*QueryRolesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Roles not implemented")
+}
+func (*UnimplementedQueryServer) UnbondingEpochCValues(ctx context.Context, req *QueryUnbondingEpochCValuesRequest) (*QueryUnbondingEpochCValuesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnbondingEpochCValues not implemented")
+}
+func (*UnimplementedQueryServer) DelegatorUnbondingEpochEntriesInRange(ctx context.Context, req *QueryDelegatorUnbondingEpochEntriesInRangeRequest) (*QueryDelegatorUnbondingEpochEntriesInRangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegatorUnbondingEpochEntriesInRange not implemented")
+}
+func (*UnimplementedQueryServer) HostAccountDelegations(ctx context.Context, req *QueryHostAccountDelegationsRequest) (*QueryHostAccountDelegationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HostAccountDelegations not implemented")
+}
+func (*UnimplementedQueryServer) HostAccountDelegation(ctx context.Context, req *QueryHostAccountDelegationRequest) (*QueryHostAccountDelegationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HostAccountDelegation not implemented")
+}
+func (*UnimplementedQueryServer) UnbondingEpochDelegatorEntries(ctx context.Context, req *QueryUnbondingEpochDelegatorEntriesRequest) (*QueryUnbondingEpochDelegatorEntriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnbondingEpochDelegatorEntries not implemented")
+}
+func (*UnimplementedQueryServer) ValidatorWeights(ctx context.Context, req *QueryValidatorWeightsRequest) (*QueryValidatorWeightsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorWeights not implemented")
+}
+func (*UnimplementedQueryServer) RewardEpochRecords(ctx context.Context, req *QueryRewardEpochRecordsRequest) (*QueryRewardEpochRecordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RewardEpochRecords not implemented")
+}
+func (*UnimplementedQueryServer) HostProposals(ctx context.Context, req *QueryHostProposalsRequest) (*QueryHostProposalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HostProposals not implemented")
+}
+func (*UnimplementedQueryServer) HostProposal(ctx context.Context, req *QueryHostProposalRequest) (*QueryHostProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HostProposal not implemented")
+}
+func (*UnimplementedQueryServer) HostProposalVotes(ctx context.Context, req *QueryHostProposalVotesRequest) (*QueryHostProposalVotesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HostProposalVotes not implemented")
+}
+func (*UnimplementedQueryServer) ModuleStatus(ctx context.Context, req *QueryModuleStatusRequest) (*QueryModuleStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModuleStatus not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
 	}
-	if m.EpochNumber != 0 {
-		n += 1 + sovQuery(uint64(m.EpochNumber))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.lscosmos.v1beta1.Query/Params",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryDelegatorUnbondingEpochEntryResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_HostChainParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHostChainParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.DelegatorUnbodingEpochEntry.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	if interceptor == nil {
+		return srv.(QueryServer).HostChainParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.lscosmos.v1beta1.Query/HostChainParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HostChainParams(ctx, req.(*QueryHostChainParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryHostAccountsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_DelegationState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegationStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(QueryServer).DelegationState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.lscosmos.v1beta1.Query/DelegationState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelegationState(ctx, req.(*QueryDelegationStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryHostAccountsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_AllowListedValidators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllowListedValidatorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.HostAccounts.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	if interceptor == nil {
+		return srv.(QueryServer).AllowListedValidators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.lscosmos.v1beta1.Query/AllowListedValidators",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllowListedValidators(ctx, req.(*QueryAllowListedValidatorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryDepositModuleAccountRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_CValue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCValueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(QueryServer).CValue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.lscosmos.v1beta1.Query/CValue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CValue(ctx, req.(*QueryCValueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryDepositModuleAccountResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_ModuleState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryModuleStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Balance.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	if interceptor == nil {
+		return srv.(QueryServer).ModuleState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.lscosmos.v1beta1.Query/ModuleState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ModuleState(ctx, req.(*QueryModuleStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllDelegatorUnbondingEpochEntriesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_IBCTransientStore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIBCTransientStoreRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).IBCTransientStore(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.lscosmos.v1beta1.Query/IBCTransientStore",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IBCTransientStore(ctx, req.(*QueryIBCTransientStoreRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllDelegatorUnbondingEpochEntriesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
+func _Query_Unclaimed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUnclaimedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Unclaimed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.lscosmos.v1beta1.Query/Unclaimed",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Unclaimed(ctx, req.(*QueryUnclaimedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FailedUnbondings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFailedUnbondingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FailedUnbondings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.lscosmos.v1beta1.Query/FailedUnbondings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FailedUnbondings(ctx, req.(*QueryFailedUnbondingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PendingUnbondings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingUnbondingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingUnbondings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.lscosmos.v1beta1.Query/PendingUnbondings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingUnbondings(ctx, req.(*QueryPendingUnbondingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_UnbondingEpochCValue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUnbondingEpochCValueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UnbondingEpochCValue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.lscosmos.v1beta1.Query/UnbondingEpochCValue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UnbondingEpochCValue(ctx, req.(*QueryUnbondingEpochCValueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_HostAccountUndelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHostAccountUndelegationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).HostAccountUndelegation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.lscosmos.v1beta1.Query/HostAccountUndelegation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HostAccountUndelegation(ctx, req.(*QueryHostAccountUndelegationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DelegatorUnbondingEpochEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegatorUnbondingEpochEntryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DelegatorUnbondingEpochEntry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.lscosmos.v1beta1.Query/DelegatorUnbondingEpochEntry",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelegatorUnbondingEpochEntry(ctx, req.(*QueryDelegatorUnbondingEpochEntryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_HostAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHostAccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).HostAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.lscosmos.v1beta1.Query/HostAccounts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HostAccounts(ctx, req.(*QueryHostAccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DepositModuleAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDepositModuleAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DepositModuleAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.lscosmos.v1beta1.Query/DepositModuleAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DepositModuleAccount(ctx, req.(*QueryDepositModuleAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DelegatorUnbondingEpochEntries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllDelegatorUnbondingEpochEntriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DelegatorUnbondingEpochEntries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.lscosmos.v1beta1.Query/DelegatorUnbondingEpochEntries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelegatorUnbondingEpochEntries(ctx, req.(*QueryAllDelegatorUnbondingEpochEntriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RemainingCapacity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRemainingCapacityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RemainingCapacity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.lscosmos.v1beta1.Query/RemainingCapacity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RemainingCapacity(ctx, req.(*QueryRemainingCapacityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FeeSplit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeeSplitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeeSplit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.lscosmos.v1beta1.Query/FeeSplit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeeSplit(ctx, req.(*QueryFeeSplitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CollectedFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCollectedFeesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CollectedFees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.lscosmos.v1beta1.Query/CollectedFees",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CollectedFees(ctx, req.(*QueryCollectedFeesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Roles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRolesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Roles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.lscosmos.v1beta1.Query/Roles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Roles(ctx, req.(*QueryRolesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_UnbondingEpochCValues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUnbondingEpochCValuesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UnbondingEpochCValues(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.lscosmos.v1beta1.Query/UnbondingEpochCValues",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UnbondingEpochCValues(ctx, req.(*QueryUnbondingEpochCValuesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DelegatorUnbondingEpochEntriesInRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegatorUnbondingEpochEntriesInRangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DelegatorUnbondingEpochEntriesInRange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.lscosmos.v1beta1.Query/DelegatorUnbondingEpochEntriesInRange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelegatorUnbondingEpochEntriesInRange(ctx, req.(*QueryDelegatorUnbondingEpochEntriesInRangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_HostAccountDelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHostAccountDelegationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).HostAccountDelegations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.lscosmos.v1beta1.Query/HostAccountDelegations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HostAccountDelegations(ctx, req.(*QueryHostAccountDelegationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_HostAccountDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHostAccountDelegationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).HostAccountDelegation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.lscosmos.v1beta1.Query/HostAccountDelegation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HostAccountDelegation(ctx, req.(*QueryHostAccountDelegationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_UnbondingEpochDelegatorEntries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUnbondingEpochDelegatorEntriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UnbondingEpochDelegatorEntries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.lscosmos.v1beta1.Query/UnbondingEpochDelegatorEntries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UnbondingEpochDelegatorEntries(ctx, req.(*QueryUnbondingEpochDelegatorEntriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorWeights_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorWeightsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorWeights(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.lscosmos.v1beta1.Query/ValidatorWeights",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorWeights(ctx, req.(*QueryValidatorWeightsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RewardEpochRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRewardEpochRecordsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RewardEpochRecords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.lscosmos.v1beta1.Query/RewardEpochRecords",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RewardEpochRecords(ctx, req.(*QueryRewardEpochRecordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_HostProposals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHostProposalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).HostProposals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.lscosmos.v1beta1.Query/HostProposals",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HostProposals(ctx, req.(*QueryHostProposalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_HostProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHostProposalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).HostProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.lscosmos.v1beta1.Query/HostProposal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HostProposal(ctx, req.(*QueryHostProposalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_HostProposalVotes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHostProposalVotesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).HostProposalVotes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.lscosmos.v1beta1.Query/HostProposalVotes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HostProposalVotes(ctx, req.(*QueryHostProposalVotesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ModuleStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryModuleStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ModuleStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.lscosmos.v1beta1.Query/ModuleStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ModuleStatus(ctx, req.(*QueryModuleStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "pstake.lscosmos.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "HostChainParams",
+			Handler:    _Query_HostChainParams_Handler,
+		},
+		{
+			MethodName: "DelegationState",
+			Handler:    _Query_DelegationState_Handler,
+		},
+		{
+			MethodName: "AllowListedValidators",
+			Handler:    _Query_AllowListedValidators_Handler,
+		},
+		{
+			MethodName: "CValue",
+			Handler:    _Query_CValue_Handler,
+		},
+		{
+			MethodName: "ModuleState",
+			Handler:    _Query_ModuleState_Handler,
+		},
+		{
+			MethodName: "IBCTransientStore",
+			Handler:    _Query_IBCTransientStore_Handler,
+		},
+		{
+			MethodName: "Unclaimed",
+			Handler:    _Query_Unclaimed_Handler,
+		},
+		{
+			MethodName: "FailedUnbondings",
+			Handler:    _Query_FailedUnbondings_Handler,
+		},
+		{
+			MethodName: "PendingUnbondings",
+			Handler:    _Query_PendingUnbondings_Handler,
+		},
+		{
+			MethodName: "UnbondingEpochCValue",
+			Handler:    _Query_UnbondingEpochCValue_Handler,
+		},
+		{
+			MethodName: "HostAccountUndelegation",
+			Handler:    _Query_HostAccountUndelegation_Handler,
+		},
+		{
+			MethodName: "DelegatorUnbondingEpochEntry",
+			Handler:    _Query_DelegatorUnbondingEpochEntry_Handler,
+		},
+		{
+			MethodName: "HostAccounts",
+			Handler:    _Query_HostAccounts_Handler,
+		},
+		{
+			MethodName: "DepositModuleAccount",
+			Handler:    _Query_DepositModuleAccount_Handler,
+		},
+		{
+			MethodName: "DelegatorUnbondingEpochEntries",
+			Handler:    _Query_DelegatorUnbondingEpochEntries_Handler,
+		},
+		{
+			MethodName: "RemainingCapacity",
+			Handler:    _Query_RemainingCapacity_Handler,
+		},
+		{
+			MethodName: "FeeSplit",
+			Handler:    _Query_FeeSplit_Handler,
+		},
+		{
+			MethodName: "CollectedFees",
+			Handler:    _Query_CollectedFees_Handler,
+		},
+		{
+			MethodName: "Roles",
+			Handler:    _Query_Roles_Handler,
+		},
+		{
+			MethodName: "UnbondingEpochCValues",
+			Handler:    _Query_UnbondingEpochCValues_Handler,
+		},
+		{
+			MethodName: "DelegatorUnbondingEpochEntriesInRange",
+			Handler:    _Query_DelegatorUnbondingEpochEntriesInRange_Handler,
+		},
+		{
+			MethodName: "HostAccountDelegations",
+			Handler:    _Query_HostAccountDelegations_Handler,
+		},
+		{
+			MethodName: "HostAccountDelegation",
+			Handler:    _Query_HostAccountDelegation_Handler,
+		},
+		{
+			MethodName: "UnbondingEpochDelegatorEntries",
+			Handler:    _Query_UnbondingEpochDelegatorEntries_Handler,
+		},
+		{
+			MethodName: "ValidatorWeights",
+			Handler:    _Query_ValidatorWeights_Handler,
+		},
+		{
+			MethodName: "RewardEpochRecords",
+			Handler:    _Query_RewardEpochRecords_Handler,
+		},
+		{
+			MethodName: "HostProposals",
+			Handler:    _Query_HostProposals_Handler,
+		},
+		{
+			MethodName: "HostProposal",
+			Handler:    _Query_HostProposal_Handler,
+		},
+		{
+			MethodName: "HostProposalVotes",
+			Handler:    _Query_HostProposalVotes_Handler,
+		},
+		{
+			MethodName: "ModuleStatus",
+			Handler:    _Query_ModuleStatus_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "pstake/lscosmos/v1beta1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHostChainParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHostChainParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHostChainParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHostChainParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHostChainParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHostChainParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.HostChainParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegationStateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegationStateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegationStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegationStateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegationStateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegationStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.DelegationState.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllowListedValidatorsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllowListedValidatorsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllowListedValidatorsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllowListedValidatorsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllowListedValidatorsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllowListedValidatorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.AllowListedValidators.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCValueRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCValueRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCValueRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCValueResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCValueResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCValueResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.CValue.Size()
+		i -= size
+		if _, err := m.CValue.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryModuleStateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryModuleStateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryModuleStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryModuleStateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryModuleStateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryModuleStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ModuleState {
+		i--
+		if m.ModuleState {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIBCTransientStoreRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIBCTransientStoreRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIBCTransientStoreRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIBCTransientStoreResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIBCTransientStoreResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIBCTransientStoreResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.IBCTransientStore.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUnclaimedRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUnclaimedRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUnclaimedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUnclaimedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUnclaimedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUnclaimedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Unclaimed) > 0 {
+		for iNdEx := len(m.Unclaimed) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Unclaimed[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFailedUnbondingsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFailedUnbondingsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFailedUnbondingsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFailedUnbondingsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFailedUnbondingsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFailedUnbondingsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FailedUnbondings) > 0 {
+		for iNdEx := len(m.FailedUnbondings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FailedUnbondings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingUnbondingsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingUnbondingsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingUnbondingsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingUnbondingsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingUnbondingsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingUnbondingsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PendingUnbondings) > 0 {
+		for iNdEx := len(m.PendingUnbondings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingUnbondings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUnbondingEpochCValueRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUnbondingEpochCValueRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUnbondingEpochCValueRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EpochNumber != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUnbondingEpochCValueResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUnbondingEpochCValueResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUnbondingEpochCValueResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.UnbondingEpochCValue.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHostAccountUndelegationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHostAccountUndelegationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHostAccountUndelegationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EpochNumber != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHostAccountUndelegationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHostAccountUndelegationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHostAccountUndelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.HostAccountUndelegation.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegatorUnbondingEpochEntryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegatorUnbondingEpochEntryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegatorUnbondingEpochEntryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EpochNumber != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegatorUnbondingEpochEntryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegatorUnbondingEpochEntryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegatorUnbondingEpochEntryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.DelegatorUnbodingEpochEntry.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHostAccountsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHostAccountsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHostAccountsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHostAccountsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHostAccountsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHostAccountsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.HostAccounts.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDepositModuleAccountRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDepositModuleAccountRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDepositModuleAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDepositModuleAccountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDepositModuleAccountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDepositModuleAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Balance.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllDelegatorUnbondingEpochEntriesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllDelegatorUnbondingEpochEntriesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllDelegatorUnbondingEpochEntriesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllDelegatorUnbondingEpochEntriesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllDelegatorUnbondingEpochEntriesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllDelegatorUnbondingEpochEntriesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorUnbondingEpochEntries) > 0 {
+		for iNdEx := len(m.DelegatorUnbondingEpochEntries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DelegatorUnbondingEpochEntries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRemainingCapacityRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRemainingCapacityRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRemainingCapacityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRemainingCapacityResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRemainingCapacityResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRemainingCapacityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.AddressDeposits.Size()
+		i -= size
+		if _, err := m.AddressDeposits.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.EpochDeposits.Size()
+		i -= size
+		if _, err := m.EpochDeposits.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Tvl.Size()
+		i -= size
+		if _, err := m.Tvl.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Limited {
+		i--
+		if m.Limited {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.Remaining.Size()
+		i -= size
+		if _, err := m.Remaining.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeSplitRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeSplitRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeSplitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeSplitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeSplitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeSplitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.FeeSplit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCollectedFeesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCollectedFeesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCollectedFeesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FeeType) > 0 {
+		i -= len(m.FeeType)
+		copy(dAtA[i:], m.FeeType)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FeeType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCollectedFeesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCollectedFeesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCollectedFeesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CollectedFees) > 0 {
+		for iNdEx := len(m.CollectedFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CollectedFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRolesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRolesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRolesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRolesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRolesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRolesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PendingAdminChange != nil {
+		{
+			size, err := m.PendingAdminChange.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Roles.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryModuleStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryModuleStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryModuleStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryModuleStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryModuleStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryModuleStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.PauseSwitches.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.ModuleEnabled {
+		i--
+		if m.ModuleEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUnbondingEpochCValuesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUnbondingEpochCValuesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUnbondingEpochCValuesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EndEpoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EndEpoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.StartEpoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StartEpoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUnbondingEpochCValuesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUnbondingEpochCValuesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUnbondingEpochCValuesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UnbondingEpochCValues) > 0 {
+		for iNdEx := len(m.UnbondingEpochCValues) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UnbondingEpochCValues[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegatorUnbondingEpochEntriesInRangeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegatorUnbondingEpochEntriesInRangeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegatorUnbondingEpochEntriesInRangeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EndEpoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EndEpoch))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StartEpoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StartEpoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegatorUnbondingEpochEntriesInRangeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegatorUnbondingEpochEntriesInRangeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegatorUnbondingEpochEntriesInRangeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DelegatorUnbondingEpochEntries) > 0 {
+		for iNdEx := len(m.DelegatorUnbondingEpochEntries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DelegatorUnbondingEpochEntries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHostAccountDelegationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHostAccountDelegationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHostAccountDelegationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHostAccountDelegationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHostAccountDelegationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHostAccountDelegationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.HostAccountDelegations) > 0 {
+		for iNdEx := len(m.HostAccountDelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HostAccountDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHostAccountDelegationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHostAccountDelegationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHostAccountDelegationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHostAccountDelegationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHostAccountDelegationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHostAccountDelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.HostAccountDelegation.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUnbondingEpochDelegatorEntriesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUnbondingEpochDelegatorEntriesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUnbondingEpochDelegatorEntriesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.EpochNumber != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUnbondingEpochDelegatorEntriesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUnbondingEpochDelegatorEntriesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUnbondingEpochDelegatorEntriesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorUnbondingEpochEntries) > 0 {
+		for iNdEx := len(m.DelegatorUnbondingEpochEntries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DelegatorUnbondingEpochEntries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorWeightsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorWeightsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorWeightsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorWeightsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorWeightsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorWeightsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorWeights) > 0 {
+		for iNdEx := len(m.ValidatorWeights) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorWeights[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRewardEpochRecordsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRewardEpochRecordsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRewardEpochRecordsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRewardEpochRecordsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRewardEpochRecordsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRewardEpochRecordsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.CarriedOverRewards.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.RewardEpochRecords) > 0 {
+		for iNdEx := len(m.RewardEpochRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardEpochRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHostProposalsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHostProposalsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHostProposalsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHostProposalsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHostProposalsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHostProposalsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.HostProposals) > 0 {
+		for iNdEx := len(m.HostProposals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HostProposals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHostProposalRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHostProposalRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHostProposalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHostProposalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHostProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHostProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.HostProposal.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHostProposalVotesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHostProposalVotesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHostProposalVotesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHostProposalVotesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHostProposalVotesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHostProposalVotesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Votes) > 0 {
+		for iNdEx := len(m.Votes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Votes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryHostChainParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryHostChainParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.HostChainParams.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryDelegationStateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDelegationStateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.DelegationState.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllowListedValidatorsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllowListedValidatorsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.AllowListedValidators.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCValueRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryCValueResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CValue.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryModuleStateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryModuleStateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ModuleState {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryIBCTransientStoreRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryIBCTransientStoreResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.IBCTransientStore.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryUnclaimedRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryUnclaimedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Unclaimed) > 0 {
+		for _, e := range m.Unclaimed {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFailedUnbondingsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFailedUnbondingsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.FailedUnbondings) > 0 {
+		for _, e := range m.FailedUnbondings {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingUnbondingsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingUnbondingsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PendingUnbondings) > 0 {
+		for _, e := range m.PendingUnbondings {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryUnbondingEpochCValueRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochNumber != 0 {
+		n += 1 + sovQuery(uint64(m.EpochNumber))
+	}
+	return n
+}
+
+func (m *QueryUnbondingEpochCValueResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.UnbondingEpochCValue.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryHostAccountUndelegationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochNumber != 0 {
+		n += 1 + sovQuery(uint64(m.EpochNumber))
+	}
+	return n
+}
+
+func (m *QueryHostAccountUndelegationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.HostAccountUndelegation.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryDelegatorUnbondingEpochEntryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.EpochNumber != 0 {
+		n += 1 + sovQuery(uint64(m.EpochNumber))
+	}
+	return n
+}
+
+func (m *QueryDelegatorUnbondingEpochEntryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.DelegatorUnbodingEpochEntry.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryHostAccountsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryHostAccountsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.HostAccounts.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryDepositModuleAccountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryDepositModuleAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Balance.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllDelegatorUnbondingEpochEntriesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllDelegatorUnbondingEpochEntriesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.DelegatorUnbondingEpochEntries) > 0 {
+		for _, e := range m.DelegatorUnbondingEpochEntries {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRemainingCapacityRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRemainingCapacityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Remaining.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Limited {
+		n += 2
+	}
+	l = m.Tvl.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.EpochDeposits.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.AddressDeposits.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryFeeSplitRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryFeeSplitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.FeeSplit.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryCollectedFeesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FeeType)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCollectedFeesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CollectedFees) > 0 {
+		for _, e := range m.CollectedFees {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryRolesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryRolesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Roles.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.PendingAdminChange != nil {
+		l = m.PendingAdminChange.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryModuleStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryModuleStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ModuleEnabled {
+		n += 2
+	}
+	l = m.PauseSwitches.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryUnbondingEpochCValuesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartEpoch != 0 {
+		n += 1 + sovQuery(uint64(m.StartEpoch))
+	}
+	if m.EndEpoch != 0 {
+		n += 1 + sovQuery(uint64(m.EndEpoch))
+	}
+	return n
+}
+
+func (m *QueryUnbondingEpochCValuesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.UnbondingEpochCValues) > 0 {
+		for _, e := range m.UnbondingEpochCValues {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryDelegatorUnbondingEpochEntriesInRangeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.StartEpoch != 0 {
+		n += 1 + sovQuery(uint64(m.StartEpoch))
+	}
+	if m.EndEpoch != 0 {
+		n += 1 + sovQuery(uint64(m.EndEpoch))
+	}
+	return n
+}
+
+func (m *QueryDelegatorUnbondingEpochEntriesInRangeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.DelegatorUnbondingEpochEntries) > 0 {
+		for _, e := range m.DelegatorUnbondingEpochEntries {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryHostAccountDelegationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryHostAccountDelegationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.HostAccountDelegations) > 0 {
+		for _, e := range m.HostAccountDelegations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryHostAccountDelegationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryHostAccountDelegationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.HostAccountDelegation.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryUnbondingEpochDelegatorEntriesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochNumber != 0 {
+		n += 1 + sovQuery(uint64(m.EpochNumber))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryUnbondingEpochDelegatorEntriesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
 	if len(m.DelegatorUnbondingEpochEntries) > 0 {
 		for _, e := range m.DelegatorUnbondingEpochEntries {
 			l = e.Size()
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
-	return n
-}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorWeightsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorWeightsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ValidatorWeights) > 0 {
+		for _, e := range m.ValidatorWeights {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRewardEpochRecordsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRewardEpochRecordsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RewardEpochRecords) > 0 {
+		for _, e := range m.RewardEpochRecords {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.CarriedOverRewards.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryHostProposalsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryHostProposalsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.HostProposals) > 0 {
+		for _, e := range m.HostProposals {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryHostProposalRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovQuery(uint64(m.ProposalId))
+	}
+	return n
+}
+
+func (m *QueryHostProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.HostProposal.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryHostProposalVotesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovQuery(uint64(m.ProposalId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryHostProposalVotesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Votes) > 0 {
+		for _, e := range m.Votes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHostChainParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHostChainParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHostChainParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHostChainParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHostChainParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHostChainParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostChainParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.HostChainParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDelegationStateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDelegationStateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDelegationStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDelegationStateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDelegationStateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDelegationStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegationState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DelegationState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllowListedValidatorsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllowListedValidatorsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllowListedValidatorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllowListedValidatorsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllowListedValidatorsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllowListedValidatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowListedValidators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AllowListedValidators.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCValueRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCValueRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCValueRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCValueResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCValueResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCValueResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryModuleStateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryModuleStateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryModuleStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryModuleStateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryModuleStateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryModuleStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleState", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ModuleState = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIBCTransientStoreRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIBCTransientStoreRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIBCTransientStoreRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIBCTransientStoreResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIBCTransientStoreResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIBCTransientStoreResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IBCTransientStore", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.IBCTransientStore.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUnclaimedRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUnclaimedRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUnclaimedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUnclaimedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUnclaimedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUnclaimedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unclaimed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Unclaimed = append(m.Unclaimed, UnbondingEpochCValue{})
+			if err := m.Unclaimed[len(m.Unclaimed)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFailedUnbondingsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFailedUnbondingsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFailedUnbondingsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFailedUnbondingsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFailedUnbondingsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFailedUnbondingsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedUnbondings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FailedUnbondings = append(m.FailedUnbondings, UnbondingEpochCValue{})
+			if err := m.FailedUnbondings[len(m.FailedUnbondings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingUnbondingsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingUnbondingsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingUnbondingsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingUnbondingsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingUnbondingsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingUnbondingsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingUnbondings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingUnbondings = append(m.PendingUnbondings, UnbondingEpochCValue{})
+			if err := m.PendingUnbondings[len(m.PendingUnbondings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUnbondingEpochCValueRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUnbondingEpochCValueRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUnbondingEpochCValueRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUnbondingEpochCValueResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUnbondingEpochCValueResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUnbondingEpochCValueResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingEpochCValue", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UnbondingEpochCValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHostAccountUndelegationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHostAccountUndelegationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHostAccountUndelegationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHostAccountUndelegationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHostAccountUndelegationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHostAccountUndelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostAccountUndelegation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.HostAccountUndelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDelegatorUnbondingEpochEntryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDelegatorUnbondingEpochEntryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDelegatorUnbondingEpochEntryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDelegatorUnbondingEpochEntryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDelegatorUnbondingEpochEntryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDelegatorUnbondingEpochEntryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorUnbodingEpochEntry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DelegatorUnbodingEpochEntry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHostAccountsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHostAccountsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHostAccountsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHostAccountsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHostAccountsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHostAccountsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostAccounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.HostAccounts.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDepositModuleAccountRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDepositModuleAccountRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDepositModuleAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDepositModuleAccountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDepositModuleAccountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDepositModuleAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllDelegatorUnbondingEpochEntriesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllDelegatorUnbondingEpochEntriesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllDelegatorUnbondingEpochEntriesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllDelegatorUnbondingEpochEntriesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllDelegatorUnbondingEpochEntriesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllDelegatorUnbondingEpochEntriesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorUnbondingEpochEntries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorUnbondingEpochEntries = append(m.DelegatorUnbondingEpochEntries, DelegatorUnbondingEpochEntry{})
+			if err := m.DelegatorUnbondingEpochEntries[len(m.DelegatorUnbondingEpochEntries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryRemainingCapacityRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3570,12 +10364,44 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRemainingCapacityRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRemainingCapacityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3597,7 +10423,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryRemainingCapacityResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3620,17 +10446,139 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRemainingCapacityResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRemainingCapacityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Remaining", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Remaining.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limited", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Limited = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tvl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Tvl.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochDeposits", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EpochDeposits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddressDeposits", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3640,22 +10588,23 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AddressDeposits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3680,7 +10629,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryHostChainParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryFeeSplitRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3703,10 +10652,10 @@ func (m *QueryHostChainParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryHostChainParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFeeSplitRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryHostChainParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFeeSplitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3730,7 +10679,7 @@ func (m *QueryHostChainParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryHostChainParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryFeeSplitResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3753,15 +10702,15 @@ func (m *QueryHostChainParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryHostChainParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFeeSplitResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryHostChainParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFeeSplitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HostChainParams", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeSplit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3788,7 +10737,7 @@ func (m *QueryHostChainParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.HostChainParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.FeeSplit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3813,7 +10762,7 @@ func (m *QueryHostChainParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDelegationStateRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryCollectedFeesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3836,12 +10785,44 @@ func (m *QueryDelegationStateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDelegationStateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCollectedFeesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDelegationStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCollectedFeesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3863,7 +10844,7 @@ func (m *QueryDelegationStateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDelegationStateResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCollectedFeesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3886,15 +10867,15 @@ func (m *QueryDelegationStateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDelegationStateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCollectedFeesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDelegationStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCollectedFeesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegationState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CollectedFees", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3921,7 +10902,8 @@ func (m *QueryDelegationStateResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DelegationState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.CollectedFees = append(m.CollectedFees, CollectedFee{})
+			if err := m.CollectedFees[len(m.CollectedFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3946,7 +10928,7 @@ func (m *QueryDelegationStateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllowListedValidatorsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryRolesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3969,10 +10951,10 @@ func (m *QueryAllowListedValidatorsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllowListedValidatorsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRolesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllowListedValidatorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRolesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3996,7 +10978,7 @@ func (m *QueryAllowListedValidatorsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllowListedValidatorsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryRolesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4019,15 +11001,15 @@ func (m *QueryAllowListedValidatorsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllowListedValidatorsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRolesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllowListedValidatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRolesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllowListedValidators", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Roles", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4054,7 +11036,43 @@ func (m *QueryAllowListedValidatorsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AllowListedValidators.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Roles.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingAdminChange", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PendingAdminChange == nil {
+				m.PendingAdminChange = &PendingAdminChange{}
+			}
+			if err := m.PendingAdminChange.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4079,7 +11097,7 @@ func (m *QueryAllowListedValidatorsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCValueRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryModuleStatusRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4102,10 +11120,10 @@ func (m *QueryCValueRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCValueRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryModuleStatusRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCValueRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryModuleStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4129,7 +11147,7 @@ func (m *QueryCValueRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCValueResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryModuleStatusResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4152,17 +11170,37 @@ func (m *QueryCValueResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCValueResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryModuleStatusResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCValueResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryModuleStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ModuleEnabled = bool(v != 0)
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CValue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PauseSwitches", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4172,23 +11210,22 @@ func (m *QueryCValueResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PauseSwitches.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4213,7 +11250,7 @@ func (m *QueryCValueResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryModuleStateRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryUnbondingEpochCValuesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4236,12 +11273,50 @@ func (m *QueryModuleStateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryModuleStateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryUnbondingEpochCValuesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryModuleStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryUnbondingEpochCValuesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartEpoch", wireType)
+			}
+			m.StartEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartEpoch |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndEpoch", wireType)
+			}
+			m.EndEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndEpoch |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4263,7 +11338,7 @@ func (m *QueryModuleStateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryModuleStateResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryUnbondingEpochCValuesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4286,17 +11361,17 @@ func (m *QueryModuleStateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryModuleStateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryUnbondingEpochCValuesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryModuleStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryUnbondingEpochCValuesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ModuleState", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingEpochCValues", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4306,12 +11381,26 @@ func (m *QueryModuleStateResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.ModuleState = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnbondingEpochCValues = append(m.UnbondingEpochCValues, UnbondingEpochCValue{})
+			if err := m.UnbondingEpochCValues[len(m.UnbondingEpochCValues)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4333,7 +11422,7 @@ func (m *QueryModuleStateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryIBCTransientStoreRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDelegatorUnbondingEpochEntriesInRangeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4356,12 +11445,82 @@ func (m *QueryIBCTransientStoreRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryIBCTransientStoreRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDelegatorUnbondingEpochEntriesInRangeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryIBCTransientStoreRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDelegatorUnbondingEpochEntriesInRangeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartEpoch", wireType)
+			}
+			m.StartEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartEpoch |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndEpoch", wireType)
+			}
+			m.EndEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndEpoch |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4383,7 +11542,7 @@ func (m *QueryIBCTransientStoreRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryIBCTransientStoreResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDelegatorUnbondingEpochEntriesInRangeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4406,15 +11565,15 @@ func (m *QueryIBCTransientStoreResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryIBCTransientStoreResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDelegatorUnbondingEpochEntriesInRangeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryIBCTransientStoreResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDelegatorUnbondingEpochEntriesInRangeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IBCTransientStore", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorUnbondingEpochEntries", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4441,7 +11600,8 @@ func (m *QueryIBCTransientStoreResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.IBCTransientStore.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.DelegatorUnbondingEpochEntries = append(m.DelegatorUnbondingEpochEntries, DelegatorUnbondingEpochEntry{})
+			if err := m.DelegatorUnbondingEpochEntries[len(m.DelegatorUnbondingEpochEntries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4466,7 +11626,7 @@ func (m *QueryIBCTransientStoreResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryUnclaimedRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryHostAccountDelegationsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4489,17 +11649,17 @@ func (m *QueryUnclaimedRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryUnclaimedRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryHostAccountDelegationsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryUnclaimedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryHostAccountDelegationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4509,23 +11669,27 @@ func (m *QueryUnclaimedRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4548,7 +11712,7 @@ func (m *QueryUnclaimedRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryUnclaimedResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryHostAccountDelegationsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4571,15 +11735,15 @@ func (m *QueryUnclaimedResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryUnclaimedResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryHostAccountDelegationsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryUnclaimedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryHostAccountDelegationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Unclaimed", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HostAccountDelegations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4606,8 +11770,44 @@ func (m *QueryUnclaimedResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Unclaimed = append(m.Unclaimed, UnbondingEpochCValue{})
-			if err := m.Unclaimed[len(m.Unclaimed)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.HostAccountDelegations = append(m.HostAccountDelegations, HostAccountDelegation{})
+			if err := m.HostAccountDelegations[len(m.HostAccountDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4632,7 +11832,7 @@ func (m *QueryUnclaimedResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFailedUnbondingsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryHostAccountDelegationRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4655,15 +11855,15 @@ func (m *QueryFailedUnbondingsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFailedUnbondingsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryHostAccountDelegationRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFailedUnbondingsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryHostAccountDelegationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4691,7 +11891,7 @@ func (m *QueryFailedUnbondingsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4714,7 +11914,7 @@ func (m *QueryFailedUnbondingsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFailedUnbondingsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryHostAccountDelegationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4737,15 +11937,15 @@ func (m *QueryFailedUnbondingsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFailedUnbondingsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryHostAccountDelegationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFailedUnbondingsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryHostAccountDelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FailedUnbondings", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HostAccountDelegation", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4772,8 +11972,7 @@ func (m *QueryFailedUnbondingsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FailedUnbondings = append(m.FailedUnbondings, UnbondingEpochCValue{})
-			if err := m.FailedUnbondings[len(m.FailedUnbondings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.HostAccountDelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4798,7 +11997,7 @@ func (m *QueryFailedUnbondingsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPendingUnbondingsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryUnbondingEpochDelegatorEntriesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4821,17 +12020,36 @@ func (m *QueryPendingUnbondingsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPendingUnbondingsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryUnbondingEpochDelegatorEntriesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPendingUnbondingsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryUnbondingEpochDelegatorEntriesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4841,23 +12059,27 @@ func (m *QueryPendingUnbondingsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4880,7 +12102,7 @@ func (m *QueryPendingUnbondingsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPendingUnbondingsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryUnbondingEpochDelegatorEntriesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4903,15 +12125,15 @@ func (m *QueryPendingUnbondingsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPendingUnbondingsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryUnbondingEpochDelegatorEntriesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPendingUnbondingsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryUnbondingEpochDelegatorEntriesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PendingUnbondings", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorUnbondingEpochEntries", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4938,66 +12160,16 @@ func (m *QueryPendingUnbondingsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PendingUnbondings = append(m.PendingUnbondings, UnbondingEpochCValue{})
-			if err := m.PendingUnbondings[len(m.PendingUnbondings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.DelegatorUnbondingEpochEntries = append(m.DelegatorUnbondingEpochEntries, DelegatorUnbondingEpochEntry{})
+			if err := m.DelegatorUnbondingEpochEntries[len(m.DelegatorUnbondingEpochEntries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryUnbondingEpochCValueRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryUnbondingEpochCValueRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryUnbondingEpochCValueRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			m.EpochNumber = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5007,11 +12179,28 @@ func (m *QueryUnbondingEpochCValueRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EpochNumber |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5033,7 +12222,7 @@ func (m *QueryUnbondingEpochCValueRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryUnbondingEpochCValueResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryValidatorWeightsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5056,15 +12245,15 @@ func (m *QueryUnbondingEpochCValueResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryUnbondingEpochCValueResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryValidatorWeightsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryUnbondingEpochCValueResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryValidatorWeightsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingEpochCValue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5091,7 +12280,10 @@ func (m *QueryUnbondingEpochCValueResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.UnbondingEpochCValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5116,7 +12308,7 @@ func (m *QueryUnbondingEpochCValueResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryHostAccountUndelegationRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryValidatorWeightsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5139,17 +12331,17 @@ func (m *QueryHostAccountUndelegationRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryHostAccountUndelegationRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryValidatorWeightsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryHostAccountUndelegationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryValidatorWeightsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorWeights", wireType)
 			}
-			m.EpochNumber = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5159,11 +12351,62 @@ func (m *QueryHostAccountUndelegationRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EpochNumber |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorWeights = append(m.ValidatorWeights, ValidatorWeight{})
+			if err := m.ValidatorWeights[len(m.ValidatorWeights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5185,7 +12428,7 @@ func (m *QueryHostAccountUndelegationRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryHostAccountUndelegationResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryRewardEpochRecordsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5208,15 +12451,15 @@ func (m *QueryHostAccountUndelegationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryHostAccountUndelegationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRewardEpochRecordsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryHostAccountUndelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRewardEpochRecordsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HostAccountUndelegation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5243,7 +12486,10 @@ func (m *QueryHostAccountUndelegationResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.HostAccountUndelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5268,7 +12514,7 @@ func (m *QueryHostAccountUndelegationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDelegatorUnbondingEpochEntryRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryRewardEpochRecordsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5291,17 +12537,17 @@ func (m *QueryDelegatorUnbondingEpochEntryRequest) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDelegatorUnbondingEpochEntryRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRewardEpochRecordsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDelegatorUnbondingEpochEntryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRewardEpochRecordsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardEpochRecords", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5311,29 +12557,31 @@ func (m *QueryDelegatorUnbondingEpochEntryRequest) Unmarshal(dAtA []byte) error
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			m.RewardEpochRecords = append(m.RewardEpochRecords, RewardEpochRecord{})
+			if err := m.RewardEpochRecords[len(m.RewardEpochRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CarriedOverRewards", wireType)
 			}
-			m.EpochNumber = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5343,11 +12591,61 @@ func (m *QueryDelegatorUnbondingEpochEntryRequest) Unmarshal(dAtA []byte) error
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EpochNumber |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CarriedOverRewards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5369,7 +12667,7 @@ func (m *QueryDelegatorUnbondingEpochEntryRequest) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *QueryDelegatorUnbondingEpochEntryResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryHostProposalsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5392,15 +12690,15 @@ func (m *QueryDelegatorUnbondingEpochEntryResponse) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDelegatorUnbondingEpochEntryResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryHostProposalsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDelegatorUnbondingEpochEntryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryHostProposalsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorUnbodingEpochEntry", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5427,7 +12725,10 @@ func (m *QueryDelegatorUnbondingEpochEntryResponse) Unmarshal(dAtA []byte) error
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DelegatorUnbodingEpochEntry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5452,7 +12753,7 @@ func (m *QueryDelegatorUnbondingEpochEntryResponse) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *QueryHostAccountsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryHostProposalsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5475,65 +12776,49 @@ func (m *QueryHostAccountsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryHostAccountsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryHostProposalsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryHostAccountsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryHostProposalsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostProposals", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryHostAccountsResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.HostProposals = append(m.HostProposals, HostProposal{})
+			if err := m.HostProposals[len(m.HostProposals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryHostAccountsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryHostAccountsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HostAccounts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5560,7 +12845,10 @@ func (m *QueryHostAccountsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.HostAccounts.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5585,7 +12873,7 @@ func (m *QueryHostAccountsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDepositModuleAccountRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryHostProposalRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5608,12 +12896,31 @@ func (m *QueryDepositModuleAccountRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDepositModuleAccountRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryHostProposalRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDepositModuleAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryHostProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5635,7 +12942,7 @@ func (m *QueryDepositModuleAccountRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDepositModuleAccountResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryHostProposalResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5658,15 +12965,15 @@ func (m *QueryDepositModuleAccountResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDepositModuleAccountResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryHostProposalResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDepositModuleAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryHostProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HostProposal", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5693,7 +13000,7 @@ func (m *QueryDepositModuleAccountResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.HostProposal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5718,7 +13025,7 @@ func (m *QueryDepositModuleAccountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllDelegatorUnbondingEpochEntriesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryHostProposalVotesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5741,17 +13048,36 @@ func (m *QueryAllDelegatorUnbondingEpochEntriesRequest) Unmarshal(dAtA []byte) e
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllDelegatorUnbondingEpochEntriesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryHostProposalVotesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllDelegatorUnbondingEpochEntriesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryHostProposalVotesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5761,23 +13087,27 @@ func (m *QueryAllDelegatorUnbondingEpochEntriesRequest) Unmarshal(dAtA []byte) e
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5800,7 +13130,7 @@ func (m *QueryAllDelegatorUnbondingEpochEntriesRequest) Unmarshal(dAtA []byte) e
 	}
 	return nil
 }
-func (m *QueryAllDelegatorUnbondingEpochEntriesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryHostProposalVotesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5823,15 +13153,15 @@ func (m *QueryAllDelegatorUnbondingEpochEntriesResponse) Unmarshal(dAtA []byte)
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllDelegatorUnbondingEpochEntriesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryHostProposalVotesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllDelegatorUnbondingEpochEntriesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryHostProposalVotesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorUnbondingEpochEntries", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5858,8 +13188,44 @@ func (m *QueryAllDelegatorUnbondingEpochEntriesResponse) Unmarshal(dAtA []byte)
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatorUnbondingEpochEntries = append(m.DelegatorUnbondingEpochEntries, DelegatorUnbondingEpochEntry{})
-			if err := m.DelegatorUnbondingEpochEntries[len(m.DelegatorUnbondingEpochEntries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Votes = append(m.Votes, HostProposalVote{})
+			if err := m.Votes[len(m.Votes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -0,0 +1,66 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Validate checks that every role has valid and unique addresses, an empty role is valid.
+func (r Roles) Validate() error {
+	if err := validateRoleAddresses("admin", r.Admins); err != nil {
+		return err
+	}
+	if err := validateRoleAddresses("pauser", r.Pausers); err != nil {
+		return err
+	}
+	return validateRoleAddresses("slashing reporter", r.SlashingReporters)
+}
+
+// Validate checks the admins and the effective time of the pending admin change
+func (p PendingAdminChange) Validate() error {
+	if len(p.Admins) == 0 {
+		return errorsmod.Wrap(ErrInvalidRoles, "pending admin change must have at least one admin")
+	}
+	if p.EffectiveTime.IsZero() {
+		return errorsmod.Wrap(ErrInvalidRoles, "pending admin change effective time cannot be empty")
+	}
+	return validateRoleAddresses("admin", p.Admins)
+}
+
+// HasAddress returns true if the address is in the list of role addresses
+func HasAddress(addresses []string, address string) bool {
+	for _, a := range addresses {
+		if a == address {
+			return true
+		}
+	}
+	return false
+}
+
+// EqualAddresses returns true if both lists hold the same addresses, ignoring the order
+func EqualAddresses(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for _, address := range a {
+		if !HasAddress(b, address) {
+			return false
+		}
+	}
+	return true
+}
+
+func validateRoleAddresses(role string, addresses []string) error {
+	noDuplicate := make(map[string]bool)
+	for _, address := range addresses {
+		if _, err := sdk.AccAddressFromBech32(address); err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "%s %s", role, address)
+		}
+		if noDuplicate[address] {
+			return errorsmod.Wrapf(ErrInvalidRoles, "duplicate %s %s", role, address)
+		}
+		noDuplicate[address] = true
+	}
+	return nil
+}
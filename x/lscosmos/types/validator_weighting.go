@@ -0,0 +1,249 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultValidatorWeightingUpdateInterval is the default number of reward epochs between two effective weight updates
+const DefaultValidatorWeightingUpdateInterval int64 = 7
+
+// DefaultValidatorWeighting returns the default validator weighting config, it is disabled and neither excludes,
+// caps nor floors any validator
+func DefaultValidatorWeighting() ValidatorWeighting {
+	return ValidatorWeighting{
+		Enabled:          false,
+		UpdateInterval:   DefaultValidatorWeightingUpdateInterval,
+		MaxCommission:    sdk.OneDec(),
+		MinSelfBondRatio: sdk.ZeroDec(),
+		WeightFloor:      sdk.ZeroDec(),
+		WeightCap:        sdk.OneDec(),
+		MaxWeightChange:  sdk.NewDecWithPrec(5, 2),
+	}
+}
+
+// Validate checks that the update interval is positive, that every ratio is between zero and one, that the weight
+// floor is not above the weight cap and that the max weight change is positive
+func (vw ValidatorWeighting) Validate() error {
+	if vw.UpdateInterval <= 0 {
+		return errorsmod.Wrapf(ErrInvalidValidatorWeighting, "update interval must be positive: %d", vw.UpdateInterval)
+	}
+
+	for _, ratio := range []struct {
+		name  string
+		value sdk.Dec
+	}{
+		{"max commission", vw.MaxCommission},
+		{"min self bond ratio", vw.MinSelfBondRatio},
+		{"weight floor", vw.WeightFloor},
+		{"weight cap", vw.WeightCap},
+		{"max weight change", vw.MaxWeightChange},
+	} {
+		if ratio.value.IsNil() || ratio.value.IsNegative() || ratio.value.GT(sdk.OneDec()) {
+			return errorsmod.Wrapf(ErrInvalidValidatorWeighting, "%s must be between 0 and 1: %s", ratio.name, ratio.value)
+		}
+	}
+
+	if vw.WeightFloor.GT(vw.WeightCap) {
+		return errorsmod.Wrapf(ErrInvalidValidatorWeighting, "weight floor %s greater than weight cap %s", vw.WeightFloor, vw.WeightCap)
+	}
+	if !vw.MaxWeightChange.IsPositive() {
+		return errorsmod.Wrap(ErrInvalidValidatorWeighting, "max weight change must be positive")
+	}
+
+	return nil
+}
+
+// IsEligible returns false if the validator should not receive any delegation, because it is not bonded, jailed,
+// tombstoned, charges a commission above the max commission or self bonds less than the min self bond ratio
+func (vm ValidatorMetrics) IsEligible(config ValidatorWeighting) bool {
+	if !vm.Bonded || vm.Jailed || vm.Tombstoned || !vm.Tokens.IsPositive() {
+		return false
+	}
+	if vm.Commission.GT(config.MaxCommission) {
+		return false
+	}
+	return !sdk.NewDecFromInt(vm.SelfBond).QuoInt(vm.Tokens).LT(config.MinSelfBondRatio)
+}
+
+// ComputeEffectiveWeights computes the effective weights of the allow listed validators from their base weights
+// and host chain metrics, previous holds the effective weight of every validator before the update.
+//
+// The base weight of an eligible validator is scaled by one minus its commission and by one minus its share of the
+// tokens of the eligible validators, to favour cheaper and smaller validators, other validators get no weight. The
+// scores are normalised and bounded by the weight floor and cap, then every eligible validator moves at most the
+// max weight change away from its previous weight. Floors and caps that can not be met by the eligible validators
+// are ignored, as is the max weight change if it can not be met after validators are excluded.
+//
+// It returns false if a validator with a positive base weight has no metrics or if no validator is eligible.
+func ComputeEffectiveWeights(
+	base AllowListedValidators, previous map[string]sdk.Dec, metrics map[string]ValidatorMetrics, config ValidatorWeighting,
+) (AllowListedValidators, bool) {
+	validators := base.AllowListedValidators
+	eligible := make([]bool, len(validators))
+	totalTokens := sdk.ZeroInt()
+	eligibleCount := 0
+	for i, validator := range validators {
+		if !validator.TargetWeight.IsPositive() {
+			continue
+		}
+		validatorMetrics, ok := metrics[validator.ValidatorAddress]
+		if !ok {
+			return AllowListedValidators{}, false
+		}
+		if validatorMetrics.IsEligible(config) {
+			eligible[i] = true
+			eligibleCount++
+			totalTokens = totalTokens.Add(validatorMetrics.Tokens)
+		}
+	}
+	if eligibleCount == 0 {
+		return AllowListedValidators{}, false
+	}
+
+	weightFloor, weightCap := config.WeightFloor, config.WeightCap
+	if weightFloor.MulInt64(int64(eligibleCount)).GT(sdk.OneDec()) {
+		weightFloor = sdk.ZeroDec()
+	}
+	if weightCap.MulInt64(int64(eligibleCount)).LT(sdk.OneDec()) {
+		weightCap = sdk.OneDec()
+	}
+
+	scores := make([]sdk.Dec, len(validators))
+	lower := make([]sdk.Dec, len(validators))
+	upper := make([]sdk.Dec, len(validators))
+	for i, validator := range validators {
+		scores[i], lower[i], upper[i] = sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec()
+		if !eligible[i] {
+			continue
+		}
+		validatorMetrics := metrics[validator.ValidatorAddress]
+		tokensShare := sdk.NewDecFromInt(validatorMetrics.Tokens).QuoInt(totalTokens)
+		scores[i] = validator.TargetWeight.Mul(sdk.OneDec().Sub(validatorMetrics.Commission)).Mul(sdk.OneDec().Sub(tokensShare))
+		lower[i], upper[i] = weightFloor, weightCap
+	}
+	weights := distributeWithinBounds(scores, lower, upper)
+
+	// limit the change of the eligible validators
+	limitedLower := make([]sdk.Dec, len(validators))
+	limitedUpper := make([]sdk.Dec, len(validators))
+	for i, validator := range validators {
+		limitedLower[i], limitedUpper[i] = lower[i], upper[i]
+		if !eligible[i] {
+			continue
+		}
+		previousWeight, ok := previous[validator.ValidatorAddress]
+		if !ok {
+			previousWeight = validator.TargetWeight
+		}
+		minWeight := previousWeight.Sub(config.MaxWeightChange)
+		maxWeight := previousWeight.Add(config.MaxWeightChange)
+		limitedLower[i] = sdk.MaxDec(lower[i], minWeight)
+		limitedUpper[i] = sdk.MinDec(upper[i], maxWeight)
+		if limitedLower[i].GT(limitedUpper[i]) {
+			// the previous weight is too far out of the floor and cap, move towards them by the max change
+			if minWeight.GT(upper[i]) {
+				limitedLower[i], limitedUpper[i] = minWeight, minWeight
+			} else {
+				limitedLower[i], limitedUpper[i] = maxWeight, maxWeight
+			}
+		}
+	}
+	if boundsFeasible(limitedLower, limitedUpper) {
+		weights = distributeWithinBounds(weights, limitedLower, limitedUpper)
+	}
+
+	effective := make([]AllowListedValidator, len(validators))
+	for i, validator := range validators {
+		effective[i] = AllowListedValidator{ValidatorAddress: validator.ValidatorAddress, TargetWeight: weights[i]}
+	}
+	return AllowListedValidators{AllowListedValidators: effective}, true
+}
+
+// boundsFeasible returns true if weights adding up to one can be picked within the lower and upper bounds
+func boundsFeasible(lower, upper []sdk.Dec) bool {
+	sumLower, sumUpper := sdk.ZeroDec(), sdk.ZeroDec()
+	for i := range lower {
+		sumLower = sumLower.Add(lower[i])
+		sumUpper = sumUpper.Add(upper[i])
+	}
+	return sumLower.LTE(sdk.OneDec()) && sumUpper.GTE(sdk.OneDec())
+}
+
+// distributeWithinBounds returns weights adding up to one, proportional to the targets as far as the lower and
+// upper bounds allow. Weights breaking their bounds are fixed at the bound and the rest is distributed again among
+// the other weights, each round fixes at least one weight.
+// CONTRACT: boundsFeasible(lower, upper)
+func distributeWithinBounds(targets, lower, upper []sdk.Dec) []sdk.Dec {
+	weights := make([]sdk.Dec, len(targets))
+	fixed := make([]bool, len(targets))
+	for i := range targets {
+		if lower[i].Equal(upper[i]) {
+			weights[i], fixed[i] = lower[i], true
+		}
+	}
+
+	for {
+		remaining := sdk.OneDec()
+		totalTarget := sdk.ZeroDec()
+		freeCount := int64(0)
+		for i := range targets {
+			if fixed[i] {
+				remaining = remaining.Sub(weights[i])
+				continue
+			}
+			totalTarget = totalTarget.Add(targets[i])
+			freeCount++
+		}
+		if freeCount == 0 {
+			break
+		}
+
+		excess, deficit := sdk.ZeroDec(), sdk.ZeroDec()
+		for i := range targets {
+			if fixed[i] {
+				continue
+			}
+			if totalTarget.IsPositive() {
+				weights[i] = targets[i].Mul(remaining).Quo(totalTarget)
+			} else {
+				weights[i] = remaining.QuoInt64(freeCount)
+			}
+			if weights[i].GT(upper[i]) {
+				excess = excess.Add(weights[i].Sub(upper[i]))
+			}
+			if weights[i].LT(lower[i]) {
+				deficit = deficit.Add(lower[i].Sub(weights[i]))
+			}
+		}
+		if excess.IsZero() && deficit.IsZero() {
+			break
+		}
+
+		// fix the weights on the side breaking the bounds the most, the other side is resolved by redistributing
+		for i := range targets {
+			if fixed[i] {
+				continue
+			}
+			if excess.GTE(deficit) && weights[i].GT(upper[i]) {
+				weights[i], fixed[i] = upper[i], true
+			}
+			if excess.LT(deficit) && weights[i].LT(lower[i]) {
+				weights[i], fixed[i] = lower[i], true
+			}
+		}
+	}
+
+	// assign the rounding dust to the highest weight so the weights add up to exactly one
+	sum := sdk.ZeroDec()
+	highest := 0
+	for i := range weights {
+		sum = sum.Add(weights[i])
+		if weights[i].GT(weights[highest]) {
+			highest = i
+		}
+	}
+	weights[highest] = weights[highest].Add(sdk.OneDec().Sub(sum))
+
+	return weights
+}
@@ -0,0 +1,217 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/persistenceOne/pstake-native/v2/x/lscosmos/types"
+)
+
+const (
+	weightingVal1 = "cosmosvaloper1hcqg5wj9t42zawqkqucs7la85ffyv08le09ljt"
+	weightingVal2 = "cosmosvaloper1lcck2cxh7dzgkrfk53kysg9ktdrsjj6jfwlnm2"
+	weightingVal3 = "cosmosvaloper10khgeppewe4rgfrcy809r9h00aquwxxxgwgwa5"
+)
+
+func TestValidatorWeightingValidate(t *testing.T) {
+	cases := []struct {
+		name     string
+		modifier func(*types.ValidatorWeighting)
+		valid    bool
+	}{
+		{"default", func(*types.ValidatorWeighting) {}, true},
+		{"enabled", func(vw *types.ValidatorWeighting) { vw.Enabled = true }, true},
+		{"zero update interval", func(vw *types.ValidatorWeighting) { vw.UpdateInterval = 0 }, false},
+		{"nil max commission", func(vw *types.ValidatorWeighting) { vw.MaxCommission = sdk.Dec{} }, false},
+		{"negative min self bond ratio", func(vw *types.ValidatorWeighting) { vw.MinSelfBondRatio = sdk.NewDec(-1) }, false},
+		{"weight cap above one", func(vw *types.ValidatorWeighting) { vw.WeightCap = sdk.NewDec(2) }, false},
+		{"weight floor above weight cap", func(vw *types.ValidatorWeighting) {
+			vw.WeightFloor = sdk.NewDecWithPrec(5, 1)
+			vw.WeightCap = sdk.NewDecWithPrec(4, 1)
+		}, false},
+		{"zero max weight change", func(vw *types.ValidatorWeighting) { vw.MaxWeightChange = sdk.ZeroDec() }, false},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			validatorWeighting := types.DefaultValidatorWeighting()
+			tc.modifier(&validatorWeighting)
+			err := validatorWeighting.Validate()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestValidatorMetricsIsEligible(t *testing.T) {
+	config := types.DefaultValidatorWeighting()
+	config.MaxCommission = sdk.NewDecWithPrec(1, 1)
+	config.MinSelfBondRatio = sdk.NewDecWithPrec(1, 2)
+
+	cases := []struct {
+		name     string
+		modifier func(*types.ValidatorMetrics)
+		eligible bool
+	}{
+		{"eligible", func(*types.ValidatorMetrics) {}, true},
+		{"not bonded", func(vm *types.ValidatorMetrics) { vm.Bonded = false }, false},
+		{"jailed", func(vm *types.ValidatorMetrics) { vm.Jailed = true }, false},
+		{"tombstoned", func(vm *types.ValidatorMetrics) { vm.Tombstoned = true }, false},
+		{"no tokens", func(vm *types.ValidatorMetrics) { vm.Tokens = sdk.ZeroInt() }, false},
+		{"commission above max", func(vm *types.ValidatorMetrics) { vm.Commission = sdk.NewDecWithPrec(2, 1) }, false},
+		{"self bond below min ratio", func(vm *types.ValidatorMetrics) { vm.SelfBond = sdk.NewInt(9) }, false},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			metrics := types.ValidatorMetrics{
+				ValidatorAddress: weightingVal1,
+				Commission:       sdk.NewDecWithPrec(5, 2),
+				Bonded:           true,
+				Tokens:           sdk.NewInt(1000),
+				SelfBond:         sdk.NewInt(10),
+			}
+			tc.modifier(&metrics)
+			require.Equal(t, tc.eligible, metrics.IsEligible(config))
+		})
+	}
+}
+
+func TestComputeEffectiveWeights(t *testing.T) {
+	base := types.AllowListedValidators{AllowListedValidators: []types.AllowListedValidator{
+		{ValidatorAddress: weightingVal1, TargetWeight: sdk.NewDecWithPrec(5, 1)},
+		{ValidatorAddress: weightingVal2, TargetWeight: sdk.NewDecWithPrec(3, 1)},
+		{ValidatorAddress: weightingVal3, TargetWeight: sdk.NewDecWithPrec(2, 1)},
+	}}
+	newMetrics := func(validatorAddress string, commission sdk.Dec, tokens int64) types.ValidatorMetrics {
+		return types.ValidatorMetrics{
+			ValidatorAddress: validatorAddress,
+			Commission:       commission,
+			Bonded:           true,
+			Tokens:           sdk.NewInt(tokens),
+			SelfBond:         sdk.NewInt(tokens / 10),
+		}
+	}
+	allMetrics := func() map[string]types.ValidatorMetrics {
+		return map[string]types.ValidatorMetrics{
+			weightingVal1: newMetrics(weightingVal1, sdk.NewDecWithPrec(5, 2), 1000),
+			weightingVal2: newMetrics(weightingVal2, sdk.NewDecWithPrec(5, 2), 1000),
+			weightingVal3: newMetrics(weightingVal3, sdk.NewDecWithPrec(5, 2), 1000),
+		}
+	}
+	unlimited := types.DefaultValidatorWeighting()
+	unlimited.MaxWeightChange = sdk.OneDec()
+
+	t.Run("equal metrics keep the base weights", func(t *testing.T) {
+		effective, ok := types.ComputeEffectiveWeights(base, nil, allMetrics(), unlimited)
+		require.True(t, ok)
+		require.Equal(t, base, effective)
+	})
+
+	t.Run("missing metrics", func(t *testing.T) {
+		metrics := allMetrics()
+		delete(metrics, weightingVal2)
+		_, ok := types.ComputeEffectiveWeights(base, nil, metrics, unlimited)
+		require.False(t, ok)
+	})
+
+	t.Run("no eligible validator", func(t *testing.T) {
+		metrics := allMetrics()
+		for address, validatorMetrics := range metrics {
+			validatorMetrics.Jailed = true
+			metrics[address] = validatorMetrics
+		}
+		_, ok := types.ComputeEffectiveWeights(base, nil, metrics, unlimited)
+		require.False(t, ok)
+	})
+
+	t.Run("ineligible validator is excluded", func(t *testing.T) {
+		metrics := allMetrics()
+		validatorMetrics := metrics[weightingVal1]
+		validatorMetrics.Tombstoned = true
+		metrics[weightingVal1] = validatorMetrics
+
+		effective, ok := types.ComputeEffectiveWeights(base, nil, metrics, unlimited)
+		require.True(t, ok)
+		require.Equal(t, sdk.ZeroDec(), effective.AllowListedValidators[0].TargetWeight)
+		require.Equal(t, sdk.NewDecWithPrec(6, 1), effective.AllowListedValidators[1].TargetWeight)
+		require.Equal(t, sdk.NewDecWithPrec(4, 1), effective.AllowListedValidators[2].TargetWeight)
+		require.True(t, effective.Valid())
+	})
+
+	t.Run("commission lowers the weight", func(t *testing.T) {
+		metrics := allMetrics()
+		metrics[weightingVal1] = newMetrics(weightingVal1, sdk.NewDecWithPrec(2, 1), 1000)
+
+		effective, ok := types.ComputeEffectiveWeights(base, nil, metrics, unlimited)
+		require.True(t, ok)
+		require.True(t, effective.AllowListedValidators[0].TargetWeight.LT(base.AllowListedValidators[0].TargetWeight))
+		require.True(t, effective.AllowListedValidators[1].TargetWeight.GT(base.AllowListedValidators[1].TargetWeight))
+		require.True(t, effective.AllowListedValidators[2].TargetWeight.GT(base.AllowListedValidators[2].TargetWeight))
+		require.True(t, effective.Valid())
+	})
+
+	t.Run("voting power lowers the weight", func(t *testing.T) {
+		metrics := allMetrics()
+		metrics[weightingVal2] = newMetrics(weightingVal2, sdk.NewDecWithPrec(5, 2), 8000)
+
+		effective, ok := types.ComputeEffectiveWeights(base, nil, metrics, unlimited)
+		require.True(t, ok)
+		require.True(t, effective.AllowListedValidators[0].TargetWeight.GT(base.AllowListedValidators[0].TargetWeight))
+		require.True(t, effective.AllowListedValidators[1].TargetWeight.LT(base.AllowListedValidators[1].TargetWeight))
+		require.True(t, effective.AllowListedValidators[2].TargetWeight.GT(base.AllowListedValidators[2].TargetWeight))
+		require.True(t, effective.Valid())
+	})
+
+	t.Run("cap and floor", func(t *testing.T) {
+		config := unlimited
+		config.WeightCap = sdk.NewDecWithPrec(4, 1)
+		config.WeightFloor = sdk.NewDecWithPrec(25, 2)
+
+		effective, ok := types.ComputeEffectiveWeights(base, nil, allMetrics(), config)
+		require.True(t, ok)
+		require.Equal(t, sdk.NewDecWithPrec(4, 1), effective.AllowListedValidators[0].TargetWeight)
+		require.Equal(t, sdk.NewDecWithPrec(35, 2), effective.AllowListedValidators[1].TargetWeight)
+		require.Equal(t, sdk.NewDecWithPrec(25, 2), effective.AllowListedValidators[2].TargetWeight)
+	})
+
+	t.Run("infeasible cap is ignored", func(t *testing.T) {
+		config := unlimited
+		config.WeightCap = sdk.NewDecWithPrec(2, 1)
+
+		effective, ok := types.ComputeEffectiveWeights(base, nil, allMetrics(), config)
+		require.True(t, ok)
+		require.Equal(t, base, effective)
+	})
+
+	t.Run("max weight change", func(t *testing.T) {
+		config := types.DefaultValidatorWeighting()
+		metrics := allMetrics()
+		validatorMetrics := metrics[weightingVal1]
+		validatorMetrics.Jailed = true
+		metrics[weightingVal1] = validatorMetrics
+
+		effective, ok := types.ComputeEffectiveWeights(base, nil, metrics, config)
+		require.True(t, ok)
+		require.Equal(t, sdk.ZeroDec(), effective.AllowListedValidators[0].TargetWeight)
+		require.Equal(t, sdk.NewDecWithPrec(6, 1), effective.AllowListedValidators[1].TargetWeight)
+		require.Equal(t, sdk.NewDecWithPrec(4, 1), effective.AllowListedValidators[2].TargetWeight)
+
+		metrics = allMetrics()
+		metrics[weightingVal2] = newMetrics(weightingVal2, sdk.NewDecWithPrec(5, 1), 1000)
+		previous := types.GetAddressMap(base)
+		effective, ok = types.ComputeEffectiveWeights(base, previous, metrics, config)
+		require.True(t, ok)
+		for _, validator := range effective.AllowListedValidators {
+			change := validator.TargetWeight.Sub(previous[validator.ValidatorAddress]).Abs()
+			require.True(t, change.LTE(config.MaxWeightChange), validator.ValidatorAddress)
+		}
+		require.Equal(t, sdk.NewDecWithPrec(25, 2), effective.AllowListedValidators[1].TargetWeight)
+		require.True(t, effective.Valid())
+	})
+}
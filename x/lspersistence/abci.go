@@ -10,8 +10,15 @@ import (
 	"github.com/persistenceOne/pstake-native/v2/x/lspersistence/types"
 )
 
-// BeginBlocker updates liquid validator set changes for the current block
+// BeginBlocker updates liquid validator set changes, removes the mature unbonding requests and records the net amount
+// state snapshot for the current block
 func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyBeginBlocker)
+	// the queued liquid validators are kept until the rebalancing is resumed
+	if !k.GetPauseSwitches(ctx).RebalancingPaused {
+		k.DeactivateQueuedLiquidValidators(ctx)
+	}
 	k.UpdateLiquidValidatorSet(ctx)
+	k.CompleteMatureUnbondingRequests(ctx)
+	k.RecordNetAmountStateSnapshot(ctx)
 }
@@ -0,0 +1,42 @@
+package lspersistence
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/persistenceOne/pstake-native/v2/x/lspersistence/keeper"
+)
+
+// BankAppModule wraps the bank AppModule to register the bank msg server with the lspersistence BankKeeper, so the
+// bank sends cap the preferred stakes of the bToken senders.
+type BankAppModule struct {
+	bank.AppModule
+	keeper keeper.BankKeeper
+}
+
+// NewBankAppModule returns the bank AppModule to be used by the module manager.
+func NewBankAppModule(cdc codec.Codec, bk bankkeeper.BaseKeeper, k keeper.Keeper, accountKeeper banktypes.AccountKeeper) BankAppModule {
+	return BankAppModule{
+		AppModule: bank.NewAppModule(cdc, bk, accountKeeper),
+		keeper:    keeper.NewBankKeeper(bk, k),
+	}
+}
+
+// RegisterServices registers the bank module services, the migrations run on the wrapped bank keeper.
+func (am BankAppModule) RegisterServices(cfg module.Configurator) {
+	banktypes.RegisterMsgServer(cfg.MsgServer(), bankkeeper.NewMsgServerImpl(am.keeper))
+	banktypes.RegisterQueryServer(cfg.QueryServer(), am.keeper)
+
+	m := bankkeeper.NewMigrator(am.keeper.BaseKeeper)
+	if err := cfg.RegisterMigration(banktypes.ModuleName, 1, m.Migrate1to2); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/bank from version 1 to 2: %v", err))
+	}
+	if err := cfg.RegisterMigration(banktypes.ModuleName, 2, m.Migrate2to3); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/bank from version 2 to 3: %v", err))
+	}
+}
@@ -6,8 +6,10 @@ import (
 
 	"github.com/spf13/cobra"
 
+	"cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
 
 	"github.com/persistenceOne/pstake-native/v2/x/lspersistence/types"
@@ -27,6 +29,17 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryParams(),
 		GetCmdQueryLiquidValidators(),
 		GetCmdQueryStates(),
+		GetCmdQueryVotingPower(),
+		GetCmdQueryCollectedRewardFees(),
+		GetCmdQueryUnbondingRequests(),
+		GetCmdQueryInstantUnstakeReserve(),
+		GetCmdQuerySlashingHistory(),
+		GetCmdQueryPauseSwitches(),
+		GetCmdQueryWhitelistChangePreview(),
+		GetCmdQueryPreferredStakes(),
+		GetCmdQueryEffectiveWeights(),
+		GetCmdQueryStatesHistory(),
+		GetCmdQueryAPY(),
 	)
 
 	return liquidValidatorQueryCmd
@@ -153,3 +166,557 @@ $ %s query %s states
 
 	return cmd
 }
+
+// GetCmdQueryVotingPower implements the query voting power command.
+func GetCmdQueryVotingPower() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "voting-power [voter]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the voting power of a voter",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Queries the staking, liquid staking and validator voting power of a voter.
+
+Example:
+$ %s query %s voting-power %s1mzgucqnfr2l8cj5apvdpllhzt4zeuh2cshz5xu
+`,
+				version.AppName, types.ModuleName, sdk.GetConfig().GetBech32AccountAddrPrefix(),
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.VotingPower(
+				cmd.Context(),
+				&types.QueryVotingPowerRequest{Voter: args[0]},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryCollectedRewardFees implements the query collected reward fees command.
+func GetCmdQueryCollectedRewardFees() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "collected-reward-fees",
+		Args:  cobra.NoArgs,
+		Short: "Query the cumulative reward fees",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Queries the cumulative reward fees sent to the reward fee address.
+
+Example:
+$ %s query %s collected-reward-fees
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.CollectedRewardFees(
+				cmd.Context(),
+				&types.QueryCollectedRewardFeesRequest{},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryUnbondingRequests implements the query unbonding requests command.
+func GetCmdQueryUnbondingRequests() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unbonding-requests [delegator]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the pending unbonding requests of a delegator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Queries the pending unbonding requests of a delegator with their amount, validators and completion time.
+
+Example:
+$ %s query %s unbonding-requests %s1mzgucqnfr2l8cj5apvdpllhzt4zeuh2cshz5xu
+`,
+				version.AppName, types.ModuleName, sdk.GetConfig().GetBech32AccountAddrPrefix(),
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.UnbondingRequests(
+				cmd.Context(),
+				&types.QueryUnbondingRequestsRequest{DelegatorAddress: args[0], Pagination: pageReq},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "unbonding-requests")
+
+	return cmd
+}
+
+// GetCmdQueryInstantUnstakeReserve implements the query instant unstake reserve command.
+func GetCmdQueryInstantUnstakeReserve() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "instant-unstake-reserve",
+		Args:  cobra.NoArgs,
+		Short: "Query the instant unstake reserve",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Queries the instant unstake reserve of the proxy account and its target.
+
+Example:
+$ %s query %s instant-unstake-reserve
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.InstantUnstakeReserve(
+				cmd.Context(),
+				&types.QueryInstantUnstakeReserveRequest{},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQuerySlashingHistory implements the query slashing history command.
+func GetCmdQuerySlashingHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "slashing-history [validator-address]",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "Query the slashing history of the liquid validators",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Queries the slashing records of the liquid validators with the slashed amount and the NetAmount change, optionally of a validator.
+
+Example:
+$ %s query %s slashing-history
+$ %s query %s slashing-history %s1zaavvzxez0elundtn32qnk9lkm8kmcszvnk6zf
+`,
+				version.AppName, types.ModuleName,
+				version.AppName, types.ModuleName, sdk.GetConfig().GetBech32ValidatorAddrPrefix(),
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QuerySlashingHistoryRequest{Pagination: pageReq}
+			if len(args) > 0 {
+				req.ValidatorAddress = args[0]
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.SlashingHistory(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "slashing-history")
+
+	return cmd
+}
+
+// GetCmdQueryPauseSwitches implements the query pause switches command.
+func GetCmdQueryPauseSwitches() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pause-switches",
+		Args:  cobra.NoArgs,
+		Short: "Query the paused operations of the module",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Queries the paused operations of the module.
+
+Example:
+$ %s query %s pause-switches
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.PauseSwitches(
+				cmd.Context(),
+				&types.QueryPauseSwitchesRequest{},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryPreferredStakes implements the query preferred stakes command.
+func GetCmdQueryPreferredStakes() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "preferred-stakes [delegator]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the preferred stakes of a delegator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Queries the bToken amounts a delegator preferred to each validator when liquid staking.
+
+Example:
+$ %s query %s preferred-stakes %s1mzgucqnfr2l8cj5apvdpllhzt4zeuh2cshz5xu
+`,
+				version.AppName, types.ModuleName, sdk.GetConfig().GetBech32AccountAddrPrefix(),
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.PreferredStakes(
+				cmd.Context(),
+				&types.QueryPreferredStakesRequest{DelegatorAddress: args[0]},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryEffectiveWeights implements the query effective weights command.
+func GetCmdQueryEffectiveWeights() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "effective-weights",
+		Args:  cobra.NoArgs,
+		Short: "Query the target weights of the active liquid validators shifted by the preferred stakes",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Queries the target weights and the preferred stakes of the active liquid validators, with the ratios of
+the liquid tokens they target before and after the preferred stakes shift.
+
+Example:
+$ %s query %s effective-weights
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.EffectiveWeights(
+				cmd.Context(),
+				&types.QueryEffectiveWeightsRequest{},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryStatesHistory implements the query states history command.
+func GetCmdQueryStatesHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "states-history",
+		Args:  cobra.NoArgs,
+		Short: "Query the recorded snapshots of the net amount state",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Queries the mint rate, net amount, total liquid tokens and bToken total supply recorded every
+NetAmountStateSnapshotInterval blocks, oldest first.
+
+Example:
+$ %s query %s states-history
+$ %s query %s states-history --reverse --limit 24
+`,
+				version.AppName, types.ModuleName,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.StatesHistory(
+				cmd.Context(),
+				&types.QueryStatesHistoryRequest{Pagination: pageReq},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "states-history")
+
+	return cmd
+}
+
+// FlagFromHeight is the flag of the APY query command for the height the estimation starts from.
+const FlagFromHeight = "from-height"
+
+// GetCmdQueryAPY implements the query APY command.
+func GetCmdQueryAPY() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "apy",
+		Args:  cobra.NoArgs,
+		Short: "Query the APY of the bToken estimated over the states history",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Queries the APY of the bToken estimated from the growth of its value, NetAmount / BtokenTotalSupply,
+between a snapshot of the states history and the latest one. The oldest retained snapshot is used by default.
+
+Example:
+$ %s query %s apy
+$ %s query %s apy --from-height 120000
+`,
+				version.AppName, types.ModuleName,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			fromHeight, err := cmd.Flags().GetInt64(FlagFromHeight)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.APY(
+				cmd.Context(),
+				&types.QueryAPYRequest{FromHeight: fromHeight},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Int64(FlagFromHeight, 0, "Height from which the APY is estimated, the oldest retained snapshot by default")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// Flags of the whitelist change preview command.
+const (
+	FlagAddValidators    = "add"
+	FlagUpdateValidators = "update"
+	FlagRemoveValidators = "remove"
+)
+
+// GetCmdQueryWhitelistChangePreview implements the query whitelist change preview command.
+func GetCmdQueryWhitelistChangePreview() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "whitelist-change-preview",
+		Args:  cobra.NoArgs,
+		Short: "Preview the whitelist and the redelegations resulting from whitelist changes",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Previews the whitelist and the redelegations the rebalancing of the next block would trigger,
+after applying the additions, the weight updates and the removals in that order. The state is not changed.
+
+Example:
+$ %s query %s whitelist-change-preview --add %s1zaavvzxez0elundtn32qnk9lkm8kmcszvnk6zf:10 --remove %s1qh6tjg6wqz8u2ugk3ccy6u0gplfaeuk6nndm3n
+`,
+				version.AppName, types.ModuleName,
+				sdk.GetConfig().GetBech32ValidatorAddrPrefix(), sdk.GetConfig().GetBech32ValidatorAddrPrefix(),
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryWhitelistChangePreviewRequest{}
+			if req.AddValidators, err = parseWhitelistedValidatorsFlag(cmd, FlagAddValidators); err != nil {
+				return err
+			}
+			if req.UpdateValidators, err = parseWhitelistedValidatorsFlag(cmd, FlagUpdateValidators); err != nil {
+				return err
+			}
+			if req.RemoveValidators, err = cmd.Flags().GetStringSlice(FlagRemoveValidators); err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.WhitelistChangePreview(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().StringSlice(FlagAddValidators, nil, "Validators to be whitelisted, as comma separated [validator-address]:[target-weight]")
+	cmd.Flags().StringSlice(FlagUpdateValidators, nil, "Target weights to be updated, as comma separated [validator-address]:[target-weight]")
+	cmd.Flags().StringSlice(FlagRemoveValidators, nil, "Comma separated validator addresses to be removed from the whitelist")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// parseWhitelistedValidatorsFlag parses the [validator-address]:[target-weight] entries of the flag.
+func parseWhitelistedValidatorsFlag(cmd *cobra.Command, flag string) ([]types.WhitelistedValidator, error) {
+	valAddrs, weights, err := parseValidatorWeightsFlag(cmd, flag, "target-weight")
+	if err != nil {
+		return nil, err
+	}
+	whitelistedValidators := make([]types.WhitelistedValidator, 0, len(valAddrs))
+	for i, valAddr := range valAddrs {
+		whitelistedValidators = append(whitelistedValidators, types.WhitelistedValidator{
+			ValidatorAddress: valAddr,
+			TargetWeight:     weights[i],
+		})
+	}
+	return whitelistedValidators, nil
+}
+
+// parsePreferredValidatorsFlag parses the [validator-address]:[weight] entries of the flag.
+func parsePreferredValidatorsFlag(cmd *cobra.Command, flag string) ([]types.PreferredValidator, error) {
+	valAddrs, weights, err := parseValidatorWeightsFlag(cmd, flag, "weight")
+	if err != nil {
+		return nil, err
+	}
+	preferredValidators := make([]types.PreferredValidator, 0, len(valAddrs))
+	for i, valAddr := range valAddrs {
+		preferredValidators = append(preferredValidators, types.PreferredValidator{
+			ValidatorAddress: valAddr,
+			Weight:           weights[i],
+		})
+	}
+	return preferredValidators, nil
+}
+
+// parseValidatorWeightsFlag parses the [validator-address]:[weight] entries of the flag, the weight is named as
+// weightName in the error messages.
+func parseValidatorWeightsFlag(cmd *cobra.Command, flag, weightName string) ([]string, []math.Int, error) {
+	entries, err := cmd.Flags().GetStringSlice(flag)
+	if err != nil {
+		return nil, nil, err
+	}
+	valAddrs := make([]string, 0, len(entries))
+	weights := make([]math.Int, 0, len(entries))
+	for _, entry := range entries {
+		valAddr, weight, ok := strings.Cut(entry, ":")
+		if !ok {
+			return nil, nil, fmt.Errorf("invalid --%s entry %q, expected [validator-address]:[%s]", flag, entry, weightName)
+		}
+		w, ok := math.NewIntFromString(weight)
+		if !ok {
+			return nil, nil, fmt.Errorf("invalid %s %q of --%s entry %q", strings.ReplaceAll(weightName, "-", " "), weight, flag, entry)
+		}
+		valAddrs = append(valAddrs, valAddr)
+		weights = append(weights, w)
+	}
+	return valAddrs, weights, nil
+}
@@ -31,11 +31,16 @@ func GetTxCmd() *cobra.Command {
 	liquidstakingTxCmd.AddCommand(
 		NewLiquidStakeCmd(),
 		NewLiquidUnstakeCmd(),
+		NewInstantLiquidUnstakeCmd(),
+		NewUpdatePauseSwitchesCmd(),
 	)
 
 	return liquidstakingTxCmd
 }
 
+// FlagPreferredValidators is the flag of the liquid stake command for the preferred validators.
+const FlagPreferredValidators = "preferred-validators"
+
 // NewLiquidStakeCmd implements the liquid stake coin command handler.
 func NewLiquidStakeCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -43,12 +48,15 @@ func NewLiquidStakeCmd() *cobra.Command {
 		Args:  cobra.ExactArgs(1),
 		Short: "Liquid-stake coin",
 		Long: strings.TrimSpace(
-			fmt.Sprintf(`Liquid-stake coin. 
+			fmt.Sprintf(`Liquid-stake coin. The minted bTokens can be preferred to active liquid validators, which shifts
+their target weights within the MaxPreferredWeightShift param.
 			
 Example:
 $ %s tx %s liquid-stake 1000stake --from mykey
+$ %s tx %s liquid-stake 1000stake --preferred-validators %s1zaavvzxez0elundtn32qnk9lkm8kmcszvnk6zf:1 --from mykey
 `,
 				version.AppName, types.ModuleName,
+				version.AppName, types.ModuleName, sdk.GetConfig().GetBech32ValidatorAddrPrefix(),
 			),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -65,11 +73,15 @@ $ %s tx %s liquid-stake 1000stake --from mykey
 			}
 
 			msg := types.NewMsgLiquidStake(liquidStaker, stakingCoin)
+			if msg.PreferredValidators, err = parsePreferredValidatorsFlag(cmd, FlagPreferredValidators); err != nil {
+				return err
+			}
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
+	cmd.Flags().StringSlice(FlagPreferredValidators, nil, "Validators to prefer the bTokens to, as comma separated [validator-address]:[weight]")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -113,3 +125,110 @@ $ %s tx %s liquid-unstake 500stake --from mykey
 
 	return cmd
 }
+
+// NewInstantLiquidUnstakeCmd implements the instant liquid unstake coin command handler.
+func NewInstantLiquidUnstakeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "instant-liquid-unstake [amount]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Instantly liquid-unstake coin from the instant unstake reserve",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Swap liquid staking tokens for native tokens immediately from the instant unstake reserve,
+the instant unstake fee is deducted.
+
+Example:
+$ %s tx %s instant-liquid-unstake 500bstake --from mykey
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			liquidStaker := clientCtx.GetFromAddress()
+
+			unstakingCoin, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgInstantLiquidUnstake(liquidStaker, unstakingCoin)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// Flags of the update pause switches command.
+const (
+	FlagLiquidStake     = "liquid-stake"
+	FlagLiquidUnstake   = "liquid-unstake"
+	FlagRebalancing     = "rebalancing"
+	FlagRewardRestaking = "reward-restaking"
+)
+
+// NewUpdatePauseSwitchesCmd implements the update pause switches command handler.
+func NewUpdatePauseSwitchesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-pause-switches",
+		Args:  cobra.NoArgs,
+		Short: "Pause or resume the operations of the module",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Pause or resume the operations of the module by the pauser of the params, the operations not
+given by the flags keep their current switches. The pauser is only allowed to pause the operations, resuming them is
+left to the governance.
+
+Example:
+$ %s tx %s update-pause-switches --liquid-stake=true --liquid-unstake=true --from mykey
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.PauseSwitches(cmd.Context(), &types.QueryPauseSwitchesRequest{})
+			if err != nil {
+				return err
+			}
+
+			switches := res.PauseSwitches
+			for flag, paused := range map[string]*bool{
+				FlagLiquidStake:     &switches.LiquidStakePaused,
+				FlagLiquidUnstake:   &switches.LiquidUnstakePaused,
+				FlagRebalancing:     &switches.RebalancingPaused,
+				FlagRewardRestaking: &switches.RewardRestakingPaused,
+			} {
+				if !cmd.Flags().Changed(flag) {
+					continue
+				}
+				if *paused, err = cmd.Flags().GetBool(flag); err != nil {
+					return err
+				}
+			}
+
+			msg := types.NewMsgUpdatePauseSwitches(clientCtx.GetFromAddress(), switches)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Bool(FlagLiquidStake, false, "Pause the liquid staking")
+	cmd.Flags().Bool(FlagLiquidUnstake, false, "Pause the liquid unstaking and instant liquid unstaking")
+	cmd.Flags().Bool(FlagRebalancing, false, "Pause the rebalancing of the liquid validators")
+	cmd.Flags().Bool(FlagRewardRestaking, false, "Pause the reward withdrawal and re-staking")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
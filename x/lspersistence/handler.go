@@ -23,6 +23,21 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 		case *types.MsgLiquidUnstake:
 			res, err := msgServer.LiquidUnstake(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgInstantLiquidUnstake:
+			res, err := msgServer.InstantLiquidUnstake(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgUpdatePauseSwitches:
+			res, err := msgServer.UpdatePauseSwitches(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgAddWhitelistedValidators:
+			res, err := msgServer.AddWhitelistedValidators(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgUpdateWhitelistedValidatorWeights:
+			res, err := msgServer.UpdateWhitelistedValidatorWeights(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgRemoveWhitelistedValidators:
+			res, err := msgServer.RemoveWhitelistedValidators(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
 
 		default:
 			return nil, errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s message type: %T", types.ModuleName, msg)
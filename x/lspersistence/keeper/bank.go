@@ -0,0 +1,51 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+var _ bankkeeper.Keeper = BankKeeper{}
+
+// BankKeeper wraps the bank keeper of x/bank and ibc transfer so that the preferred stakes of the accounts
+// sending bTokens are capped to their remaining balance, which keeps the total preferred stakes of the validators
+// up to date without iterating all delegators.
+type BankKeeper struct {
+	bankkeeper.BaseKeeper
+	keeper Keeper
+}
+
+// NewBankKeeper returns the bank keeper to be used by x/bank and ibc transfer.
+func NewBankKeeper(bk bankkeeper.BaseKeeper, k Keeper) BankKeeper {
+	return BankKeeper{BaseKeeper: bk, keeper: k}
+}
+
+// SendCoins sends the coins and caps the preferred stakes of the sender.
+func (bk BankKeeper) SendCoins(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	if err := bk.BaseKeeper.SendCoins(ctx, fromAddr, toAddr, amt); err != nil {
+		return err
+	}
+	bk.keeper.AfterCoinsSent(ctx, fromAddr, amt)
+	return nil
+}
+
+// InputOutputCoins sends the coins of the inputs to the outputs and caps the preferred stakes of the inputs.
+func (bk BankKeeper) InputOutputCoins(ctx sdk.Context, inputs []banktypes.Input, outputs []banktypes.Output) error {
+	if err := bk.BaseKeeper.InputOutputCoins(ctx, inputs, outputs); err != nil {
+		return err
+	}
+	for _, in := range inputs {
+		bk.keeper.AfterCoinsSent(ctx, sdk.MustAccAddressFromBech32(in.Address), in.Coins)
+	}
+	return nil
+}
+
+// SendCoinsFromAccountToModule sends the coins to the module account and caps the preferred stakes of the sender.
+func (bk BankKeeper) SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error {
+	if err := bk.BaseKeeper.SendCoinsFromAccountToModule(ctx, senderAddr, recipientModule, amt); err != nil {
+		return err
+	}
+	bk.keeper.AfterCoinsSent(ctx, senderAddr, amt)
+	return nil
+}
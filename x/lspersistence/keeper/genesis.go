@@ -22,6 +22,23 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 	for _, lv := range genState.LiquidValidators {
 		k.SetLiquidValidator(ctx, lv)
 	}
+	k.AddCollectedRewardFees(ctx, genState.CollectedRewardFees)
+	for _, req := range genState.UnbondingRequests {
+		k.SetUnbondingRequest(ctx, req)
+	}
+	k.SetLastUnbondingRequestID(ctx, genState.LastUnbondingRequestId)
+	for _, record := range genState.SlashingRecords {
+		k.SetSlashingRecord(ctx, record)
+	}
+	k.SetLastSlashingRecordID(ctx, genState.LastSlashingRecordId)
+	k.SetPauseSwitches(ctx, genState.PauseSwitches)
+	for _, stake := range genState.PreferredStakes {
+		k.SetPreferredStake(ctx, stake)
+	}
+	for _, snapshot := range genState.NetAmountStateSnapshots {
+		k.SetNetAmountStateSnapshot(ctx, snapshot)
+	}
+	k.AddAutoWithdrawnRewards(ctx, genState.AutoWithdrawnRewards)
 
 	moduleAcc := k.accountKeeper.GetModuleAccount(ctx, types.ModuleName)
 	if moduleAcc == nil {
@@ -38,5 +55,8 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	}
 
 	liquidValidators := k.GetAllLiquidValidators(ctx)
-	return types.NewGenesisState(params, liquidValidators)
+	return types.NewGenesisState(params, liquidValidators, k.GetCollectedRewardFees(ctx),
+		k.GetAllUnbondingRequests(ctx), k.GetLastUnbondingRequestID(ctx),
+		k.GetAllSlashingRecords(ctx), k.GetLastSlashingRecordID(ctx), k.GetPauseSwitches(ctx),
+		k.GetAllPreferredStakes(ctx), k.GetAllNetAmountStateSnapshots(ctx), k.GetAutoWithdrawnRewards(ctx))
 }
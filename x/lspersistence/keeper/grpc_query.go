@@ -2,11 +2,15 @@ package keeper
 
 import (
 	"context"
+	"errors"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/persistenceOne/pstake-native/v2/x/lspersistence/types"
 )
@@ -45,3 +49,207 @@ func (k Querier) States(c context.Context, req *types.QueryStatesRequest) (*type
 
 	return &types.QueryStatesResponse{NetAmountState: k.GetNetAmountState(ctx)}, nil
 }
+
+// VotingPower queries the voting power of a voter.
+func (k Querier) VotingPower(c context.Context, req *types.QueryVotingPowerRequest) (*types.QueryVotingPowerResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	voter, err := sdk.AccAddressFromBech32(req.Voter)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryVotingPowerResponse{VotingPower: k.GetVotingPower(ctx, voter)}, nil
+}
+
+// CollectedRewardFees queries the cumulative reward fees sent to the reward fee address.
+func (k Querier) CollectedRewardFees(c context.Context, req *types.QueryCollectedRewardFeesRequest) (*types.QueryCollectedRewardFeesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryCollectedRewardFeesResponse{CollectedRewardFees: k.GetCollectedRewardFees(ctx)}, nil
+}
+
+// InstantUnstakeReserve queries the instant unstake reserve of the proxy account and its target.
+func (k Querier) InstantUnstakeReserve(c context.Context, req *types.QueryInstantUnstakeReserveRequest) (*types.QueryInstantUnstakeReserveResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	reserve := k.GetProxyAccBalance(ctx, types.LiquidStakingProxyAcc)
+	return &types.QueryInstantUnstakeReserveResponse{
+		Reserve: reserve,
+		Target:  sdk.NewCoin(reserve.Denom, k.GetInstantUnstakeReserveTarget(ctx)),
+	}, nil
+}
+
+// UnbondingRequests queries the pending unbonding requests of a delegator.
+func (k Querier) UnbondingRequests(c context.Context, req *types.QueryUnbondingRequestsRequest) (*types.QueryUnbondingRequestsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	delegator, err := sdk.AccAddressFromBech32(req.DelegatorAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetUnbondingRequestsKey(delegator))
+	var unbondingRequests []types.UnbondingRequest
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var unbondingRequest types.UnbondingRequest
+		if err := k.cdc.Unmarshal(value, &unbondingRequest); err != nil {
+			return err
+		}
+		unbondingRequests = append(unbondingRequests, unbondingRequest)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryUnbondingRequestsResponse{UnbondingRequests: unbondingRequests, Pagination: pageRes}, nil
+}
+
+// SlashingHistory queries the slashing records of the liquid validators, optionally of a validator.
+func (k Querier) SlashingHistory(c context.Context, req *types.QuerySlashingHistoryRequest) (*types.QuerySlashingHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.ValidatorAddress != "" {
+		if _, err := sdk.ValAddressFromBech32(req.ValidatorAddress); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.SlashingRecordsKey)
+	var slashingRecords []types.SlashingRecord
+	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(_, value []byte, accumulate bool) (bool, error) {
+		var slashingRecord types.SlashingRecord
+		if err := k.cdc.Unmarshal(value, &slashingRecord); err != nil {
+			return false, err
+		}
+		if req.ValidatorAddress != "" && slashingRecord.ValidatorAddress != req.ValidatorAddress {
+			return false, nil
+		}
+		if accumulate {
+			slashingRecords = append(slashingRecords, slashingRecord)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QuerySlashingHistoryResponse{SlashingRecords: slashingRecords, Pagination: pageRes}, nil
+}
+
+// PauseSwitches queries the paused operations of the module.
+func (k Querier) PauseSwitches(c context.Context, req *types.QueryPauseSwitchesRequest) (*types.QueryPauseSwitchesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryPauseSwitchesResponse{PauseSwitches: k.GetPauseSwitches(ctx)}, nil
+}
+
+// WhitelistChangePreview queries the whitelist and the redelegations and unbondings resulting from the whitelist changes.
+func (k Querier) WhitelistChangePreview(c context.Context, req *types.QueryWhitelistChangePreviewRequest) (*types.QueryWhitelistChangePreviewResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	whitelist, reds, unbondings, err := k.PreviewWhitelistChange(ctx, req.AddValidators, req.UpdateValidators, req.RemoveValidators)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	previews := make([]types.RedelegationPreview, 0, len(reds))
+	for _, red := range reds {
+		preview := types.RedelegationPreview{
+			SrcValidatorAddress: red.SrcValidator.OperatorAddress,
+			DstValidatorAddress: red.DstValidator.OperatorAddress,
+			Amount:              red.Amount,
+		}
+		if red.Error != nil {
+			preview.Error = red.Error.Error()
+		}
+		previews = append(previews, preview)
+	}
+	return &types.QueryWhitelistChangePreviewResponse{WhitelistedValidators: whitelist, Redelegations: previews, Unbondings: unbondings}, nil
+}
+
+// PreferredStakes queries the preferred stakes of a delegator.
+func (k Querier) PreferredStakes(c context.Context, req *types.QueryPreferredStakesRequest) (*types.QueryPreferredStakesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	delegator, err := sdk.AccAddressFromBech32(req.DelegatorAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryPreferredStakesResponse{PreferredStakes: k.GetPreferredStakes(ctx, delegator)}, nil
+}
+
+// EffectiveWeights queries the target weights of the active liquid validators shifted by the preferred stakes.
+func (k Querier) EffectiveWeights(c context.Context, req *types.QueryEffectiveWeightsRequest) (*types.QueryEffectiveWeightsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryEffectiveWeightsResponse{EffectiveWeights: k.GetEffectiveWeights(ctx)}, nil
+}
+
+// StatesHistory queries the recorded snapshots of the net amount state.
+func (k Querier) StatesHistory(c context.Context, req *types.QueryStatesHistoryRequest) (*types.QueryStatesHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.StatesHistoryKey)
+	var snapshots []types.NetAmountStateSnapshot
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var snapshot types.NetAmountStateSnapshot
+		if err := k.cdc.Unmarshal(value, &snapshot); err != nil {
+			return err
+		}
+		snapshots = append(snapshots, snapshot)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryStatesHistoryResponse{Snapshots: snapshots, Pagination: pageRes}, nil
+}
+
+// APY queries the APY of the bToken estimated over the states history.
+func (k Querier) APY(c context.Context, req *types.QueryAPYRequest) (*types.QueryAPYResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.FromHeight < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "from height must not be negative: %d", req.FromHeight)
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	apy, from, to, err := k.EstimateAPY(ctx, req.FromHeight)
+	if err != nil {
+		if errors.Is(err, sdkerrors.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryAPYResponse{Apy: apy, From: from, To: to}, nil
+}
@@ -0,0 +1,77 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// Hooks wrapper struct for the liquidstaking keeper
+type Hooks struct {
+	k Keeper
+}
+
+var _ stakingtypes.StakingHooks = Hooks{}
+
+// Hooks returns the wrapper struct of the staking hooks
+func (k Keeper) Hooks() Hooks {
+	return Hooks{k}
+}
+
+// BeforeValidatorSlashed records the slashing loss of the liquid validator and queues it for deactivation,
+// the jailing following the slashing makes it inactive.
+// The liquid tokens can't be redelegated here as the staking module is in the middle of slashing the validator.
+func (h Hooks) BeforeValidatorSlashed(ctx sdk.Context, valAddr sdk.ValAddress, fraction sdk.Dec) error {
+	if _, found := h.k.GetLiquidValidator(ctx, valAddr); !found {
+		return nil
+	}
+	h.k.HandleLiquidValidatorSlashed(ctx, valAddr, fraction)
+	h.k.SetDeactivationQueue(ctx, valAddr)
+	return nil
+}
+
+// AfterValidatorBeginUnbonding queues the liquid validator for deactivation when it is jailed.
+// The liquid tokens can't be redelegated here as the staking module is in the middle of updating the validator set.
+func (h Hooks) AfterValidatorBeginUnbonding(ctx sdk.Context, _ sdk.ConsAddress, valAddr sdk.ValAddress) error {
+	if _, found := h.k.GetLiquidValidator(ctx, valAddr); !found {
+		return nil
+	}
+	validator, found := h.k.stakingKeeper.GetValidator(ctx, valAddr)
+	if found && validator.IsJailed() {
+		h.k.SetDeactivationQueue(ctx, valAddr)
+	}
+	return nil
+}
+
+// AfterValidatorBonded implements the staking hooks, the liquid validators re-bonded after being unjailed are
+// activated again when the liquid validator set is updated.
+func (h Hooks) AfterValidatorBonded(_ sdk.Context, _ sdk.ConsAddress, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h Hooks) AfterValidatorCreated(_ sdk.Context, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h Hooks) BeforeValidatorModified(_ sdk.Context, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h Hooks) AfterValidatorRemoved(_ sdk.Context, _ sdk.ConsAddress, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h Hooks) BeforeDelegationCreated(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h Hooks) BeforeDelegationSharesModified(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h Hooks) BeforeDelegationRemoved(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h Hooks) AfterDelegationModified(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress) error {
+	return nil
+}
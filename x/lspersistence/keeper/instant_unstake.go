@@ -0,0 +1,72 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/persistenceOne/pstake-native/v2/x/lspersistence/types"
+)
+
+// GetInstantUnstakeReserveTarget returns the amount of native tokens to be kept unstaked in the LiquidStakingProxyAcc
+// as the instant unstake reserve, InstantUnstakeReserveRatio of the NetAmount.
+func (k Keeper) GetInstantUnstakeReserveTarget(ctx sdk.Context) math.Int {
+	ratio := k.GetParams(ctx).InstantUnstakeReserveRatio
+	if !ratio.IsPositive() {
+		return sdk.ZeroInt()
+	}
+	return k.GetNetAmountState(ctx).NetAmount.Mul(ratio).TruncateInt()
+}
+
+// InstantLiquidUnstake burns the bToken and sends the native tokens it values, minus the InstantUnstakeFeeRate,
+// immediately from the instant unstake reserve of the proxy account. The fee remains in the reserve.
+func (k Keeper) InstantLiquidUnstake(
+	ctx sdk.Context, proxyAcc, liquidStaker sdk.AccAddress, unstakingBtoken sdk.Coin,
+) (unstakedAmount math.Int, fee math.Int, err error) {
+	params := k.GetParams(ctx)
+	if !params.InstantUnstakeReserveRatio.IsPositive() {
+		return sdk.ZeroInt(), sdk.ZeroInt(), types.ErrInstantUnstakeDisabled
+	}
+
+	// check bond denomination
+	liquidBondDenom := k.LiquidBondDenom(ctx)
+	if unstakingBtoken.Denom != liquidBondDenom {
+		return sdk.ZeroInt(), sdk.ZeroInt(), errorsmod.Wrapf(
+			types.ErrInvalidLiquidBondDenom, "invalid coin denomination: got %s, expected %s", unstakingBtoken.Denom, liquidBondDenom,
+		)
+	}
+
+	nas := k.GetNetAmountState(ctx)
+	if unstakingBtoken.Amount.GT(nas.BtokenTotalSupply) {
+		return sdk.ZeroInt(), sdk.ZeroInt(), types.ErrInvalidBTokenSupply
+	}
+
+	// UnstakedAmount = NetAmount * BTokenAmount/TotalSupply * (1-InstantUnstakeFeeRate)
+	unstakingAmount := types.BTokenToNativeToken(unstakingBtoken.Amount, nas.BtokenTotalSupply, nas.NetAmount)
+	unstakedAmount = types.DeductFeeRate(unstakingAmount, params.InstantUnstakeFeeRate).TruncateInt()
+	if !unstakedAmount.IsPositive() {
+		return sdk.ZeroInt(), sdk.ZeroInt(), types.ErrTooSmallLiquidUnstakingAmount
+	}
+	fee = unstakingAmount.TruncateInt().Sub(unstakedAmount)
+
+	reserve := k.GetProxyAccBalance(ctx, proxyAcc)
+	if reserve.Amount.LT(unstakedAmount) {
+		return sdk.ZeroInt(), sdk.ZeroInt(), errorsmod.Wrapf(
+			types.ErrInsufficientInstantUnstakeReserve, "unstaking amount %s is larger than the reserve %s", unstakedAmount, reserve.Amount,
+		)
+	}
+
+	// burn btoken
+	if err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, liquidStaker, types.ModuleName, sdk.NewCoins(unstakingBtoken)); err != nil {
+		return sdk.ZeroInt(), sdk.ZeroInt(), err
+	}
+	if err = k.bankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(unstakingBtoken)); err != nil {
+		return sdk.ZeroInt(), sdk.ZeroInt(), err
+	}
+	k.CapPreferredStakes(ctx, liquidStaker)
+
+	if err = k.bankKeeper.SendCoins(ctx, proxyAcc, liquidStaker, sdk.NewCoins(sdk.NewCoin(reserve.Denom, unstakedAmount))); err != nil {
+		return sdk.ZeroInt(), sdk.ZeroInt(), err
+	}
+	return unstakedAmount, fee, nil
+}
@@ -0,0 +1,106 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
+
+	"github.com/persistenceOne/pstake-native/v2/x/lspersistence/types"
+)
+
+func (s *KeeperTestSuite) TestInstantLiquidUnstake() {
+	_, valOpers, _ := s.CreateValidators([]int64{1000000, 1000000, 1000000})
+	params := s.keeper.GetParams(s.ctx)
+	params.WhitelistedValidators = []types.WhitelistedValidator{
+		{ValidatorAddress: valOpers[0].String(), TargetWeight: sdk.NewInt(10)},
+		{ValidatorAddress: valOpers[1].String(), TargetWeight: sdk.NewInt(10)},
+	}
+	s.keeper.SetParams(s.ctx, params)
+	s.keeper.UpdateLiquidValidatorSet(s.ctx)
+	s.Require().NoError(s.liquidStaking(s.delAddrs[0], sdk.NewInt(10000000)))
+	bondDenom := s.app.StakingKeeper.BondDenom(s.ctx)
+	unstakingBtoken := sdk.NewInt64Coin(params.LiquidBondDenom, 1000000)
+
+	// instant unstaking is disabled by default
+	_, _, err := s.keeper.InstantLiquidUnstake(s.ctx, types.LiquidStakingProxyAcc, s.delAddrs[0], unstakingBtoken)
+	s.Require().ErrorIs(err, types.ErrInstantUnstakeDisabled)
+
+	params.InstantUnstakeReserveRatio = sdk.NewDecWithPrec(1, 1)
+	params.InstantUnstakeFeeRate = sdk.NewDecWithPrec(1, 2)
+	s.keeper.SetParams(s.ctx, params)
+
+	// the reserve is empty, all the liquid staked tokens are delegated
+	_, _, err = s.keeper.InstantLiquidUnstake(s.ctx, types.LiquidStakingProxyAcc, s.delAddrs[0], unstakingBtoken)
+	s.Require().ErrorIs(err, types.ErrInsufficientInstantUnstakeReserve)
+	_, _, err = s.keeper.InstantLiquidUnstake(s.ctx, types.LiquidStakingProxyAcc, s.delAddrs[0], sdk.NewInt64Coin(bondDenom, 1000000))
+	s.Require().ErrorIs(err, types.ErrInvalidLiquidBondDenom)
+
+	// the reserve is filled, e.g. by matured unbondings of the proxy account
+	s.Require().NoError(testutil.FundAccount(s.app.BankKeeper, s.ctx, types.LiquidStakingProxyAcc, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 2000000))))
+	nas := s.keeper.GetNetAmountState(s.ctx)
+	expectedFee := types.BTokenToNativeToken(unstakingBtoken.Amount, nas.BtokenTotalSupply, nas.NetAmount).
+		Mul(params.InstantUnstakeFeeRate).TruncateInt()
+	balanceBefore := s.app.BankKeeper.GetBalance(s.ctx, s.delAddrs[0], bondDenom).Amount
+
+	unstakedAmount, fee, err := s.keeper.InstantLiquidUnstake(s.ctx, types.LiquidStakingProxyAcc, s.delAddrs[0], unstakingBtoken)
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewInt(1188000), unstakedAmount)
+	s.Require().Equal(expectedFee, fee)
+	s.Require().Equal(balanceBefore.Add(unstakedAmount), s.app.BankKeeper.GetBalance(s.ctx, s.delAddrs[0], bondDenom).Amount)
+	s.Require().Equal(sdk.NewInt(2000000).Sub(unstakedAmount), s.keeper.GetProxyAccBalance(s.ctx, types.LiquidStakingProxyAcc).Amount)
+	s.Require().Equal(nas.BtokenTotalSupply.Sub(unstakingBtoken.Amount), s.keeper.GetNetAmountState(s.ctx).BtokenTotalSupply)
+	// no unbonding request is recorded
+	s.Require().Empty(s.keeper.GetUnbondingRequests(s.ctx, s.delAddrs[0]))
+
+	// the fee remains in the reserve, so the mint rate is decreased for the remaining bToken holders
+	s.Require().True(s.keeper.GetNetAmountState(s.ctx).MintRate.LT(nas.MintRate))
+
+	// the remaining reserve is not enough
+	_, _, err = s.keeper.InstantLiquidUnstake(s.ctx, types.LiquidStakingProxyAcc, s.delAddrs[0], unstakingBtoken)
+	s.Require().ErrorIs(err, types.ErrInsufficientInstantUnstakeReserve)
+
+	res, err := s.querier.InstantUnstakeReserve(sdk.WrapSDKContext(s.ctx), &types.QueryInstantUnstakeReserveRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(s.keeper.GetProxyAccBalance(s.ctx, types.LiquidStakingProxyAcc), res.Reserve)
+	s.Require().Equal(sdk.NewCoin(bondDenom, s.keeper.GetInstantUnstakeReserveTarget(s.ctx)), res.Target)
+	_, err = s.querier.InstantUnstakeReserve(sdk.WrapSDKContext(s.ctx), nil)
+	s.Require().Error(err)
+}
+
+func (s *KeeperTestSuite) TestWithdrawRewardsAndReStakeInstantUnstakeReserve() {
+	_, valOpers, _ := s.CreateValidators([]int64{1000000, 1000000, 1000000})
+	params := s.keeper.GetParams(s.ctx)
+	params.WhitelistedValidators = []types.WhitelistedValidator{
+		{ValidatorAddress: valOpers[0].String(), TargetWeight: sdk.NewInt(10)},
+		{ValidatorAddress: valOpers[1].String(), TargetWeight: sdk.NewInt(10)},
+	}
+	params.InstantUnstakeReserveRatio = sdk.NewDecWithPrec(1, 1)
+	s.keeper.SetParams(s.ctx, params)
+	s.keeper.UpdateLiquidValidatorSet(s.ctx)
+	s.Require().NoError(s.liquidStaking(s.delAddrs[0], sdk.NewInt(100000000)))
+	whitelistedValsMap := types.GetWhitelistedValsMap(params.WhitelistedValidators)
+	bondDenom := s.app.StakingKeeper.BondDenom(s.ctx)
+
+	// the withdrawn rewards refill the reserve instead of being re-staked while it is below the target
+	s.advanceHeight(1, false)
+	totalRewards, totalDelShares, _ := s.keeper.CheckDelegationStates(s.ctx, types.LiquidStakingProxyAcc)
+	s.Require().True(totalRewards.IsPositive())
+	s.Require().True(sdk.NewDecFromInt(s.keeper.GetInstantUnstakeReserveTarget(s.ctx)).GT(totalRewards))
+	s.keeper.WithdrawRewardsAndReStake(s.ctx, whitelistedValsMap)
+	totalRewardsAfter, totalDelSharesAfter, _ := s.keeper.CheckDelegationStates(s.ctx, types.LiquidStakingProxyAcc)
+	s.Require().Equal(totalDelShares, totalDelSharesAfter)
+	s.Require().True(totalRewardsAfter.IsZero())
+	s.Require().Equal(totalRewards.TruncateInt(), s.keeper.GetProxyAccBalance(s.ctx, types.LiquidStakingProxyAcc).Amount)
+
+	// the balance over the target is re-staked, e.g. matured unbondings of the proxy account
+	s.Require().NoError(testutil.FundAccount(s.app.BankKeeper, s.ctx, types.LiquidStakingProxyAcc, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 20000000))))
+	target := s.keeper.GetInstantUnstakeReserveTarget(s.ctx)
+	s.keeper.WithdrawRewardsAndReStake(s.ctx, whitelistedValsMap)
+	s.Require().Equal(target, s.keeper.GetProxyAccBalance(s.ctx, types.LiquidStakingProxyAcc).Amount)
+	_, totalDelSharesAfter, _ = s.keeper.CheckDelegationStates(s.ctx, types.LiquidStakingProxyAcc)
+	s.Require().True(totalDelSharesAfter.GT(totalDelShares))
+	s.Require().Equal(target, s.keeper.GetInstantUnstakeReserveTarget(s.ctx))
+
+	// the reserve is kept when the balance is at the target
+	s.keeper.WithdrawRewardsAndReStake(s.ctx, whitelistedValsMap)
+	s.Require().Equal(target, s.keeper.GetProxyAccBalance(s.ctx, types.LiquidStakingProxyAcc).Amount)
+}
@@ -23,6 +23,11 @@ type Keeper struct {
 	stakingKeeper  types.StakingKeeper
 	distrKeeper    types.DistrKeeper
 	slashingKeeper types.SlashingKeeper
+
+	bTokenSources []types.BTokenSource
+
+	// the address capable of executing the governance-only messages, usually the x/gov module account
+	authority string
 }
 
 // NewKeeper returns a liquidstaking keeper. It handles:
@@ -31,7 +36,7 @@ type Keeper struct {
 // - minting, burning PoolCoins
 func NewKeeper(cdc codec.BinaryCodec, key storetypes.StoreKey, paramSpace paramtypes.Subspace,
 	accountKeeper types.AccountKeeper, bankKeeper types.BankKeeper, stakingKeeper types.StakingKeeper,
-	distrKeeper types.DistrKeeper, slashingKeeper types.SlashingKeeper,
+	distrKeeper types.DistrKeeper, slashingKeeper types.SlashingKeeper, authority string,
 ) Keeper {
 	// ensure liquidstaking module account is set
 	if addr := accountKeeper.GetModuleAddress(types.ModuleName); addr == nil {
@@ -52,7 +57,23 @@ func NewKeeper(cdc codec.BinaryCodec, key storetypes.StoreKey, paramSpace paramt
 		stakingKeeper:  stakingKeeper,
 		distrKeeper:    distrKeeper,
 		slashingKeeper: slashingKeeper,
+		authority:      authority,
+	}
+}
+
+// GetAuthority returns the address capable of executing the governance-only messages.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// SetBTokenSources registers the sources of bTokens held outside of wallets counted in the voting power of voters.
+func (k *Keeper) SetBTokenSources(sources ...types.BTokenSource) *Keeper {
+	if k.bTokenSources != nil {
+		panic("cannot set bToken sources twice")
 	}
+
+	k.bTokenSources = sources
+	return k
 }
 
 // Logger returns a module-specific logger.
@@ -47,7 +47,8 @@ func (k Keeper) GetNetAmountState(ctx sdk.Context) (nas types.NetAmountState) {
 
 // LiquidStake mints bToken worth of staking coin value according to NetAmount and performs LiquidDelegate.
 func (k Keeper) LiquidStake(
-	ctx sdk.Context, proxyAcc, liquidStaker sdk.AccAddress, stakingCoin sdk.Coin) (newShares sdk.Dec, bTokenMintAmount math.Int, err error) {
+	ctx sdk.Context, proxyAcc, liquidStaker sdk.AccAddress, stakingCoin sdk.Coin, preferredVals []types.PreferredValidator,
+) (newShares sdk.Dec, bTokenMintAmount math.Int, err error) {
 	params := k.GetParams(ctx)
 
 	// check minimum liquid staking amount
@@ -68,6 +69,9 @@ func (k Keeper) LiquidStake(
 	if activeVals.Len() == 0 || !activeVals.TotalWeight(whitelistedValsMap).IsPositive() {
 		return sdk.ZeroDec(), sdk.ZeroInt(), types.ErrActiveLiquidValidatorsNotExists
 	}
+	if err = k.ValidatePreferredValidators(ctx, params, whitelistedValsMap, preferredVals); err != nil {
+		return sdk.ZeroDec(), sdk.ZeroInt(), err
+	}
 
 	// NetAmount must be calculated before send
 	nas := k.GetNetAmountState(ctx)
@@ -100,7 +104,9 @@ func (k Keeper) LiquidStake(
 		return sdk.ZeroDec(), bTokenMintAmount, err
 	}
 
-	newShares, err = k.LiquidDelegate(ctx, proxyAcc, activeVals, stakingCoin.Amount, whitelistedValsMap)
+	// the staking amount is delegated by the target weights shifted by the preferred stakes, including the new ones
+	k.AddPreferredStakes(ctx, liquidStaker, preferredVals, bTokenMintAmount)
+	newShares, err = k.LiquidDelegate(ctx, proxyAcc, activeVals, stakingCoin.Amount, k.GetEffectiveWhitelistedValsMap(ctx, params))
 	return newShares, bTokenMintAmount, err
 }
 
@@ -118,10 +124,12 @@ func (k Keeper) LiquidDelegate(ctx sdk.Context, proxyAcc sdk.AccAddress, activeV
 			continue
 		}
 		validator, _ := k.stakingKeeper.GetValidator(ctx, val.GetOperator())
+		balance := k.GetProxyAccBalance(ctx, proxyAcc)
 		newShares, err = k.stakingKeeper.Delegate(ctx, proxyAcc, weightedAmt[i], stakingtypes.Unbonded, validator, true)
 		if err != nil {
 			return sdk.ZeroDec(), err
 		}
+		k.trackAutoWithdrawnRewards(ctx, proxyAcc, balance, weightedAmt[i])
 		totalNewShares = totalNewShares.Add(newShares)
 	}
 	return totalNewShares, nil
@@ -166,6 +174,7 @@ func (k Keeper) LiquidUnstake(
 	if err != nil {
 		return time.Time{}, sdk.ZeroInt(), []stakingtypes.UnbondingDelegation{}, sdk.ZeroInt(), err
 	}
+	k.CapPreferredStakes(ctx, liquidStaker)
 
 	liquidVals := k.GetAllLiquidValidators(ctx)
 	totalLiquidTokens, liquidTokenMap := liquidVals.TotalLiquidTokens(ctx, k.stakingKeeper, false)
@@ -196,6 +205,7 @@ func (k Keeper) LiquidUnstake(
 	totalReturnAmount := sdk.ZeroInt()
 	var ubdTime time.Time
 	var ubds []stakingtypes.UnbondingDelegation //nolint: prealloc
+	var entries []types.UnbondingRequestEntry   //nolint: prealloc
 	for i, val := range liquidVals {
 		// skip zero weight liquid validator
 		if !unbondingAmounts[i].IsPositive() {
@@ -219,6 +229,14 @@ func (k Keeper) LiquidUnstake(
 		}
 		ubds = append(ubds, ubd)
 		totalReturnAmount = totalReturnAmount.Add(returnAmount)
+		if returnAmount.IsPositive() {
+			entries = append(entries, types.UnbondingRequestEntry{ValidatorAddress: val.OperatorAddress, Amount: returnAmount})
+		}
+	}
+
+	// record the unbonding request of the liquid staker, its unbonding delegation entries are paid out by the staking module
+	if totalReturnAmount.IsPositive() {
+		k.AddUnbondingRequest(ctx, liquidStaker, unstakingBtoken, totalReturnAmount, entries, ubdTime)
 	}
 	return ubdTime, totalReturnAmount, ubds, sdk.ZeroInt(), nil
 }
@@ -238,10 +256,12 @@ func (k Keeper) LiquidUnbond(
 	}
 
 	// unbond from proxy account
+	balance := k.GetProxyAccBalance(ctx, proxyAcc)
 	returnAmount, err := k.stakingKeeper.Unbond(ctx, proxyAcc, valAddr, shares)
 	if err != nil {
 		return time.Time{}, sdk.ZeroInt(), stakingtypes.UnbondingDelegation{}, err
 	}
+	k.trackAutoWithdrawnRewards(ctx, proxyAcc, balance, sdk.ZeroInt())
 
 	// transfer the validator tokens to the not bonded pool
 	if validator.IsBonded() {
@@ -24,7 +24,7 @@ func (s *KeeperTestSuite) TestLiquidStake() {
 
 	// fail, no active validator
 	cachedCtx, _ := s.ctx.CacheContext()
-	newShares, bTokenMintAmt, err := s.keeper.LiquidStake(cachedCtx, types.LiquidStakingProxyAcc, s.delAddrs[0], sdk.NewCoin(sdk.DefaultBondDenom, stakingAmt))
+	newShares, bTokenMintAmt, err := s.keeper.LiquidStake(cachedCtx, types.LiquidStakingProxyAcc, s.delAddrs[0], sdk.NewCoin(sdk.DefaultBondDenom, stakingAmt), nil)
 	s.Require().ErrorIs(err, types.ErrActiveLiquidValidatorsNotExists)
 	s.Require().Equal(newShares, sdk.ZeroDec())
 	s.Require().Equal(bTokenMintAmt, sdk.ZeroInt())
@@ -58,7 +58,7 @@ func (s *KeeperTestSuite) TestLiquidStake() {
 	s.Require().Equal(sdk.ZeroInt(), res[2].LiquidTokens)
 
 	// liquid staking
-	newShares, bTokenMintAmt, err = s.keeper.LiquidStake(s.ctx, types.LiquidStakingProxyAcc, s.delAddrs[0], sdk.NewCoin(sdk.DefaultBondDenom, stakingAmt))
+	newShares, bTokenMintAmt, err = s.keeper.LiquidStake(s.ctx, types.LiquidStakingProxyAcc, s.delAddrs[0], sdk.NewCoin(sdk.DefaultBondDenom, stakingAmt), nil)
 	s.Require().NoError(err)
 	s.Require().Equal(newShares, sdk.NewDecFromInt(stakingAmt))
 	s.Require().Equal(bTokenMintAmt, stakingAmt)
@@ -249,7 +249,7 @@ func (s *KeeperTestSuite) TestLiquidStakeEdgeCases() {
 	s.keeper.UpdateLiquidValidatorSet(s.ctx)
 
 	// fail Invalid BondDenom case
-	_, _, err := s.keeper.LiquidStake(s.ctx, types.LiquidStakingProxyAcc, s.delAddrs[0], sdk.NewCoin("bad", stakingAmt))
+	_, _, err := s.keeper.LiquidStake(s.ctx, types.LiquidStakingProxyAcc, s.delAddrs[0], sdk.NewCoin("bad", stakingAmt), nil)
 	s.Require().ErrorIs(err, types.ErrInvalidBondDenom)
 
 	// liquid staking, unstaking with huge amount
@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	v2 "github.com/persistenceOne/pstake-native/v2/x/lspersistence/migrations/v2"
+	v3 "github.com/persistenceOne/pstake-native/v2/x/lspersistence/migrations/v3"
+	v4 "github.com/persistenceOne/pstake-native/v2/x/lspersistence/migrations/v4"
+	v5 "github.com/persistenceOne/pstake-native/v2/x/lspersistence/migrations/v5"
+	v6 "github.com/persistenceOne/pstake-native/v2/x/lspersistence/migrations/v6"
+	v7 "github.com/persistenceOne/pstake-native/v2/x/lspersistence/migrations/v7"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates the liquidstaking store from consensus version 1 to 2, the reward and rebalancing
+// triggers and the max redelegations per block are added to the params.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	return v2.MigrateStore(ctx, m.keeper.paramSpace)
+}
+
+// Migrate2to3 migrates the liquidstaking store from consensus version 2 to 3, the reward fee rate and address
+// are added to the params.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	return v3.MigrateStore(ctx, m.keeper.paramSpace)
+}
+
+// Migrate3to4 migrates the liquidstaking store from consensus version 3 to 4, the instant unstake reserve ratio
+// and fee rate are added to the params.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	return v4.MigrateStore(ctx, m.keeper.paramSpace)
+}
+
+// Migrate4to5 migrates the liquidstaking store from consensus version 4 to 5, the pauser address is added to the
+// params.
+func (m Migrator) Migrate4to5(ctx sdk.Context) error {
+	return v5.MigrateStore(ctx, m.keeper.paramSpace)
+}
+
+// Migrate5to6 migrates the liquidstaking store from consensus version 5 to 6, the max preferred weight shift is
+// added to the params.
+func (m Migrator) Migrate5to6(ctx sdk.Context) error {
+	return v6.MigrateStore(ctx, m.keeper.paramSpace)
+}
+
+// Migrate6to7 migrates the liquidstaking store from consensus version 6 to 7, the net amount state snapshot interval
+// and the max net amount state snapshots are added to the params.
+func (m Migrator) Migrate6to7(ctx sdk.Context) error {
+	return v7.MigrateStore(ctx, m.keeper.paramSpace)
+}
@@ -29,7 +29,11 @@ var _ types.MsgServer = msgServer{}
 func (k msgServer) LiquidStake(goCtx context.Context, msg *types.MsgLiquidStake) (*types.MsgLiquidStakeResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	newShares, bTokenMintAmount, err := k.Keeper.LiquidStake(ctx, types.LiquidStakingProxyAcc, msg.GetDelegator(), msg.Amount)
+	if k.GetPauseSwitches(ctx).LiquidStakePaused {
+		return nil, types.ErrLiquidStakePaused
+	}
+
+	newShares, bTokenMintAmount, err := k.Keeper.LiquidStake(ctx, types.LiquidStakingProxyAcc, msg.GetDelegator(), msg.Amount, msg.PreferredValidators)
 	if err != nil {
 		return nil, err
 	}
@@ -54,6 +58,10 @@ func (k msgServer) LiquidStake(goCtx context.Context, msg *types.MsgLiquidStake)
 func (k msgServer) LiquidUnstake(goCtx context.Context, msg *types.MsgLiquidUnstake) (*types.MsgLiquidUnstakeResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	if k.GetPauseSwitches(ctx).LiquidUnstakePaused {
+		return nil, types.ErrLiquidUnstakePaused
+	}
+
 	completionTime, unbondingAmount, _, unbondedAmount, err := k.Keeper.LiquidUnstake(ctx, types.LiquidStakingProxyAcc, msg.GetDelegator(), msg.Amount)
 	if err != nil {
 		return nil, err
@@ -78,3 +86,98 @@ func (k msgServer) LiquidUnstake(goCtx context.Context, msg *types.MsgLiquidUnst
 		CompletionTime: completionTime,
 	}, nil
 }
+
+func (k msgServer) InstantLiquidUnstake(goCtx context.Context, msg *types.MsgInstantLiquidUnstake) (*types.MsgInstantLiquidUnstakeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if k.GetPauseSwitches(ctx).LiquidUnstakePaused {
+		return nil, types.ErrLiquidUnstakePaused
+	}
+
+	unstakedAmount, fee, err := k.Keeper.InstantLiquidUnstake(ctx, types.LiquidStakingProxyAcc, msg.GetDelegator(), msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	unstakedCoin := sdk.NewCoin(k.stakingKeeper.BondDenom(ctx), unstakedAmount)
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+		sdk.NewEvent(
+			types.EventTypeMsgInstantLiquidUnstake,
+			sdk.NewAttribute(types.AttributeKeyDelegator, msg.DelegatorAddress),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyUnstakedAmount, unstakedCoin.String()),
+			sdk.NewAttribute(types.AttributeKeyInstantUnstakeFee, sdk.Coin{Denom: unstakedCoin.Denom, Amount: fee}.String()),
+		),
+	})
+	return &types.MsgInstantLiquidUnstakeResponse{
+		UnstakedAmount: unstakedCoin,
+	}, nil
+}
+
+func (k msgServer) UpdatePauseSwitches(goCtx context.Context, msg *types.MsgUpdatePauseSwitches) (*types.MsgUpdatePauseSwitchesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.Keeper.UpdatePauseSwitches(ctx, msg.Authority, msg.PauseSwitches); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+	return &types.MsgUpdatePauseSwitchesResponse{}, nil
+}
+
+func (k msgServer) AddWhitelistedValidators(goCtx context.Context, msg *types.MsgAddWhitelistedValidators) (*types.MsgAddWhitelistedValidatorsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.Keeper.AddWhitelistedValidators(ctx, msg.Authority, msg.WhitelistedValidators); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+	return &types.MsgAddWhitelistedValidatorsResponse{}, nil
+}
+
+func (k msgServer) UpdateWhitelistedValidatorWeights(goCtx context.Context, msg *types.MsgUpdateWhitelistedValidatorWeights) (*types.MsgUpdateWhitelistedValidatorWeightsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.Keeper.UpdateWhitelistedValidatorWeights(ctx, msg.Authority, msg.WhitelistedValidators); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+	return &types.MsgUpdateWhitelistedValidatorWeightsResponse{}, nil
+}
+
+func (k msgServer) RemoveWhitelistedValidators(goCtx context.Context, msg *types.MsgRemoveWhitelistedValidators) (*types.MsgRemoveWhitelistedValidatorsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.Keeper.RemoveWhitelistedValidators(ctx, msg.Authority, msg.ValidatorAddresses); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+	return &types.MsgRemoveWhitelistedValidatorsResponse{}, nil
+}
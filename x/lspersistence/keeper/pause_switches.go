@@ -0,0 +1,61 @@
+package keeper
+
+import (
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/persistenceOne/pstake-native/v2/x/lspersistence/types"
+)
+
+// GetPauseSwitches returns the paused operations of the module, no operation is paused by default.
+func (k Keeper) GetPauseSwitches(ctx sdk.Context) (switches types.PauseSwitches) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.PauseSwitchesKey)
+	if bz == nil {
+		return switches
+	}
+	k.cdc.MustUnmarshal(bz, &switches)
+	return switches
+}
+
+// SetPauseSwitches sets the paused operations of the module.
+func (k Keeper) SetPauseSwitches(ctx sdk.Context, switches types.PauseSwitches) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.PauseSwitchesKey, k.cdc.MustMarshal(&switches))
+}
+
+// UpdatePauseSwitches updates the paused operations of the module. The authority can pause and resume any operation,
+// the pauser of the params is only allowed to pause them in an emergency.
+func (k Keeper) UpdatePauseSwitches(ctx sdk.Context, authority string, switches types.PauseSwitches) error {
+	if authority != k.authority {
+		pauser := k.GetParams(ctx).PauserAddress
+		if pauser == "" || authority != pauser {
+			return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "only the authority %s or the pauser can update the pause switches, got %s", k.authority, authority)
+		}
+		if switches.Resumes(k.GetPauseSwitches(ctx)) {
+			return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "only the authority %s can resume the paused operations, got %s", k.authority, authority)
+		}
+	}
+	k.SetPauseSwitches(ctx, switches)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeMsgUpdatePauseSwitches,
+			sdk.NewAttribute(types.AttributeKeyAuthority, authority),
+			sdk.NewAttribute(types.AttributeKeyLiquidStakePaused, strconv.FormatBool(switches.LiquidStakePaused)),
+			sdk.NewAttribute(types.AttributeKeyLiquidUnstakePaused, strconv.FormatBool(switches.LiquidUnstakePaused)),
+			sdk.NewAttribute(types.AttributeKeyRebalancingPaused, strconv.FormatBool(switches.RebalancingPaused)),
+			sdk.NewAttribute(types.AttributeKeyRewardRestakingPaused, strconv.FormatBool(switches.RewardRestakingPaused)),
+		),
+	})
+	k.Logger(ctx).Info(types.EventTypeMsgUpdatePauseSwitches,
+		types.AttributeKeyAuthority, authority,
+		types.AttributeKeyLiquidStakePaused, strconv.FormatBool(switches.LiquidStakePaused),
+		types.AttributeKeyLiquidUnstakePaused, strconv.FormatBool(switches.LiquidUnstakePaused),
+		types.AttributeKeyRebalancingPaused, strconv.FormatBool(switches.RebalancingPaused),
+		types.AttributeKeyRewardRestakingPaused, strconv.FormatBool(switches.RewardRestakingPaused))
+	return nil
+}
@@ -0,0 +1,139 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
+
+	"github.com/persistenceOne/pstake-native/v2/x/lspersistence/keeper"
+	"github.com/persistenceOne/pstake-native/v2/x/lspersistence/types"
+)
+
+func (s *KeeperTestSuite) TestUpdatePauseSwitches() {
+	msgServer := keeper.NewMsgServerImpl(s.keeper)
+	authority := s.keeper.GetAuthority()
+	pauser := s.delAddrs[1]
+	paused := types.PauseSwitches{LiquidStakePaused: true, LiquidUnstakePaused: true}
+
+	// no operation is paused by default
+	s.Require().Equal(types.PauseSwitches{}, s.keeper.GetPauseSwitches(s.ctx))
+
+	// only the governance can pause the operations without the pauser
+	_, err := msgServer.UpdatePauseSwitches(sdk.WrapSDKContext(s.ctx), types.NewMsgUpdatePauseSwitches(pauser, paused))
+	s.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	params := s.keeper.GetParams(s.ctx)
+	params.PauserAddress = pauser.String()
+	s.keeper.SetParams(s.ctx, params)
+	_, err = msgServer.UpdatePauseSwitches(sdk.WrapSDKContext(s.ctx), types.NewMsgUpdatePauseSwitches(s.delAddrs[2], paused))
+	s.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	// the pauser pauses the operations
+	s.ctx = s.ctx.WithEventManager(sdk.NewEventManager())
+	_, err = msgServer.UpdatePauseSwitches(sdk.WrapSDKContext(s.ctx), types.NewMsgUpdatePauseSwitches(pauser, paused))
+	s.Require().NoError(err)
+	s.Require().Equal(paused, s.keeper.GetPauseSwitches(s.ctx))
+	found := false
+	for _, event := range s.ctx.EventManager().Events() {
+		if event.Type == types.EventTypeMsgUpdatePauseSwitches {
+			found = true
+		}
+	}
+	s.Require().True(found)
+
+	res, err := s.querier.PauseSwitches(sdk.WrapSDKContext(s.ctx), &types.QueryPauseSwitchesRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(paused, res.PauseSwitches)
+	_, err = s.querier.PauseSwitches(sdk.WrapSDKContext(s.ctx), nil)
+	s.Require().Error(err)
+
+	// the pauser can pause more operations but not resume them
+	morePaused := paused
+	morePaused.RebalancingPaused = true
+	_, err = msgServer.UpdatePauseSwitches(sdk.WrapSDKContext(s.ctx), types.NewMsgUpdatePauseSwitches(pauser, morePaused))
+	s.Require().NoError(err)
+	_, err = msgServer.UpdatePauseSwitches(sdk.WrapSDKContext(s.ctx), types.NewMsgUpdatePauseSwitches(pauser, paused))
+	s.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+	s.Require().Equal(morePaused, s.keeper.GetPauseSwitches(s.ctx))
+
+	// the paused switches are exported
+	s.Require().Equal(morePaused, s.keeper.ExportGenesis(s.ctx).PauseSwitches)
+
+	// the governance resumes the operations
+	_, err = msgServer.UpdatePauseSwitches(sdk.WrapSDKContext(s.ctx), &types.MsgUpdatePauseSwitches{Authority: authority})
+	s.Require().NoError(err)
+	s.Require().Equal(types.PauseSwitches{}, s.keeper.GetPauseSwitches(s.ctx))
+}
+
+func (s *KeeperTestSuite) TestPausedLiquidStaking() {
+	_, valOpers, _ := s.CreateValidators([]int64{1000000, 1000000, 1000000})
+	params := s.keeper.GetParams(s.ctx)
+	params.WhitelistedValidators = []types.WhitelistedValidator{
+		{ValidatorAddress: valOpers[0].String(), TargetWeight: sdk.NewInt(10)},
+		{ValidatorAddress: valOpers[1].String(), TargetWeight: sdk.NewInt(10)},
+	}
+	params.InstantUnstakeReserveRatio = sdk.NewDecWithPrec(1, 1)
+	s.keeper.SetParams(s.ctx, params)
+	s.keeper.UpdateLiquidValidatorSet(s.ctx)
+	s.Require().NoError(s.liquidStaking(s.delAddrs[0], sdk.NewInt(10000000)))
+	bondDenom := s.app.StakingKeeper.BondDenom(s.ctx)
+	s.Require().NoError(testutil.FundAccount(s.app.BankKeeper, s.ctx, types.LiquidStakingProxyAcc, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 2000000))))
+
+	msgServer := keeper.NewMsgServerImpl(s.keeper)
+	stakingCoin := sdk.NewInt64Coin(bondDenom, 1000000)
+	unstakingBtoken := sdk.NewInt64Coin(params.LiquidBondDenom, 100000)
+
+	s.keeper.SetPauseSwitches(s.ctx, types.PauseSwitches{LiquidStakePaused: true, LiquidUnstakePaused: true})
+	_, err := msgServer.LiquidStake(sdk.WrapSDKContext(s.ctx), types.NewMsgLiquidStake(s.delAddrs[0], stakingCoin))
+	s.Require().ErrorIs(err, types.ErrLiquidStakePaused)
+	_, err = msgServer.LiquidUnstake(sdk.WrapSDKContext(s.ctx), types.NewMsgLiquidUnstake(s.delAddrs[0], unstakingBtoken))
+	s.Require().ErrorIs(err, types.ErrLiquidUnstakePaused)
+	_, err = msgServer.InstantLiquidUnstake(sdk.WrapSDKContext(s.ctx), types.NewMsgInstantLiquidUnstake(s.delAddrs[0], unstakingBtoken))
+	s.Require().ErrorIs(err, types.ErrLiquidUnstakePaused)
+
+	s.keeper.SetPauseSwitches(s.ctx, types.PauseSwitches{})
+	_, err = msgServer.LiquidStake(sdk.WrapSDKContext(s.ctx), types.NewMsgLiquidStake(s.delAddrs[0], stakingCoin))
+	s.Require().NoError(err)
+	_, err = msgServer.LiquidUnstake(sdk.WrapSDKContext(s.ctx), types.NewMsgLiquidUnstake(s.delAddrs[0], unstakingBtoken))
+	s.Require().NoError(err)
+	_, err = msgServer.InstantLiquidUnstake(sdk.WrapSDKContext(s.ctx), types.NewMsgInstantLiquidUnstake(s.delAddrs[0], unstakingBtoken))
+	s.Require().NoError(err)
+}
+
+func (s *KeeperTestSuite) TestPausedRebalancingAndRestaking() {
+	_, valOpers, _ := s.CreateValidators([]int64{1000000, 1000000, 1000000})
+	params := s.keeper.GetParams(s.ctx)
+	params.WhitelistedValidators = []types.WhitelistedValidator{
+		{ValidatorAddress: valOpers[0].String(), TargetWeight: sdk.NewInt(10)},
+		{ValidatorAddress: valOpers[1].String(), TargetWeight: sdk.NewInt(10)},
+	}
+	s.keeper.SetParams(s.ctx, params)
+	s.keeper.UpdateLiquidValidatorSet(s.ctx)
+	s.Require().NoError(s.liquidStaking(s.delAddrs[0], sdk.NewInt(10000000)))
+
+	s.keeper.SetPauseSwitches(s.ctx, types.PauseSwitches{RebalancingPaused: true, RewardRestakingPaused: true})
+
+	// the added liquid validator is not rebalanced and the rewards are not withdrawn while paused
+	params.WhitelistedValidators = append(params.WhitelistedValidators,
+		types.WhitelistedValidator{ValidatorAddress: valOpers[2].String(), TargetWeight: sdk.NewInt(10)})
+	s.keeper.SetParams(s.ctx, params)
+	s.advanceHeight(1, false)
+	totalRewards, totalDelShares, _ := s.keeper.CheckDelegationStates(s.ctx, types.LiquidStakingProxyAcc)
+	s.Require().True(totalRewards.IsPositive())
+	s.Require().Empty(s.keeper.UpdateLiquidValidatorSet(s.ctx))
+	_, found := s.keeper.GetLiquidValidator(s.ctx, valOpers[2])
+	s.Require().True(found)
+	_, found = s.app.StakingKeeper.GetDelegation(s.ctx, types.LiquidStakingProxyAcc, valOpers[2])
+	s.Require().False(found)
+	totalRewardsAfter, totalDelSharesAfter, _ := s.keeper.CheckDelegationStates(s.ctx, types.LiquidStakingProxyAcc)
+	s.Require().Equal(totalRewards, totalRewardsAfter)
+	s.Require().Equal(totalDelShares, totalDelSharesAfter)
+
+	// resumed
+	s.keeper.SetPauseSwitches(s.ctx, types.PauseSwitches{})
+	s.Require().NotEmpty(s.keeper.UpdateLiquidValidatorSet(s.ctx))
+	_, found = s.app.StakingKeeper.GetDelegation(s.ctx, types.LiquidStakingProxyAcc, valOpers[2])
+	s.Require().True(found)
+	totalRewardsAfter, _, _ = s.keeper.CheckDelegationStates(s.ctx, types.LiquidStakingProxyAcc)
+	s.Require().True(totalRewardsAfter.IsZero())
+}
@@ -0,0 +1,274 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/persistenceOne/pstake-native/v2/x/lspersistence/types"
+)
+
+// GetPreferredStake returns the preferred stake of the delegator for the validator.
+func (k Keeper) GetPreferredStake(ctx sdk.Context, delegator sdk.AccAddress, valAddr sdk.ValAddress) (stake types.PreferredStake, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetPreferredStakeKey(delegator, valAddr))
+	if bz == nil {
+		return stake, false
+	}
+	k.cdc.MustUnmarshal(bz, &stake)
+	return stake, true
+}
+
+// SetPreferredStake sets the preferred stake of the delegator, or deletes it if the amount is not positive, and
+// updates the total preferred stake of the validator accordingly.
+func (k Keeper) SetPreferredStake(ctx sdk.Context, stake types.PreferredStake) {
+	store := ctx.KVStore(k.storeKey)
+	delegator, valAddr := stake.GetDelegator(), stake.GetValidator()
+	prevAmount := sdk.ZeroInt()
+	if prev, found := k.GetPreferredStake(ctx, delegator, valAddr); found {
+		prevAmount = prev.Amount
+	}
+	if stake.Amount.IsPositive() {
+		store.Set(types.GetPreferredStakeKey(delegator, valAddr), k.cdc.MustMarshal(&stake))
+	} else {
+		stake.Amount = sdk.ZeroInt()
+		store.Delete(types.GetPreferredStakeKey(delegator, valAddr))
+	}
+	k.setValidatorPreferredStake(ctx, valAddr, k.GetValidatorPreferredStake(ctx, valAddr).Sub(prevAmount).Add(stake.Amount))
+}
+
+// GetPreferredStakes returns the preferred stakes of the delegator.
+func (k Keeper) GetPreferredStakes(ctx sdk.Context, delegator sdk.AccAddress) []types.PreferredStake {
+	return k.getPreferredStakesWithPrefix(ctx, types.GetPreferredStakesKey(delegator))
+}
+
+// GetAllPreferredStakes returns the preferred stakes of all delegators.
+func (k Keeper) GetAllPreferredStakes(ctx sdk.Context) []types.PreferredStake {
+	return k.getPreferredStakesWithPrefix(ctx, types.PreferredStakesKey)
+}
+
+func (k Keeper) getPreferredStakesWithPrefix(ctx sdk.Context, prefix []byte) []types.PreferredStake {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	stakes := []types.PreferredStake{}
+	for ; iterator.Valid(); iterator.Next() {
+		var stake types.PreferredStake
+		k.cdc.MustUnmarshal(iterator.Value(), &stake)
+		stakes = append(stakes, stake)
+	}
+	return stakes
+}
+
+// GetValidatorPreferredStake returns the total bToken amount preferred for the validator.
+func (k Keeper) GetValidatorPreferredStake(ctx sdk.Context, valAddr sdk.ValAddress) math.Int {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetValidatorPreferredStakeKey(valAddr))
+	if bz == nil {
+		return sdk.ZeroInt()
+	}
+	var amount sdk.IntProto
+	k.cdc.MustUnmarshal(bz, &amount)
+	return amount.Int
+}
+
+func (k Keeper) setValidatorPreferredStake(ctx sdk.Context, valAddr sdk.ValAddress, amount math.Int) {
+	store := ctx.KVStore(k.storeKey)
+	if !amount.IsPositive() {
+		store.Delete(types.GetValidatorPreferredStakeKey(valAddr))
+		return
+	}
+	store.Set(types.GetValidatorPreferredStakeKey(valAddr), k.cdc.MustMarshal(&sdk.IntProto{Int: amount}))
+}
+
+// ValidatePreferredValidators checks the preferred liquid staking is enabled and the preferred validators are active
+// liquid validators, the whitelisted validators become liquid validators on the next BeginBlock.
+func (k Keeper) ValidatePreferredValidators(
+	ctx sdk.Context, params types.Params, whitelistedValsMap types.WhitelistedValsMap, pvs []types.PreferredValidator,
+) error {
+	if len(pvs) == 0 {
+		return nil
+	}
+	if !params.MaxPreferredWeightShift.IsPositive() {
+		return types.ErrPreferredStakingDisabled
+	}
+	for _, pv := range pvs {
+		valAddr, err := sdk.ValAddressFromBech32(pv.ValidatorAddress)
+		if err != nil {
+			return errorsmod.Wrapf(types.ErrInvalidPreferredValidator, "invalid validator address %q: %v", pv.ValidatorAddress, err)
+		}
+		if pv.Weight.IsNil() || !pv.Weight.IsPositive() {
+			return errorsmod.Wrapf(types.ErrInvalidPreferredValidator, "weight of %s must be positive", pv.ValidatorAddress)
+		}
+		lv, found := k.GetLiquidValidator(ctx, valAddr)
+		if !found || !k.IsActiveLiquidValidator(ctx, lv, whitelistedValsMap) {
+			return errorsmod.Wrapf(types.ErrInvalidPreferredValidator, "validator %s is not an active whitelisted validator", pv.ValidatorAddress)
+		}
+	}
+	return nil
+}
+
+// AddPreferredStakes divides the bToken amount by the weights of the preferred validators and adds it to the
+// preferred stakes of the delegator.
+func (k Keeper) AddPreferredStakes(ctx sdk.Context, delegator sdk.AccAddress, pvs []types.PreferredValidator, bTokenAmount math.Int) {
+	amounts := types.DivideByPreferredWeight(pvs, bTokenAmount)
+	for i, pv := range pvs {
+		if !amounts[i].IsPositive() {
+			continue
+		}
+		valAddr, err := sdk.ValAddressFromBech32(pv.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+		stake, found := k.GetPreferredStake(ctx, delegator, valAddr)
+		if !found {
+			stake = types.NewPreferredStake(delegator, valAddr, sdk.ZeroInt())
+		}
+		stake.Amount = stake.Amount.Add(amounts[i])
+		k.SetPreferredStake(ctx, stake)
+
+		ctx.EventManager().EmitEvents(sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeAddPreferredStake,
+				sdk.NewAttribute(types.AttributeKeyDelegator, stake.DelegatorAddress),
+				sdk.NewAttribute(types.AttributeKeyValidator, stake.ValidatorAddress),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, amounts[i].String()),
+			),
+		})
+	}
+}
+
+// CapPreferredStakes scales down the preferred stakes of the delegator proportionally when they exceed its bToken
+// balance, so that the bTokens burned by liquid unstaking or sent away stop shifting the target weights.
+func (k Keeper) CapPreferredStakes(ctx sdk.Context, delegator sdk.AccAddress) {
+	stakes := k.GetPreferredStakes(ctx, delegator)
+	total := sdk.ZeroInt()
+	for _, stake := range stakes {
+		total = total.Add(stake.Amount)
+	}
+	balance := k.bankKeeper.GetBalance(ctx, delegator, k.LiquidBondDenom(ctx)).Amount
+	if total.LTE(balance) {
+		return
+	}
+	for _, stake := range stakes {
+		cappedAmount := stake.Amount.Mul(balance).Quo(total)
+		reducedAmount := stake.Amount.Sub(cappedAmount)
+		stake.Amount = cappedAmount
+		k.SetPreferredStake(ctx, stake)
+
+		ctx.EventManager().EmitEvents(sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeReducePreferredStake,
+				sdk.NewAttribute(types.AttributeKeyDelegator, stake.DelegatorAddress),
+				sdk.NewAttribute(types.AttributeKeyValidator, stake.ValidatorAddress),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, reducedAmount.String()),
+			),
+		})
+	}
+}
+
+// AfterCoinsSent caps the preferred stakes of the sender when the sent coins include bTokens, so the bTokens
+// transferred to another account stop shifting the target weights and can't be preferred twice.
+func (k Keeper) AfterCoinsSent(ctx sdk.Context, sender sdk.AccAddress, amt sdk.Coins) {
+	if amt.AmountOf(k.LiquidBondDenom(ctx)).IsPositive() {
+		k.CapPreferredStakes(ctx, sender)
+	}
+}
+
+// GetEffectiveWhitelistedValsMap returns the whitelisted validators with the target weights of the active liquid
+// validators shifted by their preferred stakes. The share of the bToken supply preferred for a validator targets it
+// instead of being divided by the target weights, and the resulting target is bounded to the MaxPreferredWeightShift
+// ratio around the target of its weight. The total preferred stake is bounded to the bToken supply. The whitelisted
+// validators are returned as is if no stake is preferred.
+func (k Keeper) GetEffectiveWhitelistedValsMap(ctx sdk.Context, params types.Params) types.WhitelistedValsMap {
+	whitelistedValsMap := params.WhitelistedValsMap()
+	if !params.MaxPreferredWeightShift.IsPositive() {
+		return whitelistedValsMap
+	}
+	activeVals := k.GetActiveLiquidValidators(ctx, whitelistedValsMap)
+	totalWeight := activeVals.TotalWeight(whitelistedValsMap)
+	bTokenTotalSupply := k.bankKeeper.GetSupply(ctx, params.LiquidBondDenom).Amount
+	if !totalWeight.IsPositive() || !bTokenTotalSupply.IsPositive() {
+		return whitelistedValsMap
+	}
+
+	preferredStakes, totalPreferredStake := k.getActivePreferredStakes(ctx, activeVals, bTokenTotalSupply)
+	if totalPreferredStake.IsZero() {
+		return whitelistedValsMap
+	}
+
+	// the effective weights are scaled by the bToken supply times the total weight to keep the precision
+	lowerBound := sdk.OneDec().Sub(params.MaxPreferredWeightShift)
+	upperBound := sdk.OneDec().Add(params.MaxPreferredWeightShift)
+	effectiveValsMap := make(types.WhitelistedValsMap, len(whitelistedValsMap))
+	for addr, wv := range whitelistedValsMap {
+		effectiveValsMap[addr] = wv
+	}
+	for i, val := range activeVals {
+		wv := whitelistedValsMap[val.OperatorAddress]
+		target := sdk.NewDecFromInt(bTokenTotalSupply.Mul(wv.TargetWeight))
+		shifted := target.Sub(sdk.NewDecFromInt(totalPreferredStake.Mul(wv.TargetWeight))).
+			Add(sdk.NewDecFromInt(preferredStakes[i].Mul(totalWeight)))
+		shifted = sdk.MaxDec(shifted, target.Mul(lowerBound))
+		shifted = sdk.MinDec(shifted, target.Mul(upperBound))
+		wv.TargetWeight = shifted.TruncateInt()
+		// an active liquid validator keeps a positive weight
+		if !wv.TargetWeight.IsPositive() {
+			wv.TargetWeight = sdk.OneInt()
+		}
+		effectiveValsMap[val.OperatorAddress] = wv
+	}
+	return effectiveValsMap
+}
+
+// getActivePreferredStakes returns the total preferred stakes of the active liquid validators and their total, scaled
+// down proportionally if the total exceeds the bToken supply.
+func (k Keeper) getActivePreferredStakes(
+	ctx sdk.Context, activeVals types.ActiveLiquidValidators, bTokenTotalSupply math.Int,
+) ([]math.Int, math.Int) {
+	preferredStakes := make([]math.Int, len(activeVals))
+	totalPreferredStake := sdk.ZeroInt()
+	for i, val := range activeVals {
+		preferredStakes[i] = k.GetValidatorPreferredStake(ctx, val.GetOperator())
+		totalPreferredStake = totalPreferredStake.Add(preferredStakes[i])
+	}
+	if totalPreferredStake.LTE(bTokenTotalSupply) {
+		return preferredStakes, totalPreferredStake
+	}
+
+	scaledTotal := sdk.ZeroInt()
+	for i := range preferredStakes {
+		preferredStakes[i] = preferredStakes[i].Mul(bTokenTotalSupply).Quo(totalPreferredStake)
+		scaledTotal = scaledTotal.Add(preferredStakes[i])
+	}
+	return preferredStakes, scaledTotal
+}
+
+// GetEffectiveWeights returns the target weights of the active liquid validators with their preferred stakes and the
+// ratios of the active liquid tokens they target before and after the preferred stakes shift.
+func (k Keeper) GetEffectiveWeights(ctx sdk.Context) []types.EffectiveWeight {
+	params := k.GetParams(ctx)
+	whitelistedValsMap := params.WhitelistedValsMap()
+	effectiveValsMap := k.GetEffectiveWhitelistedValsMap(ctx, params)
+	activeVals := k.GetActiveLiquidValidators(ctx, whitelistedValsMap)
+	totalWeight := activeVals.TotalWeight(whitelistedValsMap)
+	totalEffectiveWeight := activeVals.TotalWeight(effectiveValsMap)
+
+	weights := []types.EffectiveWeight{}
+	if !totalWeight.IsPositive() || !totalEffectiveWeight.IsPositive() {
+		return weights
+	}
+	preferredStakes, _ := k.getActivePreferredStakes(ctx, activeVals, k.bankKeeper.GetSupply(ctx, params.LiquidBondDenom).Amount)
+	for i, val := range activeVals {
+		weight := val.GetWeight(whitelistedValsMap, true)
+		weights = append(weights, types.EffectiveWeight{
+			ValidatorAddress: val.OperatorAddress,
+			TargetWeight:     weight,
+			PreferredStake:   preferredStakes[i],
+			TargetRatio:      sdk.NewDecFromInt(weight).QuoInt(totalWeight),
+			EffectiveRatio:   sdk.NewDecFromInt(val.GetWeight(effectiveValsMap, true)).QuoInt(totalEffectiveWeight),
+		})
+	}
+	return weights
+}
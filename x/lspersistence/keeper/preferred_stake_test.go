@@ -0,0 +1,209 @@
+package keeper_test
+
+import (
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/persistenceOne/pstake-native/v2/x/lspersistence/types"
+)
+
+func (s *KeeperTestSuite) TestPreferredLiquidStake() {
+	_, valOpers, _ := s.CreateValidators([]int64{1000000, 1000000, 1000000})
+	params := s.keeper.GetParams(s.ctx)
+	params.WhitelistedValidators = []types.WhitelistedValidator{
+		{ValidatorAddress: valOpers[0].String(), TargetWeight: sdk.NewInt(10)},
+		{ValidatorAddress: valOpers[1].String(), TargetWeight: sdk.NewInt(10)},
+	}
+	s.keeper.SetParams(s.ctx, params)
+	s.keeper.UpdateLiquidValidatorSet(s.ctx)
+	s.Require().NoError(s.liquidStaking(s.delAddrs[0], sdk.NewInt(100000000)))
+	stakingCoin := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100000000))
+	preferVal0 := []types.PreferredValidator{{ValidatorAddress: valOpers[0].String(), Weight: sdk.NewInt(1)}}
+
+	// preferred liquid staking is disabled by default
+	_, _, err := s.keeper.LiquidStake(s.ctx, types.LiquidStakingProxyAcc, s.delAddrs[1], stakingCoin, preferVal0)
+	s.Require().ErrorIs(err, types.ErrPreferredStakingDisabled)
+
+	params.MaxPreferredWeightShift = sdk.NewDecWithPrec(1, 1)
+	s.keeper.SetParams(s.ctx, params)
+
+	// only the active liquid validators can be preferred
+	_, _, err = s.keeper.LiquidStake(s.ctx, types.LiquidStakingProxyAcc, s.delAddrs[1], stakingCoin,
+		[]types.PreferredValidator{{ValidatorAddress: valOpers[2].String(), Weight: sdk.NewInt(1)}})
+	s.Require().ErrorIs(err, types.ErrInvalidPreferredValidator)
+
+	// without preferred stakes the effective weights are the target weights
+	weights := s.keeper.GetEffectiveWeights(s.ctx)
+	s.Require().Len(weights, 2)
+	for _, w := range weights {
+		s.Require().Equal(sdk.NewDecWithPrec(5, 1), w.TargetRatio)
+		s.Require().Equal(sdk.NewDecWithPrec(5, 1), w.EffectiveRatio)
+		s.Require().True(w.PreferredStake.IsZero())
+	}
+
+	s.ctx = s.ctx.WithEventManager(sdk.NewEventManager())
+	_, bTokenMintAmount, err := s.keeper.LiquidStake(s.ctx, types.LiquidStakingProxyAcc, s.delAddrs[1], stakingCoin, preferVal0)
+	s.Require().NoError(err)
+	s.Require().True(s.hasEvent(types.EventTypeAddPreferredStake))
+	s.Require().Equal([]types.PreferredStake{types.NewPreferredStake(s.delAddrs[1], valOpers[0], bTokenMintAmount)},
+		s.keeper.GetPreferredStakes(s.ctx, s.delAddrs[1]))
+	s.Require().Equal(bTokenMintAmount, s.keeper.GetValidatorPreferredStake(s.ctx, valOpers[0]))
+	s.Require().True(s.keeper.GetValidatorPreferredStake(s.ctx, valOpers[1]).IsZero())
+
+	// half of the bToken supply prefers val0, the shift is bounded to 10% around the target weights
+	effectiveRatios := map[string]sdk.Dec{
+		valOpers[0].String(): sdk.NewDecWithPrec(55, 2),
+		valOpers[1].String(): sdk.NewDecWithPrec(45, 2),
+	}
+	res, err := s.querier.EffectiveWeights(sdk.WrapSDKContext(s.ctx), &types.QueryEffectiveWeightsRequest{})
+	s.Require().NoError(err)
+	s.Require().Len(res.EffectiveWeights, 2)
+	for _, w := range res.EffectiveWeights {
+		s.Require().Equal(sdk.NewInt(10), w.TargetWeight)
+		s.Require().Equal(sdk.NewDecWithPrec(5, 1), w.TargetRatio)
+		s.Require().Equal(effectiveRatios[w.ValidatorAddress], w.EffectiveRatio)
+	}
+
+	// the staking amount is delegated by the effective weights
+	for _, state := range s.keeper.GetAllLiquidValidatorStates(s.ctx) {
+		switch state.OperatorAddress {
+		case valOpers[0].String():
+			s.Require().Equal(sdk.NewInt(105000000), state.LiquidTokens)
+		case valOpers[1].String():
+			s.Require().Equal(sdk.NewInt(95000000), state.LiquidTokens)
+		}
+	}
+
+	// the rebalancing targets the effective weights of the whole liquid tokens
+	reds := s.keeper.UpdateLiquidValidatorSet(s.ctx)
+	s.Require().Len(reds, 1)
+	s.Require().Equal(valOpers[1].String(), reds[0].SrcValidator.OperatorAddress)
+	s.Require().Equal(valOpers[0].String(), reds[0].DstValidator.OperatorAddress)
+	s.Require().Equal(sdk.NewInt(5000000), reds[0].Amount)
+
+	// the preferred stakes are capped to the remaining bToken balance on unstaking
+	s.ctx = s.ctx.WithEventManager(sdk.NewEventManager())
+	unstakingBtoken := sdk.NewCoin(params.LiquidBondDenom, bTokenMintAmount.QuoRaw(2))
+	_, _, _, _, err = s.keeper.LiquidUnstake(s.ctx, types.LiquidStakingProxyAcc, s.delAddrs[1], unstakingBtoken)
+	s.Require().NoError(err)
+	s.Require().True(s.hasEvent(types.EventTypeReducePreferredStake))
+	balance := s.app.BankKeeper.GetBalance(s.ctx, s.delAddrs[1], params.LiquidBondDenom).Amount
+	s.Require().Equal(balance, s.keeper.GetValidatorPreferredStake(s.ctx, valOpers[0]))
+
+	queryRes, err := s.querier.PreferredStakes(sdk.WrapSDKContext(s.ctx), &types.QueryPreferredStakesRequest{
+		DelegatorAddress: s.delAddrs[1].String(),
+	})
+	s.Require().NoError(err)
+	s.Require().Equal([]types.PreferredStake{types.NewPreferredStake(s.delAddrs[1], valOpers[0], balance)}, queryRes.PreferredStakes)
+	_, err = s.querier.PreferredStakes(sdk.WrapSDKContext(s.ctx), &types.QueryPreferredStakesRequest{DelegatorAddress: "invalid"})
+	s.Require().Error(err)
+	s.Require().Equal(s.keeper.GetAllPreferredStakes(s.ctx), s.keeper.ExportGenesis(s.ctx).PreferredStakes)
+
+	// all the preferred stakes are removed when the whole balance is unstaked
+	_, _, _, _, err = s.keeper.LiquidUnstake(s.ctx, types.LiquidStakingProxyAcc, s.delAddrs[1], sdk.NewCoin(params.LiquidBondDenom, balance))
+	s.Require().NoError(err)
+	s.Require().Empty(s.keeper.GetPreferredStakes(s.ctx, s.delAddrs[1]))
+	s.Require().True(s.keeper.GetValidatorPreferredStake(s.ctx, valOpers[0]).IsZero())
+}
+
+func (s *KeeperTestSuite) TestPreferredStakeTransferredBTokens() {
+	_, valOpers, _ := s.CreateValidators([]int64{1000000, 1000000})
+	params := s.keeper.GetParams(s.ctx)
+	params.WhitelistedValidators = []types.WhitelistedValidator{
+		{ValidatorAddress: valOpers[0].String(), TargetWeight: sdk.NewInt(10)},
+		{ValidatorAddress: valOpers[1].String(), TargetWeight: sdk.NewInt(10)},
+	}
+	params.MaxPreferredWeightShift = sdk.NewDecWithPrec(1, 1)
+	s.keeper.SetParams(s.ctx, params)
+	s.keeper.UpdateLiquidValidatorSet(s.ctx)
+	s.Require().NoError(s.liquidStaking(s.delAddrs[0], sdk.NewInt(100000000)))
+	stakingCoin := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100000000))
+	preferVal0 := []types.PreferredValidator{{ValidatorAddress: valOpers[0].String(), Weight: sdk.NewInt(1)}}
+
+	_, bTokenMintAmount, err := s.keeper.LiquidStake(s.ctx, types.LiquidStakingProxyAcc, s.delAddrs[1], stakingCoin, preferVal0)
+	s.Require().NoError(err)
+
+	requireEffectiveWeights := func(preferredStake sdk.Int, val0Ratio, val1Ratio sdk.Dec) {
+		weights := s.keeper.GetEffectiveWeights(s.ctx)
+		s.Require().Len(weights, 2)
+		for _, w := range weights {
+			switch w.ValidatorAddress {
+			case valOpers[0].String():
+				s.Require().Equal(preferredStake, w.PreferredStake)
+				s.Require().Equal(val0Ratio, w.EffectiveRatio)
+			case valOpers[1].String():
+				s.Require().True(w.PreferredStake.IsZero())
+				s.Require().Equal(val1Ratio, w.EffectiveRatio)
+			}
+		}
+	}
+	requireEffectiveWeights(bTokenMintAmount, sdk.NewDecWithPrec(55, 2), sdk.NewDecWithPrec(45, 2))
+
+	// the bank messages of the app cap the preferred stakes of the sender
+	handleMsg := func(msg sdk.Msg) *sdk.Result {
+		res, err := s.app.MsgServiceRouter().Handler(msg)(s.ctx, msg)
+		s.Require().NoError(err)
+		return res
+	}
+
+	// a quarter of the bTokens is sent away
+	quarterBTokens := sdk.NewCoins(sdk.NewCoin(params.LiquidBondDenom, bTokenMintAmount.QuoRaw(4)))
+	res := handleMsg(banktypes.NewMsgSend(s.delAddrs[1], s.delAddrs[2], quarterBTokens))
+	s.Require().Contains(res.Events, abci.Event(sdk.NewEvent(types.EventTypeReducePreferredStake,
+		sdk.NewAttribute(types.AttributeKeyDelegator, s.delAddrs[1].String()),
+		sdk.NewAttribute(types.AttributeKeyValidator, valOpers[0].String()),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, bTokenMintAmount.QuoRaw(4).String()),
+	)))
+	s.Require().Equal(bTokenMintAmount.QuoRaw(4).MulRaw(3), s.keeper.GetValidatorPreferredStake(s.ctx, valOpers[0]))
+
+	// the rest of the bTokens is sent away, the preferred stakes are removed and not restored by receiving bTokens
+	restBTokens := sdk.NewCoins(sdk.NewCoin(params.LiquidBondDenom, bTokenMintAmount.Sub(bTokenMintAmount.QuoRaw(4))))
+	handleMsg(banktypes.NewMsgMultiSend(
+		[]banktypes.Input{banktypes.NewInput(s.delAddrs[1], restBTokens)},
+		[]banktypes.Output{banktypes.NewOutput(s.delAddrs[2], restBTokens)},
+	))
+	s.Require().Empty(s.keeper.GetPreferredStakes(s.ctx, s.delAddrs[1]))
+	s.Require().True(s.keeper.GetValidatorPreferredStake(s.ctx, valOpers[0]).IsZero())
+	requireEffectiveWeights(sdk.ZeroInt(), sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(5, 1))
+	handleMsg(banktypes.NewMsgSend(s.delAddrs[2], s.delAddrs[1], quarterBTokens))
+	s.Require().True(s.keeper.GetValidatorPreferredStake(s.ctx, valOpers[0]).IsZero())
+
+	// the bTokens received by a delegator don't count for its own preferred stakes
+	_, bTokenMintAmount2, err := s.keeper.LiquidStake(s.ctx, types.LiquidStakingProxyAcc, s.delAddrs[2], stakingCoin, preferVal0)
+	s.Require().NoError(err)
+	handleMsg(banktypes.NewMsgSend(s.delAddrs[2], s.delAddrs[3], restBTokens))
+	s.Require().Equal(bTokenMintAmount2, s.keeper.GetValidatorPreferredStake(s.ctx, valOpers[0]))
+}
+
+func (s *KeeperTestSuite) TestEffectiveWeightsReadsPerValidator() {
+	_, valOpers, _ := s.CreateValidators([]int64{1000000, 1000000})
+	params := s.keeper.GetParams(s.ctx)
+	params.WhitelistedValidators = []types.WhitelistedValidator{
+		{ValidatorAddress: valOpers[0].String(), TargetWeight: sdk.NewInt(10)},
+		{ValidatorAddress: valOpers[1].String(), TargetWeight: sdk.NewInt(10)},
+	}
+	params.MaxPreferredWeightShift = sdk.NewDecWithPrec(1, 1)
+	s.keeper.SetParams(s.ctx, params)
+	s.keeper.UpdateLiquidValidatorSet(s.ctx)
+	s.Require().NoError(s.liquidStaking(s.delAddrs[0], sdk.NewInt(1000000000)))
+	stakingCoin := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1000000))
+	preferVal0 := []types.PreferredValidator{{ValidatorAddress: valOpers[0].String(), Weight: sdk.NewInt(1)}}
+
+	effectiveWeightsGas := func() sdk.Gas {
+		ctx := s.ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+		s.keeper.GetEffectiveWhitelistedValsMap(ctx, s.keeper.GetParams(s.ctx))
+		return ctx.GasMeter().GasConsumed()
+	}
+	_, _, err := s.keeper.LiquidStake(s.ctx, types.LiquidStakingProxyAcc, s.delAddrs[1], stakingCoin, preferVal0)
+	s.Require().NoError(err)
+	gas := effectiveWeightsGas()
+
+	// more preferred stakers don't add store reads, only the total preferred stakes of the validators are read
+	for _, delAddr := range s.delAddrs[2:] {
+		_, _, err := s.keeper.LiquidStake(s.ctx, types.LiquidStakingProxyAcc, delAddr, stakingCoin, preferVal0)
+		s.Require().NoError(err)
+	}
+	s.Require().Less(effectiveWeightsGas()-gas, storetypes.KVGasConfig().ReadCostFlat)
+}
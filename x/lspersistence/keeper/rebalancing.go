@@ -40,16 +40,19 @@ func (k Keeper) TryRedelegation(ctx sdk.Context, re types.Redelegation) (complet
 		shares = re.SrcValidator.GetDelShares(ctx, k.stakingKeeper)
 	}
 	cachedCtx, writeCache := ctx.CacheContext()
+	balance := k.GetProxyAccBalance(cachedCtx, re.Delegator)
 	completionTime, err = k.stakingKeeper.BeginRedelegation(cachedCtx, re.Delegator, srcVal, dstVal, shares)
 	if err != nil {
 		return time.Time{}, err
 	}
+	k.trackAutoWithdrawnRewards(cachedCtx, re.Delegator, balance, sdk.ZeroInt())
 	writeCache()
 	return completionTime, nil
 }
 
 // Rebalance argument liquidVals containing ValidatorStatusActive which is containing just added on whitelist(liquidToken 0) and ValidatorStatusInactive to delist
-func (k Keeper) Rebalance(ctx sdk.Context, proxyAcc sdk.AccAddress, liquidVals types.LiquidValidators, whitelistedValsMap types.WhitelistedValsMap, rebalancingTrigger sdk.Dec) (redelegations []types.Redelegation) {
+// At most maxRedelegations redelegations are attempted, the remaining gaps are rebalanced on the next call.
+func (k Keeper) Rebalance(ctx sdk.Context, proxyAcc sdk.AccAddress, liquidVals types.LiquidValidators, whitelistedValsMap types.WhitelistedValsMap, rebalancingTrigger sdk.Dec, maxRedelegations uint32) (redelegations []types.Redelegation) {
 	logger := k.Logger(ctx)
 	totalLiquidTokens, liquidTokenMap := liquidVals.TotalLiquidTokens(ctx, k.stakingKeeper, false)
 	if !totalLiquidTokens.IsPositive() {
@@ -85,7 +88,7 @@ func (k Keeper) Rebalance(ctx sdk.Context, proxyAcc sdk.AccAddress, liquidVals t
 	failCount := 0
 	rebalancingThresholdAmt := rebalancingTrigger.Mul(sdk.NewDecFromInt(totalLiquidTokens)).TruncateInt()
 
-	for i := 0; i < liquidVals.Len(); i++ {
+	for i := 0; i < liquidVals.Len() && len(redelegations) < int(maxRedelegations); i++ {
 		// get min, max of liquid token gap
 		minVal, maxVal, amountNeeded, last := liquidVals.MinMaxGap(targetMap, liquidTokenMap)
 		if amountNeeded.IsZero() || (i == 0 && !amountNeeded.GT(rebalancingThresholdAmt)) {
@@ -131,24 +134,42 @@ func (k Keeper) Rebalance(ctx sdk.Context, proxyAcc sdk.AccAddress, liquidVals t
 	return redelegations
 }
 
-// WithdrawRewardsAndReStake withdraw rewards and re-staking when over threshold
+// WithdrawRewardsAndReStake withdraw rewards and re-staking when over threshold, the instant unstake reserve is
+// refilled from the rewards and the matured unbondings of proxy account before re-staking.
 func (k Keeper) WithdrawRewardsAndReStake(ctx sdk.Context, whitelistedValsMap types.WhitelistedValsMap) {
 	totalRemainingRewards, _, totalLiquidTokens := k.CheckDelegationStates(ctx, types.LiquidStakingProxyAcc)
 
-	// checking over types.RewardTrigger and execute GetRewards
+	// checking over params.RewardTrigger and execute GetRewards, the balance kept as the reserve is not re-staked
 	proxyAccBalance := k.GetProxyAccBalance(ctx, types.LiquidStakingProxyAcc)
-	rewardsThreshold := types.RewardTrigger.Mul(sdk.NewDecFromInt(totalLiquidTokens))
+	reserveTarget := k.GetInstantUnstakeReserveTarget(ctx)
+	rewardsThreshold := k.GetParams(ctx).RewardTrigger.Mul(sdk.NewDecFromInt(totalLiquidTokens))
 
 	// skip If it doesn't exceed the rewards threshold
-	if !sdk.NewDecFromInt(proxyAccBalance.Amount).Add(totalRemainingRewards).GT(rewardsThreshold) {
+	restakableBalance := sdk.MaxInt(proxyAccBalance.Amount.Sub(reserveTarget), sdk.ZeroInt())
+	if !sdk.NewDecFromInt(restakableBalance).Add(totalRemainingRewards).GT(rewardsThreshold) {
 		return
 	}
 
-	// Withdraw rewards of LiquidStakingProxyAcc and re-staking
-	k.WithdrawLiquidRewards(ctx, types.LiquidStakingProxyAcc)
+	// Withdraw rewards of LiquidStakingProxyAcc and re-staking, the rewards auto withdrawn on the delegation changes
+	// of the proxy account since the last re-staking are charged with the reward fee as well
+	rewards := k.WithdrawLiquidRewards(ctx, types.LiquidStakingProxyAcc).
+		Add(k.GetAutoWithdrawnRewards(ctx).AmountOf(proxyAccBalance.Denom))
+	k.ClearAutoWithdrawnRewards(ctx)
 
-	// re-staking with proxyAccBalance, due to auto-withdraw on add staking by f1
+	// the reward fee is sent before re-staking, the rewards are re-staked entirely if it fails
+	cachedCtx, writeCache := ctx.CacheContext()
+	if _, err := k.ChargeRewardFee(cachedCtx, rewards); err != nil {
+		k.Logger(ctx).Error("charging reward fee failed", "error", err)
+	} else {
+		writeCache()
+	}
+
+	// re-staking with proxyAccBalance over the reserve, due to auto-withdraw on add staking by f1
 	proxyAccBalance = k.GetProxyAccBalance(ctx, types.LiquidStakingProxyAcc)
+	reStakeAmount := proxyAccBalance.SubAmount(sdk.MinInt(proxyAccBalance.Amount, reserveTarget))
+	if !reStakeAmount.IsPositive() {
+		return
+	}
 
 	// skip when no active liquid validator
 	activeVals := k.GetActiveLiquidValidators(ctx, whitelistedValsMap)
@@ -157,8 +178,8 @@ func (k Keeper) WithdrawRewardsAndReStake(ctx sdk.Context, whitelistedValsMap ty
 	}
 
 	// re-staking
-	cachedCtx, writeCache := ctx.CacheContext()
-	_, err := k.LiquidDelegate(cachedCtx, types.LiquidStakingProxyAcc, activeVals, proxyAccBalance.Amount, whitelistedValsMap)
+	cachedCtx, writeCache = ctx.CacheContext()
+	_, err := k.LiquidDelegate(cachedCtx, types.LiquidStakingProxyAcc, activeVals, reStakeAmount.Amount, whitelistedValsMap)
 	if err != nil {
 		logger := k.Logger(ctx)
 		logger.Error("re-staking failed", "error", err)
@@ -170,86 +191,138 @@ func (k Keeper) WithdrawRewardsAndReStake(ctx sdk.Context, whitelistedValsMap ty
 		sdk.NewEvent(
 			types.EventTypeReStake,
 			sdk.NewAttribute(types.AttributeKeyDelegator, types.LiquidStakingProxyAcc.String()),
-			sdk.NewAttribute(sdk.AttributeKeyAmount, proxyAccBalance.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, reStakeAmount.String()),
 		),
 	})
 	logger.Info(types.EventTypeReStake,
 		types.AttributeKeyDelegator, types.LiquidStakingProxyAcc.String(),
-		sdk.AttributeKeyAmount, proxyAccBalance.String())
+		sdk.AttributeKeyAmount, reStakeAmount.String())
 }
 
 func (k Keeper) UpdateLiquidValidatorSet(ctx sdk.Context) []types.Redelegation {
 	logger := k.Logger(ctx)
 	params := k.GetParams(ctx)
-	liquidValidators := k.GetAllLiquidValidators(ctx)
-	liquidValsMap := liquidValidators.Map()
 	whitelistedValsMap := types.GetWhitelistedValsMap(params.WhitelistedValidators)
 
 	// Set Liquid validators for added whitelist validators
-	for _, wv := range params.WhitelistedValidators {
-		if _, ok := liquidValsMap[wv.ValidatorAddress]; !ok {
-			lv := types.LiquidValidator{
-				OperatorAddress: wv.ValidatorAddress,
-			}
-			if k.IsActiveLiquidValidator(ctx, lv, whitelistedValsMap) {
-				k.SetLiquidValidator(ctx, lv)
-				liquidValidators = append(liquidValidators, lv)
-				ctx.EventManager().EmitEvents(sdk.Events{
-					sdk.NewEvent(
-						types.EventTypeAddLiquidValidator,
-						sdk.NewAttribute(types.AttributeKeyLiquidValidator, lv.OperatorAddress),
-					),
-				})
-				logger.Info(types.EventTypeAddLiquidValidator, types.AttributeKeyLiquidValidator, lv.OperatorAddress)
-			}
-		}
-	}
+	liquidValidators := k.setWhitelistedLiquidValidators(ctx, params.WhitelistedValidators, whitelistedValsMap)
 
 	// rebalancing based updated liquid validators status with threshold, try by cachedCtx
 	// tombstone status also handled on Rebalance
-	reds := k.Rebalance(ctx, types.LiquidStakingProxyAcc, liquidValidators, whitelistedValsMap, types.RebalancingTrigger)
+	// the redelegations and unbondings are skipped while the rebalancing is paused
+	// the target weights of the active liquid validators are shifted by the preferred stakes
+	switches := k.GetPauseSwitches(ctx)
+	effectiveValsMap := k.GetEffectiveWhitelistedValsMap(ctx, params)
+	var reds []types.Redelegation
+	if !switches.RebalancingPaused {
+		reds = k.Rebalance(ctx, types.LiquidStakingProxyAcc, liquidValidators, effectiveValsMap, params.RebalancingTrigger, params.MaxRedelegationsPerBlock)
 
-	// unbond all delShares to proxyAcc if delShares exist on inactive liquid validators
-	for _, lv := range liquidValidators {
-		if !k.IsActiveLiquidValidator(ctx, lv, whitelistedValsMap) {
-			delShares := lv.GetDelShares(ctx, k.stakingKeeper)
-			if delShares.IsPositive() {
-				cachedCtx, writeCache := ctx.CacheContext()
-				completionTime, returnAmount, _, err := k.LiquidUnbond(cachedCtx, types.LiquidStakingProxyAcc, types.LiquidStakingProxyAcc, lv.GetOperator(), delShares, false)
-				if err != nil {
-					logger.Error("liquid unbonding of inactive liquid validator failed", "error", err)
-					continue
-				}
-				writeCache()
-				unbondingAmount := sdk.Coin{Denom: k.stakingKeeper.BondDenom(ctx), Amount: returnAmount}.String()
-				ctx.EventManager().EmitEvents(sdk.Events{
-					sdk.NewEvent(
-						types.EventTypeUnbondInactiveLiquidTokens,
-						sdk.NewAttribute(types.AttributeKeyLiquidValidator, lv.OperatorAddress),
-						sdk.NewAttribute(types.AttributeKeyUnbondingAmount, unbondingAmount),
-						sdk.NewAttribute(types.AttributeKeyCompletionTime, completionTime.Format(time.RFC3339)),
-					),
-				})
-				logger.Info(types.EventTypeUnbondInactiveLiquidTokens,
-					types.AttributeKeyLiquidValidator, lv.OperatorAddress,
-					types.AttributeKeyUnbondingAmount, unbondingAmount,
-					types.AttributeKeyCompletionTime, completionTime.Format(time.RFC3339))
-			}
-			_, found := k.stakingKeeper.GetDelegation(ctx, types.LiquidStakingProxyAcc, lv.GetOperator())
-			if !found {
-				k.RemoveLiquidValidator(ctx, lv)
-				ctx.EventManager().EmitEvents(sdk.Events{
-					sdk.NewEvent(
-						types.EventTypeRemoveLiquidValidator,
-						sdk.NewAttribute(types.AttributeKeyLiquidValidator, lv.OperatorAddress),
-					),
-				})
-				logger.Info(types.EventTypeRemoveLiquidValidator, types.AttributeKeyLiquidValidator, lv.OperatorAddress)
+		// unbond all delShares to proxyAcc if delShares exist on inactive liquid validators
+		for _, lv := range k.getInactiveLiquidValidatorsToUnbond(ctx, liquidValidators, whitelistedValsMap, reds, params.MaxRedelegationsPerBlock) {
+			cachedCtx, writeCache := ctx.CacheContext()
+			completionTime, returnAmount, _, err := k.LiquidUnbond(cachedCtx, types.LiquidStakingProxyAcc, types.LiquidStakingProxyAcc, lv.GetOperator(), lv.GetDelShares(ctx, k.stakingKeeper), false)
+			if err != nil {
+				logger.Error("liquid unbonding of inactive liquid validator failed", "error", err)
+				continue
 			}
+			writeCache()
+			unbondingAmount := sdk.Coin{Denom: k.stakingKeeper.BondDenom(ctx), Amount: returnAmount}.String()
+			ctx.EventManager().EmitEvents(sdk.Events{
+				sdk.NewEvent(
+					types.EventTypeUnbondInactiveLiquidTokens,
+					sdk.NewAttribute(types.AttributeKeyLiquidValidator, lv.OperatorAddress),
+					sdk.NewAttribute(types.AttributeKeyUnbondingAmount, unbondingAmount),
+					sdk.NewAttribute(types.AttributeKeyCompletionTime, completionTime.Format(time.RFC3339)),
+				),
+			})
+			logger.Info(types.EventTypeUnbondInactiveLiquidTokens,
+				types.AttributeKeyLiquidValidator, lv.OperatorAddress,
+				types.AttributeKeyUnbondingAmount, unbondingAmount,
+				types.AttributeKeyCompletionTime, completionTime.Format(time.RFC3339))
+		}
+	}
+
+	// remove the inactive liquid validators without delegation left
+	for _, lv := range liquidValidators {
+		if k.IsActiveLiquidValidator(ctx, lv, whitelistedValsMap) {
+			continue
+		}
+		if _, found := k.stakingKeeper.GetDelegation(ctx, types.LiquidStakingProxyAcc, lv.GetOperator()); !found {
+			k.RemoveLiquidValidator(ctx, lv)
+			ctx.EventManager().EmitEvents(sdk.Events{
+				sdk.NewEvent(
+					types.EventTypeRemoveLiquidValidator,
+					sdk.NewAttribute(types.AttributeKeyLiquidValidator, lv.OperatorAddress),
+				),
+			})
+			logger.Info(types.EventTypeRemoveLiquidValidator, types.AttributeKeyLiquidValidator, lv.OperatorAddress)
 		}
 	}
 
-	// withdraw rewards and re-staking when over threshold
-	k.WithdrawRewardsAndReStake(ctx, whitelistedValsMap)
+	// withdraw rewards and re-staking when over threshold, unless paused
+	if !switches.RewardRestakingPaused {
+		k.WithdrawRewardsAndReStake(ctx, effectiveValsMap)
+	}
 	return reds
 }
+
+// setWhitelistedLiquidValidators sets the liquid validators of the whitelisted validators meeting the active
+// conditions which are not liquid validators yet, and returns all the liquid validators.
+func (k Keeper) setWhitelistedLiquidValidators(
+	ctx sdk.Context, whitelist []types.WhitelistedValidator, whitelistedValsMap types.WhitelistedValsMap,
+) types.LiquidValidators {
+	liquidValidators := k.GetAllLiquidValidators(ctx)
+	liquidValsMap := liquidValidators.Map()
+	for _, wv := range whitelist {
+		if _, ok := liquidValsMap[wv.ValidatorAddress]; ok {
+			continue
+		}
+		lv := types.LiquidValidator{
+			OperatorAddress: wv.ValidatorAddress,
+		}
+		if k.IsActiveLiquidValidator(ctx, lv, whitelistedValsMap) {
+			k.SetLiquidValidator(ctx, lv)
+			liquidValidators = append(liquidValidators, lv)
+			ctx.EventManager().EmitEvents(sdk.Events{
+				sdk.NewEvent(
+					types.EventTypeAddLiquidValidator,
+					sdk.NewAttribute(types.AttributeKeyLiquidValidator, lv.OperatorAddress),
+				),
+			})
+			k.Logger(ctx).Info(types.EventTypeAddLiquidValidator, types.AttributeKeyLiquidValidator, lv.OperatorAddress)
+		}
+	}
+	return liquidValidators
+}
+
+// getInactiveLiquidValidatorsToUnbond returns the inactive liquid validators with delShares left after the
+// rebalancing redelegations. The inactive liquid validators the rebalancing redelegated from are not unbonded yet,
+// and none is unbonded once the rebalancing reached maxRedelegations, so their stake is redelegated in the next blocks.
+func (k Keeper) getInactiveLiquidValidatorsToUnbond(
+	ctx sdk.Context, liquidVals types.LiquidValidators, whitelistedValsMap types.WhitelistedValsMap,
+	reds []types.Redelegation, maxRedelegations uint32,
+) types.LiquidValidators {
+	if len(reds) >= int(maxRedelegations) {
+		return nil
+	}
+	redelegatingVals := map[string]struct{}{}
+	for _, red := range reds {
+		if red.Error == nil {
+			redelegatingVals[red.SrcValidator.OperatorAddress] = struct{}{}
+		}
+	}
+
+	var inactiveVals types.LiquidValidators
+	for _, lv := range liquidVals {
+		if k.IsActiveLiquidValidator(ctx, lv, whitelistedValsMap) {
+			continue
+		}
+		if _, redelegating := redelegatingVals[lv.OperatorAddress]; redelegating {
+			continue
+		}
+		if lv.GetDelShares(ctx, k.stakingKeeper).IsPositive() {
+			inactiveVals = append(inactiveVals, lv)
+		}
+	}
+	return inactiveVals
+}
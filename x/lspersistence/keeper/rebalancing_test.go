@@ -32,7 +32,7 @@ func (s *KeeperTestSuite) TestRebalancingCase1() {
 	reds := s.keeper.UpdateLiquidValidatorSet(s.ctx)
 	s.Require().Len(reds, 0)
 
-	newShares, bTokenMintAmt, err := s.keeper.LiquidStake(s.ctx, types.LiquidStakingProxyAcc, s.delAddrs[0], sdk.NewCoin(sdk.DefaultBondDenom, stakingAmt))
+	newShares, bTokenMintAmt, err := s.keeper.LiquidStake(s.ctx, types.LiquidStakingProxyAcc, s.delAddrs[0], sdk.NewCoin(sdk.DefaultBondDenom, stakingAmt), nil)
 	s.Require().NoError(err)
 	s.Require().Equal(newShares, sdk.NewDecFromInt(stakingAmt))
 	s.Require().Equal(bTokenMintAmt, stakingAmt)
@@ -303,7 +303,7 @@ func (s *KeeperTestSuite) TestRebalancingConsecutiveCase() {
 	reds := s.keeper.UpdateLiquidValidatorSet(s.ctx)
 	s.Require().Len(reds, 0)
 
-	newShares, bTokenMintAmt, err := s.keeper.LiquidStake(s.ctx, types.LiquidStakingProxyAcc, s.delAddrs[0], sdk.NewCoin(sdk.DefaultBondDenom, stakingAmt))
+	newShares, bTokenMintAmt, err := s.keeper.LiquidStake(s.ctx, types.LiquidStakingProxyAcc, s.delAddrs[0], sdk.NewCoin(sdk.DefaultBondDenom, stakingAmt), nil)
 	s.Require().NoError(err)
 	s.Require().Equal(newShares, sdk.NewDecFromInt(stakingAmt))
 	s.Require().Equal(bTokenMintAmt, stakingAmt)
@@ -447,7 +447,7 @@ func (s *KeeperTestSuite) TestRebalancingConsecutiveCase() {
 	s.printRedelegationsLiquidTokens()
 
 	// additional liquid staking when not rebalanced
-	_, _, err = s.keeper.LiquidStake(s.ctx, types.LiquidStakingProxyAcc, s.delAddrs[0], sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1000000000)))
+	_, _, err = s.keeper.LiquidStake(s.ctx, types.LiquidStakingProxyAcc, s.delAddrs[0], sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1000000000)), nil)
 	s.Require().NoError(err)
 	s.printRedelegationsLiquidTokens()
 
@@ -468,6 +468,116 @@ func (s *KeeperTestSuite) TestRebalancingConsecutiveCase() {
 	s.printRedelegationsLiquidTokens()
 }
 
+func (s *KeeperTestSuite) TestRebalancingMaxRedelegationsPerBlock() {
+	_, valOpers, _ := s.CreateValidators([]int64{1000000, 1000000, 1000000, 1000000})
+	params := s.keeper.GetParams(s.ctx)
+	params.MaxRedelegationsPerBlock = 1
+	params.WhitelistedValidators = []types.WhitelistedValidator{
+		{ValidatorAddress: valOpers[0].String(), TargetWeight: sdk.NewInt(10)},
+		{ValidatorAddress: valOpers[1].String(), TargetWeight: sdk.NewInt(10)},
+		{ValidatorAddress: valOpers[2].String(), TargetWeight: sdk.NewInt(10)},
+	}
+	s.keeper.SetParams(s.ctx, params)
+	s.keeper.UpdateLiquidValidatorSet(s.ctx)
+	s.Require().NoError(s.liquidStaking(s.delAddrs[0], sdk.NewInt(30000000)))
+
+	// the new liquid validator is rebalanced from one liquid validator per block
+	params.WhitelistedValidators = append(params.WhitelistedValidators,
+		types.WhitelistedValidator{ValidatorAddress: valOpers[3].String(), TargetWeight: sdk.NewInt(10)})
+	s.keeper.SetParams(s.ctx, params)
+	for i := 0; i < 3; i++ {
+		reds := s.keeper.UpdateLiquidValidatorSet(s.ctx)
+		s.Require().Len(reds, 1)
+		s.Require().Equal(0, s.redelegationsErrorCount(reds))
+		s.Require().Equal(valOpers[3], reds[0].DstValidator.GetOperator())
+	}
+	reds := s.keeper.UpdateLiquidValidatorSet(s.ctx)
+	s.Require().Len(reds, 0)
+
+	proxyAccDel, found := s.app.StakingKeeper.GetDelegation(s.ctx, types.LiquidStakingProxyAcc, valOpers[3])
+	s.Require().True(found)
+	s.Require().EqualValues(sdk.NewInt(7500000), proxyAccDel.Shares.TruncateInt())
+}
+
+func (s *KeeperTestSuite) TestRebalancingMaxRedelegationsPerBlockInactiveValidator() {
+	_, valOpers, _ := s.CreateValidators([]int64{1000000, 1000000, 1000000})
+	params := s.keeper.GetParams(s.ctx)
+	params.MaxRedelegationsPerBlock = 1
+	params.WhitelistedValidators = []types.WhitelistedValidator{
+		{ValidatorAddress: valOpers[0].String(), TargetWeight: sdk.NewInt(10)},
+		{ValidatorAddress: valOpers[1].String(), TargetWeight: sdk.NewInt(10)},
+		{ValidatorAddress: valOpers[2].String(), TargetWeight: sdk.NewInt(10)},
+	}
+	s.keeper.SetParams(s.ctx, params)
+	s.keeper.UpdateLiquidValidatorSet(s.ctx)
+	s.Require().NoError(s.liquidStaking(s.delAddrs[0], sdk.NewInt(30000000)))
+
+	// the delisted liquid validator is not unbonded while its stake is redelegated one redelegation per block
+	params.WhitelistedValidators = params.WhitelistedValidators[:2]
+	s.keeper.SetParams(s.ctx, params)
+	reds := s.keeper.UpdateLiquidValidatorSet(s.ctx)
+	s.Require().Len(reds, 1)
+	s.Require().Equal(0, s.redelegationsErrorCount(reds))
+	s.Require().Equal(valOpers[2], reds[0].SrcValidator.GetOperator())
+	s.Require().False(reds[0].Last)
+	proxyAccDel, found := s.app.StakingKeeper.GetDelegation(s.ctx, types.LiquidStakingProxyAcc, valOpers[2])
+	s.Require().True(found)
+	s.Require().EqualValues(sdk.NewInt(5000000), proxyAccDel.Shares.TruncateInt())
+	ubds := s.app.StakingKeeper.GetAllUnbondingDelegations(s.ctx, types.LiquidStakingProxyAcc)
+	s.Require().Len(ubds, 0)
+
+	reds = s.keeper.UpdateLiquidValidatorSet(s.ctx)
+	s.Require().Len(reds, 1)
+	s.Require().Equal(0, s.redelegationsErrorCount(reds))
+	s.Require().True(reds[0].Last)
+	_, found = s.app.StakingKeeper.GetDelegation(s.ctx, types.LiquidStakingProxyAcc, valOpers[2])
+	s.Require().False(found)
+	_, found = s.keeper.GetLiquidValidator(s.ctx, valOpers[2])
+	s.Require().False(found)
+	ubds = s.app.StakingKeeper.GetAllUnbondingDelegations(s.ctx, types.LiquidStakingProxyAcc)
+	s.Require().Len(ubds, 0)
+}
+
+func (s *KeeperTestSuite) TestRebalancingMaxRedelegationsPerBlockInactiveValidators() {
+	_, valOpers, _ := s.CreateValidators([]int64{1000000, 1000000, 1000000, 1000000})
+	params := s.keeper.GetParams(s.ctx)
+	params.MaxRedelegationsPerBlock = 1
+	params.WhitelistedValidators = []types.WhitelistedValidator{
+		{ValidatorAddress: valOpers[0].String(), TargetWeight: sdk.NewInt(10)},
+		{ValidatorAddress: valOpers[1].String(), TargetWeight: sdk.NewInt(10)},
+		{ValidatorAddress: valOpers[2].String(), TargetWeight: sdk.NewInt(10)},
+		{ValidatorAddress: valOpers[3].String(), TargetWeight: sdk.NewInt(10)},
+	}
+	s.keeper.SetParams(s.ctx, params)
+	s.keeper.UpdateLiquidValidatorSet(s.ctx)
+	s.Require().NoError(s.liquidStaking(s.delAddrs[0], sdk.NewInt(40000000)))
+
+	// both delisted liquid validators are redelegated from, one per block, the one the capped rebalancing didn't
+	// reach is not unbonded
+	params.WhitelistedValidators = params.WhitelistedValidators[:2]
+	s.keeper.SetParams(s.ctx, params)
+	reds := s.keeper.UpdateLiquidValidatorSet(s.ctx)
+	s.Require().Len(reds, 1)
+	s.Require().Equal(0, s.redelegationsErrorCount(reds))
+	s.Require().True(reds[0].Last)
+	firstSrc := reds[0].SrcValidator.GetOperator()
+	s.Require().Contains([]sdk.ValAddress{valOpers[2], valOpers[3]}, firstSrc)
+	_, found := s.keeper.GetLiquidValidator(s.ctx, firstSrc)
+	s.Require().False(found)
+	ubds := s.app.StakingKeeper.GetAllUnbondingDelegations(s.ctx, types.LiquidStakingProxyAcc)
+	s.Require().Len(ubds, 0)
+
+	reds = s.keeper.UpdateLiquidValidatorSet(s.ctx)
+	s.Require().Len(reds, 1)
+	s.Require().Equal(0, s.redelegationsErrorCount(reds))
+	s.Require().True(reds[0].Last)
+	s.Require().NotEqual(firstSrc, reds[0].SrcValidator.GetOperator())
+	s.Require().Contains([]sdk.ValAddress{valOpers[2], valOpers[3]}, reds[0].SrcValidator.GetOperator())
+	s.Require().Len(s.keeper.GetAllLiquidValidators(s.ctx), 2)
+	ubds = s.app.StakingKeeper.GetAllUnbondingDelegations(s.ctx, types.LiquidStakingProxyAcc)
+	s.Require().Len(ubds, 0)
+}
+
 func (s *KeeperTestSuite) TestWithdrawRewardsAndReStaking() {
 	_, valOpers, _ := s.CreateValidators([]int64{1000000, 1000000, 1000000})
 	params := s.keeper.GetParams(s.ctx)
@@ -0,0 +1,110 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/persistenceOne/pstake-native/v2/x/lspersistence/types"
+)
+
+// GetCollectedRewardFees returns the cumulative reward fees sent to the reward fee address.
+func (k Keeper) GetCollectedRewardFees(ctx sdk.Context) sdk.Coins {
+	return k.getCoins(ctx, types.CollectedRewardFeesKey)
+}
+
+// AddCollectedRewardFees adds the fees to the cumulative reward fees.
+func (k Keeper) AddCollectedRewardFees(ctx sdk.Context, fees sdk.Coins) {
+	k.addCoins(ctx, types.GetCollectedRewardFeesKey, fees)
+}
+
+// GetAutoWithdrawnRewards returns the rewards withdrawn to the LiquidStakingProxyAcc by the distribution hooks on its
+// delegation changes, they are charged with the reward fee on the next re-staking.
+func (k Keeper) GetAutoWithdrawnRewards(ctx sdk.Context) sdk.Coins {
+	return k.getCoins(ctx, types.AutoWithdrawnRewardsKey)
+}
+
+// AddAutoWithdrawnRewards adds the rewards to the auto withdrawn rewards.
+func (k Keeper) AddAutoWithdrawnRewards(ctx sdk.Context, rewards sdk.Coins) {
+	k.addCoins(ctx, types.GetAutoWithdrawnRewardsKey, rewards)
+}
+
+// ClearAutoWithdrawnRewards removes the auto withdrawn rewards once they are charged with the reward fee.
+func (k Keeper) ClearAutoWithdrawnRewards(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	for _, reward := range k.GetAutoWithdrawnRewards(ctx) {
+		store.Delete(types.GetAutoWithdrawnRewardsKey(reward.Denom))
+	}
+}
+
+// trackAutoWithdrawnRewards adds the increase of the proxy account balance since balanceBefore, net of the principal
+// delegated from it meanwhile, to the auto withdrawn rewards. It is called around the delegation changes of the
+// LiquidStakingProxyAcc, which withdraw its pending rewards of the validator.
+func (k Keeper) trackAutoWithdrawnRewards(ctx sdk.Context, proxyAcc sdk.AccAddress, balanceBefore sdk.Coin, delegated math.Int) {
+	if !proxyAcc.Equals(types.LiquidStakingProxyAcc) {
+		return
+	}
+	rewards := k.GetProxyAccBalance(ctx, proxyAcc).Amount.Add(delegated).Sub(balanceBefore.Amount)
+	if rewards.IsPositive() {
+		k.AddAutoWithdrawnRewards(ctx, sdk.NewCoins(sdk.NewCoin(balanceBefore.Denom, rewards)))
+	}
+}
+
+func (k Keeper) getCoins(ctx sdk.Context, prefix []byte) sdk.Coins {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	coins := sdk.Coins{}
+	for ; iterator.Valid(); iterator.Next() {
+		var coin sdk.Coin
+		k.cdc.MustUnmarshal(iterator.Value(), &coin)
+		coins = coins.Add(coin)
+	}
+	return coins
+}
+
+func (k Keeper) addCoins(ctx sdk.Context, getKey func(denom string) []byte, coins sdk.Coins) {
+	store := ctx.KVStore(k.storeKey)
+	for _, coin := range coins {
+		key := getKey(coin.Denom)
+		if bz := store.Get(key); bz != nil {
+			var stored sdk.Coin
+			k.cdc.MustUnmarshal(bz, &stored)
+			coin = coin.Add(stored)
+		}
+		store.Set(key, k.cdc.MustMarshal(&coin))
+	}
+}
+
+// ChargeRewardFee sends the RewardFeeRate share of the withdrawn rewards of the LiquidStakingProxyAcc to the
+// RewardFeeAddress and returns the charged fee. No fee is charged when the rate is zero or the address is not set.
+func (k Keeper) ChargeRewardFee(ctx sdk.Context, rewards math.Int) (math.Int, error) {
+	params := k.GetParams(ctx)
+	if params.RewardFeeAddress == "" || !params.RewardFeeRate.IsPositive() {
+		return sdk.ZeroInt(), nil
+	}
+	feeAmount := params.RewardFeeRate.MulInt(rewards).TruncateInt()
+	if !feeAmount.IsPositive() {
+		return sdk.ZeroInt(), nil
+	}
+
+	recipient, err := sdk.AccAddressFromBech32(params.RewardFeeAddress)
+	if err != nil {
+		return sdk.ZeroInt(), err
+	}
+	fee := sdk.NewCoins(sdk.NewCoin(k.stakingKeeper.BondDenom(ctx), feeAmount))
+	if err = k.bankKeeper.SendCoins(ctx, types.LiquidStakingProxyAcc, recipient, fee); err != nil {
+		return sdk.ZeroInt(), err
+	}
+	k.AddCollectedRewardFees(ctx, fee)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRewardFee,
+			sdk.NewAttribute(types.AttributeKeyDelegator, types.LiquidStakingProxyAcc.String()),
+			sdk.NewAttribute(types.AttributeKeyRecipient, recipient.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, fee.String()),
+		),
+	})
+	return feeAmount, nil
+}
@@ -0,0 +1,116 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
+
+	"github.com/persistenceOne/pstake-native/v2/x/lspersistence/types"
+)
+
+func (s *KeeperTestSuite) TestWithdrawRewardsAndReStakingRewardFee() {
+	_, valOpers, _ := s.CreateValidators([]int64{1000000, 1000000, 1000000})
+	feeAddress := sdk.AccAddress("reward_fee_address__")
+	params := s.keeper.GetParams(s.ctx)
+	params.WhitelistedValidators = []types.WhitelistedValidator{
+		{ValidatorAddress: valOpers[0].String(), TargetWeight: sdk.NewInt(10)},
+		{ValidatorAddress: valOpers[1].String(), TargetWeight: sdk.NewInt(10)},
+	}
+	params.RewardFeeRate = sdk.NewDecWithPrec(1, 1)
+	params.RewardFeeAddress = feeAddress.String()
+	s.keeper.SetParams(s.ctx, params)
+	s.keeper.UpdateLiquidValidatorSet(s.ctx)
+	s.Require().NoError(s.liquidStaking(s.delAddrs[0], sdk.NewInt(100000000)))
+
+	s.advanceHeight(100, false)
+	totalRewards, totalDelShares, _ := s.keeper.CheckDelegationStates(s.ctx, types.LiquidStakingProxyAcc)
+	s.Require().True(totalRewards.IsPositive())
+	s.Require().True(s.keeper.GetCollectedRewardFees(s.ctx).IsZero())
+
+	// a tenth of the withdrawn rewards is sent to the reward fee address, the rest is re-staked
+	s.keeper.WithdrawRewardsAndReStake(s.ctx, types.GetWhitelistedValsMap(params.WhitelistedValidators))
+	bondDenom := s.app.StakingKeeper.BondDenom(s.ctx)
+	expectedFee := params.RewardFeeRate.Mul(totalRewards.TruncateDec()).TruncateInt()
+	s.Require().Equal(expectedFee, s.app.BankKeeper.GetBalance(s.ctx, feeAddress, bondDenom).Amount)
+	s.Require().Equal(sdk.NewCoins(sdk.NewCoin(bondDenom, expectedFee)), s.keeper.GetCollectedRewardFees(s.ctx))
+
+	totalRewardsAfter, totalDelSharesAfter, _ := s.keeper.CheckDelegationStates(s.ctx, types.LiquidStakingProxyAcc)
+	s.Require().Equal(sdk.ZeroDec(), totalRewardsAfter)
+	s.Require().Equal(totalDelShares.Add(totalRewards.TruncateDec()).Sub(sdk.NewDecFromInt(expectedFee)), totalDelSharesAfter)
+
+	res, err := s.querier.CollectedRewardFees(sdk.WrapSDKContext(s.ctx), &types.QueryCollectedRewardFeesRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(s.keeper.GetCollectedRewardFees(s.ctx), res.CollectedRewardFees)
+	_, err = s.querier.CollectedRewardFees(sdk.WrapSDKContext(s.ctx), nil)
+	s.Require().Error(err)
+
+	// the collected reward fees are exported and imported
+	genState := s.keeper.ExportGenesis(s.ctx)
+	s.Require().Equal(s.keeper.GetCollectedRewardFees(s.ctx), genState.CollectedRewardFees)
+}
+
+func (s *KeeperTestSuite) TestWithdrawRewardsAndReStakingRewardFeeAutoWithdrawnRewards() {
+	_, valOpers, _ := s.CreateValidators([]int64{1000000, 1000000, 1000000})
+	feeAddress := sdk.AccAddress("reward_fee_address__")
+	params := s.keeper.GetParams(s.ctx)
+	params.WhitelistedValidators = []types.WhitelistedValidator{
+		{ValidatorAddress: valOpers[0].String(), TargetWeight: sdk.NewInt(10)},
+		{ValidatorAddress: valOpers[1].String(), TargetWeight: sdk.NewInt(10)},
+	}
+	params.RewardFeeRate = sdk.NewDecWithPrec(1, 1)
+	params.RewardFeeAddress = feeAddress.String()
+	s.keeper.SetParams(s.ctx, params)
+	s.keeper.UpdateLiquidValidatorSet(s.ctx)
+	s.Require().NoError(s.liquidStaking(s.delAddrs[0], sdk.NewInt(100000000)))
+
+	s.advanceHeight(100, false)
+	totalRewards, _, _ := s.keeper.CheckDelegationStates(s.ctx, types.LiquidStakingProxyAcc)
+	s.Require().True(totalRewards.IsPositive())
+
+	// the liquid staking withdraws the accrued rewards to the proxy account before they are re-staked
+	bondDenom := s.app.StakingKeeper.BondDenom(s.ctx)
+	s.Require().NoError(s.liquidStaking(s.delAddrs[1], sdk.NewInt(10000000)))
+	remainingRewards, _, _ := s.keeper.CheckDelegationStates(s.ctx, types.LiquidStakingProxyAcc)
+	s.Require().True(remainingRewards.IsZero())
+	autoWithdrawnRewards := s.keeper.GetAutoWithdrawnRewards(s.ctx).AmountOf(bondDenom)
+	s.Require().Equal(s.keeper.GetProxyAccBalance(s.ctx, types.LiquidStakingProxyAcc).Amount, autoWithdrawnRewards)
+	s.Require().True(autoWithdrawnRewards.GTE(totalRewards.TruncateInt().SubRaw(int64(len(params.WhitelistedValidators)))))
+
+	// the auto withdrawn rewards are exported and imported
+	genState := s.keeper.ExportGenesis(s.ctx)
+	s.Require().Equal(s.keeper.GetAutoWithdrawnRewards(s.ctx), genState.AutoWithdrawnRewards)
+
+	// the auto withdrawn rewards are charged with the reward fee on re-staking
+	s.keeper.WithdrawRewardsAndReStake(s.ctx, types.GetWhitelistedValsMap(params.WhitelistedValidators))
+	expectedFee := params.RewardFeeRate.MulInt(autoWithdrawnRewards).TruncateInt()
+	s.Require().True(expectedFee.IsPositive())
+	s.Require().Equal(expectedFee, s.app.BankKeeper.GetBalance(s.ctx, feeAddress, bondDenom).Amount)
+	s.Require().True(s.keeper.GetAutoWithdrawnRewards(s.ctx).IsZero())
+	s.Require().True(s.keeper.GetProxyAccBalance(s.ctx, types.LiquidStakingProxyAcc).IsZero())
+}
+
+func (s *KeeperTestSuite) TestChargeRewardFee() {
+	params := s.keeper.GetParams(s.ctx)
+	bondDenom := s.app.StakingKeeper.BondDenom(s.ctx)
+
+	// no reward fee without an address or a rate
+	fee, err := s.keeper.ChargeRewardFee(s.ctx, sdk.NewInt(1000))
+	s.Require().NoError(err)
+	s.Require().True(fee.IsZero())
+
+	params.RewardFeeRate = sdk.NewDecWithPrec(5, 2)
+	params.RewardFeeAddress = s.delAddrs[1].String()
+	s.keeper.SetParams(s.ctx, params)
+
+	// the proxy account cannot pay the fee
+	_, err = s.keeper.ChargeRewardFee(s.ctx, sdk.NewInt(1000))
+	s.Require().Error(err)
+
+	s.Require().NoError(testutil.FundAccount(s.app.BankKeeper, s.ctx, types.LiquidStakingProxyAcc, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 1000))))
+	fee, err = s.keeper.ChargeRewardFee(s.ctx, sdk.NewInt(1000))
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewInt(50), fee)
+	fee, err = s.keeper.ChargeRewardFee(s.ctx, sdk.NewInt(10))
+	s.Require().NoError(err)
+	s.Require().True(fee.IsZero())
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 50)), s.keeper.GetCollectedRewardFees(s.ctx))
+}
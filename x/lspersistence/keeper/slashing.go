@@ -0,0 +1,202 @@
+package keeper
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/persistenceOne/pstake-native/v2/x/lspersistence/types"
+)
+
+// GetLastSlashingRecordID returns the id of the last slashing record.
+func (k Keeper) GetLastSlashingRecordID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.LastSlashingRecordIDKey)
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetLastSlashingRecordID sets the id of the last slashing record.
+func (k Keeper) SetLastSlashingRecordID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.LastSlashingRecordIDKey, sdk.Uint64ToBigEndian(id))
+}
+
+// SetSlashingRecord sets the slashing record.
+func (k Keeper) SetSlashingRecord(ctx sdk.Context, record types.SlashingRecord) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetSlashingRecordKey(record.Id), k.cdc.MustMarshal(&record))
+}
+
+// GetSlashingRecord gets the slashing record with id.
+func (k Keeper) GetSlashingRecord(ctx sdk.Context, id uint64) (record types.SlashingRecord, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetSlashingRecordKey(id))
+	if bz == nil {
+		return record, false
+	}
+	k.cdc.MustUnmarshal(bz, &record)
+	return record, true
+}
+
+// GetAllSlashingRecords returns the slashing history of all liquid validators in order.
+func (k Keeper) GetAllSlashingRecords(ctx sdk.Context) []types.SlashingRecord {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.SlashingRecordsKey)
+	defer iterator.Close()
+
+	records := []types.SlashingRecord{}
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.SlashingRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &record)
+		records = append(records, record)
+	}
+	return records
+}
+
+// HandleLiquidValidatorSlashed records the loss of the liquid tokens of the proxy account delegated to the liquid
+// validator about to be slashed by the fraction and emits the NetAmount change. The unbonding delegations and
+// redelegations of the validator are already slashed, its bonded tokens are slashed after.
+func (k Keeper) HandleLiquidValidatorSlashed(ctx sdk.Context, valAddr sdk.ValAddress, fraction sdk.Dec) {
+	if _, found := k.GetLiquidValidator(ctx, valAddr); !found {
+		return
+	}
+	validator, found := k.stakingKeeper.GetValidator(ctx, valAddr)
+	if !found {
+		return
+	}
+	delegation, found := k.stakingKeeper.GetDelegation(ctx, types.LiquidStakingProxyAcc, valAddr)
+	if !found {
+		return
+	}
+
+	slashedAmount := validator.TokensFromShares(delegation.Shares).Mul(fraction).TruncateInt()
+	netAmountBefore := k.GetNetAmountState(ctx).NetAmount
+	netAmountAfter := netAmountBefore.Sub(sdk.NewDecFromInt(slashedAmount))
+	if netAmountAfter.IsNegative() {
+		netAmountAfter = sdk.ZeroDec()
+	}
+
+	id := k.GetLastSlashingRecordID(ctx) + 1
+	k.SetLastSlashingRecordID(ctx, id)
+	k.SetSlashingRecord(ctx, types.SlashingRecord{
+		Id:               id,
+		ValidatorAddress: valAddr.String(),
+		Height:           ctx.BlockHeight(),
+		Time:             ctx.BlockTime(),
+		SlashFraction:    fraction,
+		SlashedAmount:    slashedAmount,
+		NetAmountBefore:  netAmountBefore,
+		NetAmountAfter:   netAmountAfter,
+	})
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSlashingLoss,
+			sdk.NewAttribute(types.AttributeKeyLiquidValidator, valAddr.String()),
+			sdk.NewAttribute(types.AttributeKeySlashFraction, fraction.String()),
+			sdk.NewAttribute(types.AttributeKeySlashedAmount, slashedAmount.String()),
+			sdk.NewAttribute(types.AttributeKeyNetAmountBefore, netAmountBefore.String()),
+			sdk.NewAttribute(types.AttributeKeyNetAmountAfter, netAmountAfter.String()),
+		),
+	})
+	k.Logger(ctx).Info(types.EventTypeSlashingLoss,
+		types.AttributeKeyLiquidValidator, valAddr.String(),
+		types.AttributeKeySlashedAmount, slashedAmount.String(),
+		types.AttributeKeyNetAmountBefore, netAmountBefore.String(),
+		types.AttributeKeyNetAmountAfter, netAmountAfter.String())
+}
+
+// SetDeactivationQueue queues the liquid validator to be checked for deactivation on the next BeginBlock.
+func (k Keeper) SetDeactivationQueue(ctx sdk.Context, valAddr sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetDeactivationQueueKey(valAddr), []byte{})
+}
+
+// DeactivateQueuedLiquidValidators deactivates the liquid validators queued by the staking hooks and clears the queue.
+func (k Keeper) DeactivateQueuedLiquidValidators(ctx sdk.Context) (redelegations []types.Redelegation) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.DeactivationQueueKey)
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, append([]byte{}, iterator.Key()...))
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+		// key: DeactivationQueueKey | len(addr) | addr
+		valAddr := sdk.ValAddress(key[len(types.DeactivationQueueKey)+1:])
+		redelegations = append(redelegations, k.DeactivateLiquidValidator(ctx, valAddr)...)
+	}
+	return redelegations
+}
+
+// DeactivateLiquidValidator redelegates all the liquid tokens of the proxy account away from the liquid validator
+// once it is no longer active, e.g. jailed or tombstoned, to the active liquid validators according to their weight,
+// regardless of the rebalancing trigger and MaxRedelegationsPerBlock. The tokens of the failed redelegations are
+// unbonded when the liquid validator set is updated.
+func (k Keeper) DeactivateLiquidValidator(ctx sdk.Context, valAddr sdk.ValAddress) []types.Redelegation {
+	lv, found := k.GetLiquidValidator(ctx, valAddr)
+	if !found {
+		return []types.Redelegation{}
+	}
+	params := k.GetParams(ctx)
+	whitelistedValsMap := params.WhitelistedValsMap()
+	if k.IsActiveLiquidValidator(ctx, lv, whitelistedValsMap) {
+		return []types.Redelegation{}
+	}
+	liquidTokens := lv.GetLiquidTokens(ctx, k.stakingKeeper, false)
+	if !liquidTokens.IsPositive() {
+		return []types.Redelegation{}
+	}
+	activeVals := k.GetActiveLiquidValidators(ctx, whitelistedValsMap)
+	if activeVals.Len() == 0 || !activeVals.TotalWeight(whitelistedValsMap).IsPositive() {
+		return []types.Redelegation{}
+	}
+
+	// the crumb is redelegated to the last active liquid validator with the full delShares
+	amounts, _ := types.DivideByWeight(activeVals, liquidTokens, k.GetEffectiveWhitelistedValsMap(ctx, params))
+	lastIdx := -1
+	for i := range activeVals {
+		if amounts[i].IsPositive() {
+			lastIdx = i
+		}
+	}
+
+	var redelegations []types.Redelegation //nolint: prealloc
+	failCount := 0
+	for i, dstVal := range activeVals {
+		if !amounts[i].IsPositive() {
+			continue
+		}
+		redelegation := types.Redelegation{
+			Delegator:    types.LiquidStakingProxyAcc,
+			SrcValidator: lv,
+			DstValidator: types.LiquidValidator(dstVal),
+			Amount:       amounts[i],
+			Last:         i == lastIdx,
+		}
+		if _, err := k.TryRedelegation(ctx, redelegation); err != nil {
+			redelegation.Error = err
+			failCount++
+		}
+		redelegations = append(redelegations, redelegation)
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeDeactivateLiquidValidator,
+			sdk.NewAttribute(types.AttributeKeyLiquidValidator, lv.OperatorAddress),
+			sdk.NewAttribute(types.AttributeKeyRedelegationCount, strconv.Itoa(len(redelegations))),
+			sdk.NewAttribute(types.AttributeKeyRedelegationFailCount, strconv.Itoa(failCount)),
+		),
+	})
+	k.Logger(ctx).Info(types.EventTypeDeactivateLiquidValidator,
+		types.AttributeKeyLiquidValidator, lv.OperatorAddress,
+		types.AttributeKeyRedelegationCount, strconv.Itoa(len(redelegations)),
+		types.AttributeKeyRedelegationFailCount, strconv.Itoa(failCount))
+	return redelegations
+}
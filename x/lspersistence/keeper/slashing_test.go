@@ -0,0 +1,98 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/persistenceOne/pstake-native/v2/x/lspersistence/types"
+)
+
+func (s *KeeperTestSuite) TestSlashingHooks() {
+	_, valOpers, pks := s.CreateValidators([]int64{1000000, 1000000, 1000000})
+	params := s.keeper.GetParams(s.ctx)
+	params.WhitelistedValidators = []types.WhitelistedValidator{
+		{ValidatorAddress: valOpers[0].String(), TargetWeight: sdk.NewInt(10)},
+		{ValidatorAddress: valOpers[1].String(), TargetWeight: sdk.NewInt(10)},
+		{ValidatorAddress: valOpers[2].String(), TargetWeight: sdk.NewInt(10)},
+	}
+	s.keeper.SetParams(s.ctx, params)
+	s.keeper.UpdateLiquidValidatorSet(s.ctx)
+	s.Require().NoError(s.liquidStaking(s.delAddrs[0], sdk.NewInt(30000000)))
+	s.Require().Empty(s.keeper.GetAllSlashingRecords(s.ctx))
+
+	lv, found := s.keeper.GetLiquidValidator(s.ctx, valOpers[0])
+	s.Require().True(found)
+	liquidTokens := lv.GetLiquidTokens(s.ctx, s.app.StakingKeeper, false)
+	nasBefore := s.keeper.GetNetAmountState(s.ctx)
+
+	// double sign, tombstone, slash, jail
+	s.ctx = s.ctx.WithEventManager(sdk.NewEventManager())
+	s.doubleSign(valOpers[0], sdk.ConsAddress(pks[0].Address()))
+
+	// the slashing loss is recorded with the NetAmount change
+	records := s.keeper.GetAllSlashingRecords(s.ctx)
+	s.Require().Len(records, 1)
+	record := records[0]
+	s.Require().NoError(record.Validate())
+	s.Require().Equal(uint64(1), record.Id)
+	s.Require().Equal(uint64(1), s.keeper.GetLastSlashingRecordID(s.ctx))
+	s.Require().Equal(valOpers[0].String(), record.ValidatorAddress)
+	s.Require().Equal(s.ctx.BlockHeight(), record.Height)
+	s.Require().Equal(s.app.SlashingKeeper.SlashFractionDoubleSign(s.ctx), record.SlashFraction)
+	s.Require().Equal(sdk.NewDecFromInt(liquidTokens).Mul(record.SlashFraction).TruncateInt(), record.SlashedAmount)
+	s.Require().Equal(nasBefore.NetAmount, record.NetAmountBefore)
+	s.Require().Equal(nasBefore.NetAmount.Sub(sdk.NewDecFromInt(record.SlashedAmount)), record.NetAmountAfter)
+	s.Require().True(s.keeper.GetNetAmountState(s.ctx).NetAmount.LT(nasBefore.NetAmount))
+	found = false
+	for _, event := range s.ctx.EventManager().Events() {
+		if event.Type == types.EventTypeSlashingLoss {
+			found = true
+		}
+	}
+	s.Require().True(found)
+
+	// the jailed liquid validator is inactive and all its liquid tokens are redelegated away on the next BeginBlock
+	s.Require().False(s.keeper.IsActiveLiquidValidator(s.ctx, lv, params.WhitelistedValsMap()))
+	reds := s.keeper.DeactivateQueuedLiquidValidators(s.ctx)
+	s.Require().Len(reds, 2)
+	for _, red := range reds {
+		s.Require().NoError(red.Error)
+		s.Require().Equal(valOpers[0].String(), red.SrcValidator.OperatorAddress)
+	}
+	_, found = s.app.StakingKeeper.GetDelegation(s.ctx, types.LiquidStakingProxyAcc, valOpers[0])
+	s.Require().False(found)
+	s.Require().Empty(s.keeper.DeactivateQueuedLiquidValidators(s.ctx))
+
+	// the liquid validator without delegation is removed
+	s.keeper.UpdateLiquidValidatorSet(s.ctx)
+	_, found = s.keeper.GetLiquidValidator(s.ctx, valOpers[0])
+	s.Require().False(found)
+
+	// slashing of a validator not in the liquid validator set is not recorded
+	s.Require().NoError(s.keeper.Hooks().BeforeValidatorSlashed(s.ctx, valOpers[0], sdk.NewDecWithPrec(1, 2)))
+	s.Require().Len(s.keeper.GetAllSlashingRecords(s.ctx), 1)
+
+	// query
+	res, err := s.querier.SlashingHistory(sdk.WrapSDKContext(s.ctx), &types.QuerySlashingHistoryRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(records, res.SlashingRecords)
+	res, err = s.querier.SlashingHistory(sdk.WrapSDKContext(s.ctx), &types.QuerySlashingHistoryRequest{
+		ValidatorAddress: valOpers[0].String(), Pagination: &query.PageRequest{CountTotal: true},
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(records, res.SlashingRecords)
+	s.Require().Equal(uint64(1), res.Pagination.Total)
+	res, err = s.querier.SlashingHistory(sdk.WrapSDKContext(s.ctx), &types.QuerySlashingHistoryRequest{ValidatorAddress: valOpers[1].String()})
+	s.Require().NoError(err)
+	s.Require().Empty(res.SlashingRecords)
+	_, err = s.querier.SlashingHistory(sdk.WrapSDKContext(s.ctx), &types.QuerySlashingHistoryRequest{ValidatorAddress: "invalid"})
+	s.Require().Error(err)
+	_, err = s.querier.SlashingHistory(sdk.WrapSDKContext(s.ctx), nil)
+	s.Require().Error(err)
+
+	// genesis
+	genState := s.keeper.ExportGenesis(s.ctx)
+	s.Require().Equal(records, genState.SlashingRecords)
+	s.Require().Equal(uint64(1), genState.LastSlashingRecordId)
+	s.Require().NoError(types.ValidateGenesis(*genState))
+}
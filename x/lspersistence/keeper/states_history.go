@@ -0,0 +1,91 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/persistenceOne/pstake-native/v2/x/lspersistence/types"
+)
+
+// SetNetAmountStateSnapshot sets the net amount state snapshot.
+func (k Keeper) SetNetAmountStateSnapshot(ctx sdk.Context, snapshot types.NetAmountStateSnapshot) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetNetAmountStateSnapshotKey(snapshot.Height), k.cdc.MustMarshal(&snapshot))
+}
+
+// GetAllNetAmountStateSnapshots returns the retained states history, oldest first.
+func (k Keeper) GetAllNetAmountStateSnapshots(ctx sdk.Context) []types.NetAmountStateSnapshot {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.StatesHistoryKey)
+	defer iterator.Close()
+
+	snapshots := []types.NetAmountStateSnapshot{}
+	for ; iterator.Valid(); iterator.Next() {
+		var snapshot types.NetAmountStateSnapshot
+		k.cdc.MustUnmarshal(iterator.Value(), &snapshot)
+		snapshots = append(snapshots, snapshot)
+	}
+	return snapshots
+}
+
+// RecordNetAmountStateSnapshot records the net amount state to the states history every NetAmountStateSnapshotInterval
+// blocks and prunes the snapshots older than the latest MaxNetAmountStateSnapshots. Nothing is recorded while no
+// bToken is minted, as the bToken has no value to be priced.
+func (k Keeper) RecordNetAmountStateSnapshot(ctx sdk.Context) {
+	params := k.GetParams(ctx)
+	interval := int64(params.NetAmountStateSnapshotInterval)
+	if interval == 0 || ctx.BlockHeight()%interval != 0 {
+		return
+	}
+	nas := k.GetNetAmountState(ctx)
+	if !nas.BtokenTotalSupply.IsPositive() || !nas.NetAmount.IsPositive() {
+		return
+	}
+	k.SetNetAmountStateSnapshot(ctx, types.NewNetAmountStateSnapshot(ctx.BlockHeight(), ctx.BlockTime(), nas))
+	k.pruneNetAmountStateSnapshots(ctx, params.MaxNetAmountStateSnapshots)
+}
+
+// pruneNetAmountStateSnapshots deletes the snapshots older than the latest maxSnapshots ones.
+func (k Keeper) pruneNetAmountStateSnapshots(ctx sdk.Context, maxSnapshots uint32) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStoreReversePrefixIterator(store, types.StatesHistoryKey)
+	var prunedKeys [][]byte
+	for retained := uint32(0); iterator.Valid(); iterator.Next() {
+		if retained < maxSnapshots {
+			retained++
+			continue
+		}
+		prunedKeys = append(prunedKeys, append([]byte{}, iterator.Key()...))
+	}
+	iterator.Close()
+
+	for _, key := range prunedKeys {
+		store.Delete(key)
+	}
+}
+
+// EstimateAPY returns the APY of the bToken estimated from the first retained snapshot at or after the height, or
+// the oldest one if the height is zero, to the latest snapshot.
+func (k Keeper) EstimateAPY(ctx sdk.Context, fromHeight int64) (apy sdk.Dec, from, to types.NetAmountStateSnapshot, err error) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.GetNetAmountStateSnapshotKey(fromHeight), sdk.PrefixEndBytes(types.StatesHistoryKey))
+	defer iterator.Close()
+	if !iterator.Valid() {
+		return sdk.ZeroDec(), from, to, errorsmod.Wrapf(sdkerrors.ErrNotFound, "no net amount state snapshot at or after height %d", fromHeight)
+	}
+	k.cdc.MustUnmarshal(iterator.Value(), &from)
+
+	reverseIterator := sdk.KVStoreReversePrefixIterator(store, types.StatesHistoryKey)
+	defer reverseIterator.Close()
+	k.cdc.MustUnmarshal(reverseIterator.Value(), &to)
+	if to.Height == from.Height {
+		return sdk.ZeroDec(), from, to, errorsmod.Wrapf(sdkerrors.ErrNotFound, "no net amount state snapshot after height %d", from.Height)
+	}
+
+	apy, err = types.EstimateAPY(from, to)
+	if err != nil {
+		return sdk.ZeroDec(), from, to, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	return apy, from, to, nil
+}
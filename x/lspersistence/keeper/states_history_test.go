@@ -0,0 +1,90 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/persistenceOne/pstake-native/v2/x/lspersistence/types"
+)
+
+func (s *KeeperTestSuite) TestStatesHistory() {
+	_, valOpers, _ := s.CreateValidators([]int64{1000000, 1000000, 1000000})
+	params := s.keeper.GetParams(s.ctx)
+	params.WhitelistedValidators = []types.WhitelistedValidator{
+		{ValidatorAddress: valOpers[0].String(), TargetWeight: sdk.NewInt(10)},
+		{ValidatorAddress: valOpers[1].String(), TargetWeight: sdk.NewInt(10)},
+	}
+	params.NetAmountStateSnapshotInterval = 10
+	params.MaxNetAmountStateSnapshots = 3
+	s.keeper.SetParams(s.ctx, params)
+	s.keeper.UpdateLiquidValidatorSet(s.ctx)
+
+	// nothing is recorded before the bToken is minted
+	s.advanceHeight(10, true)
+	s.Require().Empty(s.keeper.GetAllNetAmountStateSnapshots(s.ctx))
+
+	s.Require().NoError(s.liquidStaking(s.delAddrs[0], sdk.NewInt(100000000)))
+	s.advanceHeight(10, true)
+	snapshots := s.keeper.GetAllNetAmountStateSnapshots(s.ctx)
+	s.Require().Len(snapshots, 1)
+	s.Require().Zero(snapshots[0].Height % 10)
+	nas := s.keeper.GetNetAmountState(s.ctx)
+	s.Require().Equal(nas.BtokenTotalSupply, snapshots[0].BtokenTotalSupply)
+
+	// a single snapshot is not enough to estimate the APY
+	_, err := s.querier.APY(sdk.WrapSDKContext(s.ctx), &types.QueryAPYRequest{})
+	s.Require().Error(err)
+
+	// only the latest snapshots are retained
+	s.advanceHeight(40, true)
+	snapshots = s.keeper.GetAllNetAmountStateSnapshots(s.ctx)
+	s.Require().Len(snapshots, 3)
+	for i, snapshot := range snapshots {
+		s.Require().Equal(s.ctx.BlockHeight()-s.ctx.BlockHeight()%10-int64(20-10*i), snapshot.Height)
+	}
+
+	res, err := s.querier.StatesHistory(sdk.WrapSDKContext(s.ctx), &types.QueryStatesHistoryRequest{
+		Pagination: &query.PageRequest{Limit: 2, Reverse: true},
+	})
+	s.Require().NoError(err)
+	s.Require().Equal([]types.NetAmountStateSnapshot{snapshots[2], snapshots[1]}, res.Snapshots)
+	s.Require().NotNil(res.Pagination.NextKey)
+	_, err = s.querier.StatesHistory(sdk.WrapSDKContext(s.ctx), nil)
+	s.Require().Error(err)
+
+	// the rewards of the test chain grow the bToken value too fast in seconds to be annualized
+	_, err = s.querier.APY(sdk.WrapSDKContext(s.ctx), &types.QueryAPYRequest{})
+	s.Require().Error(err)
+
+	// the snapshots a day apart are annualized
+	for i := range snapshots {
+		snapshots[i].Time = snapshots[0].Time.Add(time.Duration(i) * 24 * time.Hour)
+		snapshots[i].NetAmount = snapshots[0].NetAmount.Mul(sdk.NewDecWithPrec(int64(10000+i), 4))
+		snapshots[i].BtokenTotalSupply = snapshots[0].BtokenTotalSupply
+		s.keeper.SetNetAmountStateSnapshot(s.ctx, snapshots[i])
+	}
+	apyRes, err := s.querier.APY(sdk.WrapSDKContext(s.ctx), &types.QueryAPYRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(snapshots[0], apyRes.From)
+	s.Require().Equal(snapshots[2], apyRes.To)
+	s.Require().True(apyRes.Apy.IsPositive())
+	expectedAPY, err := types.EstimateAPY(snapshots[0], snapshots[2])
+	s.Require().NoError(err)
+	s.Require().Equal(expectedAPY, apyRes.Apy)
+
+	apyRes, err = s.querier.APY(sdk.WrapSDKContext(s.ctx), &types.QueryAPYRequest{FromHeight: snapshots[0].Height + 1})
+	s.Require().NoError(err)
+	s.Require().Equal(snapshots[1], apyRes.From)
+	_, err = s.querier.APY(sdk.WrapSDKContext(s.ctx), &types.QueryAPYRequest{FromHeight: snapshots[2].Height})
+	s.Require().Error(err)
+	_, err = s.querier.APY(sdk.WrapSDKContext(s.ctx), &types.QueryAPYRequest{FromHeight: -1})
+	s.Require().Error(err)
+
+	// the states history is not recorded if the interval is zero
+	params.NetAmountStateSnapshotInterval = 0
+	s.keeper.SetParams(s.ctx, params)
+	s.advanceHeight(10, true)
+	s.Require().Equal(snapshots, s.keeper.GetAllNetAmountStateSnapshots(s.ctx))
+}
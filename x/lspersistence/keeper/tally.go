@@ -0,0 +1,120 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/persistenceOne/pstake-native/v2/x/lspersistence/types"
+)
+
+var _ govtypes.StakingKeeper = GovStakingKeeper{}
+
+// GovStakingKeeper wraps the staking keeper of x/gov so that tallies count the delegations of the
+// LiquidStakingProxyAcc held by bToken holders as their own. The validators still vote with the shares
+// of bToken holders that did not vote.
+type GovStakingKeeper struct {
+	govtypes.StakingKeeper
+	keeper Keeper
+}
+
+// NewGovStakingKeeper returns the staking keeper to be used by x/gov.
+func NewGovStakingKeeper(sk govtypes.StakingKeeper, k Keeper) GovStakingKeeper {
+	return GovStakingKeeper{StakingKeeper: sk, keeper: k}
+}
+
+// IterateDelegations iterates over the delegations of the delegator followed by its liquid staking delegations.
+func (gsk GovStakingKeeper) IterateDelegations(ctx sdk.Context, delegator sdk.AccAddress,
+	fn func(index int64, delegation stakingtypes.DelegationI) (stop bool),
+) {
+	var index int64
+	stopped := false
+	gsk.StakingKeeper.IterateDelegations(ctx, delegator, func(i int64, delegation stakingtypes.DelegationI) bool {
+		index = i + 1
+		stopped = fn(i, delegation)
+		return stopped
+	})
+	if stopped {
+		return
+	}
+
+	for _, delegation := range gsk.keeper.GetLiquidStakingDelegations(ctx, delegator) {
+		if fn(index, delegation) {
+			return
+		}
+		index++
+	}
+}
+
+// GetVoterBTokenBalance returns the bTokens of the voter in its wallet and in the registered bToken sources.
+func (k Keeper) GetVoterBTokenBalance(ctx sdk.Context, voter sdk.AccAddress) math.Int {
+	liquidBondDenom := k.LiquidBondDenom(ctx)
+
+	balance := k.bankKeeper.GetBalance(ctx, voter, liquidBondDenom).Amount
+	for _, source := range k.bTokenSources {
+		balance = balance.Add(source.GetBTokenBalance(ctx, voter, liquidBondDenom))
+	}
+	return balance
+}
+
+// GetLiquidStakingDelegations returns the delegations of the LiquidStakingProxyAcc attributed to the voter, in
+// proportion to its share of the bToken supply. Only the delegated part of the NetAmountState carries voting power.
+func (k Keeper) GetLiquidStakingDelegations(ctx sdk.Context, voter sdk.AccAddress) (delegations []stakingtypes.Delegation) {
+	if voter.Equals(types.LiquidStakingProxyAcc) {
+		return nil
+	}
+
+	bTokenBalance := k.GetVoterBTokenBalance(ctx, voter)
+	if !bTokenBalance.IsPositive() {
+		return nil
+	}
+	bTokenTotalSupply := k.bankKeeper.GetSupply(ctx, k.LiquidBondDenom(ctx)).Amount
+	if !bTokenTotalSupply.IsPositive() {
+		return nil
+	}
+	share := sdk.MinDec(sdk.NewDecFromInt(bTokenBalance).QuoInt(bTokenTotalSupply), sdk.OneDec())
+
+	k.stakingKeeper.IterateDelegations(ctx, types.LiquidStakingProxyAcc, func(_ int64, delegation stakingtypes.DelegationI) bool {
+		delegations = append(delegations, stakingtypes.NewDelegation(voter, delegation.GetValidatorAddr(), delegation.GetShares().Mul(share)))
+		return false
+	})
+	return delegations
+}
+
+// GetVotingPower returns the voting power of the voter as counted in governance tallies, from its own delegations,
+// its liquid staking delegations and, for validator operators, the bonded tokens of the validator.
+func (k Keeper) GetVotingPower(ctx sdk.Context, voter sdk.AccAddress) types.VotingPower {
+	stakingVotingPower := sdk.ZeroDec()
+	k.stakingKeeper.IterateDelegations(ctx, voter, func(_ int64, delegation stakingtypes.DelegationI) bool {
+		stakingVotingPower = stakingVotingPower.Add(k.delegationVotingPower(ctx, delegation))
+		return false
+	})
+
+	liquidStakingVotingPower := sdk.ZeroDec()
+	for _, delegation := range k.GetLiquidStakingDelegations(ctx, voter) {
+		liquidStakingVotingPower = liquidStakingVotingPower.Add(k.delegationVotingPower(ctx, delegation))
+	}
+
+	validatorVotingPower := sdk.ZeroInt()
+	if validator, found := k.stakingKeeper.GetValidator(ctx, sdk.ValAddress(voter)); found && validator.IsBonded() {
+		validatorVotingPower = validator.BondedTokens()
+	}
+
+	return types.VotingPower{
+		Voter:                    voter.String(),
+		StakingVotingPower:       stakingVotingPower.TruncateInt(),
+		LiquidStakingVotingPower: liquidStakingVotingPower.TruncateInt(),
+		ValidatorVotingPower:     validatorVotingPower,
+	}
+}
+
+// delegationVotingPower returns the bonded tokens of the delegation the same way governance tallies count them,
+// delegations to validators that are not bonded have no voting power.
+func (k Keeper) delegationVotingPower(ctx sdk.Context, delegation stakingtypes.DelegationI) sdk.Dec {
+	validator, found := k.stakingKeeper.GetValidator(ctx, delegation.GetValidatorAddr())
+	if !found || !validator.IsBonded() || validator.GetDelegatorShares().IsZero() {
+		return sdk.ZeroDec()
+	}
+	return delegation.GetShares().MulInt(validator.GetBondedTokens()).Quo(validator.GetDelegatorShares())
+}
@@ -0,0 +1,113 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/persistenceOne/pstake-native/v2/x/lspersistence/keeper"
+	"github.com/persistenceOne/pstake-native/v2/x/lspersistence/types"
+)
+
+func (s *KeeperTestSuite) TestGetVotingPower() {
+	valAddrs, valOpers, _ := s.CreateValidators([]int64{10000000, 10000000})
+	params := s.keeper.GetParams(s.ctx)
+	params.WhitelistedValidators = []types.WhitelistedValidator{
+		{ValidatorAddress: valOpers[0].String(), TargetWeight: sdk.NewInt(1)},
+		{ValidatorAddress: valOpers[1].String(), TargetWeight: sdk.NewInt(1)},
+	}
+	s.keeper.SetParams(s.ctx, params)
+	s.keeper.UpdateLiquidValidatorSet(s.ctx)
+
+	s.Require().NoError(s.liquidStaking(s.delAddrs[0], sdk.NewInt(3000000)))
+	s.Require().NoError(s.liquidStaking(s.delAddrs[1], sdk.NewInt(1000000)))
+
+	votingPower := s.keeper.GetVotingPower(s.ctx, s.delAddrs[0])
+	s.Require().Equal(s.delAddrs[0].String(), votingPower.Voter)
+	s.Require().Equal(sdk.ZeroInt(), votingPower.StakingVotingPower)
+	s.Require().Equal(sdk.NewInt(3000000), votingPower.LiquidStakingVotingPower)
+	s.Require().Equal(sdk.ZeroInt(), votingPower.ValidatorVotingPower)
+
+	votingPower = s.keeper.GetVotingPower(s.ctx, valAddrs[0])
+	s.Require().Equal(sdk.NewInt(10000000), votingPower.StakingVotingPower)
+	s.Require().Equal(sdk.ZeroInt(), votingPower.LiquidStakingVotingPower)
+	s.Require().Equal(sdk.NewInt(10000000+2000000), votingPower.ValidatorVotingPower)
+
+	// the proxy account does not get voting power for its own delegations twice
+	s.Require().Empty(s.keeper.GetLiquidStakingDelegations(s.ctx, types.LiquidStakingProxyAcc))
+
+	res, err := s.querier.VotingPower(sdk.WrapSDKContext(s.ctx), &types.QueryVotingPowerRequest{Voter: s.delAddrs[1].String()})
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewInt(1000000), res.VotingPower.LiquidStakingVotingPower)
+	_, err = s.querier.VotingPower(sdk.WrapSDKContext(s.ctx), &types.QueryVotingPowerRequest{Voter: "invalid"})
+	s.Require().Error(err)
+}
+
+func (s *KeeperTestSuite) TestGovStakingKeeperIterateDelegations() {
+	_, valOpers, _ := s.CreateValidators([]int64{10000000, 10000000})
+	params := s.keeper.GetParams(s.ctx)
+	params.WhitelistedValidators = []types.WhitelistedValidator{
+		{ValidatorAddress: valOpers[0].String(), TargetWeight: sdk.NewInt(1)},
+		{ValidatorAddress: valOpers[1].String(), TargetWeight: sdk.NewInt(1)},
+	}
+	s.keeper.SetParams(s.ctx, params)
+	s.keeper.UpdateLiquidValidatorSet(s.ctx)
+	s.Require().NoError(s.liquidStaking(s.delAddrs[0], sdk.NewInt(2000000)))
+
+	val, found := s.app.StakingKeeper.GetValidator(s.ctx, valOpers[0])
+	s.Require().True(found)
+	_, err := s.app.StakingKeeper.Delegate(s.ctx, s.delAddrs[0], sdk.NewInt(50000), stakingtypes.Unbonded, val, true)
+	s.Require().NoError(err)
+
+	gsk := keeper.NewGovStakingKeeper(s.app.StakingKeeper, s.keeper)
+	var delegations []stakingtypes.DelegationI
+	gsk.IterateDelegations(s.ctx, s.delAddrs[0], func(index int64, delegation stakingtypes.DelegationI) bool {
+		s.Require().Equal(int64(len(delegations)), index)
+		delegations = append(delegations, delegation)
+		return false
+	})
+	// own delegation followed by the liquid staking delegations to both validators
+	s.Require().Len(delegations, 3)
+	s.Require().Equal(sdk.NewDec(50000), delegations[0].GetShares())
+	s.Require().Equal(sdk.NewDec(1000000), delegations[1].GetShares())
+	s.Require().Equal(sdk.NewDec(1000000), delegations[2].GetShares())
+	for _, delegation := range delegations {
+		s.Require().Equal(s.delAddrs[0], delegation.GetDelegatorAddr())
+	}
+
+	count := 0
+	gsk.IterateDelegations(s.ctx, s.delAddrs[0], func(int64, stakingtypes.DelegationI) bool {
+		count++
+		return count == 2
+	})
+	s.Require().Equal(2, count)
+}
+
+func (s *KeeperTestSuite) TestTallyWithLiquidStaking() {
+	valAddrs, valOpers, _ := s.CreateValidators([]int64{10000000, 10000000})
+	params := s.keeper.GetParams(s.ctx)
+	params.WhitelistedValidators = []types.WhitelistedValidator{
+		{ValidatorAddress: valOpers[0].String(), TargetWeight: sdk.NewInt(1)},
+		{ValidatorAddress: valOpers[1].String(), TargetWeight: sdk.NewInt(1)},
+	}
+	s.keeper.SetParams(s.ctx, params)
+	s.keeper.UpdateLiquidValidatorSet(s.ctx)
+	s.Require().NoError(s.liquidStaking(s.delAddrs[0], sdk.NewInt(3000000)))
+	s.Require().NoError(s.liquidStaking(s.delAddrs[1], sdk.NewInt(1000000)))
+
+	proposal, err := s.app.GovKeeper.SubmitProposal(s.ctx, nil, "")
+	s.Require().NoError(err)
+	s.app.GovKeeper.ActivateVotingPeriod(s.ctx, proposal)
+
+	s.Require().NoError(s.app.GovKeeper.AddVote(s.ctx, proposal.Id, valAddrs[0], govv1.NewNonSplitVoteOption(govv1.OptionYes), ""))
+	s.Require().NoError(s.app.GovKeeper.AddVote(s.ctx, proposal.Id, valAddrs[1], govv1.NewNonSplitVoteOption(govv1.OptionYes), ""))
+	s.Require().NoError(s.app.GovKeeper.AddVote(s.ctx, proposal.Id, s.delAddrs[0], govv1.NewNonSplitVoteOption(govv1.OptionNo), ""))
+
+	proposal, found := s.app.GovKeeper.GetProposal(s.ctx, proposal.Id)
+	s.Require().True(found)
+	_, _, tallyResult := s.app.GovKeeper.Tally(s.ctx, proposal)
+
+	// the validators keep the liquid staking voting power of the bToken holder that did not vote
+	s.Require().Equal(sdk.NewInt(3000000).String(), tallyResult.NoCount)
+	s.Require().Equal(sdk.NewInt(20000000+1000000).String(), tallyResult.YesCount)
+}
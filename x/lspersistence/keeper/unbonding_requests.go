@@ -0,0 +1,135 @@
+package keeper
+
+import (
+	"strconv"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/persistenceOne/pstake-native/v2/x/lspersistence/types"
+)
+
+// GetLastUnbondingRequestID returns the id of the last unbonding request.
+func (k Keeper) GetLastUnbondingRequestID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.LastUnbondingRequestIDKey)
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetLastUnbondingRequestID sets the id of the last unbonding request.
+func (k Keeper) SetLastUnbondingRequestID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.LastUnbondingRequestIDKey, sdk.Uint64ToBigEndian(id))
+}
+
+// SetUnbondingRequest sets the unbonding request and inserts it in the unbonding request queue.
+func (k Keeper) SetUnbondingRequest(ctx sdk.Context, req types.UnbondingRequest) {
+	store := ctx.KVStore(k.storeKey)
+	delegator := req.GetDelegator()
+	store.Set(types.GetUnbondingRequestKey(delegator, req.Id), k.cdc.MustMarshal(&req))
+	store.Set(types.GetUnbondingRequestQueueKey(req.CompletionTime, delegator, req.Id), []byte{})
+}
+
+// GetUnbondingRequest gets the unbonding request of the delegator.
+func (k Keeper) GetUnbondingRequest(ctx sdk.Context, delegator sdk.AccAddress, id uint64) (req types.UnbondingRequest, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetUnbondingRequestKey(delegator, id))
+	if bz == nil {
+		return req, false
+	}
+	k.cdc.MustUnmarshal(bz, &req)
+	return req, true
+}
+
+// DeleteUnbondingRequest deletes the unbonding request and removes it from the unbonding request queue.
+func (k Keeper) DeleteUnbondingRequest(ctx sdk.Context, req types.UnbondingRequest) {
+	store := ctx.KVStore(k.storeKey)
+	delegator := req.GetDelegator()
+	store.Delete(types.GetUnbondingRequestKey(delegator, req.Id))
+	store.Delete(types.GetUnbondingRequestQueueKey(req.CompletionTime, delegator, req.Id))
+}
+
+// GetUnbondingRequests returns the unbonding requests of the delegator.
+func (k Keeper) GetUnbondingRequests(ctx sdk.Context, delegator sdk.AccAddress) []types.UnbondingRequest {
+	return k.getUnbondingRequestsWithPrefix(ctx, types.GetUnbondingRequestsKey(delegator))
+}
+
+// GetAllUnbondingRequests returns the unbonding requests of all delegators.
+func (k Keeper) GetAllUnbondingRequests(ctx sdk.Context) []types.UnbondingRequest {
+	return k.getUnbondingRequestsWithPrefix(ctx, types.UnbondingRequestsKey)
+}
+
+func (k Keeper) getUnbondingRequestsWithPrefix(ctx sdk.Context, prefix []byte) []types.UnbondingRequest {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	reqs := []types.UnbondingRequest{}
+	for ; iterator.Valid(); iterator.Next() {
+		var req types.UnbondingRequest
+		k.cdc.MustUnmarshal(iterator.Value(), &req)
+		reqs = append(reqs, req)
+	}
+	return reqs
+}
+
+// AddUnbondingRequest records the liquid unstaking of the delegator with its unbonding delegation entries and
+// returns the id of the new unbonding request.
+func (k Keeper) AddUnbondingRequest(ctx sdk.Context, delegator sdk.AccAddress, burnedBToken sdk.Coin, amount math.Int,
+	entries []types.UnbondingRequestEntry, completionTime time.Time,
+) uint64 {
+	id := k.GetLastUnbondingRequestID(ctx) + 1
+	k.SetLastUnbondingRequestID(ctx, id)
+	k.SetUnbondingRequest(ctx, types.UnbondingRequest{
+		Id:               id,
+		DelegatorAddress: delegator.String(),
+		BurnedBtoken:     burnedBToken,
+		Amount:           amount,
+		Entries:          entries,
+		CreationHeight:   ctx.BlockHeight(),
+		CompletionTime:   completionTime,
+	})
+	return id
+}
+
+// CompleteMatureUnbondingRequests removes the unbonding requests whose completion time has been reached, their
+// unbonding delegation entries are paid out to the delegators by the staking module. The amount of the emitted
+// events is the pre-slash estimate recorded at the liquid unstaking.
+func (k Keeper) CompleteMatureUnbondingRequests(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.UnbondingRequestQueueKey, sdk.PrefixEndBytes(types.GetUnbondingRequestQueueTimeKey(ctx.BlockTime())))
+
+	// collect the mature requests first, the store can not be written while iterating
+	var matureReqs []types.UnbondingRequest
+	var staleKeys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		delegator, id := types.ParseUnbondingRequestQueueKey(iterator.Key())
+		req, found := k.GetUnbondingRequest(ctx, delegator, id)
+		if !found {
+			staleKeys = append(staleKeys, append([]byte{}, iterator.Key()...))
+			continue
+		}
+		matureReqs = append(matureReqs, req)
+	}
+	iterator.Close()
+
+	for _, key := range staleKeys {
+		store.Delete(key)
+	}
+
+	for _, req := range matureReqs {
+		k.DeleteUnbondingRequest(ctx, req)
+		ctx.EventManager().EmitEvents(sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeCompleteUnbondingRequest,
+				sdk.NewAttribute(types.AttributeKeyDelegator, req.DelegatorAddress),
+				sdk.NewAttribute(types.AttributeKeyUnbondingRequestID, strconv.FormatUint(req.Id, 10)),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, req.Amount.String()),
+			),
+		})
+	}
+}
@@ -0,0 +1,105 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/persistenceOne/pstake-native/v2/x/lspersistence/types"
+)
+
+func (s *KeeperTestSuite) TestUnbondingRequests() {
+	_, valOpers, _ := s.CreateValidators([]int64{1000000, 1000000, 1000000})
+	params := s.keeper.GetParams(s.ctx)
+	params.UnstakeFeeRate = sdk.ZeroDec()
+	params.WhitelistedValidators = []types.WhitelistedValidator{
+		{ValidatorAddress: valOpers[0].String(), TargetWeight: sdk.NewInt(10)},
+		{ValidatorAddress: valOpers[1].String(), TargetWeight: sdk.NewInt(10)},
+	}
+	s.keeper.SetParams(s.ctx, params)
+	s.keeper.UpdateLiquidValidatorSet(s.ctx)
+	s.Require().NoError(s.liquidStaking(s.delAddrs[0], sdk.NewInt(10000000)))
+	s.Require().NoError(s.liquidStaking(s.delAddrs[1], sdk.NewInt(10000000)))
+
+	ubdTime, unbondingAmt, _, _, err := s.liquidUnstakingWithResult(s.delAddrs[0], sdk.NewInt64Coin(params.LiquidBondDenom, 4000000))
+	s.Require().NoError(err)
+	s.ctx = s.ctx.WithBlockHeight(s.ctx.BlockHeight() + 1).WithBlockTime(s.ctx.BlockTime().Add(1))
+	_, _, _, _, err = s.liquidUnstakingWithResult(s.delAddrs[0], sdk.NewInt64Coin(params.LiquidBondDenom, 1000000))
+	s.Require().NoError(err)
+	_, _, _, _, err = s.liquidUnstakingWithResult(s.delAddrs[1], sdk.NewInt64Coin(params.LiquidBondDenom, 1000000))
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(3), s.keeper.GetLastUnbondingRequestID(s.ctx))
+
+	reqs := s.keeper.GetUnbondingRequests(s.ctx, s.delAddrs[0])
+	s.Require().Len(reqs, 2)
+	req := reqs[0]
+	s.Require().Equal(uint64(1), req.Id)
+	s.Require().Equal(s.delAddrs[0].String(), req.DelegatorAddress)
+	s.Require().Equal(sdk.NewInt64Coin(params.LiquidBondDenom, 4000000), req.BurnedBtoken)
+	s.Require().Equal(unbondingAmt, req.Amount)
+	s.Require().Equal(ubdTime, req.CompletionTime)
+	s.Require().Len(req.Entries, 2)
+	s.Require().NoError(req.Validate())
+	for _, entry := range req.Entries {
+		ubd, found := s.app.StakingKeeper.GetUnbondingDelegation(s.ctx, s.delAddrs[0], entry.GetValidator())
+		s.Require().True(found)
+		s.Require().Equal(entry.Amount, ubd.Entries[0].InitialBalance)
+	}
+	s.Require().Len(s.keeper.GetUnbondingRequests(s.ctx, s.delAddrs[1]), 1)
+	s.Require().Len(s.keeper.GetAllUnbondingRequests(s.ctx), 3)
+
+	res, err := s.querier.UnbondingRequests(sdk.WrapSDKContext(s.ctx), &types.QueryUnbondingRequestsRequest{
+		DelegatorAddress: s.delAddrs[0].String(),
+		Pagination:       &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(res.UnbondingRequests, 1)
+	s.Require().Equal(uint64(1), res.UnbondingRequests[0].Id)
+	s.Require().Equal(uint64(2), res.Pagination.Total)
+	_, err = s.querier.UnbondingRequests(sdk.WrapSDKContext(s.ctx), &types.QueryUnbondingRequestsRequest{DelegatorAddress: "invalid"})
+	s.Require().Error(err)
+	_, err = s.querier.UnbondingRequests(sdk.WrapSDKContext(s.ctx), nil)
+	s.Require().Error(err)
+
+	// the unbonding requests are removed once mature, the staking module pays them out, the stale queue keys are removed
+	staleQueueKey := types.GetUnbondingRequestQueueKey(ubdTime, s.delAddrs[1], 100)
+	store := s.ctx.KVStore(s.app.GetKey(types.StoreKey))
+	store.Set(staleQueueKey, []byte{})
+	s.ctx = s.ctx.WithBlockTime(ubdTime)
+	s.keeper.CompleteMatureUnbondingRequests(s.ctx)
+	s.Require().False(store.Has(staleQueueKey))
+	reqs = s.keeper.GetUnbondingRequests(s.ctx, s.delAddrs[0])
+	s.Require().Len(reqs, 1)
+	s.Require().Equal(uint64(2), reqs[0].Id)
+
+	balanceBefore := s.app.BankKeeper.GetBalance(s.ctx, s.delAddrs[1], sdk.DefaultBondDenom).Amount
+	s.completeRedelegationUnbonding()
+	s.keeper.CompleteMatureUnbondingRequests(s.ctx)
+	s.Require().Empty(s.keeper.GetAllUnbondingRequests(s.ctx))
+	balanceAfter := s.app.BankKeeper.GetBalance(s.ctx, s.delAddrs[1], sdk.DefaultBondDenom).Amount
+	s.Require().True(balanceAfter.GT(balanceBefore))
+	s.Require().Equal(uint64(3), s.keeper.GetLastUnbondingRequestID(s.ctx))
+}
+
+func (s *KeeperTestSuite) TestImportExportUnbondingRequests() {
+	_, valOpers, _ := s.CreateValidators([]int64{1000000, 1000000})
+	params := s.keeper.GetParams(s.ctx)
+	params.WhitelistedValidators = []types.WhitelistedValidator{
+		{ValidatorAddress: valOpers[0].String(), TargetWeight: sdk.NewInt(10)},
+		{ValidatorAddress: valOpers[1].String(), TargetWeight: sdk.NewInt(10)},
+	}
+	s.keeper.SetParams(s.ctx, params)
+	s.keeper.UpdateLiquidValidatorSet(s.ctx)
+	s.Require().NoError(s.liquidStaking(s.delAddrs[0], sdk.NewInt(10000000)))
+	_, _, _, _, err := s.liquidUnstakingWithResult(s.delAddrs[0], sdk.NewInt64Coin(params.LiquidBondDenom, 1000000))
+	s.Require().NoError(err)
+
+	genState := s.keeper.ExportGenesis(s.ctx)
+	s.Require().Len(genState.UnbondingRequests, 1)
+	s.Require().Equal(uint64(1), genState.LastUnbondingRequestId)
+
+	bz := s.app.AppCodec().MustMarshalJSON(genState)
+	var genState2 types.GenesisState
+	s.app.AppCodec().MustUnmarshalJSON(bz, &genState2)
+	s.keeper.InitGenesis(s.ctx, genState2)
+	s.Require().Equal(*genState, *s.keeper.ExportGenesis(s.ctx))
+}
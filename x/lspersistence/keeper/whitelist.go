@@ -0,0 +1,208 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/persistenceOne/pstake-native/v2/x/lspersistence/types"
+)
+
+// AddWhitelistedValidators adds the validators to the whitelist of the params. Each validator must be an existing
+// validator of the staking module which is neither jailed nor tombstoned, and must not be whitelisted already.
+func (k Keeper) AddWhitelistedValidators(ctx sdk.Context, authority string, vals []types.WhitelistedValidator) error {
+	if authority != k.authority {
+		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "invalid authority; expected %s, got %s", k.authority, authority)
+	}
+	params := k.GetParams(ctx)
+	whitelist, err := k.addWhitelistedValidators(ctx, params.WhitelistedValidators, vals)
+	if err != nil {
+		return err
+	}
+	params.WhitelistedValidators = whitelist
+	if err := params.Validate(); err != nil {
+		return err
+	}
+	k.SetParams(ctx, params)
+
+	for _, wv := range vals {
+		k.emitWhitelistEvent(ctx, types.EventTypeAddWhitelistedValidator, wv)
+	}
+	return nil
+}
+
+// UpdateWhitelistedValidatorWeights updates the target weights of the whitelisted validators.
+func (k Keeper) UpdateWhitelistedValidatorWeights(ctx sdk.Context, authority string, vals []types.WhitelistedValidator) error {
+	if authority != k.authority {
+		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "invalid authority; expected %s, got %s", k.authority, authority)
+	}
+	params := k.GetParams(ctx)
+	whitelist, err := updateWhitelistedValidatorWeights(params.WhitelistedValidators, vals)
+	if err != nil {
+		return err
+	}
+	params.WhitelistedValidators = whitelist
+	if err := params.Validate(); err != nil {
+		return err
+	}
+	k.SetParams(ctx, params)
+
+	for _, wv := range vals {
+		k.emitWhitelistEvent(ctx, types.EventTypeUpdateWhitelistedValidator, wv)
+	}
+	return nil
+}
+
+// RemoveWhitelistedValidators removes the validators from the whitelist of the params. The liquid tokens of the
+// removed validators are rebalanced to the remaining active liquid validators on the following blocks.
+func (k Keeper) RemoveWhitelistedValidators(ctx sdk.Context, authority string, valAddrs []string) error {
+	if authority != k.authority {
+		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "invalid authority; expected %s, got %s", k.authority, authority)
+	}
+	params := k.GetParams(ctx)
+	whitelist, err := removeWhitelistedValidators(params.WhitelistedValidators, valAddrs)
+	if err != nil {
+		return err
+	}
+	params.WhitelistedValidators = whitelist
+	if err := params.Validate(); err != nil {
+		return err
+	}
+	k.SetParams(ctx, params)
+
+	for _, valAddr := range valAddrs {
+		ctx.EventManager().EmitEvents(sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeRemoveWhitelistedValidator,
+				sdk.NewAttribute(types.AttributeKeyValidator, valAddr),
+			),
+		})
+		k.Logger(ctx).Info(types.EventTypeRemoveWhitelistedValidator, types.AttributeKeyValidator, valAddr)
+	}
+	return nil
+}
+
+// PreviewWhitelistChange applies the whitelist changes in the order of additions, weight updates and removals, and
+// returns the resulting whitelist with the redelegations the rebalancing of the next block would trigger, limited by
+// MaxRedelegationsPerBlock, and the unbondings of the inactive liquid validators following it. The rebalancing runs
+// on a cached context which is never written, with the changed whitelist.
+func (k Keeper) PreviewWhitelistChange(
+	ctx sdk.Context, addVals, updateVals []types.WhitelistedValidator, removeValAddrs []string,
+) ([]types.WhitelistedValidator, []types.Redelegation, []types.UnbondingPreview, error) {
+	params := k.GetParams(ctx)
+	whitelist, err := k.addWhitelistedValidators(ctx, params.WhitelistedValidators, addVals)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if whitelist, err = updateWhitelistedValidatorWeights(whitelist, updateVals); err != nil {
+		return nil, nil, nil, err
+	}
+	if whitelist, err = removeWhitelistedValidators(whitelist, removeValAddrs); err != nil {
+		return nil, nil, nil, err
+	}
+	params.WhitelistedValidators = whitelist
+	if err := params.Validate(); err != nil {
+		return nil, nil, nil, err
+	}
+	if k.GetPauseSwitches(ctx).RebalancingPaused {
+		return whitelist, []types.Redelegation{}, []types.UnbondingPreview{}, nil
+	}
+
+	cachedCtx, _ := ctx.CacheContext()
+	whitelistedValsMap := params.WhitelistedValsMap()
+	liquidVals := k.setWhitelistedLiquidValidators(cachedCtx, whitelist, whitelistedValsMap)
+	reds := k.Rebalance(cachedCtx, types.LiquidStakingProxyAcc, liquidVals, k.GetEffectiveWhitelistedValsMap(cachedCtx, params),
+		params.RebalancingTrigger, params.MaxRedelegationsPerBlock)
+
+	unbondings := []types.UnbondingPreview{}
+	for _, lv := range k.getInactiveLiquidValidatorsToUnbond(cachedCtx, liquidVals, whitelistedValsMap, reds,
+		params.MaxRedelegationsPerBlock) {
+		unbondings = append(unbondings, types.UnbondingPreview{
+			ValidatorAddress: lv.OperatorAddress,
+			Amount:           lv.GetLiquidTokens(cachedCtx, k.stakingKeeper, false),
+		})
+	}
+	return whitelist, reds, unbondings, nil
+}
+
+// addWhitelistedValidators returns a copy of the whitelist with the validators appended after checking them against
+// the staking module.
+func (k Keeper) addWhitelistedValidators(
+	ctx sdk.Context, whitelist, vals []types.WhitelistedValidator,
+) ([]types.WhitelistedValidator, error) {
+	whitelistedValsMap := types.GetWhitelistedValsMap(whitelist)
+	result := append([]types.WhitelistedValidator{}, whitelist...)
+	for _, wv := range vals {
+		valAddr, err := sdk.ValAddressFromBech32(wv.ValidatorAddress)
+		if err != nil {
+			return nil, errorsmod.Wrapf(types.ErrInvalidWhitelistedValidator, "invalid validator address %q: %v", wv.ValidatorAddress, err)
+		}
+		if whitelistedValsMap.IsListed(wv.ValidatorAddress) {
+			return nil, errorsmod.Wrapf(types.ErrInvalidWhitelistedValidator, "validator %s is already whitelisted", wv.ValidatorAddress)
+		}
+		if wv.TargetWeight.IsNil() || !wv.TargetWeight.IsPositive() {
+			return nil, errorsmod.Wrapf(types.ErrInvalidWhitelistedValidator, "target weight of %s must be positive", wv.ValidatorAddress)
+		}
+		val, found := k.stakingKeeper.GetValidator(ctx, valAddr)
+		if !found {
+			return nil, errorsmod.Wrapf(types.ErrInvalidWhitelistedValidator, "validator %s does not exist", wv.ValidatorAddress)
+		}
+		if !types.ActiveCondition(val, true, k.IsTombstoned(ctx, val)) {
+			return nil, errorsmod.Wrapf(types.ErrInvalidWhitelistedValidator, "validator %s is jailed, tombstoned or invalid", wv.ValidatorAddress)
+		}
+		whitelistedValsMap[wv.ValidatorAddress] = wv
+		result = append(result, wv)
+	}
+	return result, nil
+}
+
+// updateWhitelistedValidatorWeights returns a copy of the whitelist with the target weights of the validators updated.
+func updateWhitelistedValidatorWeights(whitelist, vals []types.WhitelistedValidator) ([]types.WhitelistedValidator, error) {
+	result := append([]types.WhitelistedValidator{}, whitelist...)
+	indexes := make(map[string]int, len(result))
+	for i, wv := range result {
+		indexes[wv.ValidatorAddress] = i
+	}
+	for _, wv := range vals {
+		i, ok := indexes[wv.ValidatorAddress]
+		if !ok {
+			return nil, errorsmod.Wrapf(types.ErrInvalidWhitelistedValidator, "validator %s is not whitelisted", wv.ValidatorAddress)
+		}
+		if wv.TargetWeight.IsNil() || !wv.TargetWeight.IsPositive() {
+			return nil, errorsmod.Wrapf(types.ErrInvalidWhitelistedValidator, "target weight of %s must be positive", wv.ValidatorAddress)
+		}
+		result[i].TargetWeight = wv.TargetWeight
+	}
+	return result, nil
+}
+
+// removeWhitelistedValidators returns a copy of the whitelist without the validators.
+func removeWhitelistedValidators(whitelist []types.WhitelistedValidator, valAddrs []string) ([]types.WhitelistedValidator, error) {
+	whitelistedValsMap := types.GetWhitelistedValsMap(whitelist)
+	for _, valAddr := range valAddrs {
+		if !whitelistedValsMap.IsListed(valAddr) {
+			return nil, errorsmod.Wrapf(types.ErrInvalidWhitelistedValidator, "validator %s is not whitelisted", valAddr)
+		}
+		delete(whitelistedValsMap, valAddr)
+	}
+	result := make([]types.WhitelistedValidator, 0, len(whitelistedValsMap))
+	for _, wv := range whitelist {
+		if whitelistedValsMap.IsListed(wv.ValidatorAddress) {
+			result = append(result, wv)
+		}
+	}
+	return result, nil
+}
+
+func (k Keeper) emitWhitelistEvent(ctx sdk.Context, eventType string, wv types.WhitelistedValidator) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			eventType,
+			sdk.NewAttribute(types.AttributeKeyValidator, wv.ValidatorAddress),
+			sdk.NewAttribute(types.AttributeKeyTargetWeight, wv.TargetWeight.String()),
+		),
+	})
+	k.Logger(ctx).Info(eventType,
+		types.AttributeKeyValidator, wv.ValidatorAddress,
+		types.AttributeKeyTargetWeight, wv.TargetWeight.String())
+}
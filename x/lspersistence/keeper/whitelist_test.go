@@ -0,0 +1,198 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/persistenceOne/pstake-native/v2/x/lspersistence/keeper"
+	"github.com/persistenceOne/pstake-native/v2/x/lspersistence/types"
+)
+
+func (s *KeeperTestSuite) TestWhitelistedValidatorsMsgs() {
+	_, valOpers, pks := s.CreateValidators([]int64{1000000, 1000000, 1000000, 1000000})
+	msgServer := keeper.NewMsgServerImpl(s.keeper)
+	authority := sdk.MustAccAddressFromBech32(s.keeper.GetAuthority())
+	params := s.keeper.GetParams(s.ctx)
+	params.WhitelistedValidators = []types.WhitelistedValidator{
+		{ValidatorAddress: valOpers[0].String(), TargetWeight: sdk.NewInt(10)},
+		{ValidatorAddress: valOpers[1].String(), TargetWeight: sdk.NewInt(10)},
+	}
+	s.keeper.SetParams(s.ctx, params)
+	s.keeper.UpdateLiquidValidatorSet(s.ctx)
+	added := types.WhitelistedValidator{ValidatorAddress: valOpers[2].String(), TargetWeight: sdk.NewInt(10)}
+
+	// only the authority can change the whitelist
+	_, err := msgServer.AddWhitelistedValidators(sdk.WrapSDKContext(s.ctx),
+		types.NewMsgAddWhitelistedValidators(s.delAddrs[0], []types.WhitelistedValidator{added}))
+	s.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+	_, err = msgServer.UpdateWhitelistedValidatorWeights(sdk.WrapSDKContext(s.ctx),
+		types.NewMsgUpdateWhitelistedValidatorWeights(s.delAddrs[0], params.WhitelistedValidators))
+	s.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+	_, err = msgServer.RemoveWhitelistedValidators(sdk.WrapSDKContext(s.ctx),
+		types.NewMsgRemoveWhitelistedValidators(s.delAddrs[0], []string{valOpers[0].String()}))
+	s.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	// the added validators are checked against the staking module
+	s.app.StakingKeeper.Jail(s.ctx, sdk.ConsAddress(pks[3].Address()))
+	for _, wv := range []types.WhitelistedValidator{
+		params.WhitelistedValidators[0], // already whitelisted
+		{ValidatorAddress: sdk.ValAddress(s.delAddrs[0]).String(), TargetWeight: sdk.NewInt(10)}, // not a validator
+		{ValidatorAddress: valOpers[3].String(), TargetWeight: sdk.NewInt(10)},                   // jailed
+	} {
+		_, err = msgServer.AddWhitelistedValidators(sdk.WrapSDKContext(s.ctx),
+			types.NewMsgAddWhitelistedValidators(authority, []types.WhitelistedValidator{wv}))
+		s.Require().ErrorIs(err, types.ErrInvalidWhitelistedValidator)
+	}
+	s.Require().Equal(params, s.keeper.GetParams(s.ctx))
+
+	s.ctx = s.ctx.WithEventManager(sdk.NewEventManager())
+	_, err = msgServer.AddWhitelistedValidators(sdk.WrapSDKContext(s.ctx),
+		types.NewMsgAddWhitelistedValidators(authority, []types.WhitelistedValidator{added}))
+	s.Require().NoError(err)
+	s.Require().Equal(append(params.WhitelistedValidators, added), s.keeper.GetParams(s.ctx).WhitelistedValidators)
+	s.Require().True(s.hasEvent(types.EventTypeAddWhitelistedValidator))
+
+	// the target weights of whitelisted validators only can be updated
+	updated := types.WhitelistedValidator{ValidatorAddress: valOpers[2].String(), TargetWeight: sdk.NewInt(20)}
+	_, err = msgServer.UpdateWhitelistedValidatorWeights(sdk.WrapSDKContext(s.ctx),
+		types.NewMsgUpdateWhitelistedValidatorWeights(authority, []types.WhitelistedValidator{
+			{ValidatorAddress: valOpers[3].String(), TargetWeight: sdk.NewInt(20)},
+		}))
+	s.Require().ErrorIs(err, types.ErrInvalidWhitelistedValidator)
+	_, err = msgServer.UpdateWhitelistedValidatorWeights(sdk.WrapSDKContext(s.ctx),
+		types.NewMsgUpdateWhitelistedValidatorWeights(authority, []types.WhitelistedValidator{updated}))
+	s.Require().NoError(err)
+	s.Require().Equal(updated, s.keeper.GetParams(s.ctx).WhitelistedValidators[2])
+	s.Require().True(s.hasEvent(types.EventTypeUpdateWhitelistedValidator))
+
+	// the whitelisted validators only can be removed
+	_, err = msgServer.RemoveWhitelistedValidators(sdk.WrapSDKContext(s.ctx),
+		types.NewMsgRemoveWhitelistedValidators(authority, []string{valOpers[3].String()}))
+	s.Require().ErrorIs(err, types.ErrInvalidWhitelistedValidator)
+	_, err = msgServer.RemoveWhitelistedValidators(sdk.WrapSDKContext(s.ctx),
+		types.NewMsgRemoveWhitelistedValidators(authority, []string{valOpers[0].String()}))
+	s.Require().NoError(err)
+	s.Require().Equal([]types.WhitelistedValidator{params.WhitelistedValidators[1], updated}, s.keeper.GetParams(s.ctx).WhitelistedValidators)
+	s.Require().True(s.hasEvent(types.EventTypeRemoveWhitelistedValidator))
+}
+
+func (s *KeeperTestSuite) TestWhitelistChangePreview() {
+	_, valOpers, _ := s.CreateValidators([]int64{1000000, 1000000, 1000000})
+	params := s.keeper.GetParams(s.ctx)
+	params.WhitelistedValidators = []types.WhitelistedValidator{
+		{ValidatorAddress: valOpers[0].String(), TargetWeight: sdk.NewInt(10)},
+		{ValidatorAddress: valOpers[1].String(), TargetWeight: sdk.NewInt(10)},
+	}
+	s.keeper.SetParams(s.ctx, params)
+	s.keeper.UpdateLiquidValidatorSet(s.ctx)
+	s.Require().NoError(s.liquidStaking(s.delAddrs[0], sdk.NewInt(100000000)))
+	liquidValidators := s.keeper.GetAllLiquidValidators(s.ctx)
+
+	_, err := s.querier.WhitelistChangePreview(sdk.WrapSDKContext(s.ctx), nil)
+	s.Require().Error(err)
+
+	// no redelegation without changes
+	res, err := s.querier.WhitelistChangePreview(sdk.WrapSDKContext(s.ctx), &types.QueryWhitelistChangePreviewRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(params.WhitelistedValidators, res.WhitelistedValidators)
+	s.Require().Empty(res.Redelegations)
+
+	// the invalid changes are rejected
+	_, err = s.querier.WhitelistChangePreview(sdk.WrapSDKContext(s.ctx), &types.QueryWhitelistChangePreviewRequest{
+		RemoveValidators: []string{valOpers[2].String()},
+	})
+	s.Require().Error(err)
+
+	// adding a validator and removing another one redelegates the liquid tokens to the added validator,
+	// from the removed one and the one over its target
+	res, err = s.querier.WhitelistChangePreview(sdk.WrapSDKContext(s.ctx), &types.QueryWhitelistChangePreviewRequest{
+		AddValidators:    []types.WhitelistedValidator{{ValidatorAddress: valOpers[2].String(), TargetWeight: sdk.NewInt(10)}},
+		UpdateValidators: []types.WhitelistedValidator{{ValidatorAddress: valOpers[2].String(), TargetWeight: sdk.NewInt(20)}},
+		RemoveValidators: []string{valOpers[0].String()},
+	})
+	s.Require().NoError(err)
+	s.Require().Equal([]types.WhitelistedValidator{
+		params.WhitelistedValidators[1],
+		{ValidatorAddress: valOpers[2].String(), TargetWeight: sdk.NewInt(20)},
+	}, res.WhitelistedValidators)
+	s.Require().NotEmpty(res.Redelegations)
+	s.Require().Empty(res.Unbondings)
+	for _, red := range res.Redelegations {
+		s.Require().Contains([]string{valOpers[0].String(), valOpers[1].String()}, red.SrcValidatorAddress)
+		s.Require().Equal(valOpers[2].String(), red.DstValidatorAddress)
+		s.Require().True(red.Amount.IsPositive())
+		s.Require().Empty(red.Error)
+	}
+
+	// the state is not changed by the preview
+	s.Require().Equal(params, s.keeper.GetParams(s.ctx))
+	s.Require().Equal(liquidValidators, s.keeper.GetAllLiquidValidators(s.ctx))
+	s.Require().Empty(s.app.StakingKeeper.GetAllRedelegations(s.ctx, types.LiquidStakingProxyAcc, nil, nil))
+}
+
+func (s *KeeperTestSuite) TestWhitelistChangePreviewUnbondings() {
+	_, valOpers, _ := s.CreateValidators([]int64{1000000, 1000000, 1000000})
+	params := s.keeper.GetParams(s.ctx)
+	params.MaxRedelegationsPerBlock = 1
+	params.WhitelistedValidators = []types.WhitelistedValidator{
+		{ValidatorAddress: valOpers[0].String(), TargetWeight: sdk.NewInt(10)},
+		{ValidatorAddress: valOpers[1].String(), TargetWeight: sdk.NewInt(10)},
+		{ValidatorAddress: valOpers[2].String(), TargetWeight: sdk.NewInt(10)},
+	}
+	s.keeper.SetParams(s.ctx, params)
+	s.keeper.UpdateLiquidValidatorSet(s.ctx)
+	s.Require().NoError(s.liquidStaking(s.delAddrs[0], sdk.NewInt(30000000)))
+
+	// removing two validators redelegates from one of them within the cap, the other one is not unbonded
+	res, err := s.querier.WhitelistChangePreview(sdk.WrapSDKContext(s.ctx), &types.QueryWhitelistChangePreviewRequest{
+		RemoveValidators: []string{valOpers[0].String(), valOpers[1].String()},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(res.Redelegations, 1)
+	s.Require().Empty(res.Redelegations[0].Error)
+	s.Require().Equal(valOpers[2].String(), res.Redelegations[0].DstValidatorAddress)
+	s.Require().Empty(res.Unbondings)
+
+	// removing all validators leaves no active liquid validator to redelegate to, all of them are unbonded
+	res, err = s.querier.WhitelistChangePreview(sdk.WrapSDKContext(s.ctx), &types.QueryWhitelistChangePreviewRequest{
+		RemoveValidators: []string{valOpers[0].String(), valOpers[1].String(), valOpers[2].String()},
+	})
+	s.Require().NoError(err)
+	s.Require().Empty(res.Redelegations)
+	s.Require().Len(res.Unbondings, 3)
+	for _, unbonding := range res.Unbondings {
+		s.Require().Equal(sdk.NewInt(10000000), unbonding.Amount)
+	}
+	s.Require().Empty(s.app.StakingKeeper.GetAllUnbondingDelegations(s.ctx, types.LiquidStakingProxyAcc))
+
+	// the preview matches the update of the liquid validator set
+	params.WhitelistedValidators = []types.WhitelistedValidator{}
+	s.keeper.SetParams(s.ctx, params)
+	reds := s.keeper.UpdateLiquidValidatorSet(s.ctx)
+	s.Require().Empty(reds)
+	ubds := s.app.StakingKeeper.GetAllUnbondingDelegations(s.ctx, types.LiquidStakingProxyAcc)
+	s.Require().Len(ubds, 3)
+	for i, ubd := range ubds {
+		s.Require().Equal(res.Unbondings[i].ValidatorAddress, ubd.ValidatorAddress)
+		s.Require().Equal(res.Unbondings[i].Amount, ubd.Entries[0].Balance)
+	}
+
+	// the preview is empty while the rebalancing is paused
+	s.keeper.SetPauseSwitches(s.ctx, types.PauseSwitches{RebalancingPaused: true})
+	res, err = s.querier.WhitelistChangePreview(sdk.WrapSDKContext(s.ctx), &types.QueryWhitelistChangePreviewRequest{
+		AddValidators: []types.WhitelistedValidator{{ValidatorAddress: valOpers[0].String(), TargetWeight: sdk.NewInt(10)}},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(res.WhitelistedValidators, 1)
+	s.Require().Empty(res.Redelegations)
+	s.Require().Empty(res.Unbondings)
+}
+
+func (s *KeeperTestSuite) hasEvent(eventType string) bool {
+	for _, event := range s.ctx.EventManager().Events() {
+		if event.Type == eventType {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,18 @@
+package v2
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/persistenceOne/pstake-native/v2/x/lspersistence/types"
+)
+
+// MigrateStore performs in-place store migrations from consensus version 1 to 2. The reward and rebalancing
+// triggers, formerly constants, and the max redelegations per block are added to the params with their
+// default values. The params are set one by one as the params of later versions are not in the store yet.
+func MigrateStore(ctx sdk.Context, paramSpace paramtypes.Subspace) error {
+	paramSpace.Set(ctx, types.KeyRewardTrigger, types.DefaultRewardTrigger)
+	paramSpace.Set(ctx, types.KeyRebalancingTrigger, types.DefaultRebalancingTrigger)
+	paramSpace.Set(ctx, types.KeyMaxRedelegations, types.DefaultMaxRedelegationsPerBlock)
+	return nil
+}
@@ -0,0 +1,54 @@
+package v2_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/persistenceOne/pstake-native/v2/app"
+	v2 "github.com/persistenceOne/pstake-native/v2/x/lspersistence/migrations/v2"
+	"github.com/persistenceOne/pstake-native/v2/x/lspersistence/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	encodingConfig := app.MakeEncodingConfig()
+	storeKey := sdk.NewKVStoreKey(paramtypes.StoreKey)
+	tStoreKey := sdk.NewTransientStoreKey(paramtypes.TStoreKey)
+	ctx := testutil.DefaultContext(storeKey, tStoreKey)
+	paramSpace := paramtypes.NewSubspace(encodingConfig.Marshaler, encodingConfig.Amino, storeKey, tStoreKey, types.ModuleName).
+		WithKeyTable(types.ParamKeyTable())
+
+	// the legacy params without the triggers and the max redelegations per block
+	legacyParams := types.DefaultParams()
+	legacyParams.UnstakeFeeRate = sdk.ZeroDec()
+	legacyParams.WhitelistedValidators = []types.WhitelistedValidator{
+		{ValidatorAddress: "persistencevaloper19rz0gtqf88vwk6dwz522ajpqpv5swunqm9z90m", TargetWeight: sdk.NewInt(10)},
+	}
+	paramSpace.Set(ctx, types.KeyLiquidBondDenom, legacyParams.LiquidBondDenom)
+	paramSpace.Set(ctx, types.KeyWhitelistedValidators, legacyParams.WhitelistedValidators)
+	paramSpace.Set(ctx, types.KeyUnstakeFeeRate, legacyParams.UnstakeFeeRate)
+	paramSpace.Set(ctx, types.KeyMinLiquidStakingAmount, legacyParams.MinLiquidStakingAmount)
+	require.Panics(t, func() {
+		var rewardTrigger sdk.Dec
+		paramSpace.Get(ctx, types.KeyRewardTrigger, &rewardTrigger)
+	})
+
+	require.NoError(t, v2.MigrateStore(ctx, paramSpace))
+
+	var (
+		rewardTrigger, rebalancingTrigger sdk.Dec
+		maxRedelegationsPerBlock          uint32
+		whitelistedValidators             []types.WhitelistedValidator
+	)
+	paramSpace.Get(ctx, types.KeyRewardTrigger, &rewardTrigger)
+	paramSpace.Get(ctx, types.KeyRebalancingTrigger, &rebalancingTrigger)
+	paramSpace.Get(ctx, types.KeyMaxRedelegations, &maxRedelegationsPerBlock)
+	paramSpace.Get(ctx, types.KeyWhitelistedValidators, &whitelistedValidators)
+	require.Equal(t, types.DefaultRewardTrigger, rewardTrigger)
+	require.Equal(t, types.DefaultRebalancingTrigger, rebalancingTrigger)
+	require.Equal(t, types.DefaultMaxRedelegationsPerBlock, maxRedelegationsPerBlock)
+	require.Equal(t, legacyParams.WhitelistedValidators, whitelistedValidators)
+}
@@ -0,0 +1,16 @@
+package v3
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/persistenceOne/pstake-native/v2/x/lspersistence/types"
+)
+
+// MigrateStore performs in-place store migrations from consensus version 2 to 3. The reward fee rate and
+// address are added to the params with their default values, no reward fee is charged until they are set.
+func MigrateStore(ctx sdk.Context, paramSpace paramtypes.Subspace) error {
+	paramSpace.Set(ctx, types.KeyRewardFeeRate, types.DefaultRewardFeeRate)
+	paramSpace.Set(ctx, types.KeyRewardFeeAddress, types.DefaultRewardFeeAddress)
+	return nil
+}
@@ -0,0 +1,54 @@
+package v3_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/persistenceOne/pstake-native/v2/app"
+	v3 "github.com/persistenceOne/pstake-native/v2/x/lspersistence/migrations/v3"
+	"github.com/persistenceOne/pstake-native/v2/x/lspersistence/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	encodingConfig := app.MakeEncodingConfig()
+	storeKey := sdk.NewKVStoreKey(paramtypes.StoreKey)
+	tStoreKey := sdk.NewTransientStoreKey(paramtypes.TStoreKey)
+	ctx := testutil.DefaultContext(storeKey, tStoreKey)
+	paramSpace := paramtypes.NewSubspace(encodingConfig.Marshaler, encodingConfig.Amino, storeKey, tStoreKey, types.ModuleName).
+		WithKeyTable(types.ParamKeyTable())
+
+	// the legacy params without the reward fee rate and address
+	legacyParams := types.DefaultParams()
+	legacyParams.WhitelistedValidators = []types.WhitelistedValidator{
+		{ValidatorAddress: "persistencevaloper19rz0gtqf88vwk6dwz522ajpqpv5swunqm9z90m", TargetWeight: sdk.NewInt(10)},
+	}
+	legacyParams.MaxRedelegationsPerBlock = 5
+	paramSpace.Set(ctx, types.KeyLiquidBondDenom, legacyParams.LiquidBondDenom)
+	paramSpace.Set(ctx, types.KeyWhitelistedValidators, legacyParams.WhitelistedValidators)
+	paramSpace.Set(ctx, types.KeyUnstakeFeeRate, legacyParams.UnstakeFeeRate)
+	paramSpace.Set(ctx, types.KeyMinLiquidStakingAmount, legacyParams.MinLiquidStakingAmount)
+	paramSpace.Set(ctx, types.KeyRewardTrigger, legacyParams.RewardTrigger)
+	paramSpace.Set(ctx, types.KeyRebalancingTrigger, legacyParams.RebalancingTrigger)
+	paramSpace.Set(ctx, types.KeyMaxRedelegations, legacyParams.MaxRedelegationsPerBlock)
+
+	require.NoError(t, v3.MigrateStore(ctx, paramSpace))
+
+	var (
+		rewardFeeRate            sdk.Dec
+		rewardFeeAddress         string
+		maxRedelegationsPerBlock uint32
+		whitelistedValidators    []types.WhitelistedValidator
+	)
+	paramSpace.Get(ctx, types.KeyRewardFeeRate, &rewardFeeRate)
+	paramSpace.Get(ctx, types.KeyRewardFeeAddress, &rewardFeeAddress)
+	paramSpace.Get(ctx, types.KeyMaxRedelegations, &maxRedelegationsPerBlock)
+	paramSpace.Get(ctx, types.KeyWhitelistedValidators, &whitelistedValidators)
+	require.Equal(t, types.DefaultRewardFeeRate, rewardFeeRate)
+	require.Equal(t, types.DefaultRewardFeeAddress, rewardFeeAddress)
+	require.Equal(t, legacyParams.MaxRedelegationsPerBlock, maxRedelegationsPerBlock)
+	require.Equal(t, legacyParams.WhitelistedValidators, whitelistedValidators)
+}
@@ -0,0 +1,17 @@
+package v4
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/persistenceOne/pstake-native/v2/x/lspersistence/types"
+)
+
+// MigrateStore performs in-place store migrations from consensus version 3 to 4. The instant unstake reserve
+// ratio and fee rate are added to the params with their default values, instant unstaking stays disabled until the
+// reserve ratio is set.
+func MigrateStore(ctx sdk.Context, paramSpace paramtypes.Subspace) error {
+	paramSpace.Set(ctx, types.KeyInstantUnstakeReserve, types.DefaultInstantUnstakeReserveRatio)
+	paramSpace.Set(ctx, types.KeyInstantUnstakeFeeRate, types.DefaultInstantUnstakeFeeRate)
+	return nil
+}
@@ -0,0 +1,55 @@
+package v4_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/persistenceOne/pstake-native/v2/app"
+	v4 "github.com/persistenceOne/pstake-native/v2/x/lspersistence/migrations/v4"
+	"github.com/persistenceOne/pstake-native/v2/x/lspersistence/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	encodingConfig := app.MakeEncodingConfig()
+	storeKey := sdk.NewKVStoreKey(paramtypes.StoreKey)
+	tStoreKey := sdk.NewTransientStoreKey(paramtypes.TStoreKey)
+	ctx := testutil.DefaultContext(storeKey, tStoreKey)
+	paramSpace := paramtypes.NewSubspace(encodingConfig.Marshaler, encodingConfig.Amino, storeKey, tStoreKey, types.ModuleName).
+		WithKeyTable(types.ParamKeyTable())
+
+	// the legacy params without the instant unstake reserve ratio and fee rate
+	legacyParams := types.DefaultParams()
+	legacyParams.WhitelistedValidators = []types.WhitelistedValidator{
+		{ValidatorAddress: "persistencevaloper19rz0gtqf88vwk6dwz522ajpqpv5swunqm9z90m", TargetWeight: sdk.NewInt(10)},
+	}
+	legacyParams.RewardFeeRate = sdk.NewDecWithPrec(5, 2)
+	paramSpace.Set(ctx, types.KeyLiquidBondDenom, legacyParams.LiquidBondDenom)
+	paramSpace.Set(ctx, types.KeyWhitelistedValidators, legacyParams.WhitelistedValidators)
+	paramSpace.Set(ctx, types.KeyUnstakeFeeRate, legacyParams.UnstakeFeeRate)
+	paramSpace.Set(ctx, types.KeyMinLiquidStakingAmount, legacyParams.MinLiquidStakingAmount)
+	paramSpace.Set(ctx, types.KeyRewardTrigger, legacyParams.RewardTrigger)
+	paramSpace.Set(ctx, types.KeyRebalancingTrigger, legacyParams.RebalancingTrigger)
+	paramSpace.Set(ctx, types.KeyMaxRedelegations, legacyParams.MaxRedelegationsPerBlock)
+	paramSpace.Set(ctx, types.KeyRewardFeeRate, legacyParams.RewardFeeRate)
+	paramSpace.Set(ctx, types.KeyRewardFeeAddress, legacyParams.RewardFeeAddress)
+
+	require.NoError(t, v4.MigrateStore(ctx, paramSpace))
+
+	var instantUnstakeReserveRatio, instantUnstakeFeeRate sdk.Dec
+	paramSpace.Get(ctx, types.KeyInstantUnstakeReserve, &instantUnstakeReserveRatio)
+	paramSpace.Get(ctx, types.KeyInstantUnstakeFeeRate, &instantUnstakeFeeRate)
+	require.Equal(t, types.DefaultInstantUnstakeReserveRatio, instantUnstakeReserveRatio)
+	require.Equal(t, types.DefaultInstantUnstakeFeeRate, instantUnstakeFeeRate)
+
+	// the legacy params are kept
+	var whitelistedValidators []types.WhitelistedValidator
+	var rewardFeeRate sdk.Dec
+	paramSpace.Get(ctx, types.KeyWhitelistedValidators, &whitelistedValidators)
+	paramSpace.Get(ctx, types.KeyRewardFeeRate, &rewardFeeRate)
+	require.Equal(t, legacyParams.WhitelistedValidators, whitelistedValidators)
+	require.Equal(t, legacyParams.RewardFeeRate, rewardFeeRate)
+}
@@ -0,0 +1,15 @@
+package v5
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/persistenceOne/pstake-native/v2/x/lspersistence/types"
+)
+
+// MigrateStore performs in-place store migrations from consensus version 4 to 5. The pauser address is added to the
+// params with its default value, only the governance can pause the operations until it is set.
+func MigrateStore(ctx sdk.Context, paramSpace paramtypes.Subspace) error {
+	paramSpace.Set(ctx, types.KeyPauserAddress, types.DefaultPauserAddress)
+	return nil
+}
@@ -0,0 +1,55 @@
+package v5_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/persistenceOne/pstake-native/v2/app"
+	v5 "github.com/persistenceOne/pstake-native/v2/x/lspersistence/migrations/v5"
+	"github.com/persistenceOne/pstake-native/v2/x/lspersistence/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	encodingConfig := app.MakeEncodingConfig()
+	storeKey := sdk.NewKVStoreKey(paramtypes.StoreKey)
+	tStoreKey := sdk.NewTransientStoreKey(paramtypes.TStoreKey)
+	ctx := testutil.DefaultContext(storeKey, tStoreKey)
+	paramSpace := paramtypes.NewSubspace(encodingConfig.Marshaler, encodingConfig.Amino, storeKey, tStoreKey, types.ModuleName).
+		WithKeyTable(types.ParamKeyTable())
+
+	// the legacy params without the pauser address
+	legacyParams := types.DefaultParams()
+	legacyParams.WhitelistedValidators = []types.WhitelistedValidator{
+		{ValidatorAddress: "persistencevaloper19rz0gtqf88vwk6dwz522ajpqpv5swunqm9z90m", TargetWeight: sdk.NewInt(10)},
+	}
+	legacyParams.InstantUnstakeReserveRatio = sdk.NewDecWithPrec(1, 1)
+	paramSpace.Set(ctx, types.KeyLiquidBondDenom, legacyParams.LiquidBondDenom)
+	paramSpace.Set(ctx, types.KeyWhitelistedValidators, legacyParams.WhitelistedValidators)
+	paramSpace.Set(ctx, types.KeyUnstakeFeeRate, legacyParams.UnstakeFeeRate)
+	paramSpace.Set(ctx, types.KeyMinLiquidStakingAmount, legacyParams.MinLiquidStakingAmount)
+	paramSpace.Set(ctx, types.KeyRewardTrigger, legacyParams.RewardTrigger)
+	paramSpace.Set(ctx, types.KeyRebalancingTrigger, legacyParams.RebalancingTrigger)
+	paramSpace.Set(ctx, types.KeyMaxRedelegations, legacyParams.MaxRedelegationsPerBlock)
+	paramSpace.Set(ctx, types.KeyRewardFeeRate, legacyParams.RewardFeeRate)
+	paramSpace.Set(ctx, types.KeyRewardFeeAddress, legacyParams.RewardFeeAddress)
+	paramSpace.Set(ctx, types.KeyInstantUnstakeReserve, legacyParams.InstantUnstakeReserveRatio)
+	paramSpace.Set(ctx, types.KeyInstantUnstakeFeeRate, legacyParams.InstantUnstakeFeeRate)
+
+	require.NoError(t, v5.MigrateStore(ctx, paramSpace))
+
+	var pauserAddress string
+	paramSpace.Get(ctx, types.KeyPauserAddress, &pauserAddress)
+	require.Equal(t, types.DefaultPauserAddress, pauserAddress)
+
+	// the legacy params are kept
+	var whitelistedValidators []types.WhitelistedValidator
+	var instantUnstakeReserveRatio sdk.Dec
+	paramSpace.Get(ctx, types.KeyWhitelistedValidators, &whitelistedValidators)
+	paramSpace.Get(ctx, types.KeyInstantUnstakeReserve, &instantUnstakeReserveRatio)
+	require.Equal(t, legacyParams.WhitelistedValidators, whitelistedValidators)
+	require.Equal(t, legacyParams.InstantUnstakeReserveRatio, instantUnstakeReserveRatio)
+}
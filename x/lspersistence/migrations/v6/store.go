@@ -0,0 +1,15 @@
+package v6
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/persistenceOne/pstake-native/v2/x/lspersistence/types"
+)
+
+// MigrateStore performs in-place store migrations from consensus version 5 to 6. The max preferred weight shift is
+// added to the params with its default value, preferred liquid staking is disabled until it is set.
+func MigrateStore(ctx sdk.Context, paramSpace paramtypes.Subspace) error {
+	paramSpace.Set(ctx, types.KeyMaxPreferredWeightShift, types.DefaultMaxPreferredWeightShift)
+	return nil
+}
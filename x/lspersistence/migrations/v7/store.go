@@ -0,0 +1,17 @@
+package v7
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/persistenceOne/pstake-native/v2/x/lspersistence/types"
+)
+
+// MigrateStore performs in-place store migrations from consensus version 6 to 7. The net amount state snapshot
+// interval and the max net amount state snapshots are added to the params with their default values, the states
+// history is recorded from the upgrade.
+func MigrateStore(ctx sdk.Context, paramSpace paramtypes.Subspace) error {
+	paramSpace.Set(ctx, types.KeySnapshotInterval, types.DefaultNetAmountStateSnapshotInterval)
+	paramSpace.Set(ctx, types.KeyMaxSnapshots, types.DefaultMaxNetAmountStateSnapshots)
+	return nil
+}
@@ -0,0 +1,33 @@
+package lspersistence
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+	paramproposal "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+
+	"github.com/persistenceOne/pstake-native/v2/x/lspersistence/keeper"
+	"github.com/persistenceOne/pstake-native/v2/x/lspersistence/types"
+)
+
+// NewParamChangeProposalHandler wraps the params module governance Handler, the lspersistence params changed by a
+// param change proposal are validated as a whole, so the proposal fails if the changes break a constraint between
+// the params that the validation of a single param can't check.
+func NewParamChangeProposalHandler(k keeper.Keeper, paramsHandler govtypes.Handler) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		if err := paramsHandler(ctx, content); err != nil {
+			return err
+		}
+
+		c, ok := content.(*paramproposal.ParameterChangeProposal)
+		if !ok {
+			return nil
+		}
+		for _, change := range c.Changes {
+			if change.Subspace == types.ModuleName {
+				params := k.GetParams(ctx)
+				return params.Validate()
+			}
+		}
+		return nil
+	}
+}
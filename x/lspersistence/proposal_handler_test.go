@@ -0,0 +1,85 @@
+package lspersistence_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	paramproposal "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/persistenceOne/pstake-native/v2/app"
+	"github.com/persistenceOne/pstake-native/v2/app/helpers"
+	"github.com/persistenceOne/pstake-native/v2/x/lspersistence"
+	"github.com/persistenceOne/pstake-native/v2/x/lspersistence/types"
+)
+
+type HandlerTestSuite struct {
+	suite.Suite
+
+	app        *app.PstakeApp
+	ctx        sdk.Context
+	govHandler govtypes.Handler
+}
+
+func (suite *HandlerTestSuite) SetupTest() {
+	_, pstakeApp, ctx := helpers.CreateTestApp(suite.T())
+	suite.app = &pstakeApp
+	suite.ctx = ctx
+	suite.govHandler = lspersistence.NewParamChangeProposalHandler(suite.app.LSPersistenceKeeper, params.NewParamChangeProposalHandler(suite.app.ParamsKeeper))
+}
+
+func TestHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(HandlerTestSuite))
+}
+
+func (suite *HandlerTestSuite) TestParamChangeProposal() {
+	k := suite.app.LSPersistenceKeeper
+	defaultParams := k.GetParams(suite.ctx)
+
+	testCases := []struct {
+		name        string
+		changes     []paramproposal.ParamChange
+		expectedErr string
+	}{
+		{
+			"instant unstake fee rate above the unstake fee rate",
+			[]paramproposal.ParamChange{{Subspace: types.ModuleName, Key: string(types.KeyInstantUnstakeFeeRate), Value: `"0.020000000000000000"`}},
+			"",
+		},
+		{
+			"instant unstake fee rate below the unstake fee rate",
+			[]paramproposal.ParamChange{{Subspace: types.ModuleName, Key: string(types.KeyInstantUnstakeFeeRate), Value: `"0.000500000000000000"`}},
+			"instant unstake fee rate 0.000500000000000000 must not be less than the unstake fee rate 0.001000000000000000",
+		},
+		{
+			"unstake fee rate above the instant unstake fee rate",
+			[]paramproposal.ParamChange{{Subspace: types.ModuleName, Key: string(types.KeyUnstakeFeeRate), Value: `"0.010000000000000000"`}},
+			"instant unstake fee rate 0.005000000000000000 must not be less than the unstake fee rate 0.010000000000000000",
+		},
+		{
+			"both fee rates raised together",
+			[]paramproposal.ParamChange{
+				{Subspace: types.ModuleName, Key: string(types.KeyUnstakeFeeRate), Value: `"0.010000000000000000"`},
+				{Subspace: types.ModuleName, Key: string(types.KeyInstantUnstakeFeeRate), Value: `"0.020000000000000000"`},
+			},
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			k.SetParams(ctx, defaultParams)
+			proposal := paramproposal.NewParameterChangeProposal("title", "description", tc.changes)
+			err := suite.govHandler(ctx, proposal)
+			if tc.expectedErr == "" {
+				suite.Require().NoError(err)
+				suite.Require().NoError(k.GetParams(ctx).Validate())
+			} else {
+				suite.Require().EqualError(err, tc.expectedErr)
+			}
+		})
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/kv"
 
 	"github.com/persistenceOne/pstake-native/v2/x/lspersistence/types"
@@ -21,6 +22,63 @@ func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 			cdc.MustUnmarshal(kvA.Value, &cB)
 			return fmt.Sprintf("%v\n%v", cA, cB)
 
+		case bytes.Equal(kvA.Key[:1], types.CollectedRewardFeesKey),
+			bytes.Equal(kvA.Key[:1], types.AutoWithdrawnRewardsKey):
+			var cA, cB sdk.Coin
+			cdc.MustUnmarshal(kvA.Value, &cA)
+			cdc.MustUnmarshal(kvB.Value, &cB)
+			return fmt.Sprintf("%v\n%v", cA, cB)
+
+		case bytes.Equal(kvA.Key[:1], types.UnbondingRequestsKey):
+			var cA, cB types.UnbondingRequest
+			cdc.MustUnmarshal(kvA.Value, &cA)
+			cdc.MustUnmarshal(kvB.Value, &cB)
+			return fmt.Sprintf("%v\n%v", cA, cB)
+
+		case bytes.Equal(kvA.Key[:1], types.UnbondingRequestQueueKey):
+			delegatorA, idA := types.ParseUnbondingRequestQueueKey(kvA.Key)
+			delegatorB, idB := types.ParseUnbondingRequestQueueKey(kvB.Key)
+			return fmt.Sprintf("%s %d\n%s %d", delegatorA, idA, delegatorB, idB)
+
+		case bytes.Equal(kvA.Key[:1], types.LastUnbondingRequestIDKey):
+			return fmt.Sprintf("%d\n%d", sdk.BigEndianToUint64(kvA.Value), sdk.BigEndianToUint64(kvB.Value))
+
+		case bytes.Equal(kvA.Key[:1], types.SlashingRecordsKey):
+			var cA, cB types.SlashingRecord
+			cdc.MustUnmarshal(kvA.Value, &cA)
+			cdc.MustUnmarshal(kvB.Value, &cB)
+			return fmt.Sprintf("%v\n%v", cA, cB)
+
+		case bytes.Equal(kvA.Key[:1], types.LastSlashingRecordIDKey):
+			return fmt.Sprintf("%d\n%d", sdk.BigEndianToUint64(kvA.Value), sdk.BigEndianToUint64(kvB.Value))
+
+		case bytes.Equal(kvA.Key[:1], types.PauseSwitchesKey):
+			var cA, cB types.PauseSwitches
+			cdc.MustUnmarshal(kvA.Value, &cA)
+			cdc.MustUnmarshal(kvB.Value, &cB)
+			return fmt.Sprintf("%v\n%v", cA, cB)
+
+		case bytes.Equal(kvA.Key[:1], types.DeactivationQueueKey):
+			return fmt.Sprintf("%s\n%s", sdk.ValAddress(kvA.Key[2:]), sdk.ValAddress(kvB.Key[2:]))
+
+		case bytes.Equal(kvA.Key[:1], types.PreferredStakesKey):
+			var cA, cB types.PreferredStake
+			cdc.MustUnmarshal(kvA.Value, &cA)
+			cdc.MustUnmarshal(kvB.Value, &cB)
+			return fmt.Sprintf("%v\n%v", cA, cB)
+
+		case bytes.Equal(kvA.Key[:1], types.ValidatorPreferredStakeKey):
+			var cA, cB sdk.IntProto
+			cdc.MustUnmarshal(kvA.Value, &cA)
+			cdc.MustUnmarshal(kvB.Value, &cB)
+			return fmt.Sprintf("%v\n%v", cA.Int, cB.Int)
+
+		case bytes.Equal(kvA.Key[:1], types.StatesHistoryKey):
+			var cA, cB types.NetAmountStateSnapshot
+			cdc.MustUnmarshal(kvA.Value, &cA)
+			cdc.MustUnmarshal(kvB.Value, &cB)
+			return fmt.Sprintf("%v\n%v", cA, cB)
+
 		default:
 			panic(fmt.Sprintf("invalid liquidstaking key prefix %X", kvA.Key[:1]))
 		}
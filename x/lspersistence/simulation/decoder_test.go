@@ -3,10 +3,12 @@ package simulation_test
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
 	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/kv"
 
 	"github.com/persistenceOne/pstake-native/v2/x/lspersistence/simulation"
@@ -22,9 +24,53 @@ func TestDecodeLiquidStakingStore(t *testing.T) {
 		OperatorAddress: "cosmosvaloper13w4ueuk80d3kmwk7ntlhp84fk0arlm3m9ammr5",
 	}
 
+	fee := sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)
+	delegator := sdk.AccAddress("delegator___________")
+	ubr := types.UnbondingRequest{
+		Id:               1,
+		DelegatorAddress: delegator.String(),
+		BurnedBtoken:     sdk.NewInt64Coin(types.DefaultLiquidBondDenom, 100),
+		Amount:           sdk.NewInt(100),
+		CompletionTime:   time.Unix(1_700_000_000, 0).UTC(),
+	}
+	valAddr := sdk.ValAddress("validator___________")
+	sr := types.SlashingRecord{
+		Id:               1,
+		ValidatorAddress: valAddr.String(),
+		Height:           10,
+		Time:             time.Unix(1_700_000_000, 0).UTC(),
+		SlashFraction:    sdk.NewDecWithPrec(5, 2),
+		SlashedAmount:    sdk.NewInt(5),
+		NetAmountBefore:  sdk.NewDec(100),
+		NetAmountAfter:   sdk.NewDec(95),
+	}
+	ps := types.PauseSwitches{LiquidStakePaused: true, RebalancingPaused: true}
+	pstake := types.NewPreferredStake(delegator, valAddr, sdk.NewInt(100))
+	vps := sdk.IntProto{Int: sdk.NewInt(100)}
+	snapshot := types.NetAmountStateSnapshot{
+		Height:            600,
+		Time:              time.Unix(1_700_000_000, 0).UTC(),
+		MintRate:          sdk.NewDecWithPrec(99, 2),
+		NetAmount:         sdk.NewDec(100),
+		TotalLiquidTokens: sdk.NewInt(100),
+		BtokenTotalSupply: sdk.NewInt(99),
+	}
+
 	kvPairs := kv.Pairs{
 		Pairs: []kv.Pair{
 			{Key: types.LiquidValidatorsKey, Value: cdc.Codec.MustMarshal(&tc)},
+			{Key: types.GetCollectedRewardFeesKey(sdk.DefaultBondDenom), Value: cdc.Codec.MustMarshal(&fee)},
+			{Key: types.GetUnbondingRequestKey(delegator, 1), Value: cdc.Codec.MustMarshal(&ubr)},
+			{Key: types.GetUnbondingRequestQueueKey(ubr.CompletionTime, delegator, 1), Value: []byte{}},
+			{Key: types.LastUnbondingRequestIDKey, Value: sdk.Uint64ToBigEndian(1)},
+			{Key: types.GetSlashingRecordKey(1), Value: cdc.Codec.MustMarshal(&sr)},
+			{Key: types.LastSlashingRecordIDKey, Value: sdk.Uint64ToBigEndian(1)},
+			{Key: types.GetDeactivationQueueKey(valAddr), Value: []byte{}},
+			{Key: types.PauseSwitchesKey, Value: cdc.Codec.MustMarshal(&ps)},
+			{Key: types.GetPreferredStakeKey(delegator, valAddr), Value: cdc.Codec.MustMarshal(&pstake)},
+			{Key: types.GetValidatorPreferredStakeKey(valAddr), Value: cdc.Codec.MustMarshal(&vps)},
+			{Key: types.GetNetAmountStateSnapshotKey(600), Value: cdc.Codec.MustMarshal(&snapshot)},
+			{Key: types.GetAutoWithdrawnRewardsKey(sdk.DefaultBondDenom), Value: cdc.Codec.MustMarshal(&fee)},
 			{Key: []byte{0x99}, Value: []byte{0x99}},
 		},
 	}
@@ -34,6 +80,18 @@ func TestDecodeLiquidStakingStore(t *testing.T) {
 		expectedLog string
 	}{
 		{"LiquidValidator", fmt.Sprintf("%v\n%v", tc, tc)},
+		{"CollectedRewardFees", fmt.Sprintf("%v\n%v", fee, fee)},
+		{"UnbondingRequest", fmt.Sprintf("%v\n%v", ubr, ubr)},
+		{"UnbondingRequestQueue", fmt.Sprintf("%s 1\n%s 1", delegator, delegator)},
+		{"LastUnbondingRequestID", "1\n1"},
+		{"SlashingRecord", fmt.Sprintf("%v\n%v", sr, sr)},
+		{"LastSlashingRecordID", "1\n1"},
+		{"DeactivationQueue", fmt.Sprintf("%s\n%s", valAddr, valAddr)},
+		{"PauseSwitches", fmt.Sprintf("%v\n%v", ps, ps)},
+		{"PreferredStake", fmt.Sprintf("%v\n%v", pstake, pstake)},
+		{"ValidatorPreferredStake", "100\n100"},
+		{"NetAmountStateSnapshot", fmt.Sprintf("%v\n%v", snapshot, snapshot)},
+		{"AutoWithdrawnRewards", fmt.Sprintf("%v\n%v", fee, fee)},
 		{"other", ""},
 	}
 	for i, tt := range tests {
@@ -17,10 +17,20 @@ import (
 
 // Simulation parameter constants
 const (
-	unstakeFeeRate         = "unstake_fee_rate"
-	liquidBondDenom        = "liquid_bond_denom"
-	minLiquidStakingAmount = "min_liquid_staking_amount"
-	whitelistedValidator   = "whiteliqted_validator"
+	unstakeFeeRate           = "unstake_fee_rate"
+	liquidBondDenom          = "liquid_bond_denom"
+	minLiquidStakingAmount   = "min_liquid_staking_amount"
+	whitelistedValidator     = "whiteliqted_validator"
+	rewardTrigger            = "reward_trigger"
+	rebalancingTrigger       = "rebalancing_trigger"
+	maxRedelegationsPerBlock = "max_redelegations_per_block"
+	rewardFeeRate            = "reward_fee_rate"
+	rewardFeeAddress         = "reward_fee_address"
+	instantUnstakeReserve    = "instant_unstake_reserve_ratio"
+	instantUnstakeFeeRate    = "instant_unstake_fee_rate"
+	maxPreferredWeightShift  = "max_preferred_weight_shift"
+	snapshotInterval         = "net_amount_state_snapshot_interval"
+	maxSnapshots             = "max_net_amount_state_snapshots"
 )
 
 func genUnstakeFeeRate(r *rand.Rand) sdk.Dec {
@@ -35,6 +45,49 @@ func genMinLiquidStakingAmount(r *rand.Rand) math.Int {
 	return sdk.NewInt(int64(simtypes.RandIntBetween(r, 0, 10000000)))
 }
 
+func genRewardTrigger(r *rand.Rand) sdk.Dec {
+	return simtypes.RandomDecAmount(r, sdk.NewDecWithPrec(1, 2))
+}
+
+func genRebalancingTrigger(r *rand.Rand) sdk.Dec {
+	return simtypes.RandomDecAmount(r, sdk.NewDecWithPrec(1, 2))
+}
+
+func genMaxRedelegationsPerBlock(r *rand.Rand) uint32 {
+	return uint32(simtypes.RandIntBetween(r, 1, 20))
+}
+
+func genRewardFeeRate(r *rand.Rand) sdk.Dec {
+	return simtypes.RandomDecAmount(r, sdk.NewDecWithPrec(1, 1))
+}
+
+func genRewardFeeAddress(r *rand.Rand, accs []simtypes.Account) string {
+	acc, _ := simtypes.RandomAcc(r, accs)
+	return acc.Address.String()
+}
+
+func genInstantUnstakeReserveRatio(r *rand.Rand) sdk.Dec {
+	return simtypes.RandomDecAmount(r, sdk.NewDecWithPrec(1, 1))
+}
+
+// genInstantUnstakeFeeRate is above the range of genUnstakeFeeRate, the instant unstake fee rate must not be less
+// than the unstake fee rate.
+func genInstantUnstakeFeeRate(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(1, 2).Add(simtypes.RandomDecAmount(r, sdk.NewDecWithPrec(1, 2)))
+}
+
+func genMaxPreferredWeightShift(r *rand.Rand) sdk.Dec {
+	return simtypes.RandomDecAmount(r, sdk.NewDecWithPrec(5, 1))
+}
+
+func genNetAmountStateSnapshotInterval(r *rand.Rand) uint32 {
+	return uint32(simtypes.RandIntBetween(r, 0, 100))
+}
+
+func genMaxNetAmountStateSnapshots(r *rand.Rand) uint32 {
+	return uint32(simtypes.RandIntBetween(r, 1, 100))
+}
+
 func genTargetWeight(r *rand.Rand) math.Int {
 	return sdk.NewInt(int64(simtypes.RandIntBetween(r, 1, 20)))
 }
@@ -68,6 +121,58 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { genesis.Params.WhitelistedValidators = genWhitelistedValidator(r) },
 	)
 
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, rewardTrigger, &genesis.Params.RewardTrigger, simState.Rand,
+		func(r *rand.Rand) { genesis.Params.RewardTrigger = genRewardTrigger(r) },
+	)
+
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, rebalancingTrigger, &genesis.Params.RebalancingTrigger, simState.Rand,
+		func(r *rand.Rand) { genesis.Params.RebalancingTrigger = genRebalancingTrigger(r) },
+	)
+
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, maxRedelegationsPerBlock, &genesis.Params.MaxRedelegationsPerBlock, simState.Rand,
+		func(r *rand.Rand) { genesis.Params.MaxRedelegationsPerBlock = genMaxRedelegationsPerBlock(r) },
+	)
+
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, rewardFeeRate, &genesis.Params.RewardFeeRate, simState.Rand,
+		func(r *rand.Rand) { genesis.Params.RewardFeeRate = genRewardFeeRate(r) },
+	)
+
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, rewardFeeAddress, &genesis.Params.RewardFeeAddress, simState.Rand,
+		func(r *rand.Rand) { genesis.Params.RewardFeeAddress = genRewardFeeAddress(r, simState.Accounts) },
+	)
+
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, instantUnstakeReserve, &genesis.Params.InstantUnstakeReserveRatio, simState.Rand,
+		func(r *rand.Rand) { genesis.Params.InstantUnstakeReserveRatio = genInstantUnstakeReserveRatio(r) },
+	)
+
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, instantUnstakeFeeRate, &genesis.Params.InstantUnstakeFeeRate, simState.Rand,
+		func(r *rand.Rand) { genesis.Params.InstantUnstakeFeeRate = genInstantUnstakeFeeRate(r) },
+	)
+
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, maxPreferredWeightShift, &genesis.Params.MaxPreferredWeightShift, simState.Rand,
+		func(r *rand.Rand) { genesis.Params.MaxPreferredWeightShift = genMaxPreferredWeightShift(r) },
+	)
+
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, snapshotInterval, &genesis.Params.NetAmountStateSnapshotInterval, simState.Rand,
+		func(r *rand.Rand) {
+			genesis.Params.NetAmountStateSnapshotInterval = genNetAmountStateSnapshotInterval(r)
+		},
+	)
+
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, maxSnapshots, &genesis.Params.MaxNetAmountStateSnapshots, simState.Rand,
+		func(r *rand.Rand) { genesis.Params.MaxNetAmountStateSnapshots = genMaxNetAmountStateSnapshots(r) },
+	)
+
 	bz, _ := json.MarshalIndent(&genesis, "", " ")
 	fmt.Printf("Selected randomly generated liquidstaking parameters:\n%s\n", bz)
 	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(genesis)
@@ -44,6 +44,16 @@ func TestRandomizedGenState(t *testing.T) {
 	require.Equal(t, []types.WhitelistedValidator{}, genState.Params.WhitelistedValidators)
 	require.Equal(t, sdk.MustNewDecFromStr("0.007235342144855554"), genState.Params.UnstakeFeeRate)
 	require.Equal(t, sdk.NewInt(5142676), genState.Params.MinLiquidStakingAmount)
+	require.Equal(t, sdk.MustNewDecFromStr("0.008984429640002898"), genState.Params.RewardTrigger)
+	require.Equal(t, sdk.MustNewDecFromStr("0.004273914046994164"), genState.Params.RebalancingTrigger)
+	require.Equal(t, uint32(18), genState.Params.MaxRedelegationsPerBlock)
+	require.Equal(t, sdk.MustNewDecFromStr("0.040282532373440991"), genState.Params.RewardFeeRate)
+	require.Equal(t, "persistence1670x2hxvr4js9tlax880xl4h50rekec5q4q7zh", genState.Params.RewardFeeAddress)
+	require.Equal(t, sdk.MustNewDecFromStr("0.029319411095344852"), genState.Params.InstantUnstakeReserveRatio)
+	require.Equal(t, sdk.MustNewDecFromStr("0.014133204343807479"), genState.Params.InstantUnstakeFeeRate)
+	require.Equal(t, sdk.MustNewDecFromStr("0.484342647756379547"), genState.Params.MaxPreferredWeightShift)
+	require.Equal(t, uint32(16), genState.Params.NetAmountStateSnapshotInterval)
+	require.Equal(t, uint32(35), genState.Params.MaxNetAmountStateSnapshots)
 }
 
 // TestRandomizedGenState tests abnormal scenarios of applying RandomizedGenState.
@@ -19,8 +19,9 @@ import (
 //
 //nolint:gosec
 const (
-	OpWeightMsgLiquidStake   = "op_weight_msg_liquid_stake"
-	OpWeightMsgLiquidUnstake = "op_weight_msg_liquid_unstake"
+	OpWeightMsgLiquidStake          = "op_weight_msg_liquid_stake"
+	OpWeightMsgLiquidUnstake        = "op_weight_msg_liquid_unstake"
+	OpWeightMsgInstantLiquidUnstake = "op_weight_msg_instant_liquid_unstake"
 )
 
 var (
@@ -53,6 +54,13 @@ func WeightedOperations(
 		},
 	)
 
+	var weightMsgInstantLiquidUnstake int
+	appParams.GetOrGenerate(cdc, OpWeightMsgInstantLiquidUnstake, &weightMsgInstantLiquidUnstake, nil,
+		func(_ *rand.Rand) {
+			weightMsgInstantLiquidUnstake = appparams.DefaultWeightMsgInstantLiquidUnstake
+		},
+	)
+
 	return simulation.WeightedOperations{
 		simulation.NewWeightedOperation(
 			weightMsgLiquidStake,
@@ -62,6 +70,10 @@ func WeightedOperations(
 			weightMsgLiquidUnstake,
 			SimulateMsgLiquidUnstake(ak, bk, k),
 		),
+		simulation.NewWeightedOperation(
+			weightMsgInstantLiquidUnstake,
+			SimulateMsgInstantLiquidUnstake(ak, bk, k),
+		),
 	}
 }
 
@@ -174,3 +186,47 @@ func SimulateMsgLiquidUnstake(ak types.AccountKeeper, bk types.BankKeeper, k kee
 		return simulation.GenAndDeliverTx(txCtx, Fees)
 	}
 }
+
+// SimulateMsgInstantLiquidUnstake generates a MsgInstantLiquidUnstake with random values
+func SimulateMsgInstantLiquidUnstake(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation { //nolint: interfacer
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		if !k.GetParams(ctx).InstantUnstakeReserveRatio.IsPositive() {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgInstantLiquidUnstake, "instant unstaking is disabled"), nil, nil
+		}
+
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		account := ak.GetAccount(ctx, simAccount.Address)
+		spendable := bk.SpendableCoins(ctx, account.GetAddress())
+
+		unstakingCoin := sdk.NewInt64Coin(types.DefaultLiquidBondDenom, int64(simtypes.RandIntBetween(r, 1_000_000, 10_000_000)))
+		if !spendable.AmountOf(types.DefaultLiquidBondDenom).GTE(unstakingCoin.Amount) {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgInstantLiquidUnstake, "insufficient funds"), nil, nil
+		}
+
+		// the reserve must cover the unstaking amount before the fee is deducted
+		nas := k.GetNetAmountState(ctx)
+		if unstakingCoin.Amount.GT(nas.BtokenTotalSupply) ||
+			types.BTokenToNativeToken(unstakingCoin.Amount, nas.BtokenTotalSupply, nas.NetAmount).GT(sdk.NewDecFromInt(nas.ProxyAccBalance)) {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgInstantLiquidUnstake, "insufficient instant unstake reserve"), nil, nil
+		}
+
+		msg := types.NewMsgInstantLiquidUnstake(account.GetAddress(), unstakingCoin)
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           simappparams.MakeTestEncodingConfig().TxConfig,
+			Cdc:             nil,
+			Msg:             msg,
+			MsgType:         msg.Type(),
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: spendable,
+		}
+		return simulation.GenAndDeliverTx(txCtx, Fees)
+	}
+}
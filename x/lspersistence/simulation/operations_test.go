@@ -66,6 +66,7 @@ func TestWeightedOperations(t *testing.T) {
 	}{
 		{params.DefaultWeightMsgLiquidStake, types.ModuleName, types.TypeMsgLiquidStake},
 		{params.DefaultWeightMsgLiquidUnstake, types.ModuleName, types.TypeMsgLiquidUnstake},
+		{params.DefaultWeightMsgInstantLiquidUnstake, types.ModuleName, types.TypeMsgInstantLiquidUnstake},
 	}
 
 	for i, w := range weightedOps {
@@ -45,5 +45,59 @@ func ParamChanges(r *rand.Rand) []simtypes.ParamChange {
 				return fmt.Sprintf("\"%s\"", genMinLiquidStakingAmount(r))
 			},
 		),
+
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyRewardTrigger),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%s\"", genRewardTrigger(r).String())
+			},
+		),
+
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyRebalancingTrigger),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%s\"", genRebalancingTrigger(r).String())
+			},
+		),
+
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyMaxRedelegations),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("%d", genMaxRedelegationsPerBlock(r))
+			},
+		),
+
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyRewardFeeRate),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%s\"", genRewardFeeRate(r).String())
+			},
+		),
+
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyInstantUnstakeReserve),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%s\"", genInstantUnstakeReserveRatio(r).String())
+			},
+		),
+
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyInstantUnstakeFeeRate),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%s\"", genInstantUnstakeFeeRate(r).String())
+			},
+		),
+
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyMaxPreferredWeightShift),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%s\"", genMaxPreferredWeightShift(r).String())
+			},
+		),
+
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeySnapshotInterval),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("%d", genNetAmountStateSnapshotInterval(r))
+			},
+		),
+
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyMaxSnapshots),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("%d", genMaxNetAmountStateSnapshots(r))
+			},
+		),
 	}
 }
@@ -23,10 +23,19 @@ func TestParamChanges(t *testing.T) {
 		{"lspersistence/LiquidBondDenom", "LiquidBondDenom", "\"bstake\"", "lspersistence"},
 		{"lspersistence/UnstakeFeeRate", "UnstakeFeeRate", "\"0.010000000000000000\"", "lspersistence"},
 		{"lspersistence/MinLiquidStakingAmount", "MinLiquidStakingAmount", "\"9727887\"", "lspersistence"},
+		{"lspersistence/RewardTrigger", "RewardTrigger", "\"0.003824594017559308\"", "lspersistence"},
+		{"lspersistence/RebalancingTrigger", "RebalancingTrigger", "\"0.000000000000000000\"", "lspersistence"},
+		{"lspersistence/MaxRedelegationsPerBlock", "MaxRedelegationsPerBlock", "1", "lspersistence"},
+		{"lspersistence/RewardFeeRate", "RewardFeeRate", "\"0.000000000000000000\"", "lspersistence"},
+		{"lspersistence/InstantUnstakeReserveRatio", "InstantUnstakeReserveRatio", "\"0.061360745258595679\"", "lspersistence"},
+		{"lspersistence/InstantUnstakeFeeRate", "InstantUnstakeFeeRate", "\"0.012579683278078640\"", "lspersistence"},
+		{"lspersistence/MaxPreferredWeightShift", "MaxPreferredWeightShift", "\"0.500000000000000000\"", "lspersistence"},
+		{"lspersistence/NetAmountStateSnapshotInterval", "NetAmountStateSnapshotInterval", "45", "lspersistence"},
+		{"lspersistence/MaxNetAmountStateSnapshots", "MaxNetAmountStateSnapshots", "75", "lspersistence"},
 	}
 
 	paramChanges := simulation.ParamChanges(r)
-	require.Len(t, paramChanges, 4)
+	require.Len(t, paramChanges, 13)
 
 	for i, p := range paramChanges {
 		require.Equal(t, expected[i].composedKey, p.ComposedKey())
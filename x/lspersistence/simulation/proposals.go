@@ -51,7 +51,7 @@ func ProposalContents(ak types.AccountKeeper, bk types.BankKeeper, sk types.Stak
 	}
 }
 
-// SimulateAddWhitelistValidatorsProposal generates random add whitelisted validator param change proposal content.
+// SimulateAddWhitelistValidatorsProposal generates random add whitelisted validator proposal content.
 func SimulateAddWhitelistValidatorsProposal(sk types.StakingKeeper, k keeper.Keeper) simtypes.ContentSimulatorFn {
 	return func(r *rand.Rand, ctx sdk.Context, accs []simtypes.Account) simtypes.Content {
 		params := k.GetParams(ctx)
@@ -62,13 +62,13 @@ func SimulateAddWhitelistValidatorsProposal(sk types.StakingKeeper, k keeper.Kee
 		for i := 0; i < len(vals) && len(params.WhitelistedValidators) < MaxWhitelistValidators; i++ {
 			val, _ := keeper.RandomValidator(r, sk, ctx)
 			if _, ok := wm[val.OperatorAddress]; !ok {
-				params.WhitelistedValidators = append(params.WhitelistedValidators,
-					types.WhitelistedValidator{
+				// manually execute the proposal for simulation
+				_ = k.AddWhitelistedValidators(ctx, k.GetAuthority(), []types.WhitelistedValidator{
+					{
 						ValidatorAddress: val.OperatorAddress,
 						TargetWeight:     genTargetWeight(r),
-					})
-				// manually set params for simulation
-				k.SetParams(ctx, params)
+					},
+				})
 				break
 			}
 		}
@@ -76,45 +76,30 @@ func SimulateAddWhitelistValidatorsProposal(sk types.StakingKeeper, k keeper.Kee
 	}
 }
 
-// SimulateUpdateWhitelistValidatorsProposal generates random update whitelisted validator param change proposal content.
+// SimulateUpdateWhitelistValidatorsProposal generates random update whitelisted validator weights proposal content.
 func SimulateUpdateWhitelistValidatorsProposal(sk types.StakingKeeper, k keeper.Keeper) simtypes.ContentSimulatorFn {
 	return func(r *rand.Rand, ctx sdk.Context, accs []simtypes.Account) simtypes.Content {
-		params := k.GetParams(ctx)
-
 		targetVal, found := keeper.RandomActiveLiquidValidator(r, ctx, k, sk)
 		if found {
-			for i := range params.WhitelistedValidators {
-				if params.WhitelistedValidators[i].ValidatorAddress == targetVal.OperatorAddress {
-					params.WhitelistedValidators[i].TargetWeight = genTargetWeight(r)
-					// manually set params for simulation
-					k.SetParams(ctx, params)
-					break
-				}
-			}
+			// manually execute the proposal for simulation
+			_ = k.UpdateWhitelistedValidatorWeights(ctx, k.GetAuthority(), []types.WhitelistedValidator{
+				{
+					ValidatorAddress: targetVal.OperatorAddress,
+					TargetWeight:     genTargetWeight(r),
+				},
+			})
 		}
 		return nil
 	}
 }
 
-// SimulateDeleteWhitelistValidatorsProposal generates random delete whitelisted validator param change proposal content.
+// SimulateDeleteWhitelistValidatorsProposal generates random remove whitelisted validators proposal content.
 func SimulateDeleteWhitelistValidatorsProposal(sk types.StakingKeeper, k keeper.Keeper) simtypes.ContentSimulatorFn {
 	return func(r *rand.Rand, ctx sdk.Context, accs []simtypes.Account) simtypes.Content {
-		params := k.GetParams(ctx)
-
 		targetVal, found := keeper.RandomActiveLiquidValidator(r, ctx, k, sk)
 		if found {
-			remove := func(slice []types.WhitelistedValidator, s int) []types.WhitelistedValidator {
-				return append(slice[:s], slice[s+1:]...)
-			}
-
-			for i := range params.WhitelistedValidators {
-				if params.WhitelistedValidators[i].ValidatorAddress == targetVal.OperatorAddress {
-					params.WhitelistedValidators[i].TargetWeight = genTargetWeight(r)
-					params.WhitelistedValidators = remove(params.WhitelistedValidators, i)
-					k.SetParams(ctx, params)
-					break
-				}
-			}
+			// manually execute the proposal for simulation
+			_ = k.RemoveWhitelistedValidators(ctx, k.GetAuthority(), []string{targetVal.OperatorAddress})
 		}
 		return nil
 	}
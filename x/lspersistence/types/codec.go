@@ -12,6 +12,11 @@ import (
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgLiquidStake{}, "liquidstaking/MsgLiquidStake", nil)
 	cdc.RegisterConcrete(&MsgLiquidUnstake{}, "liquidstaking/MsgLiquidUnstake", nil)
+	cdc.RegisterConcrete(&MsgInstantLiquidUnstake{}, "liquidstaking/MsgInstantLiquidUnstake", nil)
+	cdc.RegisterConcrete(&MsgUpdatePauseSwitches{}, "liquidstaking/MsgUpdatePauseSwitches", nil)
+	cdc.RegisterConcrete(&MsgAddWhitelistedValidators{}, "liquidstaking/MsgAddWhitelistedValidators", nil)
+	cdc.RegisterConcrete(&MsgUpdateWhitelistedValidatorWeights{}, "liquidstaking/MsgUpdateWhitelistedValidatorWeights", nil)
+	cdc.RegisterConcrete(&MsgRemoveWhitelistedValidators{}, "liquidstaking/MsgRemoveWhitelistedValidators", nil)
 }
 
 // RegisterInterfaces registers the x/liquidstaking interfaces types with the interface registry.
@@ -20,6 +25,11 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		(*sdk.Msg)(nil),
 		&MsgLiquidStake{},
 		&MsgLiquidUnstake{},
+		&MsgInstantLiquidUnstake{},
+		&MsgUpdatePauseSwitches{},
+		&MsgAddWhitelistedValidators{},
+		&MsgUpdateWhitelistedValidatorWeights{},
+		&MsgRemoveWhitelistedValidators{},
 	)
 }
 
@@ -4,11 +4,22 @@ package types
 const (
 	EventTypeMsgLiquidStake             = TypeMsgLiquidStake
 	EventTypeMsgLiquidUnstake           = TypeMsgLiquidUnstake
+	EventTypeMsgInstantLiquidUnstake    = TypeMsgInstantLiquidUnstake
 	EventTypeAddLiquidValidator         = "add_liquid_validator"
 	EventTypeRemoveLiquidValidator      = "remove_liquid_validator"
 	EventTypeBeginRebalancing           = "begin_rebalancing"
 	EventTypeReStake                    = "re_stake"
 	EventTypeUnbondInactiveLiquidTokens = "unbond_inactive_liquid_tokens"
+	EventTypeRewardFee                  = "reward_fee"
+	EventTypeCompleteUnbondingRequest   = "complete_unbonding_request"
+	EventTypeSlashingLoss               = "slashing_loss"
+	EventTypeDeactivateLiquidValidator  = "deactivate_liquid_validator"
+	EventTypeMsgUpdatePauseSwitches     = TypeMsgUpdatePauseSwitches
+	EventTypeAddWhitelistedValidator    = "add_whitelisted_validator"
+	EventTypeUpdateWhitelistedValidator = "update_whitelisted_validator"
+	EventTypeRemoveWhitelistedValidator = "remove_whitelisted_validator"
+	EventTypeAddPreferredStake          = "add_preferred_stake"
+	EventTypeReducePreferredStake       = "reduce_preferred_stake"
 
 	AttributeKeyDelegator             = "delegator"
 	AttributeKeyNewShares             = "new_shares"
@@ -19,6 +30,21 @@ const (
 	AttributeKeyLiquidValidator       = "liquid_validator"
 	AttributeKeyRedelegationCount     = "redelegation_count"
 	AttributeKeyRedelegationFailCount = "redelegation_fail_count"
+	AttributeKeyRecipient             = "recipient"
+	AttributeKeyUnbondingRequestID    = "unbonding_request_id"
+	AttributeKeyUnstakedAmount        = "unstaked_amount"
+	AttributeKeyInstantUnstakeFee     = "instant_unstake_fee"
+	AttributeKeySlashFraction         = "slash_fraction"
+	AttributeKeySlashedAmount         = "slashed_amount"
+	AttributeKeyNetAmountBefore       = "net_amount_before"
+	AttributeKeyNetAmountAfter        = "net_amount_after"
+	AttributeKeyAuthority             = "authority"
+	AttributeKeyLiquidStakePaused     = "liquid_stake_paused"
+	AttributeKeyLiquidUnstakePaused   = "liquid_unstake_paused"
+	AttributeKeyRebalancingPaused     = "rebalancing_paused"
+	AttributeKeyRewardRestakingPaused = "reward_restaking_paused"
+	AttributeKeyValidator             = "validator"
+	AttributeKeyTargetWeight          = "target_weight"
 
 	AttributeValueCategory = ModuleName
 )
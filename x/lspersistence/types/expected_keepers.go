@@ -21,6 +21,13 @@ type BankKeeper interface {
 	BurnCoins(ctx sdk.Context, name string, amt sdk.Coins) error
 	MintCoins(ctx sdk.Context, name string, amt sdk.Coins) error
 	SpendableCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
+	GetBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin
+}
+
+// BTokenSource reports the bTokens a voter holds outside of its wallet, such as in liquidity pool or farming
+// positions, so that they count in its liquid staking voting power
+type BTokenSource interface {
+	GetBTokenBalance(ctx sdk.Context, voter sdk.AccAddress, bTokenDenom string) math.Int
 }
 
 // AccountKeeper defines the expected account keeper
@@ -2,14 +2,28 @@ package types
 
 import (
 	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 // NewGenesisState returns new GenesisState instance.
-func NewGenesisState(params Params, liquidValidators []LiquidValidator) *GenesisState {
+func NewGenesisState(params Params, liquidValidators []LiquidValidator, collectedRewardFees sdk.Coins,
+	unbondingRequests []UnbondingRequest, lastUnbondingRequestID uint64,
+	slashingRecords []SlashingRecord, lastSlashingRecordID uint64, pauseSwitches PauseSwitches,
+	preferredStakes []PreferredStake, netAmountStateSnapshots []NetAmountStateSnapshot, autoWithdrawnRewards sdk.Coins,
+) *GenesisState {
 	return &GenesisState{
-		Params:           params,
-		LiquidValidators: liquidValidators,
+		Params:                  params,
+		LiquidValidators:        liquidValidators,
+		CollectedRewardFees:     collectedRewardFees,
+		UnbondingRequests:       unbondingRequests,
+		LastUnbondingRequestId:  lastUnbondingRequestID,
+		SlashingRecords:         slashingRecords,
+		LastSlashingRecordId:    lastSlashingRecordID,
+		PauseSwitches:           pauseSwitches,
+		PreferredStakes:         preferredStakes,
+		NetAmountStateSnapshots: netAmountStateSnapshots,
+		AutoWithdrawnRewards:    autoWithdrawnRewards,
 	}
 }
 
@@ -18,6 +32,15 @@ func DefaultGenesisState() *GenesisState {
 	return NewGenesisState(
 		DefaultParams(),
 		[]LiquidValidator{},
+		sdk.Coins{},
+		[]UnbondingRequest{},
+		0,
+		[]SlashingRecord{},
+		0,
+		PauseSwitches{},
+		[]PreferredStake{},
+		[]NetAmountStateSnapshot{},
+		sdk.Coins{},
 	)
 }
 
@@ -33,5 +56,62 @@ func ValidateGenesis(data GenesisState) error {
 				"invalid liquid validator %s: %v", lv, err)
 		}
 	}
+	if err := data.CollectedRewardFees.Validate(); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "invalid collected reward fees: %v", err)
+	}
+	if err := data.AutoWithdrawnRewards.Validate(); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "invalid auto withdrawn rewards: %v", err)
+	}
+	unbondingRequests := map[string]struct{}{}
+	for _, req := range data.UnbondingRequests {
+		if err := req.Validate(); err != nil {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		}
+		if req.Id > data.LastUnbondingRequestId {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest,
+				"unbonding request id %d is greater than the last unbonding request id %d", req.Id, data.LastUnbondingRequestId)
+		}
+		key := string(GetUnbondingRequestKey(req.GetDelegator(), req.Id))
+		if _, ok := unbondingRequests[key]; ok {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate unbonding request %d of %s", req.Id, req.DelegatorAddress)
+		}
+		unbondingRequests[key] = struct{}{}
+	}
+	slashingRecords := map[uint64]struct{}{}
+	for _, record := range data.SlashingRecords {
+		if err := record.Validate(); err != nil {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		}
+		if record.Id > data.LastSlashingRecordId {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest,
+				"slashing record id %d is greater than the last slashing record id %d", record.Id, data.LastSlashingRecordId)
+		}
+		if _, ok := slashingRecords[record.Id]; ok {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate slashing record %d", record.Id)
+		}
+		slashingRecords[record.Id] = struct{}{}
+	}
+	preferredStakes := map[string]struct{}{}
+	for _, stake := range data.PreferredStakes {
+		if err := stake.Validate(); err != nil {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		}
+		key := string(GetPreferredStakeKey(stake.GetDelegator(), stake.GetValidator()))
+		if _, ok := preferredStakes[key]; ok {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest,
+				"duplicate preferred stake of %s for %s", stake.DelegatorAddress, stake.ValidatorAddress)
+		}
+		preferredStakes[key] = struct{}{}
+	}
+	snapshots := map[int64]struct{}{}
+	for _, snapshot := range data.NetAmountStateSnapshots {
+		if err := snapshot.Validate(); err != nil {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		}
+		if _, ok := snapshots[snapshot.Height]; ok {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate net amount state snapshot %d", snapshot.Height)
+		}
+		snapshots[snapshot.Height] = struct{}{}
+	}
 	return nil
 }
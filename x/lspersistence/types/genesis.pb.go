@@ -5,6 +5,8 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -28,6 +30,25 @@ type GenesisState struct {
 	// params defines all the parameters for the liquidstaking module
 	Params           Params            `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	LiquidValidators []LiquidValidator `protobuf:"bytes,2,rep,name=liquid_validators,json=liquidValidators,proto3" json:"liquid_validators" yaml:"liquid_validators"`
+	// collected_reward_fees defines the cumulative reward fees sent to the reward fee address
+	CollectedRewardFees github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=collected_reward_fees,json=collectedRewardFees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"collected_reward_fees" yaml:"collected_reward_fees"`
+	// unbonding_requests defines the pending unbonding requests of all delegators
+	UnbondingRequests []UnbondingRequest `protobuf:"bytes,4,rep,name=unbonding_requests,json=unbondingRequests,proto3" json:"unbonding_requests" yaml:"unbonding_requests"`
+	// last_unbonding_request_id defines the id of the last unbonding request
+	LastUnbondingRequestId uint64 `protobuf:"varint,5,opt,name=last_unbonding_request_id,json=lastUnbondingRequestId,proto3" json:"last_unbonding_request_id,omitempty" yaml:"last_unbonding_request_id"`
+	// slashing_records defines the slashing history of the liquid validators
+	SlashingRecords []SlashingRecord `protobuf:"bytes,6,rep,name=slashing_records,json=slashingRecords,proto3" json:"slashing_records" yaml:"slashing_records"`
+	// last_slashing_record_id defines the id of the last slashing record
+	LastSlashingRecordId uint64 `protobuf:"varint,7,opt,name=last_slashing_record_id,json=lastSlashingRecordId,proto3" json:"last_slashing_record_id,omitempty" yaml:"last_slashing_record_id"`
+	// pause_switches defines the paused operations of the module
+	PauseSwitches PauseSwitches `protobuf:"bytes,8,opt,name=pause_switches,json=pauseSwitches,proto3" json:"pause_switches" yaml:"pause_switches"`
+	// preferred_stakes defines the preferred stakes of all delegators
+	PreferredStakes []PreferredStake `protobuf:"bytes,9,rep,name=preferred_stakes,json=preferredStakes,proto3" json:"preferred_stakes" yaml:"preferred_stakes"`
+	// net_amount_state_snapshots defines the retained states history
+	NetAmountStateSnapshots []NetAmountStateSnapshot `protobuf:"bytes,10,rep,name=net_amount_state_snapshots,json=netAmountStateSnapshots,proto3" json:"net_amount_state_snapshots" yaml:"net_amount_state_snapshots"`
+	// auto_withdrawn_rewards defines the rewards withdrawn to the proxy account on its delegation changes, not charged
+	// with the reward fee yet
+	AutoWithdrawnRewards github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,11,rep,name=auto_withdrawn_rewards,json=autoWithdrawnRewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"auto_withdrawn_rewards" yaml:"auto_withdrawn_rewards"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -72,26 +93,53 @@ func init() {
 }
 
 var fileDescriptor_7f1ffec0efd8ea86 = []byte{
-	// 302 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0x2a, 0x28, 0x2e, 0x49,
-	0xcc, 0x4e, 0xd5, 0xcf, 0x29, 0x2e, 0x48, 0x2d, 0x2a, 0xce, 0x2c, 0x2e, 0x49, 0xcd, 0x4b, 0x4e,
-	0xd5, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c,
-	0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x92, 0x81, 0xa8, 0xd5, 0x43, 0x51, 0xab, 0x07, 0x55,
-	0x2b, 0x25, 0x92, 0x9e, 0x9f, 0x9e, 0x0f, 0x56, 0xa8, 0x0f, 0x62, 0x41, 0xf4, 0x48, 0x19, 0xe0,
-	0x35, 0x3f, 0x27, 0xb3, 0xb0, 0x34, 0x33, 0x05, 0xa4, 0x22, 0x33, 0x2f, 0x1d, 0xa2, 0x43, 0xe9,
-	0x16, 0x23, 0x17, 0x8f, 0x3b, 0xc4, 0xde, 0xe0, 0x92, 0xc4, 0x92, 0x54, 0x21, 0x27, 0x2e, 0xb6,
-	0x82, 0xc4, 0xa2, 0xc4, 0xdc, 0x62, 0x09, 0x46, 0x05, 0x46, 0x0d, 0x6e, 0x23, 0x15, 0x3d, 0x7c,
-	0xee, 0xd0, 0x0b, 0x00, 0xab, 0x75, 0x62, 0x39, 0x71, 0x4f, 0x9e, 0x21, 0x08, 0xaa, 0x53, 0xa8,
-	0x86, 0x4b, 0x10, 0x62, 0x57, 0x7c, 0x59, 0x62, 0x4e, 0x66, 0x4a, 0x62, 0x49, 0x7e, 0x51, 0xb1,
-	0x04, 0x93, 0x02, 0xb3, 0x06, 0xb7, 0x91, 0x2e, 0x7e, 0xe3, 0x7c, 0xc0, 0xda, 0xc2, 0x60, 0xba,
-	0x9c, 0x14, 0x40, 0xe6, 0x7e, 0xba, 0x27, 0x2f, 0x51, 0x99, 0x98, 0x9b, 0x63, 0xa5, 0x84, 0x61,
-	0xaa, 0x52, 0x90, 0x40, 0x0e, 0xaa, 0x96, 0x62, 0x2b, 0x8e, 0x8e, 0x05, 0xf2, 0x0c, 0x2f, 0x16,
-	0xc8, 0x33, 0x38, 0xc5, 0xad, 0x78, 0x24, 0xc7, 0x78, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72,
-	0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7,
-	0x72, 0x0c, 0x51, 0x0e, 0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0x48,
-	0x6e, 0xf1, 0xcf, 0x4b, 0xd5, 0x87, 0xb8, 0x51, 0x37, 0x2f, 0xb1, 0x24, 0xb3, 0x2c, 0x55, 0xbf,
-	0xcc, 0x48, 0xbf, 0x02, 0x2d, 0x48, 0x4b, 0x2a, 0x0b, 0x52, 0x8b, 0x93, 0xd8, 0xc0, 0x61, 0x68,
-	0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0xe2, 0xec, 0x08, 0xec, 0xd7, 0x01, 0x00, 0x00,
+	// 721 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x4d, 0x4f, 0xd4, 0x5a,
+	0x18, 0x9e, 0x5e, 0xb8, 0x5c, 0x6e, 0xb9, 0xf7, 0x02, 0xbd, 0x7c, 0x14, 0x02, 0xed, 0xd0, 0xb0,
+	0x18, 0x3f, 0x68, 0x05, 0x5d, 0xb1, 0xd2, 0x9a, 0x68, 0x48, 0x8c, 0x62, 0x27, 0x6a, 0x74, 0x61,
+	0x73, 0xa6, 0x3d, 0xcc, 0x34, 0x74, 0xce, 0x29, 0x7d, 0x4f, 0x67, 0x20, 0x71, 0xe1, 0xd2, 0xa5,
+	0x3f, 0xc0, 0x44, 0xe2, 0xca, 0xf8, 0x4b, 0x58, 0xb2, 0x74, 0x35, 0x9a, 0x21, 0x31, 0xae, 0xf9,
+	0x05, 0xa6, 0xe7, 0x9c, 0xc1, 0xf9, 0x80, 0x41, 0x57, 0x33, 0xc9, 0x79, 0xbe, 0xde, 0xa7, 0x79,
+	0x5f, 0xf5, 0x6a, 0x02, 0x0c, 0xed, 0x62, 0x27, 0x86, 0x04, 0xa7, 0x10, 0x01, 0xc3, 0x24, 0xc0,
+	0x4e, 0x63, 0xbd, 0x82, 0x19, 0x5a, 0x77, 0xaa, 0x98, 0x60, 0x88, 0xc0, 0x4e, 0x52, 0xca, 0xa8,
+	0xb6, 0x24, 0xb0, 0x76, 0x0f, 0xd6, 0x96, 0xd8, 0xc5, 0x99, 0x2a, 0xad, 0x52, 0x0e, 0x74, 0xf2,
+	0x7f, 0x82, 0xb3, 0x68, 0x04, 0x14, 0xea, 0x14, 0x9c, 0x0a, 0x82, 0x9f, 0xb2, 0x01, 0x8d, 0x88,
+	0x7c, 0xbf, 0x31, 0xd4, 0x3f, 0x8e, 0xf6, 0xb2, 0x28, 0xcc, 0x11, 0x11, 0xa9, 0x0a, 0x86, 0xf5,
+	0x4d, 0x55, 0xff, 0xb9, 0x2f, 0x72, 0x95, 0x19, 0x62, 0x58, 0x73, 0xd5, 0xb1, 0x04, 0xa5, 0xa8,
+	0x0e, 0xba, 0x52, 0x54, 0x4a, 0x13, 0x1b, 0xab, 0xf6, 0xb0, 0x9c, 0xf6, 0x36, 0xc7, 0xba, 0xa3,
+	0x47, 0x2d, 0xb3, 0xe0, 0x49, 0xa6, 0xf6, 0x4a, 0x9d, 0x16, 0x5e, 0x7e, 0x03, 0xc5, 0x51, 0x88,
+	0x18, 0x4d, 0x41, 0xff, 0xa3, 0x38, 0x52, 0x9a, 0xd8, 0x58, 0x1b, 0x2e, 0xf7, 0x80, 0xd3, 0x9e,
+	0x76, 0x58, 0x6e, 0x31, 0xd7, 0x3d, 0x6d, 0x99, 0xfa, 0x01, 0xaa, 0xc7, 0x9b, 0xd6, 0x80, 0xaa,
+	0xe5, 0x4d, 0xc5, 0xbd, 0x14, 0xd0, 0xde, 0x2b, 0xea, 0x6c, 0x40, 0xe3, 0x18, 0x07, 0x0c, 0x87,
+	0x7e, 0x8a, 0x9b, 0x28, 0x0d, 0xfd, 0x1d, 0x8c, 0x41, 0x1f, 0xe1, 0x11, 0x16, 0x6c, 0xd1, 0xa2,
+	0x9d, 0xb7, 0x78, 0xe6, 0x7c, 0x97, 0x46, 0xc4, 0xdd, 0x96, 0x76, 0x4b, 0xc2, 0xee, 0x5c, 0x15,
+	0xeb, 0xd3, 0x17, 0xb3, 0x54, 0x8d, 0x58, 0x2d, 0xab, 0xd8, 0x01, 0xad, 0x3b, 0xf2, 0x93, 0x88,
+	0x9f, 0x35, 0x08, 0x77, 0x1d, 0x76, 0x90, 0x60, 0xe0, 0x82, 0xe0, 0xfd, 0x7f, 0xa6, 0xe1, 0x71,
+	0x89, 0x7b, 0x18, 0x83, 0xf6, 0x5a, 0x51, 0xb5, 0x8c, 0x54, 0x28, 0x09, 0x23, 0x52, 0xf5, 0x53,
+	0xbc, 0x97, 0x61, 0x60, 0xa0, 0x8f, 0xf2, 0x78, 0xf6, 0xf0, 0x86, 0x9e, 0x74, 0x78, 0x9e, 0xa0,
+	0xb9, 0x2b, 0x32, 0xf3, 0x82, 0xc8, 0x3c, 0xa8, 0x6b, 0x79, 0xd3, 0x59, 0x1f, 0x09, 0x34, 0x5f,
+	0x5d, 0x88, 0x11, 0x30, 0x7f, 0x00, 0xee, 0x47, 0xa1, 0xfe, 0x67, 0x51, 0x29, 0x8d, 0xba, 0xab,
+	0xa7, 0x2d, 0xb3, 0x28, 0x7b, 0xbf, 0x08, 0x6a, 0x79, 0x73, 0xf9, 0x5b, 0x7f, 0xa8, 0xad, 0x50,
+	0xdb, 0x57, 0xa7, 0x20, 0x46, 0x50, 0x13, 0xf8, 0x80, 0xa6, 0x21, 0xe8, 0x63, 0x7c, 0xc0, 0xeb,
+	0xc3, 0x07, 0x2c, 0x4b, 0x96, 0xc7, 0x49, 0xae, 0x29, 0xc7, 0x9b, 0x17, 0x49, 0xfa, 0x35, 0x2d,
+	0x6f, 0x12, 0x7a, 0x08, 0xa0, 0x3d, 0x57, 0xe7, 0x79, 0xde, 0x3e, 0x68, 0x3e, 0xd8, 0x5f, 0x7c,
+	0x30, 0xeb, 0xb4, 0x65, 0x1a, 0x5d, 0x83, 0x0d, 0x02, 0x2d, 0x6f, 0x26, 0x7f, 0xe9, 0x8d, 0xb2,
+	0x15, 0x6a, 0x7b, 0xea, 0x7f, 0x09, 0xca, 0x00, 0xfb, 0xd0, 0x8c, 0x58, 0x50, 0xc3, 0xa0, 0x8f,
+	0xf3, 0x25, 0xb9, 0x76, 0xd9, 0x92, 0x64, 0x80, 0xcb, 0x92, 0xe2, 0x2e, 0xcb, 0x89, 0x66, 0x45,
+	0x84, 0x5e, 0x41, 0xcb, 0xfb, 0x37, 0xe9, 0x46, 0xe7, 0x3d, 0x26, 0x29, 0xde, 0xc1, 0x69, 0x8a,
+	0x43, 0x9f, 0x9b, 0x80, 0xfe, 0xf7, 0xaf, 0xf4, 0xb8, 0xdd, 0x61, 0x95, 0x73, 0x50, 0x7f, 0x8f,
+	0xfd, 0x9a, 0x96, 0x37, 0x99, 0xf4, 0x10, 0x40, 0x7b, 0xa7, 0xa8, 0x8b, 0x04, 0x33, 0x1f, 0xd5,
+	0x69, 0x46, 0x58, 0x8e, 0x63, 0xd8, 0x07, 0x82, 0x12, 0xa8, 0x51, 0x06, 0xba, 0xca, 0x43, 0xdc,
+	0x1a, 0x1e, 0xe2, 0x21, 0x66, 0x77, 0x38, 0x9d, 0x1f, 0x97, 0xb2, 0x24, 0xbb, 0x57, 0x64, 0x98,
+	0x15, 0x11, 0xe6, 0x62, 0x17, 0xcb, 0x9b, 0x27, 0xe7, 0x4a, 0x80, 0xf6, 0x41, 0x51, 0xe7, 0x50,
+	0xc6, 0xa8, 0xdf, 0x8c, 0x58, 0x2d, 0x4c, 0x51, 0x93, 0xc8, 0x2d, 0x05, 0x7d, 0xe2, 0xb2, 0x3d,
+	0x7f, 0x2c, 0xfd, 0x97, 0x85, 0xff, 0xf9, 0x32, 0xbf, 0xb7, 0xe8, 0x33, 0xb9, 0xc8, 0xb3, 0x8e,
+	0x86, 0x58, 0x76, 0xd8, 0x1c, 0x7f, 0x73, 0x68, 0x16, 0xbe, 0x1f, 0x9a, 0x05, 0xf7, 0xe5, 0xc7,
+	0xb6, 0xa1, 0x1c, 0xb5, 0x0d, 0xe5, 0xb8, 0x6d, 0x28, 0x5f, 0xdb, 0x86, 0xf2, 0xf6, 0xc4, 0x28,
+	0x1c, 0x9f, 0x18, 0x85, 0xcf, 0x27, 0x46, 0xe1, 0xc5, 0xed, 0x2e, 0x9f, 0xae, 0x1e, 0x1f, 0x11,
+	0xec, 0x88, 0x7e, 0xd7, 0x08, 0x62, 0x51, 0x03, 0x3b, 0x8d, 0x0d, 0x67, 0xbf, 0xef, 0xbc, 0xf3,
+	0x14, 0x95, 0x31, 0x7e, 0xcf, 0x6f, 0xfe, 0x18, 0x00, 0xd3, 0xe8, 0xcc, 0xfb, 0x83, 0x06, 0x00,
+	0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -114,6 +162,110 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AutoWithdrawnRewards) > 0 {
+		for iNdEx := len(m.AutoWithdrawnRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AutoWithdrawnRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.NetAmountStateSnapshots) > 0 {
+		for iNdEx := len(m.NetAmountStateSnapshots) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NetAmountStateSnapshots[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.PreferredStakes) > 0 {
+		for iNdEx := len(m.PreferredStakes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PreferredStakes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	{
+		size, err := m.PauseSwitches.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if m.LastSlashingRecordId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastSlashingRecordId))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.SlashingRecords) > 0 {
+		for iNdEx := len(m.SlashingRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SlashingRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.LastUnbondingRequestId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastUnbondingRequestId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.UnbondingRequests) > 0 {
+		for iNdEx := len(m.UnbondingRequests) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UnbondingRequests[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.CollectedRewardFees) > 0 {
+		for iNdEx := len(m.CollectedRewardFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CollectedRewardFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.LiquidValidators) > 0 {
 		for iNdEx := len(m.LiquidValidators) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -166,6 +318,50 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.CollectedRewardFees) > 0 {
+		for _, e := range m.CollectedRewardFees {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.UnbondingRequests) > 0 {
+		for _, e := range m.UnbondingRequests {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.LastUnbondingRequestId != 0 {
+		n += 1 + sovGenesis(uint64(m.LastUnbondingRequestId))
+	}
+	if len(m.SlashingRecords) > 0 {
+		for _, e := range m.SlashingRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.LastSlashingRecordId != 0 {
+		n += 1 + sovGenesis(uint64(m.LastSlashingRecordId))
+	}
+	l = m.PauseSwitches.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.PreferredStakes) > 0 {
+		for _, e := range m.PreferredStakes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.NetAmountStateSnapshots) > 0 {
+		for _, e := range m.NetAmountStateSnapshots {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AutoWithdrawnRewards) > 0 {
+		for _, e := range m.AutoWithdrawnRewards {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -271,6 +467,281 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollectedRewardFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CollectedRewardFees = append(m.CollectedRewardFees, types.Coin{})
+			if err := m.CollectedRewardFees[len(m.CollectedRewardFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingRequests", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnbondingRequests = append(m.UnbondingRequests, UnbondingRequest{})
+			if err := m.UnbondingRequests[len(m.UnbondingRequests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUnbondingRequestId", wireType)
+			}
+			m.LastUnbondingRequestId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastUnbondingRequestId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashingRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SlashingRecords = append(m.SlashingRecords, SlashingRecord{})
+			if err := m.SlashingRecords[len(m.SlashingRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastSlashingRecordId", wireType)
+			}
+			m.LastSlashingRecordId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastSlashingRecordId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PauseSwitches", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PauseSwitches.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreferredStakes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreferredStakes = append(m.PreferredStakes, PreferredStake{})
+			if err := m.PreferredStakes[len(m.PreferredStakes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetAmountStateSnapshots", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NetAmountStateSnapshots = append(m.NetAmountStateSnapshots, NetAmountStateSnapshot{})
+			if err := m.NetAmountStateSnapshots[len(m.NetAmountStateSnapshots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoWithdrawnRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AutoWithdrawnRewards = append(m.AutoWithdrawnRewards, types.Coin{})
+			if err := m.AutoWithdrawnRewards[len(m.AutoWithdrawnRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -50,6 +51,132 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			"unstake fee rate must not be nil",
 		},
+		{
+			"invalid collected reward fees",
+			func(genState *types.GenesisState) {
+				genState.CollectedRewardFees = sdk.Coins{sdk.Coin{Denom: sdk.DefaultBondDenom, Amount: sdk.NewInt(-1)}}
+			},
+			"invalid collected reward fees: coin -1stake amount is not positive: invalid coins",
+		},
+		{
+			"invalid auto withdrawn rewards",
+			func(genState *types.GenesisState) {
+				genState.AutoWithdrawnRewards = sdk.Coins{sdk.Coin{Denom: sdk.DefaultBondDenom, Amount: sdk.NewInt(-1)}}
+			},
+			"invalid auto withdrawn rewards: coin -1stake amount is not positive: invalid coins",
+		},
+		{
+			"valid unbonding request",
+			func(genState *types.GenesisState) {
+				genState.UnbondingRequests = []types.UnbondingRequest{validUnbondingRequest()}
+				genState.LastUnbondingRequestId = 1
+			},
+			"",
+		},
+		{
+			"invalid unbonding request amount",
+			func(genState *types.GenesisState) {
+				req := validUnbondingRequest()
+				req.Amount = sdk.NewInt(999)
+				genState.UnbondingRequests = []types.UnbondingRequest{req}
+				genState.LastUnbondingRequestId = 1
+			},
+			"unbonding request 1 amount 999 does not match the sum of its entries 1000: invalid request",
+		},
+		{
+			"unbonding request id greater than the last id",
+			func(genState *types.GenesisState) {
+				genState.UnbondingRequests = []types.UnbondingRequest{validUnbondingRequest()}
+			},
+			"unbonding request id 1 is greater than the last unbonding request id 0: invalid request",
+		},
+		{
+			"duplicate unbonding request",
+			func(genState *types.GenesisState) {
+				genState.UnbondingRequests = []types.UnbondingRequest{validUnbondingRequest(), validUnbondingRequest()}
+				genState.LastUnbondingRequestId = 1
+			},
+			"duplicate unbonding request 1 of persistence16nf0mht68937d27cwrqqdtwv9y2alm06l5mdl9: invalid request",
+		},
+		{
+			"valid slashing record",
+			func(genState *types.GenesisState) {
+				genState.SlashingRecords = []types.SlashingRecord{validSlashingRecord()}
+				genState.LastSlashingRecordId = 1
+			},
+			"",
+		},
+		{
+			"invalid slashing record net amount",
+			func(genState *types.GenesisState) {
+				record := validSlashingRecord()
+				record.NetAmountAfter = sdk.NewDec(1001)
+				genState.SlashingRecords = []types.SlashingRecord{record}
+				genState.LastSlashingRecordId = 1
+			},
+			"slashing record 1 net amount after 1001.000000000000000000 must not exceed the net amount before 1000.000000000000000000: invalid request",
+		},
+		{
+			"slashing record id greater than the last id",
+			func(genState *types.GenesisState) {
+				genState.SlashingRecords = []types.SlashingRecord{validSlashingRecord()}
+			},
+			"slashing record id 1 is greater than the last slashing record id 0: invalid request",
+		},
+		{
+			"duplicate slashing record",
+			func(genState *types.GenesisState) {
+				genState.SlashingRecords = []types.SlashingRecord{validSlashingRecord(), validSlashingRecord()}
+				genState.LastSlashingRecordId = 1
+			},
+			"duplicate slashing record 1: invalid request",
+		},
+		{
+			"valid preferred stake",
+			func(genState *types.GenesisState) {
+				genState.PreferredStakes = []types.PreferredStake{validPreferredStake()}
+			},
+			"",
+		},
+		{
+			"invalid preferred stake amount",
+			func(genState *types.GenesisState) {
+				stake := validPreferredStake()
+				stake.Amount = sdk.ZeroInt()
+				genState.PreferredStakes = []types.PreferredStake{stake}
+			},
+			"preferred stake of persistence16nf0mht68937d27cwrqqdtwv9y2alm06l5mdl9 for persistencevaloper1qcxce9c4thzxnfmpr2dqnnlqea9ey35y9xq6nu must be positive: 0: invalid request",
+		},
+		{
+			"duplicate preferred stake",
+			func(genState *types.GenesisState) {
+				genState.PreferredStakes = []types.PreferredStake{validPreferredStake(), validPreferredStake()}
+			},
+			"duplicate preferred stake of persistence16nf0mht68937d27cwrqqdtwv9y2alm06l5mdl9 for persistencevaloper1qcxce9c4thzxnfmpr2dqnnlqea9ey35y9xq6nu: invalid request",
+		},
+		{
+			"valid net amount state snapshot",
+			func(genState *types.GenesisState) {
+				genState.NetAmountStateSnapshots = []types.NetAmountStateSnapshot{validNetAmountStateSnapshot()}
+			},
+			"",
+		},
+		{
+			"invalid net amount state snapshot btoken total supply",
+			func(genState *types.GenesisState) {
+				snapshot := validNetAmountStateSnapshot()
+				snapshot.BtokenTotalSupply = sdk.ZeroInt()
+				genState.NetAmountStateSnapshots = []types.NetAmountStateSnapshot{snapshot}
+			},
+			"net amount state snapshot 600 btoken total supply must be positive: 0: invalid request",
+		},
+		{
+			"duplicate net amount state snapshot",
+			func(genState *types.GenesisState) {
+				genState.NetAmountStateSnapshots = []types.NetAmountStateSnapshot{validNetAmountStateSnapshot(), validNetAmountStateSnapshot()}
+			},
+			"duplicate net amount state snapshot 600: invalid request",
+		},
 	} {
 		t.Run(tc.name, func(t *testing.T) {
 			genState := types.DefaultGenesisState()
@@ -63,3 +190,46 @@ func TestGenesisState_Validate(t *testing.T) {
 		})
 	}
 }
+
+func validUnbondingRequest() types.UnbondingRequest {
+	return types.UnbondingRequest{
+		Id:               1,
+		DelegatorAddress: sdk.AccAddress(crypto.AddressHash([]byte("delegator"))).String(),
+		BurnedBtoken:     sdk.NewInt64Coin("bstake", 1000),
+		Amount:           sdk.NewInt(1000),
+		Entries: []types.UnbondingRequestEntry{
+			{ValidatorAddress: sdk.ValAddress(crypto.AddressHash([]byte("validator1"))).String(), Amount: sdk.NewInt(400)},
+			{ValidatorAddress: sdk.ValAddress(crypto.AddressHash([]byte("validator2"))).String(), Amount: sdk.NewInt(600)},
+		},
+	}
+}
+
+func validSlashingRecord() types.SlashingRecord {
+	return types.SlashingRecord{
+		Id:               1,
+		ValidatorAddress: sdk.ValAddress(crypto.AddressHash([]byte("validator1"))).String(),
+		Height:           10,
+		SlashFraction:    sdk.NewDecWithPrec(5, 2),
+		SlashedAmount:    sdk.NewInt(50),
+		NetAmountBefore:  sdk.NewDec(1000),
+		NetAmountAfter:   sdk.NewDec(950),
+	}
+}
+
+func validPreferredStake() types.PreferredStake {
+	return types.NewPreferredStake(
+		sdk.AccAddress(crypto.AddressHash([]byte("delegator"))),
+		sdk.ValAddress(crypto.AddressHash([]byte("validator1"))),
+		sdk.NewInt(1000),
+	)
+}
+
+func validNetAmountStateSnapshot() types.NetAmountStateSnapshot {
+	return types.NetAmountStateSnapshot{
+		Height:            600,
+		MintRate:          sdk.NewDecWithPrec(99, 2),
+		NetAmount:         sdk.NewDec(1000),
+		TotalLiquidTokens: sdk.NewInt(1000),
+		BtokenTotalSupply: sdk.NewInt(990),
+	}
+}
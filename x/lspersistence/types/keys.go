@@ -1,6 +1,8 @@
 package types
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/address"
 )
@@ -21,7 +23,19 @@ const (
 
 var (
 	// Keys for store prefixes
-	LiquidValidatorsKey = []byte{0xc0} // prefix for each key to a liquid validator
+	LiquidValidatorsKey        = []byte{0xc0} // prefix for each key to a liquid validator
+	CollectedRewardFeesKey     = []byte{0xc1} // prefix for each key to the cumulative reward fees of a denom
+	UnbondingRequestsKey       = []byte{0xc2} // prefix for each key to an unbonding request of a delegator
+	UnbondingRequestQueueKey   = []byte{0xc3} // prefix for the timestamps in the unbonding request queue
+	LastUnbondingRequestIDKey  = []byte{0xc4} // key for the id of the last unbonding request
+	SlashingRecordsKey         = []byte{0xc5} // prefix for each key to a slashing record
+	LastSlashingRecordIDKey    = []byte{0xc6} // key for the id of the last slashing record
+	DeactivationQueueKey       = []byte{0xc7} // prefix for each key to a liquid validator pending deactivation
+	PauseSwitchesKey           = []byte{0xc8} // key for the paused operations of the module
+	PreferredStakesKey         = []byte{0xc9} // prefix for each key to a preferred stake of a delegator
+	ValidatorPreferredStakeKey = []byte{0xca} // prefix for each key to the total preferred stake of a validator
+	StatesHistoryKey           = []byte{0xcb} // prefix for each key to a net amount state snapshot
+	AutoWithdrawnRewardsKey    = []byte{0xcc} // prefix for each key to the auto withdrawn rewards of a denom
 )
 
 // GetLiquidValidatorKey creates the key for the liquid validator with address
@@ -29,3 +43,80 @@ var (
 func GetLiquidValidatorKey(operatorAddr sdk.ValAddress) []byte {
 	return append(LiquidValidatorsKey, address.MustLengthPrefix(operatorAddr)...)
 }
+
+// GetCollectedRewardFeesKey creates the key for the cumulative reward fees of the denom
+// VALUE: sdk.Coin
+func GetCollectedRewardFeesKey(denom string) []byte {
+	return append(CollectedRewardFeesKey, []byte(denom)...)
+}
+
+// GetAutoWithdrawnRewardsKey creates the key for the auto withdrawn rewards of the denom
+// VALUE: sdk.Coin
+func GetAutoWithdrawnRewardsKey(denom string) []byte {
+	return append(AutoWithdrawnRewardsKey, []byte(denom)...)
+}
+
+// GetUnbondingRequestsKey creates the prefix for the unbonding requests of the delegator
+func GetUnbondingRequestsKey(delegator sdk.AccAddress) []byte {
+	return append(UnbondingRequestsKey, address.MustLengthPrefix(delegator)...)
+}
+
+// GetUnbondingRequestKey creates the key for the unbonding request of the delegator with id
+// VALUE: lspersistence/UnbondingRequest
+func GetUnbondingRequestKey(delegator sdk.AccAddress, id uint64) []byte {
+	return append(GetUnbondingRequestsKey(delegator), sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetUnbondingRequestQueueTimeKey creates the prefix for the unbonding requests completing at the time
+func GetUnbondingRequestQueueTimeKey(completionTime time.Time) []byte {
+	return append(UnbondingRequestQueueKey, sdk.FormatTimeBytes(completionTime)...)
+}
+
+// GetUnbondingRequestQueueKey creates the key for the unbonding request of the delegator with id completing at the time
+// VALUE: none
+func GetUnbondingRequestQueueKey(completionTime time.Time, delegator sdk.AccAddress, id uint64) []byte {
+	return append(append(GetUnbondingRequestQueueTimeKey(completionTime), address.MustLengthPrefix(delegator)...), sdk.Uint64ToBigEndian(id)...)
+}
+
+// ParseUnbondingRequestQueueKey returns the delegator and id of the unbonding request of the queue key
+func ParseUnbondingRequestQueueKey(key []byte) (sdk.AccAddress, uint64) {
+	addrStart := len(UnbondingRequestQueueKey) + len(sdk.FormatTimeBytes(time.Time{}))
+	addrLen := int(key[addrStart])
+	delegator := sdk.AccAddress(key[addrStart+1 : addrStart+1+addrLen])
+	return delegator, sdk.BigEndianToUint64(key[addrStart+1+addrLen:])
+}
+
+// GetSlashingRecordKey creates the key for the slashing record with id
+// VALUE: lspersistence/SlashingRecord
+func GetSlashingRecordKey(id uint64) []byte {
+	return append(SlashingRecordsKey, sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetDeactivationQueueKey creates the key for the liquid validator pending deactivation with address
+// VALUE: []byte{}
+func GetDeactivationQueueKey(operatorAddr sdk.ValAddress) []byte {
+	return append(DeactivationQueueKey, address.MustLengthPrefix(operatorAddr)...)
+}
+
+// GetPreferredStakesKey creates the prefix for the preferred stakes of the delegator
+func GetPreferredStakesKey(delegator sdk.AccAddress) []byte {
+	return append(PreferredStakesKey, address.MustLengthPrefix(delegator)...)
+}
+
+// GetPreferredStakeKey creates the key for the preferred stake of the delegator for the validator
+// VALUE: lspersistence/PreferredStake
+func GetPreferredStakeKey(delegator sdk.AccAddress, operatorAddr sdk.ValAddress) []byte {
+	return append(GetPreferredStakesKey(delegator), address.MustLengthPrefix(operatorAddr)...)
+}
+
+// GetValidatorPreferredStakeKey creates the key for the total preferred stake of the validator
+// VALUE: sdk.IntProto
+func GetValidatorPreferredStakeKey(operatorAddr sdk.ValAddress) []byte {
+	return append(ValidatorPreferredStakeKey, address.MustLengthPrefix(operatorAddr)...)
+}
+
+// GetNetAmountStateSnapshotKey creates the key for the net amount state snapshot recorded at the height
+// VALUE: lspersistence/NetAmountStateSnapshot
+func GetNetAmountStateSnapshotKey(height int64) []byte {
+	return append(StatesHistoryKey, sdk.Uint64ToBigEndian(uint64(height))...)
+}
@@ -1,9 +1,15 @@
 package types_test
 
 import (
+	"bytes"
 	"testing"
+	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/suite"
+	"github.com/tendermint/tendermint/crypto"
+
+	"github.com/persistenceOne/pstake-native/v2/x/lspersistence/types"
 )
 
 type keysTestSuite struct {
@@ -13,3 +19,14 @@ type keysTestSuite struct {
 func TestKeysTestSuite(t *testing.T) {
 	suite.Run(t, new(keysTestSuite))
 }
+
+func (s *keysTestSuite) TestGetUnbondingRequestQueueKey() {
+	delegator := sdk.AccAddress(crypto.AddressHash([]byte("delegator")))
+	completionTime := time.Date(2022, 3, 22, 0, 0, 0, 1, time.UTC)
+
+	key := types.GetUnbondingRequestQueueKey(completionTime, delegator, 7)
+	s.Require().True(bytes.HasPrefix(key, types.GetUnbondingRequestQueueTimeKey(completionTime)))
+	parsedDelegator, id := types.ParseUnbondingRequestQueueKey(key)
+	s.Require().Equal(delegator, parsedDelegator)
+	s.Require().Equal(uint64(7), id)
+}
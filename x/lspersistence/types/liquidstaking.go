@@ -82,9 +82,11 @@ func (v LiquidValidator) GetStatus(activeCondition bool) ValidatorStatus {
 // - included on whitelist
 // - existed valid validator on staking module ( existed, not nil del shares and tokens, valid exchange rate)
 // - not tombstoned
+// - not jailed
 func ActiveCondition(validator stakingtypes.Validator, whitelisted bool, tombstoned bool) bool {
 	return whitelisted &&
 		!tombstoned &&
+		!validator.IsJailed() &&
 		// !Unspecified ==> Bonded, Unbonding, Unbonded
 		validator.GetStatus() != stakingtypes.Unspecified &&
 		!validator.GetTokens().IsNil() &&
@@ -6,20 +6,23 @@ package types
 import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
-	_ "github.com/cosmos/cosmos-sdk/types"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -72,6 +75,39 @@ type Params struct {
 	// MinLiquidStakingAmount specifies the minimum number of coins to be staked to the active liquid validators on liquid
 	// staking to minimize decimal loss and consider gas efficiency.
 	MinLiquidStakingAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=min_liquid_staking_amount,json=minLiquidStakingAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_liquid_staking_amount" yaml:"min_liquid_staking_amount"`
+	// RewardTrigger specifies the rate of the total liquid tokens that the balance and the upcoming rewards of the
+	// LiquidStakingProxyAcc must exceed for the rewards to be withdrawn and re-staked.
+	RewardTrigger github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=reward_trigger,json=rewardTrigger,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"reward_trigger" yaml:"reward_trigger"`
+	// RebalancingTrigger specifies the rate of the total liquid tokens that the largest gap between the liquid tokens
+	// of a liquid validator and its target must exceed for the liquid validators to be rebalanced.
+	RebalancingTrigger github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=rebalancing_trigger,json=rebalancingTrigger,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"rebalancing_trigger" yaml:"rebalancing_trigger"`
+	// MaxRedelegationsPerBlock specifies the maximum number of redelegations attempted by a rebalancing in a block,
+	// the remaining gaps are rebalanced in the following blocks.
+	MaxRedelegationsPerBlock uint32 `protobuf:"varint,8,opt,name=max_redelegations_per_block,json=maxRedelegationsPerBlock,proto3" json:"max_redelegations_per_block,omitempty" yaml:"max_redelegations_per_block"`
+	// RewardFeeRate specifies the fee rate charged on the rewards withdrawn by the LiquidStakingProxyAcc, the fee is sent
+	// to the RewardFeeAddress before the rewards are re-staked.
+	RewardFeeRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=reward_fee_rate,json=rewardFeeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"reward_fee_rate" yaml:"reward_fee_rate"`
+	// RewardFeeAddress specifies the bech32-encoded address receiving the reward fee, no reward fee is charged if it is
+	// empty.
+	RewardFeeAddress string `protobuf:"bytes,10,opt,name=reward_fee_address,json=rewardFeeAddress,proto3" json:"reward_fee_address,omitempty" yaml:"reward_fee_address"`
+	// InstantUnstakeReserveRatio specifies the ratio of the NetAmount kept unstaked in the LiquidStakingProxyAcc as a
+	// reserve for instant unstaking, it is refilled from the rewards and the matured unbondings instead of re-staking
+	// them. Instant unstaking is disabled if it is zero.
+	InstantUnstakeReserveRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,11,opt,name=instant_unstake_reserve_ratio,json=instantUnstakeReserveRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"instant_unstake_reserve_ratio" yaml:"instant_unstake_reserve_ratio"`
+	// InstantUnstakeFeeRate specifies the fee rate of instant unstaking, the fee remains in the reserve.
+	InstantUnstakeFeeRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,12,opt,name=instant_unstake_fee_rate,json=instantUnstakeFeeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"instant_unstake_fee_rate" yaml:"instant_unstake_fee_rate"`
+	// PauserAddress specifies the bech32-encoded address allowed to pause the operations of the module in an emergency,
+	// resuming them is left to the governance. No one but the governance can pause them if it is empty.
+	PauserAddress string `protobuf:"bytes,13,opt,name=pauser_address,json=pauserAddress,proto3" json:"pauser_address,omitempty" yaml:"pauser_address"`
+	// MaxPreferredWeightShift specifies the maximum ratio by which the preferred stakes can shift the target weight of
+	// an active liquid validator up or down. Preferred liquid staking is disabled if it is zero.
+	MaxPreferredWeightShift github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,14,opt,name=max_preferred_weight_shift,json=maxPreferredWeightShift,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_preferred_weight_shift" yaml:"max_preferred_weight_shift"`
+	// NetAmountStateSnapshotInterval specifies the block interval at which the NetAmountState is recorded to the states
+	// history. The states history is not recorded if it is zero.
+	NetAmountStateSnapshotInterval uint32 `protobuf:"varint,15,opt,name=net_amount_state_snapshot_interval,json=netAmountStateSnapshotInterval,proto3" json:"net_amount_state_snapshot_interval,omitempty" yaml:"net_amount_state_snapshot_interval"`
+	// MaxNetAmountStateSnapshots specifies the number of the latest snapshots retained in the states history, the older
+	// snapshots are pruned.
+	MaxNetAmountStateSnapshots uint32 `protobuf:"varint,16,opt,name=max_net_amount_state_snapshots,json=maxNetAmountStateSnapshots,proto3" json:"max_net_amount_state_snapshots,omitempty" yaml:"max_net_amount_state_snapshots"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -342,6 +378,384 @@ func (m *VotingPower) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_VotingPower proto.InternalMessageInfo
 
+// UnbondingRequest records the liquid unstaking of a delegator. Its unbonding delegation entries are queued to the
+// delegator in the staking module, which pays them out at the completion time, the request is then removed.
+type UnbondingRequest struct {
+	// id defines the id of the unbonding request, unique per delegator
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// delegator_address defines the bech32-encoded address of the delegator that liquid unstaked
+	DelegatorAddress string `protobuf:"bytes,2,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty" yaml:"delegator_address"`
+	// burned_btoken defines the bTokens burned by the liquid unstaking
+	BurnedBtoken types.Coin `protobuf:"bytes,3,opt,name=burned_btoken,json=burnedBtoken,proto3" json:"burned_btoken" yaml:"burned_btoken"`
+	// amount defines the total native token amount unbonding at the liquid unstaking, it is a pre-slash estimate as the
+	// slashing of a liquid validator during the unbonding period reduces the amount paid out by the staking module
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+	// entries define the native token amount unbonding from each liquid validator at the liquid unstaking, they are
+	// not updated on the slashing of the liquid validators
+	Entries []UnbondingRequestEntry `protobuf:"bytes,5,rep,name=entries,proto3" json:"entries"`
+	// creation_height defines the height at which the liquid unstaking took place
+	CreationHeight int64 `protobuf:"varint,6,opt,name=creation_height,json=creationHeight,proto3" json:"creation_height,omitempty" yaml:"creation_height"`
+	// completion_time defines the time at which the unbonding delegation entries are paid out
+	CompletionTime time.Time `protobuf:"bytes,7,opt,name=completion_time,json=completionTime,proto3,stdtime" json:"completion_time" yaml:"completion_time"`
+}
+
+func (m *UnbondingRequest) Reset()         { *m = UnbondingRequest{} }
+func (m *UnbondingRequest) String() string { return proto.CompactTextString(m) }
+func (*UnbondingRequest) ProtoMessage()    {}
+func (*UnbondingRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_729ca6a6bfc9e5d3, []int{6}
+}
+func (m *UnbondingRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UnbondingRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UnbondingRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UnbondingRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnbondingRequest.Merge(m, src)
+}
+func (m *UnbondingRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *UnbondingRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnbondingRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnbondingRequest proto.InternalMessageInfo
+
+// UnbondingRequestEntry defines the native token amount unbonding from a liquid validator for an unbonding request.
+type UnbondingRequestEntry struct {
+	// validator_address defines the bech32-encoded address of the liquid validator
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty" yaml:"validator_address"`
+	// amount defines the native token amount unbonding from the liquid validator
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+}
+
+func (m *UnbondingRequestEntry) Reset()         { *m = UnbondingRequestEntry{} }
+func (m *UnbondingRequestEntry) String() string { return proto.CompactTextString(m) }
+func (*UnbondingRequestEntry) ProtoMessage()    {}
+func (*UnbondingRequestEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_729ca6a6bfc9e5d3, []int{7}
+}
+func (m *UnbondingRequestEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UnbondingRequestEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UnbondingRequestEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UnbondingRequestEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnbondingRequestEntry.Merge(m, src)
+}
+func (m *UnbondingRequestEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *UnbondingRequestEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnbondingRequestEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnbondingRequestEntry proto.InternalMessageInfo
+
+// SlashingRecord records a slashing of a liquid validator and the loss of the liquid tokens delegated to it by the
+// LiquidStakingProxyAcc.
+type SlashingRecord struct {
+	// id defines the id of the slashing record
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// validator_address defines the bech32-encoded address of the slashed liquid validator
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty" yaml:"validator_address"`
+	// height defines the height at which the liquid validator was slashed
+	Height int64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	// time defines the block time at which the liquid validator was slashed
+	Time time.Time `protobuf:"bytes,4,opt,name=time,proto3,stdtime" json:"time"`
+	// slash_fraction defines the fraction of the bonded tokens of the liquid validator slashed
+	SlashFraction github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=slash_fraction,json=slashFraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction" yaml:"slash_fraction"`
+	// slashed_amount defines the liquid tokens of the LiquidStakingProxyAcc lost by the slashing
+	SlashedAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=slashed_amount,json=slashedAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"slashed_amount" yaml:"slashed_amount"`
+	// net_amount_before defines the NetAmount before the slashing
+	NetAmountBefore github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=net_amount_before,json=netAmountBefore,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"net_amount_before" yaml:"net_amount_before"`
+	// net_amount_after defines the NetAmount after the slashing
+	NetAmountAfter github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=net_amount_after,json=netAmountAfter,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"net_amount_after" yaml:"net_amount_after"`
+}
+
+func (m *SlashingRecord) Reset()         { *m = SlashingRecord{} }
+func (m *SlashingRecord) String() string { return proto.CompactTextString(m) }
+func (*SlashingRecord) ProtoMessage()    {}
+func (*SlashingRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_729ca6a6bfc9e5d3, []int{8}
+}
+func (m *SlashingRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SlashingRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SlashingRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SlashingRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SlashingRecord.Merge(m, src)
+}
+func (m *SlashingRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *SlashingRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_SlashingRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SlashingRecord proto.InternalMessageInfo
+
+// PauseSwitches defines the operations of the module paused by the governance or the pauser.
+type PauseSwitches struct {
+	// liquid_stake_paused defines whether MsgLiquidStake is paused
+	LiquidStakePaused bool `protobuf:"varint,1,opt,name=liquid_stake_paused,json=liquidStakePaused,proto3" json:"liquid_stake_paused,omitempty" yaml:"liquid_stake_paused"`
+	// liquid_unstake_paused defines whether MsgLiquidUnstake and MsgInstantLiquidUnstake are paused
+	LiquidUnstakePaused bool `protobuf:"varint,2,opt,name=liquid_unstake_paused,json=liquidUnstakePaused,proto3" json:"liquid_unstake_paused,omitempty" yaml:"liquid_unstake_paused"`
+	// rebalancing_paused defines whether the redelegations and unbondings of the liquid validator set updates are paused
+	RebalancingPaused bool `protobuf:"varint,3,opt,name=rebalancing_paused,json=rebalancingPaused,proto3" json:"rebalancing_paused,omitempty" yaml:"rebalancing_paused"`
+	// reward_restaking_paused defines whether the reward withdrawal and re-staking is paused
+	RewardRestakingPaused bool `protobuf:"varint,4,opt,name=reward_restaking_paused,json=rewardRestakingPaused,proto3" json:"reward_restaking_paused,omitempty" yaml:"reward_restaking_paused"`
+}
+
+func (m *PauseSwitches) Reset()         { *m = PauseSwitches{} }
+func (m *PauseSwitches) String() string { return proto.CompactTextString(m) }
+func (*PauseSwitches) ProtoMessage()    {}
+func (*PauseSwitches) Descriptor() ([]byte, []int) {
+	return fileDescriptor_729ca6a6bfc9e5d3, []int{9}
+}
+func (m *PauseSwitches) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PauseSwitches) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PauseSwitches.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PauseSwitches) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PauseSwitches.Merge(m, src)
+}
+func (m *PauseSwitches) XXX_Size() int {
+	return m.Size()
+}
+func (m *PauseSwitches) XXX_DiscardUnknown() {
+	xxx_messageInfo_PauseSwitches.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PauseSwitches proto.InternalMessageInfo
+
+// PreferredValidator defines a whitelisted validator preferred by a liquid staker with its weight among the preferred
+// validators.
+type PreferredValidator struct {
+	// validator_address defines the bech32-encoded address of the preferred validator
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty" yaml:"validator_address"`
+	// weight specifies the share of the liquid staking preferred for the validator, relative to the other preferred
+	// validators
+	Weight github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=weight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"weight" yaml:"weight"`
+}
+
+func (m *PreferredValidator) Reset()         { *m = PreferredValidator{} }
+func (m *PreferredValidator) String() string { return proto.CompactTextString(m) }
+func (*PreferredValidator) ProtoMessage()    {}
+func (*PreferredValidator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_729ca6a6bfc9e5d3, []int{10}
+}
+func (m *PreferredValidator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PreferredValidator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PreferredValidator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PreferredValidator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PreferredValidator.Merge(m, src)
+}
+func (m *PreferredValidator) XXX_Size() int {
+	return m.Size()
+}
+func (m *PreferredValidator) XXX_DiscardUnknown() {
+	xxx_messageInfo_PreferredValidator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PreferredValidator proto.InternalMessageInfo
+
+// PreferredStake defines the bToken amount of a delegator preferred for a validator, which shifts the effective
+// target weight of the validator.
+type PreferredStake struct {
+	// delegator_address defines the bech32-encoded address of the liquid staker
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty" yaml:"delegator_address"`
+	// validator_address defines the bech32-encoded address of the preferred validator
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty" yaml:"validator_address"`
+	// amount specifies the preferred bToken amount
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount" yaml:"amount"`
+}
+
+func (m *PreferredStake) Reset()         { *m = PreferredStake{} }
+func (m *PreferredStake) String() string { return proto.CompactTextString(m) }
+func (*PreferredStake) ProtoMessage()    {}
+func (*PreferredStake) Descriptor() ([]byte, []int) {
+	return fileDescriptor_729ca6a6bfc9e5d3, []int{11}
+}
+func (m *PreferredStake) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PreferredStake) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PreferredStake.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PreferredStake) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PreferredStake.Merge(m, src)
+}
+func (m *PreferredStake) XXX_Size() int {
+	return m.Size()
+}
+func (m *PreferredStake) XXX_DiscardUnknown() {
+	xxx_messageInfo_PreferredStake.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PreferredStake proto.InternalMessageInfo
+
+// EffectiveWeight defines the target weight of an active liquid validator shifted by the preferred stakes.
+type EffectiveWeight struct {
+	// validator_address defines the bech32-encoded address of the liquid validator
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty" yaml:"validator_address"`
+	// target_weight specifies the target weight of the whitelisted validator
+	TargetWeight github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=target_weight,json=targetWeight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"target_weight" yaml:"target_weight"`
+	// preferred_stake specifies the total bToken amount preferred for the validator
+	PreferredStake github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=preferred_stake,json=preferredStake,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"preferred_stake" yaml:"preferred_stake"`
+	// target_ratio specifies the ratio of the active liquid tokens targeted by the target weight
+	TargetRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=target_ratio,json=targetRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"target_ratio" yaml:"target_ratio"`
+	// effective_ratio specifies the ratio of the active liquid tokens targeted after the preferred stakes shift
+	EffectiveRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=effective_ratio,json=effectiveRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"effective_ratio" yaml:"effective_ratio"`
+}
+
+func (m *EffectiveWeight) Reset()         { *m = EffectiveWeight{} }
+func (m *EffectiveWeight) String() string { return proto.CompactTextString(m) }
+func (*EffectiveWeight) ProtoMessage()    {}
+func (*EffectiveWeight) Descriptor() ([]byte, []int) {
+	return fileDescriptor_729ca6a6bfc9e5d3, []int{12}
+}
+func (m *EffectiveWeight) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EffectiveWeight) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EffectiveWeight.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EffectiveWeight) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EffectiveWeight.Merge(m, src)
+}
+func (m *EffectiveWeight) XXX_Size() int {
+	return m.Size()
+}
+func (m *EffectiveWeight) XXX_DiscardUnknown() {
+	xxx_messageInfo_EffectiveWeight.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EffectiveWeight proto.InternalMessageInfo
+
+// NetAmountStateSnapshot defines the mint rate and the amounts of the NetAmountState recorded at a height, which prices
+// the bToken over time.
+type NetAmountStateSnapshot struct {
+	// height defines the height at which the snapshot was recorded
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// time defines the block time at which the snapshot was recorded
+	Time time.Time `protobuf:"bytes,2,opt,name=time,proto3,stdtime" json:"time"`
+	// mint_rate is bTokenTotalSupply / NetAmount
+	MintRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=mint_rate,json=mintRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"mint_rate" yaml:"mint_rate"`
+	// net_amount defines the NetAmount of the NetAmountState
+	NetAmount github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=net_amount,json=netAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"net_amount" yaml:"net_amount"`
+	// total_liquid_tokens defines the TotalLiquidTokens of the NetAmountState
+	TotalLiquidTokens github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=total_liquid_tokens,json=totalLiquidTokens,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total_liquid_tokens" yaml:"total_liquid_tokens"`
+	// btoken_total_supply defines the BtokenTotalSupply of the NetAmountState
+	BtokenTotalSupply github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=btoken_total_supply,json=btokenTotalSupply,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"btoken_total_supply" yaml:"btoken_total_supply"`
+}
+
+func (m *NetAmountStateSnapshot) Reset()         { *m = NetAmountStateSnapshot{} }
+func (m *NetAmountStateSnapshot) String() string { return proto.CompactTextString(m) }
+func (*NetAmountStateSnapshot) ProtoMessage()    {}
+func (*NetAmountStateSnapshot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_729ca6a6bfc9e5d3, []int{13}
+}
+func (m *NetAmountStateSnapshot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NetAmountStateSnapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NetAmountStateSnapshot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NetAmountStateSnapshot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NetAmountStateSnapshot.Merge(m, src)
+}
+func (m *NetAmountStateSnapshot) XXX_Size() int {
+	return m.Size()
+}
+func (m *NetAmountStateSnapshot) XXX_DiscardUnknown() {
+	xxx_messageInfo_NetAmountStateSnapshot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NetAmountStateSnapshot proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterEnum("pstake.lspersistence.v1beta1.ValidatorStatus", ValidatorStatus_name, ValidatorStatus_value)
 	proto.RegisterType((*Params)(nil), "pstake.lspersistence.v1beta1.Params")
@@ -350,6 +764,14 @@ func init() {
 	proto.RegisterType((*LiquidValidatorState)(nil), "pstake.lspersistence.v1beta1.LiquidValidatorState")
 	proto.RegisterType((*NetAmountState)(nil), "pstake.lspersistence.v1beta1.NetAmountState")
 	proto.RegisterType((*VotingPower)(nil), "pstake.lspersistence.v1beta1.VotingPower")
+	proto.RegisterType((*UnbondingRequest)(nil), "pstake.lspersistence.v1beta1.UnbondingRequest")
+	proto.RegisterType((*UnbondingRequestEntry)(nil), "pstake.lspersistence.v1beta1.UnbondingRequestEntry")
+	proto.RegisterType((*SlashingRecord)(nil), "pstake.lspersistence.v1beta1.SlashingRecord")
+	proto.RegisterType((*PauseSwitches)(nil), "pstake.lspersistence.v1beta1.PauseSwitches")
+	proto.RegisterType((*PreferredValidator)(nil), "pstake.lspersistence.v1beta1.PreferredValidator")
+	proto.RegisterType((*PreferredStake)(nil), "pstake.lspersistence.v1beta1.PreferredStake")
+	proto.RegisterType((*EffectiveWeight)(nil), "pstake.lspersistence.v1beta1.EffectiveWeight")
+	proto.RegisterType((*NetAmountStateSnapshot)(nil), "pstake.lspersistence.v1beta1.NetAmountStateSnapshot")
 }
 
 func init() {
@@ -357,74 +779,144 @@ func init() {
 }
 
 var fileDescriptor_729ca6a6bfc9e5d3 = []byte{
-	// 1070 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x4d, 0x6b, 0x1b, 0x47,
-	0x18, 0xd6, 0x5a, 0x8e, 0x6a, 0x4f, 0x6a, 0x5b, 0x9e, 0xc8, 0xf6, 0x5a, 0x76, 0x25, 0x21, 0x68,
-	0x09, 0x05, 0x6b, 0x1b, 0x17, 0x7a, 0x30, 0x39, 0x44, 0x8e, 0x6c, 0x2a, 0x70, 0x1d, 0xb3, 0x92,
-	0x6d, 0x9a, 0x1e, 0xb6, 0xa3, 0xdd, 0xb1, 0xbc, 0x78, 0x77, 0x66, 0xbb, 0x33, 0x92, 0x23, 0x7a,
-	0x69, 0x6f, 0xc1, 0xa7, 0xf4, 0x56, 0x28, 0x86, 0x40, 0xff, 0x42, 0x7f, 0x44, 0x2e, 0x85, 0xd0,
-	0x43, 0x29, 0x3d, 0x88, 0x62, 0x5f, 0x7a, 0xe8, 0xc9, 0xbf, 0xa0, 0xec, 0xcc, 0xea, 0x6b, 0xa5,
-	0x06, 0x44, 0xf7, 0x24, 0xcd, 0xcc, 0xfb, 0x3e, 0xcf, 0xfb, 0xbc, 0x1f, 0x33, 0x0b, 0x3e, 0xf1,
-	0x18, 0x47, 0x17, 0x58, 0x73, 0x98, 0x87, 0x7d, 0x66, 0x33, 0x8e, 0x89, 0x89, 0xb5, 0xf6, 0xa3,
-	0x06, 0xe6, 0xe8, 0x91, 0xe6, 0xd8, 0xdf, 0xb4, 0x6c, 0x2b, 0xb0, 0xb0, 0x49, 0xb3, 0xe4, 0xf9,
-	0x94, 0x53, 0xb8, 0x29, 0x3d, 0x4a, 0x23, 0x1e, 0xa5, 0xd0, 0x23, 0x9b, 0x69, 0xd2, 0x26, 0x15,
-	0x86, 0x5a, 0xf0, 0x4f, 0xfa, 0x64, 0xd7, 0x4d, 0xca, 0x5c, 0xca, 0x0c, 0x79, 0x20, 0x17, 0xe1,
-	0x51, 0x4e, 0xae, 0xb4, 0x06, 0x62, 0x03, 0x5e, 0x93, 0xda, 0x24, 0x3c, 0xcf, 0x37, 0x29, 0x6d,
-	0x3a, 0x58, 0x13, 0xab, 0x46, 0xeb, 0x4c, 0xe3, 0xb6, 0x8b, 0x19, 0x47, 0xae, 0x27, 0x0d, 0x8a,
-	0x3f, 0xcc, 0x82, 0xd4, 0x11, 0xf2, 0x91, 0xcb, 0xe0, 0xe7, 0x60, 0x59, 0x46, 0x6c, 0x34, 0x28,
-	0xb1, 0x0c, 0x0b, 0x13, 0xea, 0xaa, 0x4a, 0x41, 0x79, 0x38, 0xbf, 0xbb, 0x79, 0xd7, 0xcd, 0xab,
-	0x1d, 0xe4, 0x3a, 0x3b, 0xc5, 0x31, 0x93, 0xa2, 0xbe, 0x24, 0xf7, 0x76, 0x29, 0xb1, 0x2a, 0xc1,
-	0x0e, 0x7c, 0xa5, 0x80, 0xd5, 0xcb, 0x73, 0x9b, 0x63, 0x27, 0x10, 0x68, 0x19, 0x6d, 0xe4, 0xd8,
-	0x16, 0xe2, 0xd4, 0x67, 0xea, 0x4c, 0x21, 0xf9, 0xf0, 0xfe, 0xf6, 0x76, 0xe9, 0x5d, 0x69, 0x28,
-	0x9d, 0x0e, 0x7c, 0x4f, 0x7a, 0xae, 0xbb, 0x1f, 0xbe, 0xe9, 0xe6, 0x13, 0x77, 0xdd, 0xfc, 0x07,
-	0x32, 0x8e, 0xc9, 0xf8, 0x45, 0x7d, 0xe5, 0x72, 0x82, 0x33, 0x83, 0xdf, 0x2b, 0x20, 0xdd, 0x22,
-	0x82, 0xd4, 0x38, 0xc3, 0xd8, 0xf0, 0x11, 0xc7, 0x6a, 0x52, 0x88, 0x3b, 0x0d, 0x80, 0xff, 0xec,
-	0xe6, 0x3f, 0x6a, 0xda, 0xfc, 0xbc, 0xd5, 0x28, 0x99, 0xd4, 0x0d, 0x93, 0x1c, 0xfe, 0x6c, 0x31,
-	0xeb, 0x42, 0xe3, 0x1d, 0x0f, 0xb3, 0x52, 0x05, 0x9b, 0x77, 0xdd, 0xfc, 0x9a, 0x0c, 0x21, 0x8a,
-	0x57, 0xfc, 0xed, 0x97, 0x2d, 0x10, 0x96, 0xa7, 0x82, 0x4d, 0x7d, 0x31, 0x34, 0xd8, 0xc7, 0x58,
-	0x47, 0x1c, 0xc3, 0x9f, 0x14, 0xb0, 0xee, 0xda, 0xc4, 0x08, 0x53, 0x18, 0x36, 0x86, 0x81, 0x5c,
-	0xda, 0x22, 0x5c, 0xbd, 0x27, 0x82, 0xf9, 0x7a, 0x8a, 0x60, 0xaa, 0x84, 0xdf, 0x75, 0xf3, 0x05,
-	0x19, 0xcc, 0x7f, 0x02, 0x0f, 0x47, 0x55, 0x25, 0x5c, 0x5f, 0x75, 0x6d, 0x72, 0x20, 0x0c, 0x6b,
-	0xd2, 0xae, 0x2c, 0xcc, 0x76, 0xe6, 0x5e, 0xbe, 0xce, 0x27, 0x7e, 0x7c, 0x9d, 0x4f, 0x14, 0x6f,
-	0x14, 0x90, 0x99, 0x54, 0x02, 0x58, 0x05, 0xcb, 0xfd, 0x54, 0x1b, 0xc8, 0xb2, 0x7c, 0xcc, 0xd8,
-	0x78, 0x87, 0x8c, 0x99, 0x14, 0xf5, 0x74, 0x7f, 0xaf, 0x2c, 0xb7, 0x60, 0x07, 0x2c, 0x70, 0xe4,
-	0x37, 0x31, 0x37, 0x2e, 0xb1, 0xdd, 0x3c, 0xe7, 0xea, 0x8c, 0x80, 0xa9, 0x4f, 0x2d, 0x3f, 0x23,
-	0x49, 0x47, 0xc0, 0xa2, 0x92, 0xdf, 0x97, 0xa7, 0xa7, 0xe2, 0x70, 0x67, 0x36, 0x10, 0x5a, 0x34,
-	0xc1, 0x92, 0xcc, 0xc2, 0x40, 0xde, 0x3e, 0x48, 0x53, 0x0f, 0xfb, 0x13, 0xd4, 0x6d, 0x0c, 0x8a,
-	0x1e, 0xb5, 0x28, 0xea, 0x4b, 0xbd, 0xad, 0x50, 0x9b, 0xcc, 0xe4, 0xdf, 0x01, 0xc9, 0xef, 0x49,
-	0x90, 0x89, 0xb0, 0xd4, 0x78, 0xd0, 0x0a, 0x31, 0x51, 0x41, 0x0c, 0x52, 0x23, 0xf9, 0xfb, 0x62,
-	0xea, 0xfc, 0x2d, 0x84, 0xe3, 0x34, 0x31, 0x71, 0x21, 0x38, 0xdc, 0x03, 0x29, 0xc6, 0x11, 0x6f,
-	0x31, 0x31, 0x32, 0x8b, 0xdb, 0x5b, 0xef, 0x9e, 0xdf, 0x11, 0xb1, 0x2d, 0xa6, 0x87, 0xce, 0xf0,
-	0x2b, 0x00, 0x2c, 0xec, 0x18, 0xec, 0x1c, 0xf9, 0x98, 0xa9, 0xb3, 0x22, 0xe2, 0xc7, 0xd3, 0x4d,
-	0x5f, 0x64, 0xc4, 0xe6, 0x2d, 0xec, 0xd4, 0x04, 0x1c, 0x44, 0x60, 0x21, 0xec, 0x7f, 0x4e, 0x2f,
-	0x30, 0x61, 0xe1, 0x40, 0x3d, 0x9e, 0x2e, 0x23, 0xd1, 0xce, 0x91, 0x90, 0x75, 0x81, 0x38, 0x54,
-	0xd8, 0x7f, 0x52, 0x60, 0xf1, 0x10, 0x73, 0x39, 0x3a, 0xb2, 0xa4, 0x5f, 0x82, 0x79, 0xd7, 0x26,
-	0x5c, 0xde, 0x2c, 0x4a, 0x0c, 0xda, 0xe6, 0x02, 0x38, 0x71, 0x71, 0x38, 0xe0, 0x41, 0x43, 0x88,
-	0x32, 0x38, 0xe5, 0xc8, 0x31, 0x58, 0xcb, 0xf3, 0x9c, 0x4e, 0x58, 0xf2, 0xff, 0x27, 0x70, 0x59,
-	0x02, 0xd7, 0x03, 0xdc, 0x9a, 0x80, 0x0d, 0xaa, 0x44, 0x30, 0xef, 0x5d, 0x4b, 0xc9, 0x38, 0xaa,
-	0x44, 0x7a, 0xa9, 0x82, 0x67, 0x20, 0x2d, 0x35, 0xc4, 0xdc, 0x08, 0x8b, 0x02, 0xb5, 0xd2, 0xef,
-	0x06, 0x07, 0x3c, 0x90, 0x3c, 0xf1, 0xf7, 0xc4, 0xb2, 0x00, 0x3e, 0x18, 0x6a, 0x0c, 0xc8, 0xc1,
-	0x9a, 0x64, 0xf3, 0xb1, 0x8b, 0x6c, 0x12, 0x5c, 0xbe, 0x3e, 0xbe, 0x44, 0xbe, 0xc5, 0xd4, 0x54,
-	0x0c, 0xe2, 0x56, 0x04, 0xb8, 0xde, 0xc3, 0xd6, 0x25, 0xf4, 0x80, 0xb5, 0x45, 0x82, 0xe7, 0x38,
-	0x60, 0x6d, 0x20, 0x07, 0x11, 0x13, 0xab, 0xef, 0xc5, 0xa0, 0x53, 0xb2, 0x1e, 0xf7, 0xb0, 0x77,
-	0x25, 0x34, 0x3c, 0x07, 0xcb, 0x9e, 0x4f, 0x5f, 0x74, 0x0c, 0x64, 0x9a, 0x7d, 0xbe, 0xb9, 0x18,
-	0xf8, 0x96, 0x04, 0x6c, 0xd9, 0x34, 0x43, 0x26, 0x31, 0x6e, 0x8a, 0x18, 0xb7, 0xef, 0x92, 0xe0,
-	0xfe, 0x09, 0xe5, 0x36, 0x69, 0x1e, 0xd1, 0x4b, 0xec, 0xc3, 0x0c, 0xb8, 0xd7, 0xa6, 0x1c, 0xfb,
-	0x72, 0xce, 0x74, 0xb9, 0x80, 0x04, 0x64, 0x7a, 0x4f, 0x5f, 0x5b, 0x18, 0x1b, 0x5e, 0x60, 0x1d,
-	0xcb, 0x9c, 0xc0, 0x10, 0x79, 0x38, 0x8a, 0x6f, 0xc1, 0x46, 0xe4, 0xc5, 0x1d, 0xa1, 0x4d, 0xc6,
-	0x40, 0xab, 0x3a, 0xc3, 0x2f, 0xf5, 0x30, 0xb9, 0x05, 0x56, 0x07, 0x0f, 0xed, 0x08, 0xaf, 0x1c,
-	0xa7, 0xd2, 0x74, 0xbc, 0x7a, 0xa6, 0x8f, 0x36, 0xc4, 0x32, 0xb8, 0xf1, 0x3e, 0xfe, 0x55, 0x01,
-	0x4b, 0x91, 0x7b, 0x1d, 0x3e, 0x01, 0x9b, 0x27, 0xe5, 0x83, 0x6a, 0xa5, 0x5c, 0x7f, 0xa6, 0x1b,
-	0xb5, 0x7a, 0xb9, 0x7e, 0x5c, 0x33, 0x8e, 0x0f, 0x6b, 0x47, 0x7b, 0x4f, 0xab, 0xfb, 0xd5, 0xbd,
-	0x4a, 0x3a, 0x91, 0xcd, 0x5d, 0x5d, 0x17, 0xb2, 0x11, 0xb7, 0x63, 0xc2, 0x3c, 0x6c, 0xda, 0x67,
-	0x36, 0xb6, 0xe0, 0x67, 0x60, 0x6d, 0x0c, 0xa1, 0xfc, 0xb4, 0x5e, 0x3d, 0xd9, 0x4b, 0x2b, 0xd9,
-	0xf5, 0xab, 0xeb, 0xc2, 0x4a, 0xc4, 0xb9, 0x6c, 0x72, 0xbb, 0x8d, 0xe1, 0x0e, 0x58, 0x1f, 0xf3,
-	0xab, 0x1e, 0x86, 0x9e, 0x33, 0xd9, 0x8d, 0xab, 0xeb, 0xc2, 0x5a, 0xc4, 0xb3, 0x4a, 0x90, 0xf0,
-	0xcd, 0xce, 0xbe, 0xfc, 0x39, 0x97, 0xd8, 0x7d, 0xfe, 0xe6, 0x26, 0xa7, 0xbc, 0xbd, 0xc9, 0x29,
-	0x7f, 0xdd, 0xe4, 0x94, 0x57, 0xb7, 0xb9, 0xc4, 0xdb, 0xdb, 0x5c, 0xe2, 0x8f, 0xdb, 0x5c, 0xe2,
-	0xf9, 0x93, 0xa1, 0x8c, 0x0d, 0xbd, 0x6e, 0xcf, 0x08, 0xd6, 0xe4, 0xab, 0xb7, 0x45, 0x50, 0x00,
-	0xa4, 0xb5, 0xb7, 0xb5, 0x17, 0x91, 0x4f, 0x7f, 0x91, 0xcf, 0x46, 0x4a, 0x7c, 0x5b, 0x7f, 0xfa,
-	0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xc3, 0x78, 0xce, 0xc0, 0x1f, 0x0c, 0x00, 0x00,
+	// 2178 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x59, 0xcb, 0x6f, 0x23, 0x49,
+	0x19, 0x4f, 0xdb, 0x8e, 0x37, 0x53, 0x19, 0x3f, 0x52, 0x93, 0x47, 0xc7, 0x93, 0xb1, 0x43, 0x8b,
+	0x5d, 0xcd, 0x22, 0xc5, 0x66, 0xb3, 0x12, 0x42, 0x23, 0x0e, 0x13, 0x4f, 0x32, 0x5a, 0x8b, 0x61,
+	0x36, 0xb4, 0x9d, 0x0c, 0x04, 0xa4, 0xa6, 0xdd, 0x5d, 0xb6, 0x5b, 0xd3, 0x0f, 0x6f, 0x77, 0x39,
+	0x0f, 0x81, 0x58, 0x90, 0x38, 0xac, 0x86, 0xcb, 0x48, 0x5c, 0x56, 0x42, 0x83, 0x56, 0xe2, 0x80,
+	0xc4, 0x99, 0x33, 0xe7, 0x15, 0x02, 0x69, 0xc5, 0x01, 0x10, 0x07, 0x83, 0x66, 0x84, 0x84, 0x04,
+	0x27, 0xff, 0x05, 0xa8, 0x1e, 0xdd, 0xee, 0x6e, 0x77, 0x32, 0xea, 0x8d, 0xe1, 0xc0, 0x29, 0xe9,
+	0xaa, 0xef, 0xfb, 0x7d, 0xcf, 0xaa, 0xef, 0xab, 0xcf, 0xe0, 0xcb, 0x43, 0x0f, 0xab, 0x4f, 0x51,
+	0xc3, 0xf4, 0x86, 0xc8, 0xf5, 0x0c, 0x0f, 0x23, 0x5b, 0x43, 0x8d, 0xd3, 0x77, 0xba, 0x08, 0xab,
+	0xef, 0x34, 0x4c, 0xe3, 0x83, 0x91, 0xa1, 0x13, 0x0a, 0xc3, 0xee, 0xd7, 0x87, 0xae, 0x83, 0x1d,
+	0xb8, 0xc5, 0x38, 0xea, 0x11, 0x8e, 0x3a, 0xe7, 0xa8, 0xac, 0xf6, 0x9d, 0xbe, 0x43, 0x09, 0x1b,
+	0xe4, 0x3f, 0xc6, 0x53, 0xd9, 0xd4, 0x1c, 0xcf, 0x72, 0x3c, 0x85, 0x6d, 0xb0, 0x0f, 0xbe, 0x55,
+	0x65, 0x5f, 0x8d, 0xae, 0xea, 0x4d, 0xe5, 0x6a, 0x8e, 0x61, 0xf3, 0xfd, 0x5a, 0xdf, 0x71, 0xfa,
+	0x26, 0x6a, 0xd0, 0xaf, 0xee, 0xa8, 0xd7, 0xc0, 0x86, 0x85, 0x3c, 0xac, 0x5a, 0x43, 0x46, 0x20,
+	0xbd, 0x2a, 0x82, 0xfc, 0xa1, 0xea, 0xaa, 0x96, 0x07, 0xdf, 0x03, 0x2b, 0x4c, 0x63, 0xa5, 0xeb,
+	0xd8, 0xba, 0xa2, 0x23, 0xdb, 0xb1, 0x44, 0x61, 0x5b, 0xb8, 0x7b, 0xa3, 0xb9, 0x35, 0x19, 0xd7,
+	0xc4, 0x0b, 0xd5, 0x32, 0xef, 0x49, 0x33, 0x24, 0x92, 0x5c, 0x62, 0x6b, 0x4d, 0xc7, 0xd6, 0xf7,
+	0xc9, 0x0a, 0x7c, 0x2e, 0x80, 0xf5, 0xb3, 0x81, 0x81, 0x91, 0x49, 0x0c, 0xd4, 0x95, 0x53, 0xd5,
+	0x34, 0x74, 0x15, 0x3b, 0xae, 0x27, 0x66, 0xb6, 0xb3, 0x77, 0x97, 0x77, 0x77, 0xeb, 0x57, 0xb9,
+	0xa1, 0xfe, 0x64, 0xca, 0x7b, 0xec, 0xb3, 0x36, 0xdf, 0xfc, 0x74, 0x5c, 0x5b, 0x98, 0x8c, 0x6b,
+	0x77, 0x98, 0x1e, 0xc9, 0xf8, 0x92, 0xbc, 0x76, 0x96, 0xc0, 0xec, 0xc1, 0x1f, 0x0b, 0xa0, 0x3c,
+	0xb2, 0xa9, 0x50, 0xa5, 0x87, 0x90, 0xe2, 0xaa, 0x18, 0x89, 0x59, 0x6a, 0xdc, 0x13, 0x02, 0xfc,
+	0xd7, 0x71, 0xed, 0xad, 0xbe, 0x81, 0x07, 0xa3, 0x6e, 0x5d, 0x73, 0x2c, 0xee, 0x64, 0xfe, 0x67,
+	0xc7, 0xd3, 0x9f, 0x36, 0xf0, 0xc5, 0x10, 0x79, 0xf5, 0x7d, 0xa4, 0x4d, 0xc6, 0xb5, 0x0d, 0xa6,
+	0x42, 0x1c, 0x4f, 0xfa, 0xe3, 0x6f, 0x76, 0x00, 0x0f, 0xcf, 0x3e, 0xd2, 0xe4, 0x22, 0x27, 0x78,
+	0x88, 0x90, 0xac, 0x62, 0x04, 0x7f, 0x2e, 0x80, 0x4d, 0xcb, 0xb0, 0x15, 0xee, 0x42, 0x9e, 0x18,
+	0x8a, 0x6a, 0x39, 0x23, 0x1b, 0x8b, 0x8b, 0x54, 0x99, 0xef, 0xa5, 0x50, 0xa6, 0x65, 0xe3, 0xc9,
+	0xb8, 0xb6, 0xcd, 0x94, 0xb9, 0x14, 0x38, 0xac, 0x55, 0xcb, 0xc6, 0xf2, 0xba, 0x65, 0xd8, 0x8f,
+	0x28, 0x61, 0x9b, 0xd1, 0xed, 0x51, 0x32, 0xf8, 0x03, 0x50, 0x74, 0xd1, 0x99, 0xea, 0xea, 0x0a,
+	0x76, 0x8d, 0x7e, 0x1f, 0xb9, 0x62, 0x9e, 0x6a, 0x74, 0x94, 0xda, 0x3d, 0x6b, 0x4c, 0xa3, 0x28,
+	0x5a, 0xdc, 0x39, 0x05, 0xb6, 0xdd, 0x61, 0xbb, 0xf0, 0xa7, 0x02, 0xb8, 0xe5, 0xa2, 0xae, 0x6a,
+	0xaa, 0xb6, 0x46, 0x74, 0xf7, 0x75, 0x78, 0x83, 0xea, 0x70, 0x92, 0x5a, 0x87, 0x8a, 0xaf, 0xc3,
+	0x0c, 0x64, 0x5c, 0x11, 0x18, 0xa2, 0xf1, 0xb5, 0x41, 0xe0, 0xb6, 0xa5, 0x9e, 0x2b, 0x2e, 0xd2,
+	0x91, 0x89, 0xfa, 0x2a, 0x36, 0x1c, 0xdb, 0x53, 0x86, 0xc8, 0x55, 0xba, 0xa6, 0xa3, 0x3d, 0x15,
+	0x97, 0xb6, 0x85, 0xbb, 0x85, 0xe6, 0x5b, 0x93, 0x71, 0x4d, 0xe2, 0xce, 0xbf, 0x9c, 0x58, 0x92,
+	0x45, 0x4b, 0x3d, 0x97, 0xc3, 0x9b, 0x87, 0xc8, 0x6d, 0x92, 0x2d, 0xf8, 0x43, 0x50, 0xe2, 0x4e,
+	0x0a, 0x52, 0xf2, 0x06, 0xb5, 0xf7, 0x38, 0xb5, 0xbd, 0xeb, 0x11, 0x9f, 0x5f, 0x96, 0x91, 0xdc,
+	0xe9, 0x7e, 0x42, 0x7e, 0x1d, 0xc0, 0x10, 0x83, 0xaa, 0xeb, 0x2e, 0xf2, 0x3c, 0x11, 0x50, 0x15,
+	0xee, 0x4c, 0xc6, 0xb5, 0xcd, 0x19, 0x50, 0x4e, 0x23, 0xc9, 0xe5, 0x00, 0x69, 0x8f, 0x2d, 0xc1,
+	0x5f, 0x09, 0xe0, 0x8e, 0x41, 0x12, 0xde, 0xc6, 0x8a, 0x7f, 0x32, 0x5c, 0xe4, 0x21, 0xf7, 0x94,
+	0xea, 0x62, 0x38, 0xe2, 0x32, 0x05, 0xd6, 0x53, 0xdb, 0xf6, 0x45, 0xa6, 0xc6, 0x95, 0xe0, 0x71,
+	0x4b, 0x2b, 0x9c, 0xfa, 0x88, 0x11, 0xcb, 0x8c, 0x56, 0x26, 0xa4, 0xf0, 0x63, 0x01, 0x88, 0x71,
+	0xb0, 0x20, 0x00, 0x37, 0xa9, 0x92, 0x4a, 0x6a, 0x25, 0x6b, 0xc9, 0x4a, 0x5e, 0x16, 0x89, 0xb5,
+	0xa8, 0x7e, 0x7e, 0x44, 0xee, 0x83, 0xe2, 0x50, 0x1d, 0x79, 0xc8, 0x0d, 0xa2, 0x51, 0xa0, 0xfa,
+	0x6c, 0x4e, 0x8f, 0x55, 0x74, 0x5f, 0x92, 0x0b, 0x6c, 0xc1, 0x0f, 0xc3, 0x2f, 0x04, 0x50, 0x21,
+	0xe9, 0x38, 0x74, 0x51, 0x0f, 0xb9, 0x2e, 0xd2, 0x95, 0x33, 0x64, 0xf4, 0x07, 0x58, 0xf1, 0x06,
+	0x46, 0x0f, 0x8b, 0x45, 0x0a, 0xa7, 0xa6, 0x36, 0xef, 0x0b, 0xd3, 0x44, 0x4f, 0x46, 0x8e, 0x1b,
+	0xb8, 0x61, 0xa9, 0xe7, 0x87, 0x3e, 0xe5, 0x13, 0x4a, 0xd8, 0x26, 0x74, 0xf0, 0x02, 0x48, 0x36,
+	0xc2, 0xfc, 0x72, 0x22, 0x77, 0x15, 0x46, 0x8a, 0x67, 0xab, 0x43, 0x6f, 0xe0, 0x60, 0xc5, 0xb0,
+	0x31, 0x72, 0x4f, 0x55, 0x53, 0x2c, 0xd1, 0x23, 0xb6, 0x33, 0x19, 0xd7, 0xde, 0x66, 0x92, 0x5f,
+	0xcf, 0x23, 0xc9, 0x55, 0x1b, 0x61, 0x76, 0x9b, 0xb5, 0x09, 0x49, 0x9b, 0x53, 0xb4, 0x38, 0x01,
+	0xb4, 0x40, 0x95, 0x18, 0x70, 0x29, 0x94, 0x27, 0x96, 0xa9, 0xd8, 0xb7, 0x27, 0xe3, 0xda, 0x9b,
+	0x53, 0x83, 0x2f, 0xa7, 0x97, 0x64, 0xe2, 0xeb, 0xc7, 0x89, 0x52, 0xbd, 0x7b, 0x4b, 0x1f, 0x7d,
+	0x52, 0x5b, 0xf8, 0xf8, 0x93, 0xda, 0x82, 0xf4, 0x52, 0x00, 0xab, 0x49, 0x45, 0x0d, 0xb6, 0xc0,
+	0x4a, 0x50, 0xbc, 0x82, 0x90, 0xcf, 0xd4, 0xdc, 0x19, 0x12, 0x49, 0x2e, 0x07, 0x6b, 0x7e, 0xe0,
+	0x2f, 0x40, 0x01, 0xab, 0x6e, 0x1f, 0x61, 0x1e, 0x16, 0x31, 0x43, 0x61, 0x3a, 0xa9, 0x0b, 0xca,
+	0x2a, 0x13, 0x1a, 0x01, 0x8b, 0x17, 0x91, 0x9b, 0x6c, 0x97, 0xc5, 0xf5, 0x5e, 0x8e, 0x18, 0x2a,
+	0x69, 0xa0, 0xc4, 0xea, 0xca, 0xd4, 0xbc, 0x87, 0xa0, 0xec, 0x0c, 0x91, 0x9b, 0x60, 0xdd, 0xed,
+	0x69, 0x19, 0x8d, 0x53, 0x48, 0x72, 0xc9, 0x5f, 0xe2, 0xb6, 0x31, 0x4f, 0xfe, 0x93, 0x08, 0xf9,
+	0x53, 0x16, 0xac, 0xc6, 0xa4, 0x50, 0xaf, 0xcf, 0x4b, 0x14, 0x44, 0x20, 0x1f, 0xf1, 0xdf, 0x37,
+	0x52, 0xfb, 0xaf, 0xc0, 0x1b, 0x94, 0x44, 0xc7, 0x71, 0x70, 0x78, 0x00, 0xf2, 0x24, 0x97, 0x46,
+	0x1e, 0x6d, 0x42, 0x8a, 0xbb, 0x3b, 0x57, 0x77, 0x44, 0x11, 0x63, 0x47, 0x9e, 0xcc, 0x99, 0xe1,
+	0x77, 0x00, 0xd0, 0x91, 0xa9, 0x78, 0x03, 0xd5, 0x45, 0x9e, 0x98, 0xa3, 0x1a, 0x7f, 0x2d, 0xdd,
+	0xe1, 0x8e, 0x9d, 0xdb, 0x1b, 0x3a, 0x32, 0xdb, 0x14, 0x0e, 0xaa, 0xa0, 0xc0, 0x3b, 0x0a, 0xec,
+	0x3c, 0x45, 0xb6, 0x27, 0x2e, 0xa6, 0xc6, 0x6f, 0xd9, 0x38, 0x9e, 0x39, 0x0c, 0xb2, 0x43, 0x11,
+	0x43, 0x81, 0xfd, 0x77, 0x1e, 0x14, 0xa3, 0x07, 0x09, 0x7e, 0x1b, 0xdc, 0xb0, 0x0c, 0x1b, 0xb3,
+	0x7b, 0x59, 0x98, 0x83, 0x6d, 0x4b, 0x04, 0x8e, 0xde, 0xb3, 0x26, 0xb8, 0xd5, 0xa5, 0x46, 0x29,
+	0xd8, 0xc1, 0xaa, 0xa9, 0x78, 0xa3, 0xe1, 0xd0, 0xbc, 0x10, 0x33, 0xa9, 0x85, 0xcc, 0x1a, 0xb8,
+	0xc2, 0x80, 0x3b, 0x04, 0xb7, 0x4d, 0x61, 0x49, 0x94, 0xa6, 0x77, 0x88, 0x98, 0x4d, 0x2d, 0x24,
+	0x21, 0x4a, 0xc1, 0x4d, 0x07, 0x7b, 0xa0, 0xcc, 0x6c, 0x98, 0x73, 0x22, 0x14, 0x29, 0xea, 0x7e,
+	0x90, 0x0d, 0x26, 0xb8, 0xc5, 0xe4, 0xcc, 0x3f, 0x27, 0x56, 0x28, 0xf0, 0xa3, 0x50, 0x62, 0x40,
+	0x0c, 0x36, 0x98, 0x34, 0x17, 0x59, 0xaa, 0x61, 0x93, 0xfe, 0x8d, 0x75, 0x1c, 0x9e, 0x98, 0x4f,
+	0x2d, 0x71, 0xd6, 0xb8, 0x35, 0x0a, 0x2e, 0xfb, 0xd8, 0x32, 0x83, 0x9e, 0x4a, 0x1d, 0xd9, 0xe4,
+	0x81, 0x43, 0xa4, 0xb2, 0xde, 0x10, 0x89, 0x6f, 0xa4, 0x96, 0x3a, 0x6b, 0x27, 0x93, 0x7a, 0xe4,
+	0x63, 0x37, 0x19, 0x34, 0x1c, 0x80, 0x95, 0xa1, 0xeb, 0x9c, 0x5f, 0x28, 0xaa, 0xa6, 0x05, 0xf2,
+	0x96, 0xe6, 0x20, 0xaf, 0x44, 0x61, 0xf7, 0x34, 0x8d, 0x4b, 0xa2, 0xc7, 0x4d, 0xa0, 0xc7, 0xed,
+	0x47, 0x59, 0xb0, 0x7c, 0xec, 0x60, 0xc3, 0xee, 0x1f, 0x3a, 0x67, 0xc8, 0x85, 0xab, 0x60, 0xf1,
+	0xd4, 0xc1, 0xc8, 0x65, 0xe7, 0x4c, 0x66, 0x1f, 0xd0, 0x06, 0xab, 0xfe, 0x63, 0xe2, 0x94, 0x12,
+	0x2b, 0x43, 0x42, 0x3d, 0x97, 0x73, 0x02, 0x39, 0x72, 0x58, 0x8b, 0xef, 0x83, 0xdb, 0xb1, 0x37,
+	0x4c, 0x44, 0x6c, 0x76, 0x0e, 0x62, 0x45, 0x33, 0xfc, 0xf6, 0x09, 0x0b, 0xd7, 0xc1, 0xfa, 0xb4,
+	0xd0, 0x46, 0xe4, 0xb2, 0xe3, 0x54, 0x4f, 0x27, 0x57, 0x5e, 0x0d, 0xd0, 0x42, 0x52, 0x42, 0x37,
+	0xde, 0xaf, 0x73, 0xa0, 0x1c, 0xe4, 0x82, 0x8c, 0x3e, 0x18, 0x21, 0x0f, 0xc3, 0x22, 0xc8, 0x18,
+	0x3a, 0x0d, 0x42, 0x4e, 0xce, 0x18, 0x3a, 0x69, 0x10, 0xf8, 0xcb, 0x21, 0x54, 0xd7, 0x32, 0xf1,
+	0x06, 0x61, 0x86, 0x44, 0x92, 0xcb, 0xc1, 0x9a, 0x5f, 0xd9, 0xbe, 0x0b, 0x0a, 0xdd, 0x91, 0x6b,
+	0x23, 0x5d, 0x61, 0x37, 0x14, 0x75, 0xe7, 0xf2, 0xee, 0x66, 0x9d, 0x7b, 0x87, 0xcc, 0x10, 0x82,
+	0x82, 0xf3, 0xc0, 0x31, 0xec, 0xe6, 0x16, 0x7f, 0x72, 0xf3, 0x8e, 0x20, 0xc2, 0x2d, 0xc9, 0x37,
+	0xd9, 0x77, 0x93, 0x7e, 0xc2, 0x0e, 0xc8, 0xf3, 0xfb, 0x2d, 0x37, 0x87, 0x28, 0x71, 0x2c, 0xd8,
+	0x06, 0x6f, 0x20, 0x1b, 0xbb, 0x06, 0x22, 0x17, 0x0d, 0x99, 0x1c, 0xbc, 0x7b, 0x75, 0x9d, 0x8c,
+	0xfb, 0xf3, 0xc0, 0xc6, 0xee, 0x45, 0x33, 0x47, 0x74, 0x91, 0x7d, 0x24, 0xf8, 0x00, 0x94, 0x34,
+	0x17, 0xd1, 0xb7, 0x98, 0x32, 0x60, 0xb5, 0x9e, 0xdc, 0x29, 0xd9, 0x66, 0x65, 0xfa, 0x90, 0x8a,
+	0x11, 0x48, 0x72, 0xd1, 0x5f, 0x79, 0x8f, 0x15, 0xf0, 0x3e, 0x28, 0x69, 0x8e, 0x35, 0x34, 0x11,
+	0xa5, 0xc2, 0x86, 0xc5, 0xae, 0x88, 0xe5, 0xdd, 0x4a, 0x9d, 0xcd, 0x5c, 0xea, 0xfe, 0xcc, 0xa5,
+	0xde, 0xf1, 0x67, 0x2e, 0x4d, 0x89, 0x3b, 0xd4, 0x17, 0x12, 0x05, 0x90, 0x9e, 0xff, 0xad, 0x26,
+	0xc8, 0xc5, 0xe9, 0x2a, 0x61, 0xe4, 0xcd, 0xd5, 0x6f, 0x05, 0xb0, 0x96, 0x68, 0xdc, 0x3c, 0x5b,
+	0xc8, 0x69, 0x0c, 0x33, 0xf3, 0x8b, 0x21, 0x37, 0xe0, 0x77, 0x8b, 0xa0, 0xd8, 0x36, 0x55, 0x6f,
+	0x40, 0xf5, 0xd7, 0x1c, 0x57, 0x4f, 0xca, 0xf5, 0x59, 0x4b, 0x32, 0x9f, 0xcb, 0x92, 0x75, 0x90,
+	0xe7, 0x91, 0x25, 0x49, 0x9e, 0x95, 0xf9, 0x17, 0xfc, 0x2a, 0xc8, 0xd1, 0x50, 0xe5, 0x5e, 0x1b,
+	0xaa, 0x25, 0x62, 0x3b, 0x0d, 0x08, 0xe5, 0x20, 0xe3, 0x11, 0x8f, 0xa8, 0xaf, 0xf4, 0x5c, 0x55,
+	0x23, 0xc1, 0x11, 0x17, 0xaf, 0x37, 0x1e, 0x89, 0xa2, 0xcd, 0xbc, 0xd4, 0xe9, 0xf6, 0x43, 0xbe,
+	0x1b, 0x48, 0x47, 0xba, 0xdf, 0x45, 0xa4, 0x1f, 0xce, 0xb0, 0xee, 0x34, 0x2c, 0x3d, 0x40, 0x8b,
+	0x77, 0xa9, 0x05, 0xbe, 0xcd, 0x5b, 0x8c, 0x9f, 0x08, 0x60, 0x25, 0xf4, 0x08, 0xea, 0xa2, 0x9e,
+	0xe3, 0xfa, 0x15, 0xf1, 0x5b, 0xa9, 0xed, 0x17, 0x67, 0x1e, 0x74, 0x0c, 0x30, 0xee, 0x82, 0x52,
+	0xd0, 0xe3, 0x34, 0xe9, 0x3e, 0x9d, 0xe1, 0x85, 0xb8, 0xd4, 0x1e, 0xa9, 0x57, 0x4b, 0xd7, 0x9b,
+	0xe1, 0xc5, 0xf1, 0x66, 0x66, 0x78, 0x81, 0x12, 0x7b, 0x64, 0x9b, 0x27, 0xf3, 0x3f, 0x32, 0xa0,
+	0x70, 0x48, 0x9e, 0xdd, 0xed, 0x33, 0x03, 0x6b, 0x03, 0xe4, 0xc1, 0xc7, 0xe0, 0x56, 0xa8, 0x72,
+	0x21, 0x85, 0x3e, 0xca, 0x59, 0x72, 0x2f, 0x35, 0xab, 0xd3, 0x81, 0x54, 0x02, 0x91, 0x24, 0xaf,
+	0x4c, 0x6b, 0x12, 0xa2, 0xb0, 0x3a, 0xec, 0x80, 0x35, 0x4e, 0x3a, 0xb2, 0xc3, 0xc4, 0xf4, 0x3c,
+	0x2c, 0x35, 0xb7, 0x27, 0xe3, 0xda, 0x56, 0x04, 0x31, 0x4a, 0x26, 0xc9, 0x5c, 0x1d, 0x3e, 0x5f,
+	0xe0, 0xa8, 0x8f, 0x40, 0x78, 0xda, 0xe5, 0x43, 0x66, 0x29, 0x64, 0x64, 0xe0, 0x13, 0xa7, 0x91,
+	0xe4, 0x95, 0xd0, 0x22, 0x47, 0x3b, 0x01, 0x1b, 0x7c, 0x34, 0xe4, 0x22, 0xbf, 0x5e, 0x73, 0xc8,
+	0x1c, 0x85, 0x94, 0x26, 0xe3, 0x5a, 0x35, 0x32, 0x43, 0x8a, 0x13, 0x4a, 0xf2, 0x1a, 0xdb, 0x91,
+	0xfd, 0x0d, 0x86, 0xcd, 0xfd, 0xfc, 0x7b, 0x01, 0xc0, 0x60, 0x88, 0xf0, 0x5f, 0x79, 0x35, 0xff,
+	0x6f, 0x9e, 0x7b, 0xdc, 0x9c, 0x9f, 0x65, 0x40, 0x31, 0x30, 0x87, 0x46, 0x3b, 0xb9, 0xbe, 0x0b,
+	0x9f, 0xab, 0xbe, 0xcf, 0xf1, 0xfa, 0x44, 0x41, 0x21, 0xc8, 0x5e, 0xcf, 0x2b, 0xc9, 0xd7, 0x4b,
+	0xb4, 0x32, 0xfc, 0x39, 0x07, 0x4a, 0x07, 0xbd, 0x1e, 0xd2, 0xb0, 0x71, 0x8a, 0xd8, 0x44, 0xe1,
+	0xff, 0x63, 0x2e, 0x02, 0x3f, 0x04, 0xa5, 0xe9, 0xb0, 0x8c, 0x9e, 0x43, 0x31, 0x9b, 0x7a, 0xbe,
+	0xcb, 0x84, 0xf3, 0x8e, 0x21, 0x06, 0x17, 0x17, 0x5f, 0x1c, 0x46, 0xb3, 0xeb, 0x14, 0x70, 0x85,
+	0xf8, 0x04, 0x96, 0xb5, 0x66, 0xed, 0xd4, 0x97, 0xe5, 0xad, 0x88, 0xe9, 0x89, 0x03, 0xd7, 0x65,
+	0xb6, 0xc9, 0x26, 0xac, 0x1f, 0x82, 0x12, 0xf2, 0x23, 0xca, 0x45, 0x2f, 0x5e, 0x6f, 0xb0, 0x1d,
+	0x83, 0x9b, 0xb9, 0xa6, 0x83, 0x7d, 0xaa, 0x00, 0xcf, 0xac, 0x7f, 0xe5, 0xc0, 0x7a, 0xf2, 0x70,
+	0x2e, 0xd4, 0x20, 0x08, 0x89, 0x0d, 0x42, 0x26, 0x75, 0x83, 0x60, 0x85, 0xa7, 0x15, 0x2c, 0xcc,
+	0x87, 0xa9, 0xad, 0x2d, 0x07, 0x3f, 0xe6, 0xe0, 0xc4, 0xb1, 0xf1, 0x74, 0x82, 0x31, 0x8c, 0xcc,
+	0x14, 0x58, 0x60, 0xbf, 0x99, 0x5a, 0xde, 0x4a, 0xbc, 0x0a, 0x4a, 0x97, 0x0f, 0x1a, 0x9e, 0x09,
+	0x57, 0x4d, 0x00, 0x4e, 0x52, 0xa7, 0x34, 0xaf, 0x88, 0x09, 0x90, 0xd2, 0xeb, 0xe7, 0x03, 0x44,
+	0x99, 0xa4, 0x09, 0x4e, 0xfe, 0x7a, 0xca, 0x24, 0x40, 0x4a, 0xaf, 0x9d, 0xef, 0xb0, 0x6c, 0xfb,
+	0xd2, 0x1f, 0x04, 0x50, 0x8a, 0xcd, 0xe9, 0xe0, 0x7d, 0xb0, 0x75, 0xbc, 0xf7, 0xa8, 0xb5, 0xbf,
+	0xd7, 0x79, 0x5f, 0x56, 0xda, 0x9d, 0xbd, 0xce, 0x51, 0x5b, 0x39, 0x7a, 0xdc, 0x3e, 0x3c, 0x78,
+	0xd0, 0x7a, 0xd8, 0x3a, 0xd8, 0x2f, 0x2f, 0x54, 0xaa, 0xcf, 0x5e, 0x6c, 0x57, 0x62, 0x6c, 0x47,
+	0xb6, 0x37, 0x44, 0x9a, 0xd1, 0x33, 0x90, 0x0e, 0xbf, 0x02, 0x36, 0x66, 0x10, 0xf6, 0x1e, 0x74,
+	0x5a, 0xc7, 0x07, 0x65, 0xa1, 0xb2, 0xf9, 0xec, 0xc5, 0xf6, 0x5a, 0x8c, 0x79, 0x8f, 0x1e, 0x03,
+	0x78, 0x0f, 0x6c, 0xce, 0xf0, 0xb5, 0x1e, 0x73, 0xce, 0x4c, 0xe5, 0xf6, 0xb3, 0x17, 0xdb, 0x1b,
+	0x31, 0xce, 0x96, 0xad, 0x52, 0xde, 0x4a, 0xee, 0xa3, 0x5f, 0x56, 0x17, 0x9a, 0x27, 0x9f, 0xbe,
+	0xac, 0x0a, 0x9f, 0xbd, 0xac, 0x0a, 0x7f, 0x7f, 0x59, 0x15, 0x9e, 0xbf, 0xaa, 0x2e, 0x7c, 0xf6,
+	0xaa, 0xba, 0xf0, 0x97, 0x57, 0xd5, 0x85, 0x93, 0xfb, 0x21, 0xb7, 0x86, 0x5e, 0x61, 0xef, 0xdb,
+	0xa8, 0xc1, 0x5e, 0x67, 0x3b, 0xb6, 0x4a, 0x80, 0x1a, 0xa7, 0xbb, 0x8d, 0xf3, 0xd8, 0x8f, 0xe3,
+	0xd4, 0xe9, 0xdd, 0x3c, 0x3d, 0x50, 0xef, 0xfe, 0x67, 0x00, 0x39, 0x68, 0xf0, 0x34, 0x41, 0x1f,
+	0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -447,6 +939,97 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxNetAmountStateSnapshots != 0 {
+		i = encodeVarintLiquidstaking(dAtA, i, uint64(m.MaxNetAmountStateSnapshots))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.NetAmountStateSnapshotInterval != 0 {
+		i = encodeVarintLiquidstaking(dAtA, i, uint64(m.NetAmountStateSnapshotInterval))
+		i--
+		dAtA[i] = 0x78
+	}
+	{
+		size := m.MaxPreferredWeightShift.Size()
+		i -= size
+		if _, err := m.MaxPreferredWeightShift.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x72
+	if len(m.PauserAddress) > 0 {
+		i -= len(m.PauserAddress)
+		copy(dAtA[i:], m.PauserAddress)
+		i = encodeVarintLiquidstaking(dAtA, i, uint64(len(m.PauserAddress)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	{
+		size := m.InstantUnstakeFeeRate.Size()
+		i -= size
+		if _, err := m.InstantUnstakeFeeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	{
+		size := m.InstantUnstakeReserveRatio.Size()
+		i -= size
+		if _, err := m.InstantUnstakeReserveRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	if len(m.RewardFeeAddress) > 0 {
+		i -= len(m.RewardFeeAddress)
+		copy(dAtA[i:], m.RewardFeeAddress)
+		i = encodeVarintLiquidstaking(dAtA, i, uint64(len(m.RewardFeeAddress)))
+		i--
+		dAtA[i] = 0x52
+	}
+	{
+		size := m.RewardFeeRate.Size()
+		i -= size
+		if _, err := m.RewardFeeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if m.MaxRedelegationsPerBlock != 0 {
+		i = encodeVarintLiquidstaking(dAtA, i, uint64(m.MaxRedelegationsPerBlock))
+		i--
+		dAtA[i] = 0x40
+	}
+	{
+		size := m.RebalancingTrigger.Size()
+		i -= size
+		if _, err := m.RebalancingTrigger.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.RewardTrigger.Size()
+		i -= size
+		if _, err := m.RewardTrigger.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
 	{
 		size := m.MinLiquidStakingAmount.Size()
 		i -= size
@@ -789,141 +1372,2635 @@ func (m *VotingPower) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintLiquidstaking(dAtA []byte, offset int, v uint64) int {
-	offset -= sovLiquidstaking(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *UnbondingRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *UnbondingRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnbondingRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.LiquidBondDenom)
-	if l > 0 {
-		n += 1 + l + sovLiquidstaking(uint64(l))
+	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime):])
+	if err1 != nil {
+		return 0, err1
 	}
-	if len(m.WhitelistedValidators) > 0 {
-		for _, e := range m.WhitelistedValidators {
-			l = e.Size()
-			n += 1 + l + sovLiquidstaking(uint64(l))
+	i -= n1
+	i = encodeVarintLiquidstaking(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x3a
+	if m.CreationHeight != 0 {
+		i = encodeVarintLiquidstaking(dAtA, i, uint64(m.CreationHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLiquidstaking(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
 		}
 	}
-	l = m.UnstakeFeeRate.Size()
-	n += 1 + l + sovLiquidstaking(uint64(l))
-	l = m.MinLiquidStakingAmount.Size()
-	n += 1 + l + sovLiquidstaking(uint64(l))
-	return n
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.BurnedBtoken.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLiquidstaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintLiquidstaking(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintLiquidstaking(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *WhitelistedValidator) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *UnbondingRequestEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *UnbondingRequestEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnbondingRequestEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovLiquidstaking(uint64(l))
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstaking(dAtA, i, uint64(size))
 	}
-	l = m.TargetWeight.Size()
-	n += 1 + l + sovLiquidstaking(uint64(l))
-	return n
+	i--
+	dAtA[i] = 0x12
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintLiquidstaking(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *LiquidValidator) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SlashingRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *SlashingRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SlashingRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.OperatorAddress)
-	if l > 0 {
-		n += 1 + l + sovLiquidstaking(uint64(l))
+	{
+		size := m.NetAmountAfter.Size()
+		i -= size
+		if _, err := m.NetAmountAfter.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstaking(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.NetAmountBefore.Size()
+		i -= size
+		if _, err := m.NetAmountBefore.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.SlashedAmount.Size()
+		i -= size
+		if _, err := m.SlashedAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.SlashFraction.Size()
+		i -= size
+		if _, err := m.SlashFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintLiquidstaking(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x22
+	if m.Height != 0 {
+		i = encodeVarintLiquidstaking(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintLiquidstaking(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintLiquidstaking(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *LiquidValidatorState) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PauseSwitches) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *PauseSwitches) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PauseSwitches) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.OperatorAddress)
-	if l > 0 {
-		n += 1 + l + sovLiquidstaking(uint64(l))
+	if m.RewardRestakingPaused {
+		i--
+		if m.RewardRestakingPaused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
 	}
-	l = m.Weight.Size()
-	n += 1 + l + sovLiquidstaking(uint64(l))
-	if m.Status != 0 {
-		n += 1 + sovLiquidstaking(uint64(m.Status))
+	if m.RebalancingPaused {
+		i--
+		if m.RebalancingPaused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
 	}
-	l = m.DelShares.Size()
-	n += 1 + l + sovLiquidstaking(uint64(l))
-	l = m.LiquidTokens.Size()
-	n += 1 + l + sovLiquidstaking(uint64(l))
-	return n
+	if m.LiquidUnstakePaused {
+		i--
+		if m.LiquidUnstakePaused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.LiquidStakePaused {
+		i--
+		if m.LiquidStakePaused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *NetAmountState) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PreferredValidator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PreferredValidator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PreferredValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Weight.Size()
+		i -= size
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintLiquidstaking(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PreferredStake) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PreferredStake) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PreferredStake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintLiquidstaking(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintLiquidstaking(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EffectiveWeight) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EffectiveWeight) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EffectiveWeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.EffectiveRatio.Size()
+		i -= size
+		if _, err := m.EffectiveRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.TargetRatio.Size()
+		i -= size
+		if _, err := m.TargetRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.PreferredStake.Size()
+		i -= size
+		if _, err := m.PreferredStake.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.TargetWeight.Size()
+		i -= size
+		if _, err := m.TargetWeight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintLiquidstaking(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NetAmountStateSnapshot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NetAmountStateSnapshot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NetAmountStateSnapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.BtokenTotalSupply.Size()
+		i -= size
+		if _, err := m.BtokenTotalSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.TotalLiquidTokens.Size()
+		i -= size
+		if _, err := m.TotalLiquidTokens.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.NetAmount.Size()
+		i -= size
+		if _, err := m.NetAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.MintRate.Size()
+		i -= size
+		if _, err := m.MintRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintLiquidstaking(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x12
+	if m.Height != 0 {
+		i = encodeVarintLiquidstaking(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintLiquidstaking(dAtA []byte, offset int, v uint64) int {
+	offset -= sovLiquidstaking(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.LiquidBondDenom)
+	if l > 0 {
+		n += 1 + l + sovLiquidstaking(uint64(l))
+	}
+	if len(m.WhitelistedValidators) > 0 {
+		for _, e := range m.WhitelistedValidators {
+			l = e.Size()
+			n += 1 + l + sovLiquidstaking(uint64(l))
+		}
+	}
+	l = m.UnstakeFeeRate.Size()
+	n += 1 + l + sovLiquidstaking(uint64(l))
+	l = m.MinLiquidStakingAmount.Size()
+	n += 1 + l + sovLiquidstaking(uint64(l))
+	l = m.RewardTrigger.Size()
+	n += 1 + l + sovLiquidstaking(uint64(l))
+	l = m.RebalancingTrigger.Size()
+	n += 1 + l + sovLiquidstaking(uint64(l))
+	if m.MaxRedelegationsPerBlock != 0 {
+		n += 1 + sovLiquidstaking(uint64(m.MaxRedelegationsPerBlock))
+	}
+	l = m.RewardFeeRate.Size()
+	n += 1 + l + sovLiquidstaking(uint64(l))
+	l = len(m.RewardFeeAddress)
+	if l > 0 {
+		n += 1 + l + sovLiquidstaking(uint64(l))
+	}
+	l = m.InstantUnstakeReserveRatio.Size()
+	n += 1 + l + sovLiquidstaking(uint64(l))
+	l = m.InstantUnstakeFeeRate.Size()
+	n += 1 + l + sovLiquidstaking(uint64(l))
+	l = len(m.PauserAddress)
+	if l > 0 {
+		n += 1 + l + sovLiquidstaking(uint64(l))
+	}
+	l = m.MaxPreferredWeightShift.Size()
+	n += 1 + l + sovLiquidstaking(uint64(l))
+	if m.NetAmountStateSnapshotInterval != 0 {
+		n += 1 + sovLiquidstaking(uint64(m.NetAmountStateSnapshotInterval))
+	}
+	if m.MaxNetAmountStateSnapshots != 0 {
+		n += 2 + sovLiquidstaking(uint64(m.MaxNetAmountStateSnapshots))
+	}
+	return n
+}
+
+func (m *WhitelistedValidator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovLiquidstaking(uint64(l))
+	}
+	l = m.TargetWeight.Size()
+	n += 1 + l + sovLiquidstaking(uint64(l))
+	return n
+}
+
+func (m *LiquidValidator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovLiquidstaking(uint64(l))
+	}
+	return n
+}
+
+func (m *LiquidValidatorState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovLiquidstaking(uint64(l))
+	}
+	l = m.Weight.Size()
+	n += 1 + l + sovLiquidstaking(uint64(l))
+	if m.Status != 0 {
+		n += 1 + sovLiquidstaking(uint64(m.Status))
+	}
+	l = m.DelShares.Size()
+	n += 1 + l + sovLiquidstaking(uint64(l))
+	l = m.LiquidTokens.Size()
+	n += 1 + l + sovLiquidstaking(uint64(l))
+	return n
+}
+
+func (m *NetAmountState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MintRate.Size()
+	n += 1 + l + sovLiquidstaking(uint64(l))
+	l = m.BtokenTotalSupply.Size()
+	n += 1 + l + sovLiquidstaking(uint64(l))
+	l = m.NetAmount.Size()
+	n += 1 + l + sovLiquidstaking(uint64(l))
+	l = m.TotalDelShares.Size()
+	n += 1 + l + sovLiquidstaking(uint64(l))
+	l = m.TotalLiquidTokens.Size()
+	n += 1 + l + sovLiquidstaking(uint64(l))
+	l = m.TotalRemainingRewards.Size()
+	n += 1 + l + sovLiquidstaking(uint64(l))
+	l = m.TotalUnbondingBalance.Size()
+	n += 1 + l + sovLiquidstaking(uint64(l))
+	l = m.ProxyAccBalance.Size()
+	n += 1 + l + sovLiquidstaking(uint64(l))
+	return n
+}
+
+func (m *VotingPower) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovLiquidstaking(uint64(l))
+	}
+	l = m.StakingVotingPower.Size()
+	n += 1 + l + sovLiquidstaking(uint64(l))
+	l = m.LiquidStakingVotingPower.Size()
+	n += 1 + l + sovLiquidstaking(uint64(l))
+	l = m.ValidatorVotingPower.Size()
+	n += 1 + l + sovLiquidstaking(uint64(l))
+	return n
+}
+
+func (m *UnbondingRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovLiquidstaking(uint64(m.Id))
+	}
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovLiquidstaking(uint64(l))
+	}
+	l = m.BurnedBtoken.Size()
+	n += 1 + l + sovLiquidstaking(uint64(l))
+	l = m.Amount.Size()
+	n += 1 + l + sovLiquidstaking(uint64(l))
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovLiquidstaking(uint64(l))
+		}
+	}
+	if m.CreationHeight != 0 {
+		n += 1 + sovLiquidstaking(uint64(m.CreationHeight))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime)
+	n += 1 + l + sovLiquidstaking(uint64(l))
+	return n
+}
+
+func (m *UnbondingRequestEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovLiquidstaking(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovLiquidstaking(uint64(l))
+	return n
+}
+
+func (m *SlashingRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovLiquidstaking(uint64(m.Id))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovLiquidstaking(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovLiquidstaking(uint64(m.Height))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovLiquidstaking(uint64(l))
+	l = m.SlashFraction.Size()
+	n += 1 + l + sovLiquidstaking(uint64(l))
+	l = m.SlashedAmount.Size()
+	n += 1 + l + sovLiquidstaking(uint64(l))
+	l = m.NetAmountBefore.Size()
+	n += 1 + l + sovLiquidstaking(uint64(l))
+	l = m.NetAmountAfter.Size()
+	n += 1 + l + sovLiquidstaking(uint64(l))
+	return n
+}
+
+func (m *PauseSwitches) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LiquidStakePaused {
+		n += 2
+	}
+	if m.LiquidUnstakePaused {
+		n += 2
+	}
+	if m.RebalancingPaused {
+		n += 2
+	}
+	if m.RewardRestakingPaused {
+		n += 2
+	}
+	return n
+}
+
+func (m *PreferredValidator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovLiquidstaking(uint64(l))
+	}
+	l = m.Weight.Size()
+	n += 1 + l + sovLiquidstaking(uint64(l))
+	return n
+}
+
+func (m *PreferredStake) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovLiquidstaking(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovLiquidstaking(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovLiquidstaking(uint64(l))
+	return n
+}
+
+func (m *EffectiveWeight) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovLiquidstaking(uint64(l))
+	}
+	l = m.TargetWeight.Size()
+	n += 1 + l + sovLiquidstaking(uint64(l))
+	l = m.PreferredStake.Size()
+	n += 1 + l + sovLiquidstaking(uint64(l))
+	l = m.TargetRatio.Size()
+	n += 1 + l + sovLiquidstaking(uint64(l))
+	l = m.EffectiveRatio.Size()
+	n += 1 + l + sovLiquidstaking(uint64(l))
+	return n
+}
+
+func (m *NetAmountStateSnapshot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovLiquidstaking(uint64(m.Height))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovLiquidstaking(uint64(l))
+	l = m.MintRate.Size()
+	n += 1 + l + sovLiquidstaking(uint64(l))
+	l = m.NetAmount.Size()
+	n += 1 + l + sovLiquidstaking(uint64(l))
+	l = m.TotalLiquidTokens.Size()
+	n += 1 + l + sovLiquidstaking(uint64(l))
+	l = m.BtokenTotalSupply.Size()
+	n += 1 + l + sovLiquidstaking(uint64(l))
+	return n
+}
+
+func sovLiquidstaking(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozLiquidstaking(x uint64) (n int) {
+	return sovLiquidstaking(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidstaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidBondDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LiquidBondDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WhitelistedValidators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WhitelistedValidators = append(m.WhitelistedValidators, WhitelistedValidator{})
+			if err := m.WhitelistedValidators[len(m.WhitelistedValidators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnstakeFeeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UnstakeFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinLiquidStakingAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinLiquidStakingAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardTrigger", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RewardTrigger.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RebalancingTrigger", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RebalancingTrigger.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRedelegationsPerBlock", wireType)
+			}
+			m.MaxRedelegationsPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRedelegationsPerBlock |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardFeeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RewardFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardFeeAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardFeeAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InstantUnstakeReserveRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InstantUnstakeReserveRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InstantUnstakeFeeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InstantUnstakeFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PauserAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PauserAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPreferredWeightShift", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxPreferredWeightShift.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetAmountStateSnapshotInterval", wireType)
+			}
+			m.NetAmountStateSnapshotInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NetAmountStateSnapshotInterval |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxNetAmountStateSnapshots", wireType)
+			}
+			m.MaxNetAmountStateSnapshots = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxNetAmountStateSnapshots |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidstaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WhitelistedValidator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidstaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WhitelistedValidator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WhitelistedValidator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetWeight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TargetWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidstaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LiquidValidator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidstaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LiquidValidator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LiquidValidator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidstaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LiquidValidatorState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidstaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LiquidValidatorState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LiquidValidatorState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= ValidatorStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelShares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DelShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidTokens", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LiquidTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidstaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NetAmountState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidstaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NetAmountState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NetAmountState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MintRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtokenTotalSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BtokenTotalSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NetAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalDelShares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalDelShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalLiquidTokens", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalLiquidTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalRemainingRewards", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalRemainingRewards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalUnbondingBalance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalUnbondingBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProxyAccBalance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProxyAccBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidstaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VotingPower) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidstaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VotingPower: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VotingPower: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Voter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingVotingPower", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StakingVotingPower.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidStakingVotingPower", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LiquidStakingVotingPower.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorVotingPower", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ValidatorVotingPower.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidstaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnbondingRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidstaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnbondingRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnbondingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnedBtoken", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BurnedBtoken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, UnbondingRequestEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationHeight", wireType)
+			}
+			m.CreationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidstaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnbondingRequestEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidstaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnbondingRequestEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnbondingRequestEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidstaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	l = m.MintRate.Size()
-	n += 1 + l + sovLiquidstaking(uint64(l))
-	l = m.BtokenTotalSupply.Size()
-	n += 1 + l + sovLiquidstaking(uint64(l))
-	l = m.NetAmount.Size()
-	n += 1 + l + sovLiquidstaking(uint64(l))
-	l = m.TotalDelShares.Size()
-	n += 1 + l + sovLiquidstaking(uint64(l))
-	l = m.TotalLiquidTokens.Size()
-	n += 1 + l + sovLiquidstaking(uint64(l))
-	l = m.TotalRemainingRewards.Size()
-	n += 1 + l + sovLiquidstaking(uint64(l))
-	l = m.TotalUnbondingBalance.Size()
-	n += 1 + l + sovLiquidstaking(uint64(l))
-	l = m.ProxyAccBalance.Size()
-	n += 1 + l + sovLiquidstaking(uint64(l))
-	return n
-}
 
-func (m *VotingPower) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Voter)
-	if l > 0 {
-		n += 1 + l + sovLiquidstaking(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	l = m.StakingVotingPower.Size()
-	n += 1 + l + sovLiquidstaking(uint64(l))
-	l = m.LiquidStakingVotingPower.Size()
-	n += 1 + l + sovLiquidstaking(uint64(l))
-	l = m.ValidatorVotingPower.Size()
-	n += 1 + l + sovLiquidstaking(uint64(l))
-	return n
-}
-
-func sovLiquidstaking(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozLiquidstaking(x uint64) (n int) {
-	return sovLiquidstaking(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *SlashingRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -946,15 +4023,34 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: SlashingRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SlashingRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiquidBondDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -982,11 +4078,30 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LiquidBondDenom = string(dAtA[iNdEx:postIndex])
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WhitelistedValidators", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1013,14 +4128,13 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WhitelistedValidators = append(m.WhitelistedValidators, WhitelistedValidator{})
-			if err := m.WhitelistedValidators[len(m.WhitelistedValidators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnstakeFeeRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFraction", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1048,13 +4162,13 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.UnstakeFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SlashFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinLiquidStakingAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashedAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1082,63 +4196,13 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinLiquidStakingAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SlashedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipLiquidstaking(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthLiquidstaking
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *WhitelistedValidator) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowLiquidstaking
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: WhitelistedValidator: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WhitelistedValidator: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NetAmountBefore", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1166,11 +4230,13 @@ func (m *WhitelistedValidator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.NetAmountBefore.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetWeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NetAmountAfter", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1198,7 +4264,7 @@ func (m *WhitelistedValidator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TargetWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.NetAmountAfter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1223,7 +4289,7 @@ func (m *WhitelistedValidator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LiquidValidator) Unmarshal(dAtA []byte) error {
+func (m *PauseSwitches) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1246,17 +4312,17 @@ func (m *LiquidValidator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LiquidValidator: wiretype end group for non-group")
+			return fmt.Errorf("proto: PauseSwitches: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LiquidValidator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PauseSwitches: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidStakePaused", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidstaking
@@ -1266,24 +4332,72 @@ func (m *LiquidValidator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthLiquidstaking
+			m.LiquidStakePaused = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidUnstakePaused", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthLiquidstaking
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.LiquidUnstakePaused = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RebalancingPaused", wireType)
 			}
-			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RebalancingPaused = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardRestakingPaused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RewardRestakingPaused = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLiquidstaking(dAtA[iNdEx:])
@@ -1305,7 +4419,7 @@ func (m *LiquidValidator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LiquidValidatorState) Unmarshal(dAtA []byte) error {
+func (m *PreferredValidator) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1328,15 +4442,15 @@ func (m *LiquidValidatorState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LiquidValidatorState: wiretype end group for non-group")
+			return fmt.Errorf("proto: PreferredValidator: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LiquidValidatorState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PreferredValidator: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1364,7 +4478,7 @@ func (m *LiquidValidatorState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -1400,93 +4514,6 @@ func (m *LiquidValidatorState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
-			}
-			m.Status = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLiquidstaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Status |= ValidatorStatus(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelShares", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLiquidstaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthLiquidstaking
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthLiquidstaking
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.DelShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiquidTokens", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLiquidstaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthLiquidstaking
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthLiquidstaking
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.LiquidTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLiquidstaking(dAtA[iNdEx:])
@@ -1508,7 +4535,7 @@ func (m *LiquidValidatorState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NetAmountState) Unmarshal(dAtA []byte) error {
+func (m *PreferredStake) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1531,15 +4558,15 @@ func (m *NetAmountState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NetAmountState: wiretype end group for non-group")
+			return fmt.Errorf("proto: PreferredStake: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NetAmountState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PreferredStake: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MintRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1567,13 +4594,11 @@ func (m *NetAmountState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MintRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BtokenTotalSupply", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1601,13 +4626,11 @@ func (m *NetAmountState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BtokenTotalSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NetAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1635,13 +4658,63 @@ func (m *NetAmountState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.NetAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidstaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EffectiveWeight) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidstaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EffectiveWeight: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EffectiveWeight: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalDelShares", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1669,13 +4742,11 @@ func (m *NetAmountState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TotalDelShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalLiquidTokens", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetWeight", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1703,13 +4774,13 @@ func (m *NetAmountState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TotalLiquidTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TargetWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalRemainingRewards", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PreferredStake", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1737,13 +4808,13 @@ func (m *NetAmountState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TotalRemainingRewards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PreferredStake.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalUnbondingBalance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetRatio", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1771,13 +4842,13 @@ func (m *NetAmountState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TotalUnbondingBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TargetRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProxyAccBalance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveRatio", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1805,7 +4876,7 @@ func (m *NetAmountState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ProxyAccBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.EffectiveRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1830,7 +4901,7 @@ func (m *NetAmountState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *VotingPower) Unmarshal(dAtA []byte) error {
+func (m *NetAmountStateSnapshot) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1853,15 +4924,67 @@ func (m *VotingPower) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: VotingPower: wiretype end group for non-group")
+			return fmt.Errorf("proto: NetAmountStateSnapshot: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: VotingPower: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NetAmountStateSnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1889,11 +5012,13 @@ func (m *VotingPower) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Voter = string(dAtA[iNdEx:postIndex])
+			if err := m.MintRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakingVotingPower", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NetAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1921,13 +5046,13 @@ func (m *VotingPower) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.StakingVotingPower.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.NetAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiquidStakingVotingPower", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalLiquidTokens", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1955,13 +5080,13 @@ func (m *VotingPower) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LiquidStakingVotingPower.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TotalLiquidTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorVotingPower", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BtokenTotalSupply", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1989,7 +5114,7 @@ func (m *VotingPower) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ValidatorVotingPower.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.BtokenTotalSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
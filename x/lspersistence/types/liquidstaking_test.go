@@ -158,12 +158,12 @@ func TestActiveCondition(t *testing.T) {
 			tombstoned:     false,
 			expectedOutput: true,
 		},
-		// active case 2
+		// active case 2 (unbonding, not jailed)
 		{
 			validator: stakingtypes.Validator{
 				OperatorAddress: whitelistedValidators[0].ValidatorAddress,
-				Jailed:          true,
-				Status:          stakingtypes.Bonded,
+				Jailed:          false,
+				Status:          stakingtypes.Unbonding,
 				Tokens:          sdk.NewInt(100000000),
 				DelegatorShares: sdk.NewDec(100000000),
 			},
@@ -236,6 +236,19 @@ func TestActiveCondition(t *testing.T) {
 			tombstoned:     true,
 			expectedOutput: false,
 		},
+		// inactive case 6 (jailed)
+		{
+			validator: stakingtypes.Validator{
+				OperatorAddress: whitelistedValidators[0].ValidatorAddress,
+				Jailed:          true,
+				Status:          stakingtypes.Bonded,
+				Tokens:          sdk.NewInt(100000000),
+				DelegatorShares: sdk.NewDec(100000000),
+			},
+			whitelisted:    true,
+			tombstoned:     false,
+			expectedOutput: false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -321,7 +334,7 @@ func (s *KeeperTestSuite) TestLiquidStake() {
 
 	// fail, no active validator
 	cachedCtx, _ := s.ctx.CacheContext()
-	newShares, bTokenMintAmt, err := s.keeper.LiquidStake(cachedCtx, types.LiquidStakingProxyAcc, s.delAddrs[0], sdk.NewCoin(sdk.DefaultBondDenom, stakingAmt))
+	newShares, bTokenMintAmt, err := s.keeper.LiquidStake(cachedCtx, types.LiquidStakingProxyAcc, s.delAddrs[0], sdk.NewCoin(sdk.DefaultBondDenom, stakingAmt), nil)
 	s.Require().ErrorIs(err, types.ErrActiveLiquidValidatorsNotExists)
 	s.Require().Equal(newShares, sdk.ZeroDec())
 	s.Require().Equal(bTokenMintAmt, sdk.ZeroInt())
@@ -355,7 +368,7 @@ func (s *KeeperTestSuite) TestLiquidStake() {
 	s.Require().Equal(sdk.ZeroInt(), res[2].LiquidTokens)
 
 	// liquid staking
-	newShares, bTokenMintAmt, err = s.keeper.LiquidStake(s.ctx, types.LiquidStakingProxyAcc, s.delAddrs[0], sdk.NewCoin(sdk.DefaultBondDenom, stakingAmt))
+	newShares, bTokenMintAmt, err = s.keeper.LiquidStake(s.ctx, types.LiquidStakingProxyAcc, s.delAddrs[0], sdk.NewCoin(sdk.DefaultBondDenom, stakingAmt), nil)
 	s.Require().NoError(err)
 	s.Require().Equal(newShares, sdk.NewDecFromInt(stakingAmt))
 	s.Require().Equal(bTokenMintAmt, stakingAmt)
@@ -9,12 +9,23 @@ import (
 var (
 	_ sdk.Msg = (*MsgLiquidStake)(nil)
 	_ sdk.Msg = (*MsgLiquidUnstake)(nil)
+	_ sdk.Msg = (*MsgInstantLiquidUnstake)(nil)
+	_ sdk.Msg = (*MsgUpdatePauseSwitches)(nil)
+	_ sdk.Msg = (*MsgAddWhitelistedValidators)(nil)
+	_ sdk.Msg = (*MsgUpdateWhitelistedValidatorWeights)(nil)
+	_ sdk.Msg = (*MsgRemoveWhitelistedValidators)(nil)
 )
 
 // Message types for the liquidstaking module
 const (
-	TypeMsgLiquidStake   = "liquid_stake"
-	TypeMsgLiquidUnstake = "liquid_unstake"
+	TypeMsgLiquidStake          = "liquid_stake"
+	TypeMsgLiquidUnstake        = "liquid_unstake"
+	TypeMsgInstantLiquidUnstake = "instant_liquid_unstake"
+	TypeMsgUpdatePauseSwitches  = "update_pause_switches"
+
+	TypeMsgAddWhitelistedValidators          = "add_whitelisted_validators"
+	TypeMsgUpdateWhitelistedValidatorWeights = "update_whitelisted_validator_weights"
+	TypeMsgRemoveWhitelistedValidators       = "remove_whitelisted_validators"
 )
 
 // NewMsgLiquidStake creates a new MsgLiquidStake.
@@ -42,6 +53,9 @@ func (msg MsgLiquidStake) ValidateBasic() error {
 	if err := msg.Amount.Validate(); err != nil {
 		return err
 	}
+	if err := validatePreferredValidators(msg.PreferredValidators); err != nil {
+		return errorsmod.Wrap(ErrInvalidPreferredValidator, err.Error())
+	}
 	return nil
 }
 
@@ -112,3 +126,207 @@ func (msg MsgLiquidUnstake) GetDelegator() sdk.AccAddress {
 	}
 	return addr
 }
+
+// NewMsgInstantLiquidUnstake creates a new MsgInstantLiquidUnstake.
+func NewMsgInstantLiquidUnstake(
+	liquidStaker sdk.AccAddress, //nolint: interfacer
+	amount sdk.Coin,
+) *MsgInstantLiquidUnstake {
+	return &MsgInstantLiquidUnstake{
+		DelegatorAddress: liquidStaker.String(),
+		Amount:           amount,
+	}
+}
+
+func (msg MsgInstantLiquidUnstake) Route() string { return RouterKey }
+
+func (msg MsgInstantLiquidUnstake) Type() string { return TypeMsgInstantLiquidUnstake }
+
+func (msg MsgInstantLiquidUnstake) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.DelegatorAddress); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid delegator address %q: %v", msg.DelegatorAddress, err)
+	}
+	if ok := msg.Amount.IsZero(); ok {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "unstaking amount must not be zero")
+	}
+	if err := msg.Amount.Validate(); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (msg MsgInstantLiquidUnstake) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgInstantLiquidUnstake) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (msg MsgInstantLiquidUnstake) GetDelegator() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+// NewMsgUpdatePauseSwitches creates a new MsgUpdatePauseSwitches.
+func NewMsgUpdatePauseSwitches(
+	authority sdk.AccAddress, //nolint: interfacer
+	pauseSwitches PauseSwitches,
+) *MsgUpdatePauseSwitches {
+	return &MsgUpdatePauseSwitches{
+		Authority:     authority.String(),
+		PauseSwitches: pauseSwitches,
+	}
+}
+
+func (msg MsgUpdatePauseSwitches) Route() string { return RouterKey }
+
+func (msg MsgUpdatePauseSwitches) Type() string { return TypeMsgUpdatePauseSwitches }
+
+func (msg MsgUpdatePauseSwitches) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address %q: %v", msg.Authority, err)
+	}
+	return nil
+}
+
+func (msg MsgUpdatePauseSwitches) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgUpdatePauseSwitches) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgAddWhitelistedValidators creates a new MsgAddWhitelistedValidators.
+func NewMsgAddWhitelistedValidators(
+	authority sdk.AccAddress, //nolint: interfacer
+	whitelistedValidators []WhitelistedValidator,
+) *MsgAddWhitelistedValidators {
+	return &MsgAddWhitelistedValidators{
+		Authority:             authority.String(),
+		WhitelistedValidators: whitelistedValidators,
+	}
+}
+
+func (msg MsgAddWhitelistedValidators) Route() string { return RouterKey }
+
+func (msg MsgAddWhitelistedValidators) Type() string { return TypeMsgAddWhitelistedValidators }
+
+func (msg MsgAddWhitelistedValidators) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address %q: %v", msg.Authority, err)
+	}
+	if len(msg.WhitelistedValidators) == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "whitelisted validators must not be empty")
+	}
+	if err := validateWhitelistedValidators(msg.WhitelistedValidators); err != nil {
+		return errorsmod.Wrap(ErrInvalidWhitelistedValidator, err.Error())
+	}
+	return nil
+}
+
+func (msg MsgAddWhitelistedValidators) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgAddWhitelistedValidators) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgUpdateWhitelistedValidatorWeights creates a new MsgUpdateWhitelistedValidatorWeights.
+func NewMsgUpdateWhitelistedValidatorWeights(
+	authority sdk.AccAddress, //nolint: interfacer
+	whitelistedValidators []WhitelistedValidator,
+) *MsgUpdateWhitelistedValidatorWeights {
+	return &MsgUpdateWhitelistedValidatorWeights{
+		Authority:             authority.String(),
+		WhitelistedValidators: whitelistedValidators,
+	}
+}
+
+func (msg MsgUpdateWhitelistedValidatorWeights) Route() string { return RouterKey }
+
+func (msg MsgUpdateWhitelistedValidatorWeights) Type() string {
+	return TypeMsgUpdateWhitelistedValidatorWeights
+}
+
+func (msg MsgUpdateWhitelistedValidatorWeights) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address %q: %v", msg.Authority, err)
+	}
+	if len(msg.WhitelistedValidators) == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "whitelisted validators must not be empty")
+	}
+	if err := validateWhitelistedValidators(msg.WhitelistedValidators); err != nil {
+		return errorsmod.Wrap(ErrInvalidWhitelistedValidator, err.Error())
+	}
+	return nil
+}
+
+func (msg MsgUpdateWhitelistedValidatorWeights) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgUpdateWhitelistedValidatorWeights) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgRemoveWhitelistedValidators creates a new MsgRemoveWhitelistedValidators.
+func NewMsgRemoveWhitelistedValidators(
+	authority sdk.AccAddress, //nolint: interfacer
+	validatorAddresses []string,
+) *MsgRemoveWhitelistedValidators {
+	return &MsgRemoveWhitelistedValidators{
+		Authority:          authority.String(),
+		ValidatorAddresses: validatorAddresses,
+	}
+}
+
+func (msg MsgRemoveWhitelistedValidators) Route() string { return RouterKey }
+
+func (msg MsgRemoveWhitelistedValidators) Type() string { return TypeMsgRemoveWhitelistedValidators }
+
+func (msg MsgRemoveWhitelistedValidators) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address %q: %v", msg.Authority, err)
+	}
+	if len(msg.ValidatorAddresses) == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "validator addresses must not be empty")
+	}
+	if err := validateValidatorAddresses(msg.ValidatorAddresses); err != nil {
+		return errorsmod.Wrap(ErrInvalidWhitelistedValidator, err.Error())
+	}
+	return nil
+}
+
+func (msg MsgRemoveWhitelistedValidators) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgRemoveWhitelistedValidators) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
@@ -1,6 +1,7 @@
 package types_test
 
 import (
+	"fmt"
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -13,6 +14,12 @@ import (
 func TestMsgLiquidStake(t *testing.T) {
 	delegatorAddr := sdk.AccAddress(crypto.AddressHash([]byte("delegatorAddr")))
 	stakingCoin := sdk.NewCoin("token", sdk.NewInt(1))
+	valAddr := sdk.ValAddress(crypto.AddressHash([]byte("valAddr")))
+	withPreferredValidators := func(pvs ...types.PreferredValidator) *types.MsgLiquidStake {
+		msg := types.NewMsgLiquidStake(delegatorAddr, stakingCoin)
+		msg.PreferredValidators = pvs
+		return msg
+	}
 
 	testCases := []struct {
 		expectedErr string
@@ -30,6 +37,21 @@ func TestMsgLiquidStake(t *testing.T) {
 			"staking amount must not be zero: invalid request",
 			types.NewMsgLiquidStake(delegatorAddr, sdk.NewCoin("token", sdk.NewInt(0))),
 		},
+		{
+			"",
+			withPreferredValidators(types.PreferredValidator{ValidatorAddress: valAddr.String(), Weight: sdk.NewInt(1)}),
+		},
+		{
+			"preferred validator weight must be positive: 0: invalid preferred validator",
+			withPreferredValidators(types.PreferredValidator{ValidatorAddress: valAddr.String(), Weight: sdk.ZeroInt()}),
+		},
+		{
+			fmt.Sprintf("preferred validator cannot be duplicated: %s: invalid preferred validator", valAddr),
+			withPreferredValidators(
+				types.PreferredValidator{ValidatorAddress: valAddr.String(), Weight: sdk.NewInt(1)},
+				types.PreferredValidator{ValidatorAddress: valAddr.String(), Weight: sdk.NewInt(2)},
+			),
+		},
 	}
 
 	for _, tc := range testCases {
@@ -89,3 +111,221 @@ func TestMsgLiquidUnstake(t *testing.T) {
 		}
 	}
 }
+
+func TestMsgInstantLiquidUnstake(t *testing.T) {
+	delegatorAddr := sdk.AccAddress(crypto.AddressHash([]byte("delegatorAddr")))
+	stakingCoin := sdk.NewCoin("btoken", sdk.NewInt(1))
+
+	testCases := []struct {
+		expectedErr string
+		msg         *types.MsgInstantLiquidUnstake
+	}{
+		{
+			"", // empty means no error expected
+			types.NewMsgInstantLiquidUnstake(delegatorAddr, stakingCoin),
+		},
+		{
+			"invalid delegator address \"\": empty address string is not allowed: invalid address",
+			types.NewMsgInstantLiquidUnstake(sdk.AccAddress{}, stakingCoin),
+		},
+		{
+			"unstaking amount must not be zero: invalid request",
+			types.NewMsgInstantLiquidUnstake(delegatorAddr, sdk.NewCoin("btoken", sdk.NewInt(0))),
+		},
+	}
+
+	for _, tc := range testCases {
+		require.IsType(t, &types.MsgInstantLiquidUnstake{}, tc.msg)
+		require.Equal(t, types.TypeMsgInstantLiquidUnstake, tc.msg.Type())
+		require.Equal(t, types.RouterKey, tc.msg.Route())
+		require.Equal(t, sdk.MustSortJSON(types.ModuleCdc.MustMarshalJSON(tc.msg)), tc.msg.GetSignBytes())
+
+		err := tc.msg.ValidateBasic()
+		if tc.expectedErr == "" {
+			require.Nil(t, err)
+			signers := tc.msg.GetSigners()
+			require.Len(t, signers, 1)
+			require.Equal(t, tc.msg.GetDelegator(), signers[0])
+		} else {
+			require.EqualError(t, err, tc.expectedErr)
+		}
+	}
+}
+
+func TestMsgUpdatePauseSwitches(t *testing.T) {
+	authority := sdk.AccAddress(crypto.AddressHash([]byte("authority")))
+	switches := types.PauseSwitches{LiquidStakePaused: true}
+
+	testCases := []struct {
+		expectedErr string
+		msg         *types.MsgUpdatePauseSwitches
+	}{
+		{
+			"", // empty means no error expected
+			types.NewMsgUpdatePauseSwitches(authority, switches),
+		},
+		{
+			"invalid authority address \"\": empty address string is not allowed: invalid address",
+			types.NewMsgUpdatePauseSwitches(sdk.AccAddress{}, switches),
+		},
+	}
+
+	for _, tc := range testCases {
+		require.IsType(t, &types.MsgUpdatePauseSwitches{}, tc.msg)
+		require.Equal(t, types.TypeMsgUpdatePauseSwitches, tc.msg.Type())
+		require.Equal(t, types.RouterKey, tc.msg.Route())
+		require.Equal(t, sdk.MustSortJSON(types.ModuleCdc.MustMarshalJSON(tc.msg)), tc.msg.GetSignBytes())
+
+		err := tc.msg.ValidateBasic()
+		if tc.expectedErr == "" {
+			require.Nil(t, err)
+			signers := tc.msg.GetSigners()
+			require.Len(t, signers, 1)
+			require.Equal(t, authority, signers[0])
+		} else {
+			require.EqualError(t, err, tc.expectedErr)
+		}
+	}
+}
+
+func TestMsgAddWhitelistedValidators(t *testing.T) {
+	authority := sdk.AccAddress(crypto.AddressHash([]byte("authority")))
+	valAddr := sdk.ValAddress(crypto.AddressHash([]byte("validator"))).String()
+	vals := []types.WhitelistedValidator{{ValidatorAddress: valAddr, TargetWeight: sdk.NewInt(10)}}
+
+	testCases := []struct {
+		expectedErr string
+		msg         *types.MsgAddWhitelistedValidators
+	}{
+		{
+			"", // empty means no error expected
+			types.NewMsgAddWhitelistedValidators(authority, vals),
+		},
+		{
+			"invalid authority address \"\": empty address string is not allowed: invalid address",
+			types.NewMsgAddWhitelistedValidators(sdk.AccAddress{}, vals),
+		},
+		{
+			"whitelisted validators must not be empty: invalid request",
+			types.NewMsgAddWhitelistedValidators(authority, nil),
+		},
+		{
+			"liquidstaking validator target weight must be positive: 0: invalid whitelisted validator",
+			types.NewMsgAddWhitelistedValidators(authority, []types.WhitelistedValidator{{ValidatorAddress: valAddr, TargetWeight: sdk.ZeroInt()}}),
+		},
+		{
+			fmt.Sprintf("liquidstaking validator cannot be duplicated: %s: invalid whitelisted validator", valAddr),
+			types.NewMsgAddWhitelistedValidators(authority, append(vals, vals...)),
+		},
+	}
+
+	for _, tc := range testCases {
+		require.IsType(t, &types.MsgAddWhitelistedValidators{}, tc.msg)
+		require.Equal(t, types.TypeMsgAddWhitelistedValidators, tc.msg.Type())
+		require.Equal(t, types.RouterKey, tc.msg.Route())
+		require.Equal(t, sdk.MustSortJSON(types.ModuleCdc.MustMarshalJSON(tc.msg)), tc.msg.GetSignBytes())
+
+		err := tc.msg.ValidateBasic()
+		if tc.expectedErr == "" {
+			require.Nil(t, err)
+			signers := tc.msg.GetSigners()
+			require.Len(t, signers, 1)
+			require.Equal(t, authority, signers[0])
+		} else {
+			require.EqualError(t, err, tc.expectedErr)
+		}
+	}
+}
+
+func TestMsgUpdateWhitelistedValidatorWeights(t *testing.T) {
+	authority := sdk.AccAddress(crypto.AddressHash([]byte("authority")))
+	valAddr := sdk.ValAddress(crypto.AddressHash([]byte("validator"))).String()
+	vals := []types.WhitelistedValidator{{ValidatorAddress: valAddr, TargetWeight: sdk.NewInt(10)}}
+
+	testCases := []struct {
+		expectedErr string
+		msg         *types.MsgUpdateWhitelistedValidatorWeights
+	}{
+		{
+			"", // empty means no error expected
+			types.NewMsgUpdateWhitelistedValidatorWeights(authority, vals),
+		},
+		{
+			"invalid authority address \"\": empty address string is not allowed: invalid address",
+			types.NewMsgUpdateWhitelistedValidatorWeights(sdk.AccAddress{}, vals),
+		},
+		{
+			"whitelisted validators must not be empty: invalid request",
+			types.NewMsgUpdateWhitelistedValidatorWeights(authority, []types.WhitelistedValidator{}),
+		},
+		{
+			"liquidstaking validator target weight must be positive: 0: invalid whitelisted validator",
+			types.NewMsgUpdateWhitelistedValidatorWeights(authority, []types.WhitelistedValidator{{ValidatorAddress: valAddr, TargetWeight: sdk.ZeroInt()}}),
+		},
+	}
+
+	for _, tc := range testCases {
+		require.IsType(t, &types.MsgUpdateWhitelistedValidatorWeights{}, tc.msg)
+		require.Equal(t, types.TypeMsgUpdateWhitelistedValidatorWeights, tc.msg.Type())
+		require.Equal(t, types.RouterKey, tc.msg.Route())
+		require.Equal(t, sdk.MustSortJSON(types.ModuleCdc.MustMarshalJSON(tc.msg)), tc.msg.GetSignBytes())
+
+		err := tc.msg.ValidateBasic()
+		if tc.expectedErr == "" {
+			require.Nil(t, err)
+			signers := tc.msg.GetSigners()
+			require.Len(t, signers, 1)
+			require.Equal(t, authority, signers[0])
+		} else {
+			require.EqualError(t, err, tc.expectedErr)
+		}
+	}
+}
+
+func TestMsgRemoveWhitelistedValidators(t *testing.T) {
+	authority := sdk.AccAddress(crypto.AddressHash([]byte("authority")))
+	valAddr := sdk.ValAddress(crypto.AddressHash([]byte("validator"))).String()
+
+	testCases := []struct {
+		expectedErr string
+		msg         *types.MsgRemoveWhitelistedValidators
+	}{
+		{
+			"", // empty means no error expected
+			types.NewMsgRemoveWhitelistedValidators(authority, []string{valAddr}),
+		},
+		{
+			"invalid authority address \"\": empty address string is not allowed: invalid address",
+			types.NewMsgRemoveWhitelistedValidators(sdk.AccAddress{}, []string{valAddr}),
+		},
+		{
+			"validator addresses must not be empty: invalid request",
+			types.NewMsgRemoveWhitelistedValidators(authority, nil),
+		},
+		{
+			"empty address string is not allowed: invalid whitelisted validator",
+			types.NewMsgRemoveWhitelistedValidators(authority, []string{""}),
+		},
+		{
+			fmt.Sprintf("validator address cannot be duplicated: %s: invalid whitelisted validator", valAddr),
+			types.NewMsgRemoveWhitelistedValidators(authority, []string{valAddr, valAddr}),
+		},
+	}
+
+	for _, tc := range testCases {
+		require.IsType(t, &types.MsgRemoveWhitelistedValidators{}, tc.msg)
+		require.Equal(t, types.TypeMsgRemoveWhitelistedValidators, tc.msg.Type())
+		require.Equal(t, types.RouterKey, tc.msg.Route())
+		require.Equal(t, sdk.MustSortJSON(types.ModuleCdc.MustMarshalJSON(tc.msg)), tc.msg.GetSignBytes())
+
+		err := tc.msg.ValidateBasic()
+		if tc.expectedErr == "" {
+			require.Nil(t, err)
+			signers := tc.msg.GetSigners()
+			require.Len(t, signers, 1)
+			require.Equal(t, authority, signers[0])
+		} else {
+			require.EqualError(t, err, tc.expectedErr)
+		}
+	}
+}
@@ -13,10 +13,21 @@ import (
 
 // Parameter store keys
 var (
-	KeyLiquidBondDenom        = []byte("LiquidBondDenom")
-	KeyWhitelistedValidators  = []byte("WhitelistedValidators")
-	KeyUnstakeFeeRate         = []byte("UnstakeFeeRate")
-	KeyMinLiquidStakingAmount = []byte("MinLiquidStakingAmount")
+	KeyLiquidBondDenom         = []byte("LiquidBondDenom")
+	KeyWhitelistedValidators   = []byte("WhitelistedValidators")
+	KeyUnstakeFeeRate          = []byte("UnstakeFeeRate")
+	KeyMinLiquidStakingAmount  = []byte("MinLiquidStakingAmount")
+	KeyRewardTrigger           = []byte("RewardTrigger")
+	KeyRebalancingTrigger      = []byte("RebalancingTrigger")
+	KeyMaxRedelegations        = []byte("MaxRedelegationsPerBlock")
+	KeyRewardFeeRate           = []byte("RewardFeeRate")
+	KeyRewardFeeAddress        = []byte("RewardFeeAddress")
+	KeyInstantUnstakeReserve   = []byte("InstantUnstakeReserveRatio")
+	KeyInstantUnstakeFeeRate   = []byte("InstantUnstakeFeeRate")
+	KeyPauserAddress           = []byte("PauserAddress")
+	KeyMaxPreferredWeightShift = []byte("MaxPreferredWeightShift")
+	KeySnapshotInterval        = []byte("NetAmountStateSnapshotInterval")
+	KeyMaxSnapshots            = []byte("MaxNetAmountStateSnapshots")
 
 	DefaultLiquidBondDenom = "bstake"
 
@@ -26,13 +37,40 @@ var (
 	// DefaultMinLiquidStakingAmount is the default minimum liquid staking amount.
 	DefaultMinLiquidStakingAmount = sdk.NewInt(1000000)
 
-	// Const variables
+	// DefaultRebalancingTrigger is the default Rebalancing Trigger, if the maximum difference and needed each redelegation amount exceeds it, asset rebalacing will be executed.
+	DefaultRebalancingTrigger = sdk.NewDecWithPrec(1, 3) // "0.001000000000000000"
+
+	// DefaultRewardTrigger is the default Reward Trigger, if the sum of balance and the upcoming rewards of LiquidStakingProxyAcc exceeds it, the reward is automatically withdrawn and re-stake according to the weights.
+	DefaultRewardTrigger = sdk.NewDecWithPrec(1, 3) // "0.001000000000000000"
+
+	// DefaultMaxRedelegationsPerBlock is the default maximum number of redelegations attempted by a rebalancing in a block.
+	DefaultMaxRedelegationsPerBlock uint32 = 20
+
+	// DefaultRewardFeeRate is the default Reward Fee Rate.
+	DefaultRewardFeeRate = sdk.ZeroDec()
+
+	// DefaultRewardFeeAddress is the default Reward Fee Address, no reward fee is charged without it.
+	DefaultRewardFeeAddress = ""
+
+	// DefaultInstantUnstakeReserveRatio is the default Instant Unstake Reserve Ratio, instant unstaking is disabled by default.
+	DefaultInstantUnstakeReserveRatio = sdk.ZeroDec()
 
-	// RebalancingTrigger if the maximum difference and needed each redelegation amount exceeds it, asset rebalacing will be executed.
-	RebalancingTrigger = sdk.NewDecWithPrec(1, 3) // "0.001000000000000000"
+	// DefaultInstantUnstakeFeeRate is the default Instant Unstake Fee Rate.
+	DefaultInstantUnstakeFeeRate = sdk.NewDecWithPrec(5, 3) // "0.005000000000000000"
 
-	// RewardTrigger If the sum of balance and the upcoming rewards of LiquidStakingProxyAcc exceeds it, the reward is automatically withdrawn and re-stake according to the weights.
-	RewardTrigger = sdk.NewDecWithPrec(1, 3) // "0.001000000000000000"
+	// DefaultPauserAddress is the default Pauser Address, only the governance can pause the operations without it.
+	DefaultPauserAddress = ""
+
+	// DefaultMaxPreferredWeightShift is the default Max Preferred Weight Shift, preferred liquid staking is disabled by default.
+	DefaultMaxPreferredWeightShift = sdk.ZeroDec()
+
+	// DefaultNetAmountStateSnapshotInterval is the default block interval of the states history, about an hour.
+	DefaultNetAmountStateSnapshotInterval uint32 = 600
+
+	// DefaultMaxNetAmountStateSnapshots is the default number of retained snapshots, about a month of the default interval.
+	DefaultMaxNetAmountStateSnapshots uint32 = 720
+
+	// Const variables
 
 	// LiquidStakingProxyAcc is a proxy reserve account for delegation and undelegation.
 	LiquidStakingProxyAcc = authtypes.NewModuleAddress(ModuleName + "-LiquidStakingProxyAcc")
@@ -48,10 +86,21 @@ func ParamKeyTable() paramstypes.KeyTable {
 // DefaultParams returns the default liquidstaking module parameters.
 func DefaultParams() Params {
 	return Params{
-		WhitelistedValidators:  []WhitelistedValidator{},
-		LiquidBondDenom:        DefaultLiquidBondDenom,
-		UnstakeFeeRate:         DefaultUnstakeFeeRate,
-		MinLiquidStakingAmount: DefaultMinLiquidStakingAmount,
+		WhitelistedValidators:          []WhitelistedValidator{},
+		LiquidBondDenom:                DefaultLiquidBondDenom,
+		UnstakeFeeRate:                 DefaultUnstakeFeeRate,
+		MinLiquidStakingAmount:         DefaultMinLiquidStakingAmount,
+		RewardTrigger:                  DefaultRewardTrigger,
+		RebalancingTrigger:             DefaultRebalancingTrigger,
+		MaxRedelegationsPerBlock:       DefaultMaxRedelegationsPerBlock,
+		RewardFeeRate:                  DefaultRewardFeeRate,
+		RewardFeeAddress:               DefaultRewardFeeAddress,
+		InstantUnstakeReserveRatio:     DefaultInstantUnstakeReserveRatio,
+		InstantUnstakeFeeRate:          DefaultInstantUnstakeFeeRate,
+		PauserAddress:                  DefaultPauserAddress,
+		MaxPreferredWeightShift:        DefaultMaxPreferredWeightShift,
+		NetAmountStateSnapshotInterval: DefaultNetAmountStateSnapshotInterval,
+		MaxNetAmountStateSnapshots:     DefaultMaxNetAmountStateSnapshots,
 	}
 }
 
@@ -62,6 +111,17 @@ func (p *Params) ParamSetPairs() paramstypes.ParamSetPairs {
 		paramstypes.NewParamSetPair(KeyWhitelistedValidators, &p.WhitelistedValidators, validateWhitelistedValidators),
 		paramstypes.NewParamSetPair(KeyUnstakeFeeRate, &p.UnstakeFeeRate, validateUnstakeFeeRate),
 		paramstypes.NewParamSetPair(KeyMinLiquidStakingAmount, &p.MinLiquidStakingAmount, validateMinLiquidStakingAmount),
+		paramstypes.NewParamSetPair(KeyRewardTrigger, &p.RewardTrigger, validateRewardTrigger),
+		paramstypes.NewParamSetPair(KeyRebalancingTrigger, &p.RebalancingTrigger, validateRebalancingTrigger),
+		paramstypes.NewParamSetPair(KeyMaxRedelegations, &p.MaxRedelegationsPerBlock, validateMaxRedelegationsPerBlock),
+		paramstypes.NewParamSetPair(KeyRewardFeeRate, &p.RewardFeeRate, validateRewardFeeRate),
+		paramstypes.NewParamSetPair(KeyRewardFeeAddress, &p.RewardFeeAddress, validateRewardFeeAddress),
+		paramstypes.NewParamSetPair(KeyInstantUnstakeReserve, &p.InstantUnstakeReserveRatio, validateInstantUnstakeReserveRatio),
+		paramstypes.NewParamSetPair(KeyInstantUnstakeFeeRate, &p.InstantUnstakeFeeRate, validateInstantUnstakeFeeRate),
+		paramstypes.NewParamSetPair(KeyPauserAddress, &p.PauserAddress, validatePauserAddress),
+		paramstypes.NewParamSetPair(KeyMaxPreferredWeightShift, &p.MaxPreferredWeightShift, validateMaxPreferredWeightShift),
+		paramstypes.NewParamSetPair(KeySnapshotInterval, &p.NetAmountStateSnapshotInterval, validateNetAmountStateSnapshotInterval),
+		paramstypes.NewParamSetPair(KeyMaxSnapshots, &p.MaxNetAmountStateSnapshots, validateMaxNetAmountStateSnapshots),
 	}
 }
 
@@ -85,11 +145,26 @@ func (p Params) Validate() error {
 		{p.WhitelistedValidators, validateWhitelistedValidators},
 		{p.UnstakeFeeRate, validateUnstakeFeeRate},
 		{p.MinLiquidStakingAmount, validateMinLiquidStakingAmount},
+		{p.RewardTrigger, validateRewardTrigger},
+		{p.RebalancingTrigger, validateRebalancingTrigger},
+		{p.MaxRedelegationsPerBlock, validateMaxRedelegationsPerBlock},
+		{p.RewardFeeRate, validateRewardFeeRate},
+		{p.RewardFeeAddress, validateRewardFeeAddress},
+		{p.InstantUnstakeReserveRatio, validateInstantUnstakeReserveRatio},
+		{p.InstantUnstakeFeeRate, validateInstantUnstakeFeeRate},
+		{p.PauserAddress, validatePauserAddress},
+		{p.MaxPreferredWeightShift, validateMaxPreferredWeightShift},
+		{p.NetAmountStateSnapshotInterval, validateNetAmountStateSnapshotInterval},
+		{p.MaxNetAmountStateSnapshots, validateMaxNetAmountStateSnapshots},
 	} {
 		if err := v.validator(v.value); err != nil {
 			return err
 		}
 	}
+
+	if p.InstantUnstakeFeeRate.LT(p.UnstakeFeeRate) {
+		return fmt.Errorf("instant unstake fee rate %s must not be less than the unstake fee rate %s", p.InstantUnstakeFeeRate, p.UnstakeFeeRate)
+	}
 	return nil
 }
 
@@ -138,6 +213,21 @@ func validateWhitelistedValidators(i interface{}) error {
 	return nil
 }
 
+// validateValidatorAddresses validates the validator addresses are valid and not duplicated.
+func validateValidatorAddresses(addrs []string) error {
+	addrsMap := map[string]struct{}{}
+	for _, addr := range addrs {
+		if _, err := sdk.ValAddressFromBech32(addr); err != nil {
+			return err
+		}
+		if _, ok := addrsMap[addr]; ok {
+			return fmt.Errorf("validator address cannot be duplicated: %s", addr)
+		}
+		addrsMap[addr] = struct{}{}
+	}
+	return nil
+}
+
 func validateUnstakeFeeRate(i interface{}) error {
 	v, ok := i.(sdk.Dec)
 	if !ok {
@@ -175,3 +265,199 @@ func validateMinLiquidStakingAmount(i interface{}) error {
 
 	return nil
 }
+
+func validateRewardTrigger(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("reward trigger must not be nil")
+	}
+
+	if v.IsNegative() {
+		return fmt.Errorf("reward trigger must not be negative: %s", v)
+	}
+
+	if v.GT(sdk.OneDec()) {
+		return fmt.Errorf("reward trigger too large: %s", v)
+	}
+
+	return nil
+}
+
+func validateRebalancingTrigger(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("rebalancing trigger must not be nil")
+	}
+
+	if v.IsNegative() {
+		return fmt.Errorf("rebalancing trigger must not be negative: %s", v)
+	}
+
+	if v.GT(sdk.OneDec()) {
+		return fmt.Errorf("rebalancing trigger too large: %s", v)
+	}
+
+	return nil
+}
+
+func validateMaxRedelegationsPerBlock(i interface{}) error {
+	v, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("max redelegations per block must be positive: %d", v)
+	}
+
+	return nil
+}
+
+func validateRewardFeeRate(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("reward fee rate must not be nil")
+	}
+
+	if v.IsNegative() {
+		return fmt.Errorf("reward fee rate must not be negative: %s", v)
+	}
+
+	if v.GT(sdk.OneDec()) {
+		return fmt.Errorf("reward fee rate too large: %s", v)
+	}
+
+	return nil
+}
+
+func validateRewardFeeAddress(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == "" {
+		return nil
+	}
+
+	if _, err := sdk.AccAddressFromBech32(v); err != nil {
+		return fmt.Errorf("invalid reward fee address %s: %w", v, err)
+	}
+
+	return nil
+}
+
+func validateInstantUnstakeReserveRatio(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("instant unstake reserve ratio must not be nil")
+	}
+
+	if v.IsNegative() {
+		return fmt.Errorf("instant unstake reserve ratio must not be negative: %s", v)
+	}
+
+	if v.GT(sdk.OneDec()) {
+		return fmt.Errorf("instant unstake reserve ratio too large: %s", v)
+	}
+
+	return nil
+}
+
+func validateInstantUnstakeFeeRate(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("instant unstake fee rate must not be nil")
+	}
+
+	if v.IsNegative() {
+		return fmt.Errorf("instant unstake fee rate must not be negative: %s", v)
+	}
+
+	if v.GT(sdk.OneDec()) {
+		return fmt.Errorf("instant unstake fee rate too large: %s", v)
+	}
+
+	return nil
+}
+
+func validatePauserAddress(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == "" {
+		return nil
+	}
+
+	if _, err := sdk.AccAddressFromBech32(v); err != nil {
+		return fmt.Errorf("invalid pauser address %s: %w", v, err)
+	}
+
+	return nil
+}
+
+func validateMaxPreferredWeightShift(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("max preferred weight shift must not be nil")
+	}
+
+	if v.IsNegative() {
+		return fmt.Errorf("max preferred weight shift must not be negative: %s", v)
+	}
+
+	// the active liquid validators must keep a positive weight
+	if v.GTE(sdk.OneDec()) {
+		return fmt.Errorf("max preferred weight shift must be less than 1: %s", v)
+	}
+
+	return nil
+}
+
+func validateNetAmountStateSnapshotInterval(i interface{}) error {
+	if _, ok := i.(uint32); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateMaxNetAmountStateSnapshots(i interface{}) error {
+	v, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	// the latest snapshot is always retained to estimate the APY
+	if v == 0 {
+		return fmt.Errorf("max net amount state snapshots must be positive: %d", v)
+	}
+
+	return nil
+}
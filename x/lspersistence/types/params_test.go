@@ -21,6 +21,17 @@ func TestParams(t *testing.T) {
 whitelisted_validators: []
 unstake_fee_rate: "0.001000000000000000"
 min_liquid_staking_amount: "1000000"
+reward_trigger: "0.001000000000000000"
+rebalancing_trigger: "0.001000000000000000"
+max_redelegations_per_block: 20
+reward_fee_rate: "0.000000000000000000"
+reward_fee_address: ""
+instant_unstake_reserve_ratio: "0.000000000000000000"
+instant_unstake_fee_rate: "0.005000000000000000"
+pauser_address: ""
+max_preferred_weight_shift: "0.000000000000000000"
+net_amount_state_snapshot_interval: 600
+max_net_amount_state_snapshots: 720
 `
 	require.Equal(t, paramsStr, params.String())
 
@@ -36,6 +47,17 @@ whitelisted_validators:
   target_weight: "10"
 unstake_fee_rate: "0.001000000000000000"
 min_liquid_staking_amount: "1000000"
+reward_trigger: "0.001000000000000000"
+rebalancing_trigger: "0.001000000000000000"
+max_redelegations_per_block: 20
+reward_fee_rate: "0.000000000000000000"
+reward_fee_address: ""
+instant_unstake_reserve_ratio: "0.000000000000000000"
+instant_unstake_fee_rate: "0.005000000000000000"
+pauser_address: ""
+max_preferred_weight_shift: "0.000000000000000000"
+net_amount_state_snapshot_interval: 600
+max_net_amount_state_snapshots: 720
 `
 	require.Equal(t, paramsStr, params.String())
 }
@@ -184,6 +206,183 @@ func TestValidateWhitelistedValidators(t *testing.T) {
 			},
 			"min liquid staking amount must not be negative: -1",
 		},
+		{
+			"nil reward trigger",
+			func(params *types.Params) {
+				params.RewardTrigger = sdk.Dec{}
+			},
+			"reward trigger must not be nil",
+		},
+		{
+			"negative reward trigger",
+			func(params *types.Params) {
+				params.RewardTrigger = sdk.NewDec(-1)
+			},
+			"reward trigger must not be negative: -1.000000000000000000",
+		},
+		{
+			"too large reward trigger",
+			func(params *types.Params) {
+				params.RewardTrigger = sdk.MustNewDecFromStr("1.0000001")
+			},
+			"reward trigger too large: 1.000000100000000000",
+		},
+		{
+			"nil rebalancing trigger",
+			func(params *types.Params) {
+				params.RebalancingTrigger = sdk.Dec{}
+			},
+			"rebalancing trigger must not be nil",
+		},
+		{
+			"negative rebalancing trigger",
+			func(params *types.Params) {
+				params.RebalancingTrigger = sdk.NewDec(-1)
+			},
+			"rebalancing trigger must not be negative: -1.000000000000000000",
+		},
+		{
+			"too large rebalancing trigger",
+			func(params *types.Params) {
+				params.RebalancingTrigger = sdk.MustNewDecFromStr("1.0000001")
+			},
+			"rebalancing trigger too large: 1.000000100000000000",
+		},
+		{
+			"zero max redelegations per block",
+			func(params *types.Params) {
+				params.MaxRedelegationsPerBlock = 0
+			},
+			"max redelegations per block must be positive: 0",
+		},
+		{
+			"nil reward fee rate",
+			func(params *types.Params) {
+				params.RewardFeeRate = sdk.Dec{}
+			},
+			"reward fee rate must not be nil",
+		},
+		{
+			"negative reward fee rate",
+			func(params *types.Params) {
+				params.RewardFeeRate = sdk.NewDec(-1)
+			},
+			"reward fee rate must not be negative: -1.000000000000000000",
+		},
+		{
+			"too large reward fee rate",
+			func(params *types.Params) {
+				params.RewardFeeRate = sdk.MustNewDecFromStr("1.0000001")
+			},
+			"reward fee rate too large: 1.000000100000000000",
+		},
+		{
+			"valid reward fee address",
+			func(params *types.Params) {
+				params.RewardFeeRate = sdk.NewDecWithPrec(5, 2)
+				params.RewardFeeAddress = "persistence1hfe6arauppr5hdqje49tfqm60k24mhzfuy98c4"
+			},
+			"",
+		},
+		{
+			"invalid reward fee address",
+			func(params *types.Params) {
+				params.RewardFeeAddress = "invalidAddr"
+			},
+			"invalid reward fee address invalidAddr: decoding bech32 failed: string not all lowercase or all uppercase",
+		},
+		{
+			"nil instant unstake reserve ratio",
+			func(params *types.Params) {
+				params.InstantUnstakeReserveRatio = sdk.Dec{}
+			},
+			"instant unstake reserve ratio must not be nil",
+		},
+		{
+			"negative instant unstake reserve ratio",
+			func(params *types.Params) {
+				params.InstantUnstakeReserveRatio = sdk.NewDec(-1)
+			},
+			"instant unstake reserve ratio must not be negative: -1.000000000000000000",
+		},
+		{
+			"too large instant unstake reserve ratio",
+			func(params *types.Params) {
+				params.InstantUnstakeReserveRatio = sdk.MustNewDecFromStr("1.0000001")
+			},
+			"instant unstake reserve ratio too large: 1.000000100000000000",
+		},
+		{
+			"nil instant unstake fee rate",
+			func(params *types.Params) {
+				params.InstantUnstakeFeeRate = sdk.Dec{}
+			},
+			"instant unstake fee rate must not be nil",
+		},
+		{
+			"too large instant unstake fee rate",
+			func(params *types.Params) {
+				params.InstantUnstakeFeeRate = sdk.MustNewDecFromStr("1.0000001")
+			},
+			"instant unstake fee rate too large: 1.000000100000000000",
+		},
+		{
+			"instant unstake fee rate less than the unstake fee rate",
+			func(params *types.Params) {
+				params.UnstakeFeeRate = sdk.NewDecWithPrec(2, 2)
+				params.InstantUnstakeFeeRate = sdk.NewDecWithPrec(1, 2)
+			},
+			"instant unstake fee rate 0.010000000000000000 must not be less than the unstake fee rate 0.020000000000000000",
+		},
+		{
+			"valid pauser address",
+			func(params *types.Params) {
+				params.PauserAddress = "persistence1hfe6arauppr5hdqje49tfqm60k24mhzfuy98c4"
+			},
+			"",
+		},
+		{
+			"invalid pauser address",
+			func(params *types.Params) {
+				params.PauserAddress = "invalidAddr"
+			},
+			"invalid pauser address invalidAddr: decoding bech32 failed: string not all lowercase or all uppercase",
+		},
+		{
+			"nil max preferred weight shift",
+			func(params *types.Params) {
+				params.MaxPreferredWeightShift = sdk.Dec{}
+			},
+			"max preferred weight shift must not be nil",
+		},
+		{
+			"negative max preferred weight shift",
+			func(params *types.Params) {
+				params.MaxPreferredWeightShift = sdk.MustNewDecFromStr("-0.1")
+			},
+			"max preferred weight shift must not be negative: -0.100000000000000000",
+		},
+		{
+			"too large max preferred weight shift",
+			func(params *types.Params) {
+				params.MaxPreferredWeightShift = sdk.OneDec()
+			},
+			"max preferred weight shift must be less than 1: 1.000000000000000000",
+		},
+		{
+			"disabled states history",
+			func(params *types.Params) {
+				params.NetAmountStateSnapshotInterval = 0
+			},
+			"",
+		},
+		{
+			"zero max net amount state snapshots",
+			func(params *types.Params) {
+				params.MaxNetAmountStateSnapshots = 0
+			},
+			"max net amount state snapshots must be positive: 0",
+		},
 	} {
 		t.Run(tc.name, func(t *testing.T) {
 			params := types.DefaultParams()
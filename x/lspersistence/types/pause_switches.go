@@ -0,0 +1,9 @@
+package types
+
+// Resumes returns whether the switches resume any operation paused by the current switches.
+func (s PauseSwitches) Resumes(current PauseSwitches) bool {
+	return (current.LiquidStakePaused && !s.LiquidStakePaused) ||
+		(current.LiquidUnstakePaused && !s.LiquidUnstakePaused) ||
+		(current.RebalancingPaused && !s.RebalancingPaused) ||
+		(current.RewardRestakingPaused && !s.RewardRestakingPaused)
+}
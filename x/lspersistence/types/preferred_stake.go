@@ -0,0 +1,83 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewPreferredStake returns a new PreferredStake.
+func NewPreferredStake(delegator sdk.AccAddress, operatorAddr sdk.ValAddress, amount math.Int) PreferredStake {
+	return PreferredStake{
+		DelegatorAddress: delegator.String(),
+		ValidatorAddress: operatorAddr.String(),
+		Amount:           amount,
+	}
+}
+
+// GetDelegator returns the delegator of the preferred stake.
+func (s PreferredStake) GetDelegator() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(s.DelegatorAddress)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+// GetValidator returns the preferred validator of the preferred stake.
+func (s PreferredStake) GetValidator() sdk.ValAddress {
+	addr, err := sdk.ValAddressFromBech32(s.ValidatorAddress)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+// Validate validates the preferred stake.
+func (s PreferredStake) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(s.DelegatorAddress); err != nil {
+		return fmt.Errorf("invalid delegator address %s: %w", s.DelegatorAddress, err)
+	}
+	if _, err := sdk.ValAddressFromBech32(s.ValidatorAddress); err != nil {
+		return fmt.Errorf("invalid validator address %s: %w", s.ValidatorAddress, err)
+	}
+	if s.Amount.IsNil() || !s.Amount.IsPositive() {
+		return fmt.Errorf("preferred stake of %s for %s must be positive: %s", s.DelegatorAddress, s.ValidatorAddress, s.Amount)
+	}
+	return nil
+}
+
+// validatePreferredValidators validates the preferred validators are valid, not duplicated and positively weighted.
+func validatePreferredValidators(pvs []PreferredValidator) error {
+	valsMap := map[string]struct{}{}
+	for _, pv := range pvs {
+		if _, err := sdk.ValAddressFromBech32(pv.ValidatorAddress); err != nil {
+			return err
+		}
+		if pv.Weight.IsNil() || !pv.Weight.IsPositive() {
+			return fmt.Errorf("preferred validator weight must be positive: %s", pv.Weight)
+		}
+		if _, ok := valsMap[pv.ValidatorAddress]; ok {
+			return fmt.Errorf("preferred validator cannot be duplicated: %s", pv.ValidatorAddress)
+		}
+		valsMap[pv.ValidatorAddress] = struct{}{}
+	}
+	return nil
+}
+
+// DivideByPreferredWeight divides the input value by the weights of the preferred validators, the crumb is left
+// out of the outputs.
+func DivideByPreferredWeight(pvs []PreferredValidator, input math.Int) (outputs []math.Int) {
+	totalWeight := sdk.ZeroInt()
+	for _, pv := range pvs {
+		totalWeight = totalWeight.Add(pv.Weight)
+	}
+	if !totalWeight.IsPositive() {
+		return outputs
+	}
+	for _, pv := range pvs {
+		outputs = append(outputs, input.Mul(pv.Weight).Quo(totalWeight))
+	}
+	return outputs
+}
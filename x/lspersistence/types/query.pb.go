@@ -6,6 +6,10 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
@@ -275,465 +279,5701 @@ func (m *QueryStatesResponse) GetNetAmountState() NetAmountState {
 	return NetAmountState{}
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "pstake.lspersistence.v1beta1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "pstake.lspersistence.v1beta1.QueryParamsResponse")
-	proto.RegisterType((*QueryLiquidValidatorsRequest)(nil), "pstake.lspersistence.v1beta1.QueryLiquidValidatorsRequest")
-	proto.RegisterType((*QueryLiquidValidatorsResponse)(nil), "pstake.lspersistence.v1beta1.QueryLiquidValidatorsResponse")
-	proto.RegisterType((*QueryStatesRequest)(nil), "pstake.lspersistence.v1beta1.QueryStatesRequest")
-	proto.RegisterType((*QueryStatesResponse)(nil), "pstake.lspersistence.v1beta1.QueryStatesResponse")
+// QueryVotingPowerRequest is the request type for the Query/VotingPower RPC method.
+type QueryVotingPowerRequest struct {
+	Voter string `protobuf:"bytes,1,opt,name=voter,proto3" json:"voter,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("pstake/lspersistence/v1beta1/query.proto", fileDescriptor_686f3882ea1eb1bb)
+func (m *QueryVotingPowerRequest) Reset()         { *m = QueryVotingPowerRequest{} }
+func (m *QueryVotingPowerRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVotingPowerRequest) ProtoMessage()    {}
+func (*QueryVotingPowerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_686f3882ea1eb1bb, []int{6}
 }
-
-var fileDescriptor_686f3882ea1eb1bb = []byte{
-	// 466 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0xcf, 0x6b, 0x13, 0x41,
-	0x14, 0xc7, 0x33, 0x5a, 0x73, 0x98, 0x82, 0xc4, 0xb1, 0x87, 0x12, 0xe2, 0x5a, 0x42, 0x91, 0x20,
-	0x75, 0x27, 0x59, 0x6f, 0x7a, 0xd1, 0x9c, 0xc5, 0x1f, 0x15, 0x04, 0x8b, 0x10, 0x26, 0xed, 0x63,
-	0x1d, 0xdc, 0xcc, 0x6c, 0x76, 0xde, 0x06, 0x7b, 0x55, 0xf0, 0x2c, 0x78, 0xf4, 0x6f, 0xf1, 0xde,
-	0x63, 0xc1, 0x8b, 0x27, 0x91, 0xc4, 0x3f, 0x44, 0x32, 0x33, 0xae, 0xdd, 0x95, 0x2c, 0xab, 0xb7,
-	0xe5, 0xcd, 0xfb, 0xbe, 0xf7, 0x79, 0xdf, 0xf7, 0x58, 0x3a, 0x48, 0x0d, 0x8a, 0x37, 0xc0, 0x13,
-	0x93, 0x42, 0x66, 0xa4, 0x41, 0x50, 0xc7, 0xc0, 0x17, 0xa3, 0x29, 0xa0, 0x18, 0xf1, 0x79, 0x0e,
-	0xd9, 0x69, 0x98, 0x66, 0x1a, 0x35, 0xeb, 0xb9, 0xcc, 0xb0, 0x94, 0x19, 0xfa, 0xcc, 0x6e, 0x2f,
-	0xd6, 0x3a, 0x4e, 0x80, 0x8b, 0x54, 0x72, 0xa1, 0x94, 0x46, 0x81, 0x52, 0x2b, 0xe3, 0xb4, 0xdd,
-	0x61, 0x6d, 0x97, 0x44, 0xce, 0x73, 0x79, 0xb2, 0xce, 0x90, 0x2a, 0xf6, 0x8a, 0x9d, 0x58, 0xc7,
-	0xda, 0x7e, 0xf2, 0xf5, 0x97, 0x8b, 0xf6, 0x77, 0x28, 0x7b, 0xb6, 0x46, 0x7a, 0x2a, 0x32, 0x31,
-	0x33, 0x87, 0x30, 0xcf, 0xc1, 0x60, 0xff, 0x25, 0xbd, 0x5e, 0x8a, 0x9a, 0x54, 0x2b, 0x03, 0x6c,
-	0x4c, 0xdb, 0xa9, 0x8d, 0xec, 0x92, 0x3d, 0x32, 0xd8, 0x8e, 0xf6, 0xc3, 0xba, 0x09, 0x42, 0xa7,
-	0x1e, 0x6f, 0x9d, 0x7d, 0xbf, 0xd9, 0x3a, 0xf4, 0xca, 0x7e, 0x40, 0x7b, 0xb6, 0xf4, 0x23, 0x8b,
-	0xf8, 0x42, 0x24, 0xf2, 0x44, 0xa0, 0xce, 0x8a, 0xd6, 0x1f, 0x08, 0xbd, 0xb1, 0x21, 0xc1, 0x53,
-	0x00, 0xbd, 0xe6, 0xe6, 0x9b, 0x2c, 0x8a, 0xc7, 0x5d, 0xb2, 0x77, 0x79, 0xb0, 0x1d, 0x45, 0xf5,
-	0x40, 0x95, 0x92, 0xcf, 0x51, 0x20, 0x78, 0xbc, 0x4e, 0x52, 0x69, 0x57, 0x38, 0x63, 0xb3, 0x0a,
-	0x3c, 0xe3, 0x9d, 0xf9, 0x1d, 0xf5, 0x4c, 0xaf, 0x68, 0x47, 0x01, 0x4e, 0xc4, 0x4c, 0xe7, 0x0a,
-	0x27, 0x66, 0xfd, 0xe8, 0x3d, 0x3a, 0xa8, 0x47, 0x7a, 0x0c, 0xf8, 0xd0, 0x8a, 0x2e, 0xc2, 0x5c,
-	0x55, 0xa5, 0x68, 0xf4, 0x7e, 0x8b, 0x5e, 0xb1, 0x5d, 0xd9, 0x67, 0x42, 0xdb, 0xce, 0x56, 0x36,
-	0xac, 0x2f, 0xfc, 0xf7, 0x56, 0xbb, 0xa3, 0x7f, 0x50, 0xb8, 0xb9, 0xfa, 0x07, 0xef, 0xbe, 0xfe,
-	0xfc, 0x74, 0xe9, 0x16, 0xdb, 0xe7, 0xb5, 0xf7, 0xe6, 0x76, 0xcb, 0xbe, 0x10, 0xda, 0xa9, 0xae,
-	0x8d, 0xdd, 0x6b, 0xd0, 0x75, 0xc3, 0x31, 0x74, 0xef, 0xff, 0x97, 0xd6, 0xb3, 0x0f, 0x2d, 0xfb,
-	0x6d, 0x36, 0xa8, 0x67, 0xff, 0x73, 0x44, 0xd6, 0x5d, 0xb7, 0xd8, 0x46, 0xee, 0x96, 0x2e, 0xa3,
-	0x91, 0xbb, 0xe5, 0xab, 0x69, 0xea, 0xae, 0x3d, 0x27, 0x33, 0x3e, 0x3a, 0x5b, 0x06, 0xe4, 0x7c,
-	0x19, 0x90, 0x1f, 0xcb, 0x80, 0x7c, 0x5c, 0x05, 0xad, 0xf3, 0x55, 0xd0, 0xfa, 0xb6, 0x0a, 0x5a,
-	0x47, 0x0f, 0x62, 0x89, 0xaf, 0xf3, 0x69, 0x78, 0xac, 0x67, 0xfc, 0x42, 0x81, 0x27, 0x0a, 0x7c,
-	0xe1, 0x3b, 0x4a, 0xa0, 0x5c, 0x00, 0x5f, 0x44, 0xfc, 0x6d, 0xa5, 0x09, 0x9e, 0xa6, 0x60, 0xa6,
-	0x6d, 0xfb, 0x37, 0xb8, 0xfb, 0x2b, 0x00, 0x00, 0xff, 0xff, 0x46, 0x87, 0xb0, 0x44, 0xbd, 0x04,
-	0x00, 0x00,
+func (m *QueryVotingPowerRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVotingPowerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVotingPowerRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVotingPowerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVotingPowerRequest.Merge(m, src)
+}
+func (m *QueryVotingPowerRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVotingPowerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVotingPowerRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryVotingPowerRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Params returns parameters of the liquidstaking module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// LiquidValidators returns liquid validators with states of the liquidstaking module.
-	LiquidValidators(ctx context.Context, in *QueryLiquidValidatorsRequest, opts ...grpc.CallOption) (*QueryLiquidValidatorsResponse, error)
-	// States returns states of the liquidstaking module.
-	States(ctx context.Context, in *QueryStatesRequest, opts ...grpc.CallOption) (*QueryStatesResponse, error)
+func (m *QueryVotingPowerRequest) GetVoter() string {
+	if m != nil {
+		return m.Voter
+	}
+	return ""
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+// QueryVotingPowerResponse is the response type for the Query/VotingPower RPC method.
+type QueryVotingPowerResponse struct {
+	VotingPower VotingPower `protobuf:"bytes,1,opt,name=voting_power,json=votingPower,proto3" json:"voting_power"`
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryVotingPowerResponse) Reset()         { *m = QueryVotingPowerResponse{} }
+func (m *QueryVotingPowerResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVotingPowerResponse) ProtoMessage()    {}
+func (*QueryVotingPowerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_686f3882ea1eb1bb, []int{7}
 }
-
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/pstake.lspersistence.v1beta1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryVotingPowerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) LiquidValidators(ctx context.Context, in *QueryLiquidValidatorsRequest, opts ...grpc.CallOption) (*QueryLiquidValidatorsResponse, error) {
-	out := new(QueryLiquidValidatorsResponse)
-	err := c.cc.Invoke(ctx, "/pstake.lspersistence.v1beta1.Query/LiquidValidators", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryVotingPowerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVotingPowerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryVotingPowerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVotingPowerResponse.Merge(m, src)
+}
+func (m *QueryVotingPowerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVotingPowerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVotingPowerResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) States(ctx context.Context, in *QueryStatesRequest, opts ...grpc.CallOption) (*QueryStatesResponse, error) {
-	out := new(QueryStatesResponse)
-	err := c.cc.Invoke(ctx, "/pstake.lspersistence.v1beta1.Query/States", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryVotingPowerResponse proto.InternalMessageInfo
+
+func (m *QueryVotingPowerResponse) GetVotingPower() VotingPower {
+	if m != nil {
+		return m.VotingPower
 	}
-	return out, nil
+	return VotingPower{}
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Params returns parameters of the liquidstaking module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// LiquidValidators returns liquid validators with states of the liquidstaking module.
-	LiquidValidators(context.Context, *QueryLiquidValidatorsRequest) (*QueryLiquidValidatorsResponse, error)
-	// States returns states of the liquidstaking module.
-	States(context.Context, *QueryStatesRequest) (*QueryStatesResponse, error)
+// QueryCollectedRewardFeesRequest is the request type for the Query/CollectedRewardFees RPC method.
+type QueryCollectedRewardFeesRequest struct {
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryCollectedRewardFeesRequest) Reset()         { *m = QueryCollectedRewardFeesRequest{} }
+func (m *QueryCollectedRewardFeesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCollectedRewardFeesRequest) ProtoMessage()    {}
+func (*QueryCollectedRewardFeesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_686f3882ea1eb1bb, []int{8}
 }
-
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryCollectedRewardFeesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) LiquidValidators(ctx context.Context, req *QueryLiquidValidatorsRequest) (*QueryLiquidValidatorsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LiquidValidators not implemented")
+func (m *QueryCollectedRewardFeesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCollectedRewardFeesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) States(ctx context.Context, req *QueryStatesRequest) (*QueryStatesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method States not implemented")
+func (m *QueryCollectedRewardFeesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCollectedRewardFeesRequest.Merge(m, src)
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryCollectedRewardFeesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCollectedRewardFeesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCollectedRewardFeesRequest.DiscardUnknown(m)
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pstake.lspersistence.v1beta1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_QueryCollectedRewardFeesRequest proto.InternalMessageInfo
+
+// QueryCollectedRewardFeesResponse is the response type for the Query/CollectedRewardFees RPC method.
+type QueryCollectedRewardFeesResponse struct {
+	CollectedRewardFees github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=collected_reward_fees,json=collectedRewardFees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"collected_reward_fees"`
 }
 
-func _Query_LiquidValidators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryLiquidValidatorsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).LiquidValidators(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pstake.lspersistence.v1beta1.Query/LiquidValidators",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).LiquidValidators(ctx, req.(*QueryLiquidValidatorsRequest))
+func (m *QueryCollectedRewardFeesResponse) Reset()         { *m = QueryCollectedRewardFeesResponse{} }
+func (m *QueryCollectedRewardFeesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCollectedRewardFeesResponse) ProtoMessage()    {}
+func (*QueryCollectedRewardFeesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_686f3882ea1eb1bb, []int{9}
+}
+func (m *QueryCollectedRewardFeesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCollectedRewardFeesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCollectedRewardFeesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryCollectedRewardFeesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCollectedRewardFeesResponse.Merge(m, src)
+}
+func (m *QueryCollectedRewardFeesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCollectedRewardFeesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCollectedRewardFeesResponse.DiscardUnknown(m)
 }
 
-func _Query_States_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryStatesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).States(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pstake.lspersistence.v1beta1.Query/States",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).States(ctx, req.(*QueryStatesRequest))
+var xxx_messageInfo_QueryCollectedRewardFeesResponse proto.InternalMessageInfo
+
+func (m *QueryCollectedRewardFeesResponse) GetCollectedRewardFees() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.CollectedRewardFees
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "pstake.lspersistence.v1beta1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "LiquidValidators",
-			Handler:    _Query_LiquidValidators_Handler,
-		},
-		{
-			MethodName: "States",
-			Handler:    _Query_States_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "pstake/lspersistence/v1beta1/query.proto",
+// QueryUnbondingRequestsRequest is the request type for the Query/UnbondingRequests RPC method.
+type QueryUnbondingRequestsRequest struct {
+	DelegatorAddress string             `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	Pagination       *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryUnbondingRequestsRequest) Reset()         { *m = QueryUnbondingRequestsRequest{} }
+func (m *QueryUnbondingRequestsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryUnbondingRequestsRequest) ProtoMessage()    {}
+func (*QueryUnbondingRequestsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_686f3882ea1eb1bb, []int{10}
+}
+func (m *QueryUnbondingRequestsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUnbondingRequestsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUnbondingRequestsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryUnbondingRequestsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUnbondingRequestsRequest.Merge(m, src)
+}
+func (m *QueryUnbondingRequestsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUnbondingRequestsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUnbondingRequestsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_QueryUnbondingRequestsRequest proto.InternalMessageInfo
+
+func (m *QueryUnbondingRequestsRequest) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
+	}
+	return ""
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryUnbondingRequestsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryUnbondingRequestsResponse is the response type for the Query/UnbondingRequests RPC method.
+type QueryUnbondingRequestsResponse struct {
+	UnbondingRequests []UnbondingRequest  `protobuf:"bytes,1,rep,name=unbonding_requests,json=unbondingRequests,proto3" json:"unbonding_requests"`
+	Pagination        *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+func (m *QueryUnbondingRequestsResponse) Reset()         { *m = QueryUnbondingRequestsResponse{} }
+func (m *QueryUnbondingRequestsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryUnbondingRequestsResponse) ProtoMessage()    {}
+func (*QueryUnbondingRequestsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_686f3882ea1eb1bb, []int{11}
+}
+func (m *QueryUnbondingRequestsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUnbondingRequestsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUnbondingRequestsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryUnbondingRequestsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUnbondingRequestsResponse.Merge(m, src)
+}
+func (m *QueryUnbondingRequestsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUnbondingRequestsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUnbondingRequestsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryLiquidValidatorsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryUnbondingRequestsResponse proto.InternalMessageInfo
+
+func (m *QueryUnbondingRequestsResponse) GetUnbondingRequests() []UnbondingRequest {
+	if m != nil {
+		return m.UnbondingRequests
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryLiquidValidatorsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryUnbondingRequestsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryLiquidValidatorsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// QueryInstantUnstakeReserveRequest is the request type for the Query/InstantUnstakeReserve RPC method.
+type QueryInstantUnstakeReserveRequest struct {
 }
 
-func (m *QueryLiquidValidatorsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryInstantUnstakeReserveRequest) Reset()         { *m = QueryInstantUnstakeReserveRequest{} }
+func (m *QueryInstantUnstakeReserveRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryInstantUnstakeReserveRequest) ProtoMessage()    {}
+func (*QueryInstantUnstakeReserveRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_686f3882ea1eb1bb, []int{12}
+}
+func (m *QueryInstantUnstakeReserveRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInstantUnstakeReserveRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInstantUnstakeReserveRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryInstantUnstakeReserveRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInstantUnstakeReserveRequest.Merge(m, src)
+}
+func (m *QueryInstantUnstakeReserveRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInstantUnstakeReserveRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInstantUnstakeReserveRequest.DiscardUnknown(m)
 }
 
-func (m *QueryLiquidValidatorsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryInstantUnstakeReserveRequest proto.InternalMessageInfo
+
+// QueryInstantUnstakeReserveResponse is the response type for the Query/InstantUnstakeReserve RPC method.
+type QueryInstantUnstakeReserveResponse struct {
+	// reserve is the native token balance of the proxy account available for instant unstaking
+	Reserve types.Coin `protobuf:"bytes,1,opt,name=reserve,proto3" json:"reserve"`
+	// target is the reserve kept unstaked by re-staking, InstantUnstakeReserveRatio of the NetAmount
+	Target types.Coin `protobuf:"bytes,2,opt,name=target,proto3" json:"target"`
 }
 
-func (m *QueryLiquidValidatorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.LiquidValidators) > 0 {
-		for iNdEx := len(m.LiquidValidators) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.LiquidValidators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryInstantUnstakeReserveResponse) Reset()         { *m = QueryInstantUnstakeReserveResponse{} }
+func (m *QueryInstantUnstakeReserveResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryInstantUnstakeReserveResponse) ProtoMessage()    {}
+func (*QueryInstantUnstakeReserveResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_686f3882ea1eb1bb, []int{13}
+}
+func (m *QueryInstantUnstakeReserveResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInstantUnstakeReserveResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInstantUnstakeReserveResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryStatesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryInstantUnstakeReserveResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInstantUnstakeReserveResponse.Merge(m, src)
 }
-
-func (m *QueryStatesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryInstantUnstakeReserveResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInstantUnstakeReserveResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInstantUnstakeReserveResponse.DiscardUnknown(m)
 }
 
-func (m *QueryStatesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_QueryInstantUnstakeReserveResponse proto.InternalMessageInfo
+
+func (m *QueryInstantUnstakeReserveResponse) GetReserve() types.Coin {
+	if m != nil {
+		return m.Reserve
+	}
+	return types.Coin{}
 }
 
-func (m *QueryStatesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryInstantUnstakeReserveResponse) GetTarget() types.Coin {
+	if m != nil {
+		return m.Target
 	}
-	return dAtA[:n], nil
+	return types.Coin{}
 }
 
-func (m *QueryStatesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QuerySlashingHistoryRequest is the request type for the Query/SlashingHistory RPC method.
+type QuerySlashingHistoryRequest struct {
+	// validator_address filters the slashing records of a liquid validator, optional
+	ValidatorAddress string             `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	Pagination       *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryStatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.NetAmountState.MarshalToSizedBuffer(dAtA[:i])
+func (m *QuerySlashingHistoryRequest) Reset()         { *m = QuerySlashingHistoryRequest{} }
+func (m *QuerySlashingHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySlashingHistoryRequest) ProtoMessage()    {}
+func (*QuerySlashingHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_686f3882ea1eb1bb, []int{14}
+}
+func (m *QuerySlashingHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySlashingHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySlashingHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QuerySlashingHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySlashingHistoryRequest.Merge(m, src)
+}
+func (m *QuerySlashingHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySlashingHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySlashingHistoryRequest.DiscardUnknown(m)
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_QuerySlashingHistoryRequest proto.InternalMessageInfo
+
+func (m *QuerySlashingHistoryRequest) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return ""
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *QuerySlashingHistoryRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+// QuerySlashingHistoryResponse is the response type for the Query/SlashingHistory RPC method.
+type QuerySlashingHistoryResponse struct {
+	SlashingRecords []SlashingRecord    `protobuf:"bytes,1,rep,name=slashing_records,json=slashingRecords,proto3" json:"slashing_records"`
+	Pagination      *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QuerySlashingHistoryResponse) Reset()         { *m = QuerySlashingHistoryResponse{} }
+func (m *QuerySlashingHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySlashingHistoryResponse) ProtoMessage()    {}
+func (*QuerySlashingHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_686f3882ea1eb1bb, []int{15}
+}
+func (m *QuerySlashingHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySlashingHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySlashingHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+}
+func (m *QuerySlashingHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySlashingHistoryResponse.Merge(m, src)
+}
+func (m *QuerySlashingHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySlashingHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySlashingHistoryResponse.DiscardUnknown(m)
 }
 
-func (m *QueryLiquidValidatorsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QuerySlashingHistoryResponse proto.InternalMessageInfo
+
+func (m *QuerySlashingHistoryResponse) GetSlashingRecords() []SlashingRecord {
+	if m != nil {
+		return m.SlashingRecords
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
 
-func (m *QueryLiquidValidatorsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QuerySlashingHistoryResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	var l int
-	_ = l
-	if len(m.LiquidValidators) > 0 {
-		for _, e := range m.LiquidValidators {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+
+// QueryPauseSwitchesRequest is the request type for the Query/PauseSwitches RPC method.
+type QueryPauseSwitchesRequest struct {
+}
+
+func (m *QueryPauseSwitchesRequest) Reset()         { *m = QueryPauseSwitchesRequest{} }
+func (m *QueryPauseSwitchesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPauseSwitchesRequest) ProtoMessage()    {}
+func (*QueryPauseSwitchesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_686f3882ea1eb1bb, []int{16}
+}
+func (m *QueryPauseSwitchesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPauseSwitchesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPauseSwitchesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryPauseSwitchesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPauseSwitchesRequest.Merge(m, src)
+}
+func (m *QueryPauseSwitchesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPauseSwitchesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPauseSwitchesRequest.DiscardUnknown(m)
 }
 
-func (m *QueryStatesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+var xxx_messageInfo_QueryPauseSwitchesRequest proto.InternalMessageInfo
+
+// QueryPauseSwitchesResponse is the response type for the Query/PauseSwitches RPC method.
+type QueryPauseSwitchesResponse struct {
+	PauseSwitches PauseSwitches `protobuf:"bytes,1,opt,name=pause_switches,json=pauseSwitches,proto3" json:"pause_switches"`
 }
 
-func (m *QueryStatesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryPauseSwitchesResponse) Reset()         { *m = QueryPauseSwitchesResponse{} }
+func (m *QueryPauseSwitchesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPauseSwitchesResponse) ProtoMessage()    {}
+func (*QueryPauseSwitchesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_686f3882ea1eb1bb, []int{17}
+}
+func (m *QueryPauseSwitchesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPauseSwitchesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPauseSwitchesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPauseSwitchesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPauseSwitchesResponse.Merge(m, src)
+}
+func (m *QueryPauseSwitchesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPauseSwitchesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPauseSwitchesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPauseSwitchesResponse proto.InternalMessageInfo
+
+func (m *QueryPauseSwitchesResponse) GetPauseSwitches() PauseSwitches {
+	if m != nil {
+		return m.PauseSwitches
+	}
+	return PauseSwitches{}
+}
+
+// QueryWhitelistChangePreviewRequest is the request type for the Query/WhitelistChangePreview RPC method, the changes
+// are applied in the order of adding, updating and removing.
+type QueryWhitelistChangePreviewRequest struct {
+	AddValidators    []WhitelistedValidator `protobuf:"bytes,1,rep,name=add_validators,json=addValidators,proto3" json:"add_validators"`
+	UpdateValidators []WhitelistedValidator `protobuf:"bytes,2,rep,name=update_validators,json=updateValidators,proto3" json:"update_validators"`
+	RemoveValidators []string               `protobuf:"bytes,3,rep,name=remove_validators,json=removeValidators,proto3" json:"remove_validators,omitempty"`
+}
+
+func (m *QueryWhitelistChangePreviewRequest) Reset()         { *m = QueryWhitelistChangePreviewRequest{} }
+func (m *QueryWhitelistChangePreviewRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryWhitelistChangePreviewRequest) ProtoMessage()    {}
+func (*QueryWhitelistChangePreviewRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_686f3882ea1eb1bb, []int{18}
+}
+func (m *QueryWhitelistChangePreviewRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryWhitelistChangePreviewRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryWhitelistChangePreviewRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryWhitelistChangePreviewRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryWhitelistChangePreviewRequest.Merge(m, src)
+}
+func (m *QueryWhitelistChangePreviewRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryWhitelistChangePreviewRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryWhitelistChangePreviewRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryWhitelistChangePreviewRequest proto.InternalMessageInfo
+
+func (m *QueryWhitelistChangePreviewRequest) GetAddValidators() []WhitelistedValidator {
+	if m != nil {
+		return m.AddValidators
+	}
+	return nil
+}
+
+func (m *QueryWhitelistChangePreviewRequest) GetUpdateValidators() []WhitelistedValidator {
+	if m != nil {
+		return m.UpdateValidators
+	}
+	return nil
+}
+
+func (m *QueryWhitelistChangePreviewRequest) GetRemoveValidators() []string {
+	if m != nil {
+		return m.RemoveValidators
+	}
+	return nil
+}
+
+// QueryWhitelistChangePreviewResponse is the response type for the Query/WhitelistChangePreview RPC method.
+type QueryWhitelistChangePreviewResponse struct {
+	// whitelisted_validators is the whitelist after the changes
+	WhitelistedValidators []WhitelistedValidator `protobuf:"bytes,1,rep,name=whitelisted_validators,json=whitelistedValidators,proto3" json:"whitelisted_validators"`
+	// redelegations are the redelegations of the rebalancing on the next BeginBlock, bounded by
+	// MaxRedelegationsPerBlock, the remaining gaps are rebalanced in the following blocks
+	Redelegations []RedelegationPreview `protobuf:"bytes,2,rep,name=redelegations,proto3" json:"redelegations"`
+	// unbondings are the unbondings of the inactive liquid validators on the next BeginBlock, the inactive liquid
+	// validators the redelegations are from are unbonded once they are not redelegated from anymore
+	Unbondings []UnbondingPreview `protobuf:"bytes,3,rep,name=unbondings,proto3" json:"unbondings"`
+}
+
+func (m *QueryWhitelistChangePreviewResponse) Reset()         { *m = QueryWhitelistChangePreviewResponse{} }
+func (m *QueryWhitelistChangePreviewResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryWhitelistChangePreviewResponse) ProtoMessage()    {}
+func (*QueryWhitelistChangePreviewResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_686f3882ea1eb1bb, []int{19}
+}
+func (m *QueryWhitelistChangePreviewResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryWhitelistChangePreviewResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryWhitelistChangePreviewResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryWhitelistChangePreviewResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryWhitelistChangePreviewResponse.Merge(m, src)
+}
+func (m *QueryWhitelistChangePreviewResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryWhitelistChangePreviewResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryWhitelistChangePreviewResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryWhitelistChangePreviewResponse proto.InternalMessageInfo
+
+func (m *QueryWhitelistChangePreviewResponse) GetWhitelistedValidators() []WhitelistedValidator {
+	if m != nil {
+		return m.WhitelistedValidators
+	}
+	return nil
+}
+
+func (m *QueryWhitelistChangePreviewResponse) GetRedelegations() []RedelegationPreview {
+	if m != nil {
+		return m.Redelegations
+	}
+	return nil
+}
+
+func (m *QueryWhitelistChangePreviewResponse) GetUnbondings() []UnbondingPreview {
+	if m != nil {
+		return m.Unbondings
+	}
+	return nil
+}
+
+// RedelegationPreview defines a redelegation of the liquid tokens of the proxy account triggered by a whitelist change.
+type RedelegationPreview struct {
+	SrcValidatorAddress string                                 `protobuf:"bytes,1,opt,name=src_validator_address,json=srcValidatorAddress,proto3" json:"src_validator_address,omitempty"`
+	DstValidatorAddress string                                 `protobuf:"bytes,2,opt,name=dst_validator_address,json=dstValidatorAddress,proto3" json:"dst_validator_address,omitempty"`
+	Amount              github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+	// error is the reason of the failure of the redelegation, empty if it succeeds
+	Error string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *RedelegationPreview) Reset()         { *m = RedelegationPreview{} }
+func (m *RedelegationPreview) String() string { return proto.CompactTextString(m) }
+func (*RedelegationPreview) ProtoMessage()    {}
+func (*RedelegationPreview) Descriptor() ([]byte, []int) {
+	return fileDescriptor_686f3882ea1eb1bb, []int{20}
+}
+func (m *RedelegationPreview) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RedelegationPreview) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RedelegationPreview.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RedelegationPreview) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RedelegationPreview.Merge(m, src)
+}
+func (m *RedelegationPreview) XXX_Size() int {
+	return m.Size()
+}
+func (m *RedelegationPreview) XXX_DiscardUnknown() {
+	xxx_messageInfo_RedelegationPreview.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RedelegationPreview proto.InternalMessageInfo
+
+func (m *RedelegationPreview) GetSrcValidatorAddress() string {
+	if m != nil {
+		return m.SrcValidatorAddress
+	}
+	return ""
+}
+
+func (m *RedelegationPreview) GetDstValidatorAddress() string {
+	if m != nil {
+		return m.DstValidatorAddress
+	}
+	return ""
+}
+
+func (m *RedelegationPreview) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+// UnbondingPreview defines an unbonding of the liquid tokens of an inactive liquid validator triggered by a whitelist
+// change.
+type UnbondingPreview struct {
+	ValidatorAddress string                                 `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	Amount           github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+}
+
+func (m *UnbondingPreview) Reset()         { *m = UnbondingPreview{} }
+func (m *UnbondingPreview) String() string { return proto.CompactTextString(m) }
+func (*UnbondingPreview) ProtoMessage()    {}
+func (*UnbondingPreview) Descriptor() ([]byte, []int) {
+	return fileDescriptor_686f3882ea1eb1bb, []int{21}
+}
+func (m *UnbondingPreview) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UnbondingPreview) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UnbondingPreview.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UnbondingPreview) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnbondingPreview.Merge(m, src)
+}
+func (m *UnbondingPreview) XXX_Size() int {
+	return m.Size()
+}
+func (m *UnbondingPreview) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnbondingPreview.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnbondingPreview proto.InternalMessageInfo
+
+func (m *UnbondingPreview) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+// QueryPreferredStakesRequest is the request type for the Query/PreferredStakes RPC method.
+type QueryPreferredStakesRequest struct {
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+}
+
+func (m *QueryPreferredStakesRequest) Reset()         { *m = QueryPreferredStakesRequest{} }
+func (m *QueryPreferredStakesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPreferredStakesRequest) ProtoMessage()    {}
+func (*QueryPreferredStakesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_686f3882ea1eb1bb, []int{22}
+}
+func (m *QueryPreferredStakesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPreferredStakesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPreferredStakesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPreferredStakesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPreferredStakesRequest.Merge(m, src)
+}
+func (m *QueryPreferredStakesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPreferredStakesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPreferredStakesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPreferredStakesRequest proto.InternalMessageInfo
+
+func (m *QueryPreferredStakesRequest) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
+	}
+	return ""
+}
+
+// QueryPreferredStakesResponse is the response type for the Query/PreferredStakes RPC method.
+type QueryPreferredStakesResponse struct {
+	PreferredStakes []PreferredStake `protobuf:"bytes,1,rep,name=preferred_stakes,json=preferredStakes,proto3" json:"preferred_stakes"`
+}
+
+func (m *QueryPreferredStakesResponse) Reset()         { *m = QueryPreferredStakesResponse{} }
+func (m *QueryPreferredStakesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPreferredStakesResponse) ProtoMessage()    {}
+func (*QueryPreferredStakesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_686f3882ea1eb1bb, []int{23}
+}
+func (m *QueryPreferredStakesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPreferredStakesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPreferredStakesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPreferredStakesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPreferredStakesResponse.Merge(m, src)
+}
+func (m *QueryPreferredStakesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPreferredStakesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPreferredStakesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPreferredStakesResponse proto.InternalMessageInfo
+
+func (m *QueryPreferredStakesResponse) GetPreferredStakes() []PreferredStake {
+	if m != nil {
+		return m.PreferredStakes
+	}
+	return nil
+}
+
+// QueryEffectiveWeightsRequest is the request type for the Query/EffectiveWeights RPC method.
+type QueryEffectiveWeightsRequest struct {
+}
+
+func (m *QueryEffectiveWeightsRequest) Reset()         { *m = QueryEffectiveWeightsRequest{} }
+func (m *QueryEffectiveWeightsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEffectiveWeightsRequest) ProtoMessage()    {}
+func (*QueryEffectiveWeightsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_686f3882ea1eb1bb, []int{24}
+}
+func (m *QueryEffectiveWeightsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEffectiveWeightsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEffectiveWeightsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEffectiveWeightsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEffectiveWeightsRequest.Merge(m, src)
+}
+func (m *QueryEffectiveWeightsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEffectiveWeightsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEffectiveWeightsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEffectiveWeightsRequest proto.InternalMessageInfo
+
+// QueryEffectiveWeightsResponse is the response type for the Query/EffectiveWeights RPC method.
+type QueryEffectiveWeightsResponse struct {
+	EffectiveWeights []EffectiveWeight `protobuf:"bytes,1,rep,name=effective_weights,json=effectiveWeights,proto3" json:"effective_weights"`
+}
+
+func (m *QueryEffectiveWeightsResponse) Reset()         { *m = QueryEffectiveWeightsResponse{} }
+func (m *QueryEffectiveWeightsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEffectiveWeightsResponse) ProtoMessage()    {}
+func (*QueryEffectiveWeightsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_686f3882ea1eb1bb, []int{25}
+}
+func (m *QueryEffectiveWeightsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEffectiveWeightsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEffectiveWeightsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEffectiveWeightsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEffectiveWeightsResponse.Merge(m, src)
+}
+func (m *QueryEffectiveWeightsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEffectiveWeightsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEffectiveWeightsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEffectiveWeightsResponse proto.InternalMessageInfo
+
+func (m *QueryEffectiveWeightsResponse) GetEffectiveWeights() []EffectiveWeight {
+	if m != nil {
+		return m.EffectiveWeights
+	}
+	return nil
+}
+
+// QueryStatesHistoryRequest is the request type for the Query/StatesHistory RPC method.
+type QueryStatesHistoryRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryStatesHistoryRequest) Reset()         { *m = QueryStatesHistoryRequest{} }
+func (m *QueryStatesHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryStatesHistoryRequest) ProtoMessage()    {}
+func (*QueryStatesHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_686f3882ea1eb1bb, []int{26}
+}
+func (m *QueryStatesHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStatesHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStatesHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryStatesHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStatesHistoryRequest.Merge(m, src)
+}
+func (m *QueryStatesHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStatesHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStatesHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryStatesHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryStatesHistoryRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryStatesHistoryResponse is the response type for the Query/StatesHistory RPC method.
+type QueryStatesHistoryResponse struct {
+	Snapshots  []NetAmountStateSnapshot `protobuf:"bytes,1,rep,name=snapshots,proto3" json:"snapshots"`
+	Pagination *query.PageResponse      `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryStatesHistoryResponse) Reset()         { *m = QueryStatesHistoryResponse{} }
+func (m *QueryStatesHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryStatesHistoryResponse) ProtoMessage()    {}
+func (*QueryStatesHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_686f3882ea1eb1bb, []int{27}
+}
+func (m *QueryStatesHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStatesHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStatesHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryStatesHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStatesHistoryResponse.Merge(m, src)
+}
+func (m *QueryStatesHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStatesHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStatesHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryStatesHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryStatesHistoryResponse) GetSnapshots() []NetAmountStateSnapshot {
+	if m != nil {
+		return m.Snapshots
+	}
+	return nil
+}
+
+func (m *QueryStatesHistoryResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryAPYRequest is the request type for the Query/APY RPC method.
+type QueryAPYRequest struct {
+	// from_height defines the height from which the APY is estimated, the first retained snapshot at or after it is
+	// used. The oldest retained snapshot is used if it is zero.
+	FromHeight int64 `protobuf:"varint,1,opt,name=from_height,json=fromHeight,proto3" json:"from_height,omitempty"`
+}
+
+func (m *QueryAPYRequest) Reset()         { *m = QueryAPYRequest{} }
+func (m *QueryAPYRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAPYRequest) ProtoMessage()    {}
+func (*QueryAPYRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_686f3882ea1eb1bb, []int{28}
+}
+func (m *QueryAPYRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAPYRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAPYRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAPYRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAPYRequest.Merge(m, src)
+}
+func (m *QueryAPYRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAPYRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAPYRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAPYRequest proto.InternalMessageInfo
+
+func (m *QueryAPYRequest) GetFromHeight() int64 {
+	if m != nil {
+		return m.FromHeight
+	}
+	return 0
+}
+
+// QueryAPYResponse is the response type for the Query/APY RPC method.
+type QueryAPYResponse struct {
+	// apy is the annual yield of the bToken value compounded over the period between the snapshots
+	Apy github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=apy,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"apy"`
+	// from is the snapshot the estimation starts from
+	From NetAmountStateSnapshot `protobuf:"bytes,2,opt,name=from,proto3" json:"from"`
+	// to is the latest snapshot
+	To NetAmountStateSnapshot `protobuf:"bytes,3,opt,name=to,proto3" json:"to"`
+}
+
+func (m *QueryAPYResponse) Reset()         { *m = QueryAPYResponse{} }
+func (m *QueryAPYResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAPYResponse) ProtoMessage()    {}
+func (*QueryAPYResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_686f3882ea1eb1bb, []int{29}
+}
+func (m *QueryAPYResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAPYResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAPYResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAPYResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAPYResponse.Merge(m, src)
+}
+func (m *QueryAPYResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAPYResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAPYResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAPYResponse proto.InternalMessageInfo
+
+func (m *QueryAPYResponse) GetFrom() NetAmountStateSnapshot {
+	if m != nil {
+		return m.From
+	}
+	return NetAmountStateSnapshot{}
+}
+
+func (m *QueryAPYResponse) GetTo() NetAmountStateSnapshot {
+	if m != nil {
+		return m.To
+	}
+	return NetAmountStateSnapshot{}
+}
+
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "pstake.lspersistence.v1beta1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "pstake.lspersistence.v1beta1.QueryParamsResponse")
+	proto.RegisterType((*QueryLiquidValidatorsRequest)(nil), "pstake.lspersistence.v1beta1.QueryLiquidValidatorsRequest")
+	proto.RegisterType((*QueryLiquidValidatorsResponse)(nil), "pstake.lspersistence.v1beta1.QueryLiquidValidatorsResponse")
+	proto.RegisterType((*QueryStatesRequest)(nil), "pstake.lspersistence.v1beta1.QueryStatesRequest")
+	proto.RegisterType((*QueryStatesResponse)(nil), "pstake.lspersistence.v1beta1.QueryStatesResponse")
+	proto.RegisterType((*QueryVotingPowerRequest)(nil), "pstake.lspersistence.v1beta1.QueryVotingPowerRequest")
+	proto.RegisterType((*QueryVotingPowerResponse)(nil), "pstake.lspersistence.v1beta1.QueryVotingPowerResponse")
+	proto.RegisterType((*QueryCollectedRewardFeesRequest)(nil), "pstake.lspersistence.v1beta1.QueryCollectedRewardFeesRequest")
+	proto.RegisterType((*QueryCollectedRewardFeesResponse)(nil), "pstake.lspersistence.v1beta1.QueryCollectedRewardFeesResponse")
+	proto.RegisterType((*QueryUnbondingRequestsRequest)(nil), "pstake.lspersistence.v1beta1.QueryUnbondingRequestsRequest")
+	proto.RegisterType((*QueryUnbondingRequestsResponse)(nil), "pstake.lspersistence.v1beta1.QueryUnbondingRequestsResponse")
+	proto.RegisterType((*QueryInstantUnstakeReserveRequest)(nil), "pstake.lspersistence.v1beta1.QueryInstantUnstakeReserveRequest")
+	proto.RegisterType((*QueryInstantUnstakeReserveResponse)(nil), "pstake.lspersistence.v1beta1.QueryInstantUnstakeReserveResponse")
+	proto.RegisterType((*QuerySlashingHistoryRequest)(nil), "pstake.lspersistence.v1beta1.QuerySlashingHistoryRequest")
+	proto.RegisterType((*QuerySlashingHistoryResponse)(nil), "pstake.lspersistence.v1beta1.QuerySlashingHistoryResponse")
+	proto.RegisterType((*QueryPauseSwitchesRequest)(nil), "pstake.lspersistence.v1beta1.QueryPauseSwitchesRequest")
+	proto.RegisterType((*QueryPauseSwitchesResponse)(nil), "pstake.lspersistence.v1beta1.QueryPauseSwitchesResponse")
+	proto.RegisterType((*QueryWhitelistChangePreviewRequest)(nil), "pstake.lspersistence.v1beta1.QueryWhitelistChangePreviewRequest")
+	proto.RegisterType((*QueryWhitelistChangePreviewResponse)(nil), "pstake.lspersistence.v1beta1.QueryWhitelistChangePreviewResponse")
+	proto.RegisterType((*RedelegationPreview)(nil), "pstake.lspersistence.v1beta1.RedelegationPreview")
+	proto.RegisterType((*UnbondingPreview)(nil), "pstake.lspersistence.v1beta1.UnbondingPreview")
+	proto.RegisterType((*QueryPreferredStakesRequest)(nil), "pstake.lspersistence.v1beta1.QueryPreferredStakesRequest")
+	proto.RegisterType((*QueryPreferredStakesResponse)(nil), "pstake.lspersistence.v1beta1.QueryPreferredStakesResponse")
+	proto.RegisterType((*QueryEffectiveWeightsRequest)(nil), "pstake.lspersistence.v1beta1.QueryEffectiveWeightsRequest")
+	proto.RegisterType((*QueryEffectiveWeightsResponse)(nil), "pstake.lspersistence.v1beta1.QueryEffectiveWeightsResponse")
+	proto.RegisterType((*QueryStatesHistoryRequest)(nil), "pstake.lspersistence.v1beta1.QueryStatesHistoryRequest")
+	proto.RegisterType((*QueryStatesHistoryResponse)(nil), "pstake.lspersistence.v1beta1.QueryStatesHistoryResponse")
+	proto.RegisterType((*QueryAPYRequest)(nil), "pstake.lspersistence.v1beta1.QueryAPYRequest")
+	proto.RegisterType((*QueryAPYResponse)(nil), "pstake.lspersistence.v1beta1.QueryAPYResponse")
+}
+
+func init() {
+	proto.RegisterFile("pstake/lspersistence/v1beta1/query.proto", fileDescriptor_686f3882ea1eb1bb)
+}
+
+var fileDescriptor_686f3882ea1eb1bb = []byte{
+	// 1749 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x59, 0xdb, 0x6f, 0xdc, 0x4c,
+	0x15, 0x8f, 0x77, 0xfb, 0x05, 0x7d, 0x27, 0xb4, 0xd9, 0x4c, 0x9a, 0x8f, 0xad, 0xbf, 0xb0, 0x49,
+	0xdd, 0x4f, 0x25, 0xbd, 0x64, 0x37, 0xd9, 0x5e, 0xd2, 0x26, 0x2d, 0x34, 0x69, 0x28, 0x6d, 0x55,
+	0x95, 0xb0, 0xe9, 0x85, 0x56, 0x54, 0xc6, 0xb1, 0x27, 0xbb, 0x56, 0x37, 0xb6, 0xeb, 0x99, 0xdd,
+	0x25, 0xaa, 0x2a, 0x04, 0x0f, 0xbc, 0x82, 0xb8, 0x48, 0x54, 0x3c, 0xf2, 0xc6, 0x33, 0x0f, 0x7d,
+	0x41, 0xf0, 0x80, 0x50, 0x25, 0x24, 0x54, 0x2e, 0x0f, 0x80, 0x50, 0xa9, 0x5a, 0x5e, 0xf8, 0x27,
+	0x10, 0xf2, 0xcc, 0xd8, 0x6b, 0x7b, 0xbd, 0x8e, 0x77, 0x93, 0xa7, 0xae, 0xe7, 0xcc, 0x39, 0xe7,
+	0xf7, 0x3b, 0x73, 0xe6, 0xcc, 0x39, 0x0d, 0xcc, 0x39, 0x84, 0x6a, 0x4f, 0x71, 0xa5, 0x49, 0x1c,
+	0xec, 0x12, 0x93, 0x50, 0x6c, 0xe9, 0xb8, 0xd2, 0x5e, 0xdc, 0xc2, 0x54, 0x5b, 0xac, 0x3c, 0x6b,
+	0x61, 0x77, 0xb7, 0xec, 0xb8, 0x36, 0xb5, 0xd1, 0x34, 0xdf, 0x59, 0x8e, 0xec, 0x2c, 0x8b, 0x9d,
+	0xf2, 0x74, 0xdd, 0xb6, 0xeb, 0x4d, 0x5c, 0xd1, 0x1c, 0xb3, 0xa2, 0x59, 0x96, 0x4d, 0x35, 0x6a,
+	0xda, 0x16, 0xe1, 0xba, 0xf2, 0x42, 0xaa, 0x97, 0xa6, 0xf9, 0xac, 0x65, 0x1a, 0xde, 0x0e, 0xd3,
+	0xaa, 0x0b, 0x8d, 0xa3, 0x75, 0xbb, 0x6e, 0xb3, 0x9f, 0x15, 0xef, 0x97, 0x58, 0x3d, 0xa6, 0xdb,
+	0x64, 0xc7, 0x26, 0x2a, 0x17, 0xf0, 0x0f, 0x21, 0x2a, 0xf1, 0xaf, 0xca, 0x96, 0x46, 0xba, 0x96,
+	0x75, 0xdb, 0xb4, 0x84, 0xfc, 0x74, 0x58, 0xce, 0x78, 0x05, 0xbb, 0x1c, 0xad, 0x6e, 0x5a, 0x0c,
+	0x2f, 0xdf, 0xab, 0x1c, 0x05, 0xf4, 0x0d, 0x6f, 0xc7, 0x86, 0xe6, 0x6a, 0x3b, 0xa4, 0x86, 0x9f,
+	0xb5, 0x30, 0xa1, 0xca, 0x23, 0x98, 0x8c, 0xac, 0x12, 0xc7, 0xb6, 0x08, 0x46, 0x6b, 0x30, 0xea,
+	0xb0, 0x95, 0xa2, 0x34, 0x2b, 0xcd, 0x8d, 0x55, 0x3f, 0x2b, 0xa7, 0x05, 0xaa, 0xcc, 0xb5, 0xd7,
+	0x0e, 0xbd, 0x7e, 0x3b, 0x33, 0x52, 0x13, 0x9a, 0x4a, 0x09, 0xa6, 0x99, 0xe9, 0x3b, 0x2c, 0x12,
+	0x0f, 0xb4, 0xa6, 0x69, 0x68, 0xd4, 0x76, 0x03, 0xd7, 0x3f, 0x90, 0xe0, 0x8b, 0x7d, 0x36, 0x08,
+	0x14, 0x18, 0x26, 0x78, 0x18, 0xd5, 0x76, 0x20, 0x2c, 0x4a, 0xb3, 0xf9, 0xb9, 0xb1, 0x6a, 0x35,
+	0x1d, 0x50, 0xcc, 0xe4, 0x26, 0xd5, 0x28, 0x16, 0xf0, 0x0a, 0xcd, 0x98, 0xbb, 0x20, 0x32, 0x6c,
+	0x57, 0x00, 0x8f, 0xc0, 0x64, 0x64, 0x55, 0x60, 0xfa, 0x16, 0x14, 0x2c, 0x4c, 0x55, 0x6d, 0xc7,
+	0x6e, 0x59, 0x54, 0x25, 0x9e, 0x50, 0xc4, 0xe8, 0x6c, 0x3a, 0xa4, 0xbb, 0x98, 0xae, 0x32, 0xa5,
+	0x30, 0x98, 0x23, 0x56, 0x64, 0x55, 0xa9, 0xc0, 0x17, 0x98, 0xd3, 0x07, 0x36, 0x35, 0xad, 0xfa,
+	0x86, 0xdd, 0xc1, 0xae, 0xc0, 0x83, 0x8e, 0xc2, 0x47, 0x6d, 0x9b, 0x62, 0x97, 0x79, 0xfb, 0xb8,
+	0xc6, 0x3f, 0x14, 0x0b, 0x8a, 0xbd, 0x0a, 0x02, 0x6a, 0x0d, 0x3e, 0xdf, 0x66, 0xcb, 0xaa, 0x63,
+	0x77, 0x84, 0xe2, 0x58, 0xf5, 0x54, 0x3a, 0xcc, 0x90, 0x21, 0x81, 0x71, 0xac, 0xdd, 0x5d, 0x52,
+	0x8e, 0xc3, 0x0c, 0xf3, 0x77, 0xdd, 0x6e, 0x36, 0xb1, 0x4e, 0xb1, 0x51, 0xc3, 0x1d, 0xcd, 0x35,
+	0x6e, 0xe0, 0x6e, 0xe0, 0x7e, 0x29, 0xc1, 0x6c, 0xff, 0x3d, 0x02, 0xdb, 0x77, 0x61, 0x4a, 0xf7,
+	0xc5, 0xaa, 0xcb, 0xe4, 0xea, 0x36, 0xc6, 0xfe, 0xf1, 0x1e, 0x2b, 0x8b, 0x7b, 0xe0, 0x65, 0x76,
+	0x80, 0xed, 0xba, 0x6d, 0x5a, 0x6b, 0x0b, 0x1e, 0xa8, 0x5f, 0xfd, 0x7b, 0x66, 0xae, 0x6e, 0xd2,
+	0x46, 0x6b, 0xab, 0xac, 0xdb, 0x3b, 0xe2, 0xd2, 0x88, 0x7f, 0xe6, 0x89, 0xf1, 0xb4, 0x42, 0x77,
+	0x1d, 0x4c, 0x98, 0x02, 0xa9, 0x4d, 0xea, 0xbd, 0x40, 0x94, 0x9f, 0xfa, 0xd9, 0x77, 0xdf, 0xda,
+	0xb2, 0x2d, 0xc3, 0xb4, 0xea, 0x02, 0xbf, 0xcf, 0x03, 0x9d, 0x81, 0x09, 0x03, 0x37, 0x71, 0xdd,
+	0x4b, 0x12, 0x55, 0x33, 0x0c, 0x17, 0x13, 0x22, 0x82, 0x5f, 0x08, 0x04, 0xab, 0x7c, 0x1d, 0xdd,
+	0x00, 0xe8, 0xde, 0xb8, 0x62, 0x8e, 0x45, 0xfa, 0x64, 0x84, 0x04, 0x2f, 0x3b, 0xdd, 0x1b, 0x53,
+	0xc7, 0xc2, 0x51, 0x2d, 0xa4, 0xa9, 0xfc, 0x49, 0x82, 0x52, 0x3f, 0x58, 0x22, 0x74, 0x3a, 0xa0,
+	0x96, 0x2f, 0x54, 0x5d, 0x21, 0x15, 0x71, 0x2b, 0xa7, 0x1f, 0x6e, 0xdc, 0xa8, 0x38, 0xe1, 0x89,
+	0x56, 0xdc, 0x19, 0xfa, 0x5a, 0x02, 0x9f, 0x2f, 0xed, 0xc9, 0x87, 0x23, 0x8c, 0x10, 0x3a, 0x01,
+	0xc7, 0x19, 0x9f, 0x5b, 0x16, 0xa1, 0x9a, 0x45, 0xef, 0x5b, 0x0c, 0x5f, 0x0d, 0x13, 0xec, 0xb6,
+	0xfd, 0x08, 0x28, 0x3f, 0x97, 0x40, 0x49, 0xdb, 0x25, 0x98, 0x5f, 0x86, 0xcf, 0xb9, 0x7c, 0x49,
+	0xe4, 0x72, 0x4a, 0x9a, 0x70, 0x66, 0xfe, 0x7e, 0xb4, 0x04, 0xa3, 0x54, 0x73, 0xeb, 0x98, 0x16,
+	0x73, 0xd9, 0x34, 0xc5, 0x76, 0xe5, 0xc7, 0x12, 0x7c, 0xca, 0xeb, 0x40, 0x53, 0x23, 0x0d, 0xd3,
+	0xaa, 0xdf, 0x34, 0x09, 0xb5, 0xdd, 0xdd, 0x50, 0x96, 0x04, 0xc5, 0x29, 0x9e, 0x25, 0x81, 0xe0,
+	0xa0, 0xb3, 0xe4, 0x0f, 0x12, 0x4c, 0x27, 0x83, 0x12, 0x91, 0x7a, 0x02, 0x05, 0x22, 0x44, 0xaa,
+	0x8b, 0x75, 0xdb, 0x35, 0xfc, 0x0c, 0xd9, 0xa3, 0x4a, 0xf9, 0x06, 0x6b, 0x4c, 0x49, 0xc4, 0x62,
+	0x9c, 0x44, 0x56, 0x0f, 0x30, 0x3b, 0x3e, 0x85, 0x63, 0xe2, 0xf9, 0x69, 0x11, 0xbc, 0xd9, 0x31,
+	0xa9, 0xde, 0xe8, 0x16, 0x92, 0x36, 0xc8, 0x49, 0x42, 0x41, 0xf1, 0x9b, 0x70, 0xc4, 0xf1, 0x04,
+	0x2a, 0x11, 0x12, 0x91, 0x13, 0x67, 0xf6, 0x7a, 0xaa, 0x42, 0xc6, 0x04, 0xbf, 0xc3, 0x4e, 0x78,
+	0x51, 0x79, 0x99, 0x13, 0xd9, 0xf8, 0xb0, 0x61, 0x52, 0xdc, 0x34, 0x09, 0xbd, 0xde, 0xd0, 0xac,
+	0x3a, 0xde, 0x70, 0x71, 0xdb, 0xc4, 0x1d, 0xff, 0xe4, 0x55, 0x38, 0xa2, 0x19, 0x83, 0x3f, 0x4d,
+	0x81, 0x51, 0xdc, 0x7d, 0x83, 0x7c, 0x1c, 0x9a, 0xd1, 0x5d, 0x23, 0xde, 0xf3, 0xd7, 0x72, 0x0c,
+	0x8d, 0xe2, 0xb0, 0x8f, 0xdc, 0x3e, 0x7d, 0x14, 0xb8, 0xc9, 0x90, 0x9b, 0x33, 0x30, 0xe1, 0xe2,
+	0x1d, 0xbb, 0x1d, 0x71, 0x93, 0x9f, 0xcd, 0x7b, 0x19, 0xcc, 0x05, 0xa1, 0xb7, 0xf2, 0x8f, 0x39,
+	0x38, 0x91, 0x1a, 0x1b, 0x71, 0x3a, 0x36, 0x7c, 0xd2, 0xe9, 0x82, 0x38, 0xc8, 0x20, 0x4d, 0x75,
+	0x12, 0x64, 0x04, 0x3d, 0x81, 0xc3, 0x2e, 0x16, 0x65, 0xd9, 0x6b, 0xd2, 0x44, 0xa0, 0x16, 0xd3,
+	0xfd, 0xd4, 0x42, 0x2a, 0x82, 0x82, 0x7f, 0x16, 0x11, 0x6b, 0xe8, 0x1e, 0x40, 0x50, 0x24, 0x79,
+	0x74, 0xb2, 0x17, 0xdb, 0xa8, 0xe1, 0x90, 0x1d, 0xe5, 0xbf, 0x12, 0x4c, 0x26, 0x40, 0x40, 0x55,
+	0x98, 0x22, 0xae, 0xae, 0xf6, 0x2b, 0x2c, 0x93, 0xc4, 0xd5, 0x1f, 0xc4, 0x6b, 0x4b, 0x15, 0xa6,
+	0x0c, 0x42, 0x13, 0x74, 0x72, 0x5c, 0xc7, 0x20, 0xb4, 0x47, 0xe7, 0x1e, 0x8c, 0xf2, 0x46, 0xa6,
+	0x98, 0xf7, 0x36, 0xad, 0x5d, 0xf1, 0x10, 0xfe, 0xf3, 0xed, 0xcc, 0xc9, 0x0c, 0x6f, 0xeb, 0x2d,
+	0x8b, 0xfe, 0xe5, 0xd7, 0xf3, 0xc0, 0xd7, 0xbd, 0xaf, 0x9a, 0xb0, 0xe5, 0x75, 0x2a, 0xd8, 0x75,
+	0x6d, 0xb7, 0x78, 0x88, 0x77, 0x2a, 0xec, 0x43, 0xf9, 0x99, 0x04, 0x85, 0x78, 0x48, 0x06, 0xab,
+	0x9e, 0x5d, 0xb4, 0xb9, 0x83, 0x43, 0xab, 0xdc, 0x16, 0xf5, 0x7d, 0xc3, 0xc5, 0xdb, 0xd8, 0x75,
+	0xb1, 0xb1, 0xe9, 0x9d, 0xe9, 0x50, 0x5d, 0x80, 0xf2, 0x02, 0xa6, 0x93, 0x6d, 0x75, 0xcb, 0xb2,
+	0xe3, 0x8b, 0x54, 0x96, 0x3b, 0x19, 0xcb, 0x72, 0xd4, 0xa0, 0x5f, 0x96, 0x9d, 0xa8, 0x9b, 0xa0,
+	0xe3, 0xfe, 0xea, 0xf6, 0x36, 0xd6, 0xa9, 0xd9, 0xc6, 0x0f, 0xb1, 0x59, 0x6f, 0x04, 0x1d, 0x8d,
+	0xf2, 0x3d, 0xbf, 0xe7, 0xe9, 0xdd, 0x20, 0x00, 0x7e, 0x1b, 0x26, 0xb0, 0x2f, 0x53, 0x3b, 0x5c,
+	0x28, 0x10, 0xce, 0xa7, 0x23, 0x8c, 0x99, 0xf4, 0xab, 0x0d, 0x8e, 0x79, 0x52, 0x74, 0x51, 0xf1,
+	0x79, 0x5b, 0x1d, 0x7b, 0x4c, 0xa3, 0xef, 0xa3, 0x34, 0xf4, 0xfb, 0xf8, 0x5b, 0x09, 0xe4, 0x24,
+	0x2f, 0xc1, 0xd3, 0xf1, 0x31, 0xb1, 0x34, 0x87, 0x34, 0xec, 0x80, 0xdd, 0xf9, 0x41, 0x9a, 0xf7,
+	0x4d, 0xa1, 0x2c, 0x48, 0x76, 0x8d, 0x1d, 0xdc, 0xc3, 0x58, 0x85, 0x71, 0x46, 0x60, 0x75, 0xe3,
+	0x91, 0x1f, 0x9c, 0x19, 0x18, 0xdb, 0x76, 0xed, 0x1d, 0xb5, 0xc1, 0x22, 0xc9, 0xa2, 0x93, 0xaf,
+	0x81, 0xb7, 0x74, 0x93, 0xad, 0x28, 0xff, 0x93, 0xa0, 0xd0, 0x55, 0x12, 0x5c, 0xef, 0x42, 0x5e,
+	0x73, 0x76, 0x8b, 0xd2, 0xc0, 0x37, 0x66, 0x1d, 0xeb, 0xa1, 0x1b, 0xb3, 0x8e, 0xf5, 0x9a, 0x67,
+	0x08, 0xdd, 0x85, 0x43, 0x9e, 0x4b, 0xc1, 0x6d, 0x3f, 0x61, 0x63, 0x76, 0xd0, 0x6d, 0xc8, 0x51,
+	0xbb, 0x98, 0xdf, 0xb7, 0xb5, 0x1c, 0xb5, 0xab, 0x2f, 0xa7, 0xe0, 0x23, 0x16, 0x00, 0xf4, 0x0b,
+	0x09, 0x46, 0xf9, 0x50, 0x8a, 0x16, 0xd2, 0x8d, 0xf6, 0xce, 0xc4, 0xf2, 0xe2, 0x00, 0x1a, 0x3c,
+	0xca, 0xca, 0xd9, 0xef, 0xff, 0xf5, 0x3f, 0x3f, 0xc9, 0x9d, 0x44, 0x9f, 0x55, 0x52, 0xff, 0x53,
+	0x80, 0x4f, 0xc6, 0xe8, 0x37, 0x12, 0x14, 0xe2, 0x43, 0x2f, 0x5a, 0xce, 0xe0, 0xb5, 0xcf, 0x28,
+	0x2d, 0xaf, 0x0c, 0xa5, 0x2b, 0xb0, 0x2f, 0x30, 0xec, 0xa7, 0xd1, 0x5c, 0x3a, 0xf6, 0xee, 0x13,
+	0xce, 0xa2, 0xcb, 0x6f, 0x56, 0xa6, 0xe8, 0x46, 0xe6, 0x6a, 0x79, 0x71, 0x00, 0x8d, 0xc1, 0xa2,
+	0x4b, 0x38, 0xa4, 0x57, 0x12, 0x8c, 0x85, 0xa6, 0x58, 0x74, 0x21, 0x83, 0xc3, 0xde, 0x79, 0x5b,
+	0xbe, 0x38, 0xa8, 0x9a, 0x00, 0xbb, 0xcc, 0xc0, 0x9e, 0x47, 0xd5, 0x3d, 0xc2, 0x19, 0x9a, 0xcc,
+	0x2b, 0xcf, 0xd9, 0x30, 0xff, 0x02, 0xfd, 0x59, 0x82, 0xc9, 0x84, 0xa9, 0x19, 0x5d, 0xcd, 0x80,
+	0xa5, 0xff, 0x44, 0x2e, 0x7f, 0x79, 0x58, 0x75, 0x41, 0x69, 0x85, 0x51, 0xba, 0x80, 0xce, 0xa5,
+	0x53, 0x4a, 0x1c, 0xe8, 0xd1, 0xbf, 0x24, 0x98, 0xe8, 0x19, 0x66, 0x51, 0x96, 0x8c, 0xed, 0x37,
+	0x99, 0xcb, 0x57, 0x86, 0x53, 0x16, 0x6c, 0xee, 0x30, 0x36, 0x37, 0xd0, 0x7a, 0x3a, 0x9b, 0xde,
+	0x19, 0xbb, 0xf2, 0xbc, 0xa7, 0x13, 0x78, 0x81, 0xfe, 0x21, 0xc1, 0x54, 0xe2, 0xd4, 0x8a, 0xbe,
+	0x92, 0x01, 0x65, 0xda, 0x54, 0x2c, 0x5f, 0x1b, 0xde, 0x80, 0xa0, 0x7a, 0x95, 0x51, 0x5d, 0x42,
+	0x17, 0xd2, 0xa9, 0x9a, 0xdc, 0x88, 0xda, 0xe2, 0x56, 0x54, 0x7f, 0x68, 0xfe, 0x9d, 0x04, 0xe3,
+	0xb1, 0x09, 0x13, 0x5d, 0xce, 0x72, 0x7d, 0x13, 0x47, 0x65, 0x79, 0x79, 0x18, 0x55, 0xc1, 0xe4,
+	0x22, 0x63, 0xb2, 0x80, 0xca, 0xe9, 0x4c, 0x82, 0xa1, 0xb7, 0x21, 0xe0, 0xbe, 0x92, 0xe0, 0x70,
+	0x64, 0xe4, 0x43, 0x4b, 0x99, 0xaa, 0x7b, 0xef, 0x38, 0x2a, 0x5f, 0x1a, 0x5c, 0x51, 0x80, 0x3f,
+	0xcf, 0xc0, 0x97, 0xd1, 0xd9, 0xbd, 0x5e, 0x87, 0xf0, 0x38, 0x8b, 0xde, 0x49, 0xf0, 0x49, 0xf2,
+	0x94, 0x85, 0xb2, 0x64, 0x46, 0xea, 0xf0, 0x2a, 0xaf, 0xee, 0xc3, 0x82, 0x60, 0xb5, 0xca, 0x58,
+	0xad, 0x28, 0x17, 0xd3, 0x59, 0x05, 0xe3, 0x9a, 0xaa, 0x33, 0x33, 0xaa, 0xc3, 0xed, 0x2c, 0x4b,
+	0xa7, 0xd1, 0xdf, 0x24, 0x18, 0x8f, 0xf5, 0xca, 0x99, 0x12, 0x2c, 0xb9, 0x57, 0x97, 0x97, 0x87,
+	0x51, 0x15, 0x6c, 0x6e, 0x33, 0x36, 0xeb, 0x68, 0x6d, 0x8f, 0x33, 0x8a, 0xb5, 0xef, 0x89, 0x35,
+	0xe1, 0xf7, 0x12, 0x14, 0xe2, 0x2d, 0x76, 0xa6, 0xf7, 0xbd, 0x4f, 0xe3, 0x2e, 0xaf, 0x0c, 0xa5,
+	0x2b, 0x98, 0x2d, 0x31, 0x66, 0x8b, 0xa8, 0x92, 0xce, 0xac, 0xa7, 0xef, 0x67, 0x77, 0x27, 0xd2,
+	0x40, 0x67, 0xba, 0x3b, 0x49, 0x8d, 0xbd, 0x7c, 0x69, 0x70, 0xc5, 0xc1, 0xee, 0x0e, 0x7f, 0xfb,
+	0x83, 0x6b, 0xff, 0x43, 0x09, 0xf2, 0xab, 0x1b, 0x8f, 0xd0, 0x7c, 0x06, 0xbf, 0xdd, 0x16, 0x5b,
+	0x2e, 0x67, 0xdd, 0x2e, 0xc0, 0x9d, 0x62, 0xe0, 0x4e, 0xa0, 0xe3, 0xe9, 0xe0, 0x34, 0x67, 0x77,
+	0xed, 0xf1, 0xeb, 0xf7, 0x25, 0xe9, 0xcd, 0xfb, 0x92, 0xf4, 0xee, 0x7d, 0x49, 0xfa, 0xd1, 0x87,
+	0xd2, 0xc8, 0x9b, 0x0f, 0xa5, 0x91, 0xbf, 0x7f, 0x28, 0x8d, 0x3c, 0xbe, 0x16, 0x6a, 0xc6, 0x43,
+	0xda, 0x5f, 0xb7, 0xb0, 0xb0, 0x3a, 0xef, 0xcd, 0x04, 0x6d, 0x5c, 0x69, 0x57, 0x2b, 0xdf, 0x89,
+	0x79, 0x60, 0xad, 0xfa, 0xd6, 0x28, 0xfb, 0x0b, 0xcf, 0xb9, 0xff, 0x0f, 0x00, 0xef, 0xf0, 0x2d,
+	0x3a, 0xf8, 0x1a, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Params returns parameters of the liquidstaking module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// LiquidValidators returns liquid validators with states of the liquidstaking module.
+	LiquidValidators(ctx context.Context, in *QueryLiquidValidatorsRequest, opts ...grpc.CallOption) (*QueryLiquidValidatorsResponse, error)
+	// States returns states of the liquidstaking module.
+	States(ctx context.Context, in *QueryStatesRequest, opts ...grpc.CallOption) (*QueryStatesResponse, error)
+	// VotingPower returns the voting power of a voter, including the voting power of its bTokens.
+	VotingPower(ctx context.Context, in *QueryVotingPowerRequest, opts ...grpc.CallOption) (*QueryVotingPowerResponse, error)
+	// CollectedRewardFees returns the cumulative reward fees sent to the reward fee address.
+	CollectedRewardFees(ctx context.Context, in *QueryCollectedRewardFeesRequest, opts ...grpc.CallOption) (*QueryCollectedRewardFeesResponse, error)
+	// UnbondingRequests returns the pending unbonding requests of a delegator.
+	UnbondingRequests(ctx context.Context, in *QueryUnbondingRequestsRequest, opts ...grpc.CallOption) (*QueryUnbondingRequestsResponse, error)
+	// InstantUnstakeReserve returns the instant unstake reserve of the proxy account and its target.
+	InstantUnstakeReserve(ctx context.Context, in *QueryInstantUnstakeReserveRequest, opts ...grpc.CallOption) (*QueryInstantUnstakeReserveResponse, error)
+	// SlashingHistory returns the slashing records of the liquid validators.
+	SlashingHistory(ctx context.Context, in *QuerySlashingHistoryRequest, opts ...grpc.CallOption) (*QuerySlashingHistoryResponse, error)
+	// PauseSwitches returns the paused operations of the module.
+	PauseSwitches(ctx context.Context, in *QueryPauseSwitchesRequest, opts ...grpc.CallOption) (*QueryPauseSwitchesResponse, error)
+	// WhitelistChangePreview returns the whitelist resulting from the given changes and the redelegations they trigger
+	// on the next BeginBlock, without applying them.
+	WhitelistChangePreview(ctx context.Context, in *QueryWhitelistChangePreviewRequest, opts ...grpc.CallOption) (*QueryWhitelistChangePreviewResponse, error)
+	// PreferredStakes returns the preferred stakes of a delegator.
+	PreferredStakes(ctx context.Context, in *QueryPreferredStakesRequest, opts ...grpc.CallOption) (*QueryPreferredStakesResponse, error)
+	// EffectiveWeights returns the target weights of the active liquid validators shifted by the preferred stakes.
+	EffectiveWeights(ctx context.Context, in *QueryEffectiveWeightsRequest, opts ...grpc.CallOption) (*QueryEffectiveWeightsResponse, error)
+	// StatesHistory returns the recorded snapshots of the net amount state, oldest first.
+	StatesHistory(ctx context.Context, in *QueryStatesHistoryRequest, opts ...grpc.CallOption) (*QueryStatesHistoryResponse, error)
+	// APY returns the APY of the bToken estimated from the growth of its value over the states history.
+	APY(ctx context.Context, in *QueryAPYRequest, opts ...grpc.CallOption) (*QueryAPYResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/pstake.lspersistence.v1beta1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) LiquidValidators(ctx context.Context, in *QueryLiquidValidatorsRequest, opts ...grpc.CallOption) (*QueryLiquidValidatorsResponse, error) {
+	out := new(QueryLiquidValidatorsResponse)
+	err := c.cc.Invoke(ctx, "/pstake.lspersistence.v1beta1.Query/LiquidValidators", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) States(ctx context.Context, in *QueryStatesRequest, opts ...grpc.CallOption) (*QueryStatesResponse, error) {
+	out := new(QueryStatesResponse)
+	err := c.cc.Invoke(ctx, "/pstake.lspersistence.v1beta1.Query/States", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) VotingPower(ctx context.Context, in *QueryVotingPowerRequest, opts ...grpc.CallOption) (*QueryVotingPowerResponse, error) {
+	out := new(QueryVotingPowerResponse)
+	err := c.cc.Invoke(ctx, "/pstake.lspersistence.v1beta1.Query/VotingPower", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CollectedRewardFees(ctx context.Context, in *QueryCollectedRewardFeesRequest, opts ...grpc.CallOption) (*QueryCollectedRewardFeesResponse, error) {
+	out := new(QueryCollectedRewardFeesResponse)
+	err := c.cc.Invoke(ctx, "/pstake.lspersistence.v1beta1.Query/CollectedRewardFees", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) UnbondingRequests(ctx context.Context, in *QueryUnbondingRequestsRequest, opts ...grpc.CallOption) (*QueryUnbondingRequestsResponse, error) {
+	out := new(QueryUnbondingRequestsResponse)
+	err := c.cc.Invoke(ctx, "/pstake.lspersistence.v1beta1.Query/UnbondingRequests", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) InstantUnstakeReserve(ctx context.Context, in *QueryInstantUnstakeReserveRequest, opts ...grpc.CallOption) (*QueryInstantUnstakeReserveResponse, error) {
+	out := new(QueryInstantUnstakeReserveResponse)
+	err := c.cc.Invoke(ctx, "/pstake.lspersistence.v1beta1.Query/InstantUnstakeReserve", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SlashingHistory(ctx context.Context, in *QuerySlashingHistoryRequest, opts ...grpc.CallOption) (*QuerySlashingHistoryResponse, error) {
+	out := new(QuerySlashingHistoryResponse)
+	err := c.cc.Invoke(ctx, "/pstake.lspersistence.v1beta1.Query/SlashingHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PauseSwitches(ctx context.Context, in *QueryPauseSwitchesRequest, opts ...grpc.CallOption) (*QueryPauseSwitchesResponse, error) {
+	out := new(QueryPauseSwitchesResponse)
+	err := c.cc.Invoke(ctx, "/pstake.lspersistence.v1beta1.Query/PauseSwitches", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) WhitelistChangePreview(ctx context.Context, in *QueryWhitelistChangePreviewRequest, opts ...grpc.CallOption) (*QueryWhitelistChangePreviewResponse, error) {
+	out := new(QueryWhitelistChangePreviewResponse)
+	err := c.cc.Invoke(ctx, "/pstake.lspersistence.v1beta1.Query/WhitelistChangePreview", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PreferredStakes(ctx context.Context, in *QueryPreferredStakesRequest, opts ...grpc.CallOption) (*QueryPreferredStakesResponse, error) {
+	out := new(QueryPreferredStakesResponse)
+	err := c.cc.Invoke(ctx, "/pstake.lspersistence.v1beta1.Query/PreferredStakes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EffectiveWeights(ctx context.Context, in *QueryEffectiveWeightsRequest, opts ...grpc.CallOption) (*QueryEffectiveWeightsResponse, error) {
+	out := new(QueryEffectiveWeightsResponse)
+	err := c.cc.Invoke(ctx, "/pstake.lspersistence.v1beta1.Query/EffectiveWeights", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) StatesHistory(ctx context.Context, in *QueryStatesHistoryRequest, opts ...grpc.CallOption) (*QueryStatesHistoryResponse, error) {
+	out := new(QueryStatesHistoryResponse)
+	err := c.cc.Invoke(ctx, "/pstake.lspersistence.v1beta1.Query/StatesHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) APY(ctx context.Context, in *QueryAPYRequest, opts ...grpc.CallOption) (*QueryAPYResponse, error) {
+	out := new(QueryAPYResponse)
+	err := c.cc.Invoke(ctx, "/pstake.lspersistence.v1beta1.Query/APY", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Params returns parameters of the liquidstaking module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// LiquidValidators returns liquid validators with states of the liquidstaking module.
+	LiquidValidators(context.Context, *QueryLiquidValidatorsRequest) (*QueryLiquidValidatorsResponse, error)
+	// States returns states of the liquidstaking module.
+	States(context.Context, *QueryStatesRequest) (*QueryStatesResponse, error)
+	// VotingPower returns the voting power of a voter, including the voting power of its bTokens.
+	VotingPower(context.Context, *QueryVotingPowerRequest) (*QueryVotingPowerResponse, error)
+	// CollectedRewardFees returns the cumulative reward fees sent to the reward fee address.
+	CollectedRewardFees(context.Context, *QueryCollectedRewardFeesRequest) (*QueryCollectedRewardFeesResponse, error)
+	// UnbondingRequests returns the pending unbonding requests of a delegator.
+	UnbondingRequests(context.Context, *QueryUnbondingRequestsRequest) (*QueryUnbondingRequestsResponse, error)
+	// InstantUnstakeReserve returns the instant unstake reserve of the proxy account and its target.
+	InstantUnstakeReserve(context.Context, *QueryInstantUnstakeReserveRequest) (*QueryInstantUnstakeReserveResponse, error)
+	// SlashingHistory returns the slashing records of the liquid validators.
+	SlashingHistory(context.Context, *QuerySlashingHistoryRequest) (*QuerySlashingHistoryResponse, error)
+	// PauseSwitches returns the paused operations of the module.
+	PauseSwitches(context.Context, *QueryPauseSwitchesRequest) (*QueryPauseSwitchesResponse, error)
+	// WhitelistChangePreview returns the whitelist resulting from the given changes and the redelegations they trigger
+	// on the next BeginBlock, without applying them.
+	WhitelistChangePreview(context.Context, *QueryWhitelistChangePreviewRequest) (*QueryWhitelistChangePreviewResponse, error)
+	// PreferredStakes returns the preferred stakes of a delegator.
+	PreferredStakes(context.Context, *QueryPreferredStakesRequest) (*QueryPreferredStakesResponse, error)
+	// EffectiveWeights returns the target weights of the active liquid validators shifted by the preferred stakes.
+	EffectiveWeights(context.Context, *QueryEffectiveWeightsRequest) (*QueryEffectiveWeightsResponse, error)
+	// StatesHistory returns the recorded snapshots of the net amount state, oldest first.
+	StatesHistory(context.Context, *QueryStatesHistoryRequest) (*QueryStatesHistoryResponse, error)
+	// APY returns the APY of the bToken estimated from the growth of its value over the states history.
+	APY(context.Context, *QueryAPYRequest) (*QueryAPYResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) LiquidValidators(ctx context.Context, req *QueryLiquidValidatorsRequest) (*QueryLiquidValidatorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LiquidValidators not implemented")
+}
+func (*UnimplementedQueryServer) States(ctx context.Context, req *QueryStatesRequest) (*QueryStatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method States not implemented")
+}
+func (*UnimplementedQueryServer) VotingPower(ctx context.Context, req *QueryVotingPowerRequest) (*QueryVotingPowerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VotingPower not implemented")
+}
+func (*UnimplementedQueryServer) CollectedRewardFees(ctx context.Context, req *QueryCollectedRewardFeesRequest) (*QueryCollectedRewardFeesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CollectedRewardFees not implemented")
+}
+func (*UnimplementedQueryServer) UnbondingRequests(ctx context.Context, req *QueryUnbondingRequestsRequest) (*QueryUnbondingRequestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnbondingRequests not implemented")
+}
+func (*UnimplementedQueryServer) InstantUnstakeReserve(ctx context.Context, req *QueryInstantUnstakeReserveRequest) (*QueryInstantUnstakeReserveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InstantUnstakeReserve not implemented")
+}
+func (*UnimplementedQueryServer) SlashingHistory(ctx context.Context, req *QuerySlashingHistoryRequest) (*QuerySlashingHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SlashingHistory not implemented")
+}
+func (*UnimplementedQueryServer) PauseSwitches(ctx context.Context, req *QueryPauseSwitchesRequest) (*QueryPauseSwitchesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseSwitches not implemented")
+}
+func (*UnimplementedQueryServer) WhitelistChangePreview(ctx context.Context, req *QueryWhitelistChangePreviewRequest) (*QueryWhitelistChangePreviewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WhitelistChangePreview not implemented")
+}
+func (*UnimplementedQueryServer) PreferredStakes(ctx context.Context, req *QueryPreferredStakesRequest) (*QueryPreferredStakesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PreferredStakes not implemented")
+}
+func (*UnimplementedQueryServer) EffectiveWeights(ctx context.Context, req *QueryEffectiveWeightsRequest) (*QueryEffectiveWeightsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EffectiveWeights not implemented")
+}
+func (*UnimplementedQueryServer) StatesHistory(ctx context.Context, req *QueryStatesHistoryRequest) (*QueryStatesHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StatesHistory not implemented")
+}
+func (*UnimplementedQueryServer) APY(ctx context.Context, req *QueryAPYRequest) (*QueryAPYResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method APY not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.lspersistence.v1beta1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LiquidValidators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLiquidValidatorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LiquidValidators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.lspersistence.v1beta1.Query/LiquidValidators",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LiquidValidators(ctx, req.(*QueryLiquidValidatorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_States_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).States(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.lspersistence.v1beta1.Query/States",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).States(ctx, req.(*QueryStatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VotingPower_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVotingPowerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VotingPower(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.lspersistence.v1beta1.Query/VotingPower",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VotingPower(ctx, req.(*QueryVotingPowerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CollectedRewardFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCollectedRewardFeesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CollectedRewardFees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.lspersistence.v1beta1.Query/CollectedRewardFees",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CollectedRewardFees(ctx, req.(*QueryCollectedRewardFeesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_UnbondingRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUnbondingRequestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UnbondingRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.lspersistence.v1beta1.Query/UnbondingRequests",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UnbondingRequests(ctx, req.(*QueryUnbondingRequestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_InstantUnstakeReserve_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryInstantUnstakeReserveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).InstantUnstakeReserve(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.lspersistence.v1beta1.Query/InstantUnstakeReserve",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).InstantUnstakeReserve(ctx, req.(*QueryInstantUnstakeReserveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SlashingHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySlashingHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SlashingHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.lspersistence.v1beta1.Query/SlashingHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SlashingHistory(ctx, req.(*QuerySlashingHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PauseSwitches_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPauseSwitchesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PauseSwitches(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.lspersistence.v1beta1.Query/PauseSwitches",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PauseSwitches(ctx, req.(*QueryPauseSwitchesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_WhitelistChangePreview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryWhitelistChangePreviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).WhitelistChangePreview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.lspersistence.v1beta1.Query/WhitelistChangePreview",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).WhitelistChangePreview(ctx, req.(*QueryWhitelistChangePreviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PreferredStakes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPreferredStakesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PreferredStakes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.lspersistence.v1beta1.Query/PreferredStakes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PreferredStakes(ctx, req.(*QueryPreferredStakesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EffectiveWeights_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEffectiveWeightsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EffectiveWeights(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.lspersistence.v1beta1.Query/EffectiveWeights",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EffectiveWeights(ctx, req.(*QueryEffectiveWeightsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_StatesHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStatesHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StatesHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.lspersistence.v1beta1.Query/StatesHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StatesHistory(ctx, req.(*QueryStatesHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_APY_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAPYRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).APY(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.lspersistence.v1beta1.Query/APY",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).APY(ctx, req.(*QueryAPYRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "pstake.lspersistence.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "LiquidValidators",
+			Handler:    _Query_LiquidValidators_Handler,
+		},
+		{
+			MethodName: "States",
+			Handler:    _Query_States_Handler,
+		},
+		{
+			MethodName: "VotingPower",
+			Handler:    _Query_VotingPower_Handler,
+		},
+		{
+			MethodName: "CollectedRewardFees",
+			Handler:    _Query_CollectedRewardFees_Handler,
+		},
+		{
+			MethodName: "UnbondingRequests",
+			Handler:    _Query_UnbondingRequests_Handler,
+		},
+		{
+			MethodName: "InstantUnstakeReserve",
+			Handler:    _Query_InstantUnstakeReserve_Handler,
+		},
+		{
+			MethodName: "SlashingHistory",
+			Handler:    _Query_SlashingHistory_Handler,
+		},
+		{
+			MethodName: "PauseSwitches",
+			Handler:    _Query_PauseSwitches_Handler,
+		},
+		{
+			MethodName: "WhitelistChangePreview",
+			Handler:    _Query_WhitelistChangePreview_Handler,
+		},
+		{
+			MethodName: "PreferredStakes",
+			Handler:    _Query_PreferredStakes_Handler,
+		},
+		{
+			MethodName: "EffectiveWeights",
+			Handler:    _Query_EffectiveWeights_Handler,
+		},
+		{
+			MethodName: "StatesHistory",
+			Handler:    _Query_StatesHistory_Handler,
+		},
+		{
+			MethodName: "APY",
+			Handler:    _Query_APY_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "pstake/lspersistence/v1beta1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLiquidValidatorsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLiquidValidatorsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLiquidValidatorsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLiquidValidatorsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLiquidValidatorsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLiquidValidatorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LiquidValidators) > 0 {
+		for iNdEx := len(m.LiquidValidators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LiquidValidators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStatesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStatesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStatesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStatesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStatesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.NetAmountState.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVotingPowerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVotingPowerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVotingPowerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Voter) > 0 {
+		i -= len(m.Voter)
+		copy(dAtA[i:], m.Voter)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Voter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVotingPowerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVotingPowerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVotingPowerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.VotingPower.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCollectedRewardFeesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCollectedRewardFeesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCollectedRewardFeesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCollectedRewardFeesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCollectedRewardFeesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCollectedRewardFeesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CollectedRewardFees) > 0 {
+		for iNdEx := len(m.CollectedRewardFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CollectedRewardFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUnbondingRequestsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUnbondingRequestsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUnbondingRequestsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUnbondingRequestsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUnbondingRequestsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUnbondingRequestsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.UnbondingRequests) > 0 {
+		for iNdEx := len(m.UnbondingRequests) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UnbondingRequests[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInstantUnstakeReserveRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInstantUnstakeReserveRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInstantUnstakeReserveRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInstantUnstakeReserveResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInstantUnstakeReserveResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInstantUnstakeReserveResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Target.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Reserve.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySlashingHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySlashingHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySlashingHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySlashingHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySlashingHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySlashingHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SlashingRecords) > 0 {
+		for iNdEx := len(m.SlashingRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SlashingRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPauseSwitchesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPauseSwitchesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPauseSwitchesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPauseSwitchesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPauseSwitchesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPauseSwitchesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.PauseSwitches.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryWhitelistChangePreviewRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWhitelistChangePreviewRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWhitelistChangePreviewRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RemoveValidators) > 0 {
+		for iNdEx := len(m.RemoveValidators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RemoveValidators[iNdEx])
+			copy(dAtA[i:], m.RemoveValidators[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.RemoveValidators[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.UpdateValidators) > 0 {
+		for iNdEx := len(m.UpdateValidators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UpdateValidators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.AddValidators) > 0 {
+		for iNdEx := len(m.AddValidators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AddValidators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryWhitelistChangePreviewResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWhitelistChangePreviewResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWhitelistChangePreviewResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Unbondings) > 0 {
+		for iNdEx := len(m.Unbondings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Unbondings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Redelegations) > 0 {
+		for iNdEx := len(m.Redelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Redelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.WhitelistedValidators) > 0 {
+		for iNdEx := len(m.WhitelistedValidators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WhitelistedValidators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RedelegationPreview) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RedelegationPreview) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RedelegationPreview) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.DstValidatorAddress) > 0 {
+		i -= len(m.DstValidatorAddress)
+		copy(dAtA[i:], m.DstValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DstValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SrcValidatorAddress) > 0 {
+		i -= len(m.SrcValidatorAddress)
+		copy(dAtA[i:], m.SrcValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SrcValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UnbondingPreview) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnbondingPreview) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnbondingPreview) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPreferredStakesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPreferredStakesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPreferredStakesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPreferredStakesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPreferredStakesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPreferredStakesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PreferredStakes) > 0 {
+		for iNdEx := len(m.PreferredStakes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PreferredStakes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEffectiveWeightsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEffectiveWeightsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEffectiveWeightsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEffectiveWeightsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEffectiveWeightsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEffectiveWeightsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EffectiveWeights) > 0 {
+		for iNdEx := len(m.EffectiveWeights) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EffectiveWeights[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStatesHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStatesHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStatesHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStatesHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStatesHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStatesHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Snapshots) > 0 {
+		for iNdEx := len(m.Snapshots) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Snapshots[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAPYRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAPYRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAPYRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FromHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FromHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAPYResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAPYResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAPYResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.To.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.From.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Apy.Size()
+		i -= size
+		if _, err := m.Apy.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryLiquidValidatorsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryLiquidValidatorsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.LiquidValidators) > 0 {
+		for _, e := range m.LiquidValidators {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryStatesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryStatesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.NetAmountState.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryVotingPowerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVotingPowerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.VotingPower.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryCollectedRewardFeesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryCollectedRewardFeesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CollectedRewardFees) > 0 {
+		for _, e := range m.CollectedRewardFees {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryUnbondingRequestsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryUnbondingRequestsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.UnbondingRequests) > 0 {
+		for _, e := range m.UnbondingRequests {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryInstantUnstakeReserveRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryInstantUnstakeReserveResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Reserve.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Target.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySlashingHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySlashingHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SlashingRecords) > 0 {
+		for _, e := range m.SlashingRecords {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPauseSwitchesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryPauseSwitchesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PauseSwitches.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryWhitelistChangePreviewRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AddValidators) > 0 {
+		for _, e := range m.AddValidators {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.UpdateValidators) > 0 {
+		for _, e := range m.UpdateValidators {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.RemoveValidators) > 0 {
+		for _, s := range m.RemoveValidators {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryWhitelistChangePreviewResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.WhitelistedValidators) > 0 {
+		for _, e := range m.WhitelistedValidators {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Redelegations) > 0 {
+		for _, e := range m.Redelegations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Unbondings) > 0 {
+		for _, e := range m.Unbondings {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RedelegationPreview) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SrcValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.DstValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *UnbondingPreview) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPreferredStakesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPreferredStakesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PreferredStakes) > 0 {
+		for _, e := range m.PreferredStakes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryEffectiveWeightsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryEffectiveWeightsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.EffectiveWeights) > 0 {
+		for _, e := range m.EffectiveWeights {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryStatesHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStatesHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Snapshots) > 0 {
+		for _, e := range m.Snapshots {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAPYRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FromHeight != 0 {
+		n += 1 + sovQuery(uint64(m.FromHeight))
+	}
+	return n
+}
+
+func (m *QueryAPYResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Apy.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.From.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.To.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLiquidValidatorsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLiquidValidatorsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLiquidValidatorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLiquidValidatorsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLiquidValidatorsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLiquidValidatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidValidators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LiquidValidators = append(m.LiquidValidators, LiquidValidatorState{})
+			if err := m.LiquidValidators[len(m.LiquidValidators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStatesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStatesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStatesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStatesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStatesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetAmountState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NetAmountState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVotingPowerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVotingPowerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVotingPowerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Voter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVotingPowerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVotingPowerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVotingPowerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPower", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VotingPower.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCollectedRewardFeesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCollectedRewardFeesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCollectedRewardFeesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCollectedRewardFeesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCollectedRewardFeesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCollectedRewardFeesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollectedRewardFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CollectedRewardFees = append(m.CollectedRewardFees, types.Coin{})
+			if err := m.CollectedRewardFees[len(m.CollectedRewardFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUnbondingRequestsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUnbondingRequestsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUnbondingRequestsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUnbondingRequestsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUnbondingRequestsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUnbondingRequestsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingRequests", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnbondingRequests = append(m.UnbondingRequests, UnbondingRequest{})
+			if err := m.UnbondingRequests[len(m.UnbondingRequests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInstantUnstakeReserveRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInstantUnstakeReserveRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInstantUnstakeReserveRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInstantUnstakeReserveResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInstantUnstakeReserveResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInstantUnstakeReserveResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reserve", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Reserve.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Target.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySlashingHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySlashingHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySlashingHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySlashingHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySlashingHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySlashingHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashingRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SlashingRecords = append(m.SlashingRecords, SlashingRecord{})
+			if err := m.SlashingRecords[len(m.SlashingRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPauseSwitchesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPauseSwitchesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPauseSwitchesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPauseSwitchesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPauseSwitchesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPauseSwitchesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PauseSwitches", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PauseSwitches.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryWhitelistChangePreviewRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryWhitelistChangePreviewRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryWhitelistChangePreviewRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddValidators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AddValidators = append(m.AddValidators, WhitelistedValidator{})
+			if err := m.AddValidators[len(m.AddValidators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateValidators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpdateValidators = append(m.UpdateValidators, WhitelistedValidator{})
+			if err := m.UpdateValidators[len(m.UpdateValidators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoveValidators", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemoveValidators = append(m.RemoveValidators, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryWhitelistChangePreviewResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryWhitelistChangePreviewResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryWhitelistChangePreviewResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WhitelistedValidators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WhitelistedValidators = append(m.WhitelistedValidators, WhitelistedValidator{})
+			if err := m.WhitelistedValidators[len(m.WhitelistedValidators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Redelegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Redelegations = append(m.Redelegations, RedelegationPreview{})
+			if err := m.Redelegations[len(m.Redelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unbondings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Unbondings = append(m.Unbondings, UnbondingPreview{})
+			if err := m.Unbondings[len(m.Unbondings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RedelegationPreview) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RedelegationPreview: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RedelegationPreview: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SrcValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SrcValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DstValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DstValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnbondingPreview) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnbondingPreview: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnbondingPreview: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPreferredStakesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPreferredStakesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPreferredStakesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.NetAmountState.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return nil
 }
+func (m *QueryPreferredStakesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPreferredStakesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPreferredStakesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreferredStakes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreferredStakes = append(m.PreferredStakes, PreferredStake{})
+			if err := m.PreferredStakes[len(m.PreferredStakes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryEffectiveWeightsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -756,10 +5996,10 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEffectiveWeightsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEffectiveWeightsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -783,7 +6023,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryEffectiveWeightsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -806,15 +6046,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEffectiveWeightsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEffectiveWeightsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveWeights", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -841,7 +6081,8 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.EffectiveWeights = append(m.EffectiveWeights, EffectiveWeight{})
+			if err := m.EffectiveWeights[len(m.EffectiveWeights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -866,7 +6107,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLiquidValidatorsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryStatesHistoryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -889,12 +6130,48 @@ func (m *QueryLiquidValidatorsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLiquidValidatorsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryStatesHistoryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLiquidValidatorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryStatesHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -916,7 +6193,7 @@ func (m *QueryLiquidValidatorsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLiquidValidatorsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryStatesHistoryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -939,15 +6216,15 @@ func (m *QueryLiquidValidatorsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLiquidValidatorsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryStatesHistoryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLiquidValidatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryStatesHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiquidValidators", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Snapshots", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -974,8 +6251,44 @@ func (m *QueryLiquidValidatorsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LiquidValidators = append(m.LiquidValidators, LiquidValidatorState{})
-			if err := m.LiquidValidators[len(m.LiquidValidators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Snapshots = append(m.Snapshots, NetAmountStateSnapshot{})
+			if err := m.Snapshots[len(m.Snapshots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1000,7 +6313,7 @@ func (m *QueryLiquidValidatorsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryStatesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAPYRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1023,12 +6336,31 @@ func (m *QueryStatesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStatesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAPYRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStatesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAPYRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromHeight", wireType)
+			}
+			m.FromHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1050,7 +6382,7 @@ func (m *QueryStatesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryStatesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAPYResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1073,15 +6405,49 @@ func (m *QueryStatesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStatesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAPYResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAPYResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NetAmountState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Apy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Apy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1108,7 +6474,40 @@ func (m *QueryStatesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.NetAmountState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.From.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.To.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -0,0 +1,28 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Validate validates the slashing record.
+func (r SlashingRecord) Validate() error {
+	if r.Id == 0 {
+		return fmt.Errorf("slashing record id cannot be 0")
+	}
+	if _, err := sdk.ValAddressFromBech32(r.ValidatorAddress); err != nil {
+		return fmt.Errorf("invalid validator address %s: %w", r.ValidatorAddress, err)
+	}
+	if r.SlashFraction.IsNil() || r.SlashFraction.IsNegative() || r.SlashFraction.GT(sdk.OneDec()) {
+		return fmt.Errorf("slashing record %d slash fraction must be between 0 and 1: %s", r.Id, r.SlashFraction)
+	}
+	if r.SlashedAmount.IsNil() || r.SlashedAmount.IsNegative() {
+		return fmt.Errorf("slashing record %d slashed amount must not be negative: %s", r.Id, r.SlashedAmount)
+	}
+	if r.NetAmountBefore.IsNil() || r.NetAmountAfter.IsNil() || r.NetAmountAfter.GT(r.NetAmountBefore) {
+		return fmt.Errorf("slashing record %d net amount after %s must not exceed the net amount before %s",
+			r.Id, r.NetAmountAfter, r.NetAmountBefore)
+	}
+	return nil
+}
@@ -0,0 +1,91 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SecondsPerYear is the number of seconds in a year used to annualize the bToken yield.
+const SecondsPerYear = int64(365 * 24 * time.Hour / time.Second)
+
+// MaxAPYGrowth bounds the annual growth of the bToken value estimated from the snapshots, a short period growing the
+// bToken value fast would otherwise overflow the compounding.
+var MaxAPYGrowth = sdk.NewDec(1000000)
+
+// NewNetAmountStateSnapshot returns a new NetAmountStateSnapshot of the net amount state at the height and time.
+func NewNetAmountStateSnapshot(height int64, blockTime time.Time, nas NetAmountState) NetAmountStateSnapshot {
+	return NetAmountStateSnapshot{
+		Height:            height,
+		Time:              blockTime,
+		MintRate:          nas.MintRate,
+		NetAmount:         nas.NetAmount,
+		TotalLiquidTokens: nas.TotalLiquidTokens,
+		BtokenTotalSupply: nas.BtokenTotalSupply,
+	}
+}
+
+// Validate validates the net amount state snapshot.
+func (s NetAmountStateSnapshot) Validate() error {
+	if s.Height <= 0 {
+		return fmt.Errorf("net amount state snapshot height must be positive: %d", s.Height)
+	}
+	if s.MintRate.IsNil() || s.MintRate.IsNegative() {
+		return fmt.Errorf("net amount state snapshot %d mint rate must not be negative: %s", s.Height, s.MintRate)
+	}
+	if s.NetAmount.IsNil() || !s.NetAmount.IsPositive() {
+		return fmt.Errorf("net amount state snapshot %d net amount must be positive: %s", s.Height, s.NetAmount)
+	}
+	if s.TotalLiquidTokens.IsNil() || s.TotalLiquidTokens.IsNegative() {
+		return fmt.Errorf("net amount state snapshot %d total liquid tokens must not be negative: %s", s.Height, s.TotalLiquidTokens)
+	}
+	if s.BtokenTotalSupply.IsNil() || !s.BtokenTotalSupply.IsPositive() {
+		return fmt.Errorf("net amount state snapshot %d btoken total supply must be positive: %s", s.Height, s.BtokenTotalSupply)
+	}
+	return nil
+}
+
+// EstimateAPY returns the annual yield of the bToken value, NetAmount / BtokenTotalSupply, growing from the snapshot
+// to the later one. The growth of the period is compounded for the whole periods in a year and applied linearly to
+// the remaining part of the year.
+func EstimateAPY(from, to NetAmountStateSnapshot) (sdk.Dec, error) {
+	elapsed := int64(to.Time.Sub(from.Time) / time.Second)
+	if elapsed <= 0 {
+		return sdk.ZeroDec(), fmt.Errorf("snapshot at height %d must be later than the snapshot at height %d", to.Height, from.Height)
+	}
+	if !from.NetAmount.IsPositive() || !from.BtokenTotalSupply.IsPositive() || !to.BtokenTotalSupply.IsPositive() {
+		return sdk.ZeroDec(), fmt.Errorf("bToken value of the snapshots at height %d and %d is undefined", from.Height, to.Height)
+	}
+
+	// growth = (to.NetAmount / to.BtokenTotalSupply) / (from.NetAmount / from.BtokenTotalSupply)
+	growth := to.NetAmount.MulInt(from.BtokenTotalSupply).Quo(from.NetAmount.MulInt(to.BtokenTotalSupply))
+	periods, remainder := SecondsPerYear/elapsed, SecondsPerYear%elapsed
+	partialGrowth := sdk.OneDec().Add(growth.Sub(sdk.OneDec()).MulInt64(remainder).QuoInt64(elapsed))
+	annualGrowth, ok := powerWithLimit(growth, uint64(periods), MaxAPYGrowth)
+	if !ok {
+		return sdk.ZeroDec(), fmt.Errorf("annual growth between the snapshots at height %d and %d exceeds %s", from.Height, to.Height, MaxAPYGrowth)
+	}
+	return annualGrowth.Mul(partialGrowth).Sub(sdk.OneDec()), nil
+}
+
+// powerWithLimit returns base to the power of exp by squaring, or false if the result exceeds the limit.
+func powerWithLimit(base sdk.Dec, exp uint64, limit sdk.Dec) (sdk.Dec, bool) {
+	result := sdk.OneDec()
+	for exp > 0 {
+		if exp&1 == 1 {
+			result = result.Mul(base)
+			if result.GT(limit) {
+				return result, false
+			}
+		}
+		exp >>= 1
+		if exp > 0 {
+			base = base.Mul(base)
+			if base.GT(limit) {
+				return base, false
+			}
+		}
+	}
+	return result, true
+}
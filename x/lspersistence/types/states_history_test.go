@@ -0,0 +1,79 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/persistenceOne/pstake-native/v2/x/lspersistence/types"
+)
+
+func TestEstimateAPY(t *testing.T) {
+	startTime := time.Unix(1_700_000_000, 0).UTC()
+	halfYear := time.Duration(types.SecondsPerYear/2) * time.Second
+	snapshot := func(height int64, blockTime time.Time, netAmount, btokenTotalSupply int64) types.NetAmountStateSnapshot {
+		return types.NewNetAmountStateSnapshot(height, blockTime, types.NetAmountState{
+			MintRate:          sdk.NewDec(btokenTotalSupply).QuoInt64(netAmount),
+			NetAmount:         sdk.NewDec(netAmount),
+			TotalLiquidTokens: sdk.NewInt(netAmount),
+			BtokenTotalSupply: sdk.NewInt(btokenTotalSupply),
+		})
+	}
+	from := snapshot(600, startTime, 1000000, 1000000)
+
+	for _, tc := range []struct {
+		name        string
+		to          types.NetAmountStateSnapshot
+		expectedAPY sdk.Dec
+		expectedErr string
+	}{
+		{
+			"compounded for the whole periods in a year",
+			snapshot(1200, startTime.Add(halfYear), 1010000, 1000000),
+			sdk.MustNewDecFromStr("0.0201"),
+			"",
+		},
+		{
+			"liquid staking and unstaking do not change the bToken value",
+			snapshot(1200, startTime.Add(halfYear), 2020000, 2000000),
+			sdk.MustNewDecFromStr("0.0201"),
+			"",
+		},
+		{
+			"linear for a period longer than a year",
+			snapshot(1200, startTime.Add(4*halfYear), 1010000, 1000000),
+			sdk.MustNewDecFromStr("0.005"),
+			"",
+		},
+		{
+			"negative by slashing",
+			snapshot(1200, startTime.Add(halfYear), 990000, 1000000),
+			sdk.MustNewDecFromStr("-0.0199"),
+			"",
+		},
+		{
+			"too fast growth to be annualized",
+			snapshot(1200, startTime.Add(time.Hour), 1250000, 1000000),
+			sdk.ZeroDec(),
+			"annual growth between the snapshots at height 600 and 1200 exceeds 1000000.000000000000000000",
+		},
+		{
+			"same time",
+			snapshot(1200, startTime, 1010000, 1000000),
+			sdk.ZeroDec(),
+			"snapshot at height 1200 must be later than the snapshot at height 600",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			apy, err := types.EstimateAPY(from, tc.to)
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+				require.Equal(t, tc.expectedAPY, apy)
+			} else {
+				require.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
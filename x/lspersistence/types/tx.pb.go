@@ -39,6 +39,9 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type MsgLiquidStake struct {
 	DelegatorAddress string     `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty" yaml:"delegator_address"`
 	Amount           types.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount"`
+	// preferred_validators optionally defines the whitelisted validators preferred by the delegator with their weights,
+	// the minted bToken is recorded as their preferred stakes
+	PreferredValidators []PreferredValidator `protobuf:"bytes,3,rep,name=preferred_validators,json=preferredValidators,proto3" json:"preferred_validators" yaml:"preferred_validators"`
 }
 
 func (m *MsgLiquidStake) Reset()         { *m = MsgLiquidStake{} }
@@ -196,11 +199,421 @@ func (m *MsgLiquidUnstakeResponse) GetCompletionTime() time.Time {
 	return time.Time{}
 }
 
+// MsgInstantLiquidUnstake defines a SDK message for swapping liquid staking tokens for native tokens immediately from
+// the instant unstake reserve.
+type MsgInstantLiquidUnstake struct {
+	DelegatorAddress string     `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty" yaml:"delegator_address"`
+	Amount           types.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount"`
+}
+
+func (m *MsgInstantLiquidUnstake) Reset()         { *m = MsgInstantLiquidUnstake{} }
+func (m *MsgInstantLiquidUnstake) String() string { return proto.CompactTextString(m) }
+func (*MsgInstantLiquidUnstake) ProtoMessage()    {}
+func (*MsgInstantLiquidUnstake) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d46e981836fefd9, []int{4}
+}
+func (m *MsgInstantLiquidUnstake) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgInstantLiquidUnstake) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgInstantLiquidUnstake.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgInstantLiquidUnstake) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgInstantLiquidUnstake.Merge(m, src)
+}
+func (m *MsgInstantLiquidUnstake) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgInstantLiquidUnstake) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgInstantLiquidUnstake.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgInstantLiquidUnstake proto.InternalMessageInfo
+
+// MsgInstantLiquidUnstakeResponse defines the Msg/InstantLiquidUnstake response type.
+type MsgInstantLiquidUnstakeResponse struct {
+	UnstakedAmount types.Coin `protobuf:"bytes,1,opt,name=unstaked_amount,json=unstakedAmount,proto3" json:"unstaked_amount"`
+}
+
+func (m *MsgInstantLiquidUnstakeResponse) Reset()         { *m = MsgInstantLiquidUnstakeResponse{} }
+func (m *MsgInstantLiquidUnstakeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgInstantLiquidUnstakeResponse) ProtoMessage()    {}
+func (*MsgInstantLiquidUnstakeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d46e981836fefd9, []int{5}
+}
+func (m *MsgInstantLiquidUnstakeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgInstantLiquidUnstakeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgInstantLiquidUnstakeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgInstantLiquidUnstakeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgInstantLiquidUnstakeResponse.Merge(m, src)
+}
+func (m *MsgInstantLiquidUnstakeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgInstantLiquidUnstakeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgInstantLiquidUnstakeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgInstantLiquidUnstakeResponse proto.InternalMessageInfo
+
+func (m *MsgInstantLiquidUnstakeResponse) GetUnstakedAmount() types.Coin {
+	if m != nil {
+		return m.UnstakedAmount
+	}
+	return types.Coin{}
+}
+
+// MsgUpdatePauseSwitches defines a SDK message for updating the paused operations of the module.
+type MsgUpdatePauseSwitches struct {
+	// authority is the address of the governance module account or the pauser
+	Authority     string        `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	PauseSwitches PauseSwitches `protobuf:"bytes,2,opt,name=pause_switches,json=pauseSwitches,proto3" json:"pause_switches" yaml:"pause_switches"`
+}
+
+func (m *MsgUpdatePauseSwitches) Reset()         { *m = MsgUpdatePauseSwitches{} }
+func (m *MsgUpdatePauseSwitches) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdatePauseSwitches) ProtoMessage()    {}
+func (*MsgUpdatePauseSwitches) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d46e981836fefd9, []int{6}
+}
+func (m *MsgUpdatePauseSwitches) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdatePauseSwitches) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdatePauseSwitches.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdatePauseSwitches) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdatePauseSwitches.Merge(m, src)
+}
+func (m *MsgUpdatePauseSwitches) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdatePauseSwitches) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdatePauseSwitches.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdatePauseSwitches proto.InternalMessageInfo
+
+// MsgUpdatePauseSwitchesResponse defines the Msg/UpdatePauseSwitches response type.
+type MsgUpdatePauseSwitchesResponse struct {
+}
+
+func (m *MsgUpdatePauseSwitchesResponse) Reset()         { *m = MsgUpdatePauseSwitchesResponse{} }
+func (m *MsgUpdatePauseSwitchesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdatePauseSwitchesResponse) ProtoMessage()    {}
+func (*MsgUpdatePauseSwitchesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d46e981836fefd9, []int{7}
+}
+func (m *MsgUpdatePauseSwitchesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdatePauseSwitchesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdatePauseSwitchesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdatePauseSwitchesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdatePauseSwitchesResponse.Merge(m, src)
+}
+func (m *MsgUpdatePauseSwitchesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdatePauseSwitchesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdatePauseSwitchesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdatePauseSwitchesResponse proto.InternalMessageInfo
+
+// MsgAddWhitelistedValidators defines a SDK message for adding validators to the whitelist.
+type MsgAddWhitelistedValidators struct {
+	// authority is the address of the governance module account
+	Authority             string                 `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	WhitelistedValidators []WhitelistedValidator `protobuf:"bytes,2,rep,name=whitelisted_validators,json=whitelistedValidators,proto3" json:"whitelisted_validators" yaml:"whitelisted_validators"`
+}
+
+func (m *MsgAddWhitelistedValidators) Reset()         { *m = MsgAddWhitelistedValidators{} }
+func (m *MsgAddWhitelistedValidators) String() string { return proto.CompactTextString(m) }
+func (*MsgAddWhitelistedValidators) ProtoMessage()    {}
+func (*MsgAddWhitelistedValidators) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d46e981836fefd9, []int{8}
+}
+func (m *MsgAddWhitelistedValidators) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddWhitelistedValidators) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddWhitelistedValidators.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAddWhitelistedValidators) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddWhitelistedValidators.Merge(m, src)
+}
+func (m *MsgAddWhitelistedValidators) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddWhitelistedValidators) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddWhitelistedValidators.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAddWhitelistedValidators proto.InternalMessageInfo
+
+// MsgAddWhitelistedValidatorsResponse defines the Msg/AddWhitelistedValidators response type.
+type MsgAddWhitelistedValidatorsResponse struct {
+}
+
+func (m *MsgAddWhitelistedValidatorsResponse) Reset()         { *m = MsgAddWhitelistedValidatorsResponse{} }
+func (m *MsgAddWhitelistedValidatorsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAddWhitelistedValidatorsResponse) ProtoMessage()    {}
+func (*MsgAddWhitelistedValidatorsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d46e981836fefd9, []int{9}
+}
+func (m *MsgAddWhitelistedValidatorsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddWhitelistedValidatorsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddWhitelistedValidatorsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAddWhitelistedValidatorsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddWhitelistedValidatorsResponse.Merge(m, src)
+}
+func (m *MsgAddWhitelistedValidatorsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddWhitelistedValidatorsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddWhitelistedValidatorsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAddWhitelistedValidatorsResponse proto.InternalMessageInfo
+
+// MsgUpdateWhitelistedValidatorWeights defines a SDK message for updating the target weights of whitelisted
+// validators.
+type MsgUpdateWhitelistedValidatorWeights struct {
+	// authority is the address of the governance module account
+	Authority             string                 `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	WhitelistedValidators []WhitelistedValidator `protobuf:"bytes,2,rep,name=whitelisted_validators,json=whitelistedValidators,proto3" json:"whitelisted_validators" yaml:"whitelisted_validators"`
+}
+
+func (m *MsgUpdateWhitelistedValidatorWeights) Reset()         { *m = MsgUpdateWhitelistedValidatorWeights{} }
+func (m *MsgUpdateWhitelistedValidatorWeights) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateWhitelistedValidatorWeights) ProtoMessage()    {}
+func (*MsgUpdateWhitelistedValidatorWeights) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d46e981836fefd9, []int{10}
+}
+func (m *MsgUpdateWhitelistedValidatorWeights) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateWhitelistedValidatorWeights) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateWhitelistedValidatorWeights.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateWhitelistedValidatorWeights) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateWhitelistedValidatorWeights.Merge(m, src)
+}
+func (m *MsgUpdateWhitelistedValidatorWeights) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateWhitelistedValidatorWeights) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateWhitelistedValidatorWeights.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateWhitelistedValidatorWeights proto.InternalMessageInfo
+
+// MsgUpdateWhitelistedValidatorWeightsResponse defines the Msg/UpdateWhitelistedValidatorWeights response type.
+type MsgUpdateWhitelistedValidatorWeightsResponse struct {
+}
+
+func (m *MsgUpdateWhitelistedValidatorWeightsResponse) Reset() {
+	*m = MsgUpdateWhitelistedValidatorWeightsResponse{}
+}
+func (m *MsgUpdateWhitelistedValidatorWeightsResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*MsgUpdateWhitelistedValidatorWeightsResponse) ProtoMessage() {}
+func (*MsgUpdateWhitelistedValidatorWeightsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d46e981836fefd9, []int{11}
+}
+func (m *MsgUpdateWhitelistedValidatorWeightsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateWhitelistedValidatorWeightsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateWhitelistedValidatorWeightsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateWhitelistedValidatorWeightsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateWhitelistedValidatorWeightsResponse.Merge(m, src)
+}
+func (m *MsgUpdateWhitelistedValidatorWeightsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateWhitelistedValidatorWeightsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateWhitelistedValidatorWeightsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateWhitelistedValidatorWeightsResponse proto.InternalMessageInfo
+
+// MsgRemoveWhitelistedValidators defines a SDK message for removing validators from the whitelist.
+type MsgRemoveWhitelistedValidators struct {
+	// authority is the address of the governance module account
+	Authority          string   `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	ValidatorAddresses []string `protobuf:"bytes,2,rep,name=validator_addresses,json=validatorAddresses,proto3" json:"validator_addresses,omitempty" yaml:"validator_addresses"`
+}
+
+func (m *MsgRemoveWhitelistedValidators) Reset()         { *m = MsgRemoveWhitelistedValidators{} }
+func (m *MsgRemoveWhitelistedValidators) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveWhitelistedValidators) ProtoMessage()    {}
+func (*MsgRemoveWhitelistedValidators) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d46e981836fefd9, []int{12}
+}
+func (m *MsgRemoveWhitelistedValidators) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveWhitelistedValidators) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveWhitelistedValidators.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveWhitelistedValidators) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveWhitelistedValidators.Merge(m, src)
+}
+func (m *MsgRemoveWhitelistedValidators) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveWhitelistedValidators) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveWhitelistedValidators.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveWhitelistedValidators proto.InternalMessageInfo
+
+// MsgRemoveWhitelistedValidatorsResponse defines the Msg/RemoveWhitelistedValidators response type.
+type MsgRemoveWhitelistedValidatorsResponse struct {
+}
+
+func (m *MsgRemoveWhitelistedValidatorsResponse) Reset() {
+	*m = MsgRemoveWhitelistedValidatorsResponse{}
+}
+func (m *MsgRemoveWhitelistedValidatorsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveWhitelistedValidatorsResponse) ProtoMessage()    {}
+func (*MsgRemoveWhitelistedValidatorsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d46e981836fefd9, []int{13}
+}
+func (m *MsgRemoveWhitelistedValidatorsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveWhitelistedValidatorsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveWhitelistedValidatorsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveWhitelistedValidatorsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveWhitelistedValidatorsResponse.Merge(m, src)
+}
+func (m *MsgRemoveWhitelistedValidatorsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveWhitelistedValidatorsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveWhitelistedValidatorsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveWhitelistedValidatorsResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgLiquidStake)(nil), "pstake.lspersistence.v1beta1.MsgLiquidStake")
 	proto.RegisterType((*MsgLiquidStakeResponse)(nil), "pstake.lspersistence.v1beta1.MsgLiquidStakeResponse")
 	proto.RegisterType((*MsgLiquidUnstake)(nil), "pstake.lspersistence.v1beta1.MsgLiquidUnstake")
 	proto.RegisterType((*MsgLiquidUnstakeResponse)(nil), "pstake.lspersistence.v1beta1.MsgLiquidUnstakeResponse")
+	proto.RegisterType((*MsgInstantLiquidUnstake)(nil), "pstake.lspersistence.v1beta1.MsgInstantLiquidUnstake")
+	proto.RegisterType((*MsgInstantLiquidUnstakeResponse)(nil), "pstake.lspersistence.v1beta1.MsgInstantLiquidUnstakeResponse")
+	proto.RegisterType((*MsgUpdatePauseSwitches)(nil), "pstake.lspersistence.v1beta1.MsgUpdatePauseSwitches")
+	proto.RegisterType((*MsgUpdatePauseSwitchesResponse)(nil), "pstake.lspersistence.v1beta1.MsgUpdatePauseSwitchesResponse")
+	proto.RegisterType((*MsgAddWhitelistedValidators)(nil), "pstake.lspersistence.v1beta1.MsgAddWhitelistedValidators")
+	proto.RegisterType((*MsgAddWhitelistedValidatorsResponse)(nil), "pstake.lspersistence.v1beta1.MsgAddWhitelistedValidatorsResponse")
+	proto.RegisterType((*MsgUpdateWhitelistedValidatorWeights)(nil), "pstake.lspersistence.v1beta1.MsgUpdateWhitelistedValidatorWeights")
+	proto.RegisterType((*MsgUpdateWhitelistedValidatorWeightsResponse)(nil), "pstake.lspersistence.v1beta1.MsgUpdateWhitelistedValidatorWeightsResponse")
+	proto.RegisterType((*MsgRemoveWhitelistedValidators)(nil), "pstake.lspersistence.v1beta1.MsgRemoveWhitelistedValidators")
+	proto.RegisterType((*MsgRemoveWhitelistedValidatorsResponse)(nil), "pstake.lspersistence.v1beta1.MsgRemoveWhitelistedValidatorsResponse")
 }
 
 func init() {
@@ -208,36 +621,63 @@ func init() {
 }
 
 var fileDescriptor_7d46e981836fefd9 = []byte{
-	// 452 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x93, 0x41, 0x6f, 0xd3, 0x30,
-	0x14, 0xc7, 0x63, 0x40, 0xd3, 0x70, 0xc5, 0x18, 0x11, 0x42, 0x59, 0x34, 0x25, 0x53, 0x24, 0xa4,
-	0x1d, 0xc0, 0xd6, 0x0a, 0x02, 0x69, 0x27, 0x28, 0x27, 0x24, 0x2a, 0xa4, 0x02, 0x97, 0x5d, 0x2a,
-	0x27, 0x35, 0xc6, 0x22, 0xf1, 0xcb, 0xfa, 0xdc, 0xb2, 0x7d, 0x03, 0x8e, 0xfb, 0x04, 0xa8, 0x1f,
-	0x67, 0xc7, 0x1d, 0x39, 0x0d, 0xd4, 0x5e, 0xb8, 0xc2, 0x27, 0x40, 0xa9, 0x93, 0x6e, 0x1d, 0x12,
-	0xa2, 0x37, 0x6e, 0x79, 0xfe, 0xff, 0xec, 0xf7, 0xf7, 0xfb, 0xc7, 0xf4, 0x7e, 0x89, 0x56, 0x7c,
-	0x94, 0x3c, 0xc7, 0x52, 0x0e, 0x51, 0xa3, 0x95, 0x26, 0x93, 0x7c, 0xbc, 0x97, 0x4a, 0x2b, 0xf6,
-	0xb8, 0x3d, 0x62, 0xe5, 0x10, 0x2c, 0xf8, 0xdb, 0x0e, 0x63, 0x4b, 0x18, 0xab, 0xb1, 0xf0, 0xae,
-	0x02, 0x05, 0x73, 0x90, 0x57, 0x5f, 0x6e, 0x4f, 0xb8, 0x95, 0x01, 0x16, 0x80, 0x7d, 0x27, 0xb8,
-	0xa2, 0x96, 0x22, 0x57, 0xf1, 0x54, 0xe0, 0x45, 0xb3, 0x0c, 0xb4, 0xa9, 0xf5, 0x58, 0x01, 0xa8,
-	0x5c, 0xf2, 0x79, 0x95, 0x8e, 0xde, 0x73, 0xab, 0x0b, 0x89, 0x56, 0x14, 0xa5, 0x03, 0x92, 0x2f,
-	0x84, 0x6e, 0x74, 0x51, 0xbd, 0xd2, 0x87, 0x23, 0x3d, 0x78, 0x53, 0x59, 0xf3, 0x5f, 0xd2, 0x3b,
-	0x03, 0x99, 0x4b, 0x25, 0x2c, 0x0c, 0xfb, 0x62, 0x30, 0x18, 0x4a, 0xc4, 0x80, 0xec, 0x90, 0xdd,
-	0x9b, 0x9d, 0xed, 0x5f, 0xe7, 0x71, 0x70, 0x2c, 0x8a, 0x7c, 0x3f, 0xf9, 0x03, 0x49, 0x7a, 0x9b,
-	0x8b, 0xb5, 0xe7, 0x6e, 0xc9, 0x7f, 0x4a, 0xd7, 0x44, 0x01, 0x23, 0x63, 0x83, 0x6b, 0x3b, 0x64,
-	0xb7, 0xd5, 0xde, 0x62, 0xb5, 0xfb, 0xca, 0x6f, 0x73, 0x6b, 0xf6, 0x02, 0xb4, 0xe9, 0xdc, 0x38,
-	0x3d, 0x8f, 0xbd, 0x5e, 0x8d, 0xef, 0xaf, 0x7f, 0x9e, 0xc4, 0xde, 0x8f, 0x49, 0xec, 0x25, 0x01,
-	0xbd, 0xb7, 0xec, 0xaf, 0x27, 0xb1, 0x04, 0x83, 0x32, 0x99, 0x10, 0xba, 0xb9, 0x90, 0xde, 0x19,
-	0xfc, 0x0f, 0xcd, 0x6b, 0x1a, 0x5c, 0x75, 0xd8, 0xd8, 0xf7, 0xbb, 0xf4, 0x76, 0x06, 0x45, 0x99,
-	0x4b, 0xab, 0xc1, 0xf4, 0xab, 0x5c, 0xe6, 0x3e, 0x5b, 0xed, 0x90, 0xb9, 0xd0, 0x58, 0x13, 0x1a,
-	0x7b, 0xdb, 0x84, 0xd6, 0x59, 0xaf, 0x1a, 0x9d, 0x7c, 0x8b, 0x49, 0x6f, 0xe3, 0x62, 0x73, 0x25,
-	0xb7, 0x7f, 0x12, 0x7a, 0xbd, 0x8b, 0xca, 0x3f, 0xa4, 0xad, 0xcb, 0x61, 0x3e, 0x60, 0x7f, 0xfb,
-	0xe1, 0xd8, 0xf2, 0x68, 0xc3, 0xc7, 0xab, 0xd0, 0x8b, 0x9b, 0x7c, 0xa2, 0xb7, 0x96, 0x43, 0x60,
-	0xff, 0x78, 0x4c, 0xcd, 0x87, 0x4f, 0x56, 0xe3, 0x9b, 0xc6, 0x9d, 0x83, 0xd3, 0x69, 0x44, 0xce,
-	0xa6, 0x11, 0xf9, 0x3e, 0x8d, 0xc8, 0xc9, 0x2c, 0xf2, 0xce, 0x66, 0x91, 0xf7, 0x75, 0x16, 0x79,
-	0x07, 0xcf, 0x94, 0xb6, 0x1f, 0x46, 0x29, 0xcb, 0xa0, 0xe0, 0x97, 0x8e, 0x7c, 0x6d, 0x24, 0x77,
-	0xad, 0x1e, 0x1a, 0x61, 0xf5, 0x58, 0xf2, 0x71, 0x9b, 0x1f, 0x5d, 0x79, 0xb3, 0xf6, 0xb8, 0x94,
-	0x98, 0xae, 0xcd, 0xa7, 0xff, 0xe8, 0x77, 0x00, 0x00, 0x00, 0xff, 0xff, 0x55, 0xad, 0x86, 0xc6,
-	0xd8, 0x03, 0x00, 0x00,
+	// 888 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x56, 0x41, 0x8f, 0xdb, 0x44,
+	0x18, 0xcd, 0xec, 0xa2, 0x6a, 0x77, 0xa2, 0xa6, 0xc5, 0x9b, 0x16, 0xd7, 0xdb, 0xda, 0xc1, 0x65,
+	0x51, 0x24, 0x5a, 0xbb, 0x0d, 0x50, 0x44, 0x05, 0x12, 0x31, 0x1c, 0x28, 0x22, 0x6a, 0xe5, 0xa5,
+	0x54, 0xea, 0x25, 0x72, 0xe2, 0xa9, 0x33, 0xaa, 0xed, 0xf1, 0x7a, 0x26, 0x49, 0xf7, 0xc8, 0xad,
+	0x48, 0x1c, 0xf6, 0xc8, 0x71, 0x39, 0x21, 0xce, 0xe5, 0x47, 0xf4, 0x82, 0x54, 0x71, 0xe2, 0x14,
+	0xd0, 0xee, 0x85, 0x73, 0xae, 0x5c, 0x90, 0xed, 0xb1, 0xe3, 0xb4, 0x5e, 0x27, 0x4d, 0x39, 0x54,
+	0xdc, 0xe2, 0x99, 0xf7, 0xbe, 0xef, 0xbd, 0x97, 0x99, 0xcf, 0x86, 0x3b, 0x01, 0x65, 0xd6, 0x43,
+	0xa4, 0xbb, 0x34, 0x40, 0x21, 0xc5, 0x94, 0x21, 0xbf, 0x8f, 0xf4, 0xd1, 0xf5, 0x1e, 0x62, 0xd6,
+	0x75, 0x9d, 0x3d, 0xd2, 0x82, 0x90, 0x30, 0x22, 0x5c, 0x4c, 0x60, 0xda, 0x1c, 0x4c, 0xe3, 0x30,
+	0xa9, 0xee, 0x10, 0x87, 0xc4, 0x40, 0x3d, 0xfa, 0x95, 0x70, 0xa4, 0x0b, 0x7d, 0x42, 0x3d, 0x42,
+	0xbb, 0xc9, 0x46, 0xf2, 0xc0, 0xb7, 0xe4, 0xe4, 0x49, 0xef, 0x59, 0x74, 0xd6, 0xac, 0x4f, 0xb0,
+	0xcf, 0xf7, 0x15, 0x87, 0x10, 0xc7, 0x45, 0x7a, 0xfc, 0xd4, 0x1b, 0x3e, 0xd0, 0x19, 0xf6, 0x10,
+	0x65, 0x96, 0x17, 0x70, 0xc0, 0xb5, 0x52, 0xd9, 0x2e, 0xde, 0x1b, 0x62, 0x3b, 0x42, 0x60, 0xdf,
+	0x49, 0x18, 0xea, 0x2f, 0x6b, 0xb0, 0xd6, 0xa1, 0xce, 0xd7, 0xf1, 0xd6, 0x6e, 0x44, 0x16, 0x6e,
+	0xc1, 0x37, 0x6d, 0xe4, 0x22, 0xc7, 0x62, 0x24, 0xec, 0x5a, 0xb6, 0x1d, 0x22, 0x4a, 0x45, 0xd0,
+	0x00, 0xcd, 0x4d, 0xe3, 0xe2, 0x74, 0xa2, 0x88, 0xfb, 0x96, 0xe7, 0xde, 0x54, 0x5f, 0x80, 0xa8,
+	0xe6, 0xd9, 0x6c, 0xad, 0x9d, 0x2c, 0x09, 0x1f, 0xc1, 0x53, 0x96, 0x47, 0x86, 0x3e, 0x13, 0xd7,
+	0x1a, 0xa0, 0x59, 0x6d, 0x5d, 0xd0, 0xb8, 0xdf, 0xc8, 0x61, 0x9a, 0x93, 0xf6, 0x39, 0xc1, 0xbe,
+	0xf1, 0xc6, 0xd3, 0x89, 0x52, 0x31, 0x39, 0x5c, 0x78, 0x0c, 0x60, 0x3d, 0x08, 0xd1, 0x03, 0x14,
+	0x86, 0xc8, 0xee, 0x8e, 0x2c, 0x17, 0xdb, 0x51, 0x5d, 0x2a, 0xae, 0x37, 0xd6, 0x9b, 0xd5, 0xd6,
+	0x35, 0xad, 0x2c, 0x78, 0xed, 0x4e, 0xca, 0xfc, 0x36, 0x25, 0x1a, 0x97, 0xa3, 0xf2, 0xd3, 0x89,
+	0xb2, 0x9d, 0xa8, 0x2f, 0xaa, 0xad, 0x9a, 0x5b, 0xc1, 0x0b, 0x44, 0x7a, 0x73, 0xe3, 0xf1, 0xa1,
+	0x52, 0xf9, 0xfb, 0x50, 0xa9, 0xa8, 0x22, 0x3c, 0x3f, 0x1f, 0x95, 0x89, 0x68, 0x40, 0x7c, 0x8a,
+	0xd4, 0x43, 0x00, 0xcf, 0x66, 0x5b, 0x77, 0x7d, 0xfa, 0xba, 0xe4, 0x98, 0x13, 0x8f, 0xa1, 0xf8,
+	0xbc, 0xc2, 0x54, 0xbe, 0xd0, 0x81, 0x67, 0xfa, 0xc4, 0x0b, 0x5c, 0xc4, 0x30, 0xf1, 0xbb, 0xd1,
+	0xa1, 0x8a, 0x75, 0x56, 0x5b, 0x92, 0x96, 0x9c, 0x38, 0x2d, 0x3d, 0x71, 0xda, 0x37, 0xe9, 0x89,
+	0x33, 0x36, 0xa2, 0x46, 0x07, 0x7f, 0x2a, 0xc0, 0xac, 0xcd, 0xc8, 0xd1, 0xb6, 0xfa, 0x33, 0x80,
+	0x6f, 0x75, 0xa8, 0x73, 0x2b, 0xea, 0xe2, 0xb3, 0xd7, 0x39, 0x94, 0x87, 0x50, 0x39, 0x41, 0x68,
+	0x96, 0xcd, 0x97, 0xf0, 0xcc, 0x30, 0x59, 0xb2, 0xbb, 0xbc, 0x1d, 0x58, 0xae, 0x5d, 0x2d, 0xe5,
+	0xb5, 0x63, 0x9a, 0xfa, 0x1b, 0x88, 0xcf, 0xcf, 0xdd, 0xc0, 0xb6, 0x18, 0xba, 0x63, 0x0d, 0x29,
+	0xda, 0x1d, 0x63, 0xd6, 0x1f, 0x20, 0x2a, 0xdc, 0x80, 0x9b, 0xd6, 0x90, 0x0d, 0x48, 0x88, 0xd9,
+	0x3e, 0x4f, 0x43, 0xfc, 0xfd, 0xd7, 0xab, 0x75, 0xde, 0x81, 0x3b, 0xde, 0x65, 0x21, 0xf6, 0x1d,
+	0x73, 0x06, 0x15, 0xf6, 0x60, 0x2d, 0x88, 0x0a, 0x75, 0x29, 0xaf, 0xc4, 0xa3, 0x78, 0x6f, 0xc1,
+	0xfd, 0xc8, 0x37, 0x37, 0x2e, 0xf1, 0xab, 0x71, 0x8e, 0x5f, 0x8d, 0xb9, 0x82, 0xaa, 0x79, 0x3a,
+	0xc8, 0xa3, 0x73, 0xe1, 0x35, 0xa0, 0x5c, 0x6c, 0x27, 0xbb, 0x16, 0x53, 0x00, 0xb7, 0x3b, 0xd4,
+	0x69, 0xdb, 0xf6, 0xbd, 0x01, 0x66, 0xc8, 0x8d, 0x74, 0xe4, 0xae, 0xd6, 0xca, 0xb6, 0x0f, 0x00,
+	0x3c, 0x3f, 0x9e, 0x55, 0xcc, 0xcf, 0x87, 0xb5, 0x78, 0x3e, 0xb4, 0xca, 0xfd, 0x17, 0xa9, 0x31,
+	0x76, 0x78, 0x0c, 0x97, 0x92, 0x18, 0x8a, 0xeb, 0xab, 0xe6, 0xb9, 0x71, 0x91, 0x95, 0x5c, 0x2c,
+	0x3b, 0xf0, 0x72, 0x89, 0xe7, 0x2c, 0x9b, 0x7f, 0x00, 0x7c, 0x27, 0x8b, 0xaf, 0x08, 0x7a, 0x0f,
+	0x61, 0x67, 0xc0, 0xfe, 0xa7, 0x21, 0x69, 0xf0, 0xca, 0x32, 0xe6, 0xb3, 0xb4, 0x9e, 0x80, 0xf8,
+	0xb0, 0x99, 0xc8, 0x23, 0x23, 0xf4, 0xdf, 0x1e, 0xa6, 0xdb, 0x70, 0x2b, 0x93, 0x9e, 0x8e, 0x1b,
+	0x94, 0x64, 0xb4, 0x69, 0xc8, 0xd3, 0x89, 0x22, 0x25, 0x5e, 0x0b, 0x40, 0xaa, 0x29, 0x64, 0xab,
+	0xed, 0x74, 0x31, 0xe7, 0xb2, 0x09, 0xdf, 0x2d, 0x17, 0x9d, 0xfa, 0x6b, 0x7d, 0xb7, 0x01, 0xd7,
+	0x3b, 0xd4, 0x11, 0xf6, 0x60, 0x35, 0xff, 0x2a, 0xbe, 0x52, 0xfe, 0x17, 0xcd, 0xbf, 0x8d, 0xa4,
+	0x0f, 0x5e, 0x06, 0x9d, 0x0d, 0xb8, 0x31, 0x3c, 0x3d, 0x3f, 0xa2, 0xb5, 0x25, 0xcb, 0x70, 0xbc,
+	0x74, 0xe3, 0xe5, 0xf0, 0x59, 0xe3, 0x1f, 0x00, 0xac, 0x17, 0xbe, 0x23, 0x3e, 0x5c, 0x58, 0xb0,
+	0x88, 0x26, 0x7d, 0xba, 0x12, 0x2d, 0x93, 0xf3, 0x3d, 0x80, 0x5b, 0x45, 0xb3, 0x79, 0x71, 0xaa,
+	0x05, 0x2c, 0xe9, 0x93, 0x55, 0x58, 0x99, 0x96, 0x1f, 0x01, 0x14, 0x4f, 0x9c, 0x9a, 0x1f, 0x2f,
+	0x2c, 0x7d, 0x12, 0x55, 0x6a, 0xaf, 0x4c, 0xcd, 0xa4, 0x3d, 0x01, 0xf0, 0xed, 0xc5, 0x43, 0xcb,
+	0x58, 0xd2, 0x7e, 0x49, 0x0d, 0xe9, 0xab, 0x57, 0xaf, 0x91, 0xa9, 0xfe, 0x09, 0xc0, 0xed, 0xb2,
+	0xe1, 0xb1, 0xf8, 0xef, 0x2a, 0x61, 0x4b, 0x5f, 0xbc, 0x0a, 0x3b, 0xd5, 0x68, 0xdc, 0x7f, 0x7a,
+	0x24, 0x83, 0x67, 0x47, 0x32, 0xf8, 0xeb, 0x48, 0x06, 0x07, 0xc7, 0x72, 0xe5, 0xd9, 0xb1, 0x5c,
+	0xf9, 0xe3, 0x58, 0xae, 0xdc, 0xff, 0xcc, 0xc1, 0x6c, 0x30, 0xec, 0x69, 0x7d, 0xe2, 0xe9, 0xb9,
+	0x06, 0xb7, 0x7d, 0xa4, 0x27, 0x8d, 0xaf, 0xfa, 0x16, 0xc3, 0x23, 0xa4, 0x8f, 0x5a, 0xfa, 0xa3,
+	0xe7, 0x3e, 0xfe, 0xd9, 0x7e, 0x80, 0x68, 0xef, 0x54, 0xfc, 0x01, 0xf7, 0xfe, 0xbf, 0x03, 0x00,
+	0x57, 0x1f, 0xf1, 0xc8, 0xd8, 0x0c, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -258,6 +698,19 @@ type MsgClient interface {
 	// LiquidUnstake defines a method for performing an undelegation of liquid staking from a
 	// delegate.
 	LiquidUnstake(ctx context.Context, in *MsgLiquidUnstake, opts ...grpc.CallOption) (*MsgLiquidUnstakeResponse, error)
+	// InstantLiquidUnstake defines a method for swapping liquid staking tokens for native tokens immediately from the
+	// instant unstake reserve of the proxy account.
+	InstantLiquidUnstake(ctx context.Context, in *MsgInstantLiquidUnstake, opts ...grpc.CallOption) (*MsgInstantLiquidUnstakeResponse, error)
+	// UpdatePauseSwitches defines a method for pausing or resuming the operations of the module by the governance, the
+	// pauser is only allowed to pause them.
+	UpdatePauseSwitches(ctx context.Context, in *MsgUpdatePauseSwitches, opts ...grpc.CallOption) (*MsgUpdatePauseSwitchesResponse, error)
+	// AddWhitelistedValidators defines a governance method for adding validators to the whitelist.
+	AddWhitelistedValidators(ctx context.Context, in *MsgAddWhitelistedValidators, opts ...grpc.CallOption) (*MsgAddWhitelistedValidatorsResponse, error)
+	// UpdateWhitelistedValidatorWeights defines a governance method for updating the target weights of whitelisted
+	// validators.
+	UpdateWhitelistedValidatorWeights(ctx context.Context, in *MsgUpdateWhitelistedValidatorWeights, opts ...grpc.CallOption) (*MsgUpdateWhitelistedValidatorWeightsResponse, error)
+	// RemoveWhitelistedValidators defines a governance method for removing validators from the whitelist.
+	RemoveWhitelistedValidators(ctx context.Context, in *MsgRemoveWhitelistedValidators, opts ...grpc.CallOption) (*MsgRemoveWhitelistedValidatorsResponse, error)
 }
 
 type msgClient struct {
@@ -286,6 +739,51 @@ func (c *msgClient) LiquidUnstake(ctx context.Context, in *MsgLiquidUnstake, opt
 	return out, nil
 }
 
+func (c *msgClient) InstantLiquidUnstake(ctx context.Context, in *MsgInstantLiquidUnstake, opts ...grpc.CallOption) (*MsgInstantLiquidUnstakeResponse, error) {
+	out := new(MsgInstantLiquidUnstakeResponse)
+	err := c.cc.Invoke(ctx, "/pstake.lspersistence.v1beta1.Msg/InstantLiquidUnstake", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdatePauseSwitches(ctx context.Context, in *MsgUpdatePauseSwitches, opts ...grpc.CallOption) (*MsgUpdatePauseSwitchesResponse, error) {
+	out := new(MsgUpdatePauseSwitchesResponse)
+	err := c.cc.Invoke(ctx, "/pstake.lspersistence.v1beta1.Msg/UpdatePauseSwitches", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AddWhitelistedValidators(ctx context.Context, in *MsgAddWhitelistedValidators, opts ...grpc.CallOption) (*MsgAddWhitelistedValidatorsResponse, error) {
+	out := new(MsgAddWhitelistedValidatorsResponse)
+	err := c.cc.Invoke(ctx, "/pstake.lspersistence.v1beta1.Msg/AddWhitelistedValidators", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateWhitelistedValidatorWeights(ctx context.Context, in *MsgUpdateWhitelistedValidatorWeights, opts ...grpc.CallOption) (*MsgUpdateWhitelistedValidatorWeightsResponse, error) {
+	out := new(MsgUpdateWhitelistedValidatorWeightsResponse)
+	err := c.cc.Invoke(ctx, "/pstake.lspersistence.v1beta1.Msg/UpdateWhitelistedValidatorWeights", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveWhitelistedValidators(ctx context.Context, in *MsgRemoveWhitelistedValidators, opts ...grpc.CallOption) (*MsgRemoveWhitelistedValidatorsResponse, error) {
+	out := new(MsgRemoveWhitelistedValidatorsResponse)
+	err := c.cc.Invoke(ctx, "/pstake.lspersistence.v1beta1.Msg/RemoveWhitelistedValidators", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// LiquidStake defines a method for performing a delegation of coins
@@ -294,6 +792,19 @@ type MsgServer interface {
 	// LiquidUnstake defines a method for performing an undelegation of liquid staking from a
 	// delegate.
 	LiquidUnstake(context.Context, *MsgLiquidUnstake) (*MsgLiquidUnstakeResponse, error)
+	// InstantLiquidUnstake defines a method for swapping liquid staking tokens for native tokens immediately from the
+	// instant unstake reserve of the proxy account.
+	InstantLiquidUnstake(context.Context, *MsgInstantLiquidUnstake) (*MsgInstantLiquidUnstakeResponse, error)
+	// UpdatePauseSwitches defines a method for pausing or resuming the operations of the module by the governance, the
+	// pauser is only allowed to pause them.
+	UpdatePauseSwitches(context.Context, *MsgUpdatePauseSwitches) (*MsgUpdatePauseSwitchesResponse, error)
+	// AddWhitelistedValidators defines a governance method for adding validators to the whitelist.
+	AddWhitelistedValidators(context.Context, *MsgAddWhitelistedValidators) (*MsgAddWhitelistedValidatorsResponse, error)
+	// UpdateWhitelistedValidatorWeights defines a governance method for updating the target weights of whitelisted
+	// validators.
+	UpdateWhitelistedValidatorWeights(context.Context, *MsgUpdateWhitelistedValidatorWeights) (*MsgUpdateWhitelistedValidatorWeightsResponse, error)
+	// RemoveWhitelistedValidators defines a governance method for removing validators from the whitelist.
+	RemoveWhitelistedValidators(context.Context, *MsgRemoveWhitelistedValidators) (*MsgRemoveWhitelistedValidatorsResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -306,6 +817,21 @@ func (*UnimplementedMsgServer) LiquidStake(ctx context.Context, req *MsgLiquidSt
 func (*UnimplementedMsgServer) LiquidUnstake(ctx context.Context, req *MsgLiquidUnstake) (*MsgLiquidUnstakeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LiquidUnstake not implemented")
 }
+func (*UnimplementedMsgServer) InstantLiquidUnstake(ctx context.Context, req *MsgInstantLiquidUnstake) (*MsgInstantLiquidUnstakeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InstantLiquidUnstake not implemented")
+}
+func (*UnimplementedMsgServer) UpdatePauseSwitches(ctx context.Context, req *MsgUpdatePauseSwitches) (*MsgUpdatePauseSwitchesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePauseSwitches not implemented")
+}
+func (*UnimplementedMsgServer) AddWhitelistedValidators(ctx context.Context, req *MsgAddWhitelistedValidators) (*MsgAddWhitelistedValidatorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddWhitelistedValidators not implemented")
+}
+func (*UnimplementedMsgServer) UpdateWhitelistedValidatorWeights(ctx context.Context, req *MsgUpdateWhitelistedValidatorWeights) (*MsgUpdateWhitelistedValidatorWeightsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateWhitelistedValidatorWeights not implemented")
+}
+func (*UnimplementedMsgServer) RemoveWhitelistedValidators(ctx context.Context, req *MsgRemoveWhitelistedValidators) (*MsgRemoveWhitelistedValidatorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveWhitelistedValidators not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -347,8 +873,98 @@ func _Msg_LiquidUnstake_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "pstake.lspersistence.v1beta1.Msg",
+func _Msg_InstantLiquidUnstake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgInstantLiquidUnstake)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).InstantLiquidUnstake(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.lspersistence.v1beta1.Msg/InstantLiquidUnstake",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).InstantLiquidUnstake(ctx, req.(*MsgInstantLiquidUnstake))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdatePauseSwitches_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdatePauseSwitches)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdatePauseSwitches(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.lspersistence.v1beta1.Msg/UpdatePauseSwitches",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdatePauseSwitches(ctx, req.(*MsgUpdatePauseSwitches))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AddWhitelistedValidators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddWhitelistedValidators)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddWhitelistedValidators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.lspersistence.v1beta1.Msg/AddWhitelistedValidators",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddWhitelistedValidators(ctx, req.(*MsgAddWhitelistedValidators))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateWhitelistedValidatorWeights_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateWhitelistedValidatorWeights)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateWhitelistedValidatorWeights(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.lspersistence.v1beta1.Msg/UpdateWhitelistedValidatorWeights",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateWhitelistedValidatorWeights(ctx, req.(*MsgUpdateWhitelistedValidatorWeights))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveWhitelistedValidators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveWhitelistedValidators)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveWhitelistedValidators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.lspersistence.v1beta1.Msg/RemoveWhitelistedValidators",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveWhitelistedValidators(ctx, req.(*MsgRemoveWhitelistedValidators))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "pstake.lspersistence.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
@@ -359,6 +975,26 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "LiquidUnstake",
 			Handler:    _Msg_LiquidUnstake_Handler,
 		},
+		{
+			MethodName: "InstantLiquidUnstake",
+			Handler:    _Msg_InstantLiquidUnstake_Handler,
+		},
+		{
+			MethodName: "UpdatePauseSwitches",
+			Handler:    _Msg_UpdatePauseSwitches_Handler,
+		},
+		{
+			MethodName: "AddWhitelistedValidators",
+			Handler:    _Msg_AddWhitelistedValidators_Handler,
+		},
+		{
+			MethodName: "UpdateWhitelistedValidatorWeights",
+			Handler:    _Msg_UpdateWhitelistedValidatorWeights_Handler,
+		},
+		{
+			MethodName: "RemoveWhitelistedValidators",
+			Handler:    _Msg_RemoveWhitelistedValidators_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "pstake/lspersistence/v1beta1/tx.proto",
@@ -384,6 +1020,20 @@ func (m *MsgLiquidStake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PreferredValidators) > 0 {
+		for iNdEx := len(m.PreferredValidators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PreferredValidators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	{
 		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -498,74 +1148,1306 @@ func (m *MsgLiquidUnstakeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgInstantLiquidUnstake) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgLiquidStake) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *MsgInstantLiquidUnstake) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgInstantLiquidUnstake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgInstantLiquidUnstakeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgInstantLiquidUnstakeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgInstantLiquidUnstakeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.UnstakedAmount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdatePauseSwitches) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdatePauseSwitches) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdatePauseSwitches) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.PauseSwitches.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdatePauseSwitchesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdatePauseSwitchesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdatePauseSwitchesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddWhitelistedValidators) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddWhitelistedValidators) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddWhitelistedValidators) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.WhitelistedValidators) > 0 {
+		for iNdEx := len(m.WhitelistedValidators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WhitelistedValidators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddWhitelistedValidatorsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddWhitelistedValidatorsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddWhitelistedValidatorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateWhitelistedValidatorWeights) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateWhitelistedValidatorWeights) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateWhitelistedValidatorWeights) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.WhitelistedValidators) > 0 {
+		for iNdEx := len(m.WhitelistedValidators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WhitelistedValidators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateWhitelistedValidatorWeightsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateWhitelistedValidatorWeightsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateWhitelistedValidatorWeightsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveWhitelistedValidators) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveWhitelistedValidators) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveWhitelistedValidators) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddresses) > 0 {
+		for iNdEx := len(m.ValidatorAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ValidatorAddresses[iNdEx])
+			copy(dAtA[i:], m.ValidatorAddresses[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveWhitelistedValidatorsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveWhitelistedValidatorsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveWhitelistedValidatorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgLiquidStake) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.PreferredValidators) > 0 {
+		for _, e := range m.PreferredValidators {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgLiquidStakeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgLiquidUnstake) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgLiquidUnstakeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgInstantLiquidUnstake) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgInstantLiquidUnstakeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.UnstakedAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdatePauseSwitches) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.PauseSwitches.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdatePauseSwitchesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAddWhitelistedValidators) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.WhitelistedValidators) > 0 {
+		for _, e := range m.WhitelistedValidators {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgAddWhitelistedValidatorsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateWhitelistedValidatorWeights) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.WhitelistedValidators) > 0 {
+		for _, e := range m.WhitelistedValidators {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUpdateWhitelistedValidatorWeightsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveWhitelistedValidators) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.ValidatorAddresses) > 0 {
+		for _, s := range m.ValidatorAddresses {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgRemoveWhitelistedValidatorsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgLiquidStake) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgLiquidStake: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgLiquidStake: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreferredValidators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreferredValidators = append(m.PreferredValidators, PreferredValidator{})
+			if err := m.PreferredValidators[len(m.PreferredValidators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgLiquidStakeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgLiquidStakeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgLiquidStakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgLiquidUnstake) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgLiquidUnstake: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgLiquidUnstake: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgLiquidUnstakeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgLiquidUnstakeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgLiquidUnstakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgInstantLiquidUnstake) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgInstantLiquidUnstake: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgInstantLiquidUnstake: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgInstantLiquidUnstakeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgInstantLiquidUnstakeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgInstantLiquidUnstakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnstakedAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UnstakedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdatePauseSwitches) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdatePauseSwitches: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdatePauseSwitches: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PauseSwitches", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PauseSwitches.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
 
-func (m *MsgLiquidStakeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
-
-func (m *MsgLiquidUnstake) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgUpdatePauseSwitchesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdatePauseSwitchesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdatePauseSwitchesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
 
-func (m *MsgLiquidUnstakeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime)
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgLiquidStake) Unmarshal(dAtA []byte) error {
+func (m *MsgAddWhitelistedValidators) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -588,15 +2470,15 @@ func (m *MsgLiquidStake) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgLiquidStake: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddWhitelistedValidators: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgLiquidStake: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddWhitelistedValidators: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -624,11 +2506,11 @@ func (m *MsgLiquidStake) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WhitelistedValidators", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -655,7 +2537,8 @@ func (m *MsgLiquidStake) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.WhitelistedValidators = append(m.WhitelistedValidators, WhitelistedValidator{})
+			if err := m.WhitelistedValidators[len(m.WhitelistedValidators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -680,7 +2563,7 @@ func (m *MsgLiquidStake) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgLiquidStakeResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgAddWhitelistedValidatorsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -703,10 +2586,10 @@ func (m *MsgLiquidStakeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgLiquidStakeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddWhitelistedValidatorsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgLiquidStakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddWhitelistedValidatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -730,7 +2613,7 @@ func (m *MsgLiquidStakeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgLiquidUnstake) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateWhitelistedValidatorWeights) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -753,15 +2636,15 @@ func (m *MsgLiquidUnstake) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgLiquidUnstake: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateWhitelistedValidatorWeights: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgLiquidUnstake: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateWhitelistedValidatorWeights: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -789,11 +2672,11 @@ func (m *MsgLiquidUnstake) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WhitelistedValidators", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -820,7 +2703,8 @@ func (m *MsgLiquidUnstake) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.WhitelistedValidators = append(m.WhitelistedValidators, WhitelistedValidator{})
+			if err := m.WhitelistedValidators[len(m.WhitelistedValidators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -845,7 +2729,7 @@ func (m *MsgLiquidUnstake) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgLiquidUnstakeResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateWhitelistedValidatorWeightsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -868,17 +2752,67 @@ func (m *MsgLiquidUnstakeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgLiquidUnstakeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateWhitelistedValidatorWeightsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgLiquidUnstakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateWhitelistedValidatorWeightsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveWhitelistedValidators) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveWhitelistedValidators: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveWhitelistedValidators: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -888,24 +2822,55 @@ func (m *MsgLiquidUnstakeResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.ValidatorAddresses = append(m.ValidatorAddresses, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -928,6 +2893,56 @@ func (m *MsgLiquidUnstakeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgRemoveWhitelistedValidatorsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveWhitelistedValidatorsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveWhitelistedValidatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
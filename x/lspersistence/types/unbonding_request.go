@@ -0,0 +1,55 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Validate validates the unbonding request.
+func (r UnbondingRequest) Validate() error {
+	if r.Id == 0 {
+		return fmt.Errorf("unbonding request id cannot be 0")
+	}
+	if _, err := sdk.AccAddressFromBech32(r.DelegatorAddress); err != nil {
+		return fmt.Errorf("invalid delegator address %s: %w", r.DelegatorAddress, err)
+	}
+	if err := r.BurnedBtoken.Validate(); err != nil {
+		return fmt.Errorf("invalid burned btoken of unbonding request %d: %w", r.Id, err)
+	}
+	if r.Amount.IsNil() || !r.Amount.IsPositive() {
+		return fmt.Errorf("unbonding request %d amount must be positive: %s", r.Id, r.Amount)
+	}
+	if len(r.Entries) == 0 {
+		return fmt.Errorf("unbonding request %d has no entries", r.Id)
+	}
+
+	total := sdk.ZeroInt()
+	for _, entry := range r.Entries {
+		if _, err := sdk.ValAddressFromBech32(entry.ValidatorAddress); err != nil {
+			return fmt.Errorf("invalid validator address %s: %w", entry.ValidatorAddress, err)
+		}
+		if entry.Amount.IsNil() || !entry.Amount.IsPositive() {
+			return fmt.Errorf("unbonding request %d entry amount must be positive: %s", r.Id, entry.Amount)
+		}
+		total = total.Add(entry.Amount)
+	}
+	if !total.Equal(r.Amount) {
+		return fmt.Errorf("unbonding request %d amount %s does not match the sum of its entries %s", r.Id, r.Amount, total)
+	}
+	return nil
+}
+
+// GetDelegator returns the delegator of the unbonding request.
+func (r UnbondingRequest) GetDelegator() sdk.AccAddress {
+	return sdk.MustAccAddressFromBech32(r.DelegatorAddress)
+}
+
+// GetValidator returns the validator the unbonding request entry was unbonded from.
+func (e UnbondingRequestEntry) GetValidator() sdk.ValAddress {
+	valAddr, err := sdk.ValAddressFromBech32(e.ValidatorAddress)
+	if err != nil {
+		panic(err)
+	}
+	return valAddr
+}